@@ -11,9 +11,13 @@ import (
 	"pvz-cli/internal/cli"
 	climappers "pvz-cli/internal/cli/mappers"
 	"pvz-cli/internal/common/observability"
+	"pvz-cli/internal/common/operator"
+	pb "pvz-cli/internal/gen/orders"
 	"pvz-cli/internal/grpc/gateway"
 	"pvz-cli/internal/grpc/interceptors"
 	grpcmappers "pvz-cli/internal/grpc/mappers"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/handlers"
 	"pvz-cli/internal/workerpool"
 	"sync"
 	"syscall"
@@ -70,12 +74,19 @@ func (a *Application) Run() {
 		func() { a.StartCLI() },
 		func() { a.StartAdminGRPCServer(adminGRPCPort) },
 		func() { a.StartMetricsServer() },
+		func() { a.StartImportJobs() },
+		func() { a.StartIdempotencyPurge() },
 	}
 	if a.container.outboxDispatcher != nil {
 		services = append(services, func() {
 			a.StartOutboxDispatcher()
 		})
 	}
+	if a.container.archiveWorker != nil {
+		services = append(services, func() {
+			a.StartArchiveWorker()
+		})
+	}
 
 	a.wg.Add(len(services))
 	for _, service := range services {
@@ -117,12 +128,40 @@ func (a *Application) StartGRPCServer(port string) {
 		port,
 		router,
 		grpc.ChainUnaryInterceptor(
-			interceptors.ValidationInterceptor(),
 			interceptors.RecoveryInterceptor(),
 			interceptors.CorrelationIDInterceptor(),
+			interceptors.OperatorInterceptor(),
+			interceptors.AuditInterceptor(a.container.auditService, ordersAuditedMethods...),
+			interceptors.AuthInterceptor(a.container.authenticator, ordersMethodRoles),
+			interceptors.ValidationInterceptor(),
 			interceptors.TracingInterceptor(),
 			interceptors.RateLimitInterceptor(),
 			interceptors.LoggingInterceptor(),
+			interceptors.IdempotencyInterceptor(
+				a.container.idempotencyRepo,
+				a.container.clock,
+				a.container.config.Idempotency.TTL,
+				pb.OrdersService_AcceptOrder_FullMethodName,
+				pb.OrdersService_ReturnOrder_FullMethodName,
+				pb.OrdersService_ProcessOrders_FullMethodName,
+				pb.OrdersService_IssueAllReady_FullMethodName,
+				pb.OrdersService_UndoLastOperation_FullMethodName,
+				pb.OrdersService_ImportOrders_FullMethodName,
+				pb.OrdersService_SubmitImportJob_FullMethodName,
+				pb.OrdersService_CancelImportJob_FullMethodName,
+				pb.OrdersService_RegisterShipment_FullMethodName,
+				pb.OrdersService_HandOverShipment_FullMethodName,
+			),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.RecoveryStreamInterceptor(),
+			interceptors.CorrelationIDStreamInterceptor(),
+			interceptors.OperatorStreamInterceptor(),
+			interceptors.AuditStreamInterceptor(a.container.auditService, ordersAuditedMethods...),
+			interceptors.AuthStreamInterceptor(a.container.authenticator, ordersMethodRoles),
+			interceptors.TracingStreamInterceptor(),
+			interceptors.RateLimitStreamInterceptor(),
+			interceptors.LoggingStreamInterceptor(),
 		),
 	)
 	if err != nil && a.ctx.Err() == nil {
@@ -171,22 +210,35 @@ func (a *Application) StartCLI() {
 	defer a.wg.Done()
 	log.Println("CLI started")
 	mapper := climappers.NewDefaultFacadeMapper()
-	router := cli.NewRouter(a.container.facadeHandler, mapper)
-	router.Run(a.ctx, a.Shutdown)
+	facade := handlers.NewAuditFacadeHandler(a.container.facadeHandler, a.container.auditService, models.AuditSourceCLI)
+	router := cli.NewRouter(facade, mapper)
+	router.Run(operator.WithID(a.ctx, a.container.config.Undo.CLIOperator), a.Shutdown)
 	log.Println("CLI finished")
 }
 
-// StartAdminGRPCServer starts the admin gRPC server on the specified port with validation and recovery interceptors.
+// StartAdminGRPCServer starts the admin gRPC server on the specified port with recovery, call context, audit, auth and
+// validation interceptors.
 func (a *Application) StartAdminGRPCServer(port string) {
 	defer a.wg.Done()
-	router := gateway.NewAdminGRPCRouter(a.pool)
+	router := gateway.NewAdminGRPCRouter(
+		a.pool,
+		a.container.calendarService,
+		a.container.userDataService,
+		a.container.consistencyService,
+		a.container.auditService,
+		a.container.responseCache,
+	)
 	err := gateway.RunAdminGRPCServer(
 		a.ctx,
 		port,
 		router,
 		grpc.ChainUnaryInterceptor(
-			interceptors.ValidationInterceptor(),
 			interceptors.RecoveryInterceptor(),
+			interceptors.CorrelationIDInterceptor(),
+			interceptors.OperatorInterceptor(),
+			interceptors.AuditInterceptor(a.container.auditService, adminAuditedMethods...),
+			interceptors.AuthInterceptor(a.container.authenticator, adminMethodRoles),
+			interceptors.ValidationInterceptor(),
 		),
 	)
 	if err != nil && a.ctx.Err() == nil {
@@ -201,6 +253,30 @@ func (a *Application) StartOutboxDispatcher() {
 	}
 }
 
+// StartArchiveWorker periodically moves finished orders into the archive and purges expired archives.
+func (a *Application) StartArchiveWorker() {
+	defer a.wg.Done()
+	if err := a.container.archiveWorker.Run(a.ctx); err != nil && !errors.Is(err, context.Canceled) {
+		a.logger.Errorf("archive worker stopped: %v", err)
+	}
+}
+
+// StartIdempotencyPurge periodically deletes expired Idempotency-Key records.
+func (a *Application) StartIdempotencyPurge() {
+	defer a.wg.Done()
+	if err := a.container.idempotencyPurge.Run(a.ctx); err != nil && !errors.Is(err, context.Canceled) {
+		a.logger.Errorf("idempotency key purge stopped: %v", err)
+	}
+}
+
+// StartImportJobs processes background order imports until shutdown.
+func (a *Application) StartImportJobs() {
+	defer a.wg.Done()
+	if err := a.container.importJobService.Run(a.ctx); err != nil && !errors.Is(err, context.Canceled) {
+		a.logger.Errorf("import jobs stopped: %v", err)
+	}
+}
+
 func (a *Application) StartMetricsServer() {
 	defer a.wg.Done()
 	http.Handle("/metrics", promhttp.Handler())
@@ -0,0 +1,29 @@
+package app
+
+import (
+	adminpb "pvz-cli/internal/gen/admin"
+	pb "pvz-cli/internal/gen/orders"
+)
+
+// ordersAuditedMethods lists the orders calls that change state and are recorded in the audit log.
+var ordersAuditedMethods = []string{
+	pb.OrdersService_AcceptOrder_FullMethodName,
+	pb.OrdersService_ReturnOrder_FullMethodName,
+	pb.OrdersService_ProcessOrders_FullMethodName,
+	pb.OrdersService_IssueAllReady_FullMethodName,
+	pb.OrdersService_UndoLastOperation_FullMethodName,
+	pb.OrdersService_ImportOrders_FullMethodName,
+	pb.OrdersService_ImportOrdersStream_FullMethodName,
+	pb.OrdersService_SubmitImportJob_FullMethodName,
+	pb.OrdersService_CancelImportJob_FullMethodName,
+	pb.OrdersService_RegisterShipment_FullMethodName,
+	pb.OrdersService_HandOverShipment_FullMethodName,
+}
+
+// adminAuditedMethods lists the admin calls that change state; CheckConsistency is recorded since it may repair orders.
+var adminAuditedMethods = []string{
+	adminpb.AdminService_SetWorkerCount_FullMethodName,
+	adminpb.AdminService_SetPickupCalendar_FullMethodName,
+	adminpb.AdminService_AnonymizeUserData_FullMethodName,
+	adminpb.AdminService_CheckConsistency_FullMethodName,
+}
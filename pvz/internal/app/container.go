@@ -1,12 +1,15 @@
 package app
 
 import (
+	"context"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel"
 	"log/slog"
 	"os"
+	"pvz-cli/internal/common/auth"
 	"pvz-cli/internal/common/config"
 	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/common/cursor"
 	"pvz-cli/internal/data/repositories"
 	"pvz-cli/internal/data/storage"
 	"pvz-cli/internal/infrastructure/brokers"
@@ -27,24 +30,48 @@ import (
 
 // Container holds all shared business-level dependencies: configuration, repositories, services, and the facade handler.
 type Container struct {
-	config           *config.Config
-	orderService     services.OrderService
-	historyService   services.HistoryService
+	config          *config.Config
+	orderService    services.OrderService
+	historyService  services.HistoryService
+	calendarService services.CalendarService
+	userDataService services.UserDataService
+	// consistencyService is shared by the CLI fsck command and the admin RPC
+	consistencyService services.ConsistencyService
+	// auditService records mutating gRPC and CLI calls
+	auditService     services.AuditService
 	facadeHandler    handlers.FacadeHandler
 	outboxDispatcher *workers.DefaultOutboxDispatcher
+	archiveWorker    workers.ArchiveWorker
+	idempotencyPurge workers.IdempotencyPurgeWorker
+	importJobService services.ImportJobService
 	kafkaProducer    brokers.KafkaProducer
 	responseCache    cache.Cache[string, any]
+	idempotencyRepo  repositories.IdempotencyRepository
+	// authenticator is nil when authentication is disabled
+	authenticator auth.Authenticator
+	clock         clock.Clock
 }
 
 // NewContainer returns a new instance of an application container
 func NewContainer(pool workerpool.WorkerPool) *Container {
 	cfg := config.Load()
 	var (
-		orderRepo   repositories.OrderRepository
-		historyRepo repositories.HistoryRepository
-		txRunner    db.TxRunner
-		outboxRepo  repositories.OutboxRepository
-		producer    brokers.KafkaProducer
+		orderRepo    repositories.OrderRepository
+		historyRepo  repositories.HistoryRepository
+		calendarRepo repositories.CalendarRepository
+		shipmentRepo repositories.ShipmentRepository
+		idemRepo     repositories.IdempotencyRepository
+		archiveRepo  repositories.ArchiveRepository
+		importRepo   repositories.ImportJobRepository
+		userDataRepo repositories.UserDataRepository
+		checkRepo    repositories.ConsistencyRepository
+		auditRepo    repositories.AuditRepository
+		checkOutbox  bool
+		// atomicBatches is set for storages that roll back a failed batch
+		atomicBatches bool
+		txRunner      db.TxRunner
+		outboxRepo    repositories.OutboxRepository
+		producer      brokers.KafkaProducer
 	)
 
 	c := &Container{
@@ -61,10 +88,20 @@ func NewContainer(pool workerpool.WorkerPool) *Container {
 		}
 		client.SetConnectionSettings(20, 10, time.Hour, 30*time.Minute)
 		txRunner = db.NewTracingTxRunner(client, tracer)
+		atomicBatches = true
 		orderRepo = repositories.NewPGOrderRepository(client)
 		historyRepo = repositories.NewPGHistoryRepository(client)
+		calendarRepo = repositories.NewPGCalendarRepository(client)
+		shipmentRepo = repositories.NewPGShipmentRepository(client)
+		idemRepo = repositories.NewPGIdempotencyRepository(client)
+		archiveRepo = repositories.NewPGArchiveRepository(client)
+		importRepo = repositories.NewPGImportJobRepository(client)
+		userDataRepo = repositories.NewPGUserDataRepository(client)
+		auditRepo = repositories.NewPGAuditRepository(client)
+		checkRepo = repositories.NewPGConsistencyRepository(client)
 		if cfg.Outbox != nil && cfg.Outbox.BatchSize > 0 {
 			outboxRepo = repositories.NewPGOutboxRepository(client)
+			checkOutbox = true
 			producer, err = brokers.NewKafkaAsyncProducer(cfg.Kafka.Brokers)
 			if err != nil {
 				slog.Error("failed to init Kafka producer", "error", err)
@@ -90,6 +127,14 @@ func NewContainer(pool workerpool.WorkerPool) *Container {
 		fileStorage := storage.NewJSONStorage(cfg.File.Path)
 		orderRepo = repositories.NewSnapshotOrderRepository(fileStorage)
 		historyRepo = repositories.NewSnapshotHistoryRepository(fileStorage)
+		calendarRepo = repositories.NewSnapshotCalendarRepository(fileStorage)
+		shipmentRepo = repositories.NewSnapshotShipmentRepository(fileStorage)
+		idemRepo = repositories.NewSnapshotIdempotencyRepository(fileStorage)
+		archiveRepo = repositories.NewSnapshotArchiveRepository(fileStorage)
+		importRepo = repositories.NewSnapshotImportJobRepository(fileStorage)
+		userDataRepo = repositories.NewSnapshotUserDataRepository(fileStorage)
+		auditRepo = repositories.NewFileAuditRepository(cfg.File.AuditPath)
+		checkRepo = repositories.NewSnapshotConsistencyRepository(fileStorage)
 		outboxRepo = repositories.NewNoOpOutboxRepository()
 		txRunner = db.NewNoOpTxRunner()
 
@@ -99,17 +144,41 @@ func NewContainer(pool workerpool.WorkerPool) *Container {
 	}
 
 	clk := &clock.RealClock{}
+	c.idempotencyPurge = workers.NewDefaultIdempotencyPurgeWorker(idemRepo, clk, cfg.Idempotency.TTL, cfg.Idempotency.PurgeInterval)
 
-	orderValidator := validators.NewDefaultOrderValidator(clk)
+	calendarSvc := services.NewDefaultCalendarService(calendarRepo)
+	if cfg.Archive != nil && cfg.Archive.AfterDays > 0 {
+		c.archiveWorker = workers.NewDefaultArchiveWorker(
+			archiveRepo,
+			clk,
+			calendarSvc,
+			time.Duration(cfg.Archive.AfterDays)*24*time.Hour,
+			cfg.Archive.RetentionMonths,
+			cfg.Archive.BatchSize,
+			cfg.Archive.Interval,
+		)
+	}
+	if _, err := calendarSvc.GetCalendar(context.Background()); err != nil {
+		slog.Warn("failed to load pickup calendar, using always-open calendar", "error", err)
+	}
+
+	orderValidator := validators.NewDefaultOrderValidator(clk, calendarSvc)
+	shipmentValidator := validators.NewDefaultShipmentValidator(clk, calendarSvc)
 	packageValidator := validators.NewDefaultPackageValidator()
 	pricingStrategy := strategies.NewDefaultPricingStrategy()
+	storagePolicy := strategies.NewDefaultStoragePolicyStrategy(
+		cfg.StoragePolicy.DefaultDays,
+		cfg.StoragePolicy.PackageDays,
+		cfg.StoragePolicy.CategoryDays,
+	)
 
 	actorSvc := services.NewDefaultActorService()
 	baseHistorySvc := services.NewDefaultHistoryService(historyRepo)
 	historySvc := decorators.NewTracingHistoryService(baseHistorySvc, tracer)
 	pricingSvc := services.NewDefaultPackagePricingService(packageValidator, pricingStrategy)
-	baseOrderSvc := services.NewDefaultOrderService(clk, pool, txRunner, orderRepo, outboxRepo, pricingSvc, historySvc, actorSvc, orderValidator)
+	baseOrderSvc := services.NewDefaultOrderService(clk, pool, txRunner, orderRepo, outboxRepo, pricingSvc, historySvc, actorSvc, calendarSvc, storagePolicy, cfg.Undo.GracePeriod, atomicBatches, orderValidator)
 	orderSvc := decorators.NewTracingOrderService(baseOrderSvc, tracer)
+	shipmentSvc := services.NewDefaultShipmentService(clk, txRunner, shipmentRepo, outboxRepo, pricingSvc, shipmentValidator)
 	responsesCache := cache.NewInMemoryShardedCache[string, any](
 		constants.CacheShardsCount,
 		policies.NewTTLPolicy[string, any](),
@@ -123,12 +192,35 @@ func NewContainer(pool workerpool.WorkerPool) *Container {
 		os.Exit(1)
 	}
 
-	facadeHandler := handlers.NewDefaultFacadeHandler(orderSvc, historySvc, responsesCache, handlerMetrics)
+	exportSvc := services.NewDefaultExportService(clk, orderRepo, historyRepo)
+	importJobSvc := services.NewDefaultImportJobService(
+		clk,
+		importRepo,
+		orderSvc,
+		cfg.ImportJobs.Workers,
+		cfg.ImportJobs.QueueSize,
+		cfg.ImportJobs.ChunkSize,
+		atomicBatches,
+		handlers.InvalidateImportedOrders(responsesCache),
+		cfg.ImportJobs.InstanceID,
+	)
+	consistencySvc := services.NewDefaultConsistencyService(txRunner, checkRepo, orderRepo, outboxRepo, checkOutbox)
+	facadeHandler := handlers.NewDefaultFacadeHandler(orderSvc, historySvc, shipmentSvc, exportSvc, importJobSvc, consistencySvc, responsesCache, handlerMetrics, cursor.NewHMACCodec(cfg.Cursor.SigningKey))
 
 	c.orderService = orderSvc
 	c.historyService = historySvc
+	c.calendarService = calendarSvc
+	c.userDataService = services.NewDefaultUserDataService(clk, userDataRepo)
+	c.consistencyService = consistencySvc
+	c.auditService = services.NewDefaultAuditService(clk, auditRepo)
 	c.facadeHandler = facadeHandler
+	c.importJobService = importJobSvc
 	c.responseCache = responsesCache
+	c.idempotencyRepo = idemRepo
+	if !cfg.Auth.Disabled {
+		c.authenticator = auth.NewLocalAuthenticator(clk, cfg.Auth.APIKeys, cfg.Auth.JWTSecret)
+	}
+	c.clock = clk
 	return c
 }
 
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"pvz-cli/internal/common/auth"
+	adminpb "pvz-cli/internal/gen/admin"
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/grpc/interceptors"
+)
+
+// ordersMethodRoles grants day-to-day order handling to operators and bulk imports and exports to supervisors.
+var ordersMethodRoles = interceptors.MethodRoles{
+	pb.OrdersService_AcceptOrder_FullMethodName:           auth.RoleOperator,
+	pb.OrdersService_ReturnOrder_FullMethodName:           auth.RoleOperator,
+	pb.OrdersService_ProcessOrders_FullMethodName:         auth.RoleOperator,
+	pb.OrdersService_IssueAllReady_FullMethodName:         auth.RoleOperator,
+	pb.OrdersService_UndoLastOperation_FullMethodName:     auth.RoleOperator,
+	pb.OrdersService_GetOrder_FullMethodName:              auth.RoleOperator,
+	pb.OrdersService_ListOrders_FullMethodName:            auth.RoleOperator,
+	pb.OrdersService_ScrollOrders_FullMethodName:          auth.RoleOperator,
+	pb.OrdersService_SearchOrders_FullMethodName:          auth.RoleOperator,
+	pb.OrdersService_ListReturns_FullMethodName:           auth.RoleOperator,
+	pb.OrdersService_GetHistory_FullMethodName:            auth.RoleOperator,
+	pb.OrdersService_RegisterShipment_FullMethodName:      auth.RoleOperator,
+	pb.OrdersService_HandOverShipment_FullMethodName:      auth.RoleOperator,
+	pb.OrdersService_GetShipment_FullMethodName:           auth.RoleOperator,
+	pb.OrdersService_GetImportJob_FullMethodName:          auth.RoleOperator,
+	pb.OrdersService_WatchImportJob_FullMethodName:        auth.RoleOperator,
+	pb.OrdersService_ListImportJobFailures_FullMethodName: auth.RoleOperator,
+	pb.OrdersService_ImportOrders_FullMethodName:          auth.RoleSupervisor,
+	pb.OrdersService_ImportOrdersStream_FullMethodName:    auth.RoleSupervisor,
+	pb.OrdersService_SubmitImportJob_FullMethodName:       auth.RoleSupervisor,
+	pb.OrdersService_CancelImportJob_FullMethodName:       auth.RoleSupervisor,
+	pb.OrdersService_ExportOrders_FullMethodName:          auth.RoleSupervisor,
+}
+
+// adminMethodRoles lets supervisors look at the pool and run the calendar, and keeps everything else to admins.
+var adminMethodRoles = interceptors.MethodRoles{
+	adminpb.AdminService_GetWorkerStats_FullMethodName:    auth.RoleSupervisor,
+	adminpb.AdminService_GetPickupCalendar_FullMethodName: auth.RoleSupervisor,
+	adminpb.AdminService_SetPickupCalendar_FullMethodName: auth.RoleSupervisor,
+	adminpb.AdminService_SetWorkerCount_FullMethodName:    auth.RoleAdmin,
+	adminpb.AdminService_ExportUserData_FullMethodName:    auth.RoleAdmin,
+	adminpb.AdminService_AnonymizeUserData_FullMethodName: auth.RoleAdmin,
+	adminpb.AdminService_CheckConsistency_FullMethodName:  auth.RoleAdmin,
+	adminpb.AdminService_ListAuditLog_FullMethodName:      auth.RoleAdmin,
+}
@@ -17,7 +17,7 @@ var AllCommands = []CommandInfo{
 	{
 		Name:        "accept-order",
 		Description: "Принять заказ от курьера.",
-		Usage:       "accept-order --order-id <id> --user-id <id> --expires <yyyy-mm-dd> --weight <float> --price <float> [--package <bag|box|film|bag+film|box+film>]",
+		Usage:       "accept-order --order-id <id> --user-id <id> [--expires <yyyy-mm-dd>] --weight <float> --price <float> [--package <bag|box|film|bag+film|box+film>] [--fragile] [--hazardous] [--age-restricted]",
 	},
 	{
 		Name:        "return-order",
@@ -27,12 +27,37 @@ var AllCommands = []CommandInfo{
 	{
 		Name:        "process-orders",
 		Description: "Выдать заказы или принять возврат клиента.",
-		Usage:       "process-orders --user-id <id> --action <issue|return> --order-ids <id1,id2,...>",
+		Usage:       "process-orders --user-id <id> --action <issue|return> --order-ids <id1,id2,...> [--identity-verified] [--atomic]",
+	},
+	{
+		Name:        "issue-ready",
+		Description: "Выдать клиенту все готовые заказы и показать сводный чек.",
+		Usage:       "issue-ready --user-id <id> [--identity-verified]",
+	},
+	{
+		Name:        "undo",
+		Description: "Отменить последнюю операцию с заказом (только тем же оператором в течение льготного периода).",
+		Usage:       "undo --order-id <id>",
 	},
 	{
 		Name:        "list-orders",
 		Description: "Получить список заказов.",
-		Usage:       "list-orders --user-id <id> [--in-pvz] [--last-id <id>] [--last <N>] [--page <N> --limit <M>]",
+		Usage:       "list-orders --user-id <id> [--in-pvz] [--page-token <token>] [--last <N>] [--page <N> --limit <M>]",
+	},
+	{
+		Name:        "search-orders",
+		Description: "Найти заказы по фильтрам с сортировкой.",
+		Usage:       "search-orders [--user-id <id>] [--in-pvz] [--statuses <accepted,returned,issued>] [--packages <bag,box,...>] [--created-from <yyyy-mm-dd>] [--created-to <yyyy-mm-dd>] [--expires-from <yyyy-mm-dd>] [--expires-to <yyyy-mm-dd>] [--updated-from <yyyy-mm-dd>] [--updated-to <yyyy-mm-dd>] [--price-min <float>] [--price-max <float>] [--weight-min <float>] [--weight-max <float>] [--expiring-within <duration>] [--sort <created_at|expires_at|updated_status_at|price|weight>] [--desc] [--page <N> --limit <M>] [--page-token <token>]",
+	},
+	{
+		Name:        "get-order",
+		Description: "Показать заказ с датами, актуальными сроками и последними событиями истории.",
+		Usage:       "get-order --order-id <id>",
+	},
+	{
+		Name:        "export",
+		Description: "Выгрузить заказы, возвраты или историю в CSV или NDJSON; без --output данные пишутся в stdout.",
+		Usage:       "export --dataset <orders|returns|history> --format <csv|ndjson> [--output <file>] [--order-id <id>] [фильтры search-orders без --page, --limit и --page-token]",
 	},
 	{
 		Name:        "list-returns",
@@ -41,17 +66,52 @@ var AllCommands = []CommandInfo{
 	},
 	{
 		Name:        "order-history",
-		Description: "Получить историю заказов.",
-		Usage:       "order-history",
+		Description: "Получить историю заказов с фильтрами; по умолчанию новые события первыми.",
+		Usage:       "order-history [--order-id <id>] [--user-id <id>] [--events <accepted,issued,returned_by_client,returned_to_warehouse,undone>] [--from <yyyy-mm-dd|RFC3339>] [--to <yyyy-mm-dd|RFC3339>] [--actor-type <courier|client|operator> [--actor-id <id>]] [--asc] [--limit <M>] [--page-token <token>]",
 	},
 	{
 		Name:        "import-orders",
-		Description: "Импорт заказов из JSON-файла.",
-		Usage:       "import-orders --file <path>",
+		Description: "Импорт заказов из файла JSON, NDJSON или CSV; файл читается потоково и отправляется частями.",
+		Usage:       "import-orders --file <path> [--format <json|ndjson|csv>] [--columns <field=column,...>] [--chunk-size <N>] [--atomic] [--dry-run] [--async]",
+	},
+	{
+		Name:        "import-status",
+		Description: "Показать статус и прогресс фонового импорта; с --watch выводить каждое обновление до завершения.",
+		Usage:       "import-status --job-id <id> [--watch]",
+	},
+	{
+		Name:        "import-failures",
+		Description: "Показать заказы, которые фоновый импорт не смог импортировать.",
+		Usage:       "import-failures --job-id <id>",
+	},
+	{
+		Name:        "import-cancel",
+		Description: "Отменить фоновый импорт; уже импортированные заказы остаются.",
+		Usage:       "import-cancel --job-id <id>",
 	},
 	{
 		Name:        "scroll-orders",
 		Description: "Получить список заказов по принципу бесконечной прокрутки.",
-		Usage:       "scroll-orders --user-id <id> [--limit <N>]",
+		Usage:       "scroll-orders --user-id <id> [--limit <N>] [--page-token <token>]",
+	},
+	{
+		Name:        "register-shipment",
+		Description: "Принять посылку от отправителя для отправки в другой ПВЗ.",
+		Usage:       "register-shipment --sender-id <id> --destination <point-id> --weight <float> --price <float> [--package <bag|box|film|bag+film|box+film>] [--fragile] [--hazardous] [--age-restricted]",
+	},
+	{
+		Name:        "handover-shipment",
+		Description: "Передать отправление курьеру.",
+		Usage:       "handover-shipment --shipment-id <id> --courier-id <id>",
+	},
+	{
+		Name:        "shipment-status",
+		Description: "Показать статус и историю отправления.",
+		Usage:       "shipment-status --shipment-id <id>",
+	},
+	{
+		Name:        "fsck",
+		Description: "Проверить заказы по истории: статус, наличие ACCEPTED, допустимость переходов и события outbox; с --repair исправить статусы и недостающие события.",
+		Usage:       "fsck [--repair]",
 	},
 }
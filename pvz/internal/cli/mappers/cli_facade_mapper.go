@@ -16,11 +16,20 @@ type CLIFacadeMapper interface {
 	// MapListOrdersParams maps list-orders CLI parameters to a filtering request.
 	MapListOrdersParams(params.ListOrdersParams) (requests.OrdersFilterRequest, error)
 
+	// MapSearchOrdersParams maps search-orders CLI parameters to a filtering request.
+	MapSearchOrdersParams(params.SearchOrdersParams) (requests.OrdersFilterRequest, error)
+
+	// MapExportParams maps export CLI parameters to an export request.
+	MapExportParams(params.ExportParams) (requests.ExportRequest, error)
+
 	// MapProcessOrdersParams maps process-orders CLI parameters to a process request.
 	MapProcessOrdersParams(params.ProcessOrdersParams) (requests.ProcessOrdersRequest, error)
 
-	// MapImportOrdersParams maps import-orders CLI parameters to a batch request.
-	MapImportOrdersParams(params.ImportOrdersParams) (requests.ImportOrdersRequest, error)
+	// MapIssueReadyParams maps issue-ready CLI parameters to a grouped pickup request.
+	MapIssueReadyParams(params.IssueReadyParams) (requests.IssueAllReadyRequest, error)
+
+	// MapImportOrdersParams opens the import file of import-orders and returns its orders in chunks.
+	MapImportOrdersParams(params.ImportOrdersParams) (*ImportBatches, error)
 
 	// MapListReturnsParams maps list-returns CLI parameters to a returns request.
 	MapListReturnsParams(params.ListReturnsParams) (requests.OrdersFilterRequest, error)
@@ -28,6 +37,27 @@ type CLIFacadeMapper interface {
 	// MapReturnOrderParams maps return-order CLI parameters to a return request.
 	MapReturnOrderParams(params.ReturnOrderParams) (requests.ReturnOrderRequest, error)
 
+	// MapGetOrderParams maps get-order CLI parameters to an order ID.
+	MapGetOrderParams(params.GetOrderParams) (uint64, error)
+
+	// MapUndoParams maps undo CLI parameters to an undo request.
+	MapUndoParams(params.UndoParams) (requests.UndoRequest, error)
+
+	// MapRegisterShipmentParams maps register-shipment CLI parameters to a drop-off request.
+	MapRegisterShipmentParams(params.RegisterShipmentParams) (requests.RegisterShipmentRequest, error)
+
+	// MapHandOverShipmentParams maps handover-shipment CLI parameters to a hand-over request.
+	MapHandOverShipmentParams(params.HandOverShipmentParams) (requests.HandOverShipmentRequest, error)
+
+	// MapShipmentStatusParams maps shipment-status CLI parameters to a shipment ID.
+	MapShipmentStatusParams(params.ShipmentStatusParams) (uint64, error)
+
+	// MapImportJobParams maps import-status, import-failures and import-cancel CLI parameters to a job ID.
+	MapImportJobParams(params.ImportJobParams) (uint64, error)
+
 	// MapOrderHistoryParams maps list-orders CLI parameters to a filtering request.
 	MapOrderHistoryParams(params.OrderHistoryParams) (requests.OrderHistoryFilter, error)
+
+	// MapFsckParams maps fsck CLI parameters to a consistency check request.
+	MapFsckParams(params.FsckParams) requests.ConsistencyCheckRequest
 }
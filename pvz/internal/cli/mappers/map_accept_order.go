@@ -24,9 +24,12 @@ func (f *DefaultCLIFacadeMapper) MapAcceptOrderParams(p params.AcceptOrderParams
 		return requests.AcceptOrderRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid user_id format")
 	}
 
-	expiresAt, err := time.Parse(constants.TimeLayout, strings.TrimSpace(p.ExpiresAt))
-	if err != nil {
-		return requests.AcceptOrderRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid expires_at format")
+	var expiresAt time.Time
+	if raw := strings.TrimSpace(p.ExpiresAt); raw != "" {
+		expiresAt, err = time.Parse(constants.TimeLayout, raw)
+		if err != nil {
+			return requests.AcceptOrderRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid expires_at format")
+		}
 	}
 
 	weight, err := parseFloat("weight", p.Weight, constants.WeightFractionDigit)
@@ -51,6 +54,11 @@ func (f *DefaultCLIFacadeMapper) MapAcceptOrderParams(p params.AcceptOrderParams
 		Weight:    weight,
 		Price:     price,
 		Package:   pkg,
+		Handling: models.HandlingFlags{
+			Fragile:       p.Fragile,
+			Hazardous:     p.Hazardous,
+			AgeRestricted: p.AgeRestricted,
+		},
 	}, nil
 }
 
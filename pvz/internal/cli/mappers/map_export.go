@@ -0,0 +1,39 @@
+package mappers
+
+import (
+	"pvz-cli/internal/cli/params"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/tabular"
+	"pvz-cli/internal/usecases/requests"
+	"strconv"
+	"strings"
+)
+
+// MapExportParams converts CLI params for export command into ExportRequest
+func (f *DefaultCLIFacadeMapper) MapExportParams(p params.ExportParams) (requests.ExportRequest, error) {
+	dataset, err := requests.ParseExportDataset(p.Dataset)
+	if err != nil {
+		return requests.ExportRequest{}, apperrors.Newf(apperrors.ValidationFailed, "%v", err)
+	}
+	format, err := tabular.ParseFormat(p.Format)
+	if err != nil {
+		return requests.ExportRequest{}, apperrors.Newf(apperrors.ValidationFailed, "%v", err)
+	}
+	filter, err := f.MapSearchOrdersParams(p.Filter)
+	if err != nil {
+		return requests.ExportRequest{}, err
+	}
+
+	req := requests.ExportRequest{Dataset: dataset, Format: format, Orders: filter}
+	if raw := strings.TrimSpace(p.OrderID); raw != "" {
+		if dataset != requests.ExportHistory {
+			return requests.ExportRequest{}, apperrors.Newf(apperrors.ValidationFailed, "order-id is only supported for the history dataset")
+		}
+		orderID, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return requests.ExportRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid order_id format")
+		}
+		req.History.OrderID = &orderID
+	}
+	return req, nil
+}
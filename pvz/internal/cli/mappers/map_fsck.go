@@ -0,0 +1,11 @@
+package mappers
+
+import (
+	"pvz-cli/internal/cli/params"
+	"pvz-cli/internal/usecases/requests"
+)
+
+// MapFsckParams converts CLI params for fsck command into internal request model
+func (f *DefaultCLIFacadeMapper) MapFsckParams(p params.FsckParams) requests.ConsistencyCheckRequest {
+	return requests.ConsistencyCheckRequest{Repair: p.Repair}
+}
@@ -0,0 +1,17 @@
+package mappers
+
+import (
+	"pvz-cli/internal/cli/params"
+	"pvz-cli/internal/common/apperrors"
+	"strconv"
+	"strings"
+)
+
+// MapGetOrderParams converts CLI params for get-order command into an order ID
+func (f *DefaultCLIFacadeMapper) MapGetOrderParams(p params.GetOrderParams) (uint64, error) {
+	orderID, err := strconv.ParseUint(strings.TrimSpace(p.OrderID), 10, 64)
+	if err != nil {
+		return 0, apperrors.Newf(apperrors.ValidationFailed, "invalid order_id format")
+	}
+	return orderID, nil
+}
@@ -0,0 +1,17 @@
+package mappers
+
+import (
+	"pvz-cli/internal/cli/params"
+	"pvz-cli/internal/common/apperrors"
+	"strconv"
+	"strings"
+)
+
+// MapImportJobParams converts CLI params of the import job commands into a job ID
+func (f *DefaultCLIFacadeMapper) MapImportJobParams(p params.ImportJobParams) (uint64, error) {
+	id, err := strconv.ParseUint(strings.TrimSpace(p.JobID), 10, 64)
+	if err != nil || id == 0 {
+		return 0, apperrors.Newf(apperrors.ValidationFailed, "invalid job_id format")
+	}
+	return id, nil
+}
@@ -1,36 +1,140 @@
 package mappers
 
 import (
+	"errors"
+	"io"
 	"pvz-cli/internal/cli/params"
 	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
 	"pvz-cli/internal/common/utils"
 	"pvz-cli/internal/usecases/requests"
+	"strconv"
+	"strings"
 )
 
-// MapImportOrdersParams parses and maps file data into ImportOrdersRequest
-func (f *DefaultCLIFacadeMapper) MapImportOrdersParams(p params.ImportOrdersParams) (requests.ImportOrdersRequest, error) {
+// ImportBatches reads an order file and hands it out as ImportOrdersRequest chunks of bounded size,
+// so that only one chunk of the file is held in memory at a time.
+type ImportBatches struct {
+	mapper       *DefaultCLIFacadeMapper
+	file         *utils.OrderFileReader
+	chunkSize    int
+	atomic       bool
+	validateOnly bool
+	// async submits the whole file as a single background job
+	async bool
+	done  bool
+	// firstSeen remembers the item number of every order ID, so that a repeated ID is caught in any chunk
+	firstSeen map[uint64]int
+}
+
+// MapImportOrdersParams opens the import file and returns its orders chunk by chunk.
+// An atomic import must commit the whole file at once, and an async one is submitted as a single job,
+// so both are returned as a single chunk. With DryRun set, every chunk is a validate-only request.
+func (f *DefaultCLIFacadeMapper) MapImportOrdersParams(p params.ImportOrdersParams) (*ImportBatches, error) {
 	if p.File == "" {
-		return requests.ImportOrdersRequest{}, apperrors.Newf(apperrors.ValidationFailed, "file path must not be empty")
+		return nil, apperrors.Newf(apperrors.ValidationFailed, "file path must not be empty")
+	}
+	if p.Async && p.DryRun {
+		return nil, apperrors.Newf(apperrors.ValidationFailed, "dry-run cannot be combined with async")
+	}
+	chunkSize := constants.DefaultImportChunkSize
+	if p.ChunkSize != nil {
+		if *p.ChunkSize <= 0 {
+			return nil, apperrors.Newf(apperrors.ValidationFailed, "chunk-size must be positive")
+		}
+		chunkSize = *p.ChunkSize
+	}
+	format, err := utils.DetectOrderFileFormat(p.File, p.Format)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := utils.ParseCSVColumns(p.Columns)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) > 0 && format != utils.OrderFileCSV {
+		return nil, apperrors.Newf(apperrors.ValidationFailed, "columns mapping is only supported for CSV files")
 	}
 
-	rawOrders, err := utils.ParseOrdersFromFile(p.File)
+	file, err := utils.OpenOrderFile(p.File, format, columns)
 	if err != nil {
-		return requests.ImportOrdersRequest{}, err
+		return nil, err
 	}
+	return &ImportBatches{
+		mapper:       f,
+		file:         file,
+		chunkSize:    chunkSize,
+		atomic:       p.Atomic,
+		validateOnly: p.DryRun,
+		async:        p.Async,
+		firstSeen:    make(map[uint64]int),
+	}, nil
+}
 
+// Next returns the next chunk of the file, or io.EOF when the file has been read completely.
+// Malformed orders are returned as statuses with an error, like in the original JSON import.
+func (b *ImportBatches) Next() (requests.ImportOrdersRequest, error) {
+	if b.done {
+		return requests.ImportOrdersRequest{}, io.EOF
+	}
 	var statuses []requests.ImportOrderStatus
-	for i, raw := range rawOrders {
-		itemNumber := i + 1
-		status := requests.ImportOrderStatus{ItemNumber: itemNumber}
-		acceptRequest, err := f.MapAcceptOrderParams(raw)
+	// a dry run stores nothing, so it is chunked even for an atomic import
+	wholeFile := (b.atomic || b.async) && !b.validateOnly
+	for wholeFile || len(statuses) < b.chunkSize {
+		rec, err := b.file.Next()
+		if errors.Is(err, io.EOF) {
+			b.done = true
+			break
+		}
 		if err != nil {
-			status.Error = apperrors.Newf(apperrors.InvalidBatchEntry, "order #%d: %v", itemNumber, err)
-		} else {
-			status.Request = &acceptRequest
+			return requests.ImportOrdersRequest{}, err
 		}
-		statuses = append(statuses, status)
+		statuses = append(statuses, b.checkRepeated(b.mapper.mapImportRecord(rec)))
+	}
+	if len(statuses) == 0 {
+		return requests.ImportOrdersRequest{}, io.EOF
 	}
 	return requests.ImportOrdersRequest{
-		Statuses: statuses,
+		Statuses:     statuses,
+		Atomic:       b.atomic,
+		ValidateOnly: b.validateOnly,
 	}, nil
 }
+
+// checkRepeated fails an order whose ID already appeared earlier in the file
+func (b *ImportBatches) checkRepeated(st requests.ImportOrderStatus) requests.ImportOrderStatus {
+	if st.Error != nil {
+		return st
+	}
+	if first, ok := b.firstSeen[st.OrderID]; ok {
+		st.Request = nil
+		st.Error = apperrors.Newf(apperrors.InvalidBatchEntry, "order #%d: order %d is already listed as order #%d", st.ItemNumber, st.OrderID, first)
+		return st
+	}
+	b.firstSeen[st.OrderID] = st.ItemNumber
+	return st
+}
+
+// Close closes the import file
+func (b *ImportBatches) Close() error {
+	return b.file.Close()
+}
+
+func (f *DefaultCLIFacadeMapper) mapImportRecord(rec utils.OrderRecord) requests.ImportOrderStatus {
+	status := requests.ImportOrderStatus{ItemNumber: rec.Number}
+	if rec.Err != nil {
+		status.Error = apperrors.Newf(apperrors.InvalidBatchEntry, "order #%d: %v", rec.Number, rec.Err)
+		return status
+	}
+	// the ID is kept even for an invalid order so that its failure can be reported against it
+	if id, err := strconv.ParseUint(strings.TrimSpace(rec.Params.OrderID), 10, 64); err == nil {
+		status.OrderID = id
+	}
+	acceptRequest, err := f.MapAcceptOrderParams(rec.Params)
+	if err != nil {
+		status.Error = apperrors.Newf(apperrors.InvalidBatchEntry, "order #%d: %v", rec.Number, err)
+	} else {
+		status.Request = &acceptRequest
+	}
+	return status
+}
@@ -0,0 +1,21 @@
+package mappers
+
+import (
+	"pvz-cli/internal/cli/params"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/usecases/requests"
+	"strconv"
+	"strings"
+)
+
+// MapIssueReadyParams converts CLI params for issue-ready command into internal request model
+func (f *DefaultCLIFacadeMapper) MapIssueReadyParams(p params.IssueReadyParams) (requests.IssueAllReadyRequest, error) {
+	userID, err := strconv.ParseUint(strings.TrimSpace(p.UserID), 10, 64)
+	if err != nil {
+		return requests.IssueAllReadyRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid user_id format")
+	}
+	return requests.IssueAllReadyRequest{
+		UserID:           userID,
+		IdentityVerified: p.IdentityVerified,
+	}, nil
+}
@@ -26,27 +26,13 @@ func (f *DefaultCLIFacadeMapper) MapListOrdersParams(p params.ListOrdersParams)
 		return requests.OrdersFilterRequest{}, err
 	}
 
-	var lastIDVal uint64
-	var lastID *uint64
-	if raw := strings.TrimSpace(p.LastID); raw != "" {
-		id, err := strconv.ParseUint(raw, 10, 64)
-		if err != nil {
-			return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid last_id format")
-		}
-		if id == 0 {
-			return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "last_id must be > 0")
-		}
-		lastIDVal = id
-		lastID = &lastIDVal
-	}
-
 	var opts []requests.FilterOption
 	opts = append(opts, requests.WithUserID(userID))
 	if p.InPvz != nil {
 		opts = append(opts, requests.WithInPvz(*p.InPvz))
 	}
-	if lastID != nil {
-		opts = append(opts, requests.WithLastID(*lastID))
+	if token := strings.TrimSpace(p.PageToken); token != "" {
+		opts = append(opts, requests.WithPageToken(token))
 	}
 	if p.Page != nil {
 		opts = append(opts, requests.WithPage(*p.Page))
@@ -15,6 +15,7 @@ func (f *DefaultCLIFacadeMapper) MapListReturnsParams(p params.ListReturnsParams
 	status := models.Returned
 	var opts []requests.FilterOption
 	opts = append(opts, requests.WithStatus(status))
+	opts = append(opts, requests.WithSort(requests.SortByUpdatedStatusAt, false))
 
 	if p.Page != nil {
 		opts = append(opts, requests.WithPage(*p.Page))
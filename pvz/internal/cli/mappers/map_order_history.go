@@ -2,8 +2,13 @@ package mappers
 
 import (
 	"pvz-cli/internal/cli/params"
+	"pvz-cli/internal/common/apperrors"
 	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // MapOrderHistoryParams converts CLI params for order-history command into internal request model
@@ -12,8 +17,10 @@ func (f *DefaultCLIFacadeMapper) MapOrderHistoryParams(p params.OrderHistoryPara
 		return requests.OrderHistoryFilter{}, err
 	}
 	req := requests.OrderHistoryFilter{
-		Page:  constants.DefaultHistoryPage,
-		Limit: constants.DefaultHistoryLimit,
+		Page:      constants.DefaultHistoryPage,
+		Limit:     constants.DefaultHistoryLimit,
+		Asc:       p.Asc,
+		PageToken: strings.TrimSpace(p.PageToken),
 	}
 	if p.Page != nil {
 		req.Page = *(p.Page)
@@ -22,5 +29,98 @@ func (f *DefaultCLIFacadeMapper) MapOrderHistoryParams(p params.OrderHistoryPara
 		req.Limit = *(p.Limit)
 	}
 
+	ids := []struct {
+		name string
+		raw  string
+		dst  **uint64
+	}{
+		{"order_id", p.OrderID, &req.OrderID},
+		{"user_id", p.UserID, &req.UserID},
+		{"actor_id", p.ActorID, &req.ActorID},
+	}
+	for _, id := range ids {
+		raw := strings.TrimSpace(id.raw)
+		if raw == "" {
+			continue
+		}
+		val, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return requests.OrderHistoryFilter{}, apperrors.Newf(apperrors.ValidationFailed, "invalid %s format", id.name)
+		}
+		*id.dst = &val
+	}
+
+	var err error
+	if req.Events, err = parseEventTypes(p.Events); err != nil {
+		return requests.OrderHistoryFilter{}, err
+	}
+	if raw := strings.TrimSpace(p.ActorType); raw != "" {
+		actorType, err := parseActorType(raw)
+		if err != nil {
+			return requests.OrderHistoryFilter{}, err
+		}
+		req.ActorType = &actorType
+	}
+	if req.ActorID != nil && req.ActorType == nil {
+		return requests.OrderHistoryFilter{}, apperrors.Newf(apperrors.ValidationFailed, "actor-id requires actor-type")
+	}
+
+	dates := []struct {
+		name string
+		raw  string
+		dst  **time.Time
+	}{
+		{"from", p.From, &req.From},
+		{"to", p.To, &req.To},
+	}
+	for _, d := range dates {
+		raw := strings.TrimSpace(d.raw)
+		if raw == "" {
+			continue
+		}
+		t, err := parseHistoryTime(raw)
+		if err != nil {
+			return requests.OrderHistoryFilter{}, apperrors.Newf(apperrors.ValidationFailed, "invalid %s format", d.name)
+		}
+		*d.dst = &t
+	}
 	return req, nil
 }
+
+// parseHistoryTime accepts a date or an RFC 3339 time, since history entries are more precise than a day
+func parseHistoryTime(raw string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	return time.Parse(constants.TimeLayout, raw)
+}
+
+func parseEventTypes(raw string) ([]models.EventType, error) {
+	var events []models.EventType
+	for _, item := range splitList(raw) {
+		switch strings.ToLower(item) {
+		case "accepted":
+			events = append(events, models.EventAccepted)
+		case "issued":
+			events = append(events, models.EventIssued)
+		case "returned_by_client":
+			events = append(events, models.EventReturnedByClient)
+		case "returned_to_warehouse":
+			events = append(events, models.EventReturnedToWarehouse)
+		case "undone":
+			events = append(events, models.EventUndone)
+		default:
+			return nil, apperrors.Newf(apperrors.ValidationFailed, "invalid event: %s", item)
+		}
+	}
+	return events, nil
+}
+
+func parseActorType(raw string) (models.ActorType, error) {
+	switch actorType := models.ActorType(strings.ToLower(raw)); actorType {
+	case models.ActorCourier, models.ActorClient, models.ActorOperator:
+		return actorType, nil
+	default:
+		return "", apperrors.Newf(apperrors.ValidationFailed, "invalid actor-type: %s", raw)
+	}
+}
@@ -37,9 +37,11 @@ func (f *DefaultCLIFacadeMapper) MapProcessOrdersParams(p params.ProcessOrdersPa
 	switch action {
 	case string(requests.ActionIssue), string(requests.ActionReturn):
 		return requests.ProcessOrdersRequest{
-			UserID:   userID,
-			OrderIDs: parsedIDs,
-			Action:   requests.ProcessAction(action),
+			UserID:           userID,
+			OrderIDs:         parsedIDs,
+			Action:           requests.ProcessAction(action),
+			IdentityVerified: p.IdentityVerified,
+			Atomic:           p.Atomic,
 		}, nil
 	default:
 		return requests.ProcessOrdersRequest{}, apperrors.Newf(apperrors.ValidationFailed, "unknown action %q", action)
@@ -20,18 +20,9 @@ func (f *DefaultCLIFacadeMapper) MapScrollOrdersParams(p params.ScrollOrdersPara
 		return requests.OrdersFilterRequest{}, err
 	}
 
-	var lastID *uint64
-	if strings.TrimSpace(p.LastID) != "" {
-		id, err := strconv.ParseUint(strings.TrimSpace(p.LastID), 10, 64)
-		if err != nil {
-			return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid last_id format")
-		}
-		lastID = &id
-	}
-
 	return requests.OrdersFilterRequest{
-		UserID: &userID,
-		Limit:  p.Limit,
-		LastID: lastID,
+		UserID:    &userID,
+		Limit:     p.Limit,
+		PageToken: strings.TrimSpace(p.PageToken),
 	}, nil
 }
@@ -0,0 +1,148 @@
+package mappers
+
+import (
+	"pvz-cli/internal/cli/params"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MapSearchOrdersParams converts CLI params for search-orders command into OrdersFilterRequest.
+func (f *DefaultCLIFacadeMapper) MapSearchOrdersParams(p params.SearchOrdersParams) (requests.OrdersFilterRequest, error) {
+	if err := validatePaginationInfo(p.Page, p.Limit); err != nil {
+		return requests.OrdersFilterRequest{}, err
+	}
+	sortBy, ok := requests.ParseOrderSortField(strings.TrimSpace(p.SortBy))
+	if !ok {
+		return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid sort field: %s", p.SortBy)
+	}
+
+	opts := []requests.FilterOption{requests.WithSort(sortBy, p.SortDesc)}
+	if raw := strings.TrimSpace(p.UserID); raw != "" {
+		userID, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid user_id format")
+		}
+		opts = append(opts, requests.WithUserID(userID))
+	}
+	if p.InPvz != nil {
+		opts = append(opts, requests.WithInPvz(*p.InPvz))
+	}
+	if p.Page != nil {
+		opts = append(opts, requests.WithPage(*p.Page))
+	}
+	if p.Limit != nil {
+		opts = append(opts, requests.WithLimit(*p.Limit))
+	}
+	if token := strings.TrimSpace(p.PageToken); token != "" {
+		opts = append(opts, requests.WithPageToken(token))
+	}
+	filter := requests.NewOrdersFilter(opts...)
+
+	var err error
+	if filter.Statuses, err = parseStatuses(p.Statuses); err != nil {
+		return requests.OrdersFilterRequest{}, err
+	}
+	if filter.Packages, err = parsePackageTypes(p.Packages); err != nil {
+		return requests.OrdersFilterRequest{}, err
+	}
+
+	dates := []struct {
+		name string
+		raw  string
+		dst  **time.Time
+	}{
+		{"created-from", p.CreatedFrom, &filter.CreatedFrom},
+		{"created-to", p.CreatedTo, &filter.CreatedTo},
+		{"expires-from", p.ExpiresFrom, &filter.ExpiresFrom},
+		{"expires-to", p.ExpiresTo, &filter.ExpiresTo},
+		{"updated-from", p.UpdatedFrom, &filter.UpdatedFrom},
+		{"updated-to", p.UpdatedTo, &filter.UpdatedTo},
+	}
+	for _, d := range dates {
+		raw := strings.TrimSpace(d.raw)
+		if raw == "" {
+			continue
+		}
+		t, err := time.Parse(constants.TimeLayout, raw)
+		if err != nil {
+			return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid %s format", d.name)
+		}
+		*d.dst = &t
+	}
+
+	bounds := []struct {
+		name string
+		raw  string
+		dst  **float32
+	}{
+		{"price-min", p.PriceMin, &filter.PriceMin},
+		{"price-max", p.PriceMax, &filter.PriceMax},
+		{"weight-min", p.WeightMin, &filter.WeightMin},
+		{"weight-max", p.WeightMax, &filter.WeightMax},
+	}
+	for _, b := range bounds {
+		raw := strings.TrimSpace(b.raw)
+		if raw == "" {
+			continue
+		}
+		val, err := strconv.ParseFloat(raw, 32)
+		if err != nil || val < 0 {
+			return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid %s format", b.name)
+		}
+		*b.dst = utils.Ptr(float32(val))
+	}
+
+	if raw := strings.TrimSpace(p.ExpiringWithin); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 {
+			return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid expiring-within format")
+		}
+		filter.ExpiringWithin = &d
+	}
+	return filter, nil
+}
+
+func parseStatuses(raw string) ([]models.OrderStatus, error) {
+	var statuses []models.OrderStatus
+	for _, item := range splitList(raw) {
+		switch strings.ToLower(item) {
+		case "accepted":
+			statuses = append(statuses, models.Accepted)
+		case "returned":
+			statuses = append(statuses, models.Returned)
+		case "issued":
+			statuses = append(statuses, models.Issued)
+		default:
+			return nil, apperrors.Newf(apperrors.ValidationFailed, "invalid status: %s", item)
+		}
+	}
+	return statuses, nil
+}
+
+func parsePackageTypes(raw string) ([]models.PackageType, error) {
+	var packages []models.PackageType
+	for _, item := range splitList(raw) {
+		pkg, err := parsePackageType(item)
+		if err != nil {
+			return nil, err
+		}
+		packages = append(packages, pkg)
+	}
+	return packages, nil
+}
+
+func splitList(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
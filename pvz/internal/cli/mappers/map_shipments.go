@@ -0,0 +1,81 @@
+package mappers
+
+import (
+	"pvz-cli/internal/cli/params"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"strconv"
+	"strings"
+)
+
+// MapRegisterShipmentParams converts CLI params for register-shipment command into internal request model
+func (f *DefaultCLIFacadeMapper) MapRegisterShipmentParams(p params.RegisterShipmentParams) (requests.RegisterShipmentRequest, error) {
+	senderID, err := strconv.ParseUint(strings.TrimSpace(p.SenderID), 10, 64)
+	if err != nil {
+		return requests.RegisterShipmentRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid sender_id format")
+	}
+
+	destination, err := strconv.ParseUint(strings.TrimSpace(p.Destination), 10, 64)
+	if err != nil {
+		return requests.RegisterShipmentRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid destination_point_id format")
+	}
+
+	weight, err := parseFloat("weight", p.Weight, constants.WeightFractionDigit)
+	if err != nil {
+		return requests.RegisterShipmentRequest{}, err
+	}
+
+	price, err := parseFloat("price", p.Price, constants.PriceFractionDigit)
+	if err != nil {
+		return requests.RegisterShipmentRequest{}, err
+	}
+
+	pkg, err := parsePackageType(p.Package)
+	if err != nil {
+		return requests.RegisterShipmentRequest{}, err
+	}
+
+	return requests.RegisterShipmentRequest{
+		SenderID:           senderID,
+		DestinationPointID: destination,
+		Weight:             weight,
+		Price:              price,
+		Package:            pkg,
+		Handling: models.HandlingFlags{
+			Fragile:       p.Fragile,
+			Hazardous:     p.Hazardous,
+			AgeRestricted: p.AgeRestricted,
+		},
+	}, nil
+}
+
+// MapHandOverShipmentParams converts CLI params for handover-shipment command into internal request model
+func (f *DefaultCLIFacadeMapper) MapHandOverShipmentParams(p params.HandOverShipmentParams) (requests.HandOverShipmentRequest, error) {
+	shipmentID, err := parseShipmentID(p.ShipmentID)
+	if err != nil {
+		return requests.HandOverShipmentRequest{}, err
+	}
+	courierID, err := strconv.ParseUint(strings.TrimSpace(p.CourierID), 10, 64)
+	if err != nil {
+		return requests.HandOverShipmentRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid courier_id format")
+	}
+	return requests.HandOverShipmentRequest{
+		ShipmentID: shipmentID,
+		CourierID:  courierID,
+	}, nil
+}
+
+// MapShipmentStatusParams converts CLI params for shipment-status command into a shipment ID
+func (f *DefaultCLIFacadeMapper) MapShipmentStatusParams(p params.ShipmentStatusParams) (uint64, error) {
+	return parseShipmentID(p.ShipmentID)
+}
+
+func parseShipmentID(raw string) (uint64, error) {
+	id, err := strconv.ParseUint(strings.TrimSpace(raw), 10, 64)
+	if err != nil {
+		return 0, apperrors.Newf(apperrors.ValidationFailed, "invalid shipment_id format")
+	}
+	return id, nil
+}
@@ -0,0 +1,21 @@
+package mappers
+
+import (
+	"pvz-cli/internal/cli/params"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/usecases/requests"
+	"strconv"
+	"strings"
+)
+
+// MapUndoParams converts CLI params for undo command into internal request model
+func (f *DefaultCLIFacadeMapper) MapUndoParams(p params.UndoParams) (requests.UndoRequest, error) {
+	orderID, err := strconv.ParseUint(strings.TrimSpace(p.OrderID), 10, 64)
+	if err != nil {
+		return requests.UndoRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid order_id format")
+	}
+
+	return requests.UndoRequest{
+		OrderID: orderID,
+	}, nil
+}
@@ -2,12 +2,25 @@ package params
 
 // AcceptOrderParams contains parameters for accept-order command
 type AcceptOrderParams struct {
-	OrderID   string `json:"order_id"`
-	UserID    string `json:"user_id"`
-	ExpiresAt string `json:"expires_at"`
-	Weight    string `json:"weight"`
-	Price     string `json:"price"`
-	Package   string `json:"package"`
+	OrderID       string `json:"order_id"`
+	UserID        string `json:"user_id"`
+	ExpiresAt     string `json:"expires_at,omitempty"`
+	Weight        string `json:"weight"`
+	Price         string `json:"price"`
+	Package       string `json:"package"`
+	Fragile       bool   `json:"fragile,omitempty"`
+	Hazardous     bool   `json:"hazardous,omitempty"`
+	AgeRestricted bool   `json:"age_restricted,omitempty"`
+}
+
+// UndoParams contains parameters for undo command
+type UndoParams struct {
+	OrderID string `json:"order_id"`
+}
+
+// GetOrderParams contains parameters for get-order command
+type GetOrderParams struct {
+	OrderID string `json:"order_id"`
 }
 
 // ReturnOrderParams contains parameters for return-order command
@@ -17,19 +30,60 @@ type ReturnOrderParams struct {
 
 // ProcessOrdersParams contains parameters for process-orders command
 type ProcessOrdersParams struct {
-	UserID   string `json:"user_id"`
-	Action   string `json:"action"`
-	OrderIDs string `json:"order_ids"`
+	UserID           string `json:"user_id"`
+	Action           string `json:"action"`
+	OrderIDs         string `json:"order_ids"`
+	IdentityVerified bool   `json:"identity_verified,omitempty"`
+	Atomic           bool   `json:"atomic,omitempty"`
+}
+
+// IssueReadyParams contains parameters for issue-ready command
+type IssueReadyParams struct {
+	UserID           string `json:"user_id"`
+	IdentityVerified bool   `json:"identity_verified,omitempty"`
 }
 
 // ListOrdersParams contains parameters for list-orders command
 type ListOrdersParams struct {
-	UserID string `json:"user_id"`
-	InPvz  *bool  `json:"in_pvz,omitempty"`
-	Last   *int   `json:"last,omitempty"`
-	LastID string `json:"last_id,omitempty"`
-	Page   *int   `json:"page,omitempty"`
-	Limit  *int   `json:"limit,omitempty"`
+	UserID    string `json:"user_id"`
+	InPvz     *bool  `json:"in_pvz,omitempty"`
+	Last      *int   `json:"last,omitempty"`
+	PageToken string `json:"page_token,omitempty"`
+	Page      *int   `json:"page,omitempty"`
+	Limit     *int   `json:"limit,omitempty"`
+}
+
+// SearchOrdersParams contains parameters for search-orders command
+type SearchOrdersParams struct {
+	UserID         string `json:"user_id,omitempty"`
+	InPvz          *bool  `json:"in_pvz,omitempty"`
+	Statuses       string `json:"statuses,omitempty"`
+	Packages       string `json:"packages,omitempty"`
+	CreatedFrom    string `json:"created_from,omitempty"`
+	CreatedTo      string `json:"created_to,omitempty"`
+	ExpiresFrom    string `json:"expires_from,omitempty"`
+	ExpiresTo      string `json:"expires_to,omitempty"`
+	UpdatedFrom    string `json:"updated_from,omitempty"`
+	UpdatedTo      string `json:"updated_to,omitempty"`
+	PriceMin       string `json:"price_min,omitempty"`
+	PriceMax       string `json:"price_max,omitempty"`
+	WeightMin      string `json:"weight_min,omitempty"`
+	WeightMax      string `json:"weight_max,omitempty"`
+	ExpiringWithin string `json:"expiring_within,omitempty"`
+	SortBy         string `json:"sort_by,omitempty"`
+	SortDesc       bool   `json:"sort_desc,omitempty"`
+	Page           *int   `json:"page,omitempty"`
+	Limit          *int   `json:"limit,omitempty"`
+	PageToken      string `json:"page_token,omitempty"`
+}
+
+// ExportParams contains parameters for export command
+type ExportParams struct {
+	Dataset string             `json:"dataset"`
+	Format  string             `json:"format"`
+	Output  string             `json:"output,omitempty"`
+	OrderID string             `json:"order_id,omitempty"`
+	Filter  SearchOrdersParams `json:"filter"`
 }
 
 // ListReturnsParams contains parameters for list-returns command
@@ -40,18 +94,67 @@ type ListReturnsParams struct {
 
 // ScrollOrdersParams contains parameters for scroll-orders command
 type ScrollOrdersParams struct {
-	UserID string `json:"user_id"`
-	Limit  *int   `json:"limit,omitempty"`
-	LastID string `json:"last_id,omitempty"`
+	UserID    string `json:"user_id"`
+	Limit     *int   `json:"limit,omitempty"`
+	PageToken string `json:"page_token,omitempty"`
 }
 
 // ImportOrdersParams contains parameters for import-orders command
 type ImportOrdersParams struct {
-	File string `json:"file"`
+	File      string `json:"file"`
+	Atomic    bool   `json:"atomic,omitempty"`
+	Format    string `json:"format,omitempty"`
+	Columns   string `json:"columns,omitempty"`
+	ChunkSize *int   `json:"chunk_size,omitempty"`
+	DryRun    bool   `json:"dry_run,omitempty"`
+	Async     bool   `json:"async,omitempty"`
+}
+
+// ImportJobParams contains parameters for import-status, import-failures and import-cancel commands
+type ImportJobParams struct {
+	JobID string `json:"job_id"`
+	Watch bool   `json:"watch,omitempty"`
+}
+
+// FsckParams contains parameters for fsck command
+type FsckParams struct {
+	Repair bool `json:"repair,omitempty"`
 }
 
 // OrderHistoryParams contains parameters for order-history command
 type OrderHistoryParams struct {
-	Page  *int `json:"page,omitempty"`
-	Limit *int `json:"limit,omitempty"`
+	OrderID   string `json:"order_id,omitempty"`
+	UserID    string `json:"user_id,omitempty"`
+	Events    string `json:"events,omitempty"`
+	From      string `json:"from,omitempty"`
+	To        string `json:"to,omitempty"`
+	ActorType string `json:"actor_type,omitempty"`
+	ActorID   string `json:"actor_id,omitempty"`
+	Asc       bool   `json:"asc,omitempty"`
+	Page      *int   `json:"page,omitempty"`
+	Limit     *int   `json:"limit,omitempty"`
+	PageToken string `json:"page_token,omitempty"`
+}
+
+// RegisterShipmentParams contains parameters for register-shipment command
+type RegisterShipmentParams struct {
+	SenderID      string `json:"sender_id"`
+	Destination   string `json:"destination_point_id"`
+	Weight        string `json:"weight"`
+	Price         string `json:"price"`
+	Package       string `json:"package,omitempty"`
+	Fragile       bool   `json:"fragile,omitempty"`
+	Hazardous     bool   `json:"hazardous,omitempty"`
+	AgeRestricted bool   `json:"age_restricted,omitempty"`
+}
+
+// HandOverShipmentParams contains parameters for handover-shipment command
+type HandOverShipmentParams struct {
+	ShipmentID string `json:"shipment_id"`
+	CourierID  string `json:"courier_id"`
+}
+
+// ShipmentStatusParams contains parameters for shipment-status command
+type ShipmentStatusParams struct {
+	ShipmentID string `json:"shipment_id"`
 }
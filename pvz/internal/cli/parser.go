@@ -42,9 +42,6 @@ func (p *ArgsParser) AcceptOrderParams() (params.AcceptOrderParams, error) {
 	if m["--user-id"] == "" {
 		return params.AcceptOrderParams{}, apperrors.Newf(apperrors.ValidationFailed, "user-id is required")
 	}
-	if m["--expires"] == "" {
-		return params.AcceptOrderParams{}, apperrors.Newf(apperrors.ValidationFailed, "expires is required")
-	}
 	if m["--weight"] == "" {
 		return params.AcceptOrderParams{}, apperrors.Newf(apperrors.ValidationFailed, "weight is required")
 	}
@@ -52,13 +49,127 @@ func (p *ArgsParser) AcceptOrderParams() (params.AcceptOrderParams, error) {
 		return params.AcceptOrderParams{}, apperrors.Newf(apperrors.ValidationFailed, "price is required")
 	}
 
+	fragile, err := parseOptionalBool(m, "--fragile")
+	if err != nil {
+		return params.AcceptOrderParams{}, err
+	}
+	hazardous, err := parseOptionalBool(m, "--hazardous")
+	if err != nil {
+		return params.AcceptOrderParams{}, err
+	}
+	ageRestricted, err := parseOptionalBool(m, "--age-restricted")
+	if err != nil {
+		return params.AcceptOrderParams{}, err
+	}
+
 	return params.AcceptOrderParams{
-		OrderID:   m["--order-id"],
-		UserID:    m["--user-id"],
-		ExpiresAt: m["--expires"],
-		Weight:    m["--weight"],
-		Price:     m["--price"],
-		Package:   m["--package"],
+		OrderID:       m["--order-id"],
+		UserID:        m["--user-id"],
+		ExpiresAt:     m["--expires"],
+		Weight:        m["--weight"],
+		Price:         m["--price"],
+		Package:       m["--package"],
+		Fragile:       fragile != nil && *fragile,
+		Hazardous:     hazardous != nil && *hazardous,
+		AgeRestricted: ageRestricted != nil && *ageRestricted,
+	}, nil
+}
+
+// RegisterShipmentParams parses and validates parameters for register-shipment command
+func (p *ArgsParser) RegisterShipmentParams() (params.RegisterShipmentParams, error) {
+	m := p.asMap()
+
+	if m["--sender-id"] == "" {
+		return params.RegisterShipmentParams{}, apperrors.Newf(apperrors.ValidationFailed, "sender-id is required")
+	}
+	if m["--destination"] == "" {
+		return params.RegisterShipmentParams{}, apperrors.Newf(apperrors.ValidationFailed, "destination is required")
+	}
+	if m["--weight"] == "" {
+		return params.RegisterShipmentParams{}, apperrors.Newf(apperrors.ValidationFailed, "weight is required")
+	}
+	if m["--price"] == "" {
+		return params.RegisterShipmentParams{}, apperrors.Newf(apperrors.ValidationFailed, "price is required")
+	}
+
+	fragile, err := parseOptionalBool(m, "--fragile")
+	if err != nil {
+		return params.RegisterShipmentParams{}, err
+	}
+	hazardous, err := parseOptionalBool(m, "--hazardous")
+	if err != nil {
+		return params.RegisterShipmentParams{}, err
+	}
+	ageRestricted, err := parseOptionalBool(m, "--age-restricted")
+	if err != nil {
+		return params.RegisterShipmentParams{}, err
+	}
+
+	return params.RegisterShipmentParams{
+		SenderID:      m["--sender-id"],
+		Destination:   m["--destination"],
+		Weight:        m["--weight"],
+		Price:         m["--price"],
+		Package:       m["--package"],
+		Fragile:       fragile != nil && *fragile,
+		Hazardous:     hazardous != nil && *hazardous,
+		AgeRestricted: ageRestricted != nil && *ageRestricted,
+	}, nil
+}
+
+// HandOverShipmentParams parses and validates parameters for handover-shipment command
+func (p *ArgsParser) HandOverShipmentParams() (params.HandOverShipmentParams, error) {
+	m := p.asMap()
+
+	if m["--shipment-id"] == "" {
+		return params.HandOverShipmentParams{}, apperrors.Newf(apperrors.ValidationFailed, "shipment-id is required")
+	}
+	if m["--courier-id"] == "" {
+		return params.HandOverShipmentParams{}, apperrors.Newf(apperrors.ValidationFailed, "courier-id is required")
+	}
+
+	return params.HandOverShipmentParams{
+		ShipmentID: m["--shipment-id"],
+		CourierID:  m["--courier-id"],
+	}, nil
+}
+
+// ShipmentStatusParams parses and validates parameters for shipment-status command
+func (p *ArgsParser) ShipmentStatusParams() (params.ShipmentStatusParams, error) {
+	m := p.asMap()
+
+	if m["--shipment-id"] == "" {
+		return params.ShipmentStatusParams{}, apperrors.Newf(apperrors.ValidationFailed, "shipment-id is required")
+	}
+
+	return params.ShipmentStatusParams{
+		ShipmentID: m["--shipment-id"],
+	}, nil
+}
+
+// UndoParams parses and validates parameters for undo command
+func (p *ArgsParser) UndoParams() (params.UndoParams, error) {
+	m := p.asMap()
+
+	if m["--order-id"] == "" {
+		return params.UndoParams{}, apperrors.Newf(apperrors.ValidationFailed, "order-id is required")
+	}
+
+	return params.UndoParams{
+		OrderID: m["--order-id"],
+	}, nil
+}
+
+// GetOrderParams parses and validates parameters for get-order command
+func (p *ArgsParser) GetOrderParams() (params.GetOrderParams, error) {
+	m := p.asMap()
+
+	if m["--order-id"] == "" {
+		return params.GetOrderParams{}, apperrors.Newf(apperrors.ValidationFailed, "order-id is required")
+	}
+
+	return params.GetOrderParams{
+		OrderID: m["--order-id"],
 	}, nil
 }
 
@@ -91,10 +202,40 @@ func (p *ArgsParser) ProcessOrdersParams() (params.ProcessOrdersParams, error) {
 		return params.ProcessOrdersParams{}, apperrors.Newf(apperrors.ValidationFailed, "order-ids is required")
 	}
 
+	identityVerified, err := parseOptionalBool(m, "--identity-verified")
+	if err != nil {
+		return params.ProcessOrdersParams{}, err
+	}
+	atomic, err := parseOptionalBool(m, "--atomic")
+	if err != nil {
+		return params.ProcessOrdersParams{}, err
+	}
+
 	return params.ProcessOrdersParams{
-		UserID:   m["--user-id"],
-		Action:   m["--action"],
-		OrderIDs: m["--order-ids"],
+		UserID:           m["--user-id"],
+		Action:           m["--action"],
+		OrderIDs:         m["--order-ids"],
+		IdentityVerified: identityVerified != nil && *identityVerified,
+		Atomic:           atomic != nil && *atomic,
+	}, nil
+}
+
+// IssueReadyParams parses and validates parameters for issue-ready command
+func (p *ArgsParser) IssueReadyParams() (params.IssueReadyParams, error) {
+	m := p.asMap()
+
+	if m["--user-id"] == "" {
+		return params.IssueReadyParams{}, apperrors.Newf(apperrors.ValidationFailed, "user-id is required")
+	}
+
+	identityVerified, err := parseOptionalBool(m, "--identity-verified")
+	if err != nil {
+		return params.IssueReadyParams{}, err
+	}
+
+	return params.IssueReadyParams{
+		UserID:           m["--user-id"],
+		IdentityVerified: identityVerified != nil && *identityVerified,
 	}, nil
 }
 
@@ -103,7 +244,7 @@ func (p *ArgsParser) ListOrdersParams() (params.ListOrdersParams, error) {
 	m := p.asMap()
 	allowed := map[string]struct{}{
 		"--user-id": {}, "--in-pvz": {}, "--last": {},
-		"--page": {}, "--limit": {}, "--last-id": {},
+		"--page": {}, "--limit": {}, "--page-token": {},
 	}
 	for key := range m {
 		if _, ok := allowed[key]; !ok {
@@ -135,12 +276,107 @@ func (p *ArgsParser) ListOrdersParams() (params.ListOrdersParams, error) {
 	}
 
 	return params.ListOrdersParams{
-		UserID: m["--user-id"],
-		InPvz:  inPvz,
-		Last:   last,
-		Page:   page,
-		Limit:  limit,
-		LastID: m["--last-id"],
+		UserID:    m["--user-id"],
+		InPvz:     inPvz,
+		Last:      last,
+		Page:      page,
+		Limit:     limit,
+		PageToken: m["--page-token"],
+	}, nil
+}
+
+// SearchOrdersParams parses and validates parameters for search-orders command
+func (p *ArgsParser) SearchOrdersParams() (params.SearchOrdersParams, error) {
+	return searchOrdersParams(p.asMap())
+}
+
+// ExportParams parses and validates parameters for export command.
+// Besides its own flags, export accepts the filter flags of search-orders except paging.
+func (p *ArgsParser) ExportParams() (params.ExportParams, error) {
+	m := p.asMap()
+
+	if m["--dataset"] == "" {
+		return params.ExportParams{}, apperrors.Newf(apperrors.ValidationFailed, "dataset is required")
+	}
+	if m["--format"] == "" {
+		return params.ExportParams{}, apperrors.Newf(apperrors.ValidationFailed, "format is required")
+	}
+	for _, key := range []string{"--page", "--limit", "--page-token"} {
+		if _, ok := m[key]; ok {
+			return params.ExportParams{}, apperrors.Newf(apperrors.ValidationFailed, "unknown flag %q", key)
+		}
+	}
+
+	res := params.ExportParams{
+		Dataset: m["--dataset"],
+		Format:  m["--format"],
+		Output:  m["--output"],
+		OrderID: m["--order-id"],
+	}
+	for _, key := range []string{"--dataset", "--format", "--output", "--order-id"} {
+		delete(m, key)
+	}
+	filter, err := searchOrdersParams(m)
+	if err != nil {
+		return params.ExportParams{}, err
+	}
+	res.Filter = filter
+	return res, nil
+}
+
+func searchOrdersParams(m map[string]string) (params.SearchOrdersParams, error) {
+	allowed := map[string]struct{}{
+		"--user-id": {}, "--in-pvz": {}, "--statuses": {}, "--packages": {},
+		"--created-from": {}, "--created-to": {}, "--expires-from": {}, "--expires-to": {},
+		"--updated-from": {}, "--updated-to": {}, "--price-min": {}, "--price-max": {},
+		"--weight-min": {}, "--weight-max": {}, "--expiring-within": {},
+		"--sort": {}, "--desc": {}, "--page": {}, "--limit": {}, "--page-token": {},
+	}
+	for key := range m {
+		if _, ok := allowed[key]; !ok {
+			return params.SearchOrdersParams{},
+				apperrors.Newf(apperrors.ValidationFailed, "unknown flag %q", key)
+		}
+	}
+
+	inPvz, err := parseOptionalBool(m, "--in-pvz")
+	if err != nil {
+		return params.SearchOrdersParams{}, err
+	}
+	desc, err := parseOptionalBool(m, "--desc")
+	if err != nil {
+		return params.SearchOrdersParams{}, err
+	}
+	page, err := parseOptionalInt(m, "--page")
+	if err != nil {
+		return params.SearchOrdersParams{}, err
+	}
+	limit, err := parseOptionalInt(m, "--limit")
+	if err != nil {
+		return params.SearchOrdersParams{}, err
+	}
+
+	return params.SearchOrdersParams{
+		UserID:         m["--user-id"],
+		InPvz:          inPvz,
+		Statuses:       m["--statuses"],
+		Packages:       m["--packages"],
+		CreatedFrom:    m["--created-from"],
+		CreatedTo:      m["--created-to"],
+		ExpiresFrom:    m["--expires-from"],
+		ExpiresTo:      m["--expires-to"],
+		UpdatedFrom:    m["--updated-from"],
+		UpdatedTo:      m["--updated-to"],
+		PriceMin:       m["--price-min"],
+		PriceMax:       m["--price-max"],
+		WeightMin:      m["--weight-min"],
+		WeightMax:      m["--weight-max"],
+		ExpiringWithin: m["--expiring-within"],
+		SortBy:         m["--sort"],
+		SortDesc:       desc != nil && *desc,
+		Page:           page,
+		Limit:          limit,
+		PageToken:      m["--page-token"],
 	}, nil
 }
 
@@ -171,8 +407,63 @@ func (p *ArgsParser) ImportOrdersParams() (params.ImportOrdersParams, error) {
 		return params.ImportOrdersParams{}, apperrors.Newf(apperrors.ValidationFailed, "file is required")
 	}
 
+	atomic, err := parseOptionalBool(m, "--atomic")
+	if err != nil {
+		return params.ImportOrdersParams{}, err
+	}
+	chunkSize, err := parseOptionalInt(m, "--chunk-size")
+	if err != nil {
+		return params.ImportOrdersParams{}, err
+	}
+	dryRun, err := parseOptionalBool(m, "--dry-run")
+	if err != nil {
+		return params.ImportOrdersParams{}, err
+	}
+	async, err := parseOptionalBool(m, "--async")
+	if err != nil {
+		return params.ImportOrdersParams{}, err
+	}
+
 	return params.ImportOrdersParams{
-		File: m["--file"],
+		File:      m["--file"],
+		Atomic:    atomic != nil && *atomic,
+		Format:    m["--format"],
+		Columns:   m["--columns"],
+		ChunkSize: chunkSize,
+		DryRun:    dryRun != nil && *dryRun,
+		Async:     async != nil && *async,
+	}, nil
+}
+
+// ImportJobParams parses and validates parameters for import-status, import-failures and import-cancel commands
+func (p *ArgsParser) ImportJobParams() (params.ImportJobParams, error) {
+	m := p.asMap()
+
+	if m["--job-id"] == "" {
+		return params.ImportJobParams{}, apperrors.Newf(apperrors.ValidationFailed, "job-id is required")
+	}
+	watch, err := parseOptionalBool(m, "--watch")
+	if err != nil {
+		return params.ImportJobParams{}, err
+	}
+
+	return params.ImportJobParams{
+		JobID: m["--job-id"],
+		Watch: watch != nil && *watch,
+	}, nil
+}
+
+// FsckParams parses and validates parameters for fsck command
+func (p *ArgsParser) FsckParams() (params.FsckParams, error) {
+	m := p.asMap()
+
+	repair, err := parseOptionalBool(m, "--repair")
+	if err != nil {
+		return params.FsckParams{}, err
+	}
+
+	return params.FsckParams{
+		Repair: repair != nil && *repair,
 	}, nil
 }
 
@@ -190,8 +481,9 @@ func (p *ArgsParser) ScrollOrdersParams() (params.ScrollOrdersParams, error) {
 	}
 
 	return params.ScrollOrdersParams{
-		UserID: m["--user-id"],
-		Limit:  limit,
+		UserID:    m["--user-id"],
+		Limit:     limit,
+		PageToken: m["--page-token"],
 	}, nil
 }
 
@@ -199,6 +491,21 @@ func (p *ArgsParser) ScrollOrdersParams() (params.ScrollOrdersParams, error) {
 func (p *ArgsParser) OrderHistoryParams() (params.OrderHistoryParams, error) {
 	m := p.asMap()
 
+	allowed := map[string]struct{}{
+		"--order-id": {}, "--user-id": {}, "--events": {}, "--from": {}, "--to": {},
+		"--actor-type": {}, "--actor-id": {}, "--asc": {}, "--page": {}, "--limit": {}, "--page-token": {},
+	}
+	for key := range m {
+		if _, ok := allowed[key]; !ok {
+			return params.OrderHistoryParams{},
+				apperrors.Newf(apperrors.ValidationFailed, "unknown flag %q", key)
+		}
+	}
+
+	asc, err := parseOptionalBool(m, "--asc")
+	if err != nil {
+		return params.OrderHistoryParams{}, err
+	}
 	page, err := parseOptionalInt(m, "--page")
 	if err != nil {
 		return params.OrderHistoryParams{}, err
@@ -209,8 +516,17 @@ func (p *ArgsParser) OrderHistoryParams() (params.OrderHistoryParams, error) {
 	}
 
 	return params.OrderHistoryParams{
-		Page:  page,
-		Limit: limit,
+		OrderID:   m["--order-id"],
+		UserID:    m["--user-id"],
+		Events:    m["--events"],
+		From:      m["--from"],
+		To:        m["--to"],
+		ActorType: m["--actor-type"],
+		ActorID:   m["--actor-id"],
+		Asc:       asc != nil && *asc,
+		Page:      page,
+		Limit:     limit,
+		PageToken: m["--page-token"],
 	}, nil
 }
 
@@ -3,15 +3,21 @@ package cli
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"pvz-cli/internal/usecases/requests"
 	"strings"
 
+	"github.com/google/uuid"
 	"pvz-cli/internal/cli/mappers"
 	"pvz-cli/internal/common/apperrors"
 	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/common/correlation"
+	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/handlers"
+	"pvz-cli/internal/usecases/responses"
 )
 
 type batchHandler func(ctx context.Context, args []string)
@@ -51,7 +57,8 @@ func (r *Router) runBatch(ctx context.Context, cmd string, args []string) {
 		return
 	}
 	if h, ok := r.handlers[cmd]; ok {
-		h(ctx, args)
+		// every command is a separate request, so it gets its own correlation ID for the history it writes
+		h(correlation.WithID(ctx, uuid.NewString()), args)
 	} else {
 		fmt.Printf("ERROR: unknown command %q\n", cmd)
 	}
@@ -134,11 +141,23 @@ func (r *Router) registerHandlers() {
 	r.handlers[constants.CmdAcceptOrder] = r.acceptOrderHandler()
 	r.handlers[constants.CmdReturnOrder] = r.returnOrderHandler()
 	r.handlers[constants.CmdProcess] = r.processOrdersHandler()
+	r.handlers[constants.CmdIssueReady] = r.issueReadyHandler()
+	r.handlers[constants.CmdUndo] = r.undoHandler()
 	r.handlers[constants.CmdListOrders] = r.listOrdersHandler()
 	r.handlers[constants.CmdListReturns] = r.listReturnsHandler()
+	r.handlers[constants.CmdSearchOrders] = r.searchOrdersHandler()
+	r.handlers[constants.CmdGetOrder] = r.getOrderHandler()
+	r.handlers[constants.CmdExport] = r.exportHandler()
 	r.handlers[constants.CmdOrderHistory] = r.orderHistoryHandler()
 	r.handlers[constants.CmdImportOrders] = r.importOrdersHandler()
+	r.handlers[constants.CmdImportStatus] = r.importStatusHandler()
+	r.handlers[constants.CmdImportFails] = r.importFailuresHandler()
+	r.handlers[constants.CmdImportCancel] = r.importCancelHandler()
 	r.handlers[constants.CmdScrollOrders] = r.scrollOrdersHandler()
+	r.handlers[constants.CmdRegisterShip] = r.registerShipmentHandler()
+	r.handlers[constants.CmdHandOverShip] = r.handOverShipmentHandler()
+	r.handlers[constants.CmdShipStatus] = r.shipmentStatusHandler()
+	r.handlers[constants.CmdFsck] = r.fsckHandler()
 }
 
 func (r *Router) helpHandler() batchHandler {
@@ -167,9 +186,12 @@ func (r *Router) acceptOrderHandler() batchHandler {
 			apperrors.Handle(err)
 		}
 		fmt.Printf(
-			"ORDER_ACCEPTED: %d\nPACKAGE: %s\nTOTAL_PRICE: %.*f\n",
+			"ORDER_ACCEPTED: %d\nPACKAGE: %s\nHANDLING: %s\nEXPIRES: %s\nSTORAGE_POLICY: %s\nTOTAL_PRICE: %.*f\n",
 			resp.OrderID,
 			resp.Package,
+			resp.Handling,
+			resp.ExpiresAt.Format(constants.TimeLayout),
+			resp.StoragePolicy,
 			constants.PriceFractionDigit, resp.Price,
 		)
 	}
@@ -195,6 +217,32 @@ func (r *Router) returnOrderHandler() batchHandler {
 	}
 }
 
+func (r *Router) undoHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).UndoParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		req, err := r.facadeMapper.MapUndoParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		res, err := r.facadeHandler.HandleUndoLastOperation(ctx, req)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		fmt.Printf("ORDER_UNDONE: %d %s\n", res.OrderID, res.Undone)
+		if res.Removed {
+			fmt.Println("REMOVED")
+			return
+		}
+		fmt.Printf("STATUS: %s\n", res.Status)
+	}
+}
+
 func (r *Router) processOrdersHandler() batchHandler {
 	return func(ctx context.Context, args []string) {
 		params, err := NewArgsParser(args).ProcessOrdersParams()
@@ -225,6 +273,41 @@ func (r *Router) processOrdersHandler() batchHandler {
 	}
 }
 
+func (r *Router) issueReadyHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).IssueReadyParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+
+		req, err := r.facadeMapper.MapIssueReadyParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+
+		resp, err := r.facadeHandler.HandleIssueAllReady(ctx, req)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+
+		fmt.Printf("RECEIPT: USER %d\n", resp.UserID)
+		for _, o := range resp.Issued {
+			fmt.Printf("ISSUED: %d %.*f\n", o.OrderID, constants.PriceFractionDigit, o.Price)
+		}
+		for _, report := range resp.Skipped {
+			fmt.Printf("SKIPPED: %d %s: %s\n",
+				report.OrderID,
+				apperrors.CodeFromError(report.Error),
+				apperrors.MessageFromError(report.Error),
+			)
+		}
+		fmt.Printf("TOTAL: %.*f\n", constants.PriceFractionDigit, resp.Total)
+	}
+}
+
 func (r *Router) listOrdersHandler() batchHandler {
 	return func(ctx context.Context, args []string) {
 		params, err := NewArgsParser(args).ListOrdersParams()
@@ -244,17 +327,59 @@ func (r *Router) listOrdersHandler() batchHandler {
 
 		for _, o := range res.Orders {
 			fmt.Printf(
-				"ORDER: %d %d %s %s %s %.*f %.*f\n",
+				"ORDER: %d %d %s %s %s %.*f %.*f %s\n",
 				o.OrderID, o.UserID, o.Status,
 				o.ExpiresAt.Format(constants.TimeLayout),
 				o.Package,
 				constants.WeightFractionDigit, o.Weight,
 				constants.PriceFractionDigit, o.Price,
+				o.HandlingFlags,
 			)
 		}
 		if res.Total != nil {
 			fmt.Printf("TOTAL: %d\n", *res.Total)
 		}
+		if res.NextPageToken != "" {
+			fmt.Printf("NEXT: %s\n", res.NextPageToken)
+		}
+	}
+}
+
+func (r *Router) searchOrdersHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).SearchOrdersParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		req, err := r.facadeMapper.MapSearchOrdersParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		res, err := r.facadeHandler.HandleSearchOrders(ctx, req)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+
+		for _, o := range res.Orders {
+			fmt.Printf(
+				"ORDER: %d %d %s %s %s %.*f %.*f %s\n",
+				o.OrderID, o.UserID, o.Status,
+				o.ExpiresAt.Format(constants.TimeLayout),
+				o.Package,
+				constants.WeightFractionDigit, o.Weight,
+				constants.PriceFractionDigit, o.Price,
+				o.HandlingFlags,
+			)
+		}
+		if res.Total != nil {
+			fmt.Printf("TOTAL: %d\n", *res.Total)
+		}
+		if res.NextPageToken != "" {
+			fmt.Printf("NEXT: %s\n", res.NextPageToken)
+		}
 	}
 }
 
@@ -288,6 +413,91 @@ func (r *Router) listReturnsHandler() batchHandler {
 	}
 }
 
+func (r *Router) getOrderHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).GetOrderParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		id, err := r.facadeMapper.MapGetOrderParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		res, err := r.facadeHandler.HandleGetOrder(ctx, id)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		o := res.Order
+		fmt.Printf(
+			"ORDER: %d %d %s %s %s %.*f %.*f %s\n",
+			o.OrderID, o.UserID, o.Status,
+			o.ExpiresAt.Format(constants.TimeLayout),
+			o.Package,
+			constants.WeightFractionDigit, o.Weight,
+			constants.PriceFractionDigit, o.Price,
+			o.HandlingFlags,
+		)
+		fmt.Printf("CREATED: %s\n", o.CreatedAt.Format(constants.HistoryTimeLayout))
+		fmt.Printf("STATUS_UPDATED: %s\n", o.UpdatedStatusAt.Format(constants.HistoryTimeLayout))
+		if res.StorageDeadline != nil {
+			fmt.Printf("STORAGE_DEADLINE: %s\n", res.StorageDeadline.Format(constants.HistoryTimeLayout))
+		}
+		if res.ReturnDeadline != nil {
+			fmt.Printf("RETURN_DEADLINE: %s\n", res.ReturnDeadline.Format(constants.HistoryTimeLayout))
+		}
+		for _, e := range res.History {
+			printHistoryEntry(e)
+		}
+	}
+}
+
+// exportHandler writes the export to the --output file, or to stdout when no file is given.
+// The row count goes to stderr in the latter case so that it does not end up in the exported data.
+func (r *Router) exportHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).ExportParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		req, err := r.facadeMapper.MapExportParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+
+		if params.Output == "" {
+			res, err := r.facadeHandler.HandleExport(ctx, req, os.Stdout)
+			if err != nil {
+				apperrors.Handle(err)
+				return
+			}
+			_, _ = fmt.Fprintf(os.Stderr, "EXPORTED: %d\n", res.Rows)
+			return
+		}
+
+		file, err := os.Create(params.Output)
+		if err != nil {
+			apperrors.Handle(apperrors.Newf(apperrors.ValidationFailed, "cannot create output file: %v", err))
+			return
+		}
+		res, err := r.facadeHandler.HandleExport(ctx, req, file)
+		if closeErr := file.Close(); err == nil && closeErr != nil {
+			err = apperrors.Newf(apperrors.InternalError, "failed to write output file: %v", closeErr)
+		}
+		if err != nil {
+			// a partial file would look like a complete export
+			_ = os.Remove(params.Output)
+			apperrors.Handle(err)
+			return
+		}
+		fmt.Printf("EXPORTED: %d\n", res.Rows)
+	}
+}
+
 func (r *Router) orderHistoryHandler() batchHandler {
 	return func(ctx context.Context, args []string) {
 		params, err := NewArgsParser(args).OrderHistoryParams()
@@ -303,17 +513,105 @@ func (r *Router) orderHistoryHandler() batchHandler {
 		res, err := r.facadeHandler.HandleOrderHistory(ctx, req)
 		if err != nil {
 			apperrors.Handle(err)
+			return
+		}
+		for _, e := range res.History {
+			printHistoryEntry(e)
+		}
+		if res.NextPageToken != "" {
+			fmt.Printf("NEXT: %s\n", res.NextPageToken)
+		}
+	}
+}
+
+func (r *Router) registerShipmentHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).RegisterShipmentParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		req, err := r.facadeMapper.MapRegisterShipmentParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		res, err := r.facadeHandler.HandleRegisterShipment(ctx, req)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		fmt.Printf(
+			"SHIPMENT_REGISTERED: %d\nDESTINATION: %d\nPACKAGE: %s\nHANDLING: %s\nTOTAL_PRICE: %.*f\n",
+			res.Shipment.ShipmentID,
+			res.Shipment.DestinationPointID,
+			res.Shipment.Package,
+			res.Shipment.HandlingFlags,
+			constants.PriceFractionDigit, res.Shipment.Price,
+		)
+	}
+}
+
+func (r *Router) handOverShipmentHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).HandOverShipmentParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		req, err := r.facadeMapper.MapHandOverShipmentParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
 		}
+		res, err := r.facadeHandler.HandleHandOverShipment(ctx, req)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		fmt.Printf("SHIPMENT_HANDED_OVER: %d COURIER: %d\n", res.Shipment.ShipmentID, res.Shipment.CourierID)
+	}
+}
+
+func (r *Router) shipmentStatusHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).ShipmentStatusParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		id, err := r.facadeMapper.MapShipmentStatusParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		res, err := r.facadeHandler.HandleGetShipment(ctx, id)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		s := res.Shipment
+		fmt.Printf("SHIPMENT: %d %d -> %d %s %s %.*f\n",
+			s.ShipmentID,
+			s.SenderID,
+			s.DestinationPointID,
+			s.Status,
+			s.Package,
+			constants.PriceFractionDigit, s.Price,
+		)
 		for _, e := range res.History {
 			fmt.Printf("HISTORY: %d %s %s\n",
-				e.OrderID,
-				e.Event,
+				e.ShipmentID,
+				e.Status,
 				e.Timestamp.Format(constants.HistoryTimeLayout),
 			)
 		}
 	}
 }
 
+// importOrdersHandler sends the import file to the facade chunk by chunk; IMPORTED counts the orders of all chunks.
+// A dry run prints a ROW line with the computed price or the error of every order instead.
+// An async import submits the whole file as a background job and prints the job ID right away.
 func (r *Router) importOrdersHandler() batchHandler {
 	return func(ctx context.Context, args []string) {
 		params, err := NewArgsParser(args).ImportOrdersParams()
@@ -321,24 +619,168 @@ func (r *Router) importOrdersHandler() batchHandler {
 			apperrors.Handle(err)
 			return
 		}
-		req, err := r.facadeMapper.MapImportOrdersParams(params)
+		batches, err := r.facadeMapper.MapImportOrdersParams(params)
 		if err != nil {
 			apperrors.Handle(err)
+			return
 		}
+		defer func() {
+			if err := batches.Close(); err != nil {
+				apperrors.Handle(apperrors.Newf(apperrors.InternalError, "cannot close file %q: %v", params.File, err))
+			}
+		}()
 
-		res, err := r.facadeHandler.HandleImportOrders(ctx, req)
+		if params.Async {
+			r.submitImportJob(ctx, batches)
+			return
+		}
+
+		imported, valid, invalid := 0, 0, 0
+		defer func() {
+			if params.DryRun {
+				fmt.Printf("VALID: %d INVALID: %d\n", valid, invalid)
+				return
+			}
+			fmt.Printf("IMPORTED: %d\n", imported)
+		}()
+		for {
+			req, err := batches.Next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				apperrors.Handle(err)
+				return
+			}
+			res, err := r.facadeHandler.HandleImportOrders(ctx, req)
+			if err != nil {
+				apperrors.Handle(err)
+				return
+			}
+			if res.ValidateOnly {
+				for _, status := range res.Statuses {
+					if status.Error != nil {
+						invalid++
+						fmt.Printf("ROW: %d %d ERROR %s: %s\n", status.ItemNumber, status.OrderID,
+							apperrors.CodeFromError(status.Error), apperrors.MessageFromError(status.Error))
+						continue
+					}
+					valid++
+					fmt.Printf("ROW: %d %d OK %.*f\n", status.ItemNumber, status.OrderID,
+						constants.PriceFractionDigit, status.TotalPrice)
+				}
+				continue
+			}
+			for _, status := range res.Statuses {
+				if status.Error != nil {
+					apperrors.Handle(status.Error)
+				}
+			}
+			imported += res.Imported
+		}
+	}
+}
+
+func (r *Router) submitImportJob(ctx context.Context, batches *mappers.ImportBatches) {
+	req, err := batches.Next()
+	if errors.Is(err, io.EOF) {
+		apperrors.Handle(apperrors.Newf(apperrors.ValidationFailed, "import file has no orders"))
+		return
+	}
+	if err != nil {
+		apperrors.Handle(err)
+		return
+	}
+	res, err := r.facadeHandler.HandleSubmitImportJob(ctx, req)
+	if err != nil {
+		apperrors.Handle(err)
+		return
+	}
+	printImportJob(res.Job)
+}
+
+// importStatusHandler prints the progress of an import job; with --watch it prints every update until the job finishes.
+func (r *Router) importStatusHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).ImportJobParams()
 		if err != nil {
 			apperrors.Handle(err)
 			return
 		}
-
-		for _, status := range res.Statuses {
-			if status.Error != nil {
-				apperrors.Handle(status.Error)
+		id, err := r.facadeMapper.MapImportJobParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		if params.Watch {
+			err = r.facadeHandler.HandleWatchImportJob(ctx, id, func(res responses.ImportJobResponse) error {
+				printImportJob(res.Job)
+				return nil
+			})
+			if err != nil {
+				apperrors.Handle(err)
 			}
+			return
+		}
+		res, err := r.facadeHandler.HandleGetImportJob(ctx, id)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		printImportJob(res.Job)
+	}
+}
+
+func (r *Router) importFailuresHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).ImportJobParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		id, err := r.facadeMapper.MapImportJobParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		res, err := r.facadeHandler.HandleListImportJobFailures(ctx, id)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		for _, f := range res.Failures {
+			fmt.Printf("ROW: %d %d ERROR %s\n", f.ItemNumber, f.OrderID, f.Reason)
+		}
+		fmt.Printf("FAILED: %d\n", len(res.Failures))
+	}
+}
+
+func (r *Router) importCancelHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).ImportJobParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		id, err := r.facadeMapper.MapImportJobParams(params)
+		if err != nil {
+			apperrors.Handle(err)
+			return
 		}
+		res, err := r.facadeHandler.HandleCancelImportJob(ctx, id)
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		printImportJob(res.Job)
+	}
+}
 
-		fmt.Printf("IMPORTED: %d\n", res.Imported)
+func printImportJob(job models.ImportJob) {
+	fmt.Printf("JOB: %d %s %d/%d IMPORTED: %d FAILED: %d\n",
+		job.JobID, job.Status, job.Processed, job.Total, job.Imported, job.Failed)
+	if job.Error != "" {
+		fmt.Printf("ERROR: %s\n", job.Error)
 	}
 }
 
@@ -370,23 +812,24 @@ func (r *Router) runScrollLoop(ctx context.Context, req requests.OrdersFilterReq
 		}
 
 		for _, o := range resp.Orders {
-			fmt.Printf("ORDER: %d %d %s %s %s %.*f %.*f\n",
+			fmt.Printf("ORDER: %d %d %s %s %s %.*f %.*f %s\n",
 				o.OrderID, o.UserID, o.Status,
 				o.ExpiresAt.Format(constants.TimeLayout),
 				o.Package,
 				constants.WeightFractionDigit, o.Weight,
 				constants.PriceFractionDigit, o.Price,
+				o.HandlingFlags,
 			)
 		}
 
-		if resp.NextID == nil || *resp.NextID == 0 {
+		if resp.NextPageToken == "" {
 			fmt.Println("NEXT: -")
 			r.waitForExit(ctx, scanner)
 			return
 		}
 
-		fmt.Printf("NEXT: %d\n", *resp.NextID)
-		req.LastID = resp.NextID
+		fmt.Printf("NEXT: %s\n", resp.NextPageToken)
+		req.PageToken = resp.NextPageToken
 
 		if !promptNext(scanner) {
 			return
@@ -431,3 +874,57 @@ func (r *Router) waitForExit(ctx context.Context, scanner *bufio.Scanner) {
 		}
 	}
 }
+
+// printHistoryEntry prints a history entry with the details known for it: the status change, the actor,
+// the operator, the request correlation ID and the event metadata
+func printHistoryEntry(e models.HistoryEntry) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "HISTORY: %d %s %s", e.OrderID, e.Event, e.Timestamp.Format(constants.HistoryTimeLayout))
+	if e.PreviousStatus != 0 || e.NewStatus != 0 {
+		fmt.Fprintf(&b, " STATUS: %s -> %s", historyStatusText(e.PreviousStatus), historyStatusText(e.NewStatus))
+	}
+	if e.ActorType != "" {
+		fmt.Fprintf(&b, " ACTOR: %s %d", e.ActorType, e.ActorID)
+	}
+	if e.OperatorID != "" {
+		fmt.Fprintf(&b, " OPERATOR: %s", e.OperatorID)
+	}
+	if e.CorrelationID != "" {
+		fmt.Fprintf(&b, " CORRELATION: %s", e.CorrelationID)
+	}
+	if len(e.Metadata) > 0 {
+		fmt.Fprintf(&b, " META: %s", e.Metadata)
+	}
+	fmt.Println(b.String())
+}
+
+func historyStatusText(s models.OrderStatus) string {
+	if s == 0 {
+		return "-"
+	}
+	return s.String()
+}
+
+// fsckHandler prints every consistency issue found, then the totals of the check.
+func (r *Router) fsckHandler() batchHandler {
+	return func(ctx context.Context, args []string) {
+		params, err := NewArgsParser(args).FsckParams()
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		res, err := r.facadeHandler.HandleCheckConsistency(ctx, r.facadeMapper.MapFsckParams(params))
+		if err != nil {
+			apperrors.Handle(err)
+			return
+		}
+		for _, issue := range res.Issues {
+			line := fmt.Sprintf("ISSUE: %d %s %s", issue.OrderID, issue.Kind, issue.Details)
+			if issue.Repaired {
+				line += " REPAIRED"
+			}
+			fmt.Println(line)
+		}
+		fmt.Printf("CHECKED: %d\nISSUES: %d\nREPAIRED: %d\n", res.CheckedOrders, len(res.Issues), res.Repaired)
+	}
+}
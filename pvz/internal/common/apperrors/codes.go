@@ -20,6 +20,31 @@ const (
 	WeightTooHeavy     ErrorCode = "WEIGHT_TOO_HEAVY"
 	InvalidBatchEntry  ErrorCode = "INVALID_BATCH_ENTRY"
 	InvalidID          ErrorCode = "INVALID_ID"
+	IdentityRequired   ErrorCode = "IDENTITY_REQUIRED"
+	PickupPointClosed  ErrorCode = "PICKUP_POINT_CLOSED"
+	ShipmentNotFound   ErrorCode = "SHIPMENT_NOT_FOUND"
+	// ConcurrentModification is reported when an order changed between load and save
+	ConcurrentModification ErrorCode = "CONCURRENT_MODIFICATION"
+	// BatchAborted is reported for valid orders of an atomic batch that was not applied because other orders failed
+	BatchAborted ErrorCode = "BATCH_ABORTED"
+	// UndoNotPossible is reported when the last operation on an order cannot be reverted
+	UndoNotPossible ErrorCode = "UNDO_NOT_POSSIBLE"
+	// UndoForbidden is reported when someone other than the original operator tries to undo an operation
+	UndoForbidden ErrorCode = "UNDO_FORBIDDEN"
+	// IdempotencyKeyReused is reported when an Idempotency-Key is retried with a different request
+	IdempotencyKeyReused ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	// IdempotencyKeyInProgress is reported when an Idempotency-Key is retried before the first call finished
+	IdempotencyKeyInProgress ErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	ImportJobNotFound        ErrorCode = "IMPORT_JOB_NOT_FOUND"
+	// ImportJobNotCancellable is reported when cancelling an import job that has already stopped
+	// or is not run by this server
+	ImportJobNotCancellable ErrorCode = "IMPORT_JOB_NOT_CANCELLABLE"
+	// ImportQueueFull is reported when no more import jobs can be queued
+	ImportQueueFull ErrorCode = "IMPORT_QUEUE_FULL"
+	// AtomicNotSupported is reported for an atomic batch when the storage cannot roll back a partially applied batch
+	AtomicNotSupported ErrorCode = "ATOMIC_NOT_SUPPORTED"
+	// UserHasActiveOrders is reported when anonymizing a client whose orders are still at the pickup point
+	UserHasActiveOrders ErrorCode = "USER_HAS_ACTIVE_ORDERS"
 )
 
 // CodeFromError helps to extract code from application error common struct
@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"pvz-cli/pkg/clock"
+	"strings"
+	"time"
+)
+
+// ErrUnauthenticated is returned for credentials that are unknown, malformed, forged or expired.
+var ErrUnauthenticated = errors.New("invalid credentials")
+
+// Role is what an authenticated caller is allowed to do; every role includes the rights of the roles below it.
+type Role string
+
+const (
+	// RoleOperator works with orders and shipments at the pickup point
+	RoleOperator Role = "operator"
+	// RoleSupervisor additionally runs bulk imports and exports and manages the pickup-point calendar
+	RoleSupervisor Role = "supervisor"
+	// RoleAdmin additionally manages workers, personal data and consistency repairs
+	RoleAdmin Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleOperator:   1,
+	RoleSupervisor: 2,
+	RoleAdmin:      3,
+}
+
+// ParseRole returns the role with the given name
+func ParseRole(raw string) (Role, error) {
+	role := Role(strings.ToLower(strings.TrimSpace(raw)))
+	if _, ok := roleRanks[role]; !ok {
+		return "", fmt.Errorf("unknown role %q", raw)
+	}
+	return role, nil
+}
+
+// Allows reports whether a caller with role r may call a method that requires the given role
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRanks[r]
+	return ok && rank >= roleRanks[required]
+}
+
+// Principal is the authenticated operator making a call.
+type Principal struct {
+	OperatorID string
+	Role       Role
+}
+
+type principalCtxKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the authenticated caller.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, p)
+}
+
+// PrincipalFromContext returns the authenticated caller stored in ctx; ok is false for anonymous calls.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalCtxKey{}).(Principal)
+	return p, ok
+}
+
+// Authenticator resolves the credentials of a call to the operator making it.
+type Authenticator interface {
+	AuthenticateAPIKey(key string) (Principal, error)
+	AuthenticateJWT(token string) (Principal, error)
+}
+
+var _ Authenticator = (*LocalAuthenticator)(nil)
+
+// LocalAuthenticator checks credentials against locally configured API keys and an HS256 signing key.
+// Keys are held as SHA-256 digests, so a lookup does not compare the secret byte by byte.
+type LocalAuthenticator struct {
+	apiKeys   map[[sha256.Size]byte]Principal
+	jwtSecret []byte
+	clock     clock.Clock
+}
+
+// NewLocalAuthenticator creates an authenticator accepting the given API keys and JWTs signed with jwtSecret.
+// JWTs are rejected when jwtSecret is empty.
+func NewLocalAuthenticator(clk clock.Clock, apiKeys map[string]Principal, jwtSecret []byte) *LocalAuthenticator {
+	keys := make(map[[sha256.Size]byte]Principal, len(apiKeys))
+	for key, p := range apiKeys {
+		keys[sha256.Sum256([]byte(key))] = p
+	}
+	return &LocalAuthenticator{
+		apiKeys:   keys,
+		jwtSecret: jwtSecret,
+		clock:     clk,
+	}
+}
+
+// AuthenticateAPIKey returns the operator the API key was issued to
+func (a *LocalAuthenticator) AuthenticateAPIKey(key string) (Principal, error) {
+	p, ok := a.apiKeys[sha256.Sum256([]byte(key))]
+	if !ok || key == "" {
+		return Principal{}, ErrUnauthenticated
+	}
+	return p, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	ExpiresAt *int64 `json:"exp"`
+	NotBefore *int64 `json:"nbf"`
+}
+
+// AuthenticateJWT verifies an HS256 token and returns the operator named by its sub and role claims.
+// The exp claim is required, so a leaked token does not stay valid forever.
+func (a *LocalAuthenticator) AuthenticateJWT(token string) (Principal, error) {
+	if len(a.jwtSecret) == 0 {
+		return Principal{}, ErrUnauthenticated
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Principal{}, ErrUnauthenticated
+	}
+	enc := base64.RawURLEncoding
+	sig, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return Principal{}, ErrUnauthenticated
+	}
+	mac := hmac.New(sha256.New, a.jwtSecret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return Principal{}, ErrUnauthenticated
+	}
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return Principal{}, ErrUnauthenticated
+	}
+	now := a.clock.Now()
+	if claims.ExpiresAt == nil || !now.Before(time.Unix(*claims.ExpiresAt, 0)) {
+		return Principal{}, ErrUnauthenticated
+	}
+	if claims.NotBefore != nil && now.Before(time.Unix(*claims.NotBefore, 0)) {
+		return Principal{}, ErrUnauthenticated
+	}
+	role, err := ParseRole(claims.Role)
+	if err != nil || strings.TrimSpace(claims.Subject) == "" {
+		return Principal{}, ErrUnauthenticated
+	}
+	return Principal{OperatorID: claims.Subject, Role: role}, nil
+}
+
+func decodeSegment(raw string, dst any) error {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
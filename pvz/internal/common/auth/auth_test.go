@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"pvz-cli/pkg/clock"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var secret = []byte("jwt-secret")
+
+func signJWT(t *testing.T, key []byte, header, claims map[string]any) string {
+	t.Helper()
+	enc := base64.RawURLEncoding
+	h, err := json.Marshal(header)
+	require.NoError(t, err)
+	c, err := json.Marshal(claims)
+	require.NoError(t, err)
+	unsigned := enc.EncodeToString(h) + "." + enc.EncodeToString(c)
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + enc.EncodeToString(mac.Sum(nil))
+}
+
+// TestLocalAuthenticator_APIKey verifies that a configured key resolves to its operator and others are rejected.
+func TestLocalAuthenticator_APIKey(t *testing.T) {
+	t.Parallel()
+	want := Principal{OperatorID: "op-1", Role: RoleOperator}
+	a := NewLocalAuthenticator(&clock.FakeClock{}, map[string]Principal{"key-1": want}, nil)
+
+	p, err := a.AuthenticateAPIKey("key-1")
+	require.NoError(t, err)
+	require.Equal(t, want, p)
+
+	for _, key := range []string{"", "key-2"} {
+		_, err := a.AuthenticateAPIKey(key)
+		require.ErrorIs(t, err, ErrUnauthenticated)
+	}
+}
+
+// TestLocalAuthenticator_JWT verifies that a valid token resolves to its subject and role.
+func TestLocalAuthenticator_JWT(t *testing.T) {
+	t.Parallel()
+	clk := &clock.FakeClock{}
+	a := NewLocalAuthenticator(clk, nil, secret)
+	token := signJWT(t, secret, map[string]any{"alg": "HS256", "typ": "JWT"}, map[string]any{
+		"sub":  "sup-1",
+		"role": "supervisor",
+		"exp":  clk.After(time.Hour).Unix(),
+	})
+
+	p, err := a.AuthenticateJWT(token)
+	require.NoError(t, err)
+	require.Equal(t, Principal{OperatorID: "sup-1", Role: RoleSupervisor}, p)
+}
+
+// TestLocalAuthenticator_JWTRejects verifies that forged, expired, premature and incomplete tokens are rejected.
+func TestLocalAuthenticator_JWTRejects(t *testing.T) {
+	t.Parallel()
+	clk := &clock.FakeClock{}
+	hs256 := map[string]any{"alg": "HS256"}
+	valid := func() map[string]any {
+		return map[string]any{"sub": "op-1", "role": "operator", "exp": clk.After(time.Hour).Unix()}
+	}
+	with := func(key string, val any) map[string]any {
+		c := valid()
+		if val == nil {
+			delete(c, key)
+		} else {
+			c[key] = val
+		}
+		return c
+	}
+
+	cases := []struct {
+		name  string
+		token string
+	}{
+		{"malformed", "not-a-jwt"},
+		{"other key", signJWT(t, []byte("other"), hs256, valid())},
+		{"alg none", signJWT(t, secret, map[string]any{"alg": "none"}, valid())},
+		{"expired", signJWT(t, secret, hs256, with("exp", clk.Now().Unix()))},
+		{"no exp", signJWT(t, secret, hs256, with("exp", nil))},
+		{"not yet valid", signJWT(t, secret, hs256, with("nbf", clk.After(time.Minute).Unix()))},
+		{"unknown role", signJWT(t, secret, hs256, with("role", "root"))},
+		{"no subject", signJWT(t, secret, hs256, with("sub", nil))},
+	}
+	a := NewLocalAuthenticator(clk, nil, secret)
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := a.AuthenticateJWT(tc.token)
+			require.ErrorIs(t, err, ErrUnauthenticated)
+		})
+	}
+
+	_, err := NewLocalAuthenticator(clk, nil, nil).AuthenticateJWT(signJWT(t, nil, hs256, valid()))
+	require.ErrorIs(t, err, ErrUnauthenticated)
+}
+
+// TestRole_Allows verifies that higher roles include the rights of lower ones.
+func TestRole_Allows(t *testing.T) {
+	t.Parallel()
+	require.True(t, RoleAdmin.Allows(RoleOperator))
+	require.True(t, RoleSupervisor.Allows(RoleSupervisor))
+	require.False(t, RoleOperator.Allows(RoleSupervisor))
+	require.False(t, Role("").Allows(RoleOperator))
+}
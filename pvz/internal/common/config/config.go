@@ -1,14 +1,18 @@
 package config
 
 import (
+	"crypto/rand"
 	"flag"
 	"fmt"
 	"github.com/joho/godotenv"
 	"log/slog"
 	"os"
+	"pvz-cli/internal/common/auth"
 	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/models"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -19,6 +23,8 @@ const (
 // FileConfig holds the configuration for file-based storage, including the file path.
 type FileConfig struct {
 	Path string
+	// AuditPath is the NDJSON file the audit log is appended to, kept apart from the storage file
+	AuditPath string
 }
 
 // DBConfig holds the configuration for connecting to the database.
@@ -39,12 +45,79 @@ type OutboxConfig struct {
 	PollIntervalSec int
 }
 
+// StoragePolicyConfig holds the storage periods applied to orders accepted without an expiry date.
+type StoragePolicyConfig struct {
+	DefaultDays  int
+	PackageDays  map[string]int
+	CategoryDays map[string]int
+}
+
+// UndoConfig holds the settings of the undo operation.
+type UndoConfig struct {
+	// GracePeriod is how long after a transition its operator may still undo it
+	GracePeriod time.Duration
+	// CLIOperator identifies the operator working in the interactive CLI
+	CLIOperator string
+}
+
+// ArchiveConfig holds the settings of the archival job for finished orders.
+type ArchiveConfig struct {
+	// AfterDays is the age of the last status change after which a terminal order is archived; 0 disables archival
+	AfterDays int
+	// RetentionMonths is how long archived orders are kept before purging; 0 keeps them forever
+	RetentionMonths int
+	BatchSize       int
+	Interval        time.Duration
+}
+
+// IdempotencyConfig holds the settings of stored Idempotency-Key responses.
+type IdempotencyConfig struct {
+	// TTL is how long a response is replayed; afterwards the key may be used again and the record is purged
+	TTL           time.Duration
+	PurgeInterval time.Duration
+}
+
+// ImportJobsConfig holds the settings of background order imports.
+type ImportJobsConfig struct {
+	// Workers is how many jobs run at the same time; their orders are still accepted on the shared worker pool
+	Workers   int
+	QueueSize int
+	// ChunkSize is how many orders are imported between two progress updates
+	ChunkSize int
+	// InstanceID tells the jobs of this server from the ones of other servers sharing the storage
+	InstanceID string
+}
+
+// CursorConfig holds the key signing opaque page tokens of list endpoints.
+type CursorConfig struct {
+	// SigningKey is random per process when not configured, so tokens do not survive a restart
+	SigningKey []byte
+}
+
+// AuthConfig holds the credentials accepted by the gRPC servers and, through them, the HTTP gateway.
+type AuthConfig struct {
+	// Disabled lets anonymous calls through, with the operator taken from x-operator-id as before
+	Disabled bool
+	// APIKeys maps an API key to the operator it was issued to
+	APIKeys map[string]auth.Principal
+	// JWTSecret verifies HS256 tokens; tokens are rejected when it is empty
+	JWTSecret []byte
+}
+
 // Config represents the application configuration, supporting both file-based and database-based configurations.
 type Config struct {
 	File   *FileConfig
 	DB     *DBConfig
 	Kafka  *KafkaConfig
 	Outbox *OutboxConfig
+	// StoragePolicy is shared by both storage modes
+	StoragePolicy *StoragePolicyConfig
+	Undo          *UndoConfig
+	Archive       *ArchiveConfig
+	Cursor        *CursorConfig
+	ImportJobs    *ImportJobsConfig
+	Idempotency   *IdempotencyConfig
+	Auth          *AuthConfig
 }
 
 // Load initializes and returns the application configuration based on environment variables and flags.
@@ -71,6 +144,13 @@ func Load() *Config {
 		slog.Error("invalid storage mode", "mode", mode)
 		os.Exit(1)
 	}
+	cfg.StoragePolicy = loadStoragePolicyConfig()
+	cfg.Undo = loadUndoConfig()
+	cfg.Archive = loadArchiveConfig()
+	cfg.Cursor = loadCursorConfig()
+	cfg.ImportJobs = loadImportJobsConfig()
+	cfg.Idempotency = loadIdempotencyConfig()
+	cfg.Auth = loadAuthConfig()
 	return cfg
 }
 
@@ -109,7 +189,11 @@ func loadFileConfig() *FileConfig {
 	if path == "" {
 		path = constants.DefaultFileStoragePath
 	}
-	return &FileConfig{Path: path}
+	auditPath := strings.TrimSpace(os.Getenv("AUDIT_LOG_PATH"))
+	if auditPath == "" {
+		auditPath = constants.DefaultAuditLogPath
+	}
+	return &FileConfig{Path: path, AuditPath: auditPath}
 }
 
 func loadTestConfig() *Config {
@@ -129,6 +213,13 @@ func loadTestConfig() *Config {
 			MaxAttempts:     0,
 			RetryDelaySec:   0,
 			PollIntervalSec: 0},
+		StoragePolicy: loadStoragePolicyConfig(),
+		Undo:          loadUndoConfig(),
+		Archive:       &ArchiveConfig{},
+		Cursor:        &CursorConfig{SigningKey: []byte("test-page-token-key")},
+		ImportJobs:    loadImportJobsConfig(),
+		Idempotency:   loadIdempotencyConfig(),
+		Auth:          &AuthConfig{Disabled: true},
 	}
 }
 
@@ -147,6 +238,180 @@ func loadOutboxConfig() *OutboxConfig {
 		PollIntervalSec: atoiDef(os.Getenv("OUTBOX_POLL_INTERVAL_SEC"), 1),
 	}
 }
+func loadStoragePolicyConfig() *StoragePolicyConfig {
+	cfg := &StoragePolicyConfig{
+		DefaultDays: atoiDef(os.Getenv("STORAGE_POLICY_DEFAULT_DAYS"), constants.DefaultStorageDays),
+		PackageDays: parseDaysMap("STORAGE_POLICY_PACKAGE_DAYS"),
+		CategoryDays: map[string]int{
+			string(models.CategoryHazardous): int(constants.HazardousMaxStorage / (24 * time.Hour)),
+		},
+	}
+	for k, v := range parseDaysMap("STORAGE_POLICY_CATEGORY_DAYS") {
+		cfg.CategoryDays[k] = v
+	}
+	if cfg.DefaultDays <= 0 {
+		slog.Error("STORAGE_POLICY_DEFAULT_DAYS must be > 0", "value", cfg.DefaultDays)
+		os.Exit(1)
+	}
+	return cfg
+}
+
+func loadUndoConfig() *UndoConfig {
+	cfg := &UndoConfig{
+		GracePeriod: constants.DefaultUndoGracePeriod,
+		CLIOperator: firstNonEmpty(os.Getenv("PVZ_OPERATOR_ID"), constants.DefaultCLIOperator),
+	}
+	if raw := strings.TrimSpace(os.Getenv("UNDO_GRACE_PERIOD")); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 {
+			slog.Error("UNDO_GRACE_PERIOD must be a positive duration", "value", raw)
+			os.Exit(1)
+		}
+		cfg.GracePeriod = d
+	}
+	return cfg
+}
+
+func loadCursorConfig() *CursorConfig {
+	if key := strings.TrimSpace(os.Getenv("PAGE_TOKEN_SECRET")); key != "" {
+		return &CursorConfig{SigningKey: []byte(key)}
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		slog.Error("failed to generate page token key", "error", err)
+		os.Exit(1)
+	}
+	slog.Warn("PAGE_TOKEN_SECRET is not set, page tokens will be invalidated on restart")
+	return &CursorConfig{SigningKey: key}
+}
+
+func loadArchiveConfig() *ArchiveConfig {
+	cfg := &ArchiveConfig{
+		AfterDays:       atoiDef(os.Getenv("ARCHIVE_AFTER_DAYS"), constants.DefaultArchiveAfterDays),
+		RetentionMonths: atoiDef(os.Getenv("ARCHIVE_RETENTION_MONTHS"), constants.DefaultArchiveRetentionMonths),
+		BatchSize:       atoiDef(os.Getenv("ARCHIVE_BATCH_SIZE"), constants.DefaultArchiveBatchSize),
+		Interval:        constants.DefaultArchiveInterval,
+	}
+	if raw := strings.TrimSpace(os.Getenv("ARCHIVE_INTERVAL")); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 {
+			slog.Error("ARCHIVE_INTERVAL must be a positive duration", "value", raw)
+			os.Exit(1)
+		}
+		cfg.Interval = d
+	}
+	// Issued orders may still be returned by the client, so they must not be archived within the return window
+	if cfg.AfterDays != 0 && time.Duration(cfg.AfterDays)*24*time.Hour < constants.ReturnWindow {
+		slog.Error("ARCHIVE_AFTER_DAYS must not be shorter than the return window", "value", cfg.AfterDays)
+		os.Exit(1)
+	}
+	if cfg.AfterDays < 0 || cfg.RetentionMonths < 0 || cfg.BatchSize <= 0 {
+		slog.Error("invalid archive configuration",
+			"after_days", cfg.AfterDays, "retention_months", cfg.RetentionMonths, "batch_size", cfg.BatchSize)
+		os.Exit(1)
+	}
+	return cfg
+}
+
+func loadImportJobsConfig() *ImportJobsConfig {
+	cfg := &ImportJobsConfig{
+		Workers:    atoiDef(os.Getenv("IMPORT_JOB_WORKERS"), constants.DefaultImportJobWorkers),
+		QueueSize:  atoiDef(os.Getenv("IMPORT_JOB_QUEUE_SIZE"), constants.DefaultImportJobQueueSize),
+		ChunkSize:  atoiDef(os.Getenv("IMPORT_JOB_CHUNK_SIZE"), constants.DefaultImportJobChunkSize),
+		InstanceID: os.Getenv("INSTANCE_ID"),
+	}
+	if cfg.InstanceID == "" {
+		cfg.InstanceID, _ = os.Hostname()
+	}
+	if cfg.Workers <= 0 || cfg.QueueSize <= 0 || cfg.ChunkSize <= 0 {
+		slog.Error("invalid import job configuration",
+			"workers", cfg.Workers, "queue_size", cfg.QueueSize, "chunk_size", cfg.ChunkSize)
+		os.Exit(1)
+	}
+	return cfg
+}
+
+func loadIdempotencyConfig() *IdempotencyConfig {
+	cfg := &IdempotencyConfig{
+		TTL:           constants.DefaultIdempotencyKeyTTL,
+		PurgeInterval: constants.DefaultIdempotencyPurgeInterval,
+	}
+	for env, d := range map[string]*time.Duration{
+		"IDEMPOTENCY_KEY_TTL":        &cfg.TTL,
+		"IDEMPOTENCY_PURGE_INTERVAL": &cfg.PurgeInterval,
+	} {
+		raw := strings.TrimSpace(os.Getenv(env))
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			slog.Error(env+" must be a positive duration", "value", raw)
+			os.Exit(1)
+		}
+		*d = parsed
+	}
+	return cfg
+}
+
+func loadAuthConfig() *AuthConfig {
+	if disabled, _ := strconv.ParseBool(strings.TrimSpace(os.Getenv("AUTH_DISABLED"))); disabled {
+		slog.Warn("AUTH_DISABLED is set, the gRPC servers and the HTTP gateway accept anonymous calls")
+		return &AuthConfig{Disabled: true}
+	}
+	cfg := &AuthConfig{
+		APIKeys: parseAPIKeys("AUTH_API_KEYS"),
+	}
+	if secret := strings.TrimSpace(os.Getenv("AUTH_JWT_SECRET")); secret != "" {
+		cfg.JWTSecret = []byte(secret)
+	}
+	if len(cfg.APIKeys) == 0 && len(cfg.JWTSecret) == 0 {
+		slog.Error("AUTH_API_KEYS or AUTH_JWT_SECRET must be set unless AUTH_DISABLED=true")
+		os.Exit(1)
+	}
+	return cfg
+}
+
+// parseAPIKeys parses a "key=operator-id:role,key=operator-id:role" environment variable
+func parseAPIKeys(env string) map[string]auth.Principal {
+	res := make(map[string]auth.Principal)
+	raw := strings.TrimSpace(os.Getenv(env))
+	if raw == "" {
+		return res
+	}
+	for _, entry := range strings.Split(raw, ",") {
+		key, owner, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		operatorID, rawRole, ok2 := strings.Cut(owner, ":")
+		role, err := auth.ParseRole(rawRole)
+		if !ok || !ok2 || err != nil || key == "" || strings.TrimSpace(operatorID) == "" {
+			// the key itself is a secret and is not logged
+			slog.Error("invalid API key entry, expected key=operator-id:role", "env", env, "operator", operatorID)
+			os.Exit(1)
+		}
+		res[key] = auth.Principal{OperatorID: strings.TrimSpace(operatorID), Role: role}
+	}
+	return res
+}
+
+// parseDaysMap parses a "name=days,name=days" environment variable
+func parseDaysMap(env string) map[string]int {
+	res := make(map[string]int)
+	raw := strings.TrimSpace(os.Getenv(env))
+	if raw == "" {
+		return res
+	}
+	for _, pair := range strings.Split(raw, ",") {
+		name, days, ok := strings.Cut(pair, "=")
+		n, err := strconv.Atoi(strings.TrimSpace(days))
+		if !ok || err != nil || n <= 0 || strings.TrimSpace(name) == "" {
+			slog.Error("invalid storage policy entry, expected name=days", "env", env, "value", pair)
+			os.Exit(1)
+		}
+		res[strings.ToLower(strings.TrimSpace(name))] = n
+	}
+	return res
+}
+
 func validateKafkaOutbox(cfg *Config) {
 	if len(cfg.Kafka.Brokers) == 0 || strings.TrimSpace(cfg.Kafka.Brokers[0]) == "" {
 		slog.Error("KAFKA_BROKERS must be set when STORAGE_MODE=db")
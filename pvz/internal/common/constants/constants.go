@@ -8,21 +8,64 @@ const (
 	DefaultLimit        = 20
 	DefaultHistoryPage  = 1
 	DefaultHistoryLimit = 1000
+
+	DefaultAuditPage  = 1
+	DefaultAuditLimit = 100
+	MaxAuditLimit     = 1000
+
+	// OrderHistoryPreview is how many of the latest history entries a single-order lookup returns
+	OrderHistoryPreview = 10
 	ReturnWindow        = 48 * time.Hour
-	TimeLayout          = "2006-01-02"
-	HistoryTimeLayout   = "2006-01-02 15:04:05"
-	ActionIssue         = "issue"
-	ActionReturn        = "return"
+	HazardousMaxStorage = 72 * time.Hour
+	HazardousMaxWeight  = 20
+	DefaultStorageDays  = 7
+	// DefaultUndoGracePeriod is how long an operator may undo their last operation on an order
+	DefaultUndoGracePeriod = 5 * time.Minute
+	DefaultCLIOperator     = "cli"
+	// Archival of finished orders: age before archiving, retention of the archive, batch size and job period
+	DefaultArchiveAfterDays       = 30
+	DefaultArchiveRetentionMonths = 12
+	DefaultArchiveBatchSize       = 500
+	DefaultArchiveInterval        = time.Hour
+	// Idempotency keys: how long a stored response is replayed and how often expired keys are purged
+	DefaultIdempotencyKeyTTL        = 24 * time.Hour
+	DefaultIdempotencyPurgeInterval = time.Hour
+	// DefaultImportChunkSize is how many orders of an import file are sent to ImportOrders at once
+	DefaultImportChunkSize = 500
+	// Background import jobs: concurrently running jobs, jobs waiting in the queue, orders saved per progress update
+	// and how often a watched job is polled for progress
+	DefaultImportJobWorkers   = 1
+	DefaultImportJobQueueSize = 16
+	DefaultImportJobChunkSize = 100
+	ImportJobPollInterval     = 500 * time.Millisecond
+
+	TimeLayout        = "2006-01-02"
+	HistoryTimeLayout = "2006-01-02 15:04:05"
+	ClockLayout       = "15:04"
+	ActionIssue       = "issue"
+	ActionReturn      = "return"
 
 	CmdHelp         = "help"
 	CmdAcceptOrder  = "accept-order"
 	CmdReturnOrder  = "return-order"
 	CmdProcess      = "process-orders"
+	CmdIssueReady   = "issue-ready"
+	CmdUndo         = "undo"
 	CmdListOrders   = "list-orders"
 	CmdListReturns  = "list-returns"
+	CmdSearchOrders = "search-orders"
+	CmdGetOrder     = "get-order"
+	CmdExport       = "export"
 	CmdOrderHistory = "order-history"
 	CmdImportOrders = "import-orders"
+	CmdImportStatus = "import-status"
+	CmdImportFails  = "import-failures"
+	CmdImportCancel = "import-cancel"
 	CmdScrollOrders = "scroll-orders"
+	CmdRegisterShip = "register-shipment"
+	CmdHandOverShip = "handover-shipment"
+	CmdShipStatus   = "shipment-status"
+	CmdFsck         = "fsck"
 	CmdNext         = "next"
 	CmdExit         = "exit"
 
@@ -33,6 +76,7 @@ const (
 	DefaultBurst = 10
 
 	DefaultFileStoragePath = "./storage.json"
+	DefaultAuditLogPath    = "./audit.ndjson"
 	DefaultPGHost          = "localhost"
 	DefaultPGPort          = "5433"
 
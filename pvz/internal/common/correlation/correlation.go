@@ -0,0 +1,16 @@
+package correlation
+
+import "context"
+
+type correlationCtxKey struct{}
+
+// WithID returns a copy of ctx carrying the correlation ID of the request being served.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationCtxKey{}, id)
+}
+
+// IDFromContext returns the correlation ID stored in ctx, or an empty string when the call has none.
+func IDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationCtxKey{}).(string)
+	return id
+}
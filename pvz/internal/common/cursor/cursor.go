@@ -0,0 +1,74 @@
+package cursor
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidToken is returned for page tokens that are malformed, tampered with or issued for another listing.
+var ErrInvalidToken = errors.New("invalid page token")
+
+// Codec turns keyset positions into opaque page tokens and back.
+// The kind names the listing a token belongs to, so a token of one listing is rejected by another.
+type Codec interface {
+	Encode(kind string, pos any) (string, error)
+	Decode(kind, token string, pos any) error
+}
+
+var _ Codec = (*HMACCodec)(nil)
+
+// HMACCodec encodes positions as base64url JSON signed with HMAC-SHA256.
+type HMACCodec struct {
+	key []byte
+}
+
+// NewHMACCodec creates a codec signing tokens with the given key
+func NewHMACCodec(key []byte) *HMACCodec {
+	return &HMACCodec{key: key}
+}
+
+// Encode serializes pos and signs it together with kind
+func (c *HMACCodec) Encode(kind string, pos any) (string, error) {
+	payload, err := json.Marshal(pos)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(payload) + "." + enc.EncodeToString(c.sign(kind, payload)), nil
+}
+
+// Decode verifies the token signature for kind and unmarshals its position into pos
+func (c *HMACCodec) Decode(kind, token string, pos any) error {
+	rawPayload, rawSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return ErrInvalidToken
+	}
+	enc := base64.RawURLEncoding
+	payload, err := enc.DecodeString(rawPayload)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	sig, err := enc.DecodeString(rawSig)
+	if err != nil || !hmac.Equal(sig, c.sign(kind, payload)) {
+		return ErrInvalidToken
+	}
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(pos); err != nil {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+func (c *HMACCodec) sign(kind string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(kind))
+	mac.Write([]byte{0})
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
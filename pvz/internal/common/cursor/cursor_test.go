@@ -0,0 +1,56 @@
+package cursor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type position struct {
+	ID uint64 `json:"i"`
+}
+
+// TestHMACCodec_RoundTrip verifies that an encoded position decodes back unchanged.
+func TestHMACCodec_RoundTrip(t *testing.T) {
+	t.Parallel()
+	c := NewHMACCodec([]byte("secret"))
+	token, err := c.Encode("orders", position{ID: 42})
+	require.NoError(t, err)
+
+	var got position
+	require.NoError(t, c.Decode("orders", token, &got))
+	require.Equal(t, uint64(42), got.ID)
+}
+
+// TestHMACCodec_Rejects verifies that foreign, tampered and malformed tokens are rejected.
+func TestHMACCodec_Rejects(t *testing.T) {
+	t.Parallel()
+	c := NewHMACCodec([]byte("secret"))
+	token, err := c.Encode("orders", position{ID: 42})
+	require.NoError(t, err)
+	payload, sig, _ := strings.Cut(token, ".")
+	forged, err := NewHMACCodec([]byte("other")).Encode("orders", position{ID: 43})
+	require.NoError(t, err)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	cases := []struct {
+		name  string
+		kind  string
+		token string
+	}{
+		{name: "other kind", kind: "history", token: token},
+		{name: "other key", kind: "orders", token: forged},
+		{name: "swapped payload", kind: "orders", token: forgedPayload + "." + sig},
+		{name: "no signature", kind: "orders", token: payload},
+		{name: "garbage", kind: "orders", token: "not-a-token"},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var got position
+			require.ErrorIs(t, c.Decode(tc.kind, tc.token, &got), ErrInvalidToken)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package operator
+
+import "context"
+
+type operatorCtxKey struct{}
+
+// WithID returns a copy of ctx carrying the identity of the pickup-point operator performing the call.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, operatorCtxKey{}, id)
+}
+
+// IDFromContext returns the operator identity stored in ctx, or an empty string when the caller is anonymous.
+func IDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(operatorCtxKey{}).(string)
+	return id
+}
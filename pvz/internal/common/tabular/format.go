@@ -0,0 +1,43 @@
+// Package tabular writes flat records as CSV or newline-delimited JSON (NDJSON).
+package tabular
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Format is a text encoding of a sequence of flat records
+type Format string
+
+// Supported formats
+const (
+	CSV    Format = "csv"
+	NDJSON Format = "ndjson"
+)
+
+// ErrUnknownFormat is returned for a format name other than csv or ndjson
+var ErrUnknownFormat = errors.New("unknown format")
+
+// ParseFormat converts a case-insensitive format name into a Format
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(s))); f {
+	case CSV, NDJSON:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%w %q, expected csv or ndjson", ErrUnknownFormat, s)
+	}
+}
+
+// ContentType returns the MIME type of documents in the format
+func (f Format) ContentType() string {
+	if f == NDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Extension returns the usual file name extension of the format, with the leading dot
+func (f Format) Extension() string {
+	return "." + string(f)
+}
@@ -0,0 +1,145 @@
+package tabular
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Writer writes records with a fixed set of columns one at a time; output is buffered until Flush
+type Writer interface {
+	// Write encodes one record; values must be given in the order of the columns
+	Write(values []any) error
+	// Flush writes any buffered data to the underlying writer
+	Flush() error
+}
+
+// NewWriter creates a Writer of the given format; a CSV writer emits the header row first
+func NewWriter(f Format, w io.Writer, columns []string) (Writer, error) {
+	switch f {
+	case CSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(columns); err != nil {
+			return nil, err
+		}
+		return &csvWriter{w: cw, columns: len(columns)}, nil
+	case NDJSON:
+		keys := make([][]byte, 0, len(columns))
+		for _, c := range columns {
+			key, err := json.Marshal(c)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+		}
+		return &ndjsonWriter{w: bufio.NewWriter(w), keys: keys}, nil
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownFormat, f)
+	}
+}
+
+type csvWriter struct {
+	w       *csv.Writer
+	columns int
+	record  []string
+}
+
+func (c *csvWriter) Write(values []any) error {
+	if len(values) != c.columns {
+		return fmt.Errorf("record has %d values, expected %d", len(values), c.columns)
+	}
+	c.record = c.record[:0]
+	for _, v := range values {
+		c.record = append(c.record, formatValue(v))
+	}
+	return c.w.Write(c.record)
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// ndjsonWriter writes every record as a JSON object with keys in column order
+type ndjsonWriter struct {
+	w    *bufio.Writer
+	keys [][]byte
+}
+
+func (n *ndjsonWriter) Write(values []any) error {
+	if len(values) != len(n.keys) {
+		return fmt.Errorf("record has %d values, expected %d", len(values), len(n.keys))
+	}
+	_ = n.w.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			_ = n.w.WriteByte(',')
+		}
+		_, _ = n.w.Write(n.keys[i])
+		_ = n.w.WriteByte(':')
+		raw, err := json.Marshal(jsonValue(v))
+		if err != nil {
+			return err
+		}
+		_, _ = n.w.Write(raw)
+	}
+	_, err := n.w.WriteString("}\n")
+	return err
+}
+
+func (n *ndjsonWriter) Flush() error {
+	return n.w.Flush()
+}
+
+// formatValue renders a value as a CSV cell; times use RFC 3339 with fractional seconds, nil is an empty cell
+func formatValue(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case time.Time:
+		return x.Format(time.RFC3339Nano)
+	case *time.Time:
+		if x == nil {
+			return ""
+		}
+		return x.Format(time.RFC3339Nano)
+	case float32:
+		return strconv.FormatFloat(float64(x), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(x)
+	case json.RawMessage:
+		return string(x)
+	case fmt.Stringer:
+		return x.String()
+	default:
+		return fmt.Sprint(x)
+	}
+}
+
+// jsonValue converts values that have no natural JSON form into the same text used in CSV
+func jsonValue(v any) any {
+	switch x := v.(type) {
+	case *time.Time:
+		if x == nil {
+			return nil
+		}
+		return x.Format(time.RFC3339Nano)
+	case time.Time:
+		return x.Format(time.RFC3339Nano)
+	case json.RawMessage:
+		// embedded as is; it would be quoted as text by the Stringer case
+		return x
+	case fmt.Stringer:
+		return x.String()
+	default:
+		return v
+	}
+}
@@ -0,0 +1,61 @@
+package tabular
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type status int
+
+func (status) String() string { return "ACCEPTED" }
+
+// TestNewWriter verifies that both formats encode the same record with columns in order.
+func TestNewWriter(t *testing.T) {
+	t.Parallel()
+	at := time.Date(2025, 7, 1, 12, 30, 0, 0, time.UTC)
+	columns := []string{"order_id", "status", "note", "price", "fragile", "expires_at", "deadline"}
+	values := []any{uint64(7), status(1), `say "hi", bye`, float32(12.5), true, at, (*time.Time)(nil)}
+
+	cases := []struct {
+		format Format
+		want   string
+	}{
+		{
+			format: CSV,
+			want: "order_id,status,note,price,fragile,expires_at,deadline\n" +
+				`7,ACCEPTED,"say ""hi"", bye",12.5,true,2025-07-01T12:30:00Z,` + "\n",
+		},
+		{
+			format: NDJSON,
+			want: `{"order_id":7,"status":"ACCEPTED","note":"say \"hi\", bye","price":12.5,"fragile":true,` +
+				`"expires_at":"2025-07-01T12:30:00Z","deadline":null}` + "\n",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(string(tc.format), func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			w, err := NewWriter(tc.format, &buf, columns)
+			require.NoError(t, err)
+			require.NoError(t, w.Write(values))
+			require.Error(t, w.Write(values[:2]))
+			require.NoError(t, w.Flush())
+			require.Equal(t, tc.want, buf.String())
+		})
+	}
+}
+
+// TestParseFormat verifies that format names are case-insensitive and unknown ones are rejected.
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+	f, err := ParseFormat(" NDJSON ")
+	require.NoError(t, err)
+	require.Equal(t, NDJSON, f)
+	require.Equal(t, "application/x-ndjson", f.ContentType())
+
+	_, err = ParseFormat("xlsx")
+	require.ErrorIs(t, err, ErrUnknownFormat)
+}
@@ -1,17 +1,135 @@
 package utils
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"pvz-cli/internal/cli/params"
 	"pvz-cli/internal/common/apperrors"
+	"strconv"
 	"strings"
 )
 
-// ParseOrdersFromFile reads and parses order data from JSON file into order requests
-func ParseOrdersFromFile(filePath string) ([]params.AcceptOrderParams, error) {
+// OrderFileFormat is the encoding of an order import file
+type OrderFileFormat string
+
+// Supported order file formats
+const (
+	OrderFileJSON   OrderFileFormat = "json"
+	OrderFileNDJSON OrderFileFormat = "ndjson"
+	OrderFileCSV    OrderFileFormat = "csv"
+)
+
+// Order fields that can be read from a CSV column
+const (
+	OrderFieldOrderID       = "order_id"
+	OrderFieldUserID        = "user_id"
+	OrderFieldExpiresAt     = "expires_at"
+	OrderFieldWeight        = "weight"
+	OrderFieldPrice         = "price"
+	OrderFieldPackage       = "package"
+	OrderFieldFragile       = "fragile"
+	OrderFieldHazardous     = "hazardous"
+	OrderFieldAgeRestricted = "age_restricted"
+)
+
+var (
+	orderFields = []string{
+		OrderFieldOrderID, OrderFieldUserID, OrderFieldExpiresAt, OrderFieldWeight, OrderFieldPrice,
+		OrderFieldPackage, OrderFieldFragile, OrderFieldHazardous, OrderFieldAgeRestricted,
+	}
+	requiredOrderFields = []string{OrderFieldOrderID, OrderFieldUserID, OrderFieldWeight, OrderFieldPrice}
+)
+
+// DetectOrderFileFormat returns the explicitly requested format, or the one matching the file extension.
+// Files with an unknown extension are read as JSON, the original import format.
+func DetectOrderFileFormat(filePath, explicit string) (OrderFileFormat, error) {
+	if raw := strings.ToLower(strings.TrimSpace(explicit)); raw != "" {
+		switch f := OrderFileFormat(raw); f {
+		case OrderFileJSON, OrderFileNDJSON, OrderFileCSV:
+			return f, nil
+		default:
+			return "", apperrors.Newf(apperrors.ValidationFailed, "unknown import format %q, expected json, ndjson or csv", explicit)
+		}
+	}
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".csv":
+		return OrderFileCSV, nil
+	case ".ndjson", ".jsonl":
+		return OrderFileNDJSON, nil
+	default:
+		return OrderFileJSON, nil
+	}
+}
+
+// CSVColumns maps order fields to the CSV header names they are read from.
+// Fields without an entry are read from a column named like the field itself.
+type CSVColumns map[string]string
+
+// ParseCSVColumns parses a column mapping of the form "order_id=ID,user_id=Customer"
+func ParseCSVColumns(spec string) (CSVColumns, error) {
+	columns := CSVColumns{}
+	if strings.TrimSpace(spec) == "" {
+		return columns, nil
+	}
+	for _, pair := range strings.Split(spec, ",") {
+		field, column, ok := strings.Cut(pair, "=")
+		field = strings.ToLower(strings.TrimSpace(field))
+		column = strings.TrimSpace(column)
+		if !ok || column == "" {
+			return nil, apperrors.Newf(apperrors.ValidationFailed, "invalid column mapping %q, expected field=column", pair)
+		}
+		if !isOrderField(field) {
+			return nil, apperrors.Newf(apperrors.ValidationFailed, "unknown order field %q in column mapping", field)
+		}
+		columns[field] = column
+	}
+	return columns, nil
+}
+
+func isOrderField(field string) bool {
+	for _, f := range orderFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderRecord is one order read from an import file.
+// Err is set when the record itself is malformed; the rest of the file can still be read.
+type OrderRecord struct {
+	// Number is the 1-based position of the order in the file, not counting the CSV header
+	Number int
+	Params params.AcceptOrderParams
+	Err    error
+}
+
+// OrderFileReader reads orders from an import file one at a time, so files of any size are read in constant memory
+type OrderFileReader struct {
+	file   *os.File
+	next   func() (params.AcceptOrderParams, error)
+	number int
+}
+
+// badRecordError marks a malformed record that does not stop reading the file
+type badRecordError struct {
+	err error
+}
+
+func (e badRecordError) Error() string {
+	return e.err.Error()
+}
+
+// OpenOrderFile opens an order import file in the given format.
+// columns is used for CSV files only and may be nil.
+func OpenOrderFile(filePath string, format OrderFileFormat, columns CSVColumns) (*OrderFileReader, error) {
 	if err := validateFilePath(filePath); err != nil {
 		return nil, err
 	}
@@ -21,19 +139,173 @@ func ParseOrdersFromFile(filePath string) ([]params.AcceptOrderParams, error) {
 	if err != nil {
 		return nil, apperrors.Newf(apperrors.InternalError, "cannot open file %q: %v", filePath, err)
 	}
-	defer func() {
-		err := f.Close()
-		if err != nil {
-			apperrors.Handle(apperrors.Newf(apperrors.InternalError, "cannot close file %q: %v", filePath, err))
+	r := &OrderFileReader{file: f}
+	switch format {
+	case OrderFileJSON:
+		r.next, err = jsonArrayOrders(f)
+	case OrderFileNDJSON:
+		r.next = ndjsonOrders(f)
+	case OrderFileCSV:
+		r.next, err = csvOrders(f, columns)
+	default:
+		err = apperrors.Newf(apperrors.ValidationFailed, "unknown import format %q", format)
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// Next returns the next order of the file, or io.EOF after the last one.
+// Any other error means the file cannot be read further.
+func (r *OrderFileReader) Next() (OrderRecord, error) {
+	p, err := r.next()
+	if errors.Is(err, io.EOF) {
+		return OrderRecord{}, io.EOF
+	}
+	r.number++
+	var bad badRecordError
+	if errors.As(err, &bad) {
+		return OrderRecord{Number: r.number, Err: apperrors.Newf(apperrors.ValidationFailed, "%v", bad.err)}, nil
+	}
+	if err != nil {
+		return OrderRecord{}, err
+	}
+	return OrderRecord{Number: r.number, Params: p}, nil
+}
+
+// Close closes the underlying file
+func (r *OrderFileReader) Close() error {
+	return r.file.Close()
+}
+
+// jsonArrayOrders reads the elements of a JSON array one by one instead of decoding the whole array
+func jsonArrayOrders(r io.Reader) (func() (params.AcceptOrderParams, error), error) {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, apperrors.Newf(apperrors.ValidationFailed, "invalid JSON: expected an array of orders")
+	}
+	return func() (params.AcceptOrderParams, error) {
+		if !dec.More() {
+			if _, err := dec.Token(); err != nil {
+				return params.AcceptOrderParams{}, apperrors.Newf(apperrors.ValidationFailed, "invalid JSON: %v", err)
+			}
+			return params.AcceptOrderParams{}, io.EOF
+		}
+		var p params.AcceptOrderParams
+		if err := dec.Decode(&p); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) {
+				// the decoder has consumed the whole element, so the next one can still be read
+				return params.AcceptOrderParams{}, badRecordError{err: err}
+			}
+			return params.AcceptOrderParams{}, apperrors.Newf(apperrors.ValidationFailed, "invalid JSON: %v", err)
 		}
-	}()
+		return p, nil
+	}, nil
+}
 
-	var orders []params.AcceptOrderParams
-	if err := json.NewDecoder(f).Decode(&orders); err != nil {
-		return nil, apperrors.Newf(apperrors.ValidationFailed, "invalid JSON: %v", err)
+// ndjsonOrders reads one JSON object per line; blank lines are skipped
+func ndjsonOrders(r io.Reader) func() (params.AcceptOrderParams, error) {
+	br := bufio.NewReader(r)
+	return func() (params.AcceptOrderParams, error) {
+		for {
+			line, err := br.ReadBytes('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				return params.AcceptOrderParams{}, apperrors.Newf(apperrors.InternalError, "cannot read file: %v", err)
+			}
+			line = bytes.TrimSpace(line)
+			if len(line) == 0 {
+				if errors.Is(err, io.EOF) {
+					return params.AcceptOrderParams{}, io.EOF
+				}
+				continue
+			}
+			var p params.AcceptOrderParams
+			if jsonErr := json.Unmarshal(line, &p); jsonErr != nil {
+				return params.AcceptOrderParams{}, badRecordError{err: fmt.Errorf("invalid JSON: %v", jsonErr)}
+			}
+			return p, nil
+		}
 	}
+}
 
-	return orders, nil
+// csvOrders reads the header first and then maps every row to an order through columns
+func csvOrders(r io.Reader, columns CSVColumns) (func() (params.AcceptOrderParams, error), error) {
+	cr := csv.NewReader(bufio.NewReader(r))
+	cr.ReuseRecord = true
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, apperrors.Newf(apperrors.ValidationFailed, "invalid CSV header: %v", err)
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	index := make(map[string]int, len(orderFields))
+	for _, field := range orderFields {
+		column := field
+		if c, ok := columns[field]; ok {
+			column = c
+		}
+		if i, ok := positions[strings.ToLower(column)]; ok {
+			index[field] = i
+		}
+	}
+	for _, field := range requiredOrderFields {
+		if _, ok := index[field]; !ok {
+			return nil, apperrors.Newf(apperrors.ValidationFailed, "CSV header has no column for %s", field)
+		}
+	}
+
+	return func() (params.AcceptOrderParams, error) {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return params.AcceptOrderParams{}, io.EOF
+		}
+		if errors.Is(err, csv.ErrFieldCount) {
+			return params.AcceptOrderParams{}, badRecordError{err: err}
+		}
+		if err != nil {
+			return params.AcceptOrderParams{}, apperrors.Newf(apperrors.ValidationFailed, "invalid CSV: %v", err)
+		}
+		value := func(field string) string {
+			if i, ok := index[field]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		p := params.AcceptOrderParams{
+			OrderID:   value(OrderFieldOrderID),
+			UserID:    value(OrderFieldUserID),
+			ExpiresAt: value(OrderFieldExpiresAt),
+			Weight:    value(OrderFieldWeight),
+			Price:     value(OrderFieldPrice),
+			Package:   value(OrderFieldPackage),
+		}
+		for field, dst := range map[string]*bool{
+			OrderFieldFragile:       &p.Fragile,
+			OrderFieldHazardous:     &p.Hazardous,
+			OrderFieldAgeRestricted: &p.AgeRestricted,
+		} {
+			raw := value(field)
+			if raw == "" {
+				continue
+			}
+			b, err := strconv.ParseBool(raw)
+			if err != nil {
+				return params.AcceptOrderParams{}, badRecordError{err: fmt.Errorf("invalid %s value %q", field, raw)}
+			}
+			*dst = b
+		}
+		return p, nil
+	}, nil
 }
 
 func validateFilePath(filePath string) error {
@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// readOrderFile writes content to a temporary file and reads all of its records
+func readOrderFile(t *testing.T, name, content string, format OrderFileFormat, columns CSVColumns) []OrderRecord {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	r, err := OpenOrderFile(path, format, columns)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, r.Close()) }()
+
+	var records []OrderRecord
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, rec)
+	}
+}
+
+// TestOpenOrderFile verifies that every format yields the same orders and keeps reading past a malformed record.
+func TestOpenOrderFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("JSON array", func(t *testing.T) {
+		t.Parallel()
+		records := readOrderFile(t, "orders.json",
+			`[{"order_id":"1","user_id":"10","weight":"1","price":"5","package":"box"},`+
+				`{"order_id":2,"user_id":"10"},`+
+				`{"order_id":"3","user_id":"11","weight":"2","price":"7","fragile":true}]`,
+			OrderFileJSON, nil)
+
+		require.Len(t, records, 3)
+		require.Equal(t, "1", records[0].Params.OrderID)
+		require.Equal(t, "box", records[0].Params.Package)
+		require.Error(t, records[1].Err)
+		require.Equal(t, 3, records[2].Number)
+		require.True(t, records[2].Params.Fragile)
+	})
+
+	t.Run("NDJSON", func(t *testing.T) {
+		t.Parallel()
+		records := readOrderFile(t, "orders.ndjson",
+			"{\"order_id\":\"1\",\"user_id\":\"10\",\"weight\":\"1\",\"price\":\"5\"}\n"+
+				"\n"+
+				"{broken\n"+
+				"{\"order_id\":\"3\",\"user_id\":\"11\",\"weight\":\"2\",\"price\":\"7\"}",
+			OrderFileNDJSON, nil)
+
+		require.Len(t, records, 3)
+		require.NoError(t, records[0].Err)
+		require.Error(t, records[1].Err)
+		require.Equal(t, 2, records[1].Number)
+		require.Equal(t, "3", records[2].Params.OrderID)
+	})
+
+	t.Run("CSV with column mapping", func(t *testing.T) {
+		t.Parallel()
+		columns, err := ParseCSVColumns("order_id=ID, user_id=Customer,price=Cost")
+		require.NoError(t, err)
+		records := readOrderFile(t, "orders.csv",
+			"\ufeffID,Customer,weight,Cost,package,fragile,comment\n"+
+				"1,10,1.5,100,box,true,first\n"+
+				"2,10,1,50,bag,maybe,second\n"+
+				"3,11\n",
+			OrderFileCSV, columns)
+
+		require.Len(t, records, 3)
+		require.NoError(t, records[0].Err)
+		require.Equal(t, "1", records[0].Params.OrderID)
+		require.Equal(t, "10", records[0].Params.UserID)
+		require.Equal(t, "100", records[0].Params.Price)
+		require.True(t, records[0].Params.Fragile)
+		require.Error(t, records[1].Err)
+		require.Error(t, records[2].Err)
+	})
+
+	t.Run("CSV without a required column", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "orders.csv")
+		require.NoError(t, os.WriteFile(path, []byte("order_id,user_id,weight\n1,10,1\n"), 0o600))
+
+		_, err := OpenOrderFile(path, OrderFileCSV, nil)
+		require.ErrorContains(t, err, "price")
+	})
+}
+
+// TestDetectOrderFileFormat verifies that an explicit format wins over the file extension.
+func TestDetectOrderFileFormat(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		path, explicit string
+		want           OrderFileFormat
+	}{
+		{path: "orders.csv", want: OrderFileCSV},
+		{path: "orders.jsonl", want: OrderFileNDJSON},
+		{path: "orders.json", want: OrderFileJSON},
+		{path: "orders.txt", explicit: "CSV", want: OrderFileCSV},
+	}
+	for _, tc := range cases {
+		got, err := DetectOrderFileFormat(tc.path, tc.explicit)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got, tc.path)
+	}
+	_, err := DetectOrderFileFormat("orders.csv", "xml")
+	require.Error(t, err)
+}
@@ -0,0 +1,54 @@
+package queries
+
+const (
+	// ArchiveOrdersSQL moves up to $3 terminal orders (issued with status $1 or soft-deleted) whose status
+	// last changed before $2 into orders_archive, together with their order_history rows, stamping them with $4.
+	// Locked rows are skipped so the job never blocks live traffic.
+	ArchiveOrdersSQL = `
+with candidates as (
+	select id
+	from orders
+	where (is_deleted or status = $1) and updated_status_at < $2
+	order by updated_status_at
+	limit $3
+	for update skip locked
+), moved_orders as (
+	delete from orders o
+	using candidates c
+	where o.id = c.id
+	returning o.id, o.user_id, o.status, o.created_at, o.expires_at, o.updated_status_at, o.package, o.weight,
+		o.price, o.fragile, o.hazardous, o.age_restricted, o.storage_policy, o.version, o.is_deleted
+), moved_history as (
+	delete from order_history h
+	using moved_orders m
+	where h.order_id = m.id
+	returning h.id, h.order_id, h.event, h.timestamp, h.operator_id, h.actor_type, h.actor_id,
+		h.previous_status, h.new_status, h.correlation_id, h.metadata
+), archived_history as (
+	insert into order_history_archive (
+		id, order_id, event, timestamp, operator_id, actor_type, actor_id,
+		previous_status, new_status, correlation_id, metadata, archived_at
+	)
+	select id, order_id, event, timestamp, operator_id, actor_type, actor_id,
+		previous_status, new_status, correlation_id, metadata, $4
+	from moved_history
+)
+insert into orders_archive (
+	id, user_id, status, created_at, expires_at, updated_status_at, package, weight,
+	price, fragile, hazardous, age_restricted, storage_policy, version, is_deleted, archived_at
+)
+select id, user_id, status, created_at, expires_at, updated_status_at, package, weight,
+	price, fragile, hazardous, age_restricted, storage_policy, version, is_deleted, $4
+from moved_orders;
+`
+
+	// PurgeArchiveSQL removes archived orders and history archived before $1.
+	PurgeArchiveSQL = `
+with purged_history as (
+	delete from order_history_archive
+	where archived_at < $1
+)
+delete from orders_archive
+where archived_at < $1;
+`
+)
@@ -0,0 +1,68 @@
+package queries
+
+import (
+	"fmt"
+	"pvz-cli/internal/usecases/requests"
+	"strings"
+)
+
+const (
+	// SaveAuditRecordSQL defines the SQL query for appending a record to the audit_log table.
+	SaveAuditRecordSQL = `
+insert into audit_log (
+	timestamp,
+	operator_id,
+	role,
+	source,
+	method,
+	request,
+	result_code,
+	correlation_id
+) values ($1, $2, $3, $4, $5, $6, $7, $8);
+`
+	auditBaseSelect = `select id, timestamp, operator_id, role, source, method, request, result_code, correlation_id from audit_log`
+	auditBaseCount  = `select count(*) from audit_log`
+)
+
+// BuildFilterAuditQuery constructs a SQL query and arguments for a page of the audit log, newest first
+func BuildFilterAuditQuery(filter requests.AuditLogFilter) (string, []interface{}) {
+	q, args := applyWhereForAudit(auditBaseSelect, filter)
+	q += fmt.Sprintf(` order by timestamp desc, id desc limit $%d offset $%d`, len(args)+1, len(args)+2)
+	return q, append(args, filter.Limit, (filter.Page-1)*filter.Limit)
+}
+
+// BuildCountAuditQuery creates a count query for the audit log records matching the filter
+func BuildCountAuditQuery(filter requests.AuditLogFilter) (string, []interface{}) {
+	return applyWhereForAudit(auditBaseCount, filter)
+}
+
+func applyWhereForAudit(base string, filter requests.AuditLogFilter) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+	add := func(clause string, arg interface{}) {
+		args = append(args, arg)
+		clauses = append(clauses, fmt.Sprintf(clause, len(args)))
+	}
+	if filter.OperatorID != "" {
+		add(`operator_id = $%d`, filter.OperatorID)
+	}
+	if filter.Method != "" {
+		add(`(method = $%[1]d or right(method, length($%[1]d) + 1) = '/' || $%[1]d)`, filter.Method)
+	}
+	if filter.Source != "" {
+		add(`source = $%d`, string(filter.Source))
+	}
+	if filter.ResultCode != "" {
+		add(`result_code = $%d`, filter.ResultCode)
+	}
+	if filter.From != nil {
+		add(`timestamp >= $%d`, *filter.From)
+	}
+	if filter.To != nil {
+		add(`timestamp < $%d`, *filter.To)
+	}
+	if len(clauses) == 0 {
+		return base, args
+	}
+	return base + ` where ` + strings.Join(clauses, ` and `), args
+}
@@ -0,0 +1,34 @@
+package queries
+
+const (
+	// GetCalendarSQL is a SQL query to retrieve the pickup-point calendar.
+	GetCalendarSQL = `
+select opens_at,
+	closes_at,
+	weekly_days_off,
+	holidays,
+	business_days
+from pickup_calendar
+where id = 1;
+`
+
+	// SaveCalendarSQL is a SQL query for inserting or replacing the pickup-point calendar.
+	SaveCalendarSQL = `
+insert into pickup_calendar(
+                   id,
+                   opens_at,
+                   closes_at,
+                   weekly_days_off,
+                   holidays,
+                   business_days,
+                   updated_at)
+values (1, $1, $2, $3, $4, $5, now())
+on conflict (id) do update set
+opens_at        = EXCLUDED.opens_at,
+closes_at       = EXCLUDED.closes_at,
+weekly_days_off = EXCLUDED.weekly_days_off,
+holidays        = EXCLUDED.holidays,
+business_days   = EXCLUDED.business_days,
+updated_at      = EXCLUDED.updated_at;
+`
+)
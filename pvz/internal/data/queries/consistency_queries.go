@@ -0,0 +1,29 @@
+package queries
+
+const (
+	// SelectOrdersBatchSQL selects up to $2 live orders with IDs above $1, removed ones included, in ID order.
+	SelectOrdersBatchSQL = `
+select id, user_id, status, created_at, expires_at, updated_status_at, package, weight, price,
+	fragile, hazardous, age_restricted, storage_policy, version, is_deleted
+from orders
+where id > $1
+order by id
+limit $2;
+`
+
+	// SelectOrdersHistorySQL selects the history of the orders with IDs in $1, oldest first per order.
+	SelectOrdersHistorySQL = `
+select ` + historyColumns + `
+from order_history
+where order_id = any($1)
+order by order_id, timestamp, id;
+`
+
+	// SelectOrdersOutboxEventsSQL selects the event type and time of outbox payloads of the orders with IDs in $1.
+	// The time is read as text so it compares with history at the precision it was written with.
+	SelectOrdersOutboxEventsSQL = `
+select aggregate_id as order_id, payload->>'event_type' as event_type, payload->>'timestamp' as timestamp
+from outbox
+where aggregate_type = 'order' and aggregate_id = any($1);
+`
+)
@@ -12,32 +12,111 @@ const (
 insert into order_history (
 	order_id,
 	event,
-	timestamp
-) values ($1, $2, $3);
+	timestamp,
+	operator_id,
+	actor_type,
+	actor_id,
+	previous_status,
+	new_status,
+	correlation_id,
+	metadata
+) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
 `
-	historyBaseSelect = `select order_id, event, timestamp from order_history`
+	historyColumns    = `id, order_id, event, timestamp, operator_id, actor_type, actor_id, previous_status, new_status, correlation_id, metadata`
+	historyBaseSelect = `select ` + historyColumns + ` from order_history`
 	historyBaseCount  = `select count(*) from order_history`
+
+	// HasLiveHistorySQL checks whether order $1 has any live history entry, regardless of other filters;
+	// history of an order without one is read from the archive.
+	HasLiveHistorySQL = `select exists(select 1 from order_history where order_id = $1)`
+
+	archivedHistoryBaseSelect = `select ` + historyColumns + ` from order_history_archive`
+	archivedHistoryBaseCount  = `select count(*) from order_history_archive`
+
+	// history is listed newest first, or oldest first when ascending, with the ID as a tie-breaker
+	historyOrderByDesc = ` order by timestamp desc, id desc`
+	historyOrderByAsc  = ` order by timestamp asc, id asc`
 )
 
 // BuildFilterHistoryQuery constructs a SQL query and arguments for filtering order history
 func BuildFilterHistoryQuery(filter requests.OrderHistoryFilter) (string, []interface{}) {
-	q, args := applyWhereForHistory(historyBaseSelect, filter)
+	q, args := applyWhereForHistory(historyBaseSelect, `orders`, filter)
 	return applyPaginationForHistory(q, args, filter)
 }
 
-// BuildCountHistoryQuery creates a count query for history entries
+// BuildStreamHistoryQuery builds the same query as BuildFilterHistoryQuery without paging, for reading all matching entries
+func BuildStreamHistoryQuery(filter requests.OrderHistoryFilter) (string, []interface{}) {
+	filter.After = nil
+	q, args := applyWhereForHistory(historyBaseSelect, `orders`, filter)
+	return q + historyOrderBy(filter), args
+}
+
+// BuildCountHistoryQuery creates a count query for history entries; the count ignores the page cursor
 func BuildCountHistoryQuery(filter requests.OrderHistoryFilter) (string, []interface{}) {
-	return applyWhereForHistory(historyBaseCount, filter)
+	filter.After = nil
+	return applyWhereForHistory(historyBaseCount, `orders`, filter)
+}
+
+// BuildFilterArchivedHistoryQuery constructs the same query as BuildFilterHistoryQuery against archived history
+func BuildFilterArchivedHistoryQuery(filter requests.OrderHistoryFilter) (string, []interface{}) {
+	q, args := applyWhereForHistory(archivedHistoryBaseSelect, `orders_archive`, filter)
+	return applyPaginationForHistory(q, args, filter)
+}
+
+// BuildStreamArchivedHistoryQuery builds the same query as BuildStreamHistoryQuery against archived history
+func BuildStreamArchivedHistoryQuery(filter requests.OrderHistoryFilter) (string, []interface{}) {
+	filter.After = nil
+	q, args := applyWhereForHistory(archivedHistoryBaseSelect, `orders_archive`, filter)
+	return q + historyOrderBy(filter), args
+}
+
+// BuildCountArchivedHistoryQuery creates a count query for archived history entries
+func BuildCountArchivedHistoryQuery(filter requests.OrderHistoryFilter) (string, []interface{}) {
+	filter.After = nil
+	return applyWhereForHistory(archivedHistoryBaseCount, `orders_archive`, filter)
 }
 
-func applyWhereForHistory(base string, filter requests.OrderHistoryFilter) (string, []interface{}) {
+// applyWhereForHistory narrows base by filter; ordersTable holds the orders the history belongs to, for the user filter
+func applyWhereForHistory(base, ordersTable string, filter requests.OrderHistoryFilter) (string, []interface{}) {
 	var clauses []string
 	var args []interface{}
+	add := func(clause string, arg interface{}) {
+		args = append(args, arg)
+		clauses = append(clauses, fmt.Sprintf(clause, len(args)))
+	}
 
 	if filter.OrderID != nil {
-		ph := len(args) + 1
-		clauses = append(clauses, fmt.Sprintf(`order_id = $%d`, ph))
-		args = append(args, *filter.OrderID)
+		add(`order_id = $%d`, *filter.OrderID)
+	}
+	if filter.UserID != nil {
+		add(`order_id in (select id from `+ordersTable+` where user_id = $%d)`, *filter.UserID)
+	}
+	if len(filter.Events) > 0 {
+		events := make([]int32, 0, len(filter.Events))
+		for _, e := range filter.Events {
+			events = append(events, int32(e))
+		}
+		add(`event = any($%d)`, events)
+	}
+	if filter.From != nil {
+		add(`timestamp >= $%d`, *filter.From)
+	}
+	if filter.To != nil {
+		add(`timestamp < $%d`, *filter.To)
+	}
+	if filter.ActorType != nil {
+		add(`actor_type = $%d`, *filter.ActorType)
+	}
+	if filter.ActorID != nil {
+		add(`actor_id = $%d`, *filter.ActorID)
+	}
+	if filter.After != nil {
+		op := `<`
+		if filter.Asc {
+			op = `>`
+		}
+		clauses = append(clauses, fmt.Sprintf(`(timestamp, id) %s ($%d, $%d)`, op, len(args)+1, len(args)+2))
+		args = append(args, filter.After.At, filter.After.ID)
 	}
 	query := base
 	if len(clauses) > 0 {
@@ -46,9 +125,19 @@ func applyWhereForHistory(base string, filter requests.OrderHistoryFilter) (stri
 	return query, args
 }
 
+func historyOrderBy(filter requests.OrderHistoryFilter) string {
+	if filter.Asc {
+		return historyOrderByAsc
+	}
+	return historyOrderByDesc
+}
+
 func applyPaginationForHistory(query string, args []interface{}, filter requests.OrderHistoryFilter) (string, []interface{}) {
-	query += ` order by timestamp desc`
+	query += historyOrderBy(filter)
 	offset := (filter.Page - 1) * filter.Limit
+	if filter.After != nil {
+		offset = 0
+	}
 	phLimit := len(args) + 1
 	phOffset := len(args) + 2
 	query += fmt.Sprintf(` limit $%d offset $%d`, phLimit, phOffset)
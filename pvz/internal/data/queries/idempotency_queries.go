@@ -0,0 +1,45 @@
+package queries
+
+const (
+	// ReserveIdempotencyKeySQL is a SQL query for storing a pending record without a response. A record created
+	// before $6 is expired and is replaced; otherwise the query affects no rows when the key is already taken.
+	ReserveIdempotencyKeySQL = `
+insert into idempotency_keys(scope, key, method, request_hash, response, created_at)
+values ($1, $2, $3, $4, null, $5)
+on conflict (scope, key) do update
+set method = excluded.method,
+    request_hash = excluded.request_hash,
+    response = null,
+    user_ids = '{}',
+    created_at = excluded.created_at
+where idempotency_keys.created_at < $6;
+`
+
+	// CompleteIdempotencyKeySQL is a SQL query for storing the response of a pending record together with
+	// the clients it mentions.
+	CompleteIdempotencyKeySQL = `
+update idempotency_keys
+set response = $3,
+    user_ids = $4
+where scope = $1 and key = $2 and response is null;
+`
+
+	// ReleaseIdempotencyKeySQL is a SQL query for dropping a pending record so the call can be retried.
+	ReleaseIdempotencyKeySQL = `
+delete from idempotency_keys
+where scope = $1 and key = $2 and response is null;
+`
+
+	// LoadIdempotencyRecordSQL is the SQL query to retrieve the record stored for an idempotency key.
+	LoadIdempotencyRecordSQL = `
+select scope, key, method, request_hash, response, created_at
+from idempotency_keys
+where scope = $1 and key = $2;
+`
+
+	// PurgeIdempotencyKeysSQL is the SQL query to delete records created before $1.
+	PurgeIdempotencyKeysSQL = `
+delete from idempotency_keys
+where created_at < $1;
+`
+)
@@ -0,0 +1,91 @@
+package queries
+
+const (
+	// SaveImportJobSQL is a SQL query for inserting or updating an import job, using ON CONFLICT for upserts.
+	SaveImportJobSQL = `
+insert into import_jobs(
+                   id,
+                   status,
+                   atomic,
+                   total,
+                   processed,
+                   imported,
+                   failed,
+                   operator_id,
+                   error,
+                   created_at,
+                   updated_at,
+                   finished_at,
+                   instance_id)
+values (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13
+)
+on conflict (id) do update set
+status      = EXCLUDED.status,
+processed   = EXCLUDED.processed,
+imported    = EXCLUDED.imported,
+failed      = EXCLUDED.failed,
+error       = EXCLUDED.error,
+updated_at  = EXCLUDED.updated_at,
+finished_at = EXCLUDED.finished_at;
+`
+
+	// LoadImportJobSQL is the SQL query to retrieve an import job by its ID.
+	LoadImportJobSQL = `
+select id,
+	status,
+	atomic,
+	total,
+	processed,
+	imported,
+	failed,
+	operator_id,
+	error,
+	created_at,
+	updated_at,
+	finished_at,
+	instance_id
+from import_jobs
+where id = $1;
+`
+
+	// SaveImportJobFailuresSQL inserts the failed orders of job $1 given as parallel arrays of
+	// item numbers, order IDs, user IDs, error codes and reasons; already recorded items are kept.
+	SaveImportJobFailuresSQL = `
+insert into import_job_failures(job_id, item_number, order_id, user_id, code, reason)
+select $1, f.item_number, f.order_id, f.user_id, f.code, f.reason
+from unnest($2::integer[], $3::bigint[], $4::bigint[], $5::text[], $6::text[]) as f(item_number, order_id, user_id, code, reason)
+on conflict (job_id, item_number) do nothing;
+`
+
+	// ListImportJobFailuresSQL is a SQL query to retrieve the failed orders of an import job in file order.
+	ListImportJobFailuresSQL = `
+select job_id, item_number, order_id, user_id, code, reason
+from import_job_failures
+where job_id = $1
+order by item_number asc;
+`
+
+	// FailUnfinishedImportJobsSQL marks jobs of instance $7 in status $1 or $2 created before $6 as failed
+	// with status $3 and reason $4 at $5.
+	FailUnfinishedImportJobsSQL = `
+update import_jobs
+set status      = $3,
+	error       = $4,
+	updated_at  = $5,
+	finished_at = $5
+where instance_id = $7 and status in ($1, $2) and created_at < $6;
+`
+)
@@ -6,10 +6,14 @@ import (
 	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
 	"strings"
+	"time"
 )
 
 const (
 	// SaveOrderSQL is a SQL query for inserting or updating an order in the orders table, using ON CONFLICT for upserts.
+	// The update only applies to a live order whose stored version equals $14, so a stale write affects no rows.
+	// A soft-deleted order (e.g. after an undone acceptance) is revived only by a new acceptance, saved with version 0;
+	// its version keeps counting up, so an If-Match value issued before the deletion cannot match again.
 	SaveOrderSQL = `
 insert into orders(
                    id,
@@ -20,7 +24,12 @@ insert into orders(
                    updated_status_at,
                    package,
                    weight,
-                   price)
+                   price,
+                   fragile,
+                   hazardous,
+                   age_restricted,
+                   storage_policy,
+                   version)
 values (
         $1,
         $2,
@@ -30,7 +39,12 @@ values (
         $6,
         $7,
         $8,
-        $9
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14 + 1
 )
 on conflict (id) do update set
 user_id            = EXCLUDED.user_id,
@@ -40,7 +54,15 @@ expires_at         = EXCLUDED.expires_at,
 updated_status_at  = EXCLUDED.updated_status_at,
 package            = EXCLUDED.package,
 weight             = EXCLUDED.weight,
-price              = EXCLUDED.price;
+price              = EXCLUDED.price,
+fragile            = EXCLUDED.fragile,
+hazardous          = EXCLUDED.hazardous,
+age_restricted     = EXCLUDED.age_restricted,
+storage_policy     = EXCLUDED.storage_policy,
+version            = orders.version + 1,
+is_deleted         = false
+where (orders.version = $14 and not orders.is_deleted) or ($14 = 0 and orders.is_deleted)
+returning version;
 `
 	// LoadOrderSQL is the SQL query to retrieve non-deleted order details by order ID from the 'orders' table.
 	LoadOrderSQL = `
@@ -52,18 +74,25 @@ select id,
 	updated_status_at,
 	package,
 	weight,
-	price
+	price,
+	fragile,
+	hazardous,
+	age_restricted,
+	storage_policy,
+	version
 from orders
 where id = $1 and is_deleted = false;
 `
 
-	// SoftDeleteOrderSQL is a SQL query to mark an order as deleted in the 'orders' table by setting is_deleted to true.
+	// SoftDeleteOrderSQL is a SQL query to mark a live order as deleted in the 'orders' table by setting is_deleted to true.
+	// Like SaveOrderSQL it only applies when the stored version equals $2, and it counts as a change of the order.
 	SoftDeleteOrderSQL = `
 update orders
-	set is_deleted = true
-where id = $1;
+	set is_deleted = true,
+	    version = version + 1
+where id = $1 and version = $2 and not is_deleted;
 `
-	orderBaseSelect = `select id, user_id, status, expires_at, weight, price, package from orders`
+	orderBaseSelect = `select id, user_id, status, created_at, expires_at, updated_status_at, weight, price, package, fragile, hazardous, age_restricted, storage_policy, version from orders`
 	orderBaseCount  = `select count(*) from orders`
 )
 
@@ -73,8 +102,17 @@ func BuildFilterOrdersQuery(filter requests.OrdersFilterRequest) (string, []inte
 	return applyPaginationForOrders(q, args, filter)
 }
 
+// BuildStreamOrdersQuery builds the same query as BuildFilterOrdersQuery without paging, for reading all matching orders
+func BuildStreamOrdersQuery(filter requests.OrdersFilterRequest) (string, []interface{}) {
+	filter.After = nil
+	q, args := applyWhereForOrders(orderBaseSelect, filter)
+	return q + orderByForOrders(filter), args
+}
+
 // BuildCountOrdersQuery creates a count query for orders and binds parameters based on the provided filter criteria.
+// The count ignores the page cursor, so it is the total across all pages.
 func BuildCountOrdersQuery(filter requests.OrdersFilterRequest) (string, []interface{}) {
+	filter.After = nil
 	return applyWhereForOrders(orderBaseCount, filter)
 }
 
@@ -82,30 +120,77 @@ func applyWhereForOrders(base string, filter requests.OrdersFilterRequest) (stri
 	var clauses []string
 	clauses = append(clauses, `is_deleted = false`)
 	var args []interface{}
+	// add appends a clause whose single %d verb is replaced by the placeholder of arg
+	add := func(clause string, arg interface{}) {
+		args = append(args, arg)
+		clauses = append(clauses, fmt.Sprintf(clause, len(args)))
+	}
 	if filter.UserID != nil {
-		ph := len(args) + 1
-		clauses = append(clauses, fmt.Sprintf(`user_id = $%d`, ph))
-		args = append(args, *filter.UserID)
+		add(`user_id = $%d`, *filter.UserID)
 	}
 	if filter.InPvz != nil && *filter.InPvz {
-		ph := len(args) + 1
-		clauses = append(clauses, fmt.Sprintf(`status <> $%d`, ph))
-		args = append(args, models.Issued)
+		add(`status <> $%d`, models.Issued)
 	}
 	if filter.Status != nil {
-		ph := len(args) + 1
-		clauses = append(clauses, fmt.Sprintf(`status = $%d`, ph))
-		args = append(args, *filter.Status)
-	}
-	if filter.LastID != nil {
-		ph := len(args) + 1
-		clauses = append(clauses,
-			fmt.Sprintf(
-				`created_at > (select created_at from orders where id = $%d)`,
-				ph,
-			),
-		)
-		args = append(args, *filter.LastID)
+		add(`status = $%d`, *filter.Status)
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]int32, 0, len(filter.Statuses))
+		for _, st := range filter.Statuses {
+			statuses = append(statuses, int32(st))
+		}
+		add(`status = any($%d)`, statuses)
+	}
+	if len(filter.Packages) > 0 {
+		packages := make([]int32, 0, len(filter.Packages))
+		for _, p := range filter.Packages {
+			packages = append(packages, int32(p))
+		}
+		add(`package = any($%d)`, packages)
+	}
+	timeRanges := []struct {
+		column   string
+		from, to *time.Time
+	}{
+		{`created_at`, filter.CreatedFrom, filter.CreatedTo},
+		{`expires_at`, filter.ExpiresFrom, filter.ExpiresTo},
+		{`updated_status_at`, filter.UpdatedFrom, filter.UpdatedTo},
+	}
+	for _, r := range timeRanges {
+		if r.from != nil {
+			add(r.column+` >= $%d`, *r.from)
+		}
+		if r.to != nil {
+			add(r.column+` < $%d`, *r.to)
+		}
+	}
+	numRanges := []struct {
+		column   string
+		min, max *float32
+	}{
+		{`price`, filter.PriceMin, filter.PriceMax},
+		{`weight`, filter.WeightMin, filter.WeightMax},
+	}
+	for _, r := range numRanges {
+		if r.min != nil {
+			add(r.column+` >= $%d`, *r.min)
+		}
+		if r.max != nil {
+			add(r.column+` <= $%d`, *r.max)
+		}
+	}
+	if c := filter.After; c != nil {
+		column := orderSortColumn(c.SortBy)
+		var value interface{} = c.At
+		if c.SortBy == requests.SortByPrice || c.SortBy == requests.SortByWeight {
+			value = c.Num
+		}
+		op := `>`
+		if c.Desc {
+			op = `<`
+		}
+		args = append(args, value, c.ID)
+		clauses = append(clauses, fmt.Sprintf(`(%s, id) %s ($%d, $%d)`, column, op, len(args)-1, len(args)))
 	}
 	query := base
 	if len(clauses) > 0 {
@@ -114,11 +199,36 @@ func applyWhereForOrders(base string, filter requests.OrdersFilterRequest) (stri
 	return query, args
 }
 
+// orderSortColumns maps sortable fields to their columns; the field names are never interpolated directly
+var orderSortColumns = map[requests.OrderSortField]string{
+	requests.SortByCreatedAt:       `created_at`,
+	requests.SortByExpiresAt:       `expires_at`,
+	requests.SortByUpdatedStatusAt: `updated_status_at`,
+	requests.SortByPrice:           `price`,
+	requests.SortByWeight:          `weight`,
+}
+
+func orderSortColumn(field requests.OrderSortField) string {
+	if column, ok := orderSortColumns[field]; ok {
+		return column
+	}
+	return `created_at`
+}
+
+func orderByForOrders(filter requests.OrdersFilterRequest) string {
+	column := orderSortColumn(filter.SortBy)
+	dir := `asc`
+	if filter.SortDesc {
+		dir = `desc`
+	}
+	return fmt.Sprintf(` order by %s %s, id %s`, column, dir, dir)
+}
+
 func applyPaginationForOrders(query string, args []interface{}, filter requests.OrdersFilterRequest) (string, []interface{}) {
 	// included last parameter overrides paging
 	if filter.Last != nil {
 		phLimit := len(args) + 1
-		query = fmt.Sprintf(`%s order by created_at desc limit $%d`, query, phLimit)
+		query = fmt.Sprintf(`%s order by created_at desc, id desc limit $%d`, query, phLimit)
 		args = append(args, *filter.Last)
 		return query, args
 	}
@@ -131,9 +241,12 @@ func applyPaginationForOrders(query string, args []interface{}, filter requests.
 		page = *filter.Page
 	}
 	offset := (page - 1) * limit
+	if filter.After != nil {
+		offset = 0
+	}
 	phLimit := len(args) + 1
 	phOffset := len(args) + 2
-	query = fmt.Sprintf(`%s order by created_at asc limit $%d offset $%d`, query, phLimit, phOffset)
+	query = fmt.Sprintf(`%s%s limit $%d offset $%d`, query, orderByForOrders(filter), phLimit, phOffset)
 	args = append(args, limit, offset)
 	return query, args
 }
@@ -0,0 +1,72 @@
+package queries
+
+const (
+	// SaveShipmentSQL is a SQL query for inserting or updating a shipment in the shipments table, using ON CONFLICT for upserts.
+	SaveShipmentSQL = `
+insert into shipments(
+                   id,
+                   sender_id,
+                   destination_point_id,
+                   status,
+                   courier_id,
+                   created_at,
+                   updated_status_at,
+                   package,
+                   weight,
+                   price,
+                   fragile,
+                   hazardous,
+                   age_restricted)
+values (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13
+)
+on conflict (id) do update set
+status             = EXCLUDED.status,
+courier_id         = EXCLUDED.courier_id,
+updated_status_at  = EXCLUDED.updated_status_at;
+`
+	// LoadShipmentSQL is the SQL query to retrieve shipment details by shipment ID from the 'shipments' table.
+	LoadShipmentSQL = `
+select id,
+	sender_id,
+	destination_point_id,
+	status,
+	courier_id,
+	created_at,
+	updated_status_at,
+	package,
+	weight,
+	price,
+	fragile,
+	hazardous,
+	age_restricted
+from shipments
+where id = $1;
+`
+
+	// SaveShipmentHistoryEntrySQL is a SQL query to insert a status change into the shipment_history table.
+	SaveShipmentHistoryEntrySQL = `
+insert into shipment_history(shipment_id, status, timestamp)
+values ($1, $2, $3);
+`
+
+	// ListShipmentHistorySQL is a SQL query to retrieve the status history of a shipment in chronological order.
+	ListShipmentHistorySQL = `
+select shipment_id, status, timestamp
+from shipment_history
+where shipment_id = $1
+order by timestamp asc;
+`
+)
@@ -0,0 +1,147 @@
+package queries
+
+const (
+	userOrderIDs    = `select id from orders where user_id = $1 union select id from orders_archive where user_id = $1`
+	userShipmentIDs = `select id from shipments where sender_id = $1`
+
+	// SelectUserOrdersSQL selects every live order of the user, including the ones already returned to the warehouse.
+	SelectUserOrdersSQL = `
+select id, user_id, status, created_at, expires_at, updated_status_at, package, weight, price,
+	fragile, hazardous, age_restricted, storage_policy, version
+from orders
+where user_id = $1
+order by created_at, id;
+`
+
+	// SelectUserArchivedOrdersSQL selects archived orders of the user.
+	SelectUserArchivedOrdersSQL = `
+select id, user_id, status, created_at, expires_at, updated_status_at, package, weight, price,
+	fragile, hazardous, age_restricted, storage_policy, version, archived_at
+from orders_archive
+where user_id = $1
+order by created_at, id;
+`
+
+	// SelectUserHistorySQL selects live and archived history of the user's orders.
+	SelectUserHistorySQL = `
+select ` + historyColumns + ` from order_history where order_id in (` + userOrderIDs + `)
+union all
+select ` + historyColumns + ` from order_history_archive where order_id in (` + userOrderIDs + `)
+order by timestamp;
+`
+
+	// SelectUserOutboxSQL selects outbox events of the user's orders and of the shipments the user sent.
+	SelectUserOutboxSQL = `
+select id, aggregate_type, aggregate_id, status, created_at, payload
+from outbox
+where (aggregate_type = 'order' and aggregate_id in (` + userOrderIDs + `))
+	or (aggregate_type = 'shipment' and aggregate_id in (` + userShipmentIDs + `))
+order by created_at, id;
+`
+
+	// SelectUserShipmentsSQL selects the shipments the user dropped off as a sender.
+	SelectUserShipmentsSQL = `
+select id, sender_id, destination_point_id, status, courier_id, created_at, updated_status_at,
+	package, weight, price, fragile, hazardous, age_restricted
+from shipments
+where sender_id = $1
+order by created_at, id;
+`
+
+	// SelectUserShipmentHistorySQL selects the status history of the user's shipments.
+	SelectUserShipmentHistorySQL = `
+select shipment_id, status, timestamp
+from shipment_history
+where shipment_id in (` + userShipmentIDs + `)
+order by timestamp, id;
+`
+
+	// SelectUserIdempotencyRecordsSQL selects stored responses that mention the user.
+	SelectUserIdempotencyRecordsSQL = `
+select scope, key, method, request_hash, response, created_at
+from idempotency_keys
+where user_ids @> array[$1::bigint]
+order by created_at, scope, key;
+`
+
+	// SelectUserImportJobFailuresSQL selects the user's orders rejected by import jobs.
+	SelectUserImportJobFailuresSQL = `
+select job_id, item_number, order_id, user_id, code, reason
+from import_job_failures
+where user_id = $1
+order by job_id, item_number;
+`
+
+	// SelectUserActiveOrderSQL selects a live order of the user still at the pickup point, with status $2 or $3.
+	SelectUserActiveOrderSQL = `
+select id from orders where user_id = $1 and not is_deleted and status in ($2, $3) limit 1;
+`
+
+	// AnonymizeUserSQL replaces the user's identifier with $2 in live and archived orders, in shipments sent by
+	// the user, in import job failures, in pending and sent outbox payloads and in the client actor of history
+	// entries, leaving prices, statuses and timestamps untouched. Stored idempotent responses cannot be rewritten
+	// and are deleted. Live orders with status $3 or $4 are still at the pickup point and keep their user, so one
+	// accepted while the user is anonymized can still be issued. It returns the number of changed rows per table;
+	// history rows belong to the counted orders and are not counted.
+	AnonymizeUserSQL = `
+with target as (` + userOrderIDs + `),
+shipment_target as (` + userShipmentIDs + `),
+outbox_updated as (
+	update outbox
+	set payload = jsonb_set(
+		jsonb_set(payload, '{order,user_id}', to_jsonb($2::bigint)),
+		'{actor,id}',
+		case when payload->'actor'->>'type' = 'client' then to_jsonb($2::bigint) else payload->'actor'->'id' end
+	)
+	where aggregate_type = 'order' and aggregate_id in (select id from target)
+	returning 1
+),
+shipment_outbox_updated as (
+	update outbox
+	set payload = jsonb_set(
+		jsonb_set(payload, '{shipment,sender_id}', to_jsonb($2::bigint)),
+		'{actor,id}',
+		case when payload->'actor'->>'type' = 'client' then to_jsonb($2::bigint) else payload->'actor'->'id' end
+	)
+	where aggregate_type = 'shipment' and aggregate_id in (select id from shipment_target)
+	returning 1
+),
+history_updated as (
+	update order_history set actor_id = $2
+	where actor_type = 'client' and actor_id = $1 and order_id in (select id from target)
+	returning 1
+),
+archived_history_updated as (
+	update order_history_archive set actor_id = $2
+	where actor_type = 'client' and actor_id = $1 and order_id in (select id from target)
+	returning 1
+),
+archive_updated as (
+	update orders_archive set user_id = $2 where user_id = $1
+	returning 1
+),
+orders_updated as (
+	update orders set user_id = $2 where user_id = $1 and (is_deleted or status not in ($3, $4))
+	returning 1
+),
+shipments_updated as (
+	update shipments set sender_id = $2 where sender_id = $1
+	returning 1
+),
+failures_updated as (
+	update import_job_failures set user_id = $2 where user_id = $1
+	returning 1
+),
+idempotency_deleted as (
+	delete from idempotency_keys where user_ids @> array[$1::bigint]
+	returning 1
+)
+select
+	(select count(*) from orders_updated) as orders,
+	(select count(*) from archive_updated) as archived_orders,
+	(select count(*) from outbox_updated) + (select count(*) from shipment_outbox_updated) as outbox_events,
+	(select count(*) from shipments_updated) as shipments,
+	(select count(*) from failures_updated) as import_job_failures,
+	(select count(*) from idempotency_deleted) as idempotency_keys;
+`
+)
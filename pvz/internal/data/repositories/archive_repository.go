@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// ArchiveRepository moves finished orders out of the live tables and enforces archive retention
+type ArchiveRepository interface {
+	// ArchiveOrders moves up to limit terminal orders whose status last changed before olderThan, together with
+	// their history, into the archive stamped with archivedAt, and returns how many orders were moved
+	ArchiveOrders(ctx context.Context, olderThan, archivedAt time.Time, limit int) (int, error)
+	// PurgeArchive permanently removes orders and history archived before archivedBefore
+	PurgeArchive(ctx context.Context, archivedBefore time.Time) (int, error)
+}
@@ -0,0 +1,15 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+)
+
+// AuditRepository handles persistence of the audit log; records are only ever appended
+type AuditRepository interface {
+	Save(ctx context.Context, rec models.AuditRecord) error
+	// List returns a page of matching records, newest first, and the number of all matching records
+	List(ctx context.Context, filter requests.AuditLogFilter) ([]models.AuditRecord, int, error)
+}
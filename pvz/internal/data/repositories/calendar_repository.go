@@ -0,0 +1,13 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+)
+
+// CalendarRepository handles persistence of the pickup-point calendar
+type CalendarRepository interface {
+	Get(ctx context.Context) (models.PickupCalendar, error)
+	Save(ctx context.Context, cal models.PickupCalendar) error
+}
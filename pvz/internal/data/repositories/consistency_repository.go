@@ -0,0 +1,14 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+)
+
+// ConsistencyRepository reads live orders together with their history and outbox events for the consistency check
+type ConsistencyRepository interface {
+	// Scan passes every live order, removed ones included, with its history and outbox events to fn in order ID order;
+	// it stops at the first error of fn
+	Scan(ctx context.Context, fn func(models.OrderRecord) error) error
+}
@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var _ AuditRepository = (*FileAuditRepository)(nil)
+
+// FileAuditRepository is an implementation of the AuditRepository interface that appends records to an NDJSON file.
+// The audit log is kept out of the snapshot: it is written after every call, and rewriting the snapshot for it
+// could overwrite a change another call has just made.
+type FileAuditRepository struct {
+	path   string
+	mutex  sync.Mutex
+	lastID uint64
+	loaded bool
+}
+
+// NewFileAuditRepository creates a new instance of FileAuditRepository writing to the file at path
+func NewFileAuditRepository(path string) *FileAuditRepository {
+	return &FileAuditRepository{path: path}
+}
+
+// Save appends a record to the file, numbering it after the last record stored
+func (r *FileAuditRepository) Save(ctx context.Context, rec models.AuditRecord) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.loaded {
+		err := r.scan(func(stored models.AuditRecord) {
+			r.lastID = max(r.lastID, stored.ID)
+		})
+		if err != nil {
+			return err
+		}
+		r.loaded = true
+	}
+	rec.ID = r.lastID + 1
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("encode audit record: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0750); err != nil {
+		return fmt.Errorf("mkdir audit log dir: %w", err)
+	}
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close audit log: %w", err)
+	}
+	r.lastID = rec.ID
+	return nil
+}
+
+// List reads the whole file and returns a filtered page of it, newest first
+func (r *FileAuditRepository) List(ctx context.Context, filter requests.AuditLogFilter) ([]models.AuditRecord, int, error) {
+	if ctx.Err() != nil {
+		return nil, 0, ctx.Err()
+	}
+	r.mutex.Lock()
+	var matched []models.AuditRecord
+	err := r.scan(func(rec models.AuditRecord) {
+		if auditMatches(rec, filter) {
+			matched = append(matched, rec)
+		}
+	})
+	r.mutex.Unlock()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	slices.SortStableFunc(matched, func(a, b models.AuditRecord) int {
+		if c := b.Timestamp.Compare(a.Timestamp); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.ID, a.ID)
+	})
+	start := min((filter.Page-1)*filter.Limit, len(matched))
+	end := min(start+filter.Limit, len(matched))
+	return matched[start:end], len(matched), nil
+}
+
+// scan calls fn for every record in the file; a missing file holds no records
+func (r *FileAuditRepository) scan(fn func(models.AuditRecord)) error {
+	file, err := os.Open(r.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	// sanitized requests are bounded, but a generous line limit keeps a long one from stopping the scan
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var rec models.AuditRecord
+		// a line torn by a crash mid-write is skipped rather than making the whole log unreadable
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			continue
+		}
+		fn(rec)
+	}
+	return scanner.Err()
+}
+
+func auditMatches(rec models.AuditRecord, filter requests.AuditLogFilter) bool {
+	switch {
+	case filter.OperatorID != "" && rec.OperatorID != filter.OperatorID:
+		return false
+	case filter.Method != "" && rec.Method != filter.Method && !strings.HasSuffix(rec.Method, "/"+filter.Method):
+		return false
+	case filter.Source != "" && rec.Source != filter.Source:
+		return false
+	case filter.ResultCode != "" && rec.ResultCode != filter.ResultCode:
+		return false
+	case filter.From != nil && rec.Timestamp.Before(*filter.From):
+		return false
+	case filter.To != nil && !rec.Timestamp.Before(*filter.To):
+		return false
+	default:
+		return true
+	}
+}
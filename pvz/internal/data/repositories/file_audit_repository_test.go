@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"path/filepath"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFileAuditRepository_List covers the filters, newest-first ordering and paging of the audit log.
+func TestFileAuditRepository_List(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "audit", "audit.ndjson")
+	repo := NewFileAuditRepository(path)
+	ctx := context.Background()
+	at := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, rec := range []models.AuditRecord{
+		{Timestamp: at, OperatorID: "op-1", Source: models.AuditSourceGRPC, Method: "/orders.OrdersService/AcceptOrder", ResultCode: "OK"},
+		{Timestamp: at.Add(time.Minute), OperatorID: "op-2", Source: models.AuditSourceCLI, Method: "AcceptOrder", ResultCode: "ORDER_ALREADY_EXISTS"},
+		{Timestamp: at.Add(2 * time.Minute), OperatorID: "op-1", Source: models.AuditSourceGRPC, Method: "/orders.OrdersService/ReturnOrder", ResultCode: "OK"},
+		{Timestamp: at.Add(2 * time.Minute), OperatorID: "op-1", Source: models.AuditSourceGRPC, Method: "/admin.AdminService/SetWorkerCount", ResultCode: "PermissionDenied"},
+	} {
+		require.NoError(t, repo.Save(ctx, rec))
+	}
+
+	tests := []struct {
+		name      string
+		filter    requests.AuditLogFilter
+		wantIDs   []uint64
+		wantTotal int
+	}{
+		{
+			name:      "newest first, later ID first on equal time",
+			filter:    requests.AuditLogFilter{},
+			wantIDs:   []uint64{4, 3, 2, 1},
+			wantTotal: 4,
+		},
+		{
+			name:      "short method name matches both sources",
+			filter:    requests.AuditLogFilter{Method: "AcceptOrder"},
+			wantIDs:   []uint64{2, 1},
+			wantTotal: 2,
+		},
+		{
+			name:      "full method name",
+			filter:    requests.AuditLogFilter{Method: "/orders.OrdersService/AcceptOrder"},
+			wantIDs:   []uint64{1},
+			wantTotal: 1,
+		},
+		{
+			name:      "operator",
+			filter:    requests.AuditLogFilter{OperatorID: "op-2"},
+			wantIDs:   []uint64{2},
+			wantTotal: 1,
+		},
+		{
+			name:      "source and result code",
+			filter:    requests.AuditLogFilter{Source: models.AuditSourceGRPC, ResultCode: "OK"},
+			wantIDs:   []uint64{3, 1},
+			wantTotal: 2,
+		},
+		{
+			name:      "from is inclusive and to is exclusive",
+			filter:    requests.AuditLogFilter{From: utils.Ptr(at.Add(time.Minute)), To: utils.Ptr(at.Add(2 * time.Minute))},
+			wantIDs:   []uint64{2},
+			wantTotal: 1,
+		},
+		{
+			name:      "second page",
+			filter:    requests.AuditLogFilter{Page: 2, Limit: 3},
+			wantIDs:   []uint64{1},
+			wantTotal: 4,
+		},
+		{
+			name:      "page past the end",
+			filter:    requests.AuditLogFilter{Page: 3, Limit: 3},
+			wantIDs:   []uint64{},
+			wantTotal: 4,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			filter := tt.filter
+			if filter.Page == 0 {
+				filter.Page, filter.Limit = 1, 10
+			}
+			records, total, err := repo.List(ctx, filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantTotal, total)
+			ids := make([]uint64, 0, len(records))
+			for _, rec := range records {
+				ids = append(ids, rec.ID)
+			}
+			require.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+// TestFileAuditRepository_SaveContinuesNumbering verifies that a repository opened on an existing log numbers new
+// records after the stored ones.
+func TestFileAuditRepository_SaveContinuesNumbering(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "audit.ndjson")
+	ctx := context.Background()
+	rec := models.AuditRecord{Source: models.AuditSourceCLI, Method: "AcceptOrder", ResultCode: "OK"}
+	require.NoError(t, NewFileAuditRepository(path).Save(ctx, rec))
+	require.NoError(t, NewFileAuditRepository(path).Save(ctx, rec))
+
+	records, total, err := NewFileAuditRepository(path).List(ctx, requests.AuditLogFilter{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, 2, total)
+	require.Equal(t, uint64(2), records[0].ID)
+	require.Equal(t, uint64(1), records[1].ID)
+}
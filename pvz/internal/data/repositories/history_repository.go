@@ -11,4 +11,6 @@ import (
 type HistoryRepository interface {
 	Save(ctx context.Context, e models.HistoryEntry) error
 	List(ctx context.Context, filter requests.OrderHistoryFilter) ([]models.HistoryEntry, int, error)
+	// Stream passes every entry matching the filter to fn in the listing order of the filter, ignoring paging; it stops at the first error of fn
+	Stream(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error) error
 }
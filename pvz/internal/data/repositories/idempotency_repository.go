@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"pvz-cli/internal/models"
+	"time"
+)
+
+// ErrIdempotencyKeyNotFound is returned when no response was recorded for the given key
+var ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+
+// IdempotencyRepository defines methods to persist responses of calls made with an Idempotency-Key.
+// Keys are unique within a scope.
+type IdempotencyRepository interface {
+	// Reserve stores rec as a pending record and reports true, unless the key is taken by a record created
+	// at or after expiredBefore; then it reports false and returns that record
+	Reserve(ctx context.Context, rec models.IdempotencyRecord, expiredBefore time.Time) (models.IdempotencyRecord, bool, error)
+	// Complete stores the response of the pending record for the key and the clients the response mentions
+	Complete(ctx context.Context, scope, key string, response []byte, userIDs []uint64) error
+	// Release removes the pending record for the key, so the call can be made again
+	Release(ctx context.Context, scope, key string) error
+	// Load retrieves the record stored for the key
+	Load(ctx context.Context, scope, key string) (models.IdempotencyRecord, error)
+	// Purge deletes the records created before the given time and returns how many were deleted
+	Purge(ctx context.Context, createdBefore time.Time) (int, error)
+}
@@ -0,0 +1,23 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package repositories
+
+import (
+	"context"
+	"errors"
+	"pvz-cli/internal/models"
+	"time"
+)
+
+// ErrImportJobNotFound represents an error indicating that the requested import job could not be found.
+var ErrImportJobNotFound = errors.New("import job not found")
+
+// ImportJobRepository handles persistence of background import jobs and the orders they failed to import
+type ImportJobRepository interface {
+	Save(ctx context.Context, job models.ImportJob) error
+	Load(ctx context.Context, id uint64) (models.ImportJob, error)
+	SaveFailures(ctx context.Context, failures []models.ImportJobFailure) error
+	ListFailures(ctx context.Context, jobID uint64) ([]models.ImportJobFailure, error)
+	// FailUnfinished marks queued and running jobs of the instance created before createdBefore as failed with reason
+	// and returns how many were marked; jobs of other instances sharing the storage are left alone
+	FailUnfinished(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time) (int, error)
+}
@@ -0,0 +1,834 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ArchiveRepositoryMock implements mm_repositories.ArchiveRepository
+type ArchiveRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcArchiveOrders          func(ctx context.Context, olderThan time.Time, archivedAt time.Time, limit int) (i1 int, err error)
+	funcArchiveOrdersOrigin    string
+	inspectFuncArchiveOrders   func(ctx context.Context, olderThan time.Time, archivedAt time.Time, limit int)
+	afterArchiveOrdersCounter  uint64
+	beforeArchiveOrdersCounter uint64
+	ArchiveOrdersMock          mArchiveRepositoryMockArchiveOrders
+
+	funcPurgeArchive          func(ctx context.Context, archivedBefore time.Time) (i1 int, err error)
+	funcPurgeArchiveOrigin    string
+	inspectFuncPurgeArchive   func(ctx context.Context, archivedBefore time.Time)
+	afterPurgeArchiveCounter  uint64
+	beforePurgeArchiveCounter uint64
+	PurgeArchiveMock          mArchiveRepositoryMockPurgeArchive
+}
+
+// NewArchiveRepositoryMock returns a mock for mm_repositories.ArchiveRepository
+func NewArchiveRepositoryMock(t minimock.Tester) *ArchiveRepositoryMock {
+	m := &ArchiveRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ArchiveOrdersMock = mArchiveRepositoryMockArchiveOrders{mock: m}
+	m.ArchiveOrdersMock.callArgs = []*ArchiveRepositoryMockArchiveOrdersParams{}
+
+	m.PurgeArchiveMock = mArchiveRepositoryMockPurgeArchive{mock: m}
+	m.PurgeArchiveMock.callArgs = []*ArchiveRepositoryMockPurgeArchiveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mArchiveRepositoryMockArchiveOrders struct {
+	optional           bool
+	mock               *ArchiveRepositoryMock
+	defaultExpectation *ArchiveRepositoryMockArchiveOrdersExpectation
+	expectations       []*ArchiveRepositoryMockArchiveOrdersExpectation
+
+	callArgs []*ArchiveRepositoryMockArchiveOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ArchiveRepositoryMockArchiveOrdersExpectation specifies expectation struct of the ArchiveRepository.ArchiveOrders
+type ArchiveRepositoryMockArchiveOrdersExpectation struct {
+	mock               *ArchiveRepositoryMock
+	params             *ArchiveRepositoryMockArchiveOrdersParams
+	paramPtrs          *ArchiveRepositoryMockArchiveOrdersParamPtrs
+	expectationOrigins ArchiveRepositoryMockArchiveOrdersExpectationOrigins
+	results            *ArchiveRepositoryMockArchiveOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ArchiveRepositoryMockArchiveOrdersParams contains parameters of the ArchiveRepository.ArchiveOrders
+type ArchiveRepositoryMockArchiveOrdersParams struct {
+	ctx        context.Context
+	olderThan  time.Time
+	archivedAt time.Time
+	limit      int
+}
+
+// ArchiveRepositoryMockArchiveOrdersParamPtrs contains pointers to parameters of the ArchiveRepository.ArchiveOrders
+type ArchiveRepositoryMockArchiveOrdersParamPtrs struct {
+	ctx        *context.Context
+	olderThan  *time.Time
+	archivedAt *time.Time
+	limit      *int
+}
+
+// ArchiveRepositoryMockArchiveOrdersResults contains results of the ArchiveRepository.ArchiveOrders
+type ArchiveRepositoryMockArchiveOrdersResults struct {
+	i1  int
+	err error
+}
+
+// ArchiveRepositoryMockArchiveOrdersOrigins contains origins of expectations of the ArchiveRepository.ArchiveOrders
+type ArchiveRepositoryMockArchiveOrdersExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originOlderThan  string
+	originArchivedAt string
+	originLimit      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) Optional() *mArchiveRepositoryMockArchiveOrders {
+	mmArchiveOrders.optional = true
+	return mmArchiveOrders
+}
+
+// Expect sets up expected params for ArchiveRepository.ArchiveOrders
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) Expect(ctx context.Context, olderThan time.Time, archivedAt time.Time, limit int) *mArchiveRepositoryMockArchiveOrders {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Set")
+	}
+
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &ArchiveRepositoryMockArchiveOrdersExpectation{}
+	}
+
+	if mmArchiveOrders.defaultExpectation.paramPtrs != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by ExpectParams functions")
+	}
+
+	mmArchiveOrders.defaultExpectation.params = &ArchiveRepositoryMockArchiveOrdersParams{ctx, olderThan, archivedAt, limit}
+	mmArchiveOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmArchiveOrders.expectations {
+		if minimock.Equal(e.params, mmArchiveOrders.defaultExpectation.params) {
+			mmArchiveOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmArchiveOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmArchiveOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ArchiveRepository.ArchiveOrders
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) ExpectCtxParam1(ctx context.Context) *mArchiveRepositoryMockArchiveOrders {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Set")
+	}
+
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &ArchiveRepositoryMockArchiveOrdersExpectation{}
+	}
+
+	if mmArchiveOrders.defaultExpectation.params != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Expect")
+	}
+
+	if mmArchiveOrders.defaultExpectation.paramPtrs == nil {
+		mmArchiveOrders.defaultExpectation.paramPtrs = &ArchiveRepositoryMockArchiveOrdersParamPtrs{}
+	}
+	mmArchiveOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmArchiveOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmArchiveOrders
+}
+
+// ExpectOlderThanParam2 sets up expected param olderThan for ArchiveRepository.ArchiveOrders
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) ExpectOlderThanParam2(olderThan time.Time) *mArchiveRepositoryMockArchiveOrders {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Set")
+	}
+
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &ArchiveRepositoryMockArchiveOrdersExpectation{}
+	}
+
+	if mmArchiveOrders.defaultExpectation.params != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Expect")
+	}
+
+	if mmArchiveOrders.defaultExpectation.paramPtrs == nil {
+		mmArchiveOrders.defaultExpectation.paramPtrs = &ArchiveRepositoryMockArchiveOrdersParamPtrs{}
+	}
+	mmArchiveOrders.defaultExpectation.paramPtrs.olderThan = &olderThan
+	mmArchiveOrders.defaultExpectation.expectationOrigins.originOlderThan = minimock.CallerInfo(1)
+
+	return mmArchiveOrders
+}
+
+// ExpectArchivedAtParam3 sets up expected param archivedAt for ArchiveRepository.ArchiveOrders
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) ExpectArchivedAtParam3(archivedAt time.Time) *mArchiveRepositoryMockArchiveOrders {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Set")
+	}
+
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &ArchiveRepositoryMockArchiveOrdersExpectation{}
+	}
+
+	if mmArchiveOrders.defaultExpectation.params != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Expect")
+	}
+
+	if mmArchiveOrders.defaultExpectation.paramPtrs == nil {
+		mmArchiveOrders.defaultExpectation.paramPtrs = &ArchiveRepositoryMockArchiveOrdersParamPtrs{}
+	}
+	mmArchiveOrders.defaultExpectation.paramPtrs.archivedAt = &archivedAt
+	mmArchiveOrders.defaultExpectation.expectationOrigins.originArchivedAt = minimock.CallerInfo(1)
+
+	return mmArchiveOrders
+}
+
+// ExpectLimitParam4 sets up expected param limit for ArchiveRepository.ArchiveOrders
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) ExpectLimitParam4(limit int) *mArchiveRepositoryMockArchiveOrders {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Set")
+	}
+
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &ArchiveRepositoryMockArchiveOrdersExpectation{}
+	}
+
+	if mmArchiveOrders.defaultExpectation.params != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Expect")
+	}
+
+	if mmArchiveOrders.defaultExpectation.paramPtrs == nil {
+		mmArchiveOrders.defaultExpectation.paramPtrs = &ArchiveRepositoryMockArchiveOrdersParamPtrs{}
+	}
+	mmArchiveOrders.defaultExpectation.paramPtrs.limit = &limit
+	mmArchiveOrders.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmArchiveOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the ArchiveRepository.ArchiveOrders
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) Inspect(f func(ctx context.Context, olderThan time.Time, archivedAt time.Time, limit int)) *mArchiveRepositoryMockArchiveOrders {
+	if mmArchiveOrders.mock.inspectFuncArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("Inspect function is already set for ArchiveRepositoryMock.ArchiveOrders")
+	}
+
+	mmArchiveOrders.mock.inspectFuncArchiveOrders = f
+
+	return mmArchiveOrders
+}
+
+// Return sets up results that will be returned by ArchiveRepository.ArchiveOrders
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) Return(i1 int, err error) *ArchiveRepositoryMock {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Set")
+	}
+
+	if mmArchiveOrders.defaultExpectation == nil {
+		mmArchiveOrders.defaultExpectation = &ArchiveRepositoryMockArchiveOrdersExpectation{mock: mmArchiveOrders.mock}
+	}
+	mmArchiveOrders.defaultExpectation.results = &ArchiveRepositoryMockArchiveOrdersResults{i1, err}
+	mmArchiveOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmArchiveOrders.mock
+}
+
+// Set uses given function f to mock the ArchiveRepository.ArchiveOrders method
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) Set(f func(ctx context.Context, olderThan time.Time, archivedAt time.Time, limit int) (i1 int, err error)) *ArchiveRepositoryMock {
+	if mmArchiveOrders.defaultExpectation != nil {
+		mmArchiveOrders.mock.t.Fatalf("Default expectation is already set for the ArchiveRepository.ArchiveOrders method")
+	}
+
+	if len(mmArchiveOrders.expectations) > 0 {
+		mmArchiveOrders.mock.t.Fatalf("Some expectations are already set for the ArchiveRepository.ArchiveOrders method")
+	}
+
+	mmArchiveOrders.mock.funcArchiveOrders = f
+	mmArchiveOrders.mock.funcArchiveOrdersOrigin = minimock.CallerInfo(1)
+	return mmArchiveOrders.mock
+}
+
+// When sets expectation for the ArchiveRepository.ArchiveOrders which will trigger the result defined by the following
+// Then helper
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) When(ctx context.Context, olderThan time.Time, archivedAt time.Time, limit int) *ArchiveRepositoryMockArchiveOrdersExpectation {
+	if mmArchiveOrders.mock.funcArchiveOrders != nil {
+		mmArchiveOrders.mock.t.Fatalf("ArchiveRepositoryMock.ArchiveOrders mock is already set by Set")
+	}
+
+	expectation := &ArchiveRepositoryMockArchiveOrdersExpectation{
+		mock:               mmArchiveOrders.mock,
+		params:             &ArchiveRepositoryMockArchiveOrdersParams{ctx, olderThan, archivedAt, limit},
+		expectationOrigins: ArchiveRepositoryMockArchiveOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmArchiveOrders.expectations = append(mmArchiveOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ArchiveRepository.ArchiveOrders return parameters for the expectation previously defined by the When method
+func (e *ArchiveRepositoryMockArchiveOrdersExpectation) Then(i1 int, err error) *ArchiveRepositoryMock {
+	e.results = &ArchiveRepositoryMockArchiveOrdersResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ArchiveRepository.ArchiveOrders should be invoked
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) Times(n uint64) *mArchiveRepositoryMockArchiveOrders {
+	if n == 0 {
+		mmArchiveOrders.mock.t.Fatalf("Times of ArchiveRepositoryMock.ArchiveOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmArchiveOrders.expectedInvocations, n)
+	mmArchiveOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmArchiveOrders
+}
+
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) invocationsDone() bool {
+	if len(mmArchiveOrders.expectations) == 0 && mmArchiveOrders.defaultExpectation == nil && mmArchiveOrders.mock.funcArchiveOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmArchiveOrders.mock.afterArchiveOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmArchiveOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ArchiveOrders implements mm_repositories.ArchiveRepository
+func (mmArchiveOrders *ArchiveRepositoryMock) ArchiveOrders(ctx context.Context, olderThan time.Time, archivedAt time.Time, limit int) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmArchiveOrders.beforeArchiveOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmArchiveOrders.afterArchiveOrdersCounter, 1)
+
+	mmArchiveOrders.t.Helper()
+
+	if mmArchiveOrders.inspectFuncArchiveOrders != nil {
+		mmArchiveOrders.inspectFuncArchiveOrders(ctx, olderThan, archivedAt, limit)
+	}
+
+	mm_params := ArchiveRepositoryMockArchiveOrdersParams{ctx, olderThan, archivedAt, limit}
+
+	// Record call args
+	mmArchiveOrders.ArchiveOrdersMock.mutex.Lock()
+	mmArchiveOrders.ArchiveOrdersMock.callArgs = append(mmArchiveOrders.ArchiveOrdersMock.callArgs, &mm_params)
+	mmArchiveOrders.ArchiveOrdersMock.mutex.Unlock()
+
+	for _, e := range mmArchiveOrders.ArchiveOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmArchiveOrders.ArchiveOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := ArchiveRepositoryMockArchiveOrdersParams{ctx, olderThan, archivedAt, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmArchiveOrders.t.Errorf("ArchiveRepositoryMock.ArchiveOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.olderThan != nil && !minimock.Equal(*mm_want_ptrs.olderThan, mm_got.olderThan) {
+				mmArchiveOrders.t.Errorf("ArchiveRepositoryMock.ArchiveOrders got unexpected parameter olderThan, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.expectationOrigins.originOlderThan, *mm_want_ptrs.olderThan, mm_got.olderThan, minimock.Diff(*mm_want_ptrs.olderThan, mm_got.olderThan))
+			}
+
+			if mm_want_ptrs.archivedAt != nil && !minimock.Equal(*mm_want_ptrs.archivedAt, mm_got.archivedAt) {
+				mmArchiveOrders.t.Errorf("ArchiveRepositoryMock.ArchiveOrders got unexpected parameter archivedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.expectationOrigins.originArchivedAt, *mm_want_ptrs.archivedAt, mm_got.archivedAt, minimock.Diff(*mm_want_ptrs.archivedAt, mm_got.archivedAt))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmArchiveOrders.t.Errorf("ArchiveRepositoryMock.ArchiveOrders got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmArchiveOrders.t.Errorf("ArchiveRepositoryMock.ArchiveOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmArchiveOrders.ArchiveOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmArchiveOrders.t.Fatal("No results are set for the ArchiveRepositoryMock.ArchiveOrders")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmArchiveOrders.funcArchiveOrders != nil {
+		return mmArchiveOrders.funcArchiveOrders(ctx, olderThan, archivedAt, limit)
+	}
+	mmArchiveOrders.t.Fatalf("Unexpected call to ArchiveRepositoryMock.ArchiveOrders. %v %v %v %v", ctx, olderThan, archivedAt, limit)
+	return
+}
+
+// ArchiveOrdersAfterCounter returns a count of finished ArchiveRepositoryMock.ArchiveOrders invocations
+func (mmArchiveOrders *ArchiveRepositoryMock) ArchiveOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchiveOrders.afterArchiveOrdersCounter)
+}
+
+// ArchiveOrdersBeforeCounter returns a count of ArchiveRepositoryMock.ArchiveOrders invocations
+func (mmArchiveOrders *ArchiveRepositoryMock) ArchiveOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmArchiveOrders.beforeArchiveOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ArchiveRepositoryMock.ArchiveOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmArchiveOrders *mArchiveRepositoryMockArchiveOrders) Calls() []*ArchiveRepositoryMockArchiveOrdersParams {
+	mmArchiveOrders.mutex.RLock()
+
+	argCopy := make([]*ArchiveRepositoryMockArchiveOrdersParams, len(mmArchiveOrders.callArgs))
+	copy(argCopy, mmArchiveOrders.callArgs)
+
+	mmArchiveOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockArchiveOrdersDone returns true if the count of the ArchiveOrders invocations corresponds
+// the number of defined expectations
+func (m *ArchiveRepositoryMock) MinimockArchiveOrdersDone() bool {
+	if m.ArchiveOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ArchiveOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ArchiveOrdersMock.invocationsDone()
+}
+
+// MinimockArchiveOrdersInspect logs each unmet expectation
+func (m *ArchiveRepositoryMock) MinimockArchiveOrdersInspect() {
+	for _, e := range m.ArchiveOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ArchiveRepositoryMock.ArchiveOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterArchiveOrdersCounter := mm_atomic.LoadUint64(&m.afterArchiveOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ArchiveOrdersMock.defaultExpectation != nil && afterArchiveOrdersCounter < 1 {
+		if m.ArchiveOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ArchiveRepositoryMock.ArchiveOrders at\n%s", m.ArchiveOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ArchiveRepositoryMock.ArchiveOrders at\n%s with params: %#v", m.ArchiveOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ArchiveOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcArchiveOrders != nil && afterArchiveOrdersCounter < 1 {
+		m.t.Errorf("Expected call to ArchiveRepositoryMock.ArchiveOrders at\n%s", m.funcArchiveOrdersOrigin)
+	}
+
+	if !m.ArchiveOrdersMock.invocationsDone() && afterArchiveOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ArchiveRepositoryMock.ArchiveOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ArchiveOrdersMock.expectedInvocations), m.ArchiveOrdersMock.expectedInvocationsOrigin, afterArchiveOrdersCounter)
+	}
+}
+
+type mArchiveRepositoryMockPurgeArchive struct {
+	optional           bool
+	mock               *ArchiveRepositoryMock
+	defaultExpectation *ArchiveRepositoryMockPurgeArchiveExpectation
+	expectations       []*ArchiveRepositoryMockPurgeArchiveExpectation
+
+	callArgs []*ArchiveRepositoryMockPurgeArchiveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ArchiveRepositoryMockPurgeArchiveExpectation specifies expectation struct of the ArchiveRepository.PurgeArchive
+type ArchiveRepositoryMockPurgeArchiveExpectation struct {
+	mock               *ArchiveRepositoryMock
+	params             *ArchiveRepositoryMockPurgeArchiveParams
+	paramPtrs          *ArchiveRepositoryMockPurgeArchiveParamPtrs
+	expectationOrigins ArchiveRepositoryMockPurgeArchiveExpectationOrigins
+	results            *ArchiveRepositoryMockPurgeArchiveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ArchiveRepositoryMockPurgeArchiveParams contains parameters of the ArchiveRepository.PurgeArchive
+type ArchiveRepositoryMockPurgeArchiveParams struct {
+	ctx            context.Context
+	archivedBefore time.Time
+}
+
+// ArchiveRepositoryMockPurgeArchiveParamPtrs contains pointers to parameters of the ArchiveRepository.PurgeArchive
+type ArchiveRepositoryMockPurgeArchiveParamPtrs struct {
+	ctx            *context.Context
+	archivedBefore *time.Time
+}
+
+// ArchiveRepositoryMockPurgeArchiveResults contains results of the ArchiveRepository.PurgeArchive
+type ArchiveRepositoryMockPurgeArchiveResults struct {
+	i1  int
+	err error
+}
+
+// ArchiveRepositoryMockPurgeArchiveOrigins contains origins of expectations of the ArchiveRepository.PurgeArchive
+type ArchiveRepositoryMockPurgeArchiveExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originArchivedBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) Optional() *mArchiveRepositoryMockPurgeArchive {
+	mmPurgeArchive.optional = true
+	return mmPurgeArchive
+}
+
+// Expect sets up expected params for ArchiveRepository.PurgeArchive
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) Expect(ctx context.Context, archivedBefore time.Time) *mArchiveRepositoryMockPurgeArchive {
+	if mmPurgeArchive.mock.funcPurgeArchive != nil {
+		mmPurgeArchive.mock.t.Fatalf("ArchiveRepositoryMock.PurgeArchive mock is already set by Set")
+	}
+
+	if mmPurgeArchive.defaultExpectation == nil {
+		mmPurgeArchive.defaultExpectation = &ArchiveRepositoryMockPurgeArchiveExpectation{}
+	}
+
+	if mmPurgeArchive.defaultExpectation.paramPtrs != nil {
+		mmPurgeArchive.mock.t.Fatalf("ArchiveRepositoryMock.PurgeArchive mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeArchive.defaultExpectation.params = &ArchiveRepositoryMockPurgeArchiveParams{ctx, archivedBefore}
+	mmPurgeArchive.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeArchive.expectations {
+		if minimock.Equal(e.params, mmPurgeArchive.defaultExpectation.params) {
+			mmPurgeArchive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeArchive.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeArchive
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ArchiveRepository.PurgeArchive
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) ExpectCtxParam1(ctx context.Context) *mArchiveRepositoryMockPurgeArchive {
+	if mmPurgeArchive.mock.funcPurgeArchive != nil {
+		mmPurgeArchive.mock.t.Fatalf("ArchiveRepositoryMock.PurgeArchive mock is already set by Set")
+	}
+
+	if mmPurgeArchive.defaultExpectation == nil {
+		mmPurgeArchive.defaultExpectation = &ArchiveRepositoryMockPurgeArchiveExpectation{}
+	}
+
+	if mmPurgeArchive.defaultExpectation.params != nil {
+		mmPurgeArchive.mock.t.Fatalf("ArchiveRepositoryMock.PurgeArchive mock is already set by Expect")
+	}
+
+	if mmPurgeArchive.defaultExpectation.paramPtrs == nil {
+		mmPurgeArchive.defaultExpectation.paramPtrs = &ArchiveRepositoryMockPurgeArchiveParamPtrs{}
+	}
+	mmPurgeArchive.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeArchive.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeArchive
+}
+
+// ExpectArchivedBeforeParam2 sets up expected param archivedBefore for ArchiveRepository.PurgeArchive
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) ExpectArchivedBeforeParam2(archivedBefore time.Time) *mArchiveRepositoryMockPurgeArchive {
+	if mmPurgeArchive.mock.funcPurgeArchive != nil {
+		mmPurgeArchive.mock.t.Fatalf("ArchiveRepositoryMock.PurgeArchive mock is already set by Set")
+	}
+
+	if mmPurgeArchive.defaultExpectation == nil {
+		mmPurgeArchive.defaultExpectation = &ArchiveRepositoryMockPurgeArchiveExpectation{}
+	}
+
+	if mmPurgeArchive.defaultExpectation.params != nil {
+		mmPurgeArchive.mock.t.Fatalf("ArchiveRepositoryMock.PurgeArchive mock is already set by Expect")
+	}
+
+	if mmPurgeArchive.defaultExpectation.paramPtrs == nil {
+		mmPurgeArchive.defaultExpectation.paramPtrs = &ArchiveRepositoryMockPurgeArchiveParamPtrs{}
+	}
+	mmPurgeArchive.defaultExpectation.paramPtrs.archivedBefore = &archivedBefore
+	mmPurgeArchive.defaultExpectation.expectationOrigins.originArchivedBefore = minimock.CallerInfo(1)
+
+	return mmPurgeArchive
+}
+
+// Inspect accepts an inspector function that has same arguments as the ArchiveRepository.PurgeArchive
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) Inspect(f func(ctx context.Context, archivedBefore time.Time)) *mArchiveRepositoryMockPurgeArchive {
+	if mmPurgeArchive.mock.inspectFuncPurgeArchive != nil {
+		mmPurgeArchive.mock.t.Fatalf("Inspect function is already set for ArchiveRepositoryMock.PurgeArchive")
+	}
+
+	mmPurgeArchive.mock.inspectFuncPurgeArchive = f
+
+	return mmPurgeArchive
+}
+
+// Return sets up results that will be returned by ArchiveRepository.PurgeArchive
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) Return(i1 int, err error) *ArchiveRepositoryMock {
+	if mmPurgeArchive.mock.funcPurgeArchive != nil {
+		mmPurgeArchive.mock.t.Fatalf("ArchiveRepositoryMock.PurgeArchive mock is already set by Set")
+	}
+
+	if mmPurgeArchive.defaultExpectation == nil {
+		mmPurgeArchive.defaultExpectation = &ArchiveRepositoryMockPurgeArchiveExpectation{mock: mmPurgeArchive.mock}
+	}
+	mmPurgeArchive.defaultExpectation.results = &ArchiveRepositoryMockPurgeArchiveResults{i1, err}
+	mmPurgeArchive.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeArchive.mock
+}
+
+// Set uses given function f to mock the ArchiveRepository.PurgeArchive method
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) Set(f func(ctx context.Context, archivedBefore time.Time) (i1 int, err error)) *ArchiveRepositoryMock {
+	if mmPurgeArchive.defaultExpectation != nil {
+		mmPurgeArchive.mock.t.Fatalf("Default expectation is already set for the ArchiveRepository.PurgeArchive method")
+	}
+
+	if len(mmPurgeArchive.expectations) > 0 {
+		mmPurgeArchive.mock.t.Fatalf("Some expectations are already set for the ArchiveRepository.PurgeArchive method")
+	}
+
+	mmPurgeArchive.mock.funcPurgeArchive = f
+	mmPurgeArchive.mock.funcPurgeArchiveOrigin = minimock.CallerInfo(1)
+	return mmPurgeArchive.mock
+}
+
+// When sets expectation for the ArchiveRepository.PurgeArchive which will trigger the result defined by the following
+// Then helper
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) When(ctx context.Context, archivedBefore time.Time) *ArchiveRepositoryMockPurgeArchiveExpectation {
+	if mmPurgeArchive.mock.funcPurgeArchive != nil {
+		mmPurgeArchive.mock.t.Fatalf("ArchiveRepositoryMock.PurgeArchive mock is already set by Set")
+	}
+
+	expectation := &ArchiveRepositoryMockPurgeArchiveExpectation{
+		mock:               mmPurgeArchive.mock,
+		params:             &ArchiveRepositoryMockPurgeArchiveParams{ctx, archivedBefore},
+		expectationOrigins: ArchiveRepositoryMockPurgeArchiveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeArchive.expectations = append(mmPurgeArchive.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ArchiveRepository.PurgeArchive return parameters for the expectation previously defined by the When method
+func (e *ArchiveRepositoryMockPurgeArchiveExpectation) Then(i1 int, err error) *ArchiveRepositoryMock {
+	e.results = &ArchiveRepositoryMockPurgeArchiveResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ArchiveRepository.PurgeArchive should be invoked
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) Times(n uint64) *mArchiveRepositoryMockPurgeArchive {
+	if n == 0 {
+		mmPurgeArchive.mock.t.Fatalf("Times of ArchiveRepositoryMock.PurgeArchive mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeArchive.expectedInvocations, n)
+	mmPurgeArchive.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeArchive
+}
+
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) invocationsDone() bool {
+	if len(mmPurgeArchive.expectations) == 0 && mmPurgeArchive.defaultExpectation == nil && mmPurgeArchive.mock.funcPurgeArchive == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeArchive.mock.afterPurgeArchiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeArchive.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeArchive implements mm_repositories.ArchiveRepository
+func (mmPurgeArchive *ArchiveRepositoryMock) PurgeArchive(ctx context.Context, archivedBefore time.Time) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmPurgeArchive.beforePurgeArchiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeArchive.afterPurgeArchiveCounter, 1)
+
+	mmPurgeArchive.t.Helper()
+
+	if mmPurgeArchive.inspectFuncPurgeArchive != nil {
+		mmPurgeArchive.inspectFuncPurgeArchive(ctx, archivedBefore)
+	}
+
+	mm_params := ArchiveRepositoryMockPurgeArchiveParams{ctx, archivedBefore}
+
+	// Record call args
+	mmPurgeArchive.PurgeArchiveMock.mutex.Lock()
+	mmPurgeArchive.PurgeArchiveMock.callArgs = append(mmPurgeArchive.PurgeArchiveMock.callArgs, &mm_params)
+	mmPurgeArchive.PurgeArchiveMock.mutex.Unlock()
+
+	for _, e := range mmPurgeArchive.PurgeArchiveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeArchive.PurgeArchiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeArchive.PurgeArchiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeArchive.PurgeArchiveMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeArchive.PurgeArchiveMock.defaultExpectation.paramPtrs
+
+		mm_got := ArchiveRepositoryMockPurgeArchiveParams{ctx, archivedBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeArchive.t.Errorf("ArchiveRepositoryMock.PurgeArchive got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeArchive.PurgeArchiveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.archivedBefore != nil && !minimock.Equal(*mm_want_ptrs.archivedBefore, mm_got.archivedBefore) {
+				mmPurgeArchive.t.Errorf("ArchiveRepositoryMock.PurgeArchive got unexpected parameter archivedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeArchive.PurgeArchiveMock.defaultExpectation.expectationOrigins.originArchivedBefore, *mm_want_ptrs.archivedBefore, mm_got.archivedBefore, minimock.Diff(*mm_want_ptrs.archivedBefore, mm_got.archivedBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeArchive.t.Errorf("ArchiveRepositoryMock.PurgeArchive got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeArchive.PurgeArchiveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeArchive.PurgeArchiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeArchive.t.Fatal("No results are set for the ArchiveRepositoryMock.PurgeArchive")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeArchive.funcPurgeArchive != nil {
+		return mmPurgeArchive.funcPurgeArchive(ctx, archivedBefore)
+	}
+	mmPurgeArchive.t.Fatalf("Unexpected call to ArchiveRepositoryMock.PurgeArchive. %v %v", ctx, archivedBefore)
+	return
+}
+
+// PurgeArchiveAfterCounter returns a count of finished ArchiveRepositoryMock.PurgeArchive invocations
+func (mmPurgeArchive *ArchiveRepositoryMock) PurgeArchiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeArchive.afterPurgeArchiveCounter)
+}
+
+// PurgeArchiveBeforeCounter returns a count of ArchiveRepositoryMock.PurgeArchive invocations
+func (mmPurgeArchive *ArchiveRepositoryMock) PurgeArchiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeArchive.beforePurgeArchiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to ArchiveRepositoryMock.PurgeArchive.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeArchive *mArchiveRepositoryMockPurgeArchive) Calls() []*ArchiveRepositoryMockPurgeArchiveParams {
+	mmPurgeArchive.mutex.RLock()
+
+	argCopy := make([]*ArchiveRepositoryMockPurgeArchiveParams, len(mmPurgeArchive.callArgs))
+	copy(argCopy, mmPurgeArchive.callArgs)
+
+	mmPurgeArchive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeArchiveDone returns true if the count of the PurgeArchive invocations corresponds
+// the number of defined expectations
+func (m *ArchiveRepositoryMock) MinimockPurgeArchiveDone() bool {
+	if m.PurgeArchiveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeArchiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeArchiveMock.invocationsDone()
+}
+
+// MinimockPurgeArchiveInspect logs each unmet expectation
+func (m *ArchiveRepositoryMock) MinimockPurgeArchiveInspect() {
+	for _, e := range m.PurgeArchiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ArchiveRepositoryMock.PurgeArchive at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeArchiveCounter := mm_atomic.LoadUint64(&m.afterPurgeArchiveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeArchiveMock.defaultExpectation != nil && afterPurgeArchiveCounter < 1 {
+		if m.PurgeArchiveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ArchiveRepositoryMock.PurgeArchive at\n%s", m.PurgeArchiveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ArchiveRepositoryMock.PurgeArchive at\n%s with params: %#v", m.PurgeArchiveMock.defaultExpectation.expectationOrigins.origin, *m.PurgeArchiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeArchive != nil && afterPurgeArchiveCounter < 1 {
+		m.t.Errorf("Expected call to ArchiveRepositoryMock.PurgeArchive at\n%s", m.funcPurgeArchiveOrigin)
+	}
+
+	if !m.PurgeArchiveMock.invocationsDone() && afterPurgeArchiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to ArchiveRepositoryMock.PurgeArchive at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeArchiveMock.expectedInvocations), m.PurgeArchiveMock.expectedInvocationsOrigin, afterPurgeArchiveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ArchiveRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockArchiveOrdersInspect()
+
+			m.MinimockPurgeArchiveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ArchiveRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ArchiveRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockArchiveOrdersDone() &&
+		m.MinimockPurgeArchiveDone()
+}
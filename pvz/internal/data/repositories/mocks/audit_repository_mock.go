@@ -0,0 +1,773 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditRepositoryMock implements mm_repositories.AuditRepository
+type AuditRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcList          func(ctx context.Context, filter requests.AuditLogFilter) (aa1 []models.AuditRecord, i1 int, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, filter requests.AuditLogFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mAuditRepositoryMockList
+
+	funcSave          func(ctx context.Context, rec models.AuditRecord) (err error)
+	funcSaveOrigin    string
+	inspectFuncSave   func(ctx context.Context, rec models.AuditRecord)
+	afterSaveCounter  uint64
+	beforeSaveCounter uint64
+	SaveMock          mAuditRepositoryMockSave
+}
+
+// NewAuditRepositoryMock returns a mock for mm_repositories.AuditRepository
+func NewAuditRepositoryMock(t minimock.Tester) *AuditRepositoryMock {
+	m := &AuditRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListMock = mAuditRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*AuditRepositoryMockListParams{}
+
+	m.SaveMock = mAuditRepositoryMockSave{mock: m}
+	m.SaveMock.callArgs = []*AuditRepositoryMockSaveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditRepositoryMockList struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockListExpectation
+	expectations       []*AuditRepositoryMockListExpectation
+
+	callArgs []*AuditRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockListExpectation specifies expectation struct of the AuditRepository.List
+type AuditRepositoryMockListExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockListParams
+	paramPtrs          *AuditRepositoryMockListParamPtrs
+	expectationOrigins AuditRepositoryMockListExpectationOrigins
+	results            *AuditRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockListParams contains parameters of the AuditRepository.List
+type AuditRepositoryMockListParams struct {
+	ctx    context.Context
+	filter requests.AuditLogFilter
+}
+
+// AuditRepositoryMockListParamPtrs contains pointers to parameters of the AuditRepository.List
+type AuditRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter *requests.AuditLogFilter
+}
+
+// AuditRepositoryMockListResults contains results of the AuditRepository.List
+type AuditRepositoryMockListResults struct {
+	aa1 []models.AuditRecord
+	i1  int
+	err error
+}
+
+// AuditRepositoryMockListOrigins contains origins of expectations of the AuditRepository.List
+type AuditRepositoryMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mAuditRepositoryMockList) Optional() *mAuditRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for AuditRepository.List
+func (mmList *mAuditRepositoryMockList) Expect(ctx context.Context, filter requests.AuditLogFilter) *mAuditRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &AuditRepositoryMockListParams{ctx, filter}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.List
+func (mmList *mAuditRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &AuditRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for AuditRepository.List
+func (mmList *mAuditRepositoryMockList) ExpectFilterParam2(filter requests.AuditLogFilter) *mAuditRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &AuditRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+	mmList.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.List
+func (mmList *mAuditRepositoryMockList) Inspect(f func(ctx context.Context, filter requests.AuditLogFilter)) *mAuditRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by AuditRepository.List
+func (mmList *mAuditRepositoryMockList) Return(aa1 []models.AuditRecord, i1 int, err error) *AuditRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &AuditRepositoryMockListResults{aa1, i1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the AuditRepository.List method
+func (mmList *mAuditRepositoryMockList) Set(f func(ctx context.Context, filter requests.AuditLogFilter) (aa1 []models.AuditRecord, i1 int, err error)) *AuditRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the AuditRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the AuditRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the AuditRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mAuditRepositoryMockList) When(ctx context.Context, filter requests.AuditLogFilter) *AuditRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &AuditRepositoryMockListParams{ctx, filter},
+		expectationOrigins: AuditRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.List return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockListExpectation) Then(aa1 []models.AuditRecord, i1 int, err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockListResults{aa1, i1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.List should be invoked
+func (mmList *mAuditRepositoryMockList) Times(n uint64) *mAuditRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of AuditRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mAuditRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repositories.AuditRepository
+func (mmList *AuditRepositoryMock) List(ctx context.Context, filter requests.AuditLogFilter) (aa1 []models.AuditRecord, i1 int, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := AuditRepositoryMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.i1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("AuditRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("AuditRepositoryMock.List got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("AuditRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the AuditRepositoryMock.List")
+		}
+		return (*mm_results).aa1, (*mm_results).i1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to AuditRepositoryMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished AuditRepositoryMock.List invocations
+func (mmList *AuditRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of AuditRepositoryMock.List invocations
+func (mmList *AuditRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mAuditRepositoryMockList) Calls() []*AuditRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mAuditRepositoryMockSave struct {
+	optional           bool
+	mock               *AuditRepositoryMock
+	defaultExpectation *AuditRepositoryMockSaveExpectation
+	expectations       []*AuditRepositoryMockSaveExpectation
+
+	callArgs []*AuditRepositoryMockSaveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditRepositoryMockSaveExpectation specifies expectation struct of the AuditRepository.Save
+type AuditRepositoryMockSaveExpectation struct {
+	mock               *AuditRepositoryMock
+	params             *AuditRepositoryMockSaveParams
+	paramPtrs          *AuditRepositoryMockSaveParamPtrs
+	expectationOrigins AuditRepositoryMockSaveExpectationOrigins
+	results            *AuditRepositoryMockSaveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditRepositoryMockSaveParams contains parameters of the AuditRepository.Save
+type AuditRepositoryMockSaveParams struct {
+	ctx context.Context
+	rec models.AuditRecord
+}
+
+// AuditRepositoryMockSaveParamPtrs contains pointers to parameters of the AuditRepository.Save
+type AuditRepositoryMockSaveParamPtrs struct {
+	ctx *context.Context
+	rec *models.AuditRecord
+}
+
+// AuditRepositoryMockSaveResults contains results of the AuditRepository.Save
+type AuditRepositoryMockSaveResults struct {
+	err error
+}
+
+// AuditRepositoryMockSaveOrigins contains origins of expectations of the AuditRepository.Save
+type AuditRepositoryMockSaveExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originRec string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSave *mAuditRepositoryMockSave) Optional() *mAuditRepositoryMockSave {
+	mmSave.optional = true
+	return mmSave
+}
+
+// Expect sets up expected params for AuditRepository.Save
+func (mmSave *mAuditRepositoryMockSave) Expect(ctx context.Context, rec models.AuditRecord) *mAuditRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("AuditRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &AuditRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.paramPtrs != nil {
+		mmSave.mock.t.Fatalf("AuditRepositoryMock.Save mock is already set by ExpectParams functions")
+	}
+
+	mmSave.defaultExpectation.params = &AuditRepositoryMockSaveParams{ctx, rec}
+	mmSave.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSave.expectations {
+		if minimock.Equal(e.params, mmSave.defaultExpectation.params) {
+			mmSave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSave.defaultExpectation.params)
+		}
+	}
+
+	return mmSave
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditRepository.Save
+func (mmSave *mAuditRepositoryMockSave) ExpectCtxParam1(ctx context.Context) *mAuditRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("AuditRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &AuditRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("AuditRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &AuditRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSave.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// ExpectRecParam2 sets up expected param rec for AuditRepository.Save
+func (mmSave *mAuditRepositoryMockSave) ExpectRecParam2(rec models.AuditRecord) *mAuditRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("AuditRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &AuditRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("AuditRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &AuditRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.rec = &rec
+	mmSave.defaultExpectation.expectationOrigins.originRec = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditRepository.Save
+func (mmSave *mAuditRepositoryMockSave) Inspect(f func(ctx context.Context, rec models.AuditRecord)) *mAuditRepositoryMockSave {
+	if mmSave.mock.inspectFuncSave != nil {
+		mmSave.mock.t.Fatalf("Inspect function is already set for AuditRepositoryMock.Save")
+	}
+
+	mmSave.mock.inspectFuncSave = f
+
+	return mmSave
+}
+
+// Return sets up results that will be returned by AuditRepository.Save
+func (mmSave *mAuditRepositoryMockSave) Return(err error) *AuditRepositoryMock {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("AuditRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &AuditRepositoryMockSaveExpectation{mock: mmSave.mock}
+	}
+	mmSave.defaultExpectation.results = &AuditRepositoryMockSaveResults{err}
+	mmSave.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// Set uses given function f to mock the AuditRepository.Save method
+func (mmSave *mAuditRepositoryMockSave) Set(f func(ctx context.Context, rec models.AuditRecord) (err error)) *AuditRepositoryMock {
+	if mmSave.defaultExpectation != nil {
+		mmSave.mock.t.Fatalf("Default expectation is already set for the AuditRepository.Save method")
+	}
+
+	if len(mmSave.expectations) > 0 {
+		mmSave.mock.t.Fatalf("Some expectations are already set for the AuditRepository.Save method")
+	}
+
+	mmSave.mock.funcSave = f
+	mmSave.mock.funcSaveOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// When sets expectation for the AuditRepository.Save which will trigger the result defined by the following
+// Then helper
+func (mmSave *mAuditRepositoryMockSave) When(ctx context.Context, rec models.AuditRecord) *AuditRepositoryMockSaveExpectation {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("AuditRepositoryMock.Save mock is already set by Set")
+	}
+
+	expectation := &AuditRepositoryMockSaveExpectation{
+		mock:               mmSave.mock,
+		params:             &AuditRepositoryMockSaveParams{ctx, rec},
+		expectationOrigins: AuditRepositoryMockSaveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSave.expectations = append(mmSave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditRepository.Save return parameters for the expectation previously defined by the When method
+func (e *AuditRepositoryMockSaveExpectation) Then(err error) *AuditRepositoryMock {
+	e.results = &AuditRepositoryMockSaveResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditRepository.Save should be invoked
+func (mmSave *mAuditRepositoryMockSave) Times(n uint64) *mAuditRepositoryMockSave {
+	if n == 0 {
+		mmSave.mock.t.Fatalf("Times of AuditRepositoryMock.Save mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSave.expectedInvocations, n)
+	mmSave.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSave
+}
+
+func (mmSave *mAuditRepositoryMockSave) invocationsDone() bool {
+	if len(mmSave.expectations) == 0 && mmSave.defaultExpectation == nil && mmSave.mock.funcSave == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSave.mock.afterSaveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSave.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Save implements mm_repositories.AuditRepository
+func (mmSave *AuditRepositoryMock) Save(ctx context.Context, rec models.AuditRecord) (err error) {
+	mm_atomic.AddUint64(&mmSave.beforeSaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmSave.afterSaveCounter, 1)
+
+	mmSave.t.Helper()
+
+	if mmSave.inspectFuncSave != nil {
+		mmSave.inspectFuncSave(ctx, rec)
+	}
+
+	mm_params := AuditRepositoryMockSaveParams{ctx, rec}
+
+	// Record call args
+	mmSave.SaveMock.mutex.Lock()
+	mmSave.SaveMock.callArgs = append(mmSave.SaveMock.callArgs, &mm_params)
+	mmSave.SaveMock.mutex.Unlock()
+
+	for _, e := range mmSave.SaveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSave.SaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSave.SaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmSave.SaveMock.defaultExpectation.params
+		mm_want_ptrs := mmSave.SaveMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditRepositoryMockSaveParams{ctx, rec}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSave.t.Errorf("AuditRepositoryMock.Save got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rec != nil && !minimock.Equal(*mm_want_ptrs.rec, mm_got.rec) {
+				mmSave.t.Errorf("AuditRepositoryMock.Save got unexpected parameter rec, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originRec, *mm_want_ptrs.rec, mm_got.rec, minimock.Diff(*mm_want_ptrs.rec, mm_got.rec))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSave.t.Errorf("AuditRepositoryMock.Save got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSave.SaveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSave.SaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSave.t.Fatal("No results are set for the AuditRepositoryMock.Save")
+		}
+		return (*mm_results).err
+	}
+	if mmSave.funcSave != nil {
+		return mmSave.funcSave(ctx, rec)
+	}
+	mmSave.t.Fatalf("Unexpected call to AuditRepositoryMock.Save. %v %v", ctx, rec)
+	return
+}
+
+// SaveAfterCounter returns a count of finished AuditRepositoryMock.Save invocations
+func (mmSave *AuditRepositoryMock) SaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.afterSaveCounter)
+}
+
+// SaveBeforeCounter returns a count of AuditRepositoryMock.Save invocations
+func (mmSave *AuditRepositoryMock) SaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.beforeSaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditRepositoryMock.Save.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSave *mAuditRepositoryMockSave) Calls() []*AuditRepositoryMockSaveParams {
+	mmSave.mutex.RLock()
+
+	argCopy := make([]*AuditRepositoryMockSaveParams, len(mmSave.callArgs))
+	copy(argCopy, mmSave.callArgs)
+
+	mmSave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveDone returns true if the count of the Save invocations corresponds
+// the number of defined expectations
+func (m *AuditRepositoryMock) MinimockSaveDone() bool {
+	if m.SaveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveMock.invocationsDone()
+}
+
+// MinimockSaveInspect logs each unmet expectation
+func (m *AuditRepositoryMock) MinimockSaveInspect() {
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Save at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveCounter := mm_atomic.LoadUint64(&m.afterSaveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveMock.defaultExpectation != nil && afterSaveCounter < 1 {
+		if m.SaveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Save at\n%s", m.SaveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditRepositoryMock.Save at\n%s with params: %#v", m.SaveMock.defaultExpectation.expectationOrigins.origin, *m.SaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSave != nil && afterSaveCounter < 1 {
+		m.t.Errorf("Expected call to AuditRepositoryMock.Save at\n%s", m.funcSaveOrigin)
+	}
+
+	if !m.SaveMock.invocationsDone() && afterSaveCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditRepositoryMock.Save at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveMock.expectedInvocations), m.SaveMock.expectedInvocationsOrigin, afterSaveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListInspect()
+
+			m.MinimockSaveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListDone() &&
+		m.MinimockSaveDone()
+}
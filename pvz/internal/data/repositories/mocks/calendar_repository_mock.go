@@ -0,0 +1,740 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// CalendarRepositoryMock implements mm_repositories.CalendarRepository
+type CalendarRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGet          func(ctx context.Context) (p1 models.PickupCalendar, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mCalendarRepositoryMockGet
+
+	funcSave          func(ctx context.Context, cal models.PickupCalendar) (err error)
+	funcSaveOrigin    string
+	inspectFuncSave   func(ctx context.Context, cal models.PickupCalendar)
+	afterSaveCounter  uint64
+	beforeSaveCounter uint64
+	SaveMock          mCalendarRepositoryMockSave
+}
+
+// NewCalendarRepositoryMock returns a mock for mm_repositories.CalendarRepository
+func NewCalendarRepositoryMock(t minimock.Tester) *CalendarRepositoryMock {
+	m := &CalendarRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetMock = mCalendarRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*CalendarRepositoryMockGetParams{}
+
+	m.SaveMock = mCalendarRepositoryMockSave{mock: m}
+	m.SaveMock.callArgs = []*CalendarRepositoryMockSaveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCalendarRepositoryMockGet struct {
+	optional           bool
+	mock               *CalendarRepositoryMock
+	defaultExpectation *CalendarRepositoryMockGetExpectation
+	expectations       []*CalendarRepositoryMockGetExpectation
+
+	callArgs []*CalendarRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CalendarRepositoryMockGetExpectation specifies expectation struct of the CalendarRepository.Get
+type CalendarRepositoryMockGetExpectation struct {
+	mock               *CalendarRepositoryMock
+	params             *CalendarRepositoryMockGetParams
+	paramPtrs          *CalendarRepositoryMockGetParamPtrs
+	expectationOrigins CalendarRepositoryMockGetExpectationOrigins
+	results            *CalendarRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CalendarRepositoryMockGetParams contains parameters of the CalendarRepository.Get
+type CalendarRepositoryMockGetParams struct {
+	ctx context.Context
+}
+
+// CalendarRepositoryMockGetParamPtrs contains pointers to parameters of the CalendarRepository.Get
+type CalendarRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+}
+
+// CalendarRepositoryMockGetResults contains results of the CalendarRepository.Get
+type CalendarRepositoryMockGetResults struct {
+	p1  models.PickupCalendar
+	err error
+}
+
+// CalendarRepositoryMockGetOrigins contains origins of expectations of the CalendarRepository.Get
+type CalendarRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mCalendarRepositoryMockGet) Optional() *mCalendarRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for CalendarRepository.Get
+func (mmGet *mCalendarRepositoryMockGet) Expect(ctx context.Context) *mCalendarRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CalendarRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CalendarRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("CalendarRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &CalendarRepositoryMockGetParams{ctx}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CalendarRepository.Get
+func (mmGet *mCalendarRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mCalendarRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CalendarRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CalendarRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("CalendarRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &CalendarRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the CalendarRepository.Get
+func (mmGet *mCalendarRepositoryMockGet) Inspect(f func(ctx context.Context)) *mCalendarRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for CalendarRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by CalendarRepository.Get
+func (mmGet *mCalendarRepositoryMockGet) Return(p1 models.PickupCalendar, err error) *CalendarRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CalendarRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &CalendarRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &CalendarRepositoryMockGetResults{p1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the CalendarRepository.Get method
+func (mmGet *mCalendarRepositoryMockGet) Set(f func(ctx context.Context) (p1 models.PickupCalendar, err error)) *CalendarRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the CalendarRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the CalendarRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the CalendarRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mCalendarRepositoryMockGet) When(ctx context.Context) *CalendarRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("CalendarRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &CalendarRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &CalendarRepositoryMockGetParams{ctx},
+		expectationOrigins: CalendarRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CalendarRepository.Get return parameters for the expectation previously defined by the When method
+func (e *CalendarRepositoryMockGetExpectation) Then(p1 models.PickupCalendar, err error) *CalendarRepositoryMock {
+	e.results = &CalendarRepositoryMockGetResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times CalendarRepository.Get should be invoked
+func (mmGet *mCalendarRepositoryMockGet) Times(n uint64) *mCalendarRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of CalendarRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mCalendarRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repositories.CalendarRepository
+func (mmGet *CalendarRepositoryMock) Get(ctx context.Context) (p1 models.PickupCalendar, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx)
+	}
+
+	mm_params := CalendarRepositoryMockGetParams{ctx}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := CalendarRepositoryMockGetParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("CalendarRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("CalendarRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the CalendarRepositoryMock.Get")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx)
+	}
+	mmGet.t.Fatalf("Unexpected call to CalendarRepositoryMock.Get. %v", ctx)
+	return
+}
+
+// GetAfterCounter returns a count of finished CalendarRepositoryMock.Get invocations
+func (mmGet *CalendarRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of CalendarRepositoryMock.Get invocations
+func (mmGet *CalendarRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to CalendarRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mCalendarRepositoryMockGet) Calls() []*CalendarRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*CalendarRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *CalendarRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *CalendarRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CalendarRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CalendarRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CalendarRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to CalendarRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to CalendarRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mCalendarRepositoryMockSave struct {
+	optional           bool
+	mock               *CalendarRepositoryMock
+	defaultExpectation *CalendarRepositoryMockSaveExpectation
+	expectations       []*CalendarRepositoryMockSaveExpectation
+
+	callArgs []*CalendarRepositoryMockSaveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CalendarRepositoryMockSaveExpectation specifies expectation struct of the CalendarRepository.Save
+type CalendarRepositoryMockSaveExpectation struct {
+	mock               *CalendarRepositoryMock
+	params             *CalendarRepositoryMockSaveParams
+	paramPtrs          *CalendarRepositoryMockSaveParamPtrs
+	expectationOrigins CalendarRepositoryMockSaveExpectationOrigins
+	results            *CalendarRepositoryMockSaveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CalendarRepositoryMockSaveParams contains parameters of the CalendarRepository.Save
+type CalendarRepositoryMockSaveParams struct {
+	ctx context.Context
+	cal models.PickupCalendar
+}
+
+// CalendarRepositoryMockSaveParamPtrs contains pointers to parameters of the CalendarRepository.Save
+type CalendarRepositoryMockSaveParamPtrs struct {
+	ctx *context.Context
+	cal *models.PickupCalendar
+}
+
+// CalendarRepositoryMockSaveResults contains results of the CalendarRepository.Save
+type CalendarRepositoryMockSaveResults struct {
+	err error
+}
+
+// CalendarRepositoryMockSaveOrigins contains origins of expectations of the CalendarRepository.Save
+type CalendarRepositoryMockSaveExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originCal string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSave *mCalendarRepositoryMockSave) Optional() *mCalendarRepositoryMockSave {
+	mmSave.optional = true
+	return mmSave
+}
+
+// Expect sets up expected params for CalendarRepository.Save
+func (mmSave *mCalendarRepositoryMockSave) Expect(ctx context.Context, cal models.PickupCalendar) *mCalendarRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("CalendarRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &CalendarRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.paramPtrs != nil {
+		mmSave.mock.t.Fatalf("CalendarRepositoryMock.Save mock is already set by ExpectParams functions")
+	}
+
+	mmSave.defaultExpectation.params = &CalendarRepositoryMockSaveParams{ctx, cal}
+	mmSave.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSave.expectations {
+		if minimock.Equal(e.params, mmSave.defaultExpectation.params) {
+			mmSave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSave.defaultExpectation.params)
+		}
+	}
+
+	return mmSave
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CalendarRepository.Save
+func (mmSave *mCalendarRepositoryMockSave) ExpectCtxParam1(ctx context.Context) *mCalendarRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("CalendarRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &CalendarRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("CalendarRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &CalendarRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSave.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// ExpectCalParam2 sets up expected param cal for CalendarRepository.Save
+func (mmSave *mCalendarRepositoryMockSave) ExpectCalParam2(cal models.PickupCalendar) *mCalendarRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("CalendarRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &CalendarRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("CalendarRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &CalendarRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.cal = &cal
+	mmSave.defaultExpectation.expectationOrigins.originCal = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// Inspect accepts an inspector function that has same arguments as the CalendarRepository.Save
+func (mmSave *mCalendarRepositoryMockSave) Inspect(f func(ctx context.Context, cal models.PickupCalendar)) *mCalendarRepositoryMockSave {
+	if mmSave.mock.inspectFuncSave != nil {
+		mmSave.mock.t.Fatalf("Inspect function is already set for CalendarRepositoryMock.Save")
+	}
+
+	mmSave.mock.inspectFuncSave = f
+
+	return mmSave
+}
+
+// Return sets up results that will be returned by CalendarRepository.Save
+func (mmSave *mCalendarRepositoryMockSave) Return(err error) *CalendarRepositoryMock {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("CalendarRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &CalendarRepositoryMockSaveExpectation{mock: mmSave.mock}
+	}
+	mmSave.defaultExpectation.results = &CalendarRepositoryMockSaveResults{err}
+	mmSave.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// Set uses given function f to mock the CalendarRepository.Save method
+func (mmSave *mCalendarRepositoryMockSave) Set(f func(ctx context.Context, cal models.PickupCalendar) (err error)) *CalendarRepositoryMock {
+	if mmSave.defaultExpectation != nil {
+		mmSave.mock.t.Fatalf("Default expectation is already set for the CalendarRepository.Save method")
+	}
+
+	if len(mmSave.expectations) > 0 {
+		mmSave.mock.t.Fatalf("Some expectations are already set for the CalendarRepository.Save method")
+	}
+
+	mmSave.mock.funcSave = f
+	mmSave.mock.funcSaveOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// When sets expectation for the CalendarRepository.Save which will trigger the result defined by the following
+// Then helper
+func (mmSave *mCalendarRepositoryMockSave) When(ctx context.Context, cal models.PickupCalendar) *CalendarRepositoryMockSaveExpectation {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("CalendarRepositoryMock.Save mock is already set by Set")
+	}
+
+	expectation := &CalendarRepositoryMockSaveExpectation{
+		mock:               mmSave.mock,
+		params:             &CalendarRepositoryMockSaveParams{ctx, cal},
+		expectationOrigins: CalendarRepositoryMockSaveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSave.expectations = append(mmSave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CalendarRepository.Save return parameters for the expectation previously defined by the When method
+func (e *CalendarRepositoryMockSaveExpectation) Then(err error) *CalendarRepositoryMock {
+	e.results = &CalendarRepositoryMockSaveResults{err}
+	return e.mock
+}
+
+// Times sets number of times CalendarRepository.Save should be invoked
+func (mmSave *mCalendarRepositoryMockSave) Times(n uint64) *mCalendarRepositoryMockSave {
+	if n == 0 {
+		mmSave.mock.t.Fatalf("Times of CalendarRepositoryMock.Save mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSave.expectedInvocations, n)
+	mmSave.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSave
+}
+
+func (mmSave *mCalendarRepositoryMockSave) invocationsDone() bool {
+	if len(mmSave.expectations) == 0 && mmSave.defaultExpectation == nil && mmSave.mock.funcSave == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSave.mock.afterSaveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSave.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Save implements mm_repositories.CalendarRepository
+func (mmSave *CalendarRepositoryMock) Save(ctx context.Context, cal models.PickupCalendar) (err error) {
+	mm_atomic.AddUint64(&mmSave.beforeSaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmSave.afterSaveCounter, 1)
+
+	mmSave.t.Helper()
+
+	if mmSave.inspectFuncSave != nil {
+		mmSave.inspectFuncSave(ctx, cal)
+	}
+
+	mm_params := CalendarRepositoryMockSaveParams{ctx, cal}
+
+	// Record call args
+	mmSave.SaveMock.mutex.Lock()
+	mmSave.SaveMock.callArgs = append(mmSave.SaveMock.callArgs, &mm_params)
+	mmSave.SaveMock.mutex.Unlock()
+
+	for _, e := range mmSave.SaveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSave.SaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSave.SaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmSave.SaveMock.defaultExpectation.params
+		mm_want_ptrs := mmSave.SaveMock.defaultExpectation.paramPtrs
+
+		mm_got := CalendarRepositoryMockSaveParams{ctx, cal}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSave.t.Errorf("CalendarRepositoryMock.Save got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cal != nil && !minimock.Equal(*mm_want_ptrs.cal, mm_got.cal) {
+				mmSave.t.Errorf("CalendarRepositoryMock.Save got unexpected parameter cal, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originCal, *mm_want_ptrs.cal, mm_got.cal, minimock.Diff(*mm_want_ptrs.cal, mm_got.cal))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSave.t.Errorf("CalendarRepositoryMock.Save got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSave.SaveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSave.SaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSave.t.Fatal("No results are set for the CalendarRepositoryMock.Save")
+		}
+		return (*mm_results).err
+	}
+	if mmSave.funcSave != nil {
+		return mmSave.funcSave(ctx, cal)
+	}
+	mmSave.t.Fatalf("Unexpected call to CalendarRepositoryMock.Save. %v %v", ctx, cal)
+	return
+}
+
+// SaveAfterCounter returns a count of finished CalendarRepositoryMock.Save invocations
+func (mmSave *CalendarRepositoryMock) SaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.afterSaveCounter)
+}
+
+// SaveBeforeCounter returns a count of CalendarRepositoryMock.Save invocations
+func (mmSave *CalendarRepositoryMock) SaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.beforeSaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to CalendarRepositoryMock.Save.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSave *mCalendarRepositoryMockSave) Calls() []*CalendarRepositoryMockSaveParams {
+	mmSave.mutex.RLock()
+
+	argCopy := make([]*CalendarRepositoryMockSaveParams, len(mmSave.callArgs))
+	copy(argCopy, mmSave.callArgs)
+
+	mmSave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveDone returns true if the count of the Save invocations corresponds
+// the number of defined expectations
+func (m *CalendarRepositoryMock) MinimockSaveDone() bool {
+	if m.SaveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveMock.invocationsDone()
+}
+
+// MinimockSaveInspect logs each unmet expectation
+func (m *CalendarRepositoryMock) MinimockSaveInspect() {
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CalendarRepositoryMock.Save at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveCounter := mm_atomic.LoadUint64(&m.afterSaveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveMock.defaultExpectation != nil && afterSaveCounter < 1 {
+		if m.SaveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CalendarRepositoryMock.Save at\n%s", m.SaveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CalendarRepositoryMock.Save at\n%s with params: %#v", m.SaveMock.defaultExpectation.expectationOrigins.origin, *m.SaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSave != nil && afterSaveCounter < 1 {
+		m.t.Errorf("Expected call to CalendarRepositoryMock.Save at\n%s", m.funcSaveOrigin)
+	}
+
+	if !m.SaveMock.invocationsDone() && afterSaveCounter > 0 {
+		m.t.Errorf("Expected %d calls to CalendarRepositoryMock.Save at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveMock.expectedInvocations), m.SaveMock.expectedInvocationsOrigin, afterSaveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CalendarRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetInspect()
+
+			m.MinimockSaveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CalendarRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CalendarRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetDone() &&
+		m.MinimockSaveDone()
+}
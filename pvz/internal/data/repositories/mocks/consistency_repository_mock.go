@@ -0,0 +1,415 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ConsistencyRepositoryMock implements mm_repositories.ConsistencyRepository
+type ConsistencyRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcScan          func(ctx context.Context, fn func(models.OrderRecord) error) (err error)
+	funcScanOrigin    string
+	inspectFuncScan   func(ctx context.Context, fn func(models.OrderRecord) error)
+	afterScanCounter  uint64
+	beforeScanCounter uint64
+	ScanMock          mConsistencyRepositoryMockScan
+}
+
+// NewConsistencyRepositoryMock returns a mock for mm_repositories.ConsistencyRepository
+func NewConsistencyRepositoryMock(t minimock.Tester) *ConsistencyRepositoryMock {
+	m := &ConsistencyRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ScanMock = mConsistencyRepositoryMockScan{mock: m}
+	m.ScanMock.callArgs = []*ConsistencyRepositoryMockScanParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mConsistencyRepositoryMockScan struct {
+	optional           bool
+	mock               *ConsistencyRepositoryMock
+	defaultExpectation *ConsistencyRepositoryMockScanExpectation
+	expectations       []*ConsistencyRepositoryMockScanExpectation
+
+	callArgs []*ConsistencyRepositoryMockScanParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ConsistencyRepositoryMockScanExpectation specifies expectation struct of the ConsistencyRepository.Scan
+type ConsistencyRepositoryMockScanExpectation struct {
+	mock               *ConsistencyRepositoryMock
+	params             *ConsistencyRepositoryMockScanParams
+	paramPtrs          *ConsistencyRepositoryMockScanParamPtrs
+	expectationOrigins ConsistencyRepositoryMockScanExpectationOrigins
+	results            *ConsistencyRepositoryMockScanResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ConsistencyRepositoryMockScanParams contains parameters of the ConsistencyRepository.Scan
+type ConsistencyRepositoryMockScanParams struct {
+	ctx context.Context
+	fn  func(models.OrderRecord) error
+}
+
+// ConsistencyRepositoryMockScanParamPtrs contains pointers to parameters of the ConsistencyRepository.Scan
+type ConsistencyRepositoryMockScanParamPtrs struct {
+	ctx *context.Context
+	fn  *func(models.OrderRecord) error
+}
+
+// ConsistencyRepositoryMockScanResults contains results of the ConsistencyRepository.Scan
+type ConsistencyRepositoryMockScanResults struct {
+	err error
+}
+
+// ConsistencyRepositoryMockScanOrigins contains origins of expectations of the ConsistencyRepository.Scan
+type ConsistencyRepositoryMockScanExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmScan *mConsistencyRepositoryMockScan) Optional() *mConsistencyRepositoryMockScan {
+	mmScan.optional = true
+	return mmScan
+}
+
+// Expect sets up expected params for ConsistencyRepository.Scan
+func (mmScan *mConsistencyRepositoryMockScan) Expect(ctx context.Context, fn func(models.OrderRecord) error) *mConsistencyRepositoryMockScan {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ConsistencyRepositoryMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ConsistencyRepositoryMockScanExpectation{}
+	}
+
+	if mmScan.defaultExpectation.paramPtrs != nil {
+		mmScan.mock.t.Fatalf("ConsistencyRepositoryMock.Scan mock is already set by ExpectParams functions")
+	}
+
+	mmScan.defaultExpectation.params = &ConsistencyRepositoryMockScanParams{ctx, fn}
+	mmScan.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmScan.expectations {
+		if minimock.Equal(e.params, mmScan.defaultExpectation.params) {
+			mmScan.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScan.defaultExpectation.params)
+		}
+	}
+
+	return mmScan
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ConsistencyRepository.Scan
+func (mmScan *mConsistencyRepositoryMockScan) ExpectCtxParam1(ctx context.Context) *mConsistencyRepositoryMockScan {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ConsistencyRepositoryMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ConsistencyRepositoryMockScanExpectation{}
+	}
+
+	if mmScan.defaultExpectation.params != nil {
+		mmScan.mock.t.Fatalf("ConsistencyRepositoryMock.Scan mock is already set by Expect")
+	}
+
+	if mmScan.defaultExpectation.paramPtrs == nil {
+		mmScan.defaultExpectation.paramPtrs = &ConsistencyRepositoryMockScanParamPtrs{}
+	}
+	mmScan.defaultExpectation.paramPtrs.ctx = &ctx
+	mmScan.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmScan
+}
+
+// ExpectFnParam2 sets up expected param fn for ConsistencyRepository.Scan
+func (mmScan *mConsistencyRepositoryMockScan) ExpectFnParam2(fn func(models.OrderRecord) error) *mConsistencyRepositoryMockScan {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ConsistencyRepositoryMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ConsistencyRepositoryMockScanExpectation{}
+	}
+
+	if mmScan.defaultExpectation.params != nil {
+		mmScan.mock.t.Fatalf("ConsistencyRepositoryMock.Scan mock is already set by Expect")
+	}
+
+	if mmScan.defaultExpectation.paramPtrs == nil {
+		mmScan.defaultExpectation.paramPtrs = &ConsistencyRepositoryMockScanParamPtrs{}
+	}
+	mmScan.defaultExpectation.paramPtrs.fn = &fn
+	mmScan.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmScan
+}
+
+// Inspect accepts an inspector function that has same arguments as the ConsistencyRepository.Scan
+func (mmScan *mConsistencyRepositoryMockScan) Inspect(f func(ctx context.Context, fn func(models.OrderRecord) error)) *mConsistencyRepositoryMockScan {
+	if mmScan.mock.inspectFuncScan != nil {
+		mmScan.mock.t.Fatalf("Inspect function is already set for ConsistencyRepositoryMock.Scan")
+	}
+
+	mmScan.mock.inspectFuncScan = f
+
+	return mmScan
+}
+
+// Return sets up results that will be returned by ConsistencyRepository.Scan
+func (mmScan *mConsistencyRepositoryMockScan) Return(err error) *ConsistencyRepositoryMock {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ConsistencyRepositoryMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ConsistencyRepositoryMockScanExpectation{mock: mmScan.mock}
+	}
+	mmScan.defaultExpectation.results = &ConsistencyRepositoryMockScanResults{err}
+	mmScan.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmScan.mock
+}
+
+// Set uses given function f to mock the ConsistencyRepository.Scan method
+func (mmScan *mConsistencyRepositoryMockScan) Set(f func(ctx context.Context, fn func(models.OrderRecord) error) (err error)) *ConsistencyRepositoryMock {
+	if mmScan.defaultExpectation != nil {
+		mmScan.mock.t.Fatalf("Default expectation is already set for the ConsistencyRepository.Scan method")
+	}
+
+	if len(mmScan.expectations) > 0 {
+		mmScan.mock.t.Fatalf("Some expectations are already set for the ConsistencyRepository.Scan method")
+	}
+
+	mmScan.mock.funcScan = f
+	mmScan.mock.funcScanOrigin = minimock.CallerInfo(1)
+	return mmScan.mock
+}
+
+// When sets expectation for the ConsistencyRepository.Scan which will trigger the result defined by the following
+// Then helper
+func (mmScan *mConsistencyRepositoryMockScan) When(ctx context.Context, fn func(models.OrderRecord) error) *ConsistencyRepositoryMockScanExpectation {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ConsistencyRepositoryMock.Scan mock is already set by Set")
+	}
+
+	expectation := &ConsistencyRepositoryMockScanExpectation{
+		mock:               mmScan.mock,
+		params:             &ConsistencyRepositoryMockScanParams{ctx, fn},
+		expectationOrigins: ConsistencyRepositoryMockScanExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmScan.expectations = append(mmScan.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ConsistencyRepository.Scan return parameters for the expectation previously defined by the When method
+func (e *ConsistencyRepositoryMockScanExpectation) Then(err error) *ConsistencyRepositoryMock {
+	e.results = &ConsistencyRepositoryMockScanResults{err}
+	return e.mock
+}
+
+// Times sets number of times ConsistencyRepository.Scan should be invoked
+func (mmScan *mConsistencyRepositoryMockScan) Times(n uint64) *mConsistencyRepositoryMockScan {
+	if n == 0 {
+		mmScan.mock.t.Fatalf("Times of ConsistencyRepositoryMock.Scan mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmScan.expectedInvocations, n)
+	mmScan.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmScan
+}
+
+func (mmScan *mConsistencyRepositoryMockScan) invocationsDone() bool {
+	if len(mmScan.expectations) == 0 && mmScan.defaultExpectation == nil && mmScan.mock.funcScan == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmScan.mock.afterScanCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmScan.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Scan implements mm_repositories.ConsistencyRepository
+func (mmScan *ConsistencyRepositoryMock) Scan(ctx context.Context, fn func(models.OrderRecord) error) (err error) {
+	mm_atomic.AddUint64(&mmScan.beforeScanCounter, 1)
+	defer mm_atomic.AddUint64(&mmScan.afterScanCounter, 1)
+
+	mmScan.t.Helper()
+
+	if mmScan.inspectFuncScan != nil {
+		mmScan.inspectFuncScan(ctx, fn)
+	}
+
+	mm_params := ConsistencyRepositoryMockScanParams{ctx, fn}
+
+	// Record call args
+	mmScan.ScanMock.mutex.Lock()
+	mmScan.ScanMock.callArgs = append(mmScan.ScanMock.callArgs, &mm_params)
+	mmScan.ScanMock.mutex.Unlock()
+
+	for _, e := range mmScan.ScanMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmScan.ScanMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScan.ScanMock.defaultExpectation.Counter, 1)
+		mm_want := mmScan.ScanMock.defaultExpectation.params
+		mm_want_ptrs := mmScan.ScanMock.defaultExpectation.paramPtrs
+
+		mm_got := ConsistencyRepositoryMockScanParams{ctx, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmScan.t.Errorf("ConsistencyRepositoryMock.Scan got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScan.ScanMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmScan.t.Errorf("ConsistencyRepositoryMock.Scan got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScan.ScanMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScan.t.Errorf("ConsistencyRepositoryMock.Scan got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmScan.ScanMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScan.ScanMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScan.t.Fatal("No results are set for the ConsistencyRepositoryMock.Scan")
+		}
+		return (*mm_results).err
+	}
+	if mmScan.funcScan != nil {
+		return mmScan.funcScan(ctx, fn)
+	}
+	mmScan.t.Fatalf("Unexpected call to ConsistencyRepositoryMock.Scan. %v %v", ctx, fn)
+	return
+}
+
+// ScanAfterCounter returns a count of finished ConsistencyRepositoryMock.Scan invocations
+func (mmScan *ConsistencyRepositoryMock) ScanAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScan.afterScanCounter)
+}
+
+// ScanBeforeCounter returns a count of ConsistencyRepositoryMock.Scan invocations
+func (mmScan *ConsistencyRepositoryMock) ScanBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScan.beforeScanCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConsistencyRepositoryMock.Scan.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScan *mConsistencyRepositoryMockScan) Calls() []*ConsistencyRepositoryMockScanParams {
+	mmScan.mutex.RLock()
+
+	argCopy := make([]*ConsistencyRepositoryMockScanParams, len(mmScan.callArgs))
+	copy(argCopy, mmScan.callArgs)
+
+	mmScan.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanDone returns true if the count of the Scan invocations corresponds
+// the number of defined expectations
+func (m *ConsistencyRepositoryMock) MinimockScanDone() bool {
+	if m.ScanMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ScanMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ScanMock.invocationsDone()
+}
+
+// MinimockScanInspect logs each unmet expectation
+func (m *ConsistencyRepositoryMock) MinimockScanInspect() {
+	for _, e := range m.ScanMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConsistencyRepositoryMock.Scan at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterScanCounter := mm_atomic.LoadUint64(&m.afterScanCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanMock.defaultExpectation != nil && afterScanCounter < 1 {
+		if m.ScanMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ConsistencyRepositoryMock.Scan at\n%s", m.ScanMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ConsistencyRepositoryMock.Scan at\n%s with params: %#v", m.ScanMock.defaultExpectation.expectationOrigins.origin, *m.ScanMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScan != nil && afterScanCounter < 1 {
+		m.t.Errorf("Expected call to ConsistencyRepositoryMock.Scan at\n%s", m.funcScanOrigin)
+	}
+
+	if !m.ScanMock.invocationsDone() && afterScanCounter > 0 {
+		m.t.Errorf("Expected %d calls to ConsistencyRepositoryMock.Scan at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ScanMock.expectedInvocations), m.ScanMock.expectedInvocationsOrigin, afterScanCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ConsistencyRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockScanInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ConsistencyRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ConsistencyRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockScanDone()
+}
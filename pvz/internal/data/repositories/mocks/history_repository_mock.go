@@ -31,6 +31,13 @@ type HistoryRepositoryMock struct {
 	afterSaveCounter  uint64
 	beforeSaveCounter uint64
 	SaveMock          mHistoryRepositoryMockSave
+
+	funcStream          func(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error) (err error)
+	funcStreamOrigin    string
+	inspectFuncStream   func(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error)
+	afterStreamCounter  uint64
+	beforeStreamCounter uint64
+	StreamMock          mHistoryRepositoryMockStream
 }
 
 // NewHistoryRepositoryMock returns a mock for mm_repositories.HistoryRepository
@@ -47,6 +54,9 @@ func NewHistoryRepositoryMock(t minimock.Tester) *HistoryRepositoryMock {
 	m.SaveMock = mHistoryRepositoryMockSave{mock: m}
 	m.SaveMock.callArgs = []*HistoryRepositoryMockSaveParams{}
 
+	m.StreamMock = mHistoryRepositoryMockStream{mock: m}
+	m.StreamMock.callArgs = []*HistoryRepositoryMockStreamParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -738,6 +748,379 @@ func (m *HistoryRepositoryMock) MinimockSaveInspect() {
 	}
 }
 
+type mHistoryRepositoryMockStream struct {
+	optional           bool
+	mock               *HistoryRepositoryMock
+	defaultExpectation *HistoryRepositoryMockStreamExpectation
+	expectations       []*HistoryRepositoryMockStreamExpectation
+
+	callArgs []*HistoryRepositoryMockStreamParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HistoryRepositoryMockStreamExpectation specifies expectation struct of the HistoryRepository.Stream
+type HistoryRepositoryMockStreamExpectation struct {
+	mock               *HistoryRepositoryMock
+	params             *HistoryRepositoryMockStreamParams
+	paramPtrs          *HistoryRepositoryMockStreamParamPtrs
+	expectationOrigins HistoryRepositoryMockStreamExpectationOrigins
+	results            *HistoryRepositoryMockStreamResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// HistoryRepositoryMockStreamParams contains parameters of the HistoryRepository.Stream
+type HistoryRepositoryMockStreamParams struct {
+	ctx    context.Context
+	filter requests.OrderHistoryFilter
+	fn     func(models.HistoryEntry) error
+}
+
+// HistoryRepositoryMockStreamParamPtrs contains pointers to parameters of the HistoryRepository.Stream
+type HistoryRepositoryMockStreamParamPtrs struct {
+	ctx    *context.Context
+	filter *requests.OrderHistoryFilter
+	fn     *func(models.HistoryEntry) error
+}
+
+// HistoryRepositoryMockStreamResults contains results of the HistoryRepository.Stream
+type HistoryRepositoryMockStreamResults struct {
+	err error
+}
+
+// HistoryRepositoryMockStreamOrigins contains origins of expectations of the HistoryRepository.Stream
+type HistoryRepositoryMockStreamExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+	originFn     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStream *mHistoryRepositoryMockStream) Optional() *mHistoryRepositoryMockStream {
+	mmStream.optional = true
+	return mmStream
+}
+
+// Expect sets up expected params for HistoryRepository.Stream
+func (mmStream *mHistoryRepositoryMockStream) Expect(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error) *mHistoryRepositoryMockStream {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &HistoryRepositoryMockStreamExpectation{}
+	}
+
+	if mmStream.defaultExpectation.paramPtrs != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by ExpectParams functions")
+	}
+
+	mmStream.defaultExpectation.params = &HistoryRepositoryMockStreamParams{ctx, filter, fn}
+	mmStream.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStream.expectations {
+		if minimock.Equal(e.params, mmStream.defaultExpectation.params) {
+			mmStream.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStream.defaultExpectation.params)
+		}
+	}
+
+	return mmStream
+}
+
+// ExpectCtxParam1 sets up expected param ctx for HistoryRepository.Stream
+func (mmStream *mHistoryRepositoryMockStream) ExpectCtxParam1(ctx context.Context) *mHistoryRepositoryMockStream {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &HistoryRepositoryMockStreamExpectation{}
+	}
+
+	if mmStream.defaultExpectation.params != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by Expect")
+	}
+
+	if mmStream.defaultExpectation.paramPtrs == nil {
+		mmStream.defaultExpectation.paramPtrs = &HistoryRepositoryMockStreamParamPtrs{}
+	}
+	mmStream.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStream.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStream
+}
+
+// ExpectFilterParam2 sets up expected param filter for HistoryRepository.Stream
+func (mmStream *mHistoryRepositoryMockStream) ExpectFilterParam2(filter requests.OrderHistoryFilter) *mHistoryRepositoryMockStream {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &HistoryRepositoryMockStreamExpectation{}
+	}
+
+	if mmStream.defaultExpectation.params != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by Expect")
+	}
+
+	if mmStream.defaultExpectation.paramPtrs == nil {
+		mmStream.defaultExpectation.paramPtrs = &HistoryRepositoryMockStreamParamPtrs{}
+	}
+	mmStream.defaultExpectation.paramPtrs.filter = &filter
+	mmStream.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmStream
+}
+
+// ExpectFnParam3 sets up expected param fn for HistoryRepository.Stream
+func (mmStream *mHistoryRepositoryMockStream) ExpectFnParam3(fn func(models.HistoryEntry) error) *mHistoryRepositoryMockStream {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &HistoryRepositoryMockStreamExpectation{}
+	}
+
+	if mmStream.defaultExpectation.params != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by Expect")
+	}
+
+	if mmStream.defaultExpectation.paramPtrs == nil {
+		mmStream.defaultExpectation.paramPtrs = &HistoryRepositoryMockStreamParamPtrs{}
+	}
+	mmStream.defaultExpectation.paramPtrs.fn = &fn
+	mmStream.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmStream
+}
+
+// Inspect accepts an inspector function that has same arguments as the HistoryRepository.Stream
+func (mmStream *mHistoryRepositoryMockStream) Inspect(f func(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error)) *mHistoryRepositoryMockStream {
+	if mmStream.mock.inspectFuncStream != nil {
+		mmStream.mock.t.Fatalf("Inspect function is already set for HistoryRepositoryMock.Stream")
+	}
+
+	mmStream.mock.inspectFuncStream = f
+
+	return mmStream
+}
+
+// Return sets up results that will be returned by HistoryRepository.Stream
+func (mmStream *mHistoryRepositoryMockStream) Return(err error) *HistoryRepositoryMock {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &HistoryRepositoryMockStreamExpectation{mock: mmStream.mock}
+	}
+	mmStream.defaultExpectation.results = &HistoryRepositoryMockStreamResults{err}
+	mmStream.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStream.mock
+}
+
+// Set uses given function f to mock the HistoryRepository.Stream method
+func (mmStream *mHistoryRepositoryMockStream) Set(f func(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error) (err error)) *HistoryRepositoryMock {
+	if mmStream.defaultExpectation != nil {
+		mmStream.mock.t.Fatalf("Default expectation is already set for the HistoryRepository.Stream method")
+	}
+
+	if len(mmStream.expectations) > 0 {
+		mmStream.mock.t.Fatalf("Some expectations are already set for the HistoryRepository.Stream method")
+	}
+
+	mmStream.mock.funcStream = f
+	mmStream.mock.funcStreamOrigin = minimock.CallerInfo(1)
+	return mmStream.mock
+}
+
+// When sets expectation for the HistoryRepository.Stream which will trigger the result defined by the following
+// Then helper
+func (mmStream *mHistoryRepositoryMockStream) When(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error) *HistoryRepositoryMockStreamExpectation {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("HistoryRepositoryMock.Stream mock is already set by Set")
+	}
+
+	expectation := &HistoryRepositoryMockStreamExpectation{
+		mock:               mmStream.mock,
+		params:             &HistoryRepositoryMockStreamParams{ctx, filter, fn},
+		expectationOrigins: HistoryRepositoryMockStreamExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStream.expectations = append(mmStream.expectations, expectation)
+	return expectation
+}
+
+// Then sets up HistoryRepository.Stream return parameters for the expectation previously defined by the When method
+func (e *HistoryRepositoryMockStreamExpectation) Then(err error) *HistoryRepositoryMock {
+	e.results = &HistoryRepositoryMockStreamResults{err}
+	return e.mock
+}
+
+// Times sets number of times HistoryRepository.Stream should be invoked
+func (mmStream *mHistoryRepositoryMockStream) Times(n uint64) *mHistoryRepositoryMockStream {
+	if n == 0 {
+		mmStream.mock.t.Fatalf("Times of HistoryRepositoryMock.Stream mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStream.expectedInvocations, n)
+	mmStream.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStream
+}
+
+func (mmStream *mHistoryRepositoryMockStream) invocationsDone() bool {
+	if len(mmStream.expectations) == 0 && mmStream.defaultExpectation == nil && mmStream.mock.funcStream == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStream.mock.afterStreamCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStream.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Stream implements mm_repositories.HistoryRepository
+func (mmStream *HistoryRepositoryMock) Stream(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error) (err error) {
+	mm_atomic.AddUint64(&mmStream.beforeStreamCounter, 1)
+	defer mm_atomic.AddUint64(&mmStream.afterStreamCounter, 1)
+
+	mmStream.t.Helper()
+
+	if mmStream.inspectFuncStream != nil {
+		mmStream.inspectFuncStream(ctx, filter, fn)
+	}
+
+	mm_params := HistoryRepositoryMockStreamParams{ctx, filter, fn}
+
+	// Record call args
+	mmStream.StreamMock.mutex.Lock()
+	mmStream.StreamMock.callArgs = append(mmStream.StreamMock.callArgs, &mm_params)
+	mmStream.StreamMock.mutex.Unlock()
+
+	for _, e := range mmStream.StreamMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmStream.StreamMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStream.StreamMock.defaultExpectation.Counter, 1)
+		mm_want := mmStream.StreamMock.defaultExpectation.params
+		mm_want_ptrs := mmStream.StreamMock.defaultExpectation.paramPtrs
+
+		mm_got := HistoryRepositoryMockStreamParams{ctx, filter, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStream.t.Errorf("HistoryRepositoryMock.Stream got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStream.StreamMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmStream.t.Errorf("HistoryRepositoryMock.Stream got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStream.StreamMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmStream.t.Errorf("HistoryRepositoryMock.Stream got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStream.StreamMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStream.t.Errorf("HistoryRepositoryMock.Stream got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStream.StreamMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStream.StreamMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStream.t.Fatal("No results are set for the HistoryRepositoryMock.Stream")
+		}
+		return (*mm_results).err
+	}
+	if mmStream.funcStream != nil {
+		return mmStream.funcStream(ctx, filter, fn)
+	}
+	mmStream.t.Fatalf("Unexpected call to HistoryRepositoryMock.Stream. %v %v %v", ctx, filter, fn)
+	return
+}
+
+// StreamAfterCounter returns a count of finished HistoryRepositoryMock.Stream invocations
+func (mmStream *HistoryRepositoryMock) StreamAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStream.afterStreamCounter)
+}
+
+// StreamBeforeCounter returns a count of HistoryRepositoryMock.Stream invocations
+func (mmStream *HistoryRepositoryMock) StreamBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStream.beforeStreamCounter)
+}
+
+// Calls returns a list of arguments used in each call to HistoryRepositoryMock.Stream.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStream *mHistoryRepositoryMockStream) Calls() []*HistoryRepositoryMockStreamParams {
+	mmStream.mutex.RLock()
+
+	argCopy := make([]*HistoryRepositoryMockStreamParams, len(mmStream.callArgs))
+	copy(argCopy, mmStream.callArgs)
+
+	mmStream.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStreamDone returns true if the count of the Stream invocations corresponds
+// the number of defined expectations
+func (m *HistoryRepositoryMock) MinimockStreamDone() bool {
+	if m.StreamMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StreamMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StreamMock.invocationsDone()
+}
+
+// MinimockStreamInspect logs each unmet expectation
+func (m *HistoryRepositoryMock) MinimockStreamInspect() {
+	for _, e := range m.StreamMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HistoryRepositoryMock.Stream at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStreamCounter := mm_atomic.LoadUint64(&m.afterStreamCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StreamMock.defaultExpectation != nil && afterStreamCounter < 1 {
+		if m.StreamMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HistoryRepositoryMock.Stream at\n%s", m.StreamMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HistoryRepositoryMock.Stream at\n%s with params: %#v", m.StreamMock.defaultExpectation.expectationOrigins.origin, *m.StreamMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStream != nil && afterStreamCounter < 1 {
+		m.t.Errorf("Expected call to HistoryRepositoryMock.Stream at\n%s", m.funcStreamOrigin)
+	}
+
+	if !m.StreamMock.invocationsDone() && afterStreamCounter > 0 {
+		m.t.Errorf("Expected %d calls to HistoryRepositoryMock.Stream at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StreamMock.expectedInvocations), m.StreamMock.expectedInvocationsOrigin, afterStreamCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *HistoryRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
@@ -745,6 +1128,8 @@ func (m *HistoryRepositoryMock) MinimockFinish() {
 			m.MinimockListInspect()
 
 			m.MinimockSaveInspect()
+
+			m.MinimockStreamInspect()
 		}
 	})
 }
@@ -769,5 +1154,6 @@ func (m *HistoryRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockListDone() &&
-		m.MinimockSaveDone()
+		m.MinimockSaveDone() &&
+		m.MinimockStreamDone()
 }
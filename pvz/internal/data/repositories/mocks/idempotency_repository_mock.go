@@ -0,0 +1,2026 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// IdempotencyRepositoryMock implements mm_repositories.IdempotencyRepository
+type IdempotencyRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcComplete          func(ctx context.Context, scope string, key string, response []byte, userIDs []uint64) (err error)
+	funcCompleteOrigin    string
+	inspectFuncComplete   func(ctx context.Context, scope string, key string, response []byte, userIDs []uint64)
+	afterCompleteCounter  uint64
+	beforeCompleteCounter uint64
+	CompleteMock          mIdempotencyRepositoryMockComplete
+
+	funcLoad          func(ctx context.Context, scope string, key string) (i1 models.IdempotencyRecord, err error)
+	funcLoadOrigin    string
+	inspectFuncLoad   func(ctx context.Context, scope string, key string)
+	afterLoadCounter  uint64
+	beforeLoadCounter uint64
+	LoadMock          mIdempotencyRepositoryMockLoad
+
+	funcPurge          func(ctx context.Context, createdBefore time.Time) (i1 int, err error)
+	funcPurgeOrigin    string
+	inspectFuncPurge   func(ctx context.Context, createdBefore time.Time)
+	afterPurgeCounter  uint64
+	beforePurgeCounter uint64
+	PurgeMock          mIdempotencyRepositoryMockPurge
+
+	funcRelease          func(ctx context.Context, scope string, key string) (err error)
+	funcReleaseOrigin    string
+	inspectFuncRelease   func(ctx context.Context, scope string, key string)
+	afterReleaseCounter  uint64
+	beforeReleaseCounter uint64
+	ReleaseMock          mIdempotencyRepositoryMockRelease
+
+	funcReserve          func(ctx context.Context, rec models.IdempotencyRecord, expiredBefore time.Time) (i1 models.IdempotencyRecord, b1 bool, err error)
+	funcReserveOrigin    string
+	inspectFuncReserve   func(ctx context.Context, rec models.IdempotencyRecord, expiredBefore time.Time)
+	afterReserveCounter  uint64
+	beforeReserveCounter uint64
+	ReserveMock          mIdempotencyRepositoryMockReserve
+}
+
+// NewIdempotencyRepositoryMock returns a mock for mm_repositories.IdempotencyRepository
+func NewIdempotencyRepositoryMock(t minimock.Tester) *IdempotencyRepositoryMock {
+	m := &IdempotencyRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CompleteMock = mIdempotencyRepositoryMockComplete{mock: m}
+	m.CompleteMock.callArgs = []*IdempotencyRepositoryMockCompleteParams{}
+
+	m.LoadMock = mIdempotencyRepositoryMockLoad{mock: m}
+	m.LoadMock.callArgs = []*IdempotencyRepositoryMockLoadParams{}
+
+	m.PurgeMock = mIdempotencyRepositoryMockPurge{mock: m}
+	m.PurgeMock.callArgs = []*IdempotencyRepositoryMockPurgeParams{}
+
+	m.ReleaseMock = mIdempotencyRepositoryMockRelease{mock: m}
+	m.ReleaseMock.callArgs = []*IdempotencyRepositoryMockReleaseParams{}
+
+	m.ReserveMock = mIdempotencyRepositoryMockReserve{mock: m}
+	m.ReserveMock.callArgs = []*IdempotencyRepositoryMockReserveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIdempotencyRepositoryMockComplete struct {
+	optional           bool
+	mock               *IdempotencyRepositoryMock
+	defaultExpectation *IdempotencyRepositoryMockCompleteExpectation
+	expectations       []*IdempotencyRepositoryMockCompleteExpectation
+
+	callArgs []*IdempotencyRepositoryMockCompleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdempotencyRepositoryMockCompleteExpectation specifies expectation struct of the IdempotencyRepository.Complete
+type IdempotencyRepositoryMockCompleteExpectation struct {
+	mock               *IdempotencyRepositoryMock
+	params             *IdempotencyRepositoryMockCompleteParams
+	paramPtrs          *IdempotencyRepositoryMockCompleteParamPtrs
+	expectationOrigins IdempotencyRepositoryMockCompleteExpectationOrigins
+	results            *IdempotencyRepositoryMockCompleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdempotencyRepositoryMockCompleteParams contains parameters of the IdempotencyRepository.Complete
+type IdempotencyRepositoryMockCompleteParams struct {
+	ctx      context.Context
+	scope    string
+	key      string
+	response []byte
+	userIDs  []uint64
+}
+
+// IdempotencyRepositoryMockCompleteParamPtrs contains pointers to parameters of the IdempotencyRepository.Complete
+type IdempotencyRepositoryMockCompleteParamPtrs struct {
+	ctx      *context.Context
+	scope    *string
+	key      *string
+	response *[]byte
+	userIDs  *[]uint64
+}
+
+// IdempotencyRepositoryMockCompleteResults contains results of the IdempotencyRepository.Complete
+type IdempotencyRepositoryMockCompleteResults struct {
+	err error
+}
+
+// IdempotencyRepositoryMockCompleteOrigins contains origins of expectations of the IdempotencyRepository.Complete
+type IdempotencyRepositoryMockCompleteExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originScope    string
+	originKey      string
+	originResponse string
+	originUserIDs  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmComplete *mIdempotencyRepositoryMockComplete) Optional() *mIdempotencyRepositoryMockComplete {
+	mmComplete.optional = true
+	return mmComplete
+}
+
+// Expect sets up expected params for IdempotencyRepository.Complete
+func (mmComplete *mIdempotencyRepositoryMockComplete) Expect(ctx context.Context, scope string, key string, response []byte, userIDs []uint64) *mIdempotencyRepositoryMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyRepositoryMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by ExpectParams functions")
+	}
+
+	mmComplete.defaultExpectation.params = &IdempotencyRepositoryMockCompleteParams{ctx, scope, key, response, userIDs}
+	mmComplete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmComplete.expectations {
+		if minimock.Equal(e.params, mmComplete.defaultExpectation.params) {
+			mmComplete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmComplete.defaultExpectation.params)
+		}
+	}
+
+	return mmComplete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdempotencyRepository.Complete
+func (mmComplete *mIdempotencyRepositoryMockComplete) ExpectCtxParam1(ctx context.Context) *mIdempotencyRepositoryMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyRepositoryMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmComplete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectScopeParam2 sets up expected param scope for IdempotencyRepository.Complete
+func (mmComplete *mIdempotencyRepositoryMockComplete) ExpectScopeParam2(scope string) *mIdempotencyRepositoryMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyRepositoryMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.scope = &scope
+	mmComplete.defaultExpectation.expectationOrigins.originScope = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectKeyParam3 sets up expected param key for IdempotencyRepository.Complete
+func (mmComplete *mIdempotencyRepositoryMockComplete) ExpectKeyParam3(key string) *mIdempotencyRepositoryMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyRepositoryMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.key = &key
+	mmComplete.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectResponseParam4 sets up expected param response for IdempotencyRepository.Complete
+func (mmComplete *mIdempotencyRepositoryMockComplete) ExpectResponseParam4(response []byte) *mIdempotencyRepositoryMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyRepositoryMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.response = &response
+	mmComplete.defaultExpectation.expectationOrigins.originResponse = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// ExpectUserIDsParam5 sets up expected param userIDs for IdempotencyRepository.Complete
+func (mmComplete *mIdempotencyRepositoryMockComplete) ExpectUserIDsParam5(userIDs []uint64) *mIdempotencyRepositoryMockComplete {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyRepositoryMockCompleteExpectation{}
+	}
+
+	if mmComplete.defaultExpectation.params != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Expect")
+	}
+
+	if mmComplete.defaultExpectation.paramPtrs == nil {
+		mmComplete.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockCompleteParamPtrs{}
+	}
+	mmComplete.defaultExpectation.paramPtrs.userIDs = &userIDs
+	mmComplete.defaultExpectation.expectationOrigins.originUserIDs = minimock.CallerInfo(1)
+
+	return mmComplete
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdempotencyRepository.Complete
+func (mmComplete *mIdempotencyRepositoryMockComplete) Inspect(f func(ctx context.Context, scope string, key string, response []byte, userIDs []uint64)) *mIdempotencyRepositoryMockComplete {
+	if mmComplete.mock.inspectFuncComplete != nil {
+		mmComplete.mock.t.Fatalf("Inspect function is already set for IdempotencyRepositoryMock.Complete")
+	}
+
+	mmComplete.mock.inspectFuncComplete = f
+
+	return mmComplete
+}
+
+// Return sets up results that will be returned by IdempotencyRepository.Complete
+func (mmComplete *mIdempotencyRepositoryMockComplete) Return(err error) *IdempotencyRepositoryMock {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Set")
+	}
+
+	if mmComplete.defaultExpectation == nil {
+		mmComplete.defaultExpectation = &IdempotencyRepositoryMockCompleteExpectation{mock: mmComplete.mock}
+	}
+	mmComplete.defaultExpectation.results = &IdempotencyRepositoryMockCompleteResults{err}
+	mmComplete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmComplete.mock
+}
+
+// Set uses given function f to mock the IdempotencyRepository.Complete method
+func (mmComplete *mIdempotencyRepositoryMockComplete) Set(f func(ctx context.Context, scope string, key string, response []byte, userIDs []uint64) (err error)) *IdempotencyRepositoryMock {
+	if mmComplete.defaultExpectation != nil {
+		mmComplete.mock.t.Fatalf("Default expectation is already set for the IdempotencyRepository.Complete method")
+	}
+
+	if len(mmComplete.expectations) > 0 {
+		mmComplete.mock.t.Fatalf("Some expectations are already set for the IdempotencyRepository.Complete method")
+	}
+
+	mmComplete.mock.funcComplete = f
+	mmComplete.mock.funcCompleteOrigin = minimock.CallerInfo(1)
+	return mmComplete.mock
+}
+
+// When sets expectation for the IdempotencyRepository.Complete which will trigger the result defined by the following
+// Then helper
+func (mmComplete *mIdempotencyRepositoryMockComplete) When(ctx context.Context, scope string, key string, response []byte, userIDs []uint64) *IdempotencyRepositoryMockCompleteExpectation {
+	if mmComplete.mock.funcComplete != nil {
+		mmComplete.mock.t.Fatalf("IdempotencyRepositoryMock.Complete mock is already set by Set")
+	}
+
+	expectation := &IdempotencyRepositoryMockCompleteExpectation{
+		mock:               mmComplete.mock,
+		params:             &IdempotencyRepositoryMockCompleteParams{ctx, scope, key, response, userIDs},
+		expectationOrigins: IdempotencyRepositoryMockCompleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmComplete.expectations = append(mmComplete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdempotencyRepository.Complete return parameters for the expectation previously defined by the When method
+func (e *IdempotencyRepositoryMockCompleteExpectation) Then(err error) *IdempotencyRepositoryMock {
+	e.results = &IdempotencyRepositoryMockCompleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times IdempotencyRepository.Complete should be invoked
+func (mmComplete *mIdempotencyRepositoryMockComplete) Times(n uint64) *mIdempotencyRepositoryMockComplete {
+	if n == 0 {
+		mmComplete.mock.t.Fatalf("Times of IdempotencyRepositoryMock.Complete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmComplete.expectedInvocations, n)
+	mmComplete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmComplete
+}
+
+func (mmComplete *mIdempotencyRepositoryMockComplete) invocationsDone() bool {
+	if len(mmComplete.expectations) == 0 && mmComplete.defaultExpectation == nil && mmComplete.mock.funcComplete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmComplete.mock.afterCompleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmComplete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Complete implements mm_repositories.IdempotencyRepository
+func (mmComplete *IdempotencyRepositoryMock) Complete(ctx context.Context, scope string, key string, response []byte, userIDs []uint64) (err error) {
+	mm_atomic.AddUint64(&mmComplete.beforeCompleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmComplete.afterCompleteCounter, 1)
+
+	mmComplete.t.Helper()
+
+	if mmComplete.inspectFuncComplete != nil {
+		mmComplete.inspectFuncComplete(ctx, scope, key, response, userIDs)
+	}
+
+	mm_params := IdempotencyRepositoryMockCompleteParams{ctx, scope, key, response, userIDs}
+
+	// Record call args
+	mmComplete.CompleteMock.mutex.Lock()
+	mmComplete.CompleteMock.callArgs = append(mmComplete.CompleteMock.callArgs, &mm_params)
+	mmComplete.CompleteMock.mutex.Unlock()
+
+	for _, e := range mmComplete.CompleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmComplete.CompleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmComplete.CompleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmComplete.CompleteMock.defaultExpectation.params
+		mm_want_ptrs := mmComplete.CompleteMock.defaultExpectation.paramPtrs
+
+		mm_got := IdempotencyRepositoryMockCompleteParams{ctx, scope, key, response, userIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmComplete.t.Errorf("IdempotencyRepositoryMock.Complete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmComplete.t.Errorf("IdempotencyRepositoryMock.Complete got unexpected parameter scope, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originScope, *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmComplete.t.Errorf("IdempotencyRepositoryMock.Complete got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.response != nil && !minimock.Equal(*mm_want_ptrs.response, mm_got.response) {
+				mmComplete.t.Errorf("IdempotencyRepositoryMock.Complete got unexpected parameter response, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originResponse, *mm_want_ptrs.response, mm_got.response, minimock.Diff(*mm_want_ptrs.response, mm_got.response))
+			}
+
+			if mm_want_ptrs.userIDs != nil && !minimock.Equal(*mm_want_ptrs.userIDs, mm_got.userIDs) {
+				mmComplete.t.Errorf("IdempotencyRepositoryMock.Complete got unexpected parameter userIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmComplete.CompleteMock.defaultExpectation.expectationOrigins.originUserIDs, *mm_want_ptrs.userIDs, mm_got.userIDs, minimock.Diff(*mm_want_ptrs.userIDs, mm_got.userIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmComplete.t.Errorf("IdempotencyRepositoryMock.Complete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmComplete.CompleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmComplete.CompleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmComplete.t.Fatal("No results are set for the IdempotencyRepositoryMock.Complete")
+		}
+		return (*mm_results).err
+	}
+	if mmComplete.funcComplete != nil {
+		return mmComplete.funcComplete(ctx, scope, key, response, userIDs)
+	}
+	mmComplete.t.Fatalf("Unexpected call to IdempotencyRepositoryMock.Complete. %v %v %v %v %v", ctx, scope, key, response, userIDs)
+	return
+}
+
+// CompleteAfterCounter returns a count of finished IdempotencyRepositoryMock.Complete invocations
+func (mmComplete *IdempotencyRepositoryMock) CompleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmComplete.afterCompleteCounter)
+}
+
+// CompleteBeforeCounter returns a count of IdempotencyRepositoryMock.Complete invocations
+func (mmComplete *IdempotencyRepositoryMock) CompleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmComplete.beforeCompleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdempotencyRepositoryMock.Complete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmComplete *mIdempotencyRepositoryMockComplete) Calls() []*IdempotencyRepositoryMockCompleteParams {
+	mmComplete.mutex.RLock()
+
+	argCopy := make([]*IdempotencyRepositoryMockCompleteParams, len(mmComplete.callArgs))
+	copy(argCopy, mmComplete.callArgs)
+
+	mmComplete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteDone returns true if the count of the Complete invocations corresponds
+// the number of defined expectations
+func (m *IdempotencyRepositoryMock) MinimockCompleteDone() bool {
+	if m.CompleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteMock.invocationsDone()
+}
+
+// MinimockCompleteInspect logs each unmet expectation
+func (m *IdempotencyRepositoryMock) MinimockCompleteInspect() {
+	for _, e := range m.CompleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Complete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCompleteCounter := mm_atomic.LoadUint64(&m.afterCompleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteMock.defaultExpectation != nil && afterCompleteCounter < 1 {
+		if m.CompleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Complete at\n%s", m.CompleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Complete at\n%s with params: %#v", m.CompleteMock.defaultExpectation.expectationOrigins.origin, *m.CompleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcComplete != nil && afterCompleteCounter < 1 {
+		m.t.Errorf("Expected call to IdempotencyRepositoryMock.Complete at\n%s", m.funcCompleteOrigin)
+	}
+
+	if !m.CompleteMock.invocationsDone() && afterCompleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdempotencyRepositoryMock.Complete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteMock.expectedInvocations), m.CompleteMock.expectedInvocationsOrigin, afterCompleteCounter)
+	}
+}
+
+type mIdempotencyRepositoryMockLoad struct {
+	optional           bool
+	mock               *IdempotencyRepositoryMock
+	defaultExpectation *IdempotencyRepositoryMockLoadExpectation
+	expectations       []*IdempotencyRepositoryMockLoadExpectation
+
+	callArgs []*IdempotencyRepositoryMockLoadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdempotencyRepositoryMockLoadExpectation specifies expectation struct of the IdempotencyRepository.Load
+type IdempotencyRepositoryMockLoadExpectation struct {
+	mock               *IdempotencyRepositoryMock
+	params             *IdempotencyRepositoryMockLoadParams
+	paramPtrs          *IdempotencyRepositoryMockLoadParamPtrs
+	expectationOrigins IdempotencyRepositoryMockLoadExpectationOrigins
+	results            *IdempotencyRepositoryMockLoadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdempotencyRepositoryMockLoadParams contains parameters of the IdempotencyRepository.Load
+type IdempotencyRepositoryMockLoadParams struct {
+	ctx   context.Context
+	scope string
+	key   string
+}
+
+// IdempotencyRepositoryMockLoadParamPtrs contains pointers to parameters of the IdempotencyRepository.Load
+type IdempotencyRepositoryMockLoadParamPtrs struct {
+	ctx   *context.Context
+	scope *string
+	key   *string
+}
+
+// IdempotencyRepositoryMockLoadResults contains results of the IdempotencyRepository.Load
+type IdempotencyRepositoryMockLoadResults struct {
+	i1  models.IdempotencyRecord
+	err error
+}
+
+// IdempotencyRepositoryMockLoadOrigins contains origins of expectations of the IdempotencyRepository.Load
+type IdempotencyRepositoryMockLoadExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originScope string
+	originKey   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLoad *mIdempotencyRepositoryMockLoad) Optional() *mIdempotencyRepositoryMockLoad {
+	mmLoad.optional = true
+	return mmLoad
+}
+
+// Expect sets up expected params for IdempotencyRepository.Load
+func (mmLoad *mIdempotencyRepositoryMockLoad) Expect(ctx context.Context, scope string, key string) *mIdempotencyRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &IdempotencyRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by ExpectParams functions")
+	}
+
+	mmLoad.defaultExpectation.params = &IdempotencyRepositoryMockLoadParams{ctx, scope, key}
+	mmLoad.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLoad.expectations {
+		if minimock.Equal(e.params, mmLoad.defaultExpectation.params) {
+			mmLoad.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLoad.defaultExpectation.params)
+		}
+	}
+
+	return mmLoad
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdempotencyRepository.Load
+func (mmLoad *mIdempotencyRepositoryMockLoad) ExpectCtxParam1(ctx context.Context) *mIdempotencyRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &IdempotencyRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.params != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by Expect")
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs == nil {
+		mmLoad.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockLoadParamPtrs{}
+	}
+	mmLoad.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLoad.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLoad
+}
+
+// ExpectScopeParam2 sets up expected param scope for IdempotencyRepository.Load
+func (mmLoad *mIdempotencyRepositoryMockLoad) ExpectScopeParam2(scope string) *mIdempotencyRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &IdempotencyRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.params != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by Expect")
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs == nil {
+		mmLoad.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockLoadParamPtrs{}
+	}
+	mmLoad.defaultExpectation.paramPtrs.scope = &scope
+	mmLoad.defaultExpectation.expectationOrigins.originScope = minimock.CallerInfo(1)
+
+	return mmLoad
+}
+
+// ExpectKeyParam3 sets up expected param key for IdempotencyRepository.Load
+func (mmLoad *mIdempotencyRepositoryMockLoad) ExpectKeyParam3(key string) *mIdempotencyRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &IdempotencyRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.params != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by Expect")
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs == nil {
+		mmLoad.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockLoadParamPtrs{}
+	}
+	mmLoad.defaultExpectation.paramPtrs.key = &key
+	mmLoad.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmLoad
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdempotencyRepository.Load
+func (mmLoad *mIdempotencyRepositoryMockLoad) Inspect(f func(ctx context.Context, scope string, key string)) *mIdempotencyRepositoryMockLoad {
+	if mmLoad.mock.inspectFuncLoad != nil {
+		mmLoad.mock.t.Fatalf("Inspect function is already set for IdempotencyRepositoryMock.Load")
+	}
+
+	mmLoad.mock.inspectFuncLoad = f
+
+	return mmLoad
+}
+
+// Return sets up results that will be returned by IdempotencyRepository.Load
+func (mmLoad *mIdempotencyRepositoryMockLoad) Return(i1 models.IdempotencyRecord, err error) *IdempotencyRepositoryMock {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &IdempotencyRepositoryMockLoadExpectation{mock: mmLoad.mock}
+	}
+	mmLoad.defaultExpectation.results = &IdempotencyRepositoryMockLoadResults{i1, err}
+	mmLoad.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLoad.mock
+}
+
+// Set uses given function f to mock the IdempotencyRepository.Load method
+func (mmLoad *mIdempotencyRepositoryMockLoad) Set(f func(ctx context.Context, scope string, key string) (i1 models.IdempotencyRecord, err error)) *IdempotencyRepositoryMock {
+	if mmLoad.defaultExpectation != nil {
+		mmLoad.mock.t.Fatalf("Default expectation is already set for the IdempotencyRepository.Load method")
+	}
+
+	if len(mmLoad.expectations) > 0 {
+		mmLoad.mock.t.Fatalf("Some expectations are already set for the IdempotencyRepository.Load method")
+	}
+
+	mmLoad.mock.funcLoad = f
+	mmLoad.mock.funcLoadOrigin = minimock.CallerInfo(1)
+	return mmLoad.mock
+}
+
+// When sets expectation for the IdempotencyRepository.Load which will trigger the result defined by the following
+// Then helper
+func (mmLoad *mIdempotencyRepositoryMockLoad) When(ctx context.Context, scope string, key string) *IdempotencyRepositoryMockLoadExpectation {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("IdempotencyRepositoryMock.Load mock is already set by Set")
+	}
+
+	expectation := &IdempotencyRepositoryMockLoadExpectation{
+		mock:               mmLoad.mock,
+		params:             &IdempotencyRepositoryMockLoadParams{ctx, scope, key},
+		expectationOrigins: IdempotencyRepositoryMockLoadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLoad.expectations = append(mmLoad.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdempotencyRepository.Load return parameters for the expectation previously defined by the When method
+func (e *IdempotencyRepositoryMockLoadExpectation) Then(i1 models.IdempotencyRecord, err error) *IdempotencyRepositoryMock {
+	e.results = &IdempotencyRepositoryMockLoadResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IdempotencyRepository.Load should be invoked
+func (mmLoad *mIdempotencyRepositoryMockLoad) Times(n uint64) *mIdempotencyRepositoryMockLoad {
+	if n == 0 {
+		mmLoad.mock.t.Fatalf("Times of IdempotencyRepositoryMock.Load mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLoad.expectedInvocations, n)
+	mmLoad.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLoad
+}
+
+func (mmLoad *mIdempotencyRepositoryMockLoad) invocationsDone() bool {
+	if len(mmLoad.expectations) == 0 && mmLoad.defaultExpectation == nil && mmLoad.mock.funcLoad == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLoad.mock.afterLoadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLoad.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Load implements mm_repositories.IdempotencyRepository
+func (mmLoad *IdempotencyRepositoryMock) Load(ctx context.Context, scope string, key string) (i1 models.IdempotencyRecord, err error) {
+	mm_atomic.AddUint64(&mmLoad.beforeLoadCounter, 1)
+	defer mm_atomic.AddUint64(&mmLoad.afterLoadCounter, 1)
+
+	mmLoad.t.Helper()
+
+	if mmLoad.inspectFuncLoad != nil {
+		mmLoad.inspectFuncLoad(ctx, scope, key)
+	}
+
+	mm_params := IdempotencyRepositoryMockLoadParams{ctx, scope, key}
+
+	// Record call args
+	mmLoad.LoadMock.mutex.Lock()
+	mmLoad.LoadMock.callArgs = append(mmLoad.LoadMock.callArgs, &mm_params)
+	mmLoad.LoadMock.mutex.Unlock()
+
+	for _, e := range mmLoad.LoadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmLoad.LoadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLoad.LoadMock.defaultExpectation.Counter, 1)
+		mm_want := mmLoad.LoadMock.defaultExpectation.params
+		mm_want_ptrs := mmLoad.LoadMock.defaultExpectation.paramPtrs
+
+		mm_got := IdempotencyRepositoryMockLoadParams{ctx, scope, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLoad.t.Errorf("IdempotencyRepositoryMock.Load got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoad.LoadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmLoad.t.Errorf("IdempotencyRepositoryMock.Load got unexpected parameter scope, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoad.LoadMock.defaultExpectation.expectationOrigins.originScope, *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmLoad.t.Errorf("IdempotencyRepositoryMock.Load got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoad.LoadMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLoad.t.Errorf("IdempotencyRepositoryMock.Load got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLoad.LoadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLoad.LoadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLoad.t.Fatal("No results are set for the IdempotencyRepositoryMock.Load")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmLoad.funcLoad != nil {
+		return mmLoad.funcLoad(ctx, scope, key)
+	}
+	mmLoad.t.Fatalf("Unexpected call to IdempotencyRepositoryMock.Load. %v %v %v", ctx, scope, key)
+	return
+}
+
+// LoadAfterCounter returns a count of finished IdempotencyRepositoryMock.Load invocations
+func (mmLoad *IdempotencyRepositoryMock) LoadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoad.afterLoadCounter)
+}
+
+// LoadBeforeCounter returns a count of IdempotencyRepositoryMock.Load invocations
+func (mmLoad *IdempotencyRepositoryMock) LoadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoad.beforeLoadCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdempotencyRepositoryMock.Load.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLoad *mIdempotencyRepositoryMockLoad) Calls() []*IdempotencyRepositoryMockLoadParams {
+	mmLoad.mutex.RLock()
+
+	argCopy := make([]*IdempotencyRepositoryMockLoadParams, len(mmLoad.callArgs))
+	copy(argCopy, mmLoad.callArgs)
+
+	mmLoad.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoadDone returns true if the count of the Load invocations corresponds
+// the number of defined expectations
+func (m *IdempotencyRepositoryMock) MinimockLoadDone() bool {
+	if m.LoadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoadMock.invocationsDone()
+}
+
+// MinimockLoadInspect logs each unmet expectation
+func (m *IdempotencyRepositoryMock) MinimockLoadInspect() {
+	for _, e := range m.LoadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Load at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoadCounter := mm_atomic.LoadUint64(&m.afterLoadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoadMock.defaultExpectation != nil && afterLoadCounter < 1 {
+		if m.LoadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Load at\n%s", m.LoadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Load at\n%s with params: %#v", m.LoadMock.defaultExpectation.expectationOrigins.origin, *m.LoadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLoad != nil && afterLoadCounter < 1 {
+		m.t.Errorf("Expected call to IdempotencyRepositoryMock.Load at\n%s", m.funcLoadOrigin)
+	}
+
+	if !m.LoadMock.invocationsDone() && afterLoadCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdempotencyRepositoryMock.Load at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoadMock.expectedInvocations), m.LoadMock.expectedInvocationsOrigin, afterLoadCounter)
+	}
+}
+
+type mIdempotencyRepositoryMockPurge struct {
+	optional           bool
+	mock               *IdempotencyRepositoryMock
+	defaultExpectation *IdempotencyRepositoryMockPurgeExpectation
+	expectations       []*IdempotencyRepositoryMockPurgeExpectation
+
+	callArgs []*IdempotencyRepositoryMockPurgeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdempotencyRepositoryMockPurgeExpectation specifies expectation struct of the IdempotencyRepository.Purge
+type IdempotencyRepositoryMockPurgeExpectation struct {
+	mock               *IdempotencyRepositoryMock
+	params             *IdempotencyRepositoryMockPurgeParams
+	paramPtrs          *IdempotencyRepositoryMockPurgeParamPtrs
+	expectationOrigins IdempotencyRepositoryMockPurgeExpectationOrigins
+	results            *IdempotencyRepositoryMockPurgeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdempotencyRepositoryMockPurgeParams contains parameters of the IdempotencyRepository.Purge
+type IdempotencyRepositoryMockPurgeParams struct {
+	ctx           context.Context
+	createdBefore time.Time
+}
+
+// IdempotencyRepositoryMockPurgeParamPtrs contains pointers to parameters of the IdempotencyRepository.Purge
+type IdempotencyRepositoryMockPurgeParamPtrs struct {
+	ctx           *context.Context
+	createdBefore *time.Time
+}
+
+// IdempotencyRepositoryMockPurgeResults contains results of the IdempotencyRepository.Purge
+type IdempotencyRepositoryMockPurgeResults struct {
+	i1  int
+	err error
+}
+
+// IdempotencyRepositoryMockPurgeOrigins contains origins of expectations of the IdempotencyRepository.Purge
+type IdempotencyRepositoryMockPurgeExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originCreatedBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurge *mIdempotencyRepositoryMockPurge) Optional() *mIdempotencyRepositoryMockPurge {
+	mmPurge.optional = true
+	return mmPurge
+}
+
+// Expect sets up expected params for IdempotencyRepository.Purge
+func (mmPurge *mIdempotencyRepositoryMockPurge) Expect(ctx context.Context, createdBefore time.Time) *mIdempotencyRepositoryMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("IdempotencyRepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &IdempotencyRepositoryMockPurgeExpectation{}
+	}
+
+	if mmPurge.defaultExpectation.paramPtrs != nil {
+		mmPurge.mock.t.Fatalf("IdempotencyRepositoryMock.Purge mock is already set by ExpectParams functions")
+	}
+
+	mmPurge.defaultExpectation.params = &IdempotencyRepositoryMockPurgeParams{ctx, createdBefore}
+	mmPurge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurge.expectations {
+		if minimock.Equal(e.params, mmPurge.defaultExpectation.params) {
+			mmPurge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurge.defaultExpectation.params)
+		}
+	}
+
+	return mmPurge
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdempotencyRepository.Purge
+func (mmPurge *mIdempotencyRepositoryMockPurge) ExpectCtxParam1(ctx context.Context) *mIdempotencyRepositoryMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("IdempotencyRepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &IdempotencyRepositoryMockPurgeExpectation{}
+	}
+
+	if mmPurge.defaultExpectation.params != nil {
+		mmPurge.mock.t.Fatalf("IdempotencyRepositoryMock.Purge mock is already set by Expect")
+	}
+
+	if mmPurge.defaultExpectation.paramPtrs == nil {
+		mmPurge.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockPurgeParamPtrs{}
+	}
+	mmPurge.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurge
+}
+
+// ExpectCreatedBeforeParam2 sets up expected param createdBefore for IdempotencyRepository.Purge
+func (mmPurge *mIdempotencyRepositoryMockPurge) ExpectCreatedBeforeParam2(createdBefore time.Time) *mIdempotencyRepositoryMockPurge {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("IdempotencyRepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &IdempotencyRepositoryMockPurgeExpectation{}
+	}
+
+	if mmPurge.defaultExpectation.params != nil {
+		mmPurge.mock.t.Fatalf("IdempotencyRepositoryMock.Purge mock is already set by Expect")
+	}
+
+	if mmPurge.defaultExpectation.paramPtrs == nil {
+		mmPurge.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockPurgeParamPtrs{}
+	}
+	mmPurge.defaultExpectation.paramPtrs.createdBefore = &createdBefore
+	mmPurge.defaultExpectation.expectationOrigins.originCreatedBefore = minimock.CallerInfo(1)
+
+	return mmPurge
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdempotencyRepository.Purge
+func (mmPurge *mIdempotencyRepositoryMockPurge) Inspect(f func(ctx context.Context, createdBefore time.Time)) *mIdempotencyRepositoryMockPurge {
+	if mmPurge.mock.inspectFuncPurge != nil {
+		mmPurge.mock.t.Fatalf("Inspect function is already set for IdempotencyRepositoryMock.Purge")
+	}
+
+	mmPurge.mock.inspectFuncPurge = f
+
+	return mmPurge
+}
+
+// Return sets up results that will be returned by IdempotencyRepository.Purge
+func (mmPurge *mIdempotencyRepositoryMockPurge) Return(i1 int, err error) *IdempotencyRepositoryMock {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("IdempotencyRepositoryMock.Purge mock is already set by Set")
+	}
+
+	if mmPurge.defaultExpectation == nil {
+		mmPurge.defaultExpectation = &IdempotencyRepositoryMockPurgeExpectation{mock: mmPurge.mock}
+	}
+	mmPurge.defaultExpectation.results = &IdempotencyRepositoryMockPurgeResults{i1, err}
+	mmPurge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurge.mock
+}
+
+// Set uses given function f to mock the IdempotencyRepository.Purge method
+func (mmPurge *mIdempotencyRepositoryMockPurge) Set(f func(ctx context.Context, createdBefore time.Time) (i1 int, err error)) *IdempotencyRepositoryMock {
+	if mmPurge.defaultExpectation != nil {
+		mmPurge.mock.t.Fatalf("Default expectation is already set for the IdempotencyRepository.Purge method")
+	}
+
+	if len(mmPurge.expectations) > 0 {
+		mmPurge.mock.t.Fatalf("Some expectations are already set for the IdempotencyRepository.Purge method")
+	}
+
+	mmPurge.mock.funcPurge = f
+	mmPurge.mock.funcPurgeOrigin = minimock.CallerInfo(1)
+	return mmPurge.mock
+}
+
+// When sets expectation for the IdempotencyRepository.Purge which will trigger the result defined by the following
+// Then helper
+func (mmPurge *mIdempotencyRepositoryMockPurge) When(ctx context.Context, createdBefore time.Time) *IdempotencyRepositoryMockPurgeExpectation {
+	if mmPurge.mock.funcPurge != nil {
+		mmPurge.mock.t.Fatalf("IdempotencyRepositoryMock.Purge mock is already set by Set")
+	}
+
+	expectation := &IdempotencyRepositoryMockPurgeExpectation{
+		mock:               mmPurge.mock,
+		params:             &IdempotencyRepositoryMockPurgeParams{ctx, createdBefore},
+		expectationOrigins: IdempotencyRepositoryMockPurgeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurge.expectations = append(mmPurge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdempotencyRepository.Purge return parameters for the expectation previously defined by the When method
+func (e *IdempotencyRepositoryMockPurgeExpectation) Then(i1 int, err error) *IdempotencyRepositoryMock {
+	e.results = &IdempotencyRepositoryMockPurgeResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IdempotencyRepository.Purge should be invoked
+func (mmPurge *mIdempotencyRepositoryMockPurge) Times(n uint64) *mIdempotencyRepositoryMockPurge {
+	if n == 0 {
+		mmPurge.mock.t.Fatalf("Times of IdempotencyRepositoryMock.Purge mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurge.expectedInvocations, n)
+	mmPurge.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurge
+}
+
+func (mmPurge *mIdempotencyRepositoryMockPurge) invocationsDone() bool {
+	if len(mmPurge.expectations) == 0 && mmPurge.defaultExpectation == nil && mmPurge.mock.funcPurge == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurge.mock.afterPurgeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurge.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Purge implements mm_repositories.IdempotencyRepository
+func (mmPurge *IdempotencyRepositoryMock) Purge(ctx context.Context, createdBefore time.Time) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmPurge.beforePurgeCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurge.afterPurgeCounter, 1)
+
+	mmPurge.t.Helper()
+
+	if mmPurge.inspectFuncPurge != nil {
+		mmPurge.inspectFuncPurge(ctx, createdBefore)
+	}
+
+	mm_params := IdempotencyRepositoryMockPurgeParams{ctx, createdBefore}
+
+	// Record call args
+	mmPurge.PurgeMock.mutex.Lock()
+	mmPurge.PurgeMock.callArgs = append(mmPurge.PurgeMock.callArgs, &mm_params)
+	mmPurge.PurgeMock.mutex.Unlock()
+
+	for _, e := range mmPurge.PurgeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurge.PurgeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurge.PurgeMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurge.PurgeMock.defaultExpectation.params
+		mm_want_ptrs := mmPurge.PurgeMock.defaultExpectation.paramPtrs
+
+		mm_got := IdempotencyRepositoryMockPurgeParams{ctx, createdBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurge.t.Errorf("IdempotencyRepositoryMock.Purge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurge.PurgeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.createdBefore != nil && !minimock.Equal(*mm_want_ptrs.createdBefore, mm_got.createdBefore) {
+				mmPurge.t.Errorf("IdempotencyRepositoryMock.Purge got unexpected parameter createdBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurge.PurgeMock.defaultExpectation.expectationOrigins.originCreatedBefore, *mm_want_ptrs.createdBefore, mm_got.createdBefore, minimock.Diff(*mm_want_ptrs.createdBefore, mm_got.createdBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurge.t.Errorf("IdempotencyRepositoryMock.Purge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurge.PurgeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurge.PurgeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurge.t.Fatal("No results are set for the IdempotencyRepositoryMock.Purge")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurge.funcPurge != nil {
+		return mmPurge.funcPurge(ctx, createdBefore)
+	}
+	mmPurge.t.Fatalf("Unexpected call to IdempotencyRepositoryMock.Purge. %v %v", ctx, createdBefore)
+	return
+}
+
+// PurgeAfterCounter returns a count of finished IdempotencyRepositoryMock.Purge invocations
+func (mmPurge *IdempotencyRepositoryMock) PurgeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurge.afterPurgeCounter)
+}
+
+// PurgeBeforeCounter returns a count of IdempotencyRepositoryMock.Purge invocations
+func (mmPurge *IdempotencyRepositoryMock) PurgeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurge.beforePurgeCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdempotencyRepositoryMock.Purge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurge *mIdempotencyRepositoryMockPurge) Calls() []*IdempotencyRepositoryMockPurgeParams {
+	mmPurge.mutex.RLock()
+
+	argCopy := make([]*IdempotencyRepositoryMockPurgeParams, len(mmPurge.callArgs))
+	copy(argCopy, mmPurge.callArgs)
+
+	mmPurge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDone returns true if the count of the Purge invocations corresponds
+// the number of defined expectations
+func (m *IdempotencyRepositoryMock) MinimockPurgeDone() bool {
+	if m.PurgeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeMock.invocationsDone()
+}
+
+// MinimockPurgeInspect logs each unmet expectation
+func (m *IdempotencyRepositoryMock) MinimockPurgeInspect() {
+	for _, e := range m.PurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Purge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeCounter := mm_atomic.LoadUint64(&m.afterPurgeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeMock.defaultExpectation != nil && afterPurgeCounter < 1 {
+		if m.PurgeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Purge at\n%s", m.PurgeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Purge at\n%s with params: %#v", m.PurgeMock.defaultExpectation.expectationOrigins.origin, *m.PurgeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurge != nil && afterPurgeCounter < 1 {
+		m.t.Errorf("Expected call to IdempotencyRepositoryMock.Purge at\n%s", m.funcPurgeOrigin)
+	}
+
+	if !m.PurgeMock.invocationsDone() && afterPurgeCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdempotencyRepositoryMock.Purge at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeMock.expectedInvocations), m.PurgeMock.expectedInvocationsOrigin, afterPurgeCounter)
+	}
+}
+
+type mIdempotencyRepositoryMockRelease struct {
+	optional           bool
+	mock               *IdempotencyRepositoryMock
+	defaultExpectation *IdempotencyRepositoryMockReleaseExpectation
+	expectations       []*IdempotencyRepositoryMockReleaseExpectation
+
+	callArgs []*IdempotencyRepositoryMockReleaseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdempotencyRepositoryMockReleaseExpectation specifies expectation struct of the IdempotencyRepository.Release
+type IdempotencyRepositoryMockReleaseExpectation struct {
+	mock               *IdempotencyRepositoryMock
+	params             *IdempotencyRepositoryMockReleaseParams
+	paramPtrs          *IdempotencyRepositoryMockReleaseParamPtrs
+	expectationOrigins IdempotencyRepositoryMockReleaseExpectationOrigins
+	results            *IdempotencyRepositoryMockReleaseResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdempotencyRepositoryMockReleaseParams contains parameters of the IdempotencyRepository.Release
+type IdempotencyRepositoryMockReleaseParams struct {
+	ctx   context.Context
+	scope string
+	key   string
+}
+
+// IdempotencyRepositoryMockReleaseParamPtrs contains pointers to parameters of the IdempotencyRepository.Release
+type IdempotencyRepositoryMockReleaseParamPtrs struct {
+	ctx   *context.Context
+	scope *string
+	key   *string
+}
+
+// IdempotencyRepositoryMockReleaseResults contains results of the IdempotencyRepository.Release
+type IdempotencyRepositoryMockReleaseResults struct {
+	err error
+}
+
+// IdempotencyRepositoryMockReleaseOrigins contains origins of expectations of the IdempotencyRepository.Release
+type IdempotencyRepositoryMockReleaseExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originScope string
+	originKey   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRelease *mIdempotencyRepositoryMockRelease) Optional() *mIdempotencyRepositoryMockRelease {
+	mmRelease.optional = true
+	return mmRelease
+}
+
+// Expect sets up expected params for IdempotencyRepository.Release
+func (mmRelease *mIdempotencyRepositoryMockRelease) Expect(ctx context.Context, scope string, key string) *mIdempotencyRepositoryMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyRepositoryMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by ExpectParams functions")
+	}
+
+	mmRelease.defaultExpectation.params = &IdempotencyRepositoryMockReleaseParams{ctx, scope, key}
+	mmRelease.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRelease.expectations {
+		if minimock.Equal(e.params, mmRelease.defaultExpectation.params) {
+			mmRelease.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRelease.defaultExpectation.params)
+		}
+	}
+
+	return mmRelease
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdempotencyRepository.Release
+func (mmRelease *mIdempotencyRepositoryMockRelease) ExpectCtxParam1(ctx context.Context) *mIdempotencyRepositoryMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyRepositoryMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRelease.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// ExpectScopeParam2 sets up expected param scope for IdempotencyRepository.Release
+func (mmRelease *mIdempotencyRepositoryMockRelease) ExpectScopeParam2(scope string) *mIdempotencyRepositoryMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyRepositoryMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.scope = &scope
+	mmRelease.defaultExpectation.expectationOrigins.originScope = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// ExpectKeyParam3 sets up expected param key for IdempotencyRepository.Release
+func (mmRelease *mIdempotencyRepositoryMockRelease) ExpectKeyParam3(key string) *mIdempotencyRepositoryMockRelease {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyRepositoryMockReleaseExpectation{}
+	}
+
+	if mmRelease.defaultExpectation.params != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by Expect")
+	}
+
+	if mmRelease.defaultExpectation.paramPtrs == nil {
+		mmRelease.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockReleaseParamPtrs{}
+	}
+	mmRelease.defaultExpectation.paramPtrs.key = &key
+	mmRelease.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmRelease
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdempotencyRepository.Release
+func (mmRelease *mIdempotencyRepositoryMockRelease) Inspect(f func(ctx context.Context, scope string, key string)) *mIdempotencyRepositoryMockRelease {
+	if mmRelease.mock.inspectFuncRelease != nil {
+		mmRelease.mock.t.Fatalf("Inspect function is already set for IdempotencyRepositoryMock.Release")
+	}
+
+	mmRelease.mock.inspectFuncRelease = f
+
+	return mmRelease
+}
+
+// Return sets up results that will be returned by IdempotencyRepository.Release
+func (mmRelease *mIdempotencyRepositoryMockRelease) Return(err error) *IdempotencyRepositoryMock {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by Set")
+	}
+
+	if mmRelease.defaultExpectation == nil {
+		mmRelease.defaultExpectation = &IdempotencyRepositoryMockReleaseExpectation{mock: mmRelease.mock}
+	}
+	mmRelease.defaultExpectation.results = &IdempotencyRepositoryMockReleaseResults{err}
+	mmRelease.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRelease.mock
+}
+
+// Set uses given function f to mock the IdempotencyRepository.Release method
+func (mmRelease *mIdempotencyRepositoryMockRelease) Set(f func(ctx context.Context, scope string, key string) (err error)) *IdempotencyRepositoryMock {
+	if mmRelease.defaultExpectation != nil {
+		mmRelease.mock.t.Fatalf("Default expectation is already set for the IdempotencyRepository.Release method")
+	}
+
+	if len(mmRelease.expectations) > 0 {
+		mmRelease.mock.t.Fatalf("Some expectations are already set for the IdempotencyRepository.Release method")
+	}
+
+	mmRelease.mock.funcRelease = f
+	mmRelease.mock.funcReleaseOrigin = minimock.CallerInfo(1)
+	return mmRelease.mock
+}
+
+// When sets expectation for the IdempotencyRepository.Release which will trigger the result defined by the following
+// Then helper
+func (mmRelease *mIdempotencyRepositoryMockRelease) When(ctx context.Context, scope string, key string) *IdempotencyRepositoryMockReleaseExpectation {
+	if mmRelease.mock.funcRelease != nil {
+		mmRelease.mock.t.Fatalf("IdempotencyRepositoryMock.Release mock is already set by Set")
+	}
+
+	expectation := &IdempotencyRepositoryMockReleaseExpectation{
+		mock:               mmRelease.mock,
+		params:             &IdempotencyRepositoryMockReleaseParams{ctx, scope, key},
+		expectationOrigins: IdempotencyRepositoryMockReleaseExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRelease.expectations = append(mmRelease.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdempotencyRepository.Release return parameters for the expectation previously defined by the When method
+func (e *IdempotencyRepositoryMockReleaseExpectation) Then(err error) *IdempotencyRepositoryMock {
+	e.results = &IdempotencyRepositoryMockReleaseResults{err}
+	return e.mock
+}
+
+// Times sets number of times IdempotencyRepository.Release should be invoked
+func (mmRelease *mIdempotencyRepositoryMockRelease) Times(n uint64) *mIdempotencyRepositoryMockRelease {
+	if n == 0 {
+		mmRelease.mock.t.Fatalf("Times of IdempotencyRepositoryMock.Release mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRelease.expectedInvocations, n)
+	mmRelease.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRelease
+}
+
+func (mmRelease *mIdempotencyRepositoryMockRelease) invocationsDone() bool {
+	if len(mmRelease.expectations) == 0 && mmRelease.defaultExpectation == nil && mmRelease.mock.funcRelease == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRelease.mock.afterReleaseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRelease.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Release implements mm_repositories.IdempotencyRepository
+func (mmRelease *IdempotencyRepositoryMock) Release(ctx context.Context, scope string, key string) (err error) {
+	mm_atomic.AddUint64(&mmRelease.beforeReleaseCounter, 1)
+	defer mm_atomic.AddUint64(&mmRelease.afterReleaseCounter, 1)
+
+	mmRelease.t.Helper()
+
+	if mmRelease.inspectFuncRelease != nil {
+		mmRelease.inspectFuncRelease(ctx, scope, key)
+	}
+
+	mm_params := IdempotencyRepositoryMockReleaseParams{ctx, scope, key}
+
+	// Record call args
+	mmRelease.ReleaseMock.mutex.Lock()
+	mmRelease.ReleaseMock.callArgs = append(mmRelease.ReleaseMock.callArgs, &mm_params)
+	mmRelease.ReleaseMock.mutex.Unlock()
+
+	for _, e := range mmRelease.ReleaseMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRelease.ReleaseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRelease.ReleaseMock.defaultExpectation.Counter, 1)
+		mm_want := mmRelease.ReleaseMock.defaultExpectation.params
+		mm_want_ptrs := mmRelease.ReleaseMock.defaultExpectation.paramPtrs
+
+		mm_got := IdempotencyRepositoryMockReleaseParams{ctx, scope, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRelease.t.Errorf("IdempotencyRepositoryMock.Release got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmRelease.t.Errorf("IdempotencyRepositoryMock.Release got unexpected parameter scope, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originScope, *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmRelease.t.Errorf("IdempotencyRepositoryMock.Release got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRelease.t.Errorf("IdempotencyRepositoryMock.Release got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRelease.ReleaseMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRelease.ReleaseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRelease.t.Fatal("No results are set for the IdempotencyRepositoryMock.Release")
+		}
+		return (*mm_results).err
+	}
+	if mmRelease.funcRelease != nil {
+		return mmRelease.funcRelease(ctx, scope, key)
+	}
+	mmRelease.t.Fatalf("Unexpected call to IdempotencyRepositoryMock.Release. %v %v %v", ctx, scope, key)
+	return
+}
+
+// ReleaseAfterCounter returns a count of finished IdempotencyRepositoryMock.Release invocations
+func (mmRelease *IdempotencyRepositoryMock) ReleaseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRelease.afterReleaseCounter)
+}
+
+// ReleaseBeforeCounter returns a count of IdempotencyRepositoryMock.Release invocations
+func (mmRelease *IdempotencyRepositoryMock) ReleaseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRelease.beforeReleaseCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdempotencyRepositoryMock.Release.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRelease *mIdempotencyRepositoryMockRelease) Calls() []*IdempotencyRepositoryMockReleaseParams {
+	mmRelease.mutex.RLock()
+
+	argCopy := make([]*IdempotencyRepositoryMockReleaseParams, len(mmRelease.callArgs))
+	copy(argCopy, mmRelease.callArgs)
+
+	mmRelease.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseDone returns true if the count of the Release invocations corresponds
+// the number of defined expectations
+func (m *IdempotencyRepositoryMock) MinimockReleaseDone() bool {
+	if m.ReleaseMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReleaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReleaseMock.invocationsDone()
+}
+
+// MinimockReleaseInspect logs each unmet expectation
+func (m *IdempotencyRepositoryMock) MinimockReleaseInspect() {
+	for _, e := range m.ReleaseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Release at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReleaseCounter := mm_atomic.LoadUint64(&m.afterReleaseCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseMock.defaultExpectation != nil && afterReleaseCounter < 1 {
+		if m.ReleaseMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Release at\n%s", m.ReleaseMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Release at\n%s with params: %#v", m.ReleaseMock.defaultExpectation.expectationOrigins.origin, *m.ReleaseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRelease != nil && afterReleaseCounter < 1 {
+		m.t.Errorf("Expected call to IdempotencyRepositoryMock.Release at\n%s", m.funcReleaseOrigin)
+	}
+
+	if !m.ReleaseMock.invocationsDone() && afterReleaseCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdempotencyRepositoryMock.Release at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReleaseMock.expectedInvocations), m.ReleaseMock.expectedInvocationsOrigin, afterReleaseCounter)
+	}
+}
+
+type mIdempotencyRepositoryMockReserve struct {
+	optional           bool
+	mock               *IdempotencyRepositoryMock
+	defaultExpectation *IdempotencyRepositoryMockReserveExpectation
+	expectations       []*IdempotencyRepositoryMockReserveExpectation
+
+	callArgs []*IdempotencyRepositoryMockReserveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdempotencyRepositoryMockReserveExpectation specifies expectation struct of the IdempotencyRepository.Reserve
+type IdempotencyRepositoryMockReserveExpectation struct {
+	mock               *IdempotencyRepositoryMock
+	params             *IdempotencyRepositoryMockReserveParams
+	paramPtrs          *IdempotencyRepositoryMockReserveParamPtrs
+	expectationOrigins IdempotencyRepositoryMockReserveExpectationOrigins
+	results            *IdempotencyRepositoryMockReserveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdempotencyRepositoryMockReserveParams contains parameters of the IdempotencyRepository.Reserve
+type IdempotencyRepositoryMockReserveParams struct {
+	ctx           context.Context
+	rec           models.IdempotencyRecord
+	expiredBefore time.Time
+}
+
+// IdempotencyRepositoryMockReserveParamPtrs contains pointers to parameters of the IdempotencyRepository.Reserve
+type IdempotencyRepositoryMockReserveParamPtrs struct {
+	ctx           *context.Context
+	rec           *models.IdempotencyRecord
+	expiredBefore *time.Time
+}
+
+// IdempotencyRepositoryMockReserveResults contains results of the IdempotencyRepository.Reserve
+type IdempotencyRepositoryMockReserveResults struct {
+	i1  models.IdempotencyRecord
+	b1  bool
+	err error
+}
+
+// IdempotencyRepositoryMockReserveOrigins contains origins of expectations of the IdempotencyRepository.Reserve
+type IdempotencyRepositoryMockReserveExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originRec           string
+	originExpiredBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReserve *mIdempotencyRepositoryMockReserve) Optional() *mIdempotencyRepositoryMockReserve {
+	mmReserve.optional = true
+	return mmReserve
+}
+
+// Expect sets up expected params for IdempotencyRepository.Reserve
+func (mmReserve *mIdempotencyRepositoryMockReserve) Expect(ctx context.Context, rec models.IdempotencyRecord, expiredBefore time.Time) *mIdempotencyRepositoryMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyRepositoryMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by ExpectParams functions")
+	}
+
+	mmReserve.defaultExpectation.params = &IdempotencyRepositoryMockReserveParams{ctx, rec, expiredBefore}
+	mmReserve.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReserve.expectations {
+		if minimock.Equal(e.params, mmReserve.defaultExpectation.params) {
+			mmReserve.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserve.defaultExpectation.params)
+		}
+	}
+
+	return mmReserve
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdempotencyRepository.Reserve
+func (mmReserve *mIdempotencyRepositoryMockReserve) ExpectCtxParam1(ctx context.Context) *mIdempotencyRepositoryMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyRepositoryMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReserve.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// ExpectRecParam2 sets up expected param rec for IdempotencyRepository.Reserve
+func (mmReserve *mIdempotencyRepositoryMockReserve) ExpectRecParam2(rec models.IdempotencyRecord) *mIdempotencyRepositoryMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyRepositoryMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.rec = &rec
+	mmReserve.defaultExpectation.expectationOrigins.originRec = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// ExpectExpiredBeforeParam3 sets up expected param expiredBefore for IdempotencyRepository.Reserve
+func (mmReserve *mIdempotencyRepositoryMockReserve) ExpectExpiredBeforeParam3(expiredBefore time.Time) *mIdempotencyRepositoryMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyRepositoryMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &IdempotencyRepositoryMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.expiredBefore = &expiredBefore
+	mmReserve.defaultExpectation.expectationOrigins.originExpiredBefore = minimock.CallerInfo(1)
+
+	return mmReserve
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdempotencyRepository.Reserve
+func (mmReserve *mIdempotencyRepositoryMockReserve) Inspect(f func(ctx context.Context, rec models.IdempotencyRecord, expiredBefore time.Time)) *mIdempotencyRepositoryMockReserve {
+	if mmReserve.mock.inspectFuncReserve != nil {
+		mmReserve.mock.t.Fatalf("Inspect function is already set for IdempotencyRepositoryMock.Reserve")
+	}
+
+	mmReserve.mock.inspectFuncReserve = f
+
+	return mmReserve
+}
+
+// Return sets up results that will be returned by IdempotencyRepository.Reserve
+func (mmReserve *mIdempotencyRepositoryMockReserve) Return(i1 models.IdempotencyRecord, b1 bool, err error) *IdempotencyRepositoryMock {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &IdempotencyRepositoryMockReserveExpectation{mock: mmReserve.mock}
+	}
+	mmReserve.defaultExpectation.results = &IdempotencyRepositoryMockReserveResults{i1, b1, err}
+	mmReserve.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReserve.mock
+}
+
+// Set uses given function f to mock the IdempotencyRepository.Reserve method
+func (mmReserve *mIdempotencyRepositoryMockReserve) Set(f func(ctx context.Context, rec models.IdempotencyRecord, expiredBefore time.Time) (i1 models.IdempotencyRecord, b1 bool, err error)) *IdempotencyRepositoryMock {
+	if mmReserve.defaultExpectation != nil {
+		mmReserve.mock.t.Fatalf("Default expectation is already set for the IdempotencyRepository.Reserve method")
+	}
+
+	if len(mmReserve.expectations) > 0 {
+		mmReserve.mock.t.Fatalf("Some expectations are already set for the IdempotencyRepository.Reserve method")
+	}
+
+	mmReserve.mock.funcReserve = f
+	mmReserve.mock.funcReserveOrigin = minimock.CallerInfo(1)
+	return mmReserve.mock
+}
+
+// When sets expectation for the IdempotencyRepository.Reserve which will trigger the result defined by the following
+// Then helper
+func (mmReserve *mIdempotencyRepositoryMockReserve) When(ctx context.Context, rec models.IdempotencyRecord, expiredBefore time.Time) *IdempotencyRepositoryMockReserveExpectation {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("IdempotencyRepositoryMock.Reserve mock is already set by Set")
+	}
+
+	expectation := &IdempotencyRepositoryMockReserveExpectation{
+		mock:               mmReserve.mock,
+		params:             &IdempotencyRepositoryMockReserveParams{ctx, rec, expiredBefore},
+		expectationOrigins: IdempotencyRepositoryMockReserveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReserve.expectations = append(mmReserve.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdempotencyRepository.Reserve return parameters for the expectation previously defined by the When method
+func (e *IdempotencyRepositoryMockReserveExpectation) Then(i1 models.IdempotencyRecord, b1 bool, err error) *IdempotencyRepositoryMock {
+	e.results = &IdempotencyRepositoryMockReserveResults{i1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times IdempotencyRepository.Reserve should be invoked
+func (mmReserve *mIdempotencyRepositoryMockReserve) Times(n uint64) *mIdempotencyRepositoryMockReserve {
+	if n == 0 {
+		mmReserve.mock.t.Fatalf("Times of IdempotencyRepositoryMock.Reserve mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReserve.expectedInvocations, n)
+	mmReserve.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReserve
+}
+
+func (mmReserve *mIdempotencyRepositoryMockReserve) invocationsDone() bool {
+	if len(mmReserve.expectations) == 0 && mmReserve.defaultExpectation == nil && mmReserve.mock.funcReserve == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReserve.mock.afterReserveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReserve.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Reserve implements mm_repositories.IdempotencyRepository
+func (mmReserve *IdempotencyRepositoryMock) Reserve(ctx context.Context, rec models.IdempotencyRecord, expiredBefore time.Time) (i1 models.IdempotencyRecord, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmReserve.beforeReserveCounter, 1)
+	defer mm_atomic.AddUint64(&mmReserve.afterReserveCounter, 1)
+
+	mmReserve.t.Helper()
+
+	if mmReserve.inspectFuncReserve != nil {
+		mmReserve.inspectFuncReserve(ctx, rec, expiredBefore)
+	}
+
+	mm_params := IdempotencyRepositoryMockReserveParams{ctx, rec, expiredBefore}
+
+	// Record call args
+	mmReserve.ReserveMock.mutex.Lock()
+	mmReserve.ReserveMock.callArgs = append(mmReserve.ReserveMock.callArgs, &mm_params)
+	mmReserve.ReserveMock.mutex.Unlock()
+
+	for _, e := range mmReserve.ReserveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmReserve.ReserveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReserve.ReserveMock.defaultExpectation.Counter, 1)
+		mm_want := mmReserve.ReserveMock.defaultExpectation.params
+		mm_want_ptrs := mmReserve.ReserveMock.defaultExpectation.paramPtrs
+
+		mm_got := IdempotencyRepositoryMockReserveParams{ctx, rec, expiredBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReserve.t.Errorf("IdempotencyRepositoryMock.Reserve got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rec != nil && !minimock.Equal(*mm_want_ptrs.rec, mm_got.rec) {
+				mmReserve.t.Errorf("IdempotencyRepositoryMock.Reserve got unexpected parameter rec, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originRec, *mm_want_ptrs.rec, mm_got.rec, minimock.Diff(*mm_want_ptrs.rec, mm_got.rec))
+			}
+
+			if mm_want_ptrs.expiredBefore != nil && !minimock.Equal(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore) {
+				mmReserve.t.Errorf("IdempotencyRepositoryMock.Reserve got unexpected parameter expiredBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserve.ReserveMock.defaultExpectation.expectationOrigins.originExpiredBefore, *mm_want_ptrs.expiredBefore, mm_got.expiredBefore, minimock.Diff(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReserve.t.Errorf("IdempotencyRepositoryMock.Reserve got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReserve.ReserveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReserve.ReserveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReserve.t.Fatal("No results are set for the IdempotencyRepositoryMock.Reserve")
+		}
+		return (*mm_results).i1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmReserve.funcReserve != nil {
+		return mmReserve.funcReserve(ctx, rec, expiredBefore)
+	}
+	mmReserve.t.Fatalf("Unexpected call to IdempotencyRepositoryMock.Reserve. %v %v %v", ctx, rec, expiredBefore)
+	return
+}
+
+// ReserveAfterCounter returns a count of finished IdempotencyRepositoryMock.Reserve invocations
+func (mmReserve *IdempotencyRepositoryMock) ReserveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserve.afterReserveCounter)
+}
+
+// ReserveBeforeCounter returns a count of IdempotencyRepositoryMock.Reserve invocations
+func (mmReserve *IdempotencyRepositoryMock) ReserveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserve.beforeReserveCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdempotencyRepositoryMock.Reserve.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReserve *mIdempotencyRepositoryMockReserve) Calls() []*IdempotencyRepositoryMockReserveParams {
+	mmReserve.mutex.RLock()
+
+	argCopy := make([]*IdempotencyRepositoryMockReserveParams, len(mmReserve.callArgs))
+	copy(argCopy, mmReserve.callArgs)
+
+	mmReserve.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReserveDone returns true if the count of the Reserve invocations corresponds
+// the number of defined expectations
+func (m *IdempotencyRepositoryMock) MinimockReserveDone() bool {
+	if m.ReserveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReserveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReserveMock.invocationsDone()
+}
+
+// MinimockReserveInspect logs each unmet expectation
+func (m *IdempotencyRepositoryMock) MinimockReserveInspect() {
+	for _, e := range m.ReserveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Reserve at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReserveCounter := mm_atomic.LoadUint64(&m.afterReserveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReserveMock.defaultExpectation != nil && afterReserveCounter < 1 {
+		if m.ReserveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Reserve at\n%s", m.ReserveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdempotencyRepositoryMock.Reserve at\n%s with params: %#v", m.ReserveMock.defaultExpectation.expectationOrigins.origin, *m.ReserveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReserve != nil && afterReserveCounter < 1 {
+		m.t.Errorf("Expected call to IdempotencyRepositoryMock.Reserve at\n%s", m.funcReserveOrigin)
+	}
+
+	if !m.ReserveMock.invocationsDone() && afterReserveCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdempotencyRepositoryMock.Reserve at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReserveMock.expectedInvocations), m.ReserveMock.expectedInvocationsOrigin, afterReserveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IdempotencyRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCompleteInspect()
+
+			m.MinimockLoadInspect()
+
+			m.MinimockPurgeInspect()
+
+			m.MinimockReleaseInspect()
+
+			m.MinimockReserveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IdempotencyRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IdempotencyRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCompleteDone() &&
+		m.MinimockLoadDone() &&
+		m.MinimockPurgeDone() &&
+		m.MinimockReleaseDone() &&
+		m.MinimockReserveDone()
+}
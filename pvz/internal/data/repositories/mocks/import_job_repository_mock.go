@@ -0,0 +1,1932 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ImportJobRepositoryMock implements mm_repositories.ImportJobRepository
+type ImportJobRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcFailUnfinished          func(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time) (i1 int, err error)
+	funcFailUnfinishedOrigin    string
+	inspectFuncFailUnfinished   func(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time)
+	afterFailUnfinishedCounter  uint64
+	beforeFailUnfinishedCounter uint64
+	FailUnfinishedMock          mImportJobRepositoryMockFailUnfinished
+
+	funcListFailures          func(ctx context.Context, jobID uint64) (ia1 []models.ImportJobFailure, err error)
+	funcListFailuresOrigin    string
+	inspectFuncListFailures   func(ctx context.Context, jobID uint64)
+	afterListFailuresCounter  uint64
+	beforeListFailuresCounter uint64
+	ListFailuresMock          mImportJobRepositoryMockListFailures
+
+	funcLoad          func(ctx context.Context, id uint64) (i1 models.ImportJob, err error)
+	funcLoadOrigin    string
+	inspectFuncLoad   func(ctx context.Context, id uint64)
+	afterLoadCounter  uint64
+	beforeLoadCounter uint64
+	LoadMock          mImportJobRepositoryMockLoad
+
+	funcSave          func(ctx context.Context, job models.ImportJob) (err error)
+	funcSaveOrigin    string
+	inspectFuncSave   func(ctx context.Context, job models.ImportJob)
+	afterSaveCounter  uint64
+	beforeSaveCounter uint64
+	SaveMock          mImportJobRepositoryMockSave
+
+	funcSaveFailures          func(ctx context.Context, failures []models.ImportJobFailure) (err error)
+	funcSaveFailuresOrigin    string
+	inspectFuncSaveFailures   func(ctx context.Context, failures []models.ImportJobFailure)
+	afterSaveFailuresCounter  uint64
+	beforeSaveFailuresCounter uint64
+	SaveFailuresMock          mImportJobRepositoryMockSaveFailures
+}
+
+// NewImportJobRepositoryMock returns a mock for mm_repositories.ImportJobRepository
+func NewImportJobRepositoryMock(t minimock.Tester) *ImportJobRepositoryMock {
+	m := &ImportJobRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FailUnfinishedMock = mImportJobRepositoryMockFailUnfinished{mock: m}
+	m.FailUnfinishedMock.callArgs = []*ImportJobRepositoryMockFailUnfinishedParams{}
+
+	m.ListFailuresMock = mImportJobRepositoryMockListFailures{mock: m}
+	m.ListFailuresMock.callArgs = []*ImportJobRepositoryMockListFailuresParams{}
+
+	m.LoadMock = mImportJobRepositoryMockLoad{mock: m}
+	m.LoadMock.callArgs = []*ImportJobRepositoryMockLoadParams{}
+
+	m.SaveMock = mImportJobRepositoryMockSave{mock: m}
+	m.SaveMock.callArgs = []*ImportJobRepositoryMockSaveParams{}
+
+	m.SaveFailuresMock = mImportJobRepositoryMockSaveFailures{mock: m}
+	m.SaveFailuresMock.callArgs = []*ImportJobRepositoryMockSaveFailuresParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mImportJobRepositoryMockFailUnfinished struct {
+	optional           bool
+	mock               *ImportJobRepositoryMock
+	defaultExpectation *ImportJobRepositoryMockFailUnfinishedExpectation
+	expectations       []*ImportJobRepositoryMockFailUnfinishedExpectation
+
+	callArgs []*ImportJobRepositoryMockFailUnfinishedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobRepositoryMockFailUnfinishedExpectation specifies expectation struct of the ImportJobRepository.FailUnfinished
+type ImportJobRepositoryMockFailUnfinishedExpectation struct {
+	mock               *ImportJobRepositoryMock
+	params             *ImportJobRepositoryMockFailUnfinishedParams
+	paramPtrs          *ImportJobRepositoryMockFailUnfinishedParamPtrs
+	expectationOrigins ImportJobRepositoryMockFailUnfinishedExpectationOrigins
+	results            *ImportJobRepositoryMockFailUnfinishedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobRepositoryMockFailUnfinishedParams contains parameters of the ImportJobRepository.FailUnfinished
+type ImportJobRepositoryMockFailUnfinishedParams struct {
+	ctx           context.Context
+	instanceID    string
+	createdBefore time.Time
+	reason        string
+	at            time.Time
+}
+
+// ImportJobRepositoryMockFailUnfinishedParamPtrs contains pointers to parameters of the ImportJobRepository.FailUnfinished
+type ImportJobRepositoryMockFailUnfinishedParamPtrs struct {
+	ctx           *context.Context
+	instanceID    *string
+	createdBefore *time.Time
+	reason        *string
+	at            *time.Time
+}
+
+// ImportJobRepositoryMockFailUnfinishedResults contains results of the ImportJobRepository.FailUnfinished
+type ImportJobRepositoryMockFailUnfinishedResults struct {
+	i1  int
+	err error
+}
+
+// ImportJobRepositoryMockFailUnfinishedOrigins contains origins of expectations of the ImportJobRepository.FailUnfinished
+type ImportJobRepositoryMockFailUnfinishedExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originInstanceID    string
+	originCreatedBefore string
+	originReason        string
+	originAt            string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) Optional() *mImportJobRepositoryMockFailUnfinished {
+	mmFailUnfinished.optional = true
+	return mmFailUnfinished
+}
+
+// Expect sets up expected params for ImportJobRepository.FailUnfinished
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) Expect(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time) *mImportJobRepositoryMockFailUnfinished {
+	if mmFailUnfinished.mock.funcFailUnfinished != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Set")
+	}
+
+	if mmFailUnfinished.defaultExpectation == nil {
+		mmFailUnfinished.defaultExpectation = &ImportJobRepositoryMockFailUnfinishedExpectation{}
+	}
+
+	if mmFailUnfinished.defaultExpectation.paramPtrs != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by ExpectParams functions")
+	}
+
+	mmFailUnfinished.defaultExpectation.params = &ImportJobRepositoryMockFailUnfinishedParams{ctx, instanceID, createdBefore, reason, at}
+	mmFailUnfinished.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFailUnfinished.expectations {
+		if minimock.Equal(e.params, mmFailUnfinished.defaultExpectation.params) {
+			mmFailUnfinished.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFailUnfinished.defaultExpectation.params)
+		}
+	}
+
+	return mmFailUnfinished
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobRepository.FailUnfinished
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) ExpectCtxParam1(ctx context.Context) *mImportJobRepositoryMockFailUnfinished {
+	if mmFailUnfinished.mock.funcFailUnfinished != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Set")
+	}
+
+	if mmFailUnfinished.defaultExpectation == nil {
+		mmFailUnfinished.defaultExpectation = &ImportJobRepositoryMockFailUnfinishedExpectation{}
+	}
+
+	if mmFailUnfinished.defaultExpectation.params != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Expect")
+	}
+
+	if mmFailUnfinished.defaultExpectation.paramPtrs == nil {
+		mmFailUnfinished.defaultExpectation.paramPtrs = &ImportJobRepositoryMockFailUnfinishedParamPtrs{}
+	}
+	mmFailUnfinished.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFailUnfinished.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFailUnfinished
+}
+
+// ExpectInstanceIDParam2 sets up expected param instanceID for ImportJobRepository.FailUnfinished
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) ExpectInstanceIDParam2(instanceID string) *mImportJobRepositoryMockFailUnfinished {
+	if mmFailUnfinished.mock.funcFailUnfinished != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Set")
+	}
+
+	if mmFailUnfinished.defaultExpectation == nil {
+		mmFailUnfinished.defaultExpectation = &ImportJobRepositoryMockFailUnfinishedExpectation{}
+	}
+
+	if mmFailUnfinished.defaultExpectation.params != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Expect")
+	}
+
+	if mmFailUnfinished.defaultExpectation.paramPtrs == nil {
+		mmFailUnfinished.defaultExpectation.paramPtrs = &ImportJobRepositoryMockFailUnfinishedParamPtrs{}
+	}
+	mmFailUnfinished.defaultExpectation.paramPtrs.instanceID = &instanceID
+	mmFailUnfinished.defaultExpectation.expectationOrigins.originInstanceID = minimock.CallerInfo(1)
+
+	return mmFailUnfinished
+}
+
+// ExpectCreatedBeforeParam3 sets up expected param createdBefore for ImportJobRepository.FailUnfinished
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) ExpectCreatedBeforeParam3(createdBefore time.Time) *mImportJobRepositoryMockFailUnfinished {
+	if mmFailUnfinished.mock.funcFailUnfinished != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Set")
+	}
+
+	if mmFailUnfinished.defaultExpectation == nil {
+		mmFailUnfinished.defaultExpectation = &ImportJobRepositoryMockFailUnfinishedExpectation{}
+	}
+
+	if mmFailUnfinished.defaultExpectation.params != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Expect")
+	}
+
+	if mmFailUnfinished.defaultExpectation.paramPtrs == nil {
+		mmFailUnfinished.defaultExpectation.paramPtrs = &ImportJobRepositoryMockFailUnfinishedParamPtrs{}
+	}
+	mmFailUnfinished.defaultExpectation.paramPtrs.createdBefore = &createdBefore
+	mmFailUnfinished.defaultExpectation.expectationOrigins.originCreatedBefore = minimock.CallerInfo(1)
+
+	return mmFailUnfinished
+}
+
+// ExpectReasonParam4 sets up expected param reason for ImportJobRepository.FailUnfinished
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) ExpectReasonParam4(reason string) *mImportJobRepositoryMockFailUnfinished {
+	if mmFailUnfinished.mock.funcFailUnfinished != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Set")
+	}
+
+	if mmFailUnfinished.defaultExpectation == nil {
+		mmFailUnfinished.defaultExpectation = &ImportJobRepositoryMockFailUnfinishedExpectation{}
+	}
+
+	if mmFailUnfinished.defaultExpectation.params != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Expect")
+	}
+
+	if mmFailUnfinished.defaultExpectation.paramPtrs == nil {
+		mmFailUnfinished.defaultExpectation.paramPtrs = &ImportJobRepositoryMockFailUnfinishedParamPtrs{}
+	}
+	mmFailUnfinished.defaultExpectation.paramPtrs.reason = &reason
+	mmFailUnfinished.defaultExpectation.expectationOrigins.originReason = minimock.CallerInfo(1)
+
+	return mmFailUnfinished
+}
+
+// ExpectAtParam5 sets up expected param at for ImportJobRepository.FailUnfinished
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) ExpectAtParam5(at time.Time) *mImportJobRepositoryMockFailUnfinished {
+	if mmFailUnfinished.mock.funcFailUnfinished != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Set")
+	}
+
+	if mmFailUnfinished.defaultExpectation == nil {
+		mmFailUnfinished.defaultExpectation = &ImportJobRepositoryMockFailUnfinishedExpectation{}
+	}
+
+	if mmFailUnfinished.defaultExpectation.params != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Expect")
+	}
+
+	if mmFailUnfinished.defaultExpectation.paramPtrs == nil {
+		mmFailUnfinished.defaultExpectation.paramPtrs = &ImportJobRepositoryMockFailUnfinishedParamPtrs{}
+	}
+	mmFailUnfinished.defaultExpectation.paramPtrs.at = &at
+	mmFailUnfinished.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmFailUnfinished
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobRepository.FailUnfinished
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) Inspect(f func(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time)) *mImportJobRepositoryMockFailUnfinished {
+	if mmFailUnfinished.mock.inspectFuncFailUnfinished != nil {
+		mmFailUnfinished.mock.t.Fatalf("Inspect function is already set for ImportJobRepositoryMock.FailUnfinished")
+	}
+
+	mmFailUnfinished.mock.inspectFuncFailUnfinished = f
+
+	return mmFailUnfinished
+}
+
+// Return sets up results that will be returned by ImportJobRepository.FailUnfinished
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) Return(i1 int, err error) *ImportJobRepositoryMock {
+	if mmFailUnfinished.mock.funcFailUnfinished != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Set")
+	}
+
+	if mmFailUnfinished.defaultExpectation == nil {
+		mmFailUnfinished.defaultExpectation = &ImportJobRepositoryMockFailUnfinishedExpectation{mock: mmFailUnfinished.mock}
+	}
+	mmFailUnfinished.defaultExpectation.results = &ImportJobRepositoryMockFailUnfinishedResults{i1, err}
+	mmFailUnfinished.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFailUnfinished.mock
+}
+
+// Set uses given function f to mock the ImportJobRepository.FailUnfinished method
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) Set(f func(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time) (i1 int, err error)) *ImportJobRepositoryMock {
+	if mmFailUnfinished.defaultExpectation != nil {
+		mmFailUnfinished.mock.t.Fatalf("Default expectation is already set for the ImportJobRepository.FailUnfinished method")
+	}
+
+	if len(mmFailUnfinished.expectations) > 0 {
+		mmFailUnfinished.mock.t.Fatalf("Some expectations are already set for the ImportJobRepository.FailUnfinished method")
+	}
+
+	mmFailUnfinished.mock.funcFailUnfinished = f
+	mmFailUnfinished.mock.funcFailUnfinishedOrigin = minimock.CallerInfo(1)
+	return mmFailUnfinished.mock
+}
+
+// When sets expectation for the ImportJobRepository.FailUnfinished which will trigger the result defined by the following
+// Then helper
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) When(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time) *ImportJobRepositoryMockFailUnfinishedExpectation {
+	if mmFailUnfinished.mock.funcFailUnfinished != nil {
+		mmFailUnfinished.mock.t.Fatalf("ImportJobRepositoryMock.FailUnfinished mock is already set by Set")
+	}
+
+	expectation := &ImportJobRepositoryMockFailUnfinishedExpectation{
+		mock:               mmFailUnfinished.mock,
+		params:             &ImportJobRepositoryMockFailUnfinishedParams{ctx, instanceID, createdBefore, reason, at},
+		expectationOrigins: ImportJobRepositoryMockFailUnfinishedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFailUnfinished.expectations = append(mmFailUnfinished.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobRepository.FailUnfinished return parameters for the expectation previously defined by the When method
+func (e *ImportJobRepositoryMockFailUnfinishedExpectation) Then(i1 int, err error) *ImportJobRepositoryMock {
+	e.results = &ImportJobRepositoryMockFailUnfinishedResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobRepository.FailUnfinished should be invoked
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) Times(n uint64) *mImportJobRepositoryMockFailUnfinished {
+	if n == 0 {
+		mmFailUnfinished.mock.t.Fatalf("Times of ImportJobRepositoryMock.FailUnfinished mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFailUnfinished.expectedInvocations, n)
+	mmFailUnfinished.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFailUnfinished
+}
+
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) invocationsDone() bool {
+	if len(mmFailUnfinished.expectations) == 0 && mmFailUnfinished.defaultExpectation == nil && mmFailUnfinished.mock.funcFailUnfinished == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFailUnfinished.mock.afterFailUnfinishedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFailUnfinished.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FailUnfinished implements mm_repositories.ImportJobRepository
+func (mmFailUnfinished *ImportJobRepositoryMock) FailUnfinished(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmFailUnfinished.beforeFailUnfinishedCounter, 1)
+	defer mm_atomic.AddUint64(&mmFailUnfinished.afterFailUnfinishedCounter, 1)
+
+	mmFailUnfinished.t.Helper()
+
+	if mmFailUnfinished.inspectFuncFailUnfinished != nil {
+		mmFailUnfinished.inspectFuncFailUnfinished(ctx, instanceID, createdBefore, reason, at)
+	}
+
+	mm_params := ImportJobRepositoryMockFailUnfinishedParams{ctx, instanceID, createdBefore, reason, at}
+
+	// Record call args
+	mmFailUnfinished.FailUnfinishedMock.mutex.Lock()
+	mmFailUnfinished.FailUnfinishedMock.callArgs = append(mmFailUnfinished.FailUnfinishedMock.callArgs, &mm_params)
+	mmFailUnfinished.FailUnfinishedMock.mutex.Unlock()
+
+	for _, e := range mmFailUnfinished.FailUnfinishedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmFailUnfinished.FailUnfinishedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFailUnfinished.FailUnfinishedMock.defaultExpectation.Counter, 1)
+		mm_want := mmFailUnfinished.FailUnfinishedMock.defaultExpectation.params
+		mm_want_ptrs := mmFailUnfinished.FailUnfinishedMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobRepositoryMockFailUnfinishedParams{ctx, instanceID, createdBefore, reason, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFailUnfinished.t.Errorf("ImportJobRepositoryMock.FailUnfinished got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFailUnfinished.FailUnfinishedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.instanceID != nil && !minimock.Equal(*mm_want_ptrs.instanceID, mm_got.instanceID) {
+				mmFailUnfinished.t.Errorf("ImportJobRepositoryMock.FailUnfinished got unexpected parameter instanceID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFailUnfinished.FailUnfinishedMock.defaultExpectation.expectationOrigins.originInstanceID, *mm_want_ptrs.instanceID, mm_got.instanceID, minimock.Diff(*mm_want_ptrs.instanceID, mm_got.instanceID))
+			}
+
+			if mm_want_ptrs.createdBefore != nil && !minimock.Equal(*mm_want_ptrs.createdBefore, mm_got.createdBefore) {
+				mmFailUnfinished.t.Errorf("ImportJobRepositoryMock.FailUnfinished got unexpected parameter createdBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFailUnfinished.FailUnfinishedMock.defaultExpectation.expectationOrigins.originCreatedBefore, *mm_want_ptrs.createdBefore, mm_got.createdBefore, minimock.Diff(*mm_want_ptrs.createdBefore, mm_got.createdBefore))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmFailUnfinished.t.Errorf("ImportJobRepositoryMock.FailUnfinished got unexpected parameter reason, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFailUnfinished.FailUnfinishedMock.defaultExpectation.expectationOrigins.originReason, *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmFailUnfinished.t.Errorf("ImportJobRepositoryMock.FailUnfinished got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFailUnfinished.FailUnfinishedMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFailUnfinished.t.Errorf("ImportJobRepositoryMock.FailUnfinished got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFailUnfinished.FailUnfinishedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFailUnfinished.FailUnfinishedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFailUnfinished.t.Fatal("No results are set for the ImportJobRepositoryMock.FailUnfinished")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmFailUnfinished.funcFailUnfinished != nil {
+		return mmFailUnfinished.funcFailUnfinished(ctx, instanceID, createdBefore, reason, at)
+	}
+	mmFailUnfinished.t.Fatalf("Unexpected call to ImportJobRepositoryMock.FailUnfinished. %v %v %v %v %v", ctx, instanceID, createdBefore, reason, at)
+	return
+}
+
+// FailUnfinishedAfterCounter returns a count of finished ImportJobRepositoryMock.FailUnfinished invocations
+func (mmFailUnfinished *ImportJobRepositoryMock) FailUnfinishedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFailUnfinished.afterFailUnfinishedCounter)
+}
+
+// FailUnfinishedBeforeCounter returns a count of ImportJobRepositoryMock.FailUnfinished invocations
+func (mmFailUnfinished *ImportJobRepositoryMock) FailUnfinishedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFailUnfinished.beforeFailUnfinishedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobRepositoryMock.FailUnfinished.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFailUnfinished *mImportJobRepositoryMockFailUnfinished) Calls() []*ImportJobRepositoryMockFailUnfinishedParams {
+	mmFailUnfinished.mutex.RLock()
+
+	argCopy := make([]*ImportJobRepositoryMockFailUnfinishedParams, len(mmFailUnfinished.callArgs))
+	copy(argCopy, mmFailUnfinished.callArgs)
+
+	mmFailUnfinished.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFailUnfinishedDone returns true if the count of the FailUnfinished invocations corresponds
+// the number of defined expectations
+func (m *ImportJobRepositoryMock) MinimockFailUnfinishedDone() bool {
+	if m.FailUnfinishedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FailUnfinishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FailUnfinishedMock.invocationsDone()
+}
+
+// MinimockFailUnfinishedInspect logs each unmet expectation
+func (m *ImportJobRepositoryMock) MinimockFailUnfinishedInspect() {
+	for _, e := range m.FailUnfinishedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.FailUnfinished at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFailUnfinishedCounter := mm_atomic.LoadUint64(&m.afterFailUnfinishedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FailUnfinishedMock.defaultExpectation != nil && afterFailUnfinishedCounter < 1 {
+		if m.FailUnfinishedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.FailUnfinished at\n%s", m.FailUnfinishedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.FailUnfinished at\n%s with params: %#v", m.FailUnfinishedMock.defaultExpectation.expectationOrigins.origin, *m.FailUnfinishedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFailUnfinished != nil && afterFailUnfinishedCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobRepositoryMock.FailUnfinished at\n%s", m.funcFailUnfinishedOrigin)
+	}
+
+	if !m.FailUnfinishedMock.invocationsDone() && afterFailUnfinishedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobRepositoryMock.FailUnfinished at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FailUnfinishedMock.expectedInvocations), m.FailUnfinishedMock.expectedInvocationsOrigin, afterFailUnfinishedCounter)
+	}
+}
+
+type mImportJobRepositoryMockListFailures struct {
+	optional           bool
+	mock               *ImportJobRepositoryMock
+	defaultExpectation *ImportJobRepositoryMockListFailuresExpectation
+	expectations       []*ImportJobRepositoryMockListFailuresExpectation
+
+	callArgs []*ImportJobRepositoryMockListFailuresParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobRepositoryMockListFailuresExpectation specifies expectation struct of the ImportJobRepository.ListFailures
+type ImportJobRepositoryMockListFailuresExpectation struct {
+	mock               *ImportJobRepositoryMock
+	params             *ImportJobRepositoryMockListFailuresParams
+	paramPtrs          *ImportJobRepositoryMockListFailuresParamPtrs
+	expectationOrigins ImportJobRepositoryMockListFailuresExpectationOrigins
+	results            *ImportJobRepositoryMockListFailuresResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobRepositoryMockListFailuresParams contains parameters of the ImportJobRepository.ListFailures
+type ImportJobRepositoryMockListFailuresParams struct {
+	ctx   context.Context
+	jobID uint64
+}
+
+// ImportJobRepositoryMockListFailuresParamPtrs contains pointers to parameters of the ImportJobRepository.ListFailures
+type ImportJobRepositoryMockListFailuresParamPtrs struct {
+	ctx   *context.Context
+	jobID *uint64
+}
+
+// ImportJobRepositoryMockListFailuresResults contains results of the ImportJobRepository.ListFailures
+type ImportJobRepositoryMockListFailuresResults struct {
+	ia1 []models.ImportJobFailure
+	err error
+}
+
+// ImportJobRepositoryMockListFailuresOrigins contains origins of expectations of the ImportJobRepository.ListFailures
+type ImportJobRepositoryMockListFailuresExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originJobID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListFailures *mImportJobRepositoryMockListFailures) Optional() *mImportJobRepositoryMockListFailures {
+	mmListFailures.optional = true
+	return mmListFailures
+}
+
+// Expect sets up expected params for ImportJobRepository.ListFailures
+func (mmListFailures *mImportJobRepositoryMockListFailures) Expect(ctx context.Context, jobID uint64) *mImportJobRepositoryMockListFailures {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobRepositoryMock.ListFailures mock is already set by Set")
+	}
+
+	if mmListFailures.defaultExpectation == nil {
+		mmListFailures.defaultExpectation = &ImportJobRepositoryMockListFailuresExpectation{}
+	}
+
+	if mmListFailures.defaultExpectation.paramPtrs != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobRepositoryMock.ListFailures mock is already set by ExpectParams functions")
+	}
+
+	mmListFailures.defaultExpectation.params = &ImportJobRepositoryMockListFailuresParams{ctx, jobID}
+	mmListFailures.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListFailures.expectations {
+		if minimock.Equal(e.params, mmListFailures.defaultExpectation.params) {
+			mmListFailures.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListFailures.defaultExpectation.params)
+		}
+	}
+
+	return mmListFailures
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobRepository.ListFailures
+func (mmListFailures *mImportJobRepositoryMockListFailures) ExpectCtxParam1(ctx context.Context) *mImportJobRepositoryMockListFailures {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobRepositoryMock.ListFailures mock is already set by Set")
+	}
+
+	if mmListFailures.defaultExpectation == nil {
+		mmListFailures.defaultExpectation = &ImportJobRepositoryMockListFailuresExpectation{}
+	}
+
+	if mmListFailures.defaultExpectation.params != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobRepositoryMock.ListFailures mock is already set by Expect")
+	}
+
+	if mmListFailures.defaultExpectation.paramPtrs == nil {
+		mmListFailures.defaultExpectation.paramPtrs = &ImportJobRepositoryMockListFailuresParamPtrs{}
+	}
+	mmListFailures.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListFailures.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListFailures
+}
+
+// ExpectJobIDParam2 sets up expected param jobID for ImportJobRepository.ListFailures
+func (mmListFailures *mImportJobRepositoryMockListFailures) ExpectJobIDParam2(jobID uint64) *mImportJobRepositoryMockListFailures {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobRepositoryMock.ListFailures mock is already set by Set")
+	}
+
+	if mmListFailures.defaultExpectation == nil {
+		mmListFailures.defaultExpectation = &ImportJobRepositoryMockListFailuresExpectation{}
+	}
+
+	if mmListFailures.defaultExpectation.params != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobRepositoryMock.ListFailures mock is already set by Expect")
+	}
+
+	if mmListFailures.defaultExpectation.paramPtrs == nil {
+		mmListFailures.defaultExpectation.paramPtrs = &ImportJobRepositoryMockListFailuresParamPtrs{}
+	}
+	mmListFailures.defaultExpectation.paramPtrs.jobID = &jobID
+	mmListFailures.defaultExpectation.expectationOrigins.originJobID = minimock.CallerInfo(1)
+
+	return mmListFailures
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobRepository.ListFailures
+func (mmListFailures *mImportJobRepositoryMockListFailures) Inspect(f func(ctx context.Context, jobID uint64)) *mImportJobRepositoryMockListFailures {
+	if mmListFailures.mock.inspectFuncListFailures != nil {
+		mmListFailures.mock.t.Fatalf("Inspect function is already set for ImportJobRepositoryMock.ListFailures")
+	}
+
+	mmListFailures.mock.inspectFuncListFailures = f
+
+	return mmListFailures
+}
+
+// Return sets up results that will be returned by ImportJobRepository.ListFailures
+func (mmListFailures *mImportJobRepositoryMockListFailures) Return(ia1 []models.ImportJobFailure, err error) *ImportJobRepositoryMock {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobRepositoryMock.ListFailures mock is already set by Set")
+	}
+
+	if mmListFailures.defaultExpectation == nil {
+		mmListFailures.defaultExpectation = &ImportJobRepositoryMockListFailuresExpectation{mock: mmListFailures.mock}
+	}
+	mmListFailures.defaultExpectation.results = &ImportJobRepositoryMockListFailuresResults{ia1, err}
+	mmListFailures.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListFailures.mock
+}
+
+// Set uses given function f to mock the ImportJobRepository.ListFailures method
+func (mmListFailures *mImportJobRepositoryMockListFailures) Set(f func(ctx context.Context, jobID uint64) (ia1 []models.ImportJobFailure, err error)) *ImportJobRepositoryMock {
+	if mmListFailures.defaultExpectation != nil {
+		mmListFailures.mock.t.Fatalf("Default expectation is already set for the ImportJobRepository.ListFailures method")
+	}
+
+	if len(mmListFailures.expectations) > 0 {
+		mmListFailures.mock.t.Fatalf("Some expectations are already set for the ImportJobRepository.ListFailures method")
+	}
+
+	mmListFailures.mock.funcListFailures = f
+	mmListFailures.mock.funcListFailuresOrigin = minimock.CallerInfo(1)
+	return mmListFailures.mock
+}
+
+// When sets expectation for the ImportJobRepository.ListFailures which will trigger the result defined by the following
+// Then helper
+func (mmListFailures *mImportJobRepositoryMockListFailures) When(ctx context.Context, jobID uint64) *ImportJobRepositoryMockListFailuresExpectation {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobRepositoryMock.ListFailures mock is already set by Set")
+	}
+
+	expectation := &ImportJobRepositoryMockListFailuresExpectation{
+		mock:               mmListFailures.mock,
+		params:             &ImportJobRepositoryMockListFailuresParams{ctx, jobID},
+		expectationOrigins: ImportJobRepositoryMockListFailuresExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListFailures.expectations = append(mmListFailures.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobRepository.ListFailures return parameters for the expectation previously defined by the When method
+func (e *ImportJobRepositoryMockListFailuresExpectation) Then(ia1 []models.ImportJobFailure, err error) *ImportJobRepositoryMock {
+	e.results = &ImportJobRepositoryMockListFailuresResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobRepository.ListFailures should be invoked
+func (mmListFailures *mImportJobRepositoryMockListFailures) Times(n uint64) *mImportJobRepositoryMockListFailures {
+	if n == 0 {
+		mmListFailures.mock.t.Fatalf("Times of ImportJobRepositoryMock.ListFailures mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListFailures.expectedInvocations, n)
+	mmListFailures.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListFailures
+}
+
+func (mmListFailures *mImportJobRepositoryMockListFailures) invocationsDone() bool {
+	if len(mmListFailures.expectations) == 0 && mmListFailures.defaultExpectation == nil && mmListFailures.mock.funcListFailures == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListFailures.mock.afterListFailuresCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListFailures.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListFailures implements mm_repositories.ImportJobRepository
+func (mmListFailures *ImportJobRepositoryMock) ListFailures(ctx context.Context, jobID uint64) (ia1 []models.ImportJobFailure, err error) {
+	mm_atomic.AddUint64(&mmListFailures.beforeListFailuresCounter, 1)
+	defer mm_atomic.AddUint64(&mmListFailures.afterListFailuresCounter, 1)
+
+	mmListFailures.t.Helper()
+
+	if mmListFailures.inspectFuncListFailures != nil {
+		mmListFailures.inspectFuncListFailures(ctx, jobID)
+	}
+
+	mm_params := ImportJobRepositoryMockListFailuresParams{ctx, jobID}
+
+	// Record call args
+	mmListFailures.ListFailuresMock.mutex.Lock()
+	mmListFailures.ListFailuresMock.callArgs = append(mmListFailures.ListFailuresMock.callArgs, &mm_params)
+	mmListFailures.ListFailuresMock.mutex.Unlock()
+
+	for _, e := range mmListFailures.ListFailuresMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmListFailures.ListFailuresMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListFailures.ListFailuresMock.defaultExpectation.Counter, 1)
+		mm_want := mmListFailures.ListFailuresMock.defaultExpectation.params
+		mm_want_ptrs := mmListFailures.ListFailuresMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobRepositoryMockListFailuresParams{ctx, jobID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListFailures.t.Errorf("ImportJobRepositoryMock.ListFailures got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFailures.ListFailuresMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.jobID != nil && !minimock.Equal(*mm_want_ptrs.jobID, mm_got.jobID) {
+				mmListFailures.t.Errorf("ImportJobRepositoryMock.ListFailures got unexpected parameter jobID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFailures.ListFailuresMock.defaultExpectation.expectationOrigins.originJobID, *mm_want_ptrs.jobID, mm_got.jobID, minimock.Diff(*mm_want_ptrs.jobID, mm_got.jobID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListFailures.t.Errorf("ImportJobRepositoryMock.ListFailures got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListFailures.ListFailuresMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListFailures.ListFailuresMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListFailures.t.Fatal("No results are set for the ImportJobRepositoryMock.ListFailures")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmListFailures.funcListFailures != nil {
+		return mmListFailures.funcListFailures(ctx, jobID)
+	}
+	mmListFailures.t.Fatalf("Unexpected call to ImportJobRepositoryMock.ListFailures. %v %v", ctx, jobID)
+	return
+}
+
+// ListFailuresAfterCounter returns a count of finished ImportJobRepositoryMock.ListFailures invocations
+func (mmListFailures *ImportJobRepositoryMock) ListFailuresAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFailures.afterListFailuresCounter)
+}
+
+// ListFailuresBeforeCounter returns a count of ImportJobRepositoryMock.ListFailures invocations
+func (mmListFailures *ImportJobRepositoryMock) ListFailuresBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFailures.beforeListFailuresCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobRepositoryMock.ListFailures.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListFailures *mImportJobRepositoryMockListFailures) Calls() []*ImportJobRepositoryMockListFailuresParams {
+	mmListFailures.mutex.RLock()
+
+	argCopy := make([]*ImportJobRepositoryMockListFailuresParams, len(mmListFailures.callArgs))
+	copy(argCopy, mmListFailures.callArgs)
+
+	mmListFailures.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListFailuresDone returns true if the count of the ListFailures invocations corresponds
+// the number of defined expectations
+func (m *ImportJobRepositoryMock) MinimockListFailuresDone() bool {
+	if m.ListFailuresMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListFailuresMock.invocationsDone()
+}
+
+// MinimockListFailuresInspect logs each unmet expectation
+func (m *ImportJobRepositoryMock) MinimockListFailuresInspect() {
+	for _, e := range m.ListFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.ListFailures at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListFailuresCounter := mm_atomic.LoadUint64(&m.afterListFailuresCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListFailuresMock.defaultExpectation != nil && afterListFailuresCounter < 1 {
+		if m.ListFailuresMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.ListFailures at\n%s", m.ListFailuresMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.ListFailures at\n%s with params: %#v", m.ListFailuresMock.defaultExpectation.expectationOrigins.origin, *m.ListFailuresMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListFailures != nil && afterListFailuresCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobRepositoryMock.ListFailures at\n%s", m.funcListFailuresOrigin)
+	}
+
+	if !m.ListFailuresMock.invocationsDone() && afterListFailuresCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobRepositoryMock.ListFailures at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListFailuresMock.expectedInvocations), m.ListFailuresMock.expectedInvocationsOrigin, afterListFailuresCounter)
+	}
+}
+
+type mImportJobRepositoryMockLoad struct {
+	optional           bool
+	mock               *ImportJobRepositoryMock
+	defaultExpectation *ImportJobRepositoryMockLoadExpectation
+	expectations       []*ImportJobRepositoryMockLoadExpectation
+
+	callArgs []*ImportJobRepositoryMockLoadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobRepositoryMockLoadExpectation specifies expectation struct of the ImportJobRepository.Load
+type ImportJobRepositoryMockLoadExpectation struct {
+	mock               *ImportJobRepositoryMock
+	params             *ImportJobRepositoryMockLoadParams
+	paramPtrs          *ImportJobRepositoryMockLoadParamPtrs
+	expectationOrigins ImportJobRepositoryMockLoadExpectationOrigins
+	results            *ImportJobRepositoryMockLoadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobRepositoryMockLoadParams contains parameters of the ImportJobRepository.Load
+type ImportJobRepositoryMockLoadParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// ImportJobRepositoryMockLoadParamPtrs contains pointers to parameters of the ImportJobRepository.Load
+type ImportJobRepositoryMockLoadParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// ImportJobRepositoryMockLoadResults contains results of the ImportJobRepository.Load
+type ImportJobRepositoryMockLoadResults struct {
+	i1  models.ImportJob
+	err error
+}
+
+// ImportJobRepositoryMockLoadOrigins contains origins of expectations of the ImportJobRepository.Load
+type ImportJobRepositoryMockLoadExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLoad *mImportJobRepositoryMockLoad) Optional() *mImportJobRepositoryMockLoad {
+	mmLoad.optional = true
+	return mmLoad
+}
+
+// Expect sets up expected params for ImportJobRepository.Load
+func (mmLoad *mImportJobRepositoryMockLoad) Expect(ctx context.Context, id uint64) *mImportJobRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ImportJobRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &ImportJobRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs != nil {
+		mmLoad.mock.t.Fatalf("ImportJobRepositoryMock.Load mock is already set by ExpectParams functions")
+	}
+
+	mmLoad.defaultExpectation.params = &ImportJobRepositoryMockLoadParams{ctx, id}
+	mmLoad.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLoad.expectations {
+		if minimock.Equal(e.params, mmLoad.defaultExpectation.params) {
+			mmLoad.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLoad.defaultExpectation.params)
+		}
+	}
+
+	return mmLoad
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobRepository.Load
+func (mmLoad *mImportJobRepositoryMockLoad) ExpectCtxParam1(ctx context.Context) *mImportJobRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ImportJobRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &ImportJobRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.params != nil {
+		mmLoad.mock.t.Fatalf("ImportJobRepositoryMock.Load mock is already set by Expect")
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs == nil {
+		mmLoad.defaultExpectation.paramPtrs = &ImportJobRepositoryMockLoadParamPtrs{}
+	}
+	mmLoad.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLoad.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLoad
+}
+
+// ExpectIdParam2 sets up expected param id for ImportJobRepository.Load
+func (mmLoad *mImportJobRepositoryMockLoad) ExpectIdParam2(id uint64) *mImportJobRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ImportJobRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &ImportJobRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.params != nil {
+		mmLoad.mock.t.Fatalf("ImportJobRepositoryMock.Load mock is already set by Expect")
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs == nil {
+		mmLoad.defaultExpectation.paramPtrs = &ImportJobRepositoryMockLoadParamPtrs{}
+	}
+	mmLoad.defaultExpectation.paramPtrs.id = &id
+	mmLoad.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmLoad
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobRepository.Load
+func (mmLoad *mImportJobRepositoryMockLoad) Inspect(f func(ctx context.Context, id uint64)) *mImportJobRepositoryMockLoad {
+	if mmLoad.mock.inspectFuncLoad != nil {
+		mmLoad.mock.t.Fatalf("Inspect function is already set for ImportJobRepositoryMock.Load")
+	}
+
+	mmLoad.mock.inspectFuncLoad = f
+
+	return mmLoad
+}
+
+// Return sets up results that will be returned by ImportJobRepository.Load
+func (mmLoad *mImportJobRepositoryMockLoad) Return(i1 models.ImportJob, err error) *ImportJobRepositoryMock {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ImportJobRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &ImportJobRepositoryMockLoadExpectation{mock: mmLoad.mock}
+	}
+	mmLoad.defaultExpectation.results = &ImportJobRepositoryMockLoadResults{i1, err}
+	mmLoad.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLoad.mock
+}
+
+// Set uses given function f to mock the ImportJobRepository.Load method
+func (mmLoad *mImportJobRepositoryMockLoad) Set(f func(ctx context.Context, id uint64) (i1 models.ImportJob, err error)) *ImportJobRepositoryMock {
+	if mmLoad.defaultExpectation != nil {
+		mmLoad.mock.t.Fatalf("Default expectation is already set for the ImportJobRepository.Load method")
+	}
+
+	if len(mmLoad.expectations) > 0 {
+		mmLoad.mock.t.Fatalf("Some expectations are already set for the ImportJobRepository.Load method")
+	}
+
+	mmLoad.mock.funcLoad = f
+	mmLoad.mock.funcLoadOrigin = minimock.CallerInfo(1)
+	return mmLoad.mock
+}
+
+// When sets expectation for the ImportJobRepository.Load which will trigger the result defined by the following
+// Then helper
+func (mmLoad *mImportJobRepositoryMockLoad) When(ctx context.Context, id uint64) *ImportJobRepositoryMockLoadExpectation {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ImportJobRepositoryMock.Load mock is already set by Set")
+	}
+
+	expectation := &ImportJobRepositoryMockLoadExpectation{
+		mock:               mmLoad.mock,
+		params:             &ImportJobRepositoryMockLoadParams{ctx, id},
+		expectationOrigins: ImportJobRepositoryMockLoadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLoad.expectations = append(mmLoad.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobRepository.Load return parameters for the expectation previously defined by the When method
+func (e *ImportJobRepositoryMockLoadExpectation) Then(i1 models.ImportJob, err error) *ImportJobRepositoryMock {
+	e.results = &ImportJobRepositoryMockLoadResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobRepository.Load should be invoked
+func (mmLoad *mImportJobRepositoryMockLoad) Times(n uint64) *mImportJobRepositoryMockLoad {
+	if n == 0 {
+		mmLoad.mock.t.Fatalf("Times of ImportJobRepositoryMock.Load mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLoad.expectedInvocations, n)
+	mmLoad.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLoad
+}
+
+func (mmLoad *mImportJobRepositoryMockLoad) invocationsDone() bool {
+	if len(mmLoad.expectations) == 0 && mmLoad.defaultExpectation == nil && mmLoad.mock.funcLoad == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLoad.mock.afterLoadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLoad.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Load implements mm_repositories.ImportJobRepository
+func (mmLoad *ImportJobRepositoryMock) Load(ctx context.Context, id uint64) (i1 models.ImportJob, err error) {
+	mm_atomic.AddUint64(&mmLoad.beforeLoadCounter, 1)
+	defer mm_atomic.AddUint64(&mmLoad.afterLoadCounter, 1)
+
+	mmLoad.t.Helper()
+
+	if mmLoad.inspectFuncLoad != nil {
+		mmLoad.inspectFuncLoad(ctx, id)
+	}
+
+	mm_params := ImportJobRepositoryMockLoadParams{ctx, id}
+
+	// Record call args
+	mmLoad.LoadMock.mutex.Lock()
+	mmLoad.LoadMock.callArgs = append(mmLoad.LoadMock.callArgs, &mm_params)
+	mmLoad.LoadMock.mutex.Unlock()
+
+	for _, e := range mmLoad.LoadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmLoad.LoadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLoad.LoadMock.defaultExpectation.Counter, 1)
+		mm_want := mmLoad.LoadMock.defaultExpectation.params
+		mm_want_ptrs := mmLoad.LoadMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobRepositoryMockLoadParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLoad.t.Errorf("ImportJobRepositoryMock.Load got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoad.LoadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmLoad.t.Errorf("ImportJobRepositoryMock.Load got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoad.LoadMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLoad.t.Errorf("ImportJobRepositoryMock.Load got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLoad.LoadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLoad.LoadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLoad.t.Fatal("No results are set for the ImportJobRepositoryMock.Load")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmLoad.funcLoad != nil {
+		return mmLoad.funcLoad(ctx, id)
+	}
+	mmLoad.t.Fatalf("Unexpected call to ImportJobRepositoryMock.Load. %v %v", ctx, id)
+	return
+}
+
+// LoadAfterCounter returns a count of finished ImportJobRepositoryMock.Load invocations
+func (mmLoad *ImportJobRepositoryMock) LoadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoad.afterLoadCounter)
+}
+
+// LoadBeforeCounter returns a count of ImportJobRepositoryMock.Load invocations
+func (mmLoad *ImportJobRepositoryMock) LoadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoad.beforeLoadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobRepositoryMock.Load.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLoad *mImportJobRepositoryMockLoad) Calls() []*ImportJobRepositoryMockLoadParams {
+	mmLoad.mutex.RLock()
+
+	argCopy := make([]*ImportJobRepositoryMockLoadParams, len(mmLoad.callArgs))
+	copy(argCopy, mmLoad.callArgs)
+
+	mmLoad.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoadDone returns true if the count of the Load invocations corresponds
+// the number of defined expectations
+func (m *ImportJobRepositoryMock) MinimockLoadDone() bool {
+	if m.LoadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoadMock.invocationsDone()
+}
+
+// MinimockLoadInspect logs each unmet expectation
+func (m *ImportJobRepositoryMock) MinimockLoadInspect() {
+	for _, e := range m.LoadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.Load at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoadCounter := mm_atomic.LoadUint64(&m.afterLoadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoadMock.defaultExpectation != nil && afterLoadCounter < 1 {
+		if m.LoadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.Load at\n%s", m.LoadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.Load at\n%s with params: %#v", m.LoadMock.defaultExpectation.expectationOrigins.origin, *m.LoadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLoad != nil && afterLoadCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobRepositoryMock.Load at\n%s", m.funcLoadOrigin)
+	}
+
+	if !m.LoadMock.invocationsDone() && afterLoadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobRepositoryMock.Load at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoadMock.expectedInvocations), m.LoadMock.expectedInvocationsOrigin, afterLoadCounter)
+	}
+}
+
+type mImportJobRepositoryMockSave struct {
+	optional           bool
+	mock               *ImportJobRepositoryMock
+	defaultExpectation *ImportJobRepositoryMockSaveExpectation
+	expectations       []*ImportJobRepositoryMockSaveExpectation
+
+	callArgs []*ImportJobRepositoryMockSaveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobRepositoryMockSaveExpectation specifies expectation struct of the ImportJobRepository.Save
+type ImportJobRepositoryMockSaveExpectation struct {
+	mock               *ImportJobRepositoryMock
+	params             *ImportJobRepositoryMockSaveParams
+	paramPtrs          *ImportJobRepositoryMockSaveParamPtrs
+	expectationOrigins ImportJobRepositoryMockSaveExpectationOrigins
+	results            *ImportJobRepositoryMockSaveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobRepositoryMockSaveParams contains parameters of the ImportJobRepository.Save
+type ImportJobRepositoryMockSaveParams struct {
+	ctx context.Context
+	job models.ImportJob
+}
+
+// ImportJobRepositoryMockSaveParamPtrs contains pointers to parameters of the ImportJobRepository.Save
+type ImportJobRepositoryMockSaveParamPtrs struct {
+	ctx *context.Context
+	job *models.ImportJob
+}
+
+// ImportJobRepositoryMockSaveResults contains results of the ImportJobRepository.Save
+type ImportJobRepositoryMockSaveResults struct {
+	err error
+}
+
+// ImportJobRepositoryMockSaveOrigins contains origins of expectations of the ImportJobRepository.Save
+type ImportJobRepositoryMockSaveExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originJob string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSave *mImportJobRepositoryMockSave) Optional() *mImportJobRepositoryMockSave {
+	mmSave.optional = true
+	return mmSave
+}
+
+// Expect sets up expected params for ImportJobRepository.Save
+func (mmSave *mImportJobRepositoryMockSave) Expect(ctx context.Context, job models.ImportJob) *mImportJobRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ImportJobRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &ImportJobRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.paramPtrs != nil {
+		mmSave.mock.t.Fatalf("ImportJobRepositoryMock.Save mock is already set by ExpectParams functions")
+	}
+
+	mmSave.defaultExpectation.params = &ImportJobRepositoryMockSaveParams{ctx, job}
+	mmSave.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSave.expectations {
+		if minimock.Equal(e.params, mmSave.defaultExpectation.params) {
+			mmSave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSave.defaultExpectation.params)
+		}
+	}
+
+	return mmSave
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobRepository.Save
+func (mmSave *mImportJobRepositoryMockSave) ExpectCtxParam1(ctx context.Context) *mImportJobRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ImportJobRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &ImportJobRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("ImportJobRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &ImportJobRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSave.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// ExpectJobParam2 sets up expected param job for ImportJobRepository.Save
+func (mmSave *mImportJobRepositoryMockSave) ExpectJobParam2(job models.ImportJob) *mImportJobRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ImportJobRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &ImportJobRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("ImportJobRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &ImportJobRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.job = &job
+	mmSave.defaultExpectation.expectationOrigins.originJob = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobRepository.Save
+func (mmSave *mImportJobRepositoryMockSave) Inspect(f func(ctx context.Context, job models.ImportJob)) *mImportJobRepositoryMockSave {
+	if mmSave.mock.inspectFuncSave != nil {
+		mmSave.mock.t.Fatalf("Inspect function is already set for ImportJobRepositoryMock.Save")
+	}
+
+	mmSave.mock.inspectFuncSave = f
+
+	return mmSave
+}
+
+// Return sets up results that will be returned by ImportJobRepository.Save
+func (mmSave *mImportJobRepositoryMockSave) Return(err error) *ImportJobRepositoryMock {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ImportJobRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &ImportJobRepositoryMockSaveExpectation{mock: mmSave.mock}
+	}
+	mmSave.defaultExpectation.results = &ImportJobRepositoryMockSaveResults{err}
+	mmSave.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// Set uses given function f to mock the ImportJobRepository.Save method
+func (mmSave *mImportJobRepositoryMockSave) Set(f func(ctx context.Context, job models.ImportJob) (err error)) *ImportJobRepositoryMock {
+	if mmSave.defaultExpectation != nil {
+		mmSave.mock.t.Fatalf("Default expectation is already set for the ImportJobRepository.Save method")
+	}
+
+	if len(mmSave.expectations) > 0 {
+		mmSave.mock.t.Fatalf("Some expectations are already set for the ImportJobRepository.Save method")
+	}
+
+	mmSave.mock.funcSave = f
+	mmSave.mock.funcSaveOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// When sets expectation for the ImportJobRepository.Save which will trigger the result defined by the following
+// Then helper
+func (mmSave *mImportJobRepositoryMockSave) When(ctx context.Context, job models.ImportJob) *ImportJobRepositoryMockSaveExpectation {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ImportJobRepositoryMock.Save mock is already set by Set")
+	}
+
+	expectation := &ImportJobRepositoryMockSaveExpectation{
+		mock:               mmSave.mock,
+		params:             &ImportJobRepositoryMockSaveParams{ctx, job},
+		expectationOrigins: ImportJobRepositoryMockSaveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSave.expectations = append(mmSave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobRepository.Save return parameters for the expectation previously defined by the When method
+func (e *ImportJobRepositoryMockSaveExpectation) Then(err error) *ImportJobRepositoryMock {
+	e.results = &ImportJobRepositoryMockSaveResults{err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobRepository.Save should be invoked
+func (mmSave *mImportJobRepositoryMockSave) Times(n uint64) *mImportJobRepositoryMockSave {
+	if n == 0 {
+		mmSave.mock.t.Fatalf("Times of ImportJobRepositoryMock.Save mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSave.expectedInvocations, n)
+	mmSave.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSave
+}
+
+func (mmSave *mImportJobRepositoryMockSave) invocationsDone() bool {
+	if len(mmSave.expectations) == 0 && mmSave.defaultExpectation == nil && mmSave.mock.funcSave == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSave.mock.afterSaveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSave.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Save implements mm_repositories.ImportJobRepository
+func (mmSave *ImportJobRepositoryMock) Save(ctx context.Context, job models.ImportJob) (err error) {
+	mm_atomic.AddUint64(&mmSave.beforeSaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmSave.afterSaveCounter, 1)
+
+	mmSave.t.Helper()
+
+	if mmSave.inspectFuncSave != nil {
+		mmSave.inspectFuncSave(ctx, job)
+	}
+
+	mm_params := ImportJobRepositoryMockSaveParams{ctx, job}
+
+	// Record call args
+	mmSave.SaveMock.mutex.Lock()
+	mmSave.SaveMock.callArgs = append(mmSave.SaveMock.callArgs, &mm_params)
+	mmSave.SaveMock.mutex.Unlock()
+
+	for _, e := range mmSave.SaveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSave.SaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSave.SaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmSave.SaveMock.defaultExpectation.params
+		mm_want_ptrs := mmSave.SaveMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobRepositoryMockSaveParams{ctx, job}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSave.t.Errorf("ImportJobRepositoryMock.Save got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.job != nil && !minimock.Equal(*mm_want_ptrs.job, mm_got.job) {
+				mmSave.t.Errorf("ImportJobRepositoryMock.Save got unexpected parameter job, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originJob, *mm_want_ptrs.job, mm_got.job, minimock.Diff(*mm_want_ptrs.job, mm_got.job))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSave.t.Errorf("ImportJobRepositoryMock.Save got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSave.SaveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSave.SaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSave.t.Fatal("No results are set for the ImportJobRepositoryMock.Save")
+		}
+		return (*mm_results).err
+	}
+	if mmSave.funcSave != nil {
+		return mmSave.funcSave(ctx, job)
+	}
+	mmSave.t.Fatalf("Unexpected call to ImportJobRepositoryMock.Save. %v %v", ctx, job)
+	return
+}
+
+// SaveAfterCounter returns a count of finished ImportJobRepositoryMock.Save invocations
+func (mmSave *ImportJobRepositoryMock) SaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.afterSaveCounter)
+}
+
+// SaveBeforeCounter returns a count of ImportJobRepositoryMock.Save invocations
+func (mmSave *ImportJobRepositoryMock) SaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.beforeSaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobRepositoryMock.Save.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSave *mImportJobRepositoryMockSave) Calls() []*ImportJobRepositoryMockSaveParams {
+	mmSave.mutex.RLock()
+
+	argCopy := make([]*ImportJobRepositoryMockSaveParams, len(mmSave.callArgs))
+	copy(argCopy, mmSave.callArgs)
+
+	mmSave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveDone returns true if the count of the Save invocations corresponds
+// the number of defined expectations
+func (m *ImportJobRepositoryMock) MinimockSaveDone() bool {
+	if m.SaveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveMock.invocationsDone()
+}
+
+// MinimockSaveInspect logs each unmet expectation
+func (m *ImportJobRepositoryMock) MinimockSaveInspect() {
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.Save at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveCounter := mm_atomic.LoadUint64(&m.afterSaveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveMock.defaultExpectation != nil && afterSaveCounter < 1 {
+		if m.SaveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.Save at\n%s", m.SaveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.Save at\n%s with params: %#v", m.SaveMock.defaultExpectation.expectationOrigins.origin, *m.SaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSave != nil && afterSaveCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobRepositoryMock.Save at\n%s", m.funcSaveOrigin)
+	}
+
+	if !m.SaveMock.invocationsDone() && afterSaveCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobRepositoryMock.Save at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveMock.expectedInvocations), m.SaveMock.expectedInvocationsOrigin, afterSaveCounter)
+	}
+}
+
+type mImportJobRepositoryMockSaveFailures struct {
+	optional           bool
+	mock               *ImportJobRepositoryMock
+	defaultExpectation *ImportJobRepositoryMockSaveFailuresExpectation
+	expectations       []*ImportJobRepositoryMockSaveFailuresExpectation
+
+	callArgs []*ImportJobRepositoryMockSaveFailuresParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobRepositoryMockSaveFailuresExpectation specifies expectation struct of the ImportJobRepository.SaveFailures
+type ImportJobRepositoryMockSaveFailuresExpectation struct {
+	mock               *ImportJobRepositoryMock
+	params             *ImportJobRepositoryMockSaveFailuresParams
+	paramPtrs          *ImportJobRepositoryMockSaveFailuresParamPtrs
+	expectationOrigins ImportJobRepositoryMockSaveFailuresExpectationOrigins
+	results            *ImportJobRepositoryMockSaveFailuresResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobRepositoryMockSaveFailuresParams contains parameters of the ImportJobRepository.SaveFailures
+type ImportJobRepositoryMockSaveFailuresParams struct {
+	ctx      context.Context
+	failures []models.ImportJobFailure
+}
+
+// ImportJobRepositoryMockSaveFailuresParamPtrs contains pointers to parameters of the ImportJobRepository.SaveFailures
+type ImportJobRepositoryMockSaveFailuresParamPtrs struct {
+	ctx      *context.Context
+	failures *[]models.ImportJobFailure
+}
+
+// ImportJobRepositoryMockSaveFailuresResults contains results of the ImportJobRepository.SaveFailures
+type ImportJobRepositoryMockSaveFailuresResults struct {
+	err error
+}
+
+// ImportJobRepositoryMockSaveFailuresOrigins contains origins of expectations of the ImportJobRepository.SaveFailures
+type ImportJobRepositoryMockSaveFailuresExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originFailures string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) Optional() *mImportJobRepositoryMockSaveFailures {
+	mmSaveFailures.optional = true
+	return mmSaveFailures
+}
+
+// Expect sets up expected params for ImportJobRepository.SaveFailures
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) Expect(ctx context.Context, failures []models.ImportJobFailure) *mImportJobRepositoryMockSaveFailures {
+	if mmSaveFailures.mock.funcSaveFailures != nil {
+		mmSaveFailures.mock.t.Fatalf("ImportJobRepositoryMock.SaveFailures mock is already set by Set")
+	}
+
+	if mmSaveFailures.defaultExpectation == nil {
+		mmSaveFailures.defaultExpectation = &ImportJobRepositoryMockSaveFailuresExpectation{}
+	}
+
+	if mmSaveFailures.defaultExpectation.paramPtrs != nil {
+		mmSaveFailures.mock.t.Fatalf("ImportJobRepositoryMock.SaveFailures mock is already set by ExpectParams functions")
+	}
+
+	mmSaveFailures.defaultExpectation.params = &ImportJobRepositoryMockSaveFailuresParams{ctx, failures}
+	mmSaveFailures.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveFailures.expectations {
+		if minimock.Equal(e.params, mmSaveFailures.defaultExpectation.params) {
+			mmSaveFailures.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveFailures.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveFailures
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobRepository.SaveFailures
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) ExpectCtxParam1(ctx context.Context) *mImportJobRepositoryMockSaveFailures {
+	if mmSaveFailures.mock.funcSaveFailures != nil {
+		mmSaveFailures.mock.t.Fatalf("ImportJobRepositoryMock.SaveFailures mock is already set by Set")
+	}
+
+	if mmSaveFailures.defaultExpectation == nil {
+		mmSaveFailures.defaultExpectation = &ImportJobRepositoryMockSaveFailuresExpectation{}
+	}
+
+	if mmSaveFailures.defaultExpectation.params != nil {
+		mmSaveFailures.mock.t.Fatalf("ImportJobRepositoryMock.SaveFailures mock is already set by Expect")
+	}
+
+	if mmSaveFailures.defaultExpectation.paramPtrs == nil {
+		mmSaveFailures.defaultExpectation.paramPtrs = &ImportJobRepositoryMockSaveFailuresParamPtrs{}
+	}
+	mmSaveFailures.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveFailures.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveFailures
+}
+
+// ExpectFailuresParam2 sets up expected param failures for ImportJobRepository.SaveFailures
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) ExpectFailuresParam2(failures []models.ImportJobFailure) *mImportJobRepositoryMockSaveFailures {
+	if mmSaveFailures.mock.funcSaveFailures != nil {
+		mmSaveFailures.mock.t.Fatalf("ImportJobRepositoryMock.SaveFailures mock is already set by Set")
+	}
+
+	if mmSaveFailures.defaultExpectation == nil {
+		mmSaveFailures.defaultExpectation = &ImportJobRepositoryMockSaveFailuresExpectation{}
+	}
+
+	if mmSaveFailures.defaultExpectation.params != nil {
+		mmSaveFailures.mock.t.Fatalf("ImportJobRepositoryMock.SaveFailures mock is already set by Expect")
+	}
+
+	if mmSaveFailures.defaultExpectation.paramPtrs == nil {
+		mmSaveFailures.defaultExpectation.paramPtrs = &ImportJobRepositoryMockSaveFailuresParamPtrs{}
+	}
+	mmSaveFailures.defaultExpectation.paramPtrs.failures = &failures
+	mmSaveFailures.defaultExpectation.expectationOrigins.originFailures = minimock.CallerInfo(1)
+
+	return mmSaveFailures
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobRepository.SaveFailures
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) Inspect(f func(ctx context.Context, failures []models.ImportJobFailure)) *mImportJobRepositoryMockSaveFailures {
+	if mmSaveFailures.mock.inspectFuncSaveFailures != nil {
+		mmSaveFailures.mock.t.Fatalf("Inspect function is already set for ImportJobRepositoryMock.SaveFailures")
+	}
+
+	mmSaveFailures.mock.inspectFuncSaveFailures = f
+
+	return mmSaveFailures
+}
+
+// Return sets up results that will be returned by ImportJobRepository.SaveFailures
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) Return(err error) *ImportJobRepositoryMock {
+	if mmSaveFailures.mock.funcSaveFailures != nil {
+		mmSaveFailures.mock.t.Fatalf("ImportJobRepositoryMock.SaveFailures mock is already set by Set")
+	}
+
+	if mmSaveFailures.defaultExpectation == nil {
+		mmSaveFailures.defaultExpectation = &ImportJobRepositoryMockSaveFailuresExpectation{mock: mmSaveFailures.mock}
+	}
+	mmSaveFailures.defaultExpectation.results = &ImportJobRepositoryMockSaveFailuresResults{err}
+	mmSaveFailures.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveFailures.mock
+}
+
+// Set uses given function f to mock the ImportJobRepository.SaveFailures method
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) Set(f func(ctx context.Context, failures []models.ImportJobFailure) (err error)) *ImportJobRepositoryMock {
+	if mmSaveFailures.defaultExpectation != nil {
+		mmSaveFailures.mock.t.Fatalf("Default expectation is already set for the ImportJobRepository.SaveFailures method")
+	}
+
+	if len(mmSaveFailures.expectations) > 0 {
+		mmSaveFailures.mock.t.Fatalf("Some expectations are already set for the ImportJobRepository.SaveFailures method")
+	}
+
+	mmSaveFailures.mock.funcSaveFailures = f
+	mmSaveFailures.mock.funcSaveFailuresOrigin = minimock.CallerInfo(1)
+	return mmSaveFailures.mock
+}
+
+// When sets expectation for the ImportJobRepository.SaveFailures which will trigger the result defined by the following
+// Then helper
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) When(ctx context.Context, failures []models.ImportJobFailure) *ImportJobRepositoryMockSaveFailuresExpectation {
+	if mmSaveFailures.mock.funcSaveFailures != nil {
+		mmSaveFailures.mock.t.Fatalf("ImportJobRepositoryMock.SaveFailures mock is already set by Set")
+	}
+
+	expectation := &ImportJobRepositoryMockSaveFailuresExpectation{
+		mock:               mmSaveFailures.mock,
+		params:             &ImportJobRepositoryMockSaveFailuresParams{ctx, failures},
+		expectationOrigins: ImportJobRepositoryMockSaveFailuresExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveFailures.expectations = append(mmSaveFailures.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobRepository.SaveFailures return parameters for the expectation previously defined by the When method
+func (e *ImportJobRepositoryMockSaveFailuresExpectation) Then(err error) *ImportJobRepositoryMock {
+	e.results = &ImportJobRepositoryMockSaveFailuresResults{err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobRepository.SaveFailures should be invoked
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) Times(n uint64) *mImportJobRepositoryMockSaveFailures {
+	if n == 0 {
+		mmSaveFailures.mock.t.Fatalf("Times of ImportJobRepositoryMock.SaveFailures mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveFailures.expectedInvocations, n)
+	mmSaveFailures.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveFailures
+}
+
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) invocationsDone() bool {
+	if len(mmSaveFailures.expectations) == 0 && mmSaveFailures.defaultExpectation == nil && mmSaveFailures.mock.funcSaveFailures == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveFailures.mock.afterSaveFailuresCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveFailures.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveFailures implements mm_repositories.ImportJobRepository
+func (mmSaveFailures *ImportJobRepositoryMock) SaveFailures(ctx context.Context, failures []models.ImportJobFailure) (err error) {
+	mm_atomic.AddUint64(&mmSaveFailures.beforeSaveFailuresCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveFailures.afterSaveFailuresCounter, 1)
+
+	mmSaveFailures.t.Helper()
+
+	if mmSaveFailures.inspectFuncSaveFailures != nil {
+		mmSaveFailures.inspectFuncSaveFailures(ctx, failures)
+	}
+
+	mm_params := ImportJobRepositoryMockSaveFailuresParams{ctx, failures}
+
+	// Record call args
+	mmSaveFailures.SaveFailuresMock.mutex.Lock()
+	mmSaveFailures.SaveFailuresMock.callArgs = append(mmSaveFailures.SaveFailuresMock.callArgs, &mm_params)
+	mmSaveFailures.SaveFailuresMock.mutex.Unlock()
+
+	for _, e := range mmSaveFailures.SaveFailuresMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveFailures.SaveFailuresMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveFailures.SaveFailuresMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveFailures.SaveFailuresMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveFailures.SaveFailuresMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobRepositoryMockSaveFailuresParams{ctx, failures}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveFailures.t.Errorf("ImportJobRepositoryMock.SaveFailures got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveFailures.SaveFailuresMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.failures != nil && !minimock.Equal(*mm_want_ptrs.failures, mm_got.failures) {
+				mmSaveFailures.t.Errorf("ImportJobRepositoryMock.SaveFailures got unexpected parameter failures, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveFailures.SaveFailuresMock.defaultExpectation.expectationOrigins.originFailures, *mm_want_ptrs.failures, mm_got.failures, minimock.Diff(*mm_want_ptrs.failures, mm_got.failures))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveFailures.t.Errorf("ImportJobRepositoryMock.SaveFailures got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveFailures.SaveFailuresMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveFailures.SaveFailuresMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveFailures.t.Fatal("No results are set for the ImportJobRepositoryMock.SaveFailures")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveFailures.funcSaveFailures != nil {
+		return mmSaveFailures.funcSaveFailures(ctx, failures)
+	}
+	mmSaveFailures.t.Fatalf("Unexpected call to ImportJobRepositoryMock.SaveFailures. %v %v", ctx, failures)
+	return
+}
+
+// SaveFailuresAfterCounter returns a count of finished ImportJobRepositoryMock.SaveFailures invocations
+func (mmSaveFailures *ImportJobRepositoryMock) SaveFailuresAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveFailures.afterSaveFailuresCounter)
+}
+
+// SaveFailuresBeforeCounter returns a count of ImportJobRepositoryMock.SaveFailures invocations
+func (mmSaveFailures *ImportJobRepositoryMock) SaveFailuresBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveFailures.beforeSaveFailuresCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobRepositoryMock.SaveFailures.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveFailures *mImportJobRepositoryMockSaveFailures) Calls() []*ImportJobRepositoryMockSaveFailuresParams {
+	mmSaveFailures.mutex.RLock()
+
+	argCopy := make([]*ImportJobRepositoryMockSaveFailuresParams, len(mmSaveFailures.callArgs))
+	copy(argCopy, mmSaveFailures.callArgs)
+
+	mmSaveFailures.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveFailuresDone returns true if the count of the SaveFailures invocations corresponds
+// the number of defined expectations
+func (m *ImportJobRepositoryMock) MinimockSaveFailuresDone() bool {
+	if m.SaveFailuresMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveFailuresMock.invocationsDone()
+}
+
+// MinimockSaveFailuresInspect logs each unmet expectation
+func (m *ImportJobRepositoryMock) MinimockSaveFailuresInspect() {
+	for _, e := range m.SaveFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.SaveFailures at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveFailuresCounter := mm_atomic.LoadUint64(&m.afterSaveFailuresCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveFailuresMock.defaultExpectation != nil && afterSaveFailuresCounter < 1 {
+		if m.SaveFailuresMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.SaveFailures at\n%s", m.SaveFailuresMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobRepositoryMock.SaveFailures at\n%s with params: %#v", m.SaveFailuresMock.defaultExpectation.expectationOrigins.origin, *m.SaveFailuresMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveFailures != nil && afterSaveFailuresCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobRepositoryMock.SaveFailures at\n%s", m.funcSaveFailuresOrigin)
+	}
+
+	if !m.SaveFailuresMock.invocationsDone() && afterSaveFailuresCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobRepositoryMock.SaveFailures at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveFailuresMock.expectedInvocations), m.SaveFailuresMock.expectedInvocationsOrigin, afterSaveFailuresCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ImportJobRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockFailUnfinishedInspect()
+
+			m.MinimockListFailuresInspect()
+
+			m.MinimockLoadInspect()
+
+			m.MinimockSaveInspect()
+
+			m.MinimockSaveFailuresInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ImportJobRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ImportJobRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFailUnfinishedDone() &&
+		m.MinimockListFailuresDone() &&
+		m.MinimockLoadDone() &&
+		m.MinimockSaveDone() &&
+		m.MinimockSaveFailuresDone()
+}
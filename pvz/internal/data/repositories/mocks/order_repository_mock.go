@@ -18,9 +18,9 @@ type OrderRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcDelete          func(ctx context.Context, id uint64) (err error)
+	funcDelete          func(ctx context.Context, id uint64, version int64) (err error)
 	funcDeleteOrigin    string
-	inspectFuncDelete   func(ctx context.Context, id uint64)
+	inspectFuncDelete   func(ctx context.Context, id uint64, version int64)
 	afterDeleteCounter  uint64
 	beforeDeleteCounter uint64
 	DeleteMock          mOrderRepositoryMockDelete
@@ -39,12 +39,19 @@ type OrderRepositoryMock struct {
 	beforeLoadCounter uint64
 	LoadMock          mOrderRepositoryMockLoad
 
-	funcSave          func(ctx context.Context, order models.Order) (err error)
+	funcSave          func(ctx context.Context, order models.Order) (i1 int64, err error)
 	funcSaveOrigin    string
 	inspectFuncSave   func(ctx context.Context, order models.Order)
 	afterSaveCounter  uint64
 	beforeSaveCounter uint64
 	SaveMock          mOrderRepositoryMockSave
+
+	funcStream          func(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error) (err error)
+	funcStreamOrigin    string
+	inspectFuncStream   func(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error)
+	afterStreamCounter  uint64
+	beforeStreamCounter uint64
+	StreamMock          mOrderRepositoryMockStream
 }
 
 // NewOrderRepositoryMock returns a mock for mm_repositories.OrderRepository
@@ -67,6 +74,9 @@ func NewOrderRepositoryMock(t minimock.Tester) *OrderRepositoryMock {
 	m.SaveMock = mOrderRepositoryMockSave{mock: m}
 	m.SaveMock.callArgs = []*OrderRepositoryMockSaveParams{}
 
+	m.StreamMock = mOrderRepositoryMockStream{mock: m}
+	m.StreamMock.callArgs = []*OrderRepositoryMockStreamParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -98,14 +108,16 @@ type OrderRepositoryMockDeleteExpectation struct {
 
 // OrderRepositoryMockDeleteParams contains parameters of the OrderRepository.Delete
 type OrderRepositoryMockDeleteParams struct {
-	ctx context.Context
-	id  uint64
+	ctx     context.Context
+	id      uint64
+	version int64
 }
 
 // OrderRepositoryMockDeleteParamPtrs contains pointers to parameters of the OrderRepository.Delete
 type OrderRepositoryMockDeleteParamPtrs struct {
-	ctx *context.Context
-	id  *uint64
+	ctx     *context.Context
+	id      *uint64
+	version *int64
 }
 
 // OrderRepositoryMockDeleteResults contains results of the OrderRepository.Delete
@@ -115,9 +127,10 @@ type OrderRepositoryMockDeleteResults struct {
 
 // OrderRepositoryMockDeleteOrigins contains origins of expectations of the OrderRepository.Delete
 type OrderRepositoryMockDeleteExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+	origin        string
+	originCtx     string
+	originId      string
+	originVersion string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -131,7 +144,7 @@ func (mmDelete *mOrderRepositoryMockDelete) Optional() *mOrderRepositoryMockDele
 }
 
 // Expect sets up expected params for OrderRepository.Delete
-func (mmDelete *mOrderRepositoryMockDelete) Expect(ctx context.Context, id uint64) *mOrderRepositoryMockDelete {
+func (mmDelete *mOrderRepositoryMockDelete) Expect(ctx context.Context, id uint64, version int64) *mOrderRepositoryMockDelete {
 	if mmDelete.mock.funcDelete != nil {
 		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
 	}
@@ -144,7 +157,7 @@ func (mmDelete *mOrderRepositoryMockDelete) Expect(ctx context.Context, id uint6
 		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by ExpectParams functions")
 	}
 
-	mmDelete.defaultExpectation.params = &OrderRepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.params = &OrderRepositoryMockDeleteParams{ctx, id, version}
 	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmDelete.expectations {
 		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
@@ -201,8 +214,31 @@ func (mmDelete *mOrderRepositoryMockDelete) ExpectIdParam2(id uint64) *mOrderRep
 	return mmDelete
 }
 
+// ExpectVersionParam3 sets up expected param version for OrderRepository.Delete
+func (mmDelete *mOrderRepositoryMockDelete) ExpectVersionParam3(version int64) *mOrderRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &OrderRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &OrderRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.version = &version
+	mmDelete.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
 // Inspect accepts an inspector function that has same arguments as the OrderRepository.Delete
-func (mmDelete *mOrderRepositoryMockDelete) Inspect(f func(ctx context.Context, id uint64)) *mOrderRepositoryMockDelete {
+func (mmDelete *mOrderRepositoryMockDelete) Inspect(f func(ctx context.Context, id uint64, version int64)) *mOrderRepositoryMockDelete {
 	if mmDelete.mock.inspectFuncDelete != nil {
 		mmDelete.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.Delete")
 	}
@@ -227,7 +263,7 @@ func (mmDelete *mOrderRepositoryMockDelete) Return(err error) *OrderRepositoryMo
 }
 
 // Set uses given function f to mock the OrderRepository.Delete method
-func (mmDelete *mOrderRepositoryMockDelete) Set(f func(ctx context.Context, id uint64) (err error)) *OrderRepositoryMock {
+func (mmDelete *mOrderRepositoryMockDelete) Set(f func(ctx context.Context, id uint64, version int64) (err error)) *OrderRepositoryMock {
 	if mmDelete.defaultExpectation != nil {
 		mmDelete.mock.t.Fatalf("Default expectation is already set for the OrderRepository.Delete method")
 	}
@@ -243,14 +279,14 @@ func (mmDelete *mOrderRepositoryMockDelete) Set(f func(ctx context.Context, id u
 
 // When sets expectation for the OrderRepository.Delete which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mOrderRepositoryMockDelete) When(ctx context.Context, id uint64) *OrderRepositoryMockDeleteExpectation {
+func (mmDelete *mOrderRepositoryMockDelete) When(ctx context.Context, id uint64, version int64) *OrderRepositoryMockDeleteExpectation {
 	if mmDelete.mock.funcDelete != nil {
 		mmDelete.mock.t.Fatalf("OrderRepositoryMock.Delete mock is already set by Set")
 	}
 
 	expectation := &OrderRepositoryMockDeleteExpectation{
 		mock:               mmDelete.mock,
-		params:             &OrderRepositoryMockDeleteParams{ctx, id},
+		params:             &OrderRepositoryMockDeleteParams{ctx, id, version},
 		expectationOrigins: OrderRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmDelete.expectations = append(mmDelete.expectations, expectation)
@@ -285,17 +321,17 @@ func (mmDelete *mOrderRepositoryMockDelete) invocationsDone() bool {
 }
 
 // Delete implements mm_repositories.OrderRepository
-func (mmDelete *OrderRepositoryMock) Delete(ctx context.Context, id uint64) (err error) {
+func (mmDelete *OrderRepositoryMock) Delete(ctx context.Context, id uint64, version int64) (err error) {
 	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
 	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
 
 	mmDelete.t.Helper()
 
 	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, id)
+		mmDelete.inspectFuncDelete(ctx, id, version)
 	}
 
-	mm_params := OrderRepositoryMockDeleteParams{ctx, id}
+	mm_params := OrderRepositoryMockDeleteParams{ctx, id, version}
 
 	// Record call args
 	mmDelete.DeleteMock.mutex.Lock()
@@ -314,7 +350,7 @@ func (mmDelete *OrderRepositoryMock) Delete(ctx context.Context, id uint64) (err
 		mm_want := mmDelete.DeleteMock.defaultExpectation.params
 		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderRepositoryMockDeleteParams{ctx, id}
+		mm_got := OrderRepositoryMockDeleteParams{ctx, id, version}
 
 		if mm_want_ptrs != nil {
 
@@ -328,6 +364,11 @@ func (mmDelete *OrderRepositoryMock) Delete(ctx context.Context, id uint64) (err
 					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmDelete.t.Errorf("OrderRepositoryMock.Delete got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmDelete.t.Errorf("OrderRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -340,9 +381,9 @@ func (mmDelete *OrderRepositoryMock) Delete(ctx context.Context, id uint64) (err
 		return (*mm_results).err
 	}
 	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, id)
+		return mmDelete.funcDelete(ctx, id, version)
 	}
-	mmDelete.t.Fatalf("Unexpected call to OrderRepositoryMock.Delete. %v %v", ctx, id)
+	mmDelete.t.Fatalf("Unexpected call to OrderRepositoryMock.Delete. %v %v %v", ctx, id, version)
 	return
 }
 
@@ -1139,6 +1180,7 @@ type OrderRepositoryMockSaveParamPtrs struct {
 
 // OrderRepositoryMockSaveResults contains results of the OrderRepository.Save
 type OrderRepositoryMockSaveResults struct {
+	i1  int64
 	err error
 }
 
@@ -1242,7 +1284,7 @@ func (mmSave *mOrderRepositoryMockSave) Inspect(f func(ctx context.Context, orde
 }
 
 // Return sets up results that will be returned by OrderRepository.Save
-func (mmSave *mOrderRepositoryMockSave) Return(err error) *OrderRepositoryMock {
+func (mmSave *mOrderRepositoryMockSave) Return(i1 int64, err error) *OrderRepositoryMock {
 	if mmSave.mock.funcSave != nil {
 		mmSave.mock.t.Fatalf("OrderRepositoryMock.Save mock is already set by Set")
 	}
@@ -1250,13 +1292,13 @@ func (mmSave *mOrderRepositoryMockSave) Return(err error) *OrderRepositoryMock {
 	if mmSave.defaultExpectation == nil {
 		mmSave.defaultExpectation = &OrderRepositoryMockSaveExpectation{mock: mmSave.mock}
 	}
-	mmSave.defaultExpectation.results = &OrderRepositoryMockSaveResults{err}
+	mmSave.defaultExpectation.results = &OrderRepositoryMockSaveResults{i1, err}
 	mmSave.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmSave.mock
 }
 
 // Set uses given function f to mock the OrderRepository.Save method
-func (mmSave *mOrderRepositoryMockSave) Set(f func(ctx context.Context, order models.Order) (err error)) *OrderRepositoryMock {
+func (mmSave *mOrderRepositoryMockSave) Set(f func(ctx context.Context, order models.Order) (i1 int64, err error)) *OrderRepositoryMock {
 	if mmSave.defaultExpectation != nil {
 		mmSave.mock.t.Fatalf("Default expectation is already set for the OrderRepository.Save method")
 	}
@@ -1287,8 +1329,8 @@ func (mmSave *mOrderRepositoryMockSave) When(ctx context.Context, order models.O
 }
 
 // Then sets up OrderRepository.Save return parameters for the expectation previously defined by the When method
-func (e *OrderRepositoryMockSaveExpectation) Then(err error) *OrderRepositoryMock {
-	e.results = &OrderRepositoryMockSaveResults{err}
+func (e *OrderRepositoryMockSaveExpectation) Then(i1 int64, err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockSaveResults{i1, err}
 	return e.mock
 }
 
@@ -1314,7 +1356,7 @@ func (mmSave *mOrderRepositoryMockSave) invocationsDone() bool {
 }
 
 // Save implements mm_repositories.OrderRepository
-func (mmSave *OrderRepositoryMock) Save(ctx context.Context, order models.Order) (err error) {
+func (mmSave *OrderRepositoryMock) Save(ctx context.Context, order models.Order) (i1 int64, err error) {
 	mm_atomic.AddUint64(&mmSave.beforeSaveCounter, 1)
 	defer mm_atomic.AddUint64(&mmSave.afterSaveCounter, 1)
 
@@ -1334,7 +1376,7 @@ func (mmSave *OrderRepositoryMock) Save(ctx context.Context, order models.Order)
 	for _, e := range mmSave.SaveMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
@@ -1366,7 +1408,7 @@ func (mmSave *OrderRepositoryMock) Save(ctx context.Context, order models.Order)
 		if mm_results == nil {
 			mmSave.t.Fatal("No results are set for the OrderRepositoryMock.Save")
 		}
-		return (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
 	if mmSave.funcSave != nil {
 		return mmSave.funcSave(ctx, order)
@@ -1443,6 +1485,379 @@ func (m *OrderRepositoryMock) MinimockSaveInspect() {
 	}
 }
 
+type mOrderRepositoryMockStream struct {
+	optional           bool
+	mock               *OrderRepositoryMock
+	defaultExpectation *OrderRepositoryMockStreamExpectation
+	expectations       []*OrderRepositoryMockStreamExpectation
+
+	callArgs []*OrderRepositoryMockStreamParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderRepositoryMockStreamExpectation specifies expectation struct of the OrderRepository.Stream
+type OrderRepositoryMockStreamExpectation struct {
+	mock               *OrderRepositoryMock
+	params             *OrderRepositoryMockStreamParams
+	paramPtrs          *OrderRepositoryMockStreamParamPtrs
+	expectationOrigins OrderRepositoryMockStreamExpectationOrigins
+	results            *OrderRepositoryMockStreamResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderRepositoryMockStreamParams contains parameters of the OrderRepository.Stream
+type OrderRepositoryMockStreamParams struct {
+	ctx    context.Context
+	filter requests.OrdersFilterRequest
+	fn     func(models.Order) error
+}
+
+// OrderRepositoryMockStreamParamPtrs contains pointers to parameters of the OrderRepository.Stream
+type OrderRepositoryMockStreamParamPtrs struct {
+	ctx    *context.Context
+	filter *requests.OrdersFilterRequest
+	fn     *func(models.Order) error
+}
+
+// OrderRepositoryMockStreamResults contains results of the OrderRepository.Stream
+type OrderRepositoryMockStreamResults struct {
+	err error
+}
+
+// OrderRepositoryMockStreamOrigins contains origins of expectations of the OrderRepository.Stream
+type OrderRepositoryMockStreamExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+	originFn     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStream *mOrderRepositoryMockStream) Optional() *mOrderRepositoryMockStream {
+	mmStream.optional = true
+	return mmStream
+}
+
+// Expect sets up expected params for OrderRepository.Stream
+func (mmStream *mOrderRepositoryMockStream) Expect(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error) *mOrderRepositoryMockStream {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &OrderRepositoryMockStreamExpectation{}
+	}
+
+	if mmStream.defaultExpectation.paramPtrs != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by ExpectParams functions")
+	}
+
+	mmStream.defaultExpectation.params = &OrderRepositoryMockStreamParams{ctx, filter, fn}
+	mmStream.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStream.expectations {
+		if minimock.Equal(e.params, mmStream.defaultExpectation.params) {
+			mmStream.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStream.defaultExpectation.params)
+		}
+	}
+
+	return mmStream
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderRepository.Stream
+func (mmStream *mOrderRepositoryMockStream) ExpectCtxParam1(ctx context.Context) *mOrderRepositoryMockStream {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &OrderRepositoryMockStreamExpectation{}
+	}
+
+	if mmStream.defaultExpectation.params != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by Expect")
+	}
+
+	if mmStream.defaultExpectation.paramPtrs == nil {
+		mmStream.defaultExpectation.paramPtrs = &OrderRepositoryMockStreamParamPtrs{}
+	}
+	mmStream.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStream.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStream
+}
+
+// ExpectFilterParam2 sets up expected param filter for OrderRepository.Stream
+func (mmStream *mOrderRepositoryMockStream) ExpectFilterParam2(filter requests.OrdersFilterRequest) *mOrderRepositoryMockStream {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &OrderRepositoryMockStreamExpectation{}
+	}
+
+	if mmStream.defaultExpectation.params != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by Expect")
+	}
+
+	if mmStream.defaultExpectation.paramPtrs == nil {
+		mmStream.defaultExpectation.paramPtrs = &OrderRepositoryMockStreamParamPtrs{}
+	}
+	mmStream.defaultExpectation.paramPtrs.filter = &filter
+	mmStream.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmStream
+}
+
+// ExpectFnParam3 sets up expected param fn for OrderRepository.Stream
+func (mmStream *mOrderRepositoryMockStream) ExpectFnParam3(fn func(models.Order) error) *mOrderRepositoryMockStream {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &OrderRepositoryMockStreamExpectation{}
+	}
+
+	if mmStream.defaultExpectation.params != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by Expect")
+	}
+
+	if mmStream.defaultExpectation.paramPtrs == nil {
+		mmStream.defaultExpectation.paramPtrs = &OrderRepositoryMockStreamParamPtrs{}
+	}
+	mmStream.defaultExpectation.paramPtrs.fn = &fn
+	mmStream.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmStream
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderRepository.Stream
+func (mmStream *mOrderRepositoryMockStream) Inspect(f func(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error)) *mOrderRepositoryMockStream {
+	if mmStream.mock.inspectFuncStream != nil {
+		mmStream.mock.t.Fatalf("Inspect function is already set for OrderRepositoryMock.Stream")
+	}
+
+	mmStream.mock.inspectFuncStream = f
+
+	return mmStream
+}
+
+// Return sets up results that will be returned by OrderRepository.Stream
+func (mmStream *mOrderRepositoryMockStream) Return(err error) *OrderRepositoryMock {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by Set")
+	}
+
+	if mmStream.defaultExpectation == nil {
+		mmStream.defaultExpectation = &OrderRepositoryMockStreamExpectation{mock: mmStream.mock}
+	}
+	mmStream.defaultExpectation.results = &OrderRepositoryMockStreamResults{err}
+	mmStream.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStream.mock
+}
+
+// Set uses given function f to mock the OrderRepository.Stream method
+func (mmStream *mOrderRepositoryMockStream) Set(f func(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error) (err error)) *OrderRepositoryMock {
+	if mmStream.defaultExpectation != nil {
+		mmStream.mock.t.Fatalf("Default expectation is already set for the OrderRepository.Stream method")
+	}
+
+	if len(mmStream.expectations) > 0 {
+		mmStream.mock.t.Fatalf("Some expectations are already set for the OrderRepository.Stream method")
+	}
+
+	mmStream.mock.funcStream = f
+	mmStream.mock.funcStreamOrigin = minimock.CallerInfo(1)
+	return mmStream.mock
+}
+
+// When sets expectation for the OrderRepository.Stream which will trigger the result defined by the following
+// Then helper
+func (mmStream *mOrderRepositoryMockStream) When(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error) *OrderRepositoryMockStreamExpectation {
+	if mmStream.mock.funcStream != nil {
+		mmStream.mock.t.Fatalf("OrderRepositoryMock.Stream mock is already set by Set")
+	}
+
+	expectation := &OrderRepositoryMockStreamExpectation{
+		mock:               mmStream.mock,
+		params:             &OrderRepositoryMockStreamParams{ctx, filter, fn},
+		expectationOrigins: OrderRepositoryMockStreamExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStream.expectations = append(mmStream.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderRepository.Stream return parameters for the expectation previously defined by the When method
+func (e *OrderRepositoryMockStreamExpectation) Then(err error) *OrderRepositoryMock {
+	e.results = &OrderRepositoryMockStreamResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrderRepository.Stream should be invoked
+func (mmStream *mOrderRepositoryMockStream) Times(n uint64) *mOrderRepositoryMockStream {
+	if n == 0 {
+		mmStream.mock.t.Fatalf("Times of OrderRepositoryMock.Stream mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStream.expectedInvocations, n)
+	mmStream.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStream
+}
+
+func (mmStream *mOrderRepositoryMockStream) invocationsDone() bool {
+	if len(mmStream.expectations) == 0 && mmStream.defaultExpectation == nil && mmStream.mock.funcStream == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStream.mock.afterStreamCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStream.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Stream implements mm_repositories.OrderRepository
+func (mmStream *OrderRepositoryMock) Stream(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error) (err error) {
+	mm_atomic.AddUint64(&mmStream.beforeStreamCounter, 1)
+	defer mm_atomic.AddUint64(&mmStream.afterStreamCounter, 1)
+
+	mmStream.t.Helper()
+
+	if mmStream.inspectFuncStream != nil {
+		mmStream.inspectFuncStream(ctx, filter, fn)
+	}
+
+	mm_params := OrderRepositoryMockStreamParams{ctx, filter, fn}
+
+	// Record call args
+	mmStream.StreamMock.mutex.Lock()
+	mmStream.StreamMock.callArgs = append(mmStream.StreamMock.callArgs, &mm_params)
+	mmStream.StreamMock.mutex.Unlock()
+
+	for _, e := range mmStream.StreamMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmStream.StreamMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStream.StreamMock.defaultExpectation.Counter, 1)
+		mm_want := mmStream.StreamMock.defaultExpectation.params
+		mm_want_ptrs := mmStream.StreamMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderRepositoryMockStreamParams{ctx, filter, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStream.t.Errorf("OrderRepositoryMock.Stream got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStream.StreamMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmStream.t.Errorf("OrderRepositoryMock.Stream got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStream.StreamMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmStream.t.Errorf("OrderRepositoryMock.Stream got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStream.StreamMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStream.t.Errorf("OrderRepositoryMock.Stream got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStream.StreamMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStream.StreamMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStream.t.Fatal("No results are set for the OrderRepositoryMock.Stream")
+		}
+		return (*mm_results).err
+	}
+	if mmStream.funcStream != nil {
+		return mmStream.funcStream(ctx, filter, fn)
+	}
+	mmStream.t.Fatalf("Unexpected call to OrderRepositoryMock.Stream. %v %v %v", ctx, filter, fn)
+	return
+}
+
+// StreamAfterCounter returns a count of finished OrderRepositoryMock.Stream invocations
+func (mmStream *OrderRepositoryMock) StreamAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStream.afterStreamCounter)
+}
+
+// StreamBeforeCounter returns a count of OrderRepositoryMock.Stream invocations
+func (mmStream *OrderRepositoryMock) StreamBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStream.beforeStreamCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderRepositoryMock.Stream.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStream *mOrderRepositoryMockStream) Calls() []*OrderRepositoryMockStreamParams {
+	mmStream.mutex.RLock()
+
+	argCopy := make([]*OrderRepositoryMockStreamParams, len(mmStream.callArgs))
+	copy(argCopy, mmStream.callArgs)
+
+	mmStream.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStreamDone returns true if the count of the Stream invocations corresponds
+// the number of defined expectations
+func (m *OrderRepositoryMock) MinimockStreamDone() bool {
+	if m.StreamMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StreamMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StreamMock.invocationsDone()
+}
+
+// MinimockStreamInspect logs each unmet expectation
+func (m *OrderRepositoryMock) MinimockStreamInspect() {
+	for _, e := range m.StreamMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Stream at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStreamCounter := mm_atomic.LoadUint64(&m.afterStreamCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StreamMock.defaultExpectation != nil && afterStreamCounter < 1 {
+		if m.StreamMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Stream at\n%s", m.StreamMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderRepositoryMock.Stream at\n%s with params: %#v", m.StreamMock.defaultExpectation.expectationOrigins.origin, *m.StreamMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStream != nil && afterStreamCounter < 1 {
+		m.t.Errorf("Expected call to OrderRepositoryMock.Stream at\n%s", m.funcStreamOrigin)
+	}
+
+	if !m.StreamMock.invocationsDone() && afterStreamCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderRepositoryMock.Stream at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StreamMock.expectedInvocations), m.StreamMock.expectedInvocationsOrigin, afterStreamCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *OrderRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
@@ -1454,6 +1869,8 @@ func (m *OrderRepositoryMock) MinimockFinish() {
 			m.MinimockLoadInspect()
 
 			m.MinimockSaveInspect()
+
+			m.MinimockStreamInspect()
 		}
 	})
 }
@@ -1480,5 +1897,6 @@ func (m *OrderRepositoryMock) minimockDone() bool {
 		m.MinimockDeleteDone() &&
 		m.MinimockListDone() &&
 		m.MinimockLoadDone() &&
-		m.MinimockSaveDone()
+		m.MinimockSaveDone() &&
+		m.MinimockStreamDone()
 }
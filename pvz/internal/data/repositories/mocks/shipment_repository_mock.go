@@ -0,0 +1,1482 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ShipmentRepositoryMock implements mm_repositories.ShipmentRepository
+type ShipmentRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListHistory          func(ctx context.Context, shipmentID uint64) (sa1 []models.ShipmentHistoryEntry, err error)
+	funcListHistoryOrigin    string
+	inspectFuncListHistory   func(ctx context.Context, shipmentID uint64)
+	afterListHistoryCounter  uint64
+	beforeListHistoryCounter uint64
+	ListHistoryMock          mShipmentRepositoryMockListHistory
+
+	funcLoad          func(ctx context.Context, id uint64) (s1 models.Shipment, err error)
+	funcLoadOrigin    string
+	inspectFuncLoad   func(ctx context.Context, id uint64)
+	afterLoadCounter  uint64
+	beforeLoadCounter uint64
+	LoadMock          mShipmentRepositoryMockLoad
+
+	funcSave          func(ctx context.Context, s models.Shipment) (err error)
+	funcSaveOrigin    string
+	inspectFuncSave   func(ctx context.Context, s models.Shipment)
+	afterSaveCounter  uint64
+	beforeSaveCounter uint64
+	SaveMock          mShipmentRepositoryMockSave
+
+	funcSaveHistory          func(ctx context.Context, e models.ShipmentHistoryEntry) (err error)
+	funcSaveHistoryOrigin    string
+	inspectFuncSaveHistory   func(ctx context.Context, e models.ShipmentHistoryEntry)
+	afterSaveHistoryCounter  uint64
+	beforeSaveHistoryCounter uint64
+	SaveHistoryMock          mShipmentRepositoryMockSaveHistory
+}
+
+// NewShipmentRepositoryMock returns a mock for mm_repositories.ShipmentRepository
+func NewShipmentRepositoryMock(t minimock.Tester) *ShipmentRepositoryMock {
+	m := &ShipmentRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListHistoryMock = mShipmentRepositoryMockListHistory{mock: m}
+	m.ListHistoryMock.callArgs = []*ShipmentRepositoryMockListHistoryParams{}
+
+	m.LoadMock = mShipmentRepositoryMockLoad{mock: m}
+	m.LoadMock.callArgs = []*ShipmentRepositoryMockLoadParams{}
+
+	m.SaveMock = mShipmentRepositoryMockSave{mock: m}
+	m.SaveMock.callArgs = []*ShipmentRepositoryMockSaveParams{}
+
+	m.SaveHistoryMock = mShipmentRepositoryMockSaveHistory{mock: m}
+	m.SaveHistoryMock.callArgs = []*ShipmentRepositoryMockSaveHistoryParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mShipmentRepositoryMockListHistory struct {
+	optional           bool
+	mock               *ShipmentRepositoryMock
+	defaultExpectation *ShipmentRepositoryMockListHistoryExpectation
+	expectations       []*ShipmentRepositoryMockListHistoryExpectation
+
+	callArgs []*ShipmentRepositoryMockListHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ShipmentRepositoryMockListHistoryExpectation specifies expectation struct of the ShipmentRepository.ListHistory
+type ShipmentRepositoryMockListHistoryExpectation struct {
+	mock               *ShipmentRepositoryMock
+	params             *ShipmentRepositoryMockListHistoryParams
+	paramPtrs          *ShipmentRepositoryMockListHistoryParamPtrs
+	expectationOrigins ShipmentRepositoryMockListHistoryExpectationOrigins
+	results            *ShipmentRepositoryMockListHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ShipmentRepositoryMockListHistoryParams contains parameters of the ShipmentRepository.ListHistory
+type ShipmentRepositoryMockListHistoryParams struct {
+	ctx        context.Context
+	shipmentID uint64
+}
+
+// ShipmentRepositoryMockListHistoryParamPtrs contains pointers to parameters of the ShipmentRepository.ListHistory
+type ShipmentRepositoryMockListHistoryParamPtrs struct {
+	ctx        *context.Context
+	shipmentID *uint64
+}
+
+// ShipmentRepositoryMockListHistoryResults contains results of the ShipmentRepository.ListHistory
+type ShipmentRepositoryMockListHistoryResults struct {
+	sa1 []models.ShipmentHistoryEntry
+	err error
+}
+
+// ShipmentRepositoryMockListHistoryOrigins contains origins of expectations of the ShipmentRepository.ListHistory
+type ShipmentRepositoryMockListHistoryExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originShipmentID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListHistory *mShipmentRepositoryMockListHistory) Optional() *mShipmentRepositoryMockListHistory {
+	mmListHistory.optional = true
+	return mmListHistory
+}
+
+// Expect sets up expected params for ShipmentRepository.ListHistory
+func (mmListHistory *mShipmentRepositoryMockListHistory) Expect(ctx context.Context, shipmentID uint64) *mShipmentRepositoryMockListHistory {
+	if mmListHistory.mock.funcListHistory != nil {
+		mmListHistory.mock.t.Fatalf("ShipmentRepositoryMock.ListHistory mock is already set by Set")
+	}
+
+	if mmListHistory.defaultExpectation == nil {
+		mmListHistory.defaultExpectation = &ShipmentRepositoryMockListHistoryExpectation{}
+	}
+
+	if mmListHistory.defaultExpectation.paramPtrs != nil {
+		mmListHistory.mock.t.Fatalf("ShipmentRepositoryMock.ListHistory mock is already set by ExpectParams functions")
+	}
+
+	mmListHistory.defaultExpectation.params = &ShipmentRepositoryMockListHistoryParams{ctx, shipmentID}
+	mmListHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListHistory.expectations {
+		if minimock.Equal(e.params, mmListHistory.defaultExpectation.params) {
+			mmListHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmListHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ShipmentRepository.ListHistory
+func (mmListHistory *mShipmentRepositoryMockListHistory) ExpectCtxParam1(ctx context.Context) *mShipmentRepositoryMockListHistory {
+	if mmListHistory.mock.funcListHistory != nil {
+		mmListHistory.mock.t.Fatalf("ShipmentRepositoryMock.ListHistory mock is already set by Set")
+	}
+
+	if mmListHistory.defaultExpectation == nil {
+		mmListHistory.defaultExpectation = &ShipmentRepositoryMockListHistoryExpectation{}
+	}
+
+	if mmListHistory.defaultExpectation.params != nil {
+		mmListHistory.mock.t.Fatalf("ShipmentRepositoryMock.ListHistory mock is already set by Expect")
+	}
+
+	if mmListHistory.defaultExpectation.paramPtrs == nil {
+		mmListHistory.defaultExpectation.paramPtrs = &ShipmentRepositoryMockListHistoryParamPtrs{}
+	}
+	mmListHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListHistory
+}
+
+// ExpectShipmentIDParam2 sets up expected param shipmentID for ShipmentRepository.ListHistory
+func (mmListHistory *mShipmentRepositoryMockListHistory) ExpectShipmentIDParam2(shipmentID uint64) *mShipmentRepositoryMockListHistory {
+	if mmListHistory.mock.funcListHistory != nil {
+		mmListHistory.mock.t.Fatalf("ShipmentRepositoryMock.ListHistory mock is already set by Set")
+	}
+
+	if mmListHistory.defaultExpectation == nil {
+		mmListHistory.defaultExpectation = &ShipmentRepositoryMockListHistoryExpectation{}
+	}
+
+	if mmListHistory.defaultExpectation.params != nil {
+		mmListHistory.mock.t.Fatalf("ShipmentRepositoryMock.ListHistory mock is already set by Expect")
+	}
+
+	if mmListHistory.defaultExpectation.paramPtrs == nil {
+		mmListHistory.defaultExpectation.paramPtrs = &ShipmentRepositoryMockListHistoryParamPtrs{}
+	}
+	mmListHistory.defaultExpectation.paramPtrs.shipmentID = &shipmentID
+	mmListHistory.defaultExpectation.expectationOrigins.originShipmentID = minimock.CallerInfo(1)
+
+	return mmListHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentRepository.ListHistory
+func (mmListHistory *mShipmentRepositoryMockListHistory) Inspect(f func(ctx context.Context, shipmentID uint64)) *mShipmentRepositoryMockListHistory {
+	if mmListHistory.mock.inspectFuncListHistory != nil {
+		mmListHistory.mock.t.Fatalf("Inspect function is already set for ShipmentRepositoryMock.ListHistory")
+	}
+
+	mmListHistory.mock.inspectFuncListHistory = f
+
+	return mmListHistory
+}
+
+// Return sets up results that will be returned by ShipmentRepository.ListHistory
+func (mmListHistory *mShipmentRepositoryMockListHistory) Return(sa1 []models.ShipmentHistoryEntry, err error) *ShipmentRepositoryMock {
+	if mmListHistory.mock.funcListHistory != nil {
+		mmListHistory.mock.t.Fatalf("ShipmentRepositoryMock.ListHistory mock is already set by Set")
+	}
+
+	if mmListHistory.defaultExpectation == nil {
+		mmListHistory.defaultExpectation = &ShipmentRepositoryMockListHistoryExpectation{mock: mmListHistory.mock}
+	}
+	mmListHistory.defaultExpectation.results = &ShipmentRepositoryMockListHistoryResults{sa1, err}
+	mmListHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListHistory.mock
+}
+
+// Set uses given function f to mock the ShipmentRepository.ListHistory method
+func (mmListHistory *mShipmentRepositoryMockListHistory) Set(f func(ctx context.Context, shipmentID uint64) (sa1 []models.ShipmentHistoryEntry, err error)) *ShipmentRepositoryMock {
+	if mmListHistory.defaultExpectation != nil {
+		mmListHistory.mock.t.Fatalf("Default expectation is already set for the ShipmentRepository.ListHistory method")
+	}
+
+	if len(mmListHistory.expectations) > 0 {
+		mmListHistory.mock.t.Fatalf("Some expectations are already set for the ShipmentRepository.ListHistory method")
+	}
+
+	mmListHistory.mock.funcListHistory = f
+	mmListHistory.mock.funcListHistoryOrigin = minimock.CallerInfo(1)
+	return mmListHistory.mock
+}
+
+// When sets expectation for the ShipmentRepository.ListHistory which will trigger the result defined by the following
+// Then helper
+func (mmListHistory *mShipmentRepositoryMockListHistory) When(ctx context.Context, shipmentID uint64) *ShipmentRepositoryMockListHistoryExpectation {
+	if mmListHistory.mock.funcListHistory != nil {
+		mmListHistory.mock.t.Fatalf("ShipmentRepositoryMock.ListHistory mock is already set by Set")
+	}
+
+	expectation := &ShipmentRepositoryMockListHistoryExpectation{
+		mock:               mmListHistory.mock,
+		params:             &ShipmentRepositoryMockListHistoryParams{ctx, shipmentID},
+		expectationOrigins: ShipmentRepositoryMockListHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListHistory.expectations = append(mmListHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentRepository.ListHistory return parameters for the expectation previously defined by the When method
+func (e *ShipmentRepositoryMockListHistoryExpectation) Then(sa1 []models.ShipmentHistoryEntry, err error) *ShipmentRepositoryMock {
+	e.results = &ShipmentRepositoryMockListHistoryResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ShipmentRepository.ListHistory should be invoked
+func (mmListHistory *mShipmentRepositoryMockListHistory) Times(n uint64) *mShipmentRepositoryMockListHistory {
+	if n == 0 {
+		mmListHistory.mock.t.Fatalf("Times of ShipmentRepositoryMock.ListHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListHistory.expectedInvocations, n)
+	mmListHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListHistory
+}
+
+func (mmListHistory *mShipmentRepositoryMockListHistory) invocationsDone() bool {
+	if len(mmListHistory.expectations) == 0 && mmListHistory.defaultExpectation == nil && mmListHistory.mock.funcListHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListHistory.mock.afterListHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListHistory implements mm_repositories.ShipmentRepository
+func (mmListHistory *ShipmentRepositoryMock) ListHistory(ctx context.Context, shipmentID uint64) (sa1 []models.ShipmentHistoryEntry, err error) {
+	mm_atomic.AddUint64(&mmListHistory.beforeListHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmListHistory.afterListHistoryCounter, 1)
+
+	mmListHistory.t.Helper()
+
+	if mmListHistory.inspectFuncListHistory != nil {
+		mmListHistory.inspectFuncListHistory(ctx, shipmentID)
+	}
+
+	mm_params := ShipmentRepositoryMockListHistoryParams{ctx, shipmentID}
+
+	// Record call args
+	mmListHistory.ListHistoryMock.mutex.Lock()
+	mmListHistory.ListHistoryMock.callArgs = append(mmListHistory.ListHistoryMock.callArgs, &mm_params)
+	mmListHistory.ListHistoryMock.mutex.Unlock()
+
+	for _, e := range mmListHistory.ListHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListHistory.ListHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListHistory.ListHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmListHistory.ListHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmListHistory.ListHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := ShipmentRepositoryMockListHistoryParams{ctx, shipmentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListHistory.t.Errorf("ShipmentRepositoryMock.ListHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListHistory.ListHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.shipmentID != nil && !minimock.Equal(*mm_want_ptrs.shipmentID, mm_got.shipmentID) {
+				mmListHistory.t.Errorf("ShipmentRepositoryMock.ListHistory got unexpected parameter shipmentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListHistory.ListHistoryMock.defaultExpectation.expectationOrigins.originShipmentID, *mm_want_ptrs.shipmentID, mm_got.shipmentID, minimock.Diff(*mm_want_ptrs.shipmentID, mm_got.shipmentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListHistory.t.Errorf("ShipmentRepositoryMock.ListHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListHistory.ListHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListHistory.ListHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListHistory.t.Fatal("No results are set for the ShipmentRepositoryMock.ListHistory")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListHistory.funcListHistory != nil {
+		return mmListHistory.funcListHistory(ctx, shipmentID)
+	}
+	mmListHistory.t.Fatalf("Unexpected call to ShipmentRepositoryMock.ListHistory. %v %v", ctx, shipmentID)
+	return
+}
+
+// ListHistoryAfterCounter returns a count of finished ShipmentRepositoryMock.ListHistory invocations
+func (mmListHistory *ShipmentRepositoryMock) ListHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListHistory.afterListHistoryCounter)
+}
+
+// ListHistoryBeforeCounter returns a count of ShipmentRepositoryMock.ListHistory invocations
+func (mmListHistory *ShipmentRepositoryMock) ListHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListHistory.beforeListHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentRepositoryMock.ListHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListHistory *mShipmentRepositoryMockListHistory) Calls() []*ShipmentRepositoryMockListHistoryParams {
+	mmListHistory.mutex.RLock()
+
+	argCopy := make([]*ShipmentRepositoryMockListHistoryParams, len(mmListHistory.callArgs))
+	copy(argCopy, mmListHistory.callArgs)
+
+	mmListHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListHistoryDone returns true if the count of the ListHistory invocations corresponds
+// the number of defined expectations
+func (m *ShipmentRepositoryMock) MinimockListHistoryDone() bool {
+	if m.ListHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListHistoryMock.invocationsDone()
+}
+
+// MinimockListHistoryInspect logs each unmet expectation
+func (m *ShipmentRepositoryMock) MinimockListHistoryInspect() {
+	for _, e := range m.ListHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.ListHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListHistoryCounter := mm_atomic.LoadUint64(&m.afterListHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListHistoryMock.defaultExpectation != nil && afterListHistoryCounter < 1 {
+		if m.ListHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.ListHistory at\n%s", m.ListHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.ListHistory at\n%s with params: %#v", m.ListHistoryMock.defaultExpectation.expectationOrigins.origin, *m.ListHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListHistory != nil && afterListHistoryCounter < 1 {
+		m.t.Errorf("Expected call to ShipmentRepositoryMock.ListHistory at\n%s", m.funcListHistoryOrigin)
+	}
+
+	if !m.ListHistoryMock.invocationsDone() && afterListHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to ShipmentRepositoryMock.ListHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListHistoryMock.expectedInvocations), m.ListHistoryMock.expectedInvocationsOrigin, afterListHistoryCounter)
+	}
+}
+
+type mShipmentRepositoryMockLoad struct {
+	optional           bool
+	mock               *ShipmentRepositoryMock
+	defaultExpectation *ShipmentRepositoryMockLoadExpectation
+	expectations       []*ShipmentRepositoryMockLoadExpectation
+
+	callArgs []*ShipmentRepositoryMockLoadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ShipmentRepositoryMockLoadExpectation specifies expectation struct of the ShipmentRepository.Load
+type ShipmentRepositoryMockLoadExpectation struct {
+	mock               *ShipmentRepositoryMock
+	params             *ShipmentRepositoryMockLoadParams
+	paramPtrs          *ShipmentRepositoryMockLoadParamPtrs
+	expectationOrigins ShipmentRepositoryMockLoadExpectationOrigins
+	results            *ShipmentRepositoryMockLoadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ShipmentRepositoryMockLoadParams contains parameters of the ShipmentRepository.Load
+type ShipmentRepositoryMockLoadParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// ShipmentRepositoryMockLoadParamPtrs contains pointers to parameters of the ShipmentRepository.Load
+type ShipmentRepositoryMockLoadParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// ShipmentRepositoryMockLoadResults contains results of the ShipmentRepository.Load
+type ShipmentRepositoryMockLoadResults struct {
+	s1  models.Shipment
+	err error
+}
+
+// ShipmentRepositoryMockLoadOrigins contains origins of expectations of the ShipmentRepository.Load
+type ShipmentRepositoryMockLoadExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLoad *mShipmentRepositoryMockLoad) Optional() *mShipmentRepositoryMockLoad {
+	mmLoad.optional = true
+	return mmLoad
+}
+
+// Expect sets up expected params for ShipmentRepository.Load
+func (mmLoad *mShipmentRepositoryMockLoad) Expect(ctx context.Context, id uint64) *mShipmentRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ShipmentRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &ShipmentRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs != nil {
+		mmLoad.mock.t.Fatalf("ShipmentRepositoryMock.Load mock is already set by ExpectParams functions")
+	}
+
+	mmLoad.defaultExpectation.params = &ShipmentRepositoryMockLoadParams{ctx, id}
+	mmLoad.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLoad.expectations {
+		if minimock.Equal(e.params, mmLoad.defaultExpectation.params) {
+			mmLoad.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLoad.defaultExpectation.params)
+		}
+	}
+
+	return mmLoad
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ShipmentRepository.Load
+func (mmLoad *mShipmentRepositoryMockLoad) ExpectCtxParam1(ctx context.Context) *mShipmentRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ShipmentRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &ShipmentRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.params != nil {
+		mmLoad.mock.t.Fatalf("ShipmentRepositoryMock.Load mock is already set by Expect")
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs == nil {
+		mmLoad.defaultExpectation.paramPtrs = &ShipmentRepositoryMockLoadParamPtrs{}
+	}
+	mmLoad.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLoad.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLoad
+}
+
+// ExpectIdParam2 sets up expected param id for ShipmentRepository.Load
+func (mmLoad *mShipmentRepositoryMockLoad) ExpectIdParam2(id uint64) *mShipmentRepositoryMockLoad {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ShipmentRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &ShipmentRepositoryMockLoadExpectation{}
+	}
+
+	if mmLoad.defaultExpectation.params != nil {
+		mmLoad.mock.t.Fatalf("ShipmentRepositoryMock.Load mock is already set by Expect")
+	}
+
+	if mmLoad.defaultExpectation.paramPtrs == nil {
+		mmLoad.defaultExpectation.paramPtrs = &ShipmentRepositoryMockLoadParamPtrs{}
+	}
+	mmLoad.defaultExpectation.paramPtrs.id = &id
+	mmLoad.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmLoad
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentRepository.Load
+func (mmLoad *mShipmentRepositoryMockLoad) Inspect(f func(ctx context.Context, id uint64)) *mShipmentRepositoryMockLoad {
+	if mmLoad.mock.inspectFuncLoad != nil {
+		mmLoad.mock.t.Fatalf("Inspect function is already set for ShipmentRepositoryMock.Load")
+	}
+
+	mmLoad.mock.inspectFuncLoad = f
+
+	return mmLoad
+}
+
+// Return sets up results that will be returned by ShipmentRepository.Load
+func (mmLoad *mShipmentRepositoryMockLoad) Return(s1 models.Shipment, err error) *ShipmentRepositoryMock {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ShipmentRepositoryMock.Load mock is already set by Set")
+	}
+
+	if mmLoad.defaultExpectation == nil {
+		mmLoad.defaultExpectation = &ShipmentRepositoryMockLoadExpectation{mock: mmLoad.mock}
+	}
+	mmLoad.defaultExpectation.results = &ShipmentRepositoryMockLoadResults{s1, err}
+	mmLoad.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLoad.mock
+}
+
+// Set uses given function f to mock the ShipmentRepository.Load method
+func (mmLoad *mShipmentRepositoryMockLoad) Set(f func(ctx context.Context, id uint64) (s1 models.Shipment, err error)) *ShipmentRepositoryMock {
+	if mmLoad.defaultExpectation != nil {
+		mmLoad.mock.t.Fatalf("Default expectation is already set for the ShipmentRepository.Load method")
+	}
+
+	if len(mmLoad.expectations) > 0 {
+		mmLoad.mock.t.Fatalf("Some expectations are already set for the ShipmentRepository.Load method")
+	}
+
+	mmLoad.mock.funcLoad = f
+	mmLoad.mock.funcLoadOrigin = minimock.CallerInfo(1)
+	return mmLoad.mock
+}
+
+// When sets expectation for the ShipmentRepository.Load which will trigger the result defined by the following
+// Then helper
+func (mmLoad *mShipmentRepositoryMockLoad) When(ctx context.Context, id uint64) *ShipmentRepositoryMockLoadExpectation {
+	if mmLoad.mock.funcLoad != nil {
+		mmLoad.mock.t.Fatalf("ShipmentRepositoryMock.Load mock is already set by Set")
+	}
+
+	expectation := &ShipmentRepositoryMockLoadExpectation{
+		mock:               mmLoad.mock,
+		params:             &ShipmentRepositoryMockLoadParams{ctx, id},
+		expectationOrigins: ShipmentRepositoryMockLoadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLoad.expectations = append(mmLoad.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentRepository.Load return parameters for the expectation previously defined by the When method
+func (e *ShipmentRepositoryMockLoadExpectation) Then(s1 models.Shipment, err error) *ShipmentRepositoryMock {
+	e.results = &ShipmentRepositoryMockLoadResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times ShipmentRepository.Load should be invoked
+func (mmLoad *mShipmentRepositoryMockLoad) Times(n uint64) *mShipmentRepositoryMockLoad {
+	if n == 0 {
+		mmLoad.mock.t.Fatalf("Times of ShipmentRepositoryMock.Load mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLoad.expectedInvocations, n)
+	mmLoad.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLoad
+}
+
+func (mmLoad *mShipmentRepositoryMockLoad) invocationsDone() bool {
+	if len(mmLoad.expectations) == 0 && mmLoad.defaultExpectation == nil && mmLoad.mock.funcLoad == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLoad.mock.afterLoadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLoad.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Load implements mm_repositories.ShipmentRepository
+func (mmLoad *ShipmentRepositoryMock) Load(ctx context.Context, id uint64) (s1 models.Shipment, err error) {
+	mm_atomic.AddUint64(&mmLoad.beforeLoadCounter, 1)
+	defer mm_atomic.AddUint64(&mmLoad.afterLoadCounter, 1)
+
+	mmLoad.t.Helper()
+
+	if mmLoad.inspectFuncLoad != nil {
+		mmLoad.inspectFuncLoad(ctx, id)
+	}
+
+	mm_params := ShipmentRepositoryMockLoadParams{ctx, id}
+
+	// Record call args
+	mmLoad.LoadMock.mutex.Lock()
+	mmLoad.LoadMock.callArgs = append(mmLoad.LoadMock.callArgs, &mm_params)
+	mmLoad.LoadMock.mutex.Unlock()
+
+	for _, e := range mmLoad.LoadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmLoad.LoadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLoad.LoadMock.defaultExpectation.Counter, 1)
+		mm_want := mmLoad.LoadMock.defaultExpectation.params
+		mm_want_ptrs := mmLoad.LoadMock.defaultExpectation.paramPtrs
+
+		mm_got := ShipmentRepositoryMockLoadParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLoad.t.Errorf("ShipmentRepositoryMock.Load got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoad.LoadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmLoad.t.Errorf("ShipmentRepositoryMock.Load got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoad.LoadMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLoad.t.Errorf("ShipmentRepositoryMock.Load got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLoad.LoadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLoad.LoadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLoad.t.Fatal("No results are set for the ShipmentRepositoryMock.Load")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmLoad.funcLoad != nil {
+		return mmLoad.funcLoad(ctx, id)
+	}
+	mmLoad.t.Fatalf("Unexpected call to ShipmentRepositoryMock.Load. %v %v", ctx, id)
+	return
+}
+
+// LoadAfterCounter returns a count of finished ShipmentRepositoryMock.Load invocations
+func (mmLoad *ShipmentRepositoryMock) LoadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoad.afterLoadCounter)
+}
+
+// LoadBeforeCounter returns a count of ShipmentRepositoryMock.Load invocations
+func (mmLoad *ShipmentRepositoryMock) LoadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoad.beforeLoadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentRepositoryMock.Load.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLoad *mShipmentRepositoryMockLoad) Calls() []*ShipmentRepositoryMockLoadParams {
+	mmLoad.mutex.RLock()
+
+	argCopy := make([]*ShipmentRepositoryMockLoadParams, len(mmLoad.callArgs))
+	copy(argCopy, mmLoad.callArgs)
+
+	mmLoad.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoadDone returns true if the count of the Load invocations corresponds
+// the number of defined expectations
+func (m *ShipmentRepositoryMock) MinimockLoadDone() bool {
+	if m.LoadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoadMock.invocationsDone()
+}
+
+// MinimockLoadInspect logs each unmet expectation
+func (m *ShipmentRepositoryMock) MinimockLoadInspect() {
+	for _, e := range m.LoadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.Load at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoadCounter := mm_atomic.LoadUint64(&m.afterLoadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoadMock.defaultExpectation != nil && afterLoadCounter < 1 {
+		if m.LoadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.Load at\n%s", m.LoadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.Load at\n%s with params: %#v", m.LoadMock.defaultExpectation.expectationOrigins.origin, *m.LoadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLoad != nil && afterLoadCounter < 1 {
+		m.t.Errorf("Expected call to ShipmentRepositoryMock.Load at\n%s", m.funcLoadOrigin)
+	}
+
+	if !m.LoadMock.invocationsDone() && afterLoadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ShipmentRepositoryMock.Load at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoadMock.expectedInvocations), m.LoadMock.expectedInvocationsOrigin, afterLoadCounter)
+	}
+}
+
+type mShipmentRepositoryMockSave struct {
+	optional           bool
+	mock               *ShipmentRepositoryMock
+	defaultExpectation *ShipmentRepositoryMockSaveExpectation
+	expectations       []*ShipmentRepositoryMockSaveExpectation
+
+	callArgs []*ShipmentRepositoryMockSaveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ShipmentRepositoryMockSaveExpectation specifies expectation struct of the ShipmentRepository.Save
+type ShipmentRepositoryMockSaveExpectation struct {
+	mock               *ShipmentRepositoryMock
+	params             *ShipmentRepositoryMockSaveParams
+	paramPtrs          *ShipmentRepositoryMockSaveParamPtrs
+	expectationOrigins ShipmentRepositoryMockSaveExpectationOrigins
+	results            *ShipmentRepositoryMockSaveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ShipmentRepositoryMockSaveParams contains parameters of the ShipmentRepository.Save
+type ShipmentRepositoryMockSaveParams struct {
+	ctx context.Context
+	s   models.Shipment
+}
+
+// ShipmentRepositoryMockSaveParamPtrs contains pointers to parameters of the ShipmentRepository.Save
+type ShipmentRepositoryMockSaveParamPtrs struct {
+	ctx *context.Context
+	s   *models.Shipment
+}
+
+// ShipmentRepositoryMockSaveResults contains results of the ShipmentRepository.Save
+type ShipmentRepositoryMockSaveResults struct {
+	err error
+}
+
+// ShipmentRepositoryMockSaveOrigins contains origins of expectations of the ShipmentRepository.Save
+type ShipmentRepositoryMockSaveExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originS   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSave *mShipmentRepositoryMockSave) Optional() *mShipmentRepositoryMockSave {
+	mmSave.optional = true
+	return mmSave
+}
+
+// Expect sets up expected params for ShipmentRepository.Save
+func (mmSave *mShipmentRepositoryMockSave) Expect(ctx context.Context, s models.Shipment) *mShipmentRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ShipmentRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &ShipmentRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.paramPtrs != nil {
+		mmSave.mock.t.Fatalf("ShipmentRepositoryMock.Save mock is already set by ExpectParams functions")
+	}
+
+	mmSave.defaultExpectation.params = &ShipmentRepositoryMockSaveParams{ctx, s}
+	mmSave.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSave.expectations {
+		if minimock.Equal(e.params, mmSave.defaultExpectation.params) {
+			mmSave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSave.defaultExpectation.params)
+		}
+	}
+
+	return mmSave
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ShipmentRepository.Save
+func (mmSave *mShipmentRepositoryMockSave) ExpectCtxParam1(ctx context.Context) *mShipmentRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ShipmentRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &ShipmentRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("ShipmentRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &ShipmentRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSave.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// ExpectSParam2 sets up expected param s for ShipmentRepository.Save
+func (mmSave *mShipmentRepositoryMockSave) ExpectSParam2(s models.Shipment) *mShipmentRepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ShipmentRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &ShipmentRepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("ShipmentRepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &ShipmentRepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.s = &s
+	mmSave.defaultExpectation.expectationOrigins.originS = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentRepository.Save
+func (mmSave *mShipmentRepositoryMockSave) Inspect(f func(ctx context.Context, s models.Shipment)) *mShipmentRepositoryMockSave {
+	if mmSave.mock.inspectFuncSave != nil {
+		mmSave.mock.t.Fatalf("Inspect function is already set for ShipmentRepositoryMock.Save")
+	}
+
+	mmSave.mock.inspectFuncSave = f
+
+	return mmSave
+}
+
+// Return sets up results that will be returned by ShipmentRepository.Save
+func (mmSave *mShipmentRepositoryMockSave) Return(err error) *ShipmentRepositoryMock {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ShipmentRepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &ShipmentRepositoryMockSaveExpectation{mock: mmSave.mock}
+	}
+	mmSave.defaultExpectation.results = &ShipmentRepositoryMockSaveResults{err}
+	mmSave.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// Set uses given function f to mock the ShipmentRepository.Save method
+func (mmSave *mShipmentRepositoryMockSave) Set(f func(ctx context.Context, s models.Shipment) (err error)) *ShipmentRepositoryMock {
+	if mmSave.defaultExpectation != nil {
+		mmSave.mock.t.Fatalf("Default expectation is already set for the ShipmentRepository.Save method")
+	}
+
+	if len(mmSave.expectations) > 0 {
+		mmSave.mock.t.Fatalf("Some expectations are already set for the ShipmentRepository.Save method")
+	}
+
+	mmSave.mock.funcSave = f
+	mmSave.mock.funcSaveOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// When sets expectation for the ShipmentRepository.Save which will trigger the result defined by the following
+// Then helper
+func (mmSave *mShipmentRepositoryMockSave) When(ctx context.Context, s models.Shipment) *ShipmentRepositoryMockSaveExpectation {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("ShipmentRepositoryMock.Save mock is already set by Set")
+	}
+
+	expectation := &ShipmentRepositoryMockSaveExpectation{
+		mock:               mmSave.mock,
+		params:             &ShipmentRepositoryMockSaveParams{ctx, s},
+		expectationOrigins: ShipmentRepositoryMockSaveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSave.expectations = append(mmSave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentRepository.Save return parameters for the expectation previously defined by the When method
+func (e *ShipmentRepositoryMockSaveExpectation) Then(err error) *ShipmentRepositoryMock {
+	e.results = &ShipmentRepositoryMockSaveResults{err}
+	return e.mock
+}
+
+// Times sets number of times ShipmentRepository.Save should be invoked
+func (mmSave *mShipmentRepositoryMockSave) Times(n uint64) *mShipmentRepositoryMockSave {
+	if n == 0 {
+		mmSave.mock.t.Fatalf("Times of ShipmentRepositoryMock.Save mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSave.expectedInvocations, n)
+	mmSave.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSave
+}
+
+func (mmSave *mShipmentRepositoryMockSave) invocationsDone() bool {
+	if len(mmSave.expectations) == 0 && mmSave.defaultExpectation == nil && mmSave.mock.funcSave == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSave.mock.afterSaveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSave.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Save implements mm_repositories.ShipmentRepository
+func (mmSave *ShipmentRepositoryMock) Save(ctx context.Context, s models.Shipment) (err error) {
+	mm_atomic.AddUint64(&mmSave.beforeSaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmSave.afterSaveCounter, 1)
+
+	mmSave.t.Helper()
+
+	if mmSave.inspectFuncSave != nil {
+		mmSave.inspectFuncSave(ctx, s)
+	}
+
+	mm_params := ShipmentRepositoryMockSaveParams{ctx, s}
+
+	// Record call args
+	mmSave.SaveMock.mutex.Lock()
+	mmSave.SaveMock.callArgs = append(mmSave.SaveMock.callArgs, &mm_params)
+	mmSave.SaveMock.mutex.Unlock()
+
+	for _, e := range mmSave.SaveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSave.SaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSave.SaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmSave.SaveMock.defaultExpectation.params
+		mm_want_ptrs := mmSave.SaveMock.defaultExpectation.paramPtrs
+
+		mm_got := ShipmentRepositoryMockSaveParams{ctx, s}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSave.t.Errorf("ShipmentRepositoryMock.Save got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.s != nil && !minimock.Equal(*mm_want_ptrs.s, mm_got.s) {
+				mmSave.t.Errorf("ShipmentRepositoryMock.Save got unexpected parameter s, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originS, *mm_want_ptrs.s, mm_got.s, minimock.Diff(*mm_want_ptrs.s, mm_got.s))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSave.t.Errorf("ShipmentRepositoryMock.Save got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSave.SaveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSave.SaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSave.t.Fatal("No results are set for the ShipmentRepositoryMock.Save")
+		}
+		return (*mm_results).err
+	}
+	if mmSave.funcSave != nil {
+		return mmSave.funcSave(ctx, s)
+	}
+	mmSave.t.Fatalf("Unexpected call to ShipmentRepositoryMock.Save. %v %v", ctx, s)
+	return
+}
+
+// SaveAfterCounter returns a count of finished ShipmentRepositoryMock.Save invocations
+func (mmSave *ShipmentRepositoryMock) SaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.afterSaveCounter)
+}
+
+// SaveBeforeCounter returns a count of ShipmentRepositoryMock.Save invocations
+func (mmSave *ShipmentRepositoryMock) SaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.beforeSaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentRepositoryMock.Save.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSave *mShipmentRepositoryMockSave) Calls() []*ShipmentRepositoryMockSaveParams {
+	mmSave.mutex.RLock()
+
+	argCopy := make([]*ShipmentRepositoryMockSaveParams, len(mmSave.callArgs))
+	copy(argCopy, mmSave.callArgs)
+
+	mmSave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveDone returns true if the count of the Save invocations corresponds
+// the number of defined expectations
+func (m *ShipmentRepositoryMock) MinimockSaveDone() bool {
+	if m.SaveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveMock.invocationsDone()
+}
+
+// MinimockSaveInspect logs each unmet expectation
+func (m *ShipmentRepositoryMock) MinimockSaveInspect() {
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.Save at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveCounter := mm_atomic.LoadUint64(&m.afterSaveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveMock.defaultExpectation != nil && afterSaveCounter < 1 {
+		if m.SaveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.Save at\n%s", m.SaveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.Save at\n%s with params: %#v", m.SaveMock.defaultExpectation.expectationOrigins.origin, *m.SaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSave != nil && afterSaveCounter < 1 {
+		m.t.Errorf("Expected call to ShipmentRepositoryMock.Save at\n%s", m.funcSaveOrigin)
+	}
+
+	if !m.SaveMock.invocationsDone() && afterSaveCounter > 0 {
+		m.t.Errorf("Expected %d calls to ShipmentRepositoryMock.Save at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveMock.expectedInvocations), m.SaveMock.expectedInvocationsOrigin, afterSaveCounter)
+	}
+}
+
+type mShipmentRepositoryMockSaveHistory struct {
+	optional           bool
+	mock               *ShipmentRepositoryMock
+	defaultExpectation *ShipmentRepositoryMockSaveHistoryExpectation
+	expectations       []*ShipmentRepositoryMockSaveHistoryExpectation
+
+	callArgs []*ShipmentRepositoryMockSaveHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ShipmentRepositoryMockSaveHistoryExpectation specifies expectation struct of the ShipmentRepository.SaveHistory
+type ShipmentRepositoryMockSaveHistoryExpectation struct {
+	mock               *ShipmentRepositoryMock
+	params             *ShipmentRepositoryMockSaveHistoryParams
+	paramPtrs          *ShipmentRepositoryMockSaveHistoryParamPtrs
+	expectationOrigins ShipmentRepositoryMockSaveHistoryExpectationOrigins
+	results            *ShipmentRepositoryMockSaveHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ShipmentRepositoryMockSaveHistoryParams contains parameters of the ShipmentRepository.SaveHistory
+type ShipmentRepositoryMockSaveHistoryParams struct {
+	ctx context.Context
+	e   models.ShipmentHistoryEntry
+}
+
+// ShipmentRepositoryMockSaveHistoryParamPtrs contains pointers to parameters of the ShipmentRepository.SaveHistory
+type ShipmentRepositoryMockSaveHistoryParamPtrs struct {
+	ctx *context.Context
+	e   *models.ShipmentHistoryEntry
+}
+
+// ShipmentRepositoryMockSaveHistoryResults contains results of the ShipmentRepository.SaveHistory
+type ShipmentRepositoryMockSaveHistoryResults struct {
+	err error
+}
+
+// ShipmentRepositoryMockSaveHistoryOrigins contains origins of expectations of the ShipmentRepository.SaveHistory
+type ShipmentRepositoryMockSaveHistoryExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originE   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) Optional() *mShipmentRepositoryMockSaveHistory {
+	mmSaveHistory.optional = true
+	return mmSaveHistory
+}
+
+// Expect sets up expected params for ShipmentRepository.SaveHistory
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) Expect(ctx context.Context, e models.ShipmentHistoryEntry) *mShipmentRepositoryMockSaveHistory {
+	if mmSaveHistory.mock.funcSaveHistory != nil {
+		mmSaveHistory.mock.t.Fatalf("ShipmentRepositoryMock.SaveHistory mock is already set by Set")
+	}
+
+	if mmSaveHistory.defaultExpectation == nil {
+		mmSaveHistory.defaultExpectation = &ShipmentRepositoryMockSaveHistoryExpectation{}
+	}
+
+	if mmSaveHistory.defaultExpectation.paramPtrs != nil {
+		mmSaveHistory.mock.t.Fatalf("ShipmentRepositoryMock.SaveHistory mock is already set by ExpectParams functions")
+	}
+
+	mmSaveHistory.defaultExpectation.params = &ShipmentRepositoryMockSaveHistoryParams{ctx, e}
+	mmSaveHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveHistory.expectations {
+		if minimock.Equal(e.params, mmSaveHistory.defaultExpectation.params) {
+			mmSaveHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ShipmentRepository.SaveHistory
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) ExpectCtxParam1(ctx context.Context) *mShipmentRepositoryMockSaveHistory {
+	if mmSaveHistory.mock.funcSaveHistory != nil {
+		mmSaveHistory.mock.t.Fatalf("ShipmentRepositoryMock.SaveHistory mock is already set by Set")
+	}
+
+	if mmSaveHistory.defaultExpectation == nil {
+		mmSaveHistory.defaultExpectation = &ShipmentRepositoryMockSaveHistoryExpectation{}
+	}
+
+	if mmSaveHistory.defaultExpectation.params != nil {
+		mmSaveHistory.mock.t.Fatalf("ShipmentRepositoryMock.SaveHistory mock is already set by Expect")
+	}
+
+	if mmSaveHistory.defaultExpectation.paramPtrs == nil {
+		mmSaveHistory.defaultExpectation.paramPtrs = &ShipmentRepositoryMockSaveHistoryParamPtrs{}
+	}
+	mmSaveHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveHistory
+}
+
+// ExpectEParam2 sets up expected param e for ShipmentRepository.SaveHistory
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) ExpectEParam2(e models.ShipmentHistoryEntry) *mShipmentRepositoryMockSaveHistory {
+	if mmSaveHistory.mock.funcSaveHistory != nil {
+		mmSaveHistory.mock.t.Fatalf("ShipmentRepositoryMock.SaveHistory mock is already set by Set")
+	}
+
+	if mmSaveHistory.defaultExpectation == nil {
+		mmSaveHistory.defaultExpectation = &ShipmentRepositoryMockSaveHistoryExpectation{}
+	}
+
+	if mmSaveHistory.defaultExpectation.params != nil {
+		mmSaveHistory.mock.t.Fatalf("ShipmentRepositoryMock.SaveHistory mock is already set by Expect")
+	}
+
+	if mmSaveHistory.defaultExpectation.paramPtrs == nil {
+		mmSaveHistory.defaultExpectation.paramPtrs = &ShipmentRepositoryMockSaveHistoryParamPtrs{}
+	}
+	mmSaveHistory.defaultExpectation.paramPtrs.e = &e
+	mmSaveHistory.defaultExpectation.expectationOrigins.originE = minimock.CallerInfo(1)
+
+	return mmSaveHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentRepository.SaveHistory
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) Inspect(f func(ctx context.Context, e models.ShipmentHistoryEntry)) *mShipmentRepositoryMockSaveHistory {
+	if mmSaveHistory.mock.inspectFuncSaveHistory != nil {
+		mmSaveHistory.mock.t.Fatalf("Inspect function is already set for ShipmentRepositoryMock.SaveHistory")
+	}
+
+	mmSaveHistory.mock.inspectFuncSaveHistory = f
+
+	return mmSaveHistory
+}
+
+// Return sets up results that will be returned by ShipmentRepository.SaveHistory
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) Return(err error) *ShipmentRepositoryMock {
+	if mmSaveHistory.mock.funcSaveHistory != nil {
+		mmSaveHistory.mock.t.Fatalf("ShipmentRepositoryMock.SaveHistory mock is already set by Set")
+	}
+
+	if mmSaveHistory.defaultExpectation == nil {
+		mmSaveHistory.defaultExpectation = &ShipmentRepositoryMockSaveHistoryExpectation{mock: mmSaveHistory.mock}
+	}
+	mmSaveHistory.defaultExpectation.results = &ShipmentRepositoryMockSaveHistoryResults{err}
+	mmSaveHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveHistory.mock
+}
+
+// Set uses given function f to mock the ShipmentRepository.SaveHistory method
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) Set(f func(ctx context.Context, e models.ShipmentHistoryEntry) (err error)) *ShipmentRepositoryMock {
+	if mmSaveHistory.defaultExpectation != nil {
+		mmSaveHistory.mock.t.Fatalf("Default expectation is already set for the ShipmentRepository.SaveHistory method")
+	}
+
+	if len(mmSaveHistory.expectations) > 0 {
+		mmSaveHistory.mock.t.Fatalf("Some expectations are already set for the ShipmentRepository.SaveHistory method")
+	}
+
+	mmSaveHistory.mock.funcSaveHistory = f
+	mmSaveHistory.mock.funcSaveHistoryOrigin = minimock.CallerInfo(1)
+	return mmSaveHistory.mock
+}
+
+// When sets expectation for the ShipmentRepository.SaveHistory which will trigger the result defined by the following
+// Then helper
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) When(ctx context.Context, e models.ShipmentHistoryEntry) *ShipmentRepositoryMockSaveHistoryExpectation {
+	if mmSaveHistory.mock.funcSaveHistory != nil {
+		mmSaveHistory.mock.t.Fatalf("ShipmentRepositoryMock.SaveHistory mock is already set by Set")
+	}
+
+	expectation := &ShipmentRepositoryMockSaveHistoryExpectation{
+		mock:               mmSaveHistory.mock,
+		params:             &ShipmentRepositoryMockSaveHistoryParams{ctx, e},
+		expectationOrigins: ShipmentRepositoryMockSaveHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveHistory.expectations = append(mmSaveHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentRepository.SaveHistory return parameters for the expectation previously defined by the When method
+func (e *ShipmentRepositoryMockSaveHistoryExpectation) Then(err error) *ShipmentRepositoryMock {
+	e.results = &ShipmentRepositoryMockSaveHistoryResults{err}
+	return e.mock
+}
+
+// Times sets number of times ShipmentRepository.SaveHistory should be invoked
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) Times(n uint64) *mShipmentRepositoryMockSaveHistory {
+	if n == 0 {
+		mmSaveHistory.mock.t.Fatalf("Times of ShipmentRepositoryMock.SaveHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveHistory.expectedInvocations, n)
+	mmSaveHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveHistory
+}
+
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) invocationsDone() bool {
+	if len(mmSaveHistory.expectations) == 0 && mmSaveHistory.defaultExpectation == nil && mmSaveHistory.mock.funcSaveHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveHistory.mock.afterSaveHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveHistory implements mm_repositories.ShipmentRepository
+func (mmSaveHistory *ShipmentRepositoryMock) SaveHistory(ctx context.Context, e models.ShipmentHistoryEntry) (err error) {
+	mm_atomic.AddUint64(&mmSaveHistory.beforeSaveHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveHistory.afterSaveHistoryCounter, 1)
+
+	mmSaveHistory.t.Helper()
+
+	if mmSaveHistory.inspectFuncSaveHistory != nil {
+		mmSaveHistory.inspectFuncSaveHistory(ctx, e)
+	}
+
+	mm_params := ShipmentRepositoryMockSaveHistoryParams{ctx, e}
+
+	// Record call args
+	mmSaveHistory.SaveHistoryMock.mutex.Lock()
+	mmSaveHistory.SaveHistoryMock.callArgs = append(mmSaveHistory.SaveHistoryMock.callArgs, &mm_params)
+	mmSaveHistory.SaveHistoryMock.mutex.Unlock()
+
+	for _, e := range mmSaveHistory.SaveHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveHistory.SaveHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveHistory.SaveHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveHistory.SaveHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveHistory.SaveHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := ShipmentRepositoryMockSaveHistoryParams{ctx, e}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveHistory.t.Errorf("ShipmentRepositoryMock.SaveHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveHistory.SaveHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.e != nil && !minimock.Equal(*mm_want_ptrs.e, mm_got.e) {
+				mmSaveHistory.t.Errorf("ShipmentRepositoryMock.SaveHistory got unexpected parameter e, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveHistory.SaveHistoryMock.defaultExpectation.expectationOrigins.originE, *mm_want_ptrs.e, mm_got.e, minimock.Diff(*mm_want_ptrs.e, mm_got.e))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveHistory.t.Errorf("ShipmentRepositoryMock.SaveHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveHistory.SaveHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveHistory.SaveHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveHistory.t.Fatal("No results are set for the ShipmentRepositoryMock.SaveHistory")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveHistory.funcSaveHistory != nil {
+		return mmSaveHistory.funcSaveHistory(ctx, e)
+	}
+	mmSaveHistory.t.Fatalf("Unexpected call to ShipmentRepositoryMock.SaveHistory. %v %v", ctx, e)
+	return
+}
+
+// SaveHistoryAfterCounter returns a count of finished ShipmentRepositoryMock.SaveHistory invocations
+func (mmSaveHistory *ShipmentRepositoryMock) SaveHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveHistory.afterSaveHistoryCounter)
+}
+
+// SaveHistoryBeforeCounter returns a count of ShipmentRepositoryMock.SaveHistory invocations
+func (mmSaveHistory *ShipmentRepositoryMock) SaveHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveHistory.beforeSaveHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentRepositoryMock.SaveHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveHistory *mShipmentRepositoryMockSaveHistory) Calls() []*ShipmentRepositoryMockSaveHistoryParams {
+	mmSaveHistory.mutex.RLock()
+
+	argCopy := make([]*ShipmentRepositoryMockSaveHistoryParams, len(mmSaveHistory.callArgs))
+	copy(argCopy, mmSaveHistory.callArgs)
+
+	mmSaveHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveHistoryDone returns true if the count of the SaveHistory invocations corresponds
+// the number of defined expectations
+func (m *ShipmentRepositoryMock) MinimockSaveHistoryDone() bool {
+	if m.SaveHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveHistoryMock.invocationsDone()
+}
+
+// MinimockSaveHistoryInspect logs each unmet expectation
+func (m *ShipmentRepositoryMock) MinimockSaveHistoryInspect() {
+	for _, e := range m.SaveHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.SaveHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveHistoryCounter := mm_atomic.LoadUint64(&m.afterSaveHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveHistoryMock.defaultExpectation != nil && afterSaveHistoryCounter < 1 {
+		if m.SaveHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.SaveHistory at\n%s", m.SaveHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ShipmentRepositoryMock.SaveHistory at\n%s with params: %#v", m.SaveHistoryMock.defaultExpectation.expectationOrigins.origin, *m.SaveHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveHistory != nil && afterSaveHistoryCounter < 1 {
+		m.t.Errorf("Expected call to ShipmentRepositoryMock.SaveHistory at\n%s", m.funcSaveHistoryOrigin)
+	}
+
+	if !m.SaveHistoryMock.invocationsDone() && afterSaveHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to ShipmentRepositoryMock.SaveHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveHistoryMock.expectedInvocations), m.SaveHistoryMock.expectedInvocationsOrigin, afterSaveHistoryCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ShipmentRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListHistoryInspect()
+
+			m.MinimockLoadInspect()
+
+			m.MinimockSaveInspect()
+
+			m.MinimockSaveHistoryInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ShipmentRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ShipmentRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListHistoryDone() &&
+		m.MinimockLoadDone() &&
+		m.MinimockSaveDone() &&
+		m.MinimockSaveHistoryDone()
+}
@@ -0,0 +1,772 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UserDataRepositoryMock implements mm_repositories.UserDataRepository
+type UserDataRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAnonymize          func(ctx context.Context, userID uint64) (a1 models.AnonymizeResult, err error)
+	funcAnonymizeOrigin    string
+	inspectFuncAnonymize   func(ctx context.Context, userID uint64)
+	afterAnonymizeCounter  uint64
+	beforeAnonymizeCounter uint64
+	AnonymizeMock          mUserDataRepositoryMockAnonymize
+
+	funcExport          func(ctx context.Context, userID uint64) (u1 models.UserDataExport, err error)
+	funcExportOrigin    string
+	inspectFuncExport   func(ctx context.Context, userID uint64)
+	afterExportCounter  uint64
+	beforeExportCounter uint64
+	ExportMock          mUserDataRepositoryMockExport
+}
+
+// NewUserDataRepositoryMock returns a mock for mm_repositories.UserDataRepository
+func NewUserDataRepositoryMock(t minimock.Tester) *UserDataRepositoryMock {
+	m := &UserDataRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AnonymizeMock = mUserDataRepositoryMockAnonymize{mock: m}
+	m.AnonymizeMock.callArgs = []*UserDataRepositoryMockAnonymizeParams{}
+
+	m.ExportMock = mUserDataRepositoryMockExport{mock: m}
+	m.ExportMock.callArgs = []*UserDataRepositoryMockExportParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserDataRepositoryMockAnonymize struct {
+	optional           bool
+	mock               *UserDataRepositoryMock
+	defaultExpectation *UserDataRepositoryMockAnonymizeExpectation
+	expectations       []*UserDataRepositoryMockAnonymizeExpectation
+
+	callArgs []*UserDataRepositoryMockAnonymizeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserDataRepositoryMockAnonymizeExpectation specifies expectation struct of the UserDataRepository.Anonymize
+type UserDataRepositoryMockAnonymizeExpectation struct {
+	mock               *UserDataRepositoryMock
+	params             *UserDataRepositoryMockAnonymizeParams
+	paramPtrs          *UserDataRepositoryMockAnonymizeParamPtrs
+	expectationOrigins UserDataRepositoryMockAnonymizeExpectationOrigins
+	results            *UserDataRepositoryMockAnonymizeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserDataRepositoryMockAnonymizeParams contains parameters of the UserDataRepository.Anonymize
+type UserDataRepositoryMockAnonymizeParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// UserDataRepositoryMockAnonymizeParamPtrs contains pointers to parameters of the UserDataRepository.Anonymize
+type UserDataRepositoryMockAnonymizeParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// UserDataRepositoryMockAnonymizeResults contains results of the UserDataRepository.Anonymize
+type UserDataRepositoryMockAnonymizeResults struct {
+	a1  models.AnonymizeResult
+	err error
+}
+
+// UserDataRepositoryMockAnonymizeOrigins contains origins of expectations of the UserDataRepository.Anonymize
+type UserDataRepositoryMockAnonymizeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) Optional() *mUserDataRepositoryMockAnonymize {
+	mmAnonymize.optional = true
+	return mmAnonymize
+}
+
+// Expect sets up expected params for UserDataRepository.Anonymize
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) Expect(ctx context.Context, userID uint64) *mUserDataRepositoryMockAnonymize {
+	if mmAnonymize.mock.funcAnonymize != nil {
+		mmAnonymize.mock.t.Fatalf("UserDataRepositoryMock.Anonymize mock is already set by Set")
+	}
+
+	if mmAnonymize.defaultExpectation == nil {
+		mmAnonymize.defaultExpectation = &UserDataRepositoryMockAnonymizeExpectation{}
+	}
+
+	if mmAnonymize.defaultExpectation.paramPtrs != nil {
+		mmAnonymize.mock.t.Fatalf("UserDataRepositoryMock.Anonymize mock is already set by ExpectParams functions")
+	}
+
+	mmAnonymize.defaultExpectation.params = &UserDataRepositoryMockAnonymizeParams{ctx, userID}
+	mmAnonymize.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAnonymize.expectations {
+		if minimock.Equal(e.params, mmAnonymize.defaultExpectation.params) {
+			mmAnonymize.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAnonymize.defaultExpectation.params)
+		}
+	}
+
+	return mmAnonymize
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserDataRepository.Anonymize
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) ExpectCtxParam1(ctx context.Context) *mUserDataRepositoryMockAnonymize {
+	if mmAnonymize.mock.funcAnonymize != nil {
+		mmAnonymize.mock.t.Fatalf("UserDataRepositoryMock.Anonymize mock is already set by Set")
+	}
+
+	if mmAnonymize.defaultExpectation == nil {
+		mmAnonymize.defaultExpectation = &UserDataRepositoryMockAnonymizeExpectation{}
+	}
+
+	if mmAnonymize.defaultExpectation.params != nil {
+		mmAnonymize.mock.t.Fatalf("UserDataRepositoryMock.Anonymize mock is already set by Expect")
+	}
+
+	if mmAnonymize.defaultExpectation.paramPtrs == nil {
+		mmAnonymize.defaultExpectation.paramPtrs = &UserDataRepositoryMockAnonymizeParamPtrs{}
+	}
+	mmAnonymize.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAnonymize.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAnonymize
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserDataRepository.Anonymize
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) ExpectUserIDParam2(userID uint64) *mUserDataRepositoryMockAnonymize {
+	if mmAnonymize.mock.funcAnonymize != nil {
+		mmAnonymize.mock.t.Fatalf("UserDataRepositoryMock.Anonymize mock is already set by Set")
+	}
+
+	if mmAnonymize.defaultExpectation == nil {
+		mmAnonymize.defaultExpectation = &UserDataRepositoryMockAnonymizeExpectation{}
+	}
+
+	if mmAnonymize.defaultExpectation.params != nil {
+		mmAnonymize.mock.t.Fatalf("UserDataRepositoryMock.Anonymize mock is already set by Expect")
+	}
+
+	if mmAnonymize.defaultExpectation.paramPtrs == nil {
+		mmAnonymize.defaultExpectation.paramPtrs = &UserDataRepositoryMockAnonymizeParamPtrs{}
+	}
+	mmAnonymize.defaultExpectation.paramPtrs.userID = &userID
+	mmAnonymize.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAnonymize
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserDataRepository.Anonymize
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) Inspect(f func(ctx context.Context, userID uint64)) *mUserDataRepositoryMockAnonymize {
+	if mmAnonymize.mock.inspectFuncAnonymize != nil {
+		mmAnonymize.mock.t.Fatalf("Inspect function is already set for UserDataRepositoryMock.Anonymize")
+	}
+
+	mmAnonymize.mock.inspectFuncAnonymize = f
+
+	return mmAnonymize
+}
+
+// Return sets up results that will be returned by UserDataRepository.Anonymize
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) Return(a1 models.AnonymizeResult, err error) *UserDataRepositoryMock {
+	if mmAnonymize.mock.funcAnonymize != nil {
+		mmAnonymize.mock.t.Fatalf("UserDataRepositoryMock.Anonymize mock is already set by Set")
+	}
+
+	if mmAnonymize.defaultExpectation == nil {
+		mmAnonymize.defaultExpectation = &UserDataRepositoryMockAnonymizeExpectation{mock: mmAnonymize.mock}
+	}
+	mmAnonymize.defaultExpectation.results = &UserDataRepositoryMockAnonymizeResults{a1, err}
+	mmAnonymize.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAnonymize.mock
+}
+
+// Set uses given function f to mock the UserDataRepository.Anonymize method
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) Set(f func(ctx context.Context, userID uint64) (a1 models.AnonymizeResult, err error)) *UserDataRepositoryMock {
+	if mmAnonymize.defaultExpectation != nil {
+		mmAnonymize.mock.t.Fatalf("Default expectation is already set for the UserDataRepository.Anonymize method")
+	}
+
+	if len(mmAnonymize.expectations) > 0 {
+		mmAnonymize.mock.t.Fatalf("Some expectations are already set for the UserDataRepository.Anonymize method")
+	}
+
+	mmAnonymize.mock.funcAnonymize = f
+	mmAnonymize.mock.funcAnonymizeOrigin = minimock.CallerInfo(1)
+	return mmAnonymize.mock
+}
+
+// When sets expectation for the UserDataRepository.Anonymize which will trigger the result defined by the following
+// Then helper
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) When(ctx context.Context, userID uint64) *UserDataRepositoryMockAnonymizeExpectation {
+	if mmAnonymize.mock.funcAnonymize != nil {
+		mmAnonymize.mock.t.Fatalf("UserDataRepositoryMock.Anonymize mock is already set by Set")
+	}
+
+	expectation := &UserDataRepositoryMockAnonymizeExpectation{
+		mock:               mmAnonymize.mock,
+		params:             &UserDataRepositoryMockAnonymizeParams{ctx, userID},
+		expectationOrigins: UserDataRepositoryMockAnonymizeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAnonymize.expectations = append(mmAnonymize.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserDataRepository.Anonymize return parameters for the expectation previously defined by the When method
+func (e *UserDataRepositoryMockAnonymizeExpectation) Then(a1 models.AnonymizeResult, err error) *UserDataRepositoryMock {
+	e.results = &UserDataRepositoryMockAnonymizeResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times UserDataRepository.Anonymize should be invoked
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) Times(n uint64) *mUserDataRepositoryMockAnonymize {
+	if n == 0 {
+		mmAnonymize.mock.t.Fatalf("Times of UserDataRepositoryMock.Anonymize mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAnonymize.expectedInvocations, n)
+	mmAnonymize.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAnonymize
+}
+
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) invocationsDone() bool {
+	if len(mmAnonymize.expectations) == 0 && mmAnonymize.defaultExpectation == nil && mmAnonymize.mock.funcAnonymize == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAnonymize.mock.afterAnonymizeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAnonymize.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Anonymize implements mm_repositories.UserDataRepository
+func (mmAnonymize *UserDataRepositoryMock) Anonymize(ctx context.Context, userID uint64) (a1 models.AnonymizeResult, err error) {
+	mm_atomic.AddUint64(&mmAnonymize.beforeAnonymizeCounter, 1)
+	defer mm_atomic.AddUint64(&mmAnonymize.afterAnonymizeCounter, 1)
+
+	mmAnonymize.t.Helper()
+
+	if mmAnonymize.inspectFuncAnonymize != nil {
+		mmAnonymize.inspectFuncAnonymize(ctx, userID)
+	}
+
+	mm_params := UserDataRepositoryMockAnonymizeParams{ctx, userID}
+
+	// Record call args
+	mmAnonymize.AnonymizeMock.mutex.Lock()
+	mmAnonymize.AnonymizeMock.callArgs = append(mmAnonymize.AnonymizeMock.callArgs, &mm_params)
+	mmAnonymize.AnonymizeMock.mutex.Unlock()
+
+	for _, e := range mmAnonymize.AnonymizeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmAnonymize.AnonymizeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAnonymize.AnonymizeMock.defaultExpectation.Counter, 1)
+		mm_want := mmAnonymize.AnonymizeMock.defaultExpectation.params
+		mm_want_ptrs := mmAnonymize.AnonymizeMock.defaultExpectation.paramPtrs
+
+		mm_got := UserDataRepositoryMockAnonymizeParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAnonymize.t.Errorf("UserDataRepositoryMock.Anonymize got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymize.AnonymizeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAnonymize.t.Errorf("UserDataRepositoryMock.Anonymize got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymize.AnonymizeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAnonymize.t.Errorf("UserDataRepositoryMock.Anonymize got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAnonymize.AnonymizeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAnonymize.AnonymizeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAnonymize.t.Fatal("No results are set for the UserDataRepositoryMock.Anonymize")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmAnonymize.funcAnonymize != nil {
+		return mmAnonymize.funcAnonymize(ctx, userID)
+	}
+	mmAnonymize.t.Fatalf("Unexpected call to UserDataRepositoryMock.Anonymize. %v %v", ctx, userID)
+	return
+}
+
+// AnonymizeAfterCounter returns a count of finished UserDataRepositoryMock.Anonymize invocations
+func (mmAnonymize *UserDataRepositoryMock) AnonymizeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymize.afterAnonymizeCounter)
+}
+
+// AnonymizeBeforeCounter returns a count of UserDataRepositoryMock.Anonymize invocations
+func (mmAnonymize *UserDataRepositoryMock) AnonymizeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymize.beforeAnonymizeCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserDataRepositoryMock.Anonymize.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAnonymize *mUserDataRepositoryMockAnonymize) Calls() []*UserDataRepositoryMockAnonymizeParams {
+	mmAnonymize.mutex.RLock()
+
+	argCopy := make([]*UserDataRepositoryMockAnonymizeParams, len(mmAnonymize.callArgs))
+	copy(argCopy, mmAnonymize.callArgs)
+
+	mmAnonymize.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAnonymizeDone returns true if the count of the Anonymize invocations corresponds
+// the number of defined expectations
+func (m *UserDataRepositoryMock) MinimockAnonymizeDone() bool {
+	if m.AnonymizeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AnonymizeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AnonymizeMock.invocationsDone()
+}
+
+// MinimockAnonymizeInspect logs each unmet expectation
+func (m *UserDataRepositoryMock) MinimockAnonymizeInspect() {
+	for _, e := range m.AnonymizeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserDataRepositoryMock.Anonymize at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAnonymizeCounter := mm_atomic.LoadUint64(&m.afterAnonymizeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AnonymizeMock.defaultExpectation != nil && afterAnonymizeCounter < 1 {
+		if m.AnonymizeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserDataRepositoryMock.Anonymize at\n%s", m.AnonymizeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserDataRepositoryMock.Anonymize at\n%s with params: %#v", m.AnonymizeMock.defaultExpectation.expectationOrigins.origin, *m.AnonymizeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAnonymize != nil && afterAnonymizeCounter < 1 {
+		m.t.Errorf("Expected call to UserDataRepositoryMock.Anonymize at\n%s", m.funcAnonymizeOrigin)
+	}
+
+	if !m.AnonymizeMock.invocationsDone() && afterAnonymizeCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserDataRepositoryMock.Anonymize at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AnonymizeMock.expectedInvocations), m.AnonymizeMock.expectedInvocationsOrigin, afterAnonymizeCounter)
+	}
+}
+
+type mUserDataRepositoryMockExport struct {
+	optional           bool
+	mock               *UserDataRepositoryMock
+	defaultExpectation *UserDataRepositoryMockExportExpectation
+	expectations       []*UserDataRepositoryMockExportExpectation
+
+	callArgs []*UserDataRepositoryMockExportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserDataRepositoryMockExportExpectation specifies expectation struct of the UserDataRepository.Export
+type UserDataRepositoryMockExportExpectation struct {
+	mock               *UserDataRepositoryMock
+	params             *UserDataRepositoryMockExportParams
+	paramPtrs          *UserDataRepositoryMockExportParamPtrs
+	expectationOrigins UserDataRepositoryMockExportExpectationOrigins
+	results            *UserDataRepositoryMockExportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserDataRepositoryMockExportParams contains parameters of the UserDataRepository.Export
+type UserDataRepositoryMockExportParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// UserDataRepositoryMockExportParamPtrs contains pointers to parameters of the UserDataRepository.Export
+type UserDataRepositoryMockExportParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// UserDataRepositoryMockExportResults contains results of the UserDataRepository.Export
+type UserDataRepositoryMockExportResults struct {
+	u1  models.UserDataExport
+	err error
+}
+
+// UserDataRepositoryMockExportOrigins contains origins of expectations of the UserDataRepository.Export
+type UserDataRepositoryMockExportExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExport *mUserDataRepositoryMockExport) Optional() *mUserDataRepositoryMockExport {
+	mmExport.optional = true
+	return mmExport
+}
+
+// Expect sets up expected params for UserDataRepository.Export
+func (mmExport *mUserDataRepositoryMockExport) Expect(ctx context.Context, userID uint64) *mUserDataRepositoryMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("UserDataRepositoryMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &UserDataRepositoryMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.paramPtrs != nil {
+		mmExport.mock.t.Fatalf("UserDataRepositoryMock.Export mock is already set by ExpectParams functions")
+	}
+
+	mmExport.defaultExpectation.params = &UserDataRepositoryMockExportParams{ctx, userID}
+	mmExport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExport.expectations {
+		if minimock.Equal(e.params, mmExport.defaultExpectation.params) {
+			mmExport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExport.defaultExpectation.params)
+		}
+	}
+
+	return mmExport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserDataRepository.Export
+func (mmExport *mUserDataRepositoryMockExport) ExpectCtxParam1(ctx context.Context) *mUserDataRepositoryMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("UserDataRepositoryMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &UserDataRepositoryMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("UserDataRepositoryMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &UserDataRepositoryMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserDataRepository.Export
+func (mmExport *mUserDataRepositoryMockExport) ExpectUserIDParam2(userID uint64) *mUserDataRepositoryMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("UserDataRepositoryMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &UserDataRepositoryMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("UserDataRepositoryMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &UserDataRepositoryMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.userID = &userID
+	mmExport.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserDataRepository.Export
+func (mmExport *mUserDataRepositoryMockExport) Inspect(f func(ctx context.Context, userID uint64)) *mUserDataRepositoryMockExport {
+	if mmExport.mock.inspectFuncExport != nil {
+		mmExport.mock.t.Fatalf("Inspect function is already set for UserDataRepositoryMock.Export")
+	}
+
+	mmExport.mock.inspectFuncExport = f
+
+	return mmExport
+}
+
+// Return sets up results that will be returned by UserDataRepository.Export
+func (mmExport *mUserDataRepositoryMockExport) Return(u1 models.UserDataExport, err error) *UserDataRepositoryMock {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("UserDataRepositoryMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &UserDataRepositoryMockExportExpectation{mock: mmExport.mock}
+	}
+	mmExport.defaultExpectation.results = &UserDataRepositoryMockExportResults{u1, err}
+	mmExport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// Set uses given function f to mock the UserDataRepository.Export method
+func (mmExport *mUserDataRepositoryMockExport) Set(f func(ctx context.Context, userID uint64) (u1 models.UserDataExport, err error)) *UserDataRepositoryMock {
+	if mmExport.defaultExpectation != nil {
+		mmExport.mock.t.Fatalf("Default expectation is already set for the UserDataRepository.Export method")
+	}
+
+	if len(mmExport.expectations) > 0 {
+		mmExport.mock.t.Fatalf("Some expectations are already set for the UserDataRepository.Export method")
+	}
+
+	mmExport.mock.funcExport = f
+	mmExport.mock.funcExportOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// When sets expectation for the UserDataRepository.Export which will trigger the result defined by the following
+// Then helper
+func (mmExport *mUserDataRepositoryMockExport) When(ctx context.Context, userID uint64) *UserDataRepositoryMockExportExpectation {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("UserDataRepositoryMock.Export mock is already set by Set")
+	}
+
+	expectation := &UserDataRepositoryMockExportExpectation{
+		mock:               mmExport.mock,
+		params:             &UserDataRepositoryMockExportParams{ctx, userID},
+		expectationOrigins: UserDataRepositoryMockExportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExport.expectations = append(mmExport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserDataRepository.Export return parameters for the expectation previously defined by the When method
+func (e *UserDataRepositoryMockExportExpectation) Then(u1 models.UserDataExport, err error) *UserDataRepositoryMock {
+	e.results = &UserDataRepositoryMockExportResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times UserDataRepository.Export should be invoked
+func (mmExport *mUserDataRepositoryMockExport) Times(n uint64) *mUserDataRepositoryMockExport {
+	if n == 0 {
+		mmExport.mock.t.Fatalf("Times of UserDataRepositoryMock.Export mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExport.expectedInvocations, n)
+	mmExport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExport
+}
+
+func (mmExport *mUserDataRepositoryMockExport) invocationsDone() bool {
+	if len(mmExport.expectations) == 0 && mmExport.defaultExpectation == nil && mmExport.mock.funcExport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExport.mock.afterExportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Export implements mm_repositories.UserDataRepository
+func (mmExport *UserDataRepositoryMock) Export(ctx context.Context, userID uint64) (u1 models.UserDataExport, err error) {
+	mm_atomic.AddUint64(&mmExport.beforeExportCounter, 1)
+	defer mm_atomic.AddUint64(&mmExport.afterExportCounter, 1)
+
+	mmExport.t.Helper()
+
+	if mmExport.inspectFuncExport != nil {
+		mmExport.inspectFuncExport(ctx, userID)
+	}
+
+	mm_params := UserDataRepositoryMockExportParams{ctx, userID}
+
+	// Record call args
+	mmExport.ExportMock.mutex.Lock()
+	mmExport.ExportMock.callArgs = append(mmExport.ExportMock.callArgs, &mm_params)
+	mmExport.ExportMock.mutex.Unlock()
+
+	for _, e := range mmExport.ExportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmExport.ExportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExport.ExportMock.defaultExpectation.Counter, 1)
+		mm_want := mmExport.ExportMock.defaultExpectation.params
+		mm_want_ptrs := mmExport.ExportMock.defaultExpectation.paramPtrs
+
+		mm_got := UserDataRepositoryMockExportParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExport.t.Errorf("UserDataRepositoryMock.Export got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmExport.t.Errorf("UserDataRepositoryMock.Export got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExport.t.Errorf("UserDataRepositoryMock.Export got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExport.ExportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExport.ExportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExport.t.Fatal("No results are set for the UserDataRepositoryMock.Export")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmExport.funcExport != nil {
+		return mmExport.funcExport(ctx, userID)
+	}
+	mmExport.t.Fatalf("Unexpected call to UserDataRepositoryMock.Export. %v %v", ctx, userID)
+	return
+}
+
+// ExportAfterCounter returns a count of finished UserDataRepositoryMock.Export invocations
+func (mmExport *UserDataRepositoryMock) ExportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.afterExportCounter)
+}
+
+// ExportBeforeCounter returns a count of UserDataRepositoryMock.Export invocations
+func (mmExport *UserDataRepositoryMock) ExportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.beforeExportCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserDataRepositoryMock.Export.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExport *mUserDataRepositoryMockExport) Calls() []*UserDataRepositoryMockExportParams {
+	mmExport.mutex.RLock()
+
+	argCopy := make([]*UserDataRepositoryMockExportParams, len(mmExport.callArgs))
+	copy(argCopy, mmExport.callArgs)
+
+	mmExport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportDone returns true if the count of the Export invocations corresponds
+// the number of defined expectations
+func (m *UserDataRepositoryMock) MinimockExportDone() bool {
+	if m.ExportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportMock.invocationsDone()
+}
+
+// MinimockExportInspect logs each unmet expectation
+func (m *UserDataRepositoryMock) MinimockExportInspect() {
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserDataRepositoryMock.Export at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportCounter := mm_atomic.LoadUint64(&m.afterExportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportMock.defaultExpectation != nil && afterExportCounter < 1 {
+		if m.ExportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserDataRepositoryMock.Export at\n%s", m.ExportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserDataRepositoryMock.Export at\n%s with params: %#v", m.ExportMock.defaultExpectation.expectationOrigins.origin, *m.ExportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExport != nil && afterExportCounter < 1 {
+		m.t.Errorf("Expected call to UserDataRepositoryMock.Export at\n%s", m.funcExportOrigin)
+	}
+
+	if !m.ExportMock.invocationsDone() && afterExportCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserDataRepositoryMock.Export at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportMock.expectedInvocations), m.ExportMock.expectedInvocationsOrigin, afterExportCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserDataRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAnonymizeInspect()
+
+			m.MinimockExportInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserDataRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserDataRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAnonymizeDone() &&
+		m.MinimockExportDone()
+}
@@ -34,7 +34,7 @@ func (r *NoOpOutboxRepository) SetFailed(ctx context.Context, eventID uint64, er
 }
 
 // Create inserts a new outbox event with the provided payload in the no-operation outbox repository implementation.
-func (r *NoOpOutboxRepository) Create(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+func (r *NoOpOutboxRepository) Create(ctx context.Context, eventID uint64, aggregate models.OutboxAggregate, aggregateID uint64, payload []byte) error {
 	return nil
 }
 
@@ -9,8 +9,14 @@ import (
 
 // OrderRepository handles persistence operations for orders
 type OrderRepository interface {
-	Save(ctx context.Context, order models.Order) error
+	// Save stores the order, expecting order.Version to match the stored version, and returns ErrConcurrentModification otherwise.
+	// It returns the version the order was stored with.
+	Save(ctx context.Context, order models.Order) (int64, error)
 	Load(ctx context.Context, id uint64) (models.Order, error)
-	Delete(ctx context.Context, id uint64) error
+	// Delete removes the order, expecting version to match the stored version, and returns ErrConcurrentModification otherwise,
+	// also when the order is already gone
+	Delete(ctx context.Context, id uint64, version int64) error
 	List(ctx context.Context, filter requests.OrdersFilterRequest) ([]models.Order, int, error)
+	// Stream passes every order matching the filter to fn in the filter's sort order, ignoring paging; it stops at the first error of fn
+	Stream(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error) error
 }
@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/data/queries"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"time"
+)
+
+var _ ArchiveRepository = (*PGArchiveRepository)(nil)
+
+// PGArchiveRepository provides PostgreSQL-based persistence for ArchiveRepository.
+type PGArchiveRepository struct {
+	Db db.PGXClient
+}
+
+// NewPGArchiveRepository initializes and returns a new instance of PGArchiveRepository with the provided database client.
+func NewPGArchiveRepository(db db.PGXClient) *PGArchiveRepository {
+	return &PGArchiveRepository{
+		Db: db,
+	}
+}
+
+// ArchiveOrders moves a batch of terminal orders and their history into the archive tables in a single statement.
+func (r *PGArchiveRepository) ArchiveOrders(ctx context.Context, olderThan, archivedAt time.Time, limit int) (int, error) {
+	tag, err := r.Db.ExecCtx(ctx, db.WriteMode, queries.ArchiveOrdersSQL, models.Issued, olderThan, limit, archivedAt)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// PurgeArchive deletes archived orders and history older than the retention boundary.
+func (r *PGArchiveRepository) PurgeArchive(ctx context.Context, archivedBefore time.Time) (int, error) {
+	tag, err := r.Db.ExecCtx(ctx, db.WriteMode, queries.PurgeArchiveSQL, archivedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/data/queries"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+var _ AuditRepository = (*PGAuditRepository)(nil)
+
+// PGAuditRepository provides PostgreSQL-based persistence for AuditRepository.
+type PGAuditRepository struct {
+	Db db.PGXClient
+}
+
+// NewPGAuditRepository initializes and returns a new instance of PGAuditRepository with the provided database client.
+func NewPGAuditRepository(db db.PGXClient) *PGAuditRepository {
+	return &PGAuditRepository{
+		Db: db,
+	}
+}
+
+// Save appends a record to the audit log.
+func (r *PGAuditRepository) Save(ctx context.Context, rec models.AuditRecord) error {
+	_, err := r.Db.ExecCtx(
+		ctx,
+		db.WriteMode,
+		queries.SaveAuditRecordSQL,
+		rec.Timestamp,
+		rec.OperatorID,
+		rec.Role,
+		rec.Source,
+		rec.Method,
+		rec.Request,
+		rec.ResultCode,
+		rec.CorrelationID,
+	)
+	return err
+}
+
+// List retrieves a filtered page of the audit log, newest first.
+func (r *PGAuditRepository) List(ctx context.Context, filter requests.AuditLogFilter) ([]models.AuditRecord, int, error) {
+	countQuery, countArgs := queries.BuildCountAuditQuery(filter)
+	var count int
+	if err := pgxscan.Get(ctx, r.Db, &count, countQuery, countArgs...); err != nil {
+		return nil, 0, err
+	}
+	query, args := queries.BuildFilterAuditQuery(filter)
+	var out []models.AuditRecord
+	if err := pgxscan.Select(ctx, r.Db, &out, query, args...); err != nil {
+		return nil, 0, err
+	}
+	return out, count, nil
+}
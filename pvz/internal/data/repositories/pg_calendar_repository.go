@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"pvz-cli/internal/data/queries"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"time"
+)
+
+var _ CalendarRepository = (*PGCalendarRepository)(nil)
+
+// PGCalendarRepository provides PostgreSQL-based persistence for CalendarRepository.
+type PGCalendarRepository struct {
+	Db db.PGXClient
+}
+
+type calendarRow struct {
+	OpensAt       string      `db:"opens_at"`
+	ClosesAt      string      `db:"closes_at"`
+	WeeklyDaysOff []int32     `db:"weekly_days_off"`
+	Holidays      []time.Time `db:"holidays"`
+	BusinessDays  bool        `db:"business_days"`
+}
+
+// NewPGCalendarRepository initializes and returns a new instance of PGCalendarRepository with the provided database client.
+func NewPGCalendarRepository(db db.PGXClient) *PGCalendarRepository {
+	return &PGCalendarRepository{
+		Db: db,
+	}
+}
+
+// Get retrieves the pickup-point calendar, returning an empty (always open) calendar if none is configured.
+func (r *PGCalendarRepository) Get(ctx context.Context) (models.PickupCalendar, error) {
+	var row calendarRow
+	err := pgxscan.Get(ctx, r.Db, &row, queries.GetCalendarSQL)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.PickupCalendar{}, nil
+		}
+		return models.PickupCalendar{}, err
+	}
+	daysOff := make([]time.Weekday, 0, len(row.WeeklyDaysOff))
+	for _, d := range row.WeeklyDaysOff {
+		daysOff = append(daysOff, time.Weekday(d))
+	}
+	return models.PickupCalendar{
+		OpensAt:       row.OpensAt,
+		ClosesAt:      row.ClosesAt,
+		WeeklyDaysOff: daysOff,
+		Holidays:      row.Holidays,
+		BusinessDays:  row.BusinessDays,
+	}, nil
+}
+
+// Save persists the pickup-point calendar in the database.
+func (r *PGCalendarRepository) Save(ctx context.Context, cal models.PickupCalendar) error {
+	daysOff := make([]int32, 0, len(cal.WeeklyDaysOff))
+	for _, d := range cal.WeeklyDaysOff {
+		daysOff = append(daysOff, int32(d))
+	}
+	holidays := cal.Holidays
+	if holidays == nil {
+		holidays = []time.Time{}
+	}
+	_, err := r.Db.ExecCtx(
+		ctx,
+		db.WriteMode,
+		queries.SaveCalendarSQL,
+		cal.OpensAt,
+		cal.ClosesAt,
+		daysOff,
+		holidays,
+		cal.BusinessDays,
+	)
+	return err
+}
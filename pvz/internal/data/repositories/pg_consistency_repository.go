@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"pvz-cli/internal/data/queries"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+var _ ConsistencyRepository = (*PGConsistencyRepository)(nil)
+
+// consistencyBatchSize bounds how many orders with their history and outbox events are held in memory at once
+const consistencyBatchSize = 500
+
+// PGConsistencyRepository provides PostgreSQL-based reads for ConsistencyRepository.
+type PGConsistencyRepository struct {
+	Db db.PGXClient
+}
+
+// NewPGConsistencyRepository initializes and returns a new instance of PGConsistencyRepository with the provided database client.
+func NewPGConsistencyRepository(db db.PGXClient) *PGConsistencyRepository {
+	return &PGConsistencyRepository{
+		Db: db,
+	}
+}
+
+// Scan reads orders in ID batches and loads the history and outbox events of each batch with one query apiece.
+func (r *PGConsistencyRepository) Scan(ctx context.Context, fn func(models.OrderRecord) error) error {
+	var lastID uint64
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var rows []consistencyOrderRow
+		if err := pgxscan.Select(ctx, r.Db, &rows, queries.SelectOrdersBatchSQL, lastID, consistencyBatchSize); err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		records, err := r.loadBatch(ctx, rows)
+		if err != nil {
+			return err
+		}
+		for _, rec := range records {
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+		lastID = rows[len(rows)-1].OrderID
+	}
+}
+
+func (r *PGConsistencyRepository) loadBatch(ctx context.Context, rows []consistencyOrderRow) ([]models.OrderRecord, error) {
+	ids := make([]int64, 0, len(rows))
+	records := make([]models.OrderRecord, 0, len(rows))
+	index := make(map[uint64]int, len(rows))
+	for _, row := range rows {
+		ids = append(ids, int64(row.OrderID))
+		order := row.Order
+		index[row.OrderID] = len(records)
+		records = append(records, models.OrderRecord{OrderID: row.OrderID, Order: &order, Removed: row.IsDeleted})
+	}
+
+	var history []models.HistoryEntry
+	if err := pgxscan.Select(ctx, r.Db, &history, queries.SelectOrdersHistorySQL, ids); err != nil {
+		return nil, err
+	}
+	for _, h := range history {
+		rec := &records[index[h.OrderID]]
+		rec.History = append(rec.History, h)
+	}
+
+	var events []consistencyOutboxRow
+	if err := pgxscan.Select(ctx, r.Db, &events, queries.SelectOrdersOutboxEventsSQL, ids); err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		at, err := time.Parse(time.RFC3339Nano, e.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("outbox event of order %d has malformed timestamp %q: %w", e.OrderID, e.Timestamp, err)
+		}
+		rec := &records[index[e.OrderID]]
+		rec.OutboxEvents = append(rec.OutboxEvents, models.OutboxEventRef{EventType: e.EventType, Timestamp: at})
+	}
+	return records, nil
+}
+
+type consistencyOrderRow struct {
+	models.Order
+	IsDeleted bool `db:"is_deleted"`
+}
+
+type consistencyOutboxRow struct {
+	OrderID   uint64 `db:"order_id"`
+	EventType string `db:"event_type"`
+	Timestamp string `db:"timestamp"`
+}
@@ -33,19 +33,34 @@ func (r *PGHistoryRepository) Save(ctx context.Context, e models.HistoryEntry) e
 		e.OrderID,
 		e.Event,
 		e.Timestamp,
+		e.OperatorID,
+		e.ActorType,
+		e.ActorID,
+		e.PreviousStatus,
+		e.NewStatus,
+		e.CorrelationID,
+		e.Metadata,
 	)
 	return err
 }
 
-// List retrieves a paginated list of all history entries from the database based on the specified page and limit.
+// List retrieves a filtered and paginated list of history entries from the database.
+// History of a single order without live entries is read from the archive table.
 func (r *PGHistoryRepository) List(ctx context.Context, filter requests.OrderHistoryFilter) ([]models.HistoryEntry, int, error) {
-	countQuery, countArgs := queries.BuildCountHistoryQuery(filter)
-	var count int
-	err := pgxscan.Get(ctx, r.Db, &count, countQuery, countArgs...)
+	archived, err := r.archivedOrder(ctx, filter)
 	if err != nil {
 		return nil, 0, err
 	}
-	query, args := queries.BuildFilterHistoryQuery(filter)
+	buildCount, buildQuery := queries.BuildCountHistoryQuery, queries.BuildFilterHistoryQuery
+	if archived {
+		buildCount, buildQuery = queries.BuildCountArchivedHistoryQuery, queries.BuildFilterArchivedHistoryQuery
+	}
+	countQuery, countArgs := buildCount(filter)
+	var count int
+	if err = pgxscan.Get(ctx, r.Db, &count, countQuery, countArgs...); err != nil {
+		return nil, 0, err
+	}
+	query, args := buildQuery(filter)
 	var out []models.HistoryEntry
 	err = pgxscan.Select(ctx, r.Db, &out, query, args...)
 	if err != nil {
@@ -54,6 +69,36 @@ func (r *PGHistoryRepository) List(ctx context.Context, filter requests.OrderHis
 	return out, count, nil
 }
 
+// Stream reads every history entry matching the filter row by row, in the listing order of the filter and without paging.
+// History of a single order without live entries is read from the archive table.
+func (r *PGHistoryRepository) Stream(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error) error {
+	archived, err := r.archivedOrder(ctx, filter)
+	if err != nil {
+		return err
+	}
+	buildQuery := queries.BuildStreamHistoryQuery
+	if archived {
+		buildQuery = queries.BuildStreamArchivedHistoryQuery
+	}
+	query, args := buildQuery(filter)
+	_, err = streamRows(ctx, r.Db, fn, query, args...)
+	return err
+}
+
+// archivedOrder reports whether the filter names a single order that has no live history, as an order and
+// its history are archived together; other filters do not matter, so a narrowed filter of a live order
+// never falls through to the archive.
+func (r *PGHistoryRepository) archivedOrder(ctx context.Context, filter requests.OrderHistoryFilter) (bool, error) {
+	if filter.OrderID == nil {
+		return false, nil
+	}
+	var live bool
+	if err := pgxscan.Get(ctx, r.Db, &live, queries.HasLiveHistorySQL, *filter.OrderID); err != nil {
+		return false, err
+	}
+	return !live, nil
+}
+
 func (r *PGHistoryRepository) GetDBClient() db.PGXClient {
 	return r.Db
 }
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"pvz-cli/internal/data/queries"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"time"
+)
+
+var _ IdempotencyRepository = (*PGIdempotencyRepository)(nil)
+
+// reserveAttempts bounds how many times Reserve retries when the record holding the key is released between
+// the insert and the lookup
+const reserveAttempts = 3
+
+// PGIdempotencyRepository provides PostgreSQL-based persistence for IdempotencyRepository.
+type PGIdempotencyRepository struct {
+	Db db.PGXClient
+}
+
+// NewPGIdempotencyRepository initializes and returns a new instance of PGIdempotencyRepository with the provided database client.
+func NewPGIdempotencyRepository(db db.PGXClient) *PGIdempotencyRepository {
+	return &PGIdempotencyRepository{
+		Db: db,
+	}
+}
+
+// Reserve inserts a pending record or replaces an expired one; when the key is taken, the stored record is read
+// from the primary, as a replica may not have it yet.
+func (r *PGIdempotencyRepository) Reserve(
+	ctx context.Context,
+	rec models.IdempotencyRecord,
+	expiredBefore time.Time,
+) (models.IdempotencyRecord, bool, error) {
+	for i := 0; i < reserveAttempts; i++ {
+		res, err := r.Db.ExecCtx(
+			ctx,
+			db.WriteMode,
+			queries.ReserveIdempotencyKeySQL,
+			rec.Scope,
+			rec.Key,
+			rec.Method,
+			rec.RequestHash,
+			rec.CreatedAt,
+			expiredBefore,
+		)
+		if err != nil {
+			return models.IdempotencyRecord{}, false, err
+		}
+		if res.RowsAffected() == 1 {
+			rec.Response, rec.UserIDs = nil, nil
+			return rec, true, nil
+		}
+		existing, err := r.load(ctx, db.WriteMode, rec.Scope, rec.Key)
+		if errors.Is(err, ErrIdempotencyKeyNotFound) {
+			continue
+		}
+		return existing, false, err
+	}
+	return models.IdempotencyRecord{}, false, fmt.Errorf("idempotency key %q is being reserved and released concurrently", rec.Key)
+}
+
+// Complete stores the response of the pending record and the clients it mentions.
+func (r *PGIdempotencyRepository) Complete(ctx context.Context, scope, key string, response []byte, userIDs []uint64) error {
+	ids := make([]int64, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = int64(id) // #nosec G115 -- user IDs are stored as bigint everywhere
+	}
+	_, err := r.Db.ExecCtx(ctx, db.WriteMode, queries.CompleteIdempotencyKeySQL, scope, key, response, ids)
+	return err
+}
+
+// Release deletes the pending record; a completed one is kept.
+func (r *PGIdempotencyRepository) Release(ctx context.Context, scope, key string) error {
+	_, err := r.Db.ExecCtx(ctx, db.WriteMode, queries.ReleaseIdempotencyKeySQL, scope, key)
+	return err
+}
+
+// Load retrieves the record stored for the given key.
+func (r *PGIdempotencyRepository) Load(ctx context.Context, scope, key string) (models.IdempotencyRecord, error) {
+	return r.load(ctx, db.ReadMode, scope, key)
+}
+
+// Purge deletes the records created before createdBefore.
+func (r *PGIdempotencyRepository) Purge(ctx context.Context, createdBefore time.Time) (int, error) {
+	res, err := r.Db.ExecCtx(ctx, db.WriteMode, queries.PurgeIdempotencyKeysSQL, createdBefore)
+	if err != nil {
+		return 0, err
+	}
+	return int(res.RowsAffected()), nil
+}
+
+func (r *PGIdempotencyRepository) load(ctx context.Context, mode db.Mode, scope, key string) (models.IdempotencyRecord, error) {
+	var rec models.IdempotencyRecord
+	err := r.Db.QueryRowCtx(ctx, mode, queries.LoadIdempotencyRecordSQL, scope, key).
+		Scan(&rec.Scope, &rec.Key, &rec.Method, &rec.RequestHash, &rec.Response, &rec.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.IdempotencyRecord{}, ErrIdempotencyKeyNotFound
+		}
+		return models.IdempotencyRecord{}, err
+	}
+	return rec, nil
+}
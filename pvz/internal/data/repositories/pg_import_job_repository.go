@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"pvz-cli/internal/data/queries"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"time"
+)
+
+var _ ImportJobRepository = (*PGImportJobRepository)(nil)
+
+// PGImportJobRepository provides PostgreSQL-based persistence for ImportJobRepository.
+type PGImportJobRepository struct {
+	Db db.PGXClient
+}
+
+// NewPGImportJobRepository initializes and returns a new instance of PGImportJobRepository with the provided database client.
+func NewPGImportJobRepository(db db.PGXClient) *PGImportJobRepository {
+	return &PGImportJobRepository{
+		Db: db,
+	}
+}
+
+// Save persists the provided import job in the database.
+func (r *PGImportJobRepository) Save(ctx context.Context, job models.ImportJob) error {
+	_, err := r.Db.ExecCtx(
+		ctx,
+		db.WriteMode,
+		queries.SaveImportJobSQL,
+		job.JobID,
+		job.Status,
+		job.Atomic,
+		job.Total,
+		job.Processed,
+		job.Imported,
+		job.Failed,
+		job.OperatorID,
+		job.Error,
+		job.CreatedAt,
+		job.UpdatedAt,
+		job.FinishedAt,
+		job.InstanceID,
+	)
+	return err
+}
+
+// Load retrieves an import job from the database by the given ID.
+func (r *PGImportJobRepository) Load(ctx context.Context, id uint64) (models.ImportJob, error) {
+	var job models.ImportJob
+	err := pgxscan.Get(ctx, r.Db, &job, queries.LoadImportJobSQL, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ImportJob{}, ErrImportJobNotFound
+		}
+		return models.ImportJob{}, err
+	}
+	return job, nil
+}
+
+// SaveFailures persists the failed orders of a job in a single statement.
+// Failures of different jobs are saved one job at a time.
+func (r *PGImportJobRepository) SaveFailures(ctx context.Context, failures []models.ImportJobFailure) error {
+	byJob := make(map[uint64][]models.ImportJobFailure)
+	for _, f := range failures {
+		byJob[f.JobID] = append(byJob[f.JobID], f)
+	}
+	for jobID, batch := range byJob {
+		items := make([]int32, len(batch))
+		orderIDs := make([]int64, len(batch))
+		userIDs := make([]int64, len(batch))
+		codes := make([]string, len(batch))
+		reasons := make([]string, len(batch))
+		for i, f := range batch {
+			items[i] = int32(f.ItemNumber) // #nosec G115 -- item numbers are bounded by the import request size
+			orderIDs[i] = int64(f.OrderID) // #nosec G115 -- order IDs are stored as bigint everywhere
+			userIDs[i] = int64(f.UserID)   // #nosec G115 -- user IDs are stored as bigint everywhere
+			codes[i] = f.Code
+			reasons[i] = f.Reason
+		}
+		if _, err := r.Db.ExecCtx(ctx, db.WriteMode, queries.SaveImportJobFailuresSQL, jobID, items, orderIDs, userIDs, codes, reasons); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListFailures retrieves the failed orders of a job in the order of the import file.
+func (r *PGImportJobRepository) ListFailures(ctx context.Context, jobID uint64) ([]models.ImportJobFailure, error) {
+	var out []models.ImportJobFailure
+	if err := pgxscan.Select(ctx, r.Db, &out, queries.ListImportJobFailuresSQL, jobID); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FailUnfinished marks queued and running jobs of the instance created before createdBefore as failed,
+// e.g. after they were interrupted by a restart.
+func (r *PGImportJobRepository) FailUnfinished(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time) (int, error) {
+	tag, err := r.Db.ExecCtx(
+		ctx,
+		db.WriteMode,
+		queries.FailUnfinishedImportJobsSQL,
+		models.ImportJobQueued,
+		models.ImportJobRunning,
+		models.ImportJobFailed,
+		reason,
+		at,
+		createdBefore,
+		instanceID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return int(tag.RowsAffected()), nil
+}
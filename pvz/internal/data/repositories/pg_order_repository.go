@@ -17,6 +17,9 @@ var (
 
 	// ErrOrderNotFound represents an error indicating that the requested order could not be found in the database.
 	ErrOrderNotFound = errors.New("order not found")
+
+	// ErrConcurrentModification is returned by Save when the stored order version differs from the one being saved.
+	ErrConcurrentModification = errors.New("order was modified concurrently")
 )
 
 // PGOrderRepository provides PostgreSQL-based persistence for OrderRepository.
@@ -31,9 +34,10 @@ func NewPGOrderRepository(db db.PGXClient) *PGOrderRepository {
 	}
 }
 
-// Save persists the provided order in the database.
-func (r *PGOrderRepository) Save(ctx context.Context, order models.Order) error {
-	_, err := r.Db.ExecCtx(
+// Save persists the provided order in the database if its version matches the stored one and returns the new version.
+func (r *PGOrderRepository) Save(ctx context.Context, order models.Order) (int64, error) {
+	var version int64
+	err := r.Db.QueryRowCtx(
 		ctx,
 		db.WriteMode,
 		queries.SaveOrderSQL,
@@ -46,8 +50,20 @@ func (r *PGOrderRepository) Save(ctx context.Context, order models.Order) error
 		order.Package,
 		order.Weight,
 		order.Price,
-	)
-	return err
+		order.Fragile,
+		order.Hazardous,
+		order.AgeRestricted,
+		order.StoragePolicy,
+		order.Version,
+	).Scan(&version)
+	if err != nil {
+		// the conflicting row was left untouched, so nothing was returned
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrConcurrentModification
+		}
+		return 0, err
+	}
+	return version, nil
 }
 
 // Load retrieves an order from the database by the given ID.
@@ -63,19 +79,20 @@ func (r *PGOrderRepository) Load(ctx context.Context, id uint64) (models.Order,
 	return order, nil
 }
 
-// Delete removes an order from the database identified by its ID.
-func (r *PGOrderRepository) Delete(ctx context.Context, id uint64) error {
+// Delete removes an order from the database identified by its ID if its version matches the stored one.
+func (r *PGOrderRepository) Delete(ctx context.Context, id uint64, version int64) error {
 	res, err := r.Db.ExecCtx(
 		ctx, db.WriteMode,
 		queries.SoftDeleteOrderSQL,
 		id,
+		version,
 	)
 	if err != nil {
 		return err
 	}
 	affected := res.RowsAffected()
 	if affected == 0 {
-		return ErrOrderNotFound
+		return ErrConcurrentModification
 	}
 	return nil
 }
@@ -96,3 +113,12 @@ func (r *PGOrderRepository) List(ctx context.Context, filter requests.OrdersFilt
 	}
 	return orders, total, nil
 }
+
+// Stream reads every order matching the filter row by row, in the filter's sort order and without paging.
+func (r *PGOrderRepository) Stream(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error) error {
+	sqlStr, args := queries.BuildStreamOrdersQuery(filter)
+	if _, err := streamRows(ctx, r.Db, fn, sqlStr, args...); err != nil {
+		return fmt.Errorf("stream orders: %w", err)
+	}
+	return nil
+}
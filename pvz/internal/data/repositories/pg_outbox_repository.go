@@ -21,13 +21,14 @@ func NewPGOutboxRepository(client db.PGXClient) *PGOutboxRepository {
 	}
 }
 
-func (r *PGOutboxRepository) Create(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+func (r *PGOutboxRepository) Create(ctx context.Context, eventID uint64, aggregate models.OutboxAggregate, aggregateID uint64, payload []byte) error {
 	_, err := r.client.ExecCtx(
 		ctx,
 		db.WriteMode,
 		queries.CreateOutboxEventSQL,
 		eventID,
-		orderID,
+		aggregate,
+		aggregateID,
 		payload,
 	)
 	if err != nil {
@@ -68,7 +69,8 @@ func (r *PGOutboxRepository) GetProcessingEvents(ctx context.Context, limit int,
 		var e models.OutboxEvent
 		if err := rows.Scan(
 			&e.EventID,
-			&e.OrderID,
+			&e.AggregateType,
+			&e.AggregateID,
 			&e.Payload,
 			&e.Status,
 			&e.Error,
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"pvz-cli/internal/infrastructure/db"
+)
+
+// streamRows runs a read query and scans its rows one by one into fn, so the result set is never held in memory;
+// it returns the number of rows passed to fn.
+func streamRows[T any](ctx context.Context, client db.PGXClient, fn func(T) error, query string, args ...interface{}) (int, error) {
+	rows, err := client.QueryCtx(ctx, db.ReadMode, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	scanner := pgxscan.NewRowScanner(rows)
+	n := 0
+	for rows.Next() {
+		var item T
+		if err := scanner.Scan(&item); err != nil {
+			return n, err
+		}
+		if err := fn(item); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, rows.Err()
+}
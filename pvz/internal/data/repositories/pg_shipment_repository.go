@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"pvz-cli/internal/data/queries"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+)
+
+var _ ShipmentRepository = (*PGShipmentRepository)(nil)
+
+// PGShipmentRepository provides PostgreSQL-based persistence for ShipmentRepository.
+type PGShipmentRepository struct {
+	Db db.PGXClient
+}
+
+// NewPGShipmentRepository initializes and returns a new instance of PGShipmentRepository with the provided database client.
+func NewPGShipmentRepository(db db.PGXClient) *PGShipmentRepository {
+	return &PGShipmentRepository{
+		Db: db,
+	}
+}
+
+// Save persists the provided shipment in the database.
+func (r *PGShipmentRepository) Save(ctx context.Context, s models.Shipment) error {
+	_, err := r.Db.ExecCtx(
+		ctx,
+		db.WriteMode,
+		queries.SaveShipmentSQL,
+		s.ShipmentID,
+		s.SenderID,
+		s.DestinationPointID,
+		s.Status,
+		s.CourierID,
+		s.CreatedAt,
+		s.UpdatedStatusAt,
+		s.Package,
+		s.Weight,
+		s.Price,
+		s.Fragile,
+		s.Hazardous,
+		s.AgeRestricted,
+	)
+	return err
+}
+
+// Load retrieves a shipment from the database by the given ID.
+func (r *PGShipmentRepository) Load(ctx context.Context, id uint64) (models.Shipment, error) {
+	var s models.Shipment
+	err := pgxscan.Get(ctx, r.Db, &s, queries.LoadShipmentSQL, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Shipment{}, ErrShipmentNotFound
+		}
+		return models.Shipment{}, err
+	}
+	return s, nil
+}
+
+// SaveHistory persists a shipment status change into the database.
+func (r *PGShipmentRepository) SaveHistory(ctx context.Context, e models.ShipmentHistoryEntry) error {
+	_, err := r.Db.ExecCtx(
+		ctx,
+		db.WriteMode,
+		queries.SaveShipmentHistoryEntrySQL,
+		e.ShipmentID,
+		e.Status,
+		e.Timestamp,
+	)
+	return err
+}
+
+// ListHistory retrieves the status history of a shipment in chronological order.
+func (r *PGShipmentRepository) ListHistory(ctx context.Context, shipmentID uint64) ([]models.ShipmentHistoryEntry, error) {
+	var out []models.ShipmentHistoryEntry
+	if err := pgxscan.Select(ctx, r.Db, &out, queries.ListShipmentHistorySQL, shipmentID); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"pvz-cli/internal/data/queries"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"time"
+)
+
+var _ UserDataRepository = (*PGUserDataRepository)(nil)
+
+// PGUserDataRepository provides PostgreSQL-based persistence for UserDataRepository.
+type PGUserDataRepository struct {
+	Db db.PGXClient
+}
+
+// NewPGUserDataRepository initializes and returns a new instance of PGUserDataRepository with the provided database client.
+func NewPGUserDataRepository(db db.PGXClient) *PGUserDataRepository {
+	return &PGUserDataRepository{
+		Db: db,
+	}
+}
+
+// Export reads every record related to the user from live, archive and outbox tables.
+func (r *PGUserDataRepository) Export(ctx context.Context, userID uint64) (models.UserDataExport, error) {
+	out := models.UserDataExport{
+		UserID:             userID,
+		Orders:             []models.Order{},
+		ArchivedOrders:     []models.ArchivedOrder{},
+		History:            []models.HistoryEntry{},
+		OutboxEvents:       []models.UserDataOutboxRecord{},
+		Shipments:          []models.Shipment{},
+		ShipmentHistory:    []models.ShipmentHistoryEntry{},
+		IdempotencyRecords: []models.IdempotencyRecord{},
+		ImportJobFailures:  []models.ImportJobFailure{},
+	}
+	if err := pgxscan.Select(ctx, r.Db, &out.Orders, queries.SelectUserOrdersSQL, userID); err != nil {
+		return models.UserDataExport{}, err
+	}
+	var archived []archivedOrderRow
+	if err := pgxscan.Select(ctx, r.Db, &archived, queries.SelectUserArchivedOrdersSQL, userID); err != nil {
+		return models.UserDataExport{}, err
+	}
+	for _, a := range archived {
+		out.ArchivedOrders = append(out.ArchivedOrders, models.ArchivedOrder{Order: a.Order, ArchivedAt: a.ArchivedAt})
+	}
+	if err := pgxscan.Select(ctx, r.Db, &out.History, queries.SelectUserHistorySQL, userID); err != nil {
+		return models.UserDataExport{}, err
+	}
+	if err := pgxscan.Select(ctx, r.Db, &out.OutboxEvents, queries.SelectUserOutboxSQL, userID); err != nil {
+		return models.UserDataExport{}, err
+	}
+	if err := pgxscan.Select(ctx, r.Db, &out.Shipments, queries.SelectUserShipmentsSQL, userID); err != nil {
+		return models.UserDataExport{}, err
+	}
+	if err := pgxscan.Select(ctx, r.Db, &out.ShipmentHistory, queries.SelectUserShipmentHistorySQL, userID); err != nil {
+		return models.UserDataExport{}, err
+	}
+	if err := pgxscan.Select(ctx, r.Db, &out.IdempotencyRecords, queries.SelectUserIdempotencyRecordsSQL, userID); err != nil {
+		return models.UserDataExport{}, err
+	}
+	if err := pgxscan.Select(ctx, r.Db, &out.ImportJobFailures, queries.SelectUserImportJobFailuresSQL, userID); err != nil {
+		return models.UserDataExport{}, err
+	}
+	return out, nil
+}
+
+// Anonymize replaces the user's identifier in a single statement so either every table is updated or none.
+func (r *PGUserDataRepository) Anonymize(ctx context.Context, userID uint64) (models.AnonymizeResult, error) {
+	var counts struct {
+		Orders            int `db:"orders"`
+		ArchivedOrders    int `db:"archived_orders"`
+		OutboxEvents      int `db:"outbox_events"`
+		Shipments         int `db:"shipments"`
+		ImportJobFailures int `db:"import_job_failures"`
+		IdempotencyKeys   int `db:"idempotency_keys"`
+	}
+	// the check reads from the primary, which the anonymization below writes to
+	rows, err := r.Db.QueryCtx(ctx, db.WriteMode, queries.SelectUserActiveOrderSQL, userID, models.Accepted, models.Returned)
+	if err != nil {
+		return models.AnonymizeResult{}, err
+	}
+	var active []uint64
+	if err := pgxscan.ScanAll(&active, rows); err != nil {
+		return models.AnonymizeResult{}, err
+	}
+	if len(active) > 0 {
+		return models.AnonymizeResult{}, ErrUserHasActiveOrders
+	}
+	rows, err = r.Db.QueryCtx(ctx, db.WriteMode, queries.AnonymizeUserSQL, userID, models.AnonymousUserID,
+		models.Accepted, models.Returned)
+	if err != nil {
+		return models.AnonymizeResult{}, err
+	}
+	if err := pgxscan.ScanOne(&counts, rows); err != nil {
+		return models.AnonymizeResult{}, err
+	}
+	return models.AnonymizeResult{
+		UserID:            userID,
+		Orders:            counts.Orders,
+		ArchivedOrders:    counts.ArchivedOrders,
+		OutboxEvents:      counts.OutboxEvents,
+		Shipments:         counts.Shipments,
+		ImportJobFailures: counts.ImportJobFailures,
+		IdempotencyKeys:   counts.IdempotencyKeys,
+	}, nil
+}
+
+type archivedOrderRow struct {
+	models.Order
+	ArchivedAt time.Time `db:"archived_at"`
+}
@@ -0,0 +1,19 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package repositories
+
+import (
+	"context"
+	"errors"
+	"pvz-cli/internal/models"
+)
+
+// ErrShipmentNotFound represents an error indicating that the requested shipment could not be found.
+var ErrShipmentNotFound = errors.New("shipment not found")
+
+// ShipmentRepository handles persistence operations for outbound shipments and their status history
+type ShipmentRepository interface {
+	Save(ctx context.Context, s models.Shipment) error
+	Load(ctx context.Context, id uint64) (models.Shipment, error)
+	SaveHistory(ctx context.Context, e models.ShipmentHistoryEntry) error
+	ListHistory(ctx context.Context, shipmentID uint64) ([]models.ShipmentHistoryEntry, error)
+}
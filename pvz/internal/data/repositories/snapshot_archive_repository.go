@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/data"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"sort"
+	"time"
+)
+
+var _ ArchiveRepository = (*SnapshotArchiveRepository)(nil)
+
+// SnapshotArchiveRepository is an implementation of the ArchiveRepository interface that uses snapshot storage.
+type SnapshotArchiveRepository struct {
+	storage storage.Storage
+}
+
+// NewSnapshotArchiveRepository creates a new instance of SnapshotArchiveRepository
+func NewSnapshotArchiveRepository(s storage.Storage) *SnapshotArchiveRepository {
+	return &SnapshotArchiveRepository{storage: s}
+}
+
+// ArchiveOrders moves issued orders, and the history of orders already returned to the warehouse,
+// whose last transition happened before olderThan into the snapshot archive
+func (r *SnapshotArchiveRepository) ArchiveOrders(ctx context.Context, olderThan, archivedAt time.Time, limit int) (int, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	archived := 0
+	err := r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		live := make(map[uint64]struct{}, len(snap.Orders))
+		for _, o := range snap.Orders {
+			live[o.OrderID] = struct{}{}
+		}
+		lastChange := make(map[uint64]time.Time)
+		for _, h := range snap.History {
+			if h.Timestamp.After(lastChange[h.OrderID]) {
+				lastChange[h.OrderID] = h.Timestamp
+			}
+		}
+
+		// Orders removed from the snapshot have already left the pickup point; their history is archived
+		// together with the deleted order record, if one was kept
+		var candidates []uint64
+		for _, o := range snap.Orders {
+			if o.Status == models.Issued && o.UpdatedStatusAt.Before(olderThan) {
+				candidates = append(candidates, o.OrderID)
+			}
+		}
+		for id, ts := range lastChange {
+			if _, ok := live[id]; !ok && ts.Before(olderThan) {
+				candidates = append(candidates, id)
+			}
+		}
+		sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+		if limit > 0 && len(candidates) > limit {
+			candidates = candidates[:limit]
+		}
+		if len(candidates) == 0 {
+			return storage.ErrUnchanged
+		}
+
+		moving := make(map[uint64]struct{}, len(candidates))
+		for _, id := range candidates {
+			moving[id] = struct{}{}
+		}
+		orders := make([]models.Order, 0, len(snap.Orders))
+		for _, o := range snap.Orders {
+			if _, ok := moving[o.OrderID]; ok {
+				snap.ArchivedOrders = append(snap.ArchivedOrders, models.ArchivedOrder{Order: o, ArchivedAt: archivedAt})
+				continue
+			}
+			orders = append(orders, o)
+		}
+		history := make([]models.HistoryEntry, 0, len(snap.History))
+		for _, h := range snap.History {
+			if _, ok := moving[h.OrderID]; ok {
+				snap.ArchivedHistory = append(snap.ArchivedHistory, models.ArchivedHistoryEntry{HistoryEntry: h, ArchivedAt: archivedAt})
+				continue
+			}
+			history = append(history, h)
+		}
+		deleted := make([]models.Order, 0, len(snap.DeletedOrders))
+		for _, o := range snap.DeletedOrders {
+			if _, ok := moving[o.OrderID]; ok {
+				snap.ArchivedOrders = append(snap.ArchivedOrders, models.ArchivedOrder{Order: o, ArchivedAt: archivedAt})
+				continue
+			}
+			deleted = append(deleted, o)
+		}
+		snap.Orders = orders
+		snap.DeletedOrders = deleted
+		snap.History = history
+		archived = len(candidates)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return archived, nil
+}
+
+// PurgeArchive removes archived orders and history archived before archivedBefore
+func (r *SnapshotArchiveRepository) PurgeArchive(ctx context.Context, archivedBefore time.Time) (int, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	purged := make(map[uint64]struct{})
+	err := r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		orders := make([]models.ArchivedOrder, 0, len(snap.ArchivedOrders))
+		for _, o := range snap.ArchivedOrders {
+			if o.ArchivedAt.Before(archivedBefore) {
+				purged[o.OrderID] = struct{}{}
+				continue
+			}
+			orders = append(orders, o)
+		}
+		history := make([]models.ArchivedHistoryEntry, 0, len(snap.ArchivedHistory))
+		for _, h := range snap.ArchivedHistory {
+			if h.ArchivedAt.Before(archivedBefore) {
+				purged[h.OrderID] = struct{}{}
+				continue
+			}
+			history = append(history, h)
+		}
+		if len(purged) == 0 {
+			return storage.ErrUnchanged
+		}
+		snap.ArchivedOrders = orders
+		snap.ArchivedHistory = history
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(purged), nil
+}
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/data"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+)
+
+var _ CalendarRepository = (*SnapshotCalendarRepository)(nil)
+
+// SnapshotCalendarRepository is an implementation of the CalendarRepository interface that uses snapshot storage.
+type SnapshotCalendarRepository struct {
+	storage storage.Storage
+}
+
+// NewSnapshotCalendarRepository creates a new instance of SnapshotCalendarRepository
+func NewSnapshotCalendarRepository(s storage.Storage) *SnapshotCalendarRepository {
+	return &SnapshotCalendarRepository{storage: s}
+}
+
+// Get retrieves the pickup-point calendar, returning an empty (always open) calendar if none is configured
+func (r *SnapshotCalendarRepository) Get(ctx context.Context) (models.PickupCalendar, error) {
+	if ctx.Err() != nil {
+		return models.PickupCalendar{}, ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return models.PickupCalendar{}, err
+	}
+	if snap.Calendar == nil {
+		return models.PickupCalendar{}, nil
+	}
+	return *snap.Calendar, nil
+}
+
+// Save stores the pickup-point calendar in the repository
+func (r *SnapshotCalendarRepository) Save(ctx context.Context, cal models.PickupCalendar) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		snap.Calendar = &cal
+		return nil
+	})
+}
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"cmp"
+	"context"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"slices"
+)
+
+var _ ConsistencyRepository = (*SnapshotConsistencyRepository)(nil)
+
+// SnapshotConsistencyRepository is an implementation of the ConsistencyRepository interface that uses snapshot storage.
+// The snapshot mode removes orders instead of flagging them and has no outbox, so records carry no removed rows
+// and no outbox events.
+type SnapshotConsistencyRepository struct {
+	storage storage.Storage
+}
+
+// NewSnapshotConsistencyRepository creates a new instance of SnapshotConsistencyRepository
+func NewSnapshotConsistencyRepository(s storage.Storage) *SnapshotConsistencyRepository {
+	return &SnapshotConsistencyRepository{storage: s}
+}
+
+// Scan groups the live history by order; orders that only have history left get a record without a row
+func (r *SnapshotConsistencyRepository) Scan(ctx context.Context, fn func(models.OrderRecord) error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	records := make(map[uint64]*models.OrderRecord, len(snap.Orders))
+	for _, o := range snap.Orders {
+		order := o
+		records[o.OrderID] = &models.OrderRecord{OrderID: o.OrderID, Order: &order}
+	}
+	for _, h := range snap.History {
+		rec, ok := records[h.OrderID]
+		if !ok {
+			rec = &models.OrderRecord{OrderID: h.OrderID}
+			records[h.OrderID] = rec
+		}
+		rec.History = append(rec.History, h)
+	}
+
+	ids := make([]uint64, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		rec := records[id]
+		slices.SortStableFunc(rec.History, func(a, b models.HistoryEntry) int {
+			if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.ID, b.ID)
+		})
+		if err := fn(*rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
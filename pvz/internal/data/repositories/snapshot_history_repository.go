@@ -1,10 +1,13 @@
 package repositories
 
 import (
+	"cmp"
 	"context"
+	"pvz-cli/internal/data"
 	"pvz-cli/internal/data/storage"
 	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
+	"slices"
 	"sort"
 )
 
@@ -25,16 +28,21 @@ func (r *SnapshotHistoryRepository) Save(ctx context.Context, e models.HistoryEn
 	if ctx.Err() != nil {
 		return ctx.Err()
 	}
-	snap, err := r.storage.Load(ctx)
-	if err != nil {
-		return err
-	}
-
-	snap.History = append(snap.History, e)
-	return r.storage.Save(ctx, snap)
+	return r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		var maxID uint64
+		for _, h := range snap.History {
+			maxID = max(maxID, h.ID)
+		}
+		for _, h := range snap.ArchivedHistory {
+			maxID = max(maxID, h.ID)
+		}
+		e.ID = maxID + 1
+		snap.History = append(snap.History, e)
+		return nil
+	})
 }
 
-// List retrieves filtered and paginated history entries (by order ID or all)
+// List retrieves filtered and paginated history entries; a single archived order is read from the archive
 func (r *SnapshotHistoryRepository) List(ctx context.Context, filter requests.OrderHistoryFilter) ([]models.HistoryEntry, int, error) {
 	if ctx.Err() != nil {
 		return nil, 0, ctx.Err()
@@ -43,26 +51,121 @@ func (r *SnapshotHistoryRepository) List(ctx context.Context, filter requests.Or
 	if err != nil {
 		return nil, 0, err
 	}
-	var filtered []models.HistoryEntry
-	if filter.OrderID != nil {
-		for _, h := range snap.History {
-			if h.OrderID == *filter.OrderID {
-				filtered = append(filtered, h)
-			}
-		}
-	} else {
-		filtered = snap.History
-	}
-	sort.Slice(filtered, func(i, j int) bool {
-		return filtered[i].Timestamp.Before(filtered[j].Timestamp)
-	})
+	filtered := matchingHistory(snap, filter)
+	total := len(filtered)
 	start := (filter.Page - 1) * filter.Limit
+	if c := filter.After; c != nil {
+		start = sort.Search(len(filtered), func(i int) bool {
+			return historyAfter(filtered[i], *c, filter.Asc)
+		})
+	}
 	if start >= len(filtered) {
-		return []models.HistoryEntry{}, 0, nil
+		return []models.HistoryEntry{}, total, nil
 	}
 	end := start + filter.Limit
 	if end > len(filtered) {
 		end = len(filtered)
 	}
-	return filtered[start:end], len(filtered), nil
+	return filtered[start:end], total, nil
+}
+
+// Stream passes every entry matching the filter to fn in the listing order of the filter and without paging
+func (r *SnapshotHistoryRepository) Stream(ctx context.Context, filter requests.OrderHistoryFilter, fn func(models.HistoryEntry) error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return err
+	}
+	for _, h := range matchingHistory(snap, filter) {
+		if err := fn(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchingHistory returns the entries matching the filter, newest first or oldest first when ascending,
+// as queries.BuildFilterHistoryQuery does; an order without live entries is looked up in the archive
+func matchingHistory(snap *data.Snapshot, filter requests.OrderHistoryFilter) []models.HistoryEntry {
+	source := snap.History
+	// archived history belongs to archived orders, live history to live and deleted ones
+	owners := make(map[uint64]uint64, len(snap.Orders)+len(snap.DeletedOrders))
+	for _, o := range snap.DeletedOrders {
+		owners[o.OrderID] = o.UserID
+	}
+	for _, o := range snap.Orders {
+		owners[o.OrderID] = o.UserID
+	}
+	if filter.OrderID != nil && !slices.ContainsFunc(snap.History, func(h models.HistoryEntry) bool {
+		return h.OrderID == *filter.OrderID
+	}) {
+		source = make([]models.HistoryEntry, 0, len(snap.ArchivedHistory))
+		for _, h := range snap.ArchivedHistory {
+			source = append(source, h.HistoryEntry)
+		}
+		owners = make(map[uint64]uint64, len(snap.ArchivedOrders))
+		for _, o := range snap.ArchivedOrders {
+			owners[o.OrderID] = o.UserID
+		}
+	}
+
+	var filtered []models.HistoryEntry
+	for _, h := range source {
+		if historyMatches(h, filter, owners) {
+			filtered = append(filtered, h)
+		}
+	}
+	// the ID is a tie-breaker for equal timestamps
+	sort.SliceStable(filtered, func(i, j int) bool {
+		ord := filtered[i].Timestamp.Compare(filtered[j].Timestamp)
+		if ord == 0 {
+			ord = cmp.Compare(filtered[i].ID, filtered[j].ID)
+		}
+		if filter.Asc {
+			return ord < 0
+		}
+		return ord > 0
+	})
+	return filtered
+}
+
+func historyMatches(h models.HistoryEntry, filter requests.OrderHistoryFilter, owners map[uint64]uint64) bool {
+	if filter.OrderID != nil && h.OrderID != *filter.OrderID {
+		return false
+	}
+	if filter.UserID != nil {
+		if userID, ok := owners[h.OrderID]; !ok || userID != *filter.UserID {
+			return false
+		}
+	}
+	if len(filter.Events) > 0 && !slices.Contains(filter.Events, h.Event) {
+		return false
+	}
+	if filter.From != nil && h.Timestamp.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && !h.Timestamp.Before(*filter.To) {
+		return false
+	}
+	if filter.ActorType != nil && h.ActorType != *filter.ActorType {
+		return false
+	}
+	if filter.ActorID != nil && h.ActorID != *filter.ActorID {
+		return false
+	}
+	return true
+}
+
+// historyAfter reports whether h is listed past the cursor c
+func historyAfter(h models.HistoryEntry, c requests.HistoryCursor, asc bool) bool {
+	ord := h.Timestamp.Compare(c.At)
+	if ord == 0 {
+		ord = cmp.Compare(h.ID, c.ID)
+	}
+	if asc {
+		return ord > 0
+	}
+	return ord < 0
 }
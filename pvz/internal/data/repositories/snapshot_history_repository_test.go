@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"path/filepath"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSnapshotHistoryRepository_DeletedOrderOwner verifies that the user filter still finds the history of an order
+// removed from the pickup point, both before and after it is archived.
+func TestSnapshotHistoryRepository_DeletedOrderOwner(t *testing.T) {
+	t.Parallel()
+	s := storage.NewJSONStorage(filepath.Join(t.TempDir(), "storage.json"))
+	orders := NewSnapshotOrderRepository(s)
+	history := NewSnapshotHistoryRepository(s)
+	ctx := context.Background()
+	at := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	byUser := requests.OrderHistoryFilter{UserID: utils.Ptr(uint64(42)), Page: 1, Limit: 10}
+
+	version, err := orders.Save(ctx, models.Order{OrderID: 1, UserID: 42, Status: models.ReturnedToWarehouse})
+	require.NoError(t, err)
+	require.NoError(t, history.Save(ctx, models.HistoryEntry{OrderID: 1, Event: models.EventReturnedToWarehouse, Timestamp: at}))
+	require.NoError(t, orders.Delete(ctx, 1, version))
+
+	entries, total, err := history.List(ctx, byUser)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, uint64(1), entries[0].OrderID)
+
+	archived, err := NewSnapshotArchiveRepository(s).ArchiveOrders(ctx, at.Add(time.Hour), at.Add(time.Hour), 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, archived)
+
+	byUser.OrderID = utils.Ptr(uint64(1))
+	entries, total, err = history.List(ctx, byUser)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, models.EventReturnedToWarehouse, entries[0].Event)
+}
+
+// TestSnapshotHistoryRepository_NarrowedFilterOfLiveOrder verifies that an order with live history is never read
+// from the archive, even when the other filters match none of its live entries.
+func TestSnapshotHistoryRepository_NarrowedFilterOfLiveOrder(t *testing.T) {
+	t.Parallel()
+	s := storage.NewJSONStorage(filepath.Join(t.TempDir(), "storage.json"))
+	history := NewSnapshotHistoryRepository(s)
+	ctx := context.Background()
+	at := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, s.Update(ctx, func(snap *data.Snapshot) error {
+		snap.Orders = []models.Order{{OrderID: 1, UserID: 43, Status: models.Accepted}}
+		snap.History = []models.HistoryEntry{{ID: 2, OrderID: 1, Event: models.EventAccepted, Timestamp: at}}
+		snap.ArchivedOrders = []models.ArchivedOrder{{Order: models.Order{OrderID: 1, UserID: 42, Status: models.Issued}}}
+		snap.ArchivedHistory = []models.ArchivedHistoryEntry{
+			{HistoryEntry: models.HistoryEntry{ID: 1, OrderID: 1, Event: models.EventIssued, Timestamp: at.Add(-time.Hour)}},
+		}
+		return nil
+	}))
+
+	entries, total, err := history.List(ctx, requests.OrderHistoryFilter{
+		OrderID: utils.Ptr(uint64(1)),
+		Events:  []models.EventType{models.EventIssued},
+		Page:    1,
+		Limit:   10,
+	})
+	require.NoError(t, err)
+	require.Zero(t, total)
+	require.Empty(t, entries)
+}
@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/data"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"time"
+)
+
+var _ IdempotencyRepository = (*SnapshotIdempotencyRepository)(nil)
+
+// SnapshotIdempotencyRepository is an implementation of the IdempotencyRepository interface that uses snapshot storage.
+type SnapshotIdempotencyRepository struct {
+	storage storage.Storage
+}
+
+// NewSnapshotIdempotencyRepository creates a new instance of SnapshotIdempotencyRepository
+func NewSnapshotIdempotencyRepository(s storage.Storage) *SnapshotIdempotencyRepository {
+	return &SnapshotIdempotencyRepository{storage: s}
+}
+
+// Reserve appends a pending record or replaces an expired one, unless the key is taken
+func (r *SnapshotIdempotencyRepository) Reserve(
+	ctx context.Context,
+	rec models.IdempotencyRecord,
+	expiredBefore time.Time,
+) (models.IdempotencyRecord, bool, error) {
+	if ctx.Err() != nil {
+		return models.IdempotencyRecord{}, false, ctx.Err()
+	}
+	rec.Response, rec.UserIDs = nil, nil
+	stored, reserved := rec, true
+	err := r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		i := findIdempotencyRecord(snap, rec.Scope, rec.Key)
+		switch {
+		case i < 0:
+			snap.IdempotencyKeys = append(snap.IdempotencyKeys, rec)
+		case snap.IdempotencyKeys[i].CreatedAt.Before(expiredBefore):
+			snap.IdempotencyKeys[i] = rec
+		default:
+			stored, reserved = snap.IdempotencyKeys[i], false
+			return storage.ErrUnchanged
+		}
+		return nil
+	})
+	if err != nil {
+		return models.IdempotencyRecord{}, false, err
+	}
+	return stored, reserved, nil
+}
+
+// Complete stores the response of the pending record for the key and the clients it mentions
+func (r *SnapshotIdempotencyRepository) Complete(ctx context.Context, scope, key string, response []byte, userIDs []uint64) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		i := findIdempotencyRecord(snap, scope, key)
+		if i < 0 || !snap.IdempotencyKeys[i].Pending() {
+			return storage.ErrUnchanged
+		}
+		snap.IdempotencyKeys[i].Response = response
+		snap.IdempotencyKeys[i].UserIDs = userIDs
+		return nil
+	})
+}
+
+// Release removes the pending record for the key
+func (r *SnapshotIdempotencyRepository) Release(ctx context.Context, scope, key string) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		i := findIdempotencyRecord(snap, scope, key)
+		if i < 0 || !snap.IdempotencyKeys[i].Pending() {
+			return storage.ErrUnchanged
+		}
+		snap.IdempotencyKeys = append(snap.IdempotencyKeys[:i], snap.IdempotencyKeys[i+1:]...)
+		return nil
+	})
+}
+
+// Load retrieves the record stored for the key
+func (r *SnapshotIdempotencyRepository) Load(ctx context.Context, scope, key string) (models.IdempotencyRecord, error) {
+	if ctx.Err() != nil {
+		return models.IdempotencyRecord{}, ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return models.IdempotencyRecord{}, err
+	}
+	if i := findIdempotencyRecord(snap, scope, key); i >= 0 {
+		return snap.IdempotencyKeys[i], nil
+	}
+	return models.IdempotencyRecord{}, ErrIdempotencyKeyNotFound
+}
+
+// Purge drops the records created before createdBefore
+func (r *SnapshotIdempotencyRepository) Purge(ctx context.Context, createdBefore time.Time) (int, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	purged := 0
+	err := r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		kept := snap.IdempotencyKeys[:0]
+		for _, rec := range snap.IdempotencyKeys {
+			if rec.CreatedAt.Before(createdBefore) {
+				purged++
+				continue
+			}
+			kept = append(kept, rec)
+		}
+		if purged == 0 {
+			return storage.ErrUnchanged
+		}
+		snap.IdempotencyKeys = kept
+		return nil
+	})
+	return purged, err
+}
+
+// findIdempotencyRecord returns the index of the record for the key in the snapshot, or -1
+func findIdempotencyRecord(snap *data.Snapshot, scope, key string) int {
+	for i, rec := range snap.IdempotencyKeys {
+		if rec.Scope == scope && rec.Key == key {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+	"path/filepath"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSnapshotIdempotencyRepository_Lifecycle covers reserving, completing, releasing and purging keys.
+func TestSnapshotIdempotencyRepository_Lifecycle(t *testing.T) {
+	t.Parallel()
+	repo := NewSnapshotIdempotencyRepository(storage.NewJSONStorage(filepath.Join(t.TempDir(), "storage.json")))
+	ctx := context.Background()
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	rec := models.IdempotencyRecord{Scope: "op-1", Key: "k1", Method: "AcceptOrder", RequestHash: "h1", CreatedAt: now}
+
+	stored, reserved, err := repo.Reserve(ctx, rec, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.True(t, stored.Pending())
+
+	retry := rec
+	retry.RequestHash = "h2"
+	stored, reserved, err = repo.Reserve(ctx, retry, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.False(t, reserved)
+	require.Equal(t, "h1", stored.RequestHash)
+	require.True(t, stored.Pending())
+
+	other := rec
+	other.Scope = "op-2"
+	_, reserved, err = repo.Reserve(ctx, other, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.True(t, reserved, "the same key of another operator is free")
+
+	require.NoError(t, repo.Release(ctx, "op-2", "k1"))
+	_, err = repo.Load(ctx, "op-2", "k1")
+	require.ErrorIs(t, err, ErrIdempotencyKeyNotFound)
+
+	require.NoError(t, repo.Complete(ctx, "op-1", "k1", []byte("response"), []uint64{7}))
+	require.NoError(t, repo.Release(ctx, "op-1", "k1"), "a completed record is not released")
+	loaded, err := repo.Load(ctx, "op-1", "k1")
+	require.NoError(t, err)
+	require.False(t, loaded.Pending())
+	require.Equal(t, []byte("response"), loaded.Response)
+
+	later := retry
+	later.CreatedAt = now.Add(2 * time.Hour)
+	stored, reserved, err = repo.Reserve(ctx, later, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, reserved, "an expired record is replaced")
+	require.Equal(t, "h2", stored.RequestHash)
+
+	old := models.IdempotencyRecord{Scope: "op-1", Key: "k2", Method: "AcceptOrder", RequestHash: "h3", CreatedAt: now}
+	_, _, err = repo.Reserve(ctx, old, now.Add(-time.Hour))
+	require.NoError(t, err)
+	purged, err := repo.Purge(ctx, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+	_, err = repo.Load(ctx, "op-1", "k2")
+	require.ErrorIs(t, err, ErrIdempotencyKeyNotFound)
+	_, err = repo.Load(ctx, "op-1", "k1")
+	require.NoError(t, err)
+}
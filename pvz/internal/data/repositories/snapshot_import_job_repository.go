@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/data"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"sort"
+	"time"
+)
+
+var _ ImportJobRepository = (*SnapshotImportJobRepository)(nil)
+
+// SnapshotImportJobRepository is an implementation of the ImportJobRepository interface that uses snapshot storage.
+type SnapshotImportJobRepository struct {
+	storage storage.Storage
+}
+
+// NewSnapshotImportJobRepository creates a new instance of SnapshotImportJobRepository
+func NewSnapshotImportJobRepository(s storage.Storage) *SnapshotImportJobRepository {
+	return &SnapshotImportJobRepository{storage: s}
+}
+
+// Save stores or updates an import job in the repository
+func (r *SnapshotImportJobRepository) Save(ctx context.Context, job models.ImportJob) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		for i, existing := range snap.ImportJobs {
+			if existing.JobID == job.JobID {
+				snap.ImportJobs[i] = job
+				return nil
+			}
+		}
+		snap.ImportJobs = append(snap.ImportJobs, job)
+		return nil
+	})
+}
+
+// Load retrieves an import job by its ID
+func (r *SnapshotImportJobRepository) Load(ctx context.Context, id uint64) (models.ImportJob, error) {
+	if ctx.Err() != nil {
+		return models.ImportJob{}, ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return models.ImportJob{}, err
+	}
+	for _, job := range snap.ImportJobs {
+		if job.JobID == id {
+			return job, nil
+		}
+	}
+	return models.ImportJob{}, ErrImportJobNotFound
+}
+
+// SaveFailures stores the failed orders of import jobs, keeping already recorded items
+func (r *SnapshotImportJobRepository) SaveFailures(ctx context.Context, failures []models.ImportJobFailure) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	type item struct {
+		jobID  uint64
+		number int
+	}
+	return r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		known := make(map[item]struct{}, len(snap.ImportJobFailures))
+		for _, f := range snap.ImportJobFailures {
+			known[item{f.JobID, f.ItemNumber}] = struct{}{}
+		}
+		for _, f := range failures {
+			if _, ok := known[item{f.JobID, f.ItemNumber}]; ok {
+				continue
+			}
+			known[item{f.JobID, f.ItemNumber}] = struct{}{}
+			snap.ImportJobFailures = append(snap.ImportJobFailures, f)
+		}
+		return nil
+	})
+}
+
+// ListFailures retrieves the failed orders of an import job in the order of the import file
+func (r *SnapshotImportJobRepository) ListFailures(ctx context.Context, jobID uint64) ([]models.ImportJobFailure, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []models.ImportJobFailure
+	for _, f := range snap.ImportJobFailures {
+		if f.JobID == jobID {
+			out = append(out, f)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ItemNumber < out[j].ItemNumber
+	})
+	return out, nil
+}
+
+// FailUnfinished marks queued and running jobs of the instance created before createdBefore as failed
+func (r *SnapshotImportJobRepository) FailUnfinished(ctx context.Context, instanceID string, createdBefore time.Time, reason string, at time.Time) (int, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	n := 0
+	err := r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		for i, job := range snap.ImportJobs {
+			if job.InstanceID != instanceID || job.Status.Finished() || !job.CreatedAt.Before(createdBefore) {
+				continue
+			}
+			finishedAt := at
+			snap.ImportJobs[i].Status = models.ImportJobFailed
+			snap.ImportJobs[i].Error = reason
+			snap.ImportJobs[i].UpdatedAt = at
+			snap.ImportJobs[i].FinishedAt = &finishedAt
+			n++
+		}
+		if n == 0 {
+			return storage.ErrUnchanged
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"pvz-cli/internal/common/constants"
+	"slices"
 	"sort"
 	"time"
 
+	"pvz-cli/internal/data"
 	"pvz-cli/internal/data/storage"
 	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
@@ -24,30 +26,38 @@ func NewSnapshotOrderRepository(s storage.Storage) *SnapshotOrderRepository {
 	return &SnapshotOrderRepository{storage: s}
 }
 
-// Save stores or updates an order in the repository
-func (r *SnapshotOrderRepository) Save(ctx context.Context, order models.Order) error {
+// Save stores or updates an order in the repository and returns the version it was stored with.
+// The version check and the write happen under one storage lock, so concurrent saves cannot both pass the check.
+func (r *SnapshotOrderRepository) Save(ctx context.Context, order models.Order) (int64, error) {
 	if ctx.Err() != nil {
-		return ctx.Err()
+		return 0, ctx.Err()
 	}
-	snap, err := r.storage.Load(ctx)
-	if err != nil {
-		return err
-	}
-
-	found := false
-	for i, o := range snap.Orders {
-		if o.OrderID == order.OrderID {
-			snap.Orders[i] = order
-			found = true
-			break
+	err := r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		for i, o := range snap.Orders {
+			if o.OrderID == order.OrderID {
+				if o.Version != order.Version {
+					return ErrConcurrentModification
+				}
+				order.Version++
+				snap.Orders[i] = order
+				return nil
+			}
 		}
-	}
-
-	if !found {
+		// an order that is gone may only be stored again by a new acceptance
+		if order.Version != 0 {
+			return ErrConcurrentModification
+		}
+		order.Version++
 		snap.Orders = append(snap.Orders, order)
+		snap.DeletedOrders = slices.DeleteFunc(snap.DeletedOrders, func(o models.Order) bool {
+			return o.OrderID == order.OrderID
+		})
+		return nil
+	})
+	if err != nil {
+		return 0, err
 	}
-
-	return r.storage.Save(ctx, snap)
+	return order.Version, nil
 }
 
 // Load retrieves an order by its ID
@@ -68,25 +78,34 @@ func (r *SnapshotOrderRepository) Load(ctx context.Context, id uint64) (models.O
 	return models.Order{}, errors.New("order not found")
 }
 
-// Delete removes an order from the repository
-func (r *SnapshotOrderRepository) Delete(ctx context.Context, id uint64) error {
+// Delete removes an order from the repository if its version matches the stored one
+func (r *SnapshotOrderRepository) Delete(ctx context.Context, id uint64, version int64) error {
 	if ctx.Err() != nil {
 		return ctx.Err()
 	}
-	snap, err := r.storage.Load(ctx)
-	if err != nil {
-		return err
-	}
-
-	filtered := make([]models.Order, 0, len(snap.Orders))
-	for _, o := range snap.Orders {
-		if o.OrderID != id {
-			filtered = append(filtered, o)
+	return r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		found := false
+		filtered := make([]models.Order, 0, len(snap.Orders))
+		for _, o := range snap.Orders {
+			if o.OrderID != id {
+				filtered = append(filtered, o)
+				continue
+			}
+			if o.Version != version {
+				return ErrConcurrentModification
+			}
+			found = true
+			o.Version++
+			snap.DeletedOrders = append(slices.DeleteFunc(snap.DeletedOrders, func(d models.Order) bool {
+				return d.OrderID == id
+			}), o)
 		}
-	}
-	snap.Orders = filtered
-
-	return r.storage.Save(ctx, snap)
+		if !found {
+			return ErrConcurrentModification
+		}
+		snap.Orders = filtered
+		return nil
+	})
 }
 
 // List retrieves filtered and paginated list of orders
@@ -99,23 +118,52 @@ func (r *SnapshotOrderRepository) List(ctx context.Context, filter requests.Orde
 		return nil, 0, err
 	}
 
-	var orders []models.Order
-	if filter.Status == nil {
-		orders = sortByCreatedAt(snap.Orders)
-	} else {
-		orders = sortByUpdatedAt(snap.Orders)
+	filtered := matchingOrders(snap.Orders, filter)
+	// like the Postgres count, the total ignores the cursor, so it is the same on every page
+	total := len(filtered)
+	if filter.After != nil {
+		filtered = applyFilters(filtered, filterAfterCursor(*filter.After))
+	}
+	page := constants.DefaultPage
+	limit := constants.DefaultLimit
+	if filter.Page != nil && filter.After == nil {
+		page = *filter.Page
+	}
+	if filter.Limit != nil {
+		limit = *filter.Limit
 	}
+	paged := paginate(filtered, page, limit)
+
+	return paged, total, nil
+}
+
+// Stream passes every order matching the filter to fn in the filter's sort order, without paging.
+func (r *SnapshotOrderRepository) Stream(ctx context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return err
+	}
+	for _, o := range matchingOrders(snap.Orders, filter) {
+		if err := fn(o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchingOrders returns the orders that pass every condition of the filter except the page cursor, sorted as
+// the filter asks
+func matchingOrders(all []models.Order, filter requests.OrdersFilterRequest) []models.Order {
+	orders := sortOrders(all, filter.SortBy, filter.SortDesc)
 
 	var filters []orderFilter
 	if filter.UserID != nil {
 		filters = append(filters, filterByUser(*filter.UserID))
 	}
 
-	if filter.LastID != nil {
-		lastCreatedAt := findLastCreatedAt(orders, *filter.LastID)
-		filters = append(filters, filterByLastID(lastCreatedAt))
-	}
-
 	if filter.InPvz != nil {
 		filters = append(filters, filterByInPvz(filter.InPvz))
 	}
@@ -124,44 +172,76 @@ func (r *SnapshotOrderRepository) List(ctx context.Context, filter requests.Orde
 		filters = append(filters, filterByStatus(*filter.Status))
 	}
 
-	filtered := applyFilters(orders, filters...)
-	total := len(filtered)
-	page := constants.DefaultPage
-	limit := constants.DefaultLimit
-	if filter.Page != nil {
-		page = *filter.Page
+	if len(filter.Statuses) > 0 {
+		filters = append(filters, filterByStatuses(filter.Statuses))
 	}
-	if filter.Limit != nil {
-		limit = *filter.Limit
+
+	if len(filter.Packages) > 0 {
+		filters = append(filters, filterByPackages(filter.Packages))
 	}
-	paged := paginate(filtered, page, limit)
 
-	return paged, total, nil
-}
+	filters = append(filters,
+		filterByTimeRange(func(o models.Order) time.Time { return o.CreatedAt }, filter.CreatedFrom, filter.CreatedTo),
+		filterByTimeRange(func(o models.Order) time.Time { return o.ExpiresAt }, filter.ExpiresFrom, filter.ExpiresTo),
+		filterByTimeRange(func(o models.Order) time.Time { return o.UpdatedStatusAt }, filter.UpdatedFrom, filter.UpdatedTo),
+		filterByRange(func(o models.Order) float32 { return o.Price }, filter.PriceMin, filter.PriceMax),
+		filterByRange(func(o models.Order) float32 { return o.Weight }, filter.WeightMin, filter.WeightMax),
+	)
 
-func sortByCreatedAt(orders []models.Order) []models.Order {
-	sort.Slice(orders, func(i, j int) bool {
-		return orders[i].CreatedAt.Before(orders[j].CreatedAt)
-	})
-	return orders
+	return applyFilters(orders, filters...)
 }
 
-func sortByUpdatedAt(src []models.Order) []models.Order {
+// sortOrders returns a copy of src ordered by the given field with the order ID as a tie-breaker,
+// mirroring the order by clause of queries.BuildFilterOrdersQuery
+func sortOrders(src []models.Order, by requests.OrderSortField, desc bool) []models.Order {
 	sorted := make([]models.Order, len(src))
 	copy(sorted, src)
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].UpdatedStatusAt.Before(sorted[j].UpdatedStatusAt)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		cmp := compareOrders(sorted[i], sorted[j], by)
+		if cmp == 0 {
+			cmp = compareUint(sorted[i].OrderID, sorted[j].OrderID)
+		}
+		if desc {
+			return cmp > 0
+		}
+		return cmp < 0
 	})
 	return sorted
 }
 
-func findLastCreatedAt(orders []models.Order, lastID uint64) time.Time {
-	for _, o := range orders {
-		if o.OrderID == lastID {
-			return o.CreatedAt
-		}
+func compareOrders(a, b models.Order, by requests.OrderSortField) int {
+	switch by {
+	case requests.SortByExpiresAt:
+		return a.ExpiresAt.Compare(b.ExpiresAt)
+	case requests.SortByUpdatedStatusAt:
+		return a.UpdatedStatusAt.Compare(b.UpdatedStatusAt)
+	case requests.SortByPrice:
+		return compareFloat(a.Price, b.Price)
+	case requests.SortByWeight:
+		return compareFloat(a.Weight, b.Weight)
+	default:
+		return a.CreatedAt.Compare(b.CreatedAt)
 	}
-	return time.Time{}
+}
+
+func compareFloat(a, b float32) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
 }
 
 type orderFilter func(models.Order) bool
@@ -172,9 +252,29 @@ func filterByUser(userID uint64) orderFilter {
 	}
 }
 
-func filterByLastID(ts time.Time) orderFilter {
+// filterAfterCursor keeps orders that come strictly after the cursor in its sort order
+func filterAfterCursor(c requests.OrderCursor) orderFilter {
 	return func(o models.Order) bool {
-		return o.CreatedAt.After(ts)
+		var cmp int
+		switch c.SortBy {
+		case requests.SortByExpiresAt:
+			cmp = o.ExpiresAt.Compare(c.At)
+		case requests.SortByUpdatedStatusAt:
+			cmp = o.UpdatedStatusAt.Compare(c.At)
+		case requests.SortByPrice:
+			cmp = compareFloat(o.Price, c.Num)
+		case requests.SortByWeight:
+			cmp = compareFloat(o.Weight, c.Num)
+		default:
+			cmp = o.CreatedAt.Compare(c.At)
+		}
+		if cmp == 0 {
+			cmp = compareUint(o.OrderID, c.ID)
+		}
+		if c.Desc {
+			return cmp < 0
+		}
+		return cmp > 0
 	}
 }
 
@@ -193,6 +293,56 @@ func filterByStatus(status models.OrderStatus) orderFilter {
 	}
 }
 
+func filterByStatuses(statuses []models.OrderStatus) orderFilter {
+	return func(o models.Order) bool {
+		for _, st := range statuses {
+			if o.Status == st {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func filterByPackages(packages []models.PackageType) orderFilter {
+	return func(o models.Order) bool {
+		for _, p := range packages {
+			if o.Package == p {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// filterByTimeRange keeps orders whose value lies in [from, to); nil bounds are open
+func filterByTimeRange(value func(models.Order) time.Time, from, to *time.Time) orderFilter {
+	return func(o models.Order) bool {
+		v := value(o)
+		if from != nil && v.Before(*from) {
+			return false
+		}
+		if to != nil && !v.Before(*to) {
+			return false
+		}
+		return true
+	}
+}
+
+// filterByRange keeps orders whose value lies in [lo, hi]; nil bounds are open
+func filterByRange(value func(models.Order) float32, lo, hi *float32) orderFilter {
+	return func(o models.Order) bool {
+		v := value(o)
+		if lo != nil && v < *lo {
+			return false
+		}
+		if hi != nil && v > *hi {
+			return false
+		}
+		return true
+	}
+}
+
 func applyFilters(orders []models.Order, filters ...orderFilter) []models.Order {
 	var out []models.Order
 	for _, o := range orders {
@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"context"
+	"path/filepath"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newSnapshotOrderRepository(t *testing.T) *SnapshotOrderRepository {
+	t.Helper()
+	return NewSnapshotOrderRepository(storage.NewJSONStorage(filepath.Join(t.TempDir(), "storage.json")))
+}
+
+// TestSnapshotOrderRepository_ConcurrentSaves verifies that of several saves of the same version exactly one is stored.
+func TestSnapshotOrderRepository_ConcurrentSaves(t *testing.T) {
+	t.Parallel()
+	repo := newSnapshotOrderRepository(t)
+	ctx := context.Background()
+	version, err := repo.Save(ctx, models.Order{OrderID: 1, UserID: 42, Status: models.Accepted})
+	require.NoError(t, err)
+	loaded, err := repo.Load(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, version, loaded.Version)
+
+	const writers = 8
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		failures  []error
+	)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(userID uint64) {
+			defer wg.Done()
+			order := loaded
+			order.UserID = userID
+			_, err := repo.Save(ctx, order)
+			mu.Lock()
+			defer mu.Unlock()
+			if err == nil {
+				succeeded++
+				return
+			}
+			failures = append(failures, err)
+		}(uint64(100 + i))
+	}
+	wg.Wait()
+
+	require.Equal(t, 1, succeeded)
+	for _, err := range failures {
+		require.ErrorIs(t, err, ErrConcurrentModification)
+	}
+	current, err := repo.Load(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, loaded.Version+1, current.Version)
+}
+
+// TestSnapshotOrderRepository_SaveAndDeleteVersions covers the version checks of Save and Delete.
+func TestSnapshotOrderRepository_SaveAndDeleteVersions(t *testing.T) {
+	t.Parallel()
+	repo := newSnapshotOrderRepository(t)
+	ctx := context.Background()
+	order := models.Order{OrderID: 1, UserID: 42, Status: models.Accepted}
+
+	version, err := repo.Save(ctx, order)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), version)
+
+	stale := order
+	stale.Status = models.Issued
+	_, err = repo.Save(ctx, stale)
+	require.ErrorIs(t, err, ErrConcurrentModification)
+
+	require.ErrorIs(t, repo.Delete(ctx, 1, 0), ErrConcurrentModification)
+	require.NoError(t, repo.Delete(ctx, 1, 1))
+	require.ErrorIs(t, repo.Delete(ctx, 1, 1), ErrConcurrentModification)
+
+	loadedBeforeDelete := order
+	loadedBeforeDelete.Version = 1
+	_, err = repo.Save(ctx, loadedBeforeDelete)
+	require.ErrorIs(t, err, ErrConcurrentModification)
+
+	_, err = repo.Save(ctx, order)
+	require.NoError(t, err)
+}
+
+// TestSnapshotOrderRepository_ListTotalWithCursor verifies that the total counts every matching order on every page,
+// as the Postgres count does, while the cursor only moves the page.
+func TestSnapshotOrderRepository_ListTotalWithCursor(t *testing.T) {
+	t.Parallel()
+	repo := newSnapshotOrderRepository(t)
+	ctx := context.Background()
+	at := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	for id := uint64(1); id <= 5; id++ {
+		_, err := repo.Save(ctx, models.Order{OrderID: id, UserID: 42, Status: models.Accepted, CreatedAt: at.Add(time.Duration(id) * time.Minute)})
+		require.NoError(t, err)
+	}
+	_, err := repo.Save(ctx, models.Order{OrderID: 6, UserID: 43, Status: models.Accepted, CreatedAt: at})
+	require.NoError(t, err)
+
+	filter := requests.OrdersFilterRequest{UserID: utils.Ptr(uint64(42)), Limit: utils.Ptr(2)}
+	var ids []uint64
+	for page := 0; page < 3; page++ {
+		orders, total, err := repo.List(ctx, filter)
+		require.NoError(t, err)
+		require.Equal(t, 5, total)
+		for _, o := range orders {
+			ids = append(ids, o.OrderID)
+		}
+		last := orders[len(orders)-1]
+		filter.After = &requests.OrderCursor{SortBy: requests.SortByCreatedAt, At: last.CreatedAt, ID: last.OrderID}
+	}
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, ids)
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/data"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"sort"
+)
+
+var _ ShipmentRepository = (*SnapshotShipmentRepository)(nil)
+
+// SnapshotShipmentRepository is an implementation of the ShipmentRepository interface that uses snapshot storage.
+type SnapshotShipmentRepository struct {
+	storage storage.Storage
+}
+
+// NewSnapshotShipmentRepository creates a new instance of SnapshotShipmentRepository
+func NewSnapshotShipmentRepository(s storage.Storage) *SnapshotShipmentRepository {
+	return &SnapshotShipmentRepository{storage: s}
+}
+
+// Save stores or updates a shipment in the repository
+func (r *SnapshotShipmentRepository) Save(ctx context.Context, s models.Shipment) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		for i, existing := range snap.Shipments {
+			if existing.ShipmentID == s.ShipmentID {
+				snap.Shipments[i] = s
+				return nil
+			}
+		}
+		snap.Shipments = append(snap.Shipments, s)
+		return nil
+	})
+}
+
+// Load retrieves a shipment by its ID
+func (r *SnapshotShipmentRepository) Load(ctx context.Context, id uint64) (models.Shipment, error) {
+	if ctx.Err() != nil {
+		return models.Shipment{}, ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return models.Shipment{}, err
+	}
+	for _, s := range snap.Shipments {
+		if s.ShipmentID == id {
+			return s, nil
+		}
+	}
+	return models.Shipment{}, ErrShipmentNotFound
+}
+
+// SaveHistory stores a shipment status change in the repository
+func (r *SnapshotShipmentRepository) SaveHistory(ctx context.Context, e models.ShipmentHistoryEntry) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		snap.ShipmentHistory = append(snap.ShipmentHistory, e)
+		return nil
+	})
+}
+
+// ListHistory retrieves the status history of a shipment in chronological order
+func (r *SnapshotShipmentRepository) ListHistory(ctx context.Context, shipmentID uint64) ([]models.ShipmentHistoryEntry, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []models.ShipmentHistoryEntry
+	for _, e := range snap.ShipmentHistory {
+		if e.ShipmentID == shipmentID {
+			out = append(out, e)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Timestamp.Before(out[j].Timestamp)
+	})
+	return out, nil
+}
@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"pvz-cli/internal/data"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"slices"
+	"sort"
+)
+
+var _ UserDataRepository = (*SnapshotUserDataRepository)(nil)
+
+// SnapshotUserDataRepository is an implementation of the UserDataRepository interface that uses snapshot storage.
+// The snapshot mode has no outbox, so exports never contain outbox events.
+type SnapshotUserDataRepository struct {
+	storage storage.Storage
+}
+
+// NewSnapshotUserDataRepository creates a new instance of SnapshotUserDataRepository
+func NewSnapshotUserDataRepository(s storage.Storage) *SnapshotUserDataRepository {
+	return &SnapshotUserDataRepository{storage: s}
+}
+
+// Export gathers the user's live and archived orders together with their history
+func (r *SnapshotUserDataRepository) Export(ctx context.Context, userID uint64) (models.UserDataExport, error) {
+	if ctx.Err() != nil {
+		return models.UserDataExport{}, ctx.Err()
+	}
+	snap, err := r.storage.Load(ctx)
+	if err != nil {
+		return models.UserDataExport{}, err
+	}
+
+	out := models.UserDataExport{
+		UserID:             userID,
+		Orders:             []models.Order{},
+		ArchivedOrders:     []models.ArchivedOrder{},
+		History:            []models.HistoryEntry{},
+		OutboxEvents:       []models.UserDataOutboxRecord{},
+		Shipments:          []models.Shipment{},
+		ShipmentHistory:    []models.ShipmentHistoryEntry{},
+		IdempotencyRecords: []models.IdempotencyRecord{},
+		ImportJobFailures:  []models.ImportJobFailure{},
+	}
+	ids := make(map[uint64]struct{})
+	for _, o := range slices.Concat(snap.Orders, snap.DeletedOrders) {
+		if o.UserID == userID {
+			out.Orders = append(out.Orders, o)
+			ids[o.OrderID] = struct{}{}
+		}
+	}
+	for _, o := range snap.ArchivedOrders {
+		if o.UserID == userID {
+			out.ArchivedOrders = append(out.ArchivedOrders, o)
+			ids[o.OrderID] = struct{}{}
+		}
+	}
+	for _, h := range snap.History {
+		if _, ok := ids[h.OrderID]; ok {
+			out.History = append(out.History, h)
+		}
+	}
+	for _, h := range snap.ArchivedHistory {
+		if _, ok := ids[h.OrderID]; ok {
+			out.History = append(out.History, h.HistoryEntry)
+		}
+	}
+	sort.Slice(out.History, func(i, j int) bool {
+		return out.History[i].Timestamp.Before(out.History[j].Timestamp)
+	})
+
+	shipmentIDs := make(map[uint64]struct{})
+	for _, sh := range snap.Shipments {
+		if sh.SenderID == userID {
+			out.Shipments = append(out.Shipments, sh)
+			shipmentIDs[sh.ShipmentID] = struct{}{}
+		}
+	}
+	for _, h := range snap.ShipmentHistory {
+		if _, ok := shipmentIDs[h.ShipmentID]; ok {
+			out.ShipmentHistory = append(out.ShipmentHistory, h)
+		}
+	}
+	for _, rec := range snap.IdempotencyKeys {
+		if slices.Contains(rec.UserIDs, userID) {
+			rec.UserIDs = nil
+			out.IdempotencyRecords = append(out.IdempotencyRecords, rec)
+		}
+	}
+	for _, f := range snap.ImportJobFailures {
+		if f.UserID == userID {
+			out.ImportJobFailures = append(out.ImportJobFailures, f)
+		}
+	}
+	return out, nil
+}
+
+// Anonymize replaces the user's identifier in live and archived orders, in the client actor of their history,
+// in shipments the user sent and in import job failures; stored idempotent responses mentioning the user are dropped
+func (r *SnapshotUserDataRepository) Anonymize(ctx context.Context, userID uint64) (models.AnonymizeResult, error) {
+	if ctx.Err() != nil {
+		return models.AnonymizeResult{}, ctx.Err()
+	}
+	res := models.AnonymizeResult{UserID: userID}
+	err := r.storage.Update(ctx, func(snap *data.Snapshot) error {
+		for _, o := range snap.Orders {
+			if o.UserID == userID && (o.Status == models.Accepted || o.Status == models.Returned) {
+				return ErrUserHasActiveOrders
+			}
+		}
+		for i := range snap.Orders {
+			if snap.Orders[i].UserID == userID {
+				snap.Orders[i].UserID = models.AnonymousUserID
+				res.Orders++
+			}
+		}
+		for i := range snap.DeletedOrders {
+			if snap.DeletedOrders[i].UserID == userID {
+				snap.DeletedOrders[i].UserID = models.AnonymousUserID
+				res.Orders++
+			}
+		}
+		for i := range snap.ArchivedOrders {
+			if snap.ArchivedOrders[i].UserID == userID {
+				snap.ArchivedOrders[i].UserID = models.AnonymousUserID
+				res.ArchivedOrders++
+			}
+		}
+		for i := range snap.Shipments {
+			if snap.Shipments[i].SenderID == userID {
+				snap.Shipments[i].SenderID = models.AnonymousUserID
+				res.Shipments++
+			}
+		}
+		for i := range snap.ImportJobFailures {
+			if snap.ImportJobFailures[i].UserID == userID {
+				snap.ImportJobFailures[i].UserID = models.AnonymousUserID
+				res.ImportJobFailures++
+			}
+		}
+		kept := snap.IdempotencyKeys[:0]
+		for _, rec := range snap.IdempotencyKeys {
+			if slices.Contains(rec.UserIDs, userID) {
+				res.IdempotencyKeys++
+				continue
+			}
+			kept = append(kept, rec)
+		}
+		snap.IdempotencyKeys = kept
+		if res.Orders == 0 && res.ArchivedOrders == 0 && res.Shipments == 0 &&
+			res.ImportJobFailures == 0 && res.IdempotencyKeys == 0 {
+			return storage.ErrUnchanged
+		}
+		for i := range snap.History {
+			anonymizeHistoryActor(&snap.History[i], userID)
+		}
+		for i := range snap.ArchivedHistory {
+			anonymizeHistoryActor(&snap.ArchivedHistory[i].HistoryEntry, userID)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.AnonymizeResult{}, err
+	}
+	return res, nil
+}
+
+// anonymizeHistoryActor hides the user behind a history entry of an action they performed themselves
+func anonymizeHistoryActor(e *models.HistoryEntry, userID uint64) {
+	if e.ActorType == models.ActorClient && e.ActorID == userID {
+		e.ActorID = models.AnonymousUserID
+	}
+}
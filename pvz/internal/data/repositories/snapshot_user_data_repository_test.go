@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	"path/filepath"
+	"pvz-cli/internal/data"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSnapshotUserDataRepository_ShipmentsKeysAndImports covers the client's shipments, stored idempotent responses
+// and rejected imports in export and anonymization, leaving other clients' records alone.
+func TestSnapshotUserDataRepository_ShipmentsKeysAndImports(t *testing.T) {
+	t.Parallel()
+	const (
+		userID  uint64 = 7
+		otherID uint64 = 8
+	)
+	s := storage.NewJSONStorage(filepath.Join(t.TempDir(), "storage.json"))
+	repo := NewSnapshotUserDataRepository(s)
+	ctx := context.Background()
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, s.Update(ctx, func(snap *data.Snapshot) error {
+		snap.Shipments = []models.Shipment{
+			{ShipmentID: 1, SenderID: userID, Status: models.ShipmentRegistered, CreatedAt: now},
+			{ShipmentID: 2, SenderID: otherID, Status: models.ShipmentRegistered, CreatedAt: now},
+		}
+		snap.ShipmentHistory = []models.ShipmentHistoryEntry{
+			{ShipmentID: 1, Status: models.ShipmentRegistered, Timestamp: now},
+			{ShipmentID: 2, Status: models.ShipmentRegistered, Timestamp: now},
+		}
+		snap.IdempotencyKeys = []models.IdempotencyRecord{
+			{Scope: "op-1", Key: "k1", Response: []byte("mine"), CreatedAt: now, UserIDs: []uint64{userID}},
+			{Scope: "op-1", Key: "k2", Response: []byte("other"), CreatedAt: now, UserIDs: []uint64{otherID}},
+		}
+		snap.ImportJobFailures = []models.ImportJobFailure{
+			{JobID: 1, ItemNumber: 1, OrderID: 10, UserID: userID, Code: "VALIDATION_FAILED"},
+			{JobID: 1, ItemNumber: 2, OrderID: 11, UserID: otherID, Code: "VALIDATION_FAILED"},
+		}
+		return nil
+	}))
+
+	export, err := repo.Export(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, export.Shipments, 1)
+	require.Equal(t, uint64(1), export.Shipments[0].ShipmentID)
+	require.Len(t, export.ShipmentHistory, 1)
+	require.Len(t, export.IdempotencyRecords, 1)
+	require.Equal(t, "k1", export.IdempotencyRecords[0].Key)
+	require.Equal(t, []models.ImportJobFailure{{JobID: 1, ItemNumber: 1, OrderID: 10, UserID: userID, Code: "VALIDATION_FAILED"}},
+		export.ImportJobFailures)
+
+	res, err := repo.Anonymize(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, models.AnonymizeResult{UserID: userID, Shipments: 1, ImportJobFailures: 1, IdempotencyKeys: 1}, res)
+
+	export, err = repo.Export(ctx, userID)
+	require.NoError(t, err)
+	require.Empty(t, export.Shipments)
+	require.Empty(t, export.IdempotencyRecords)
+	require.Empty(t, export.ImportJobFailures)
+
+	snap, err := s.Load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, models.AnonymousUserID, snap.Shipments[0].SenderID)
+	require.Equal(t, otherID, snap.Shipments[1].SenderID)
+	require.Len(t, snap.IdempotencyKeys, 1)
+	require.Equal(t, "k2", snap.IdempotencyKeys[0].Key)
+	require.Equal(t, models.AnonymousUserID, snap.ImportJobFailures[0].UserID)
+	require.Equal(t, otherID, snap.ImportJobFailures[1].UserID)
+}
+
+// TestSnapshotUserDataRepository_AnonymizeWithActiveOrders verifies that a client whose orders are still at the
+// pickup point is not anonymized at all.
+func TestSnapshotUserDataRepository_AnonymizeWithActiveOrders(t *testing.T) {
+	t.Parallel()
+	const userID uint64 = 7
+	for _, status := range []models.OrderStatus{models.Accepted, models.Returned} {
+		s := storage.NewJSONStorage(filepath.Join(t.TempDir(), "storage.json"))
+		repo := NewSnapshotUserDataRepository(s)
+		ctx := context.Background()
+		require.NoError(t, s.Update(ctx, func(snap *data.Snapshot) error {
+			snap.Orders = []models.Order{
+				{OrderID: 1, UserID: userID, Status: models.Issued},
+				{OrderID: 2, UserID: userID, Status: status},
+			}
+			snap.Shipments = []models.Shipment{{ShipmentID: 1, SenderID: userID, Status: models.ShipmentRegistered}}
+			return nil
+		}))
+
+		_, err := repo.Anonymize(ctx, userID)
+		require.ErrorIs(t, err, ErrUserHasActiveOrders)
+
+		snap, err := s.Load(ctx)
+		require.NoError(t, err)
+		require.Equal(t, userID, snap.Orders[0].UserID)
+		require.Equal(t, userID, snap.Orders[1].UserID)
+		require.Equal(t, userID, snap.Shipments[0].SenderID)
+	}
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"pvz-cli/internal/models"
+)
+
+// ErrUserHasActiveOrders is returned when anonymizing a client whose orders are still waiting at the pickup point
+var ErrUserHasActiveOrders = errors.New("user has orders at the pickup point")
+
+// UserDataRepository collects and erases personal data of a single client across all stored records
+type UserDataRepository interface {
+	// Export gathers the user's live and archived orders, their history and outbox payloads
+	Export(ctx context.Context, userID uint64) (models.UserDataExport, error)
+	// Anonymize replaces the user's identifier everywhere it is stored, keeping the rest of the records intact.
+	// It returns ErrUserHasActiveOrders and changes nothing while the user has accepted or returned orders, which
+	// still have to be issued to or returned by the client.
+	Anonymize(ctx context.Context, userID uint64) (models.AnonymizeResult, error)
+}
@@ -6,6 +6,21 @@ import (
 
 // Snapshot represents complete application state for persistence
 type Snapshot struct {
-	Orders  []models.Order
-	History []models.HistoryEntry
+	Orders   []models.Order
+	History  []models.HistoryEntry
+	Calendar *models.PickupCalendar `json:",omitempty"`
+	// DeletedOrders keep orders removed from the pickup point, as soft-deleted rows do in Postgres,
+	// so their history still has an owner
+	DeletedOrders []models.Order `json:",omitempty"`
+	// Shipments and ShipmentHistory hold outbound parcel drop-offs
+	Shipments       []models.Shipment             `json:",omitempty"`
+	ShipmentHistory []models.ShipmentHistoryEntry `json:",omitempty"`
+	// IdempotencyKeys hold replayable responses of retried mutating calls
+	IdempotencyKeys []models.IdempotencyRecord `json:",omitempty"`
+	// ArchivedOrders and ArchivedHistory hold terminal orders moved out of the live state
+	ArchivedOrders  []models.ArchivedOrder        `json:",omitempty"`
+	ArchivedHistory []models.ArchivedHistoryEntry `json:",omitempty"`
+	// ImportJobs and ImportJobFailures hold background imports and the orders they rejected
+	ImportJobs        []models.ImportJob        `json:",omitempty"`
+	ImportJobFailures []models.ImportJobFailure `json:",omitempty"`
 }
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -32,6 +33,32 @@ func (s *JSONStorage) Save(ctx context.Context, snapshot *data.Snapshot) error {
 
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
+	return s.save(snapshot)
+}
+
+// Update runs fn on the loaded snapshot and saves the result while holding the lock,
+// so that concurrent read-modify-write cycles cannot overwrite each other
+func (s *JSONStorage) Update(ctx context.Context, fn func(snapshot *data.Snapshot) error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	snapshot, err := s.load()
+	if err != nil {
+		return err
+	}
+	if err := fn(snapshot); err != nil {
+		if errors.Is(err, ErrUnchanged) {
+			return nil
+		}
+		return err
+	}
+	return s.save(snapshot)
+}
+
+func (s *JSONStorage) save(snapshot *data.Snapshot) error {
 	if err := os.MkdirAll(filepath.Dir(s.path), 0750); err != nil {
 		return fmt.Errorf("mkdir target dir: %w", err)
 	}
@@ -91,6 +118,10 @@ func (s *JSONStorage) Load(ctx context.Context) (*data.Snapshot, error) {
 	}
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
+	return s.load()
+}
+
+func (s *JSONStorage) load() (*data.Snapshot, error) {
 	file, err := os.Open(s.path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -2,11 +2,18 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"pvz-cli/internal/data"
 )
 
+// ErrUnchanged may be returned by the fn passed to Update to skip saving a snapshot it left as is; Update then returns nil
+var ErrUnchanged = errors.New("snapshot unchanged")
+
 // Storage handles persistence operations for application snapshots
 type Storage interface {
 	Save(ctx context.Context, snapshot *data.Snapshot) error
 	Load(ctx context.Context) (*data.Snapshot, error)
+	// Update loads the snapshot, lets fn change it and saves it, with no other load or save in between;
+	// nothing is saved when fn returns an error, which Update then returns unless it is ErrUnchanged
+	Update(ctx context.Context, fn func(snapshot *data.Snapshot) error) error
 }
@@ -469,3 +469,1506 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetWorkerStatsResponseValidationError{}
+
+// Validate checks the field values on GetPickupCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *GetPickupCalendarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPickupCalendarRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPickupCalendarRequestMultiError, or nil if none found.
+func (m *GetPickupCalendarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPickupCalendarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPickupCalendarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPickupCalendarRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPickupCalendarRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPickupCalendarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPickupCalendarRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPickupCalendarRequestMultiError) AllErrors() []error { return m }
+
+// GetPickupCalendarRequestValidationError is the validation error returned by
+// GetPickupCalendarRequest.Validate if the designated constraints aren't met.
+type GetPickupCalendarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPickupCalendarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPickupCalendarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPickupCalendarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPickupCalendarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPickupCalendarRequestValidationError) ErrorName() string {
+	return "GetPickupCalendarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPickupCalendarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPickupCalendarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPickupCalendarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPickupCalendarRequestValidationError{}
+
+// Validate checks the field values on SetPickupCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *SetPickupCalendarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPickupCalendarRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPickupCalendarRequestMultiError, or nil if none found.
+func (m *SetPickupCalendarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPickupCalendarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCalendar() == nil {
+		err := SetPickupCalendarRequestValidationError{
+			field:  "Calendar",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCalendar()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetPickupCalendarRequestValidationError{
+					field:  "Calendar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetPickupCalendarRequestValidationError{
+					field:  "Calendar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCalendar()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetPickupCalendarRequestValidationError{
+				field:  "Calendar",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetPickupCalendarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPickupCalendarRequestMultiError is an error wrapping multiple validation
+// errors returned by SetPickupCalendarRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetPickupCalendarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPickupCalendarRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPickupCalendarRequestMultiError) AllErrors() []error { return m }
+
+// SetPickupCalendarRequestValidationError is the validation error returned by
+// SetPickupCalendarRequest.Validate if the designated constraints aren't met.
+type SetPickupCalendarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPickupCalendarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPickupCalendarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPickupCalendarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPickupCalendarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPickupCalendarRequestValidationError) ErrorName() string {
+	return "SetPickupCalendarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPickupCalendarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPickupCalendarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPickupCalendarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPickupCalendarRequestValidationError{}
+
+// Validate checks the field values on PickupCalendar with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PickupCalendar) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PickupCalendar with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PickupCalendarMultiError, or
+// nil if none found.
+func (m *PickupCalendar) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PickupCalendar) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OpensAt
+
+	// no validation rules for ClosesAt
+
+	for idx, item := range m.GetWeeklyDaysOff() {
+		_, _ = idx, item
+
+		if item > 6 {
+			err := PickupCalendarValidationError{
+				field:  fmt.Sprintf("WeeklyDaysOff[%v]", idx),
+				reason: "value must be less than or equal to 6",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for BusinessDays
+
+	if len(errors) > 0 {
+		return PickupCalendarMultiError(errors)
+	}
+
+	return nil
+}
+
+// PickupCalendarMultiError is an error wrapping multiple validation errors
+// returned by PickupCalendar.ValidateAll() if the designated constraints aren't
+// met.
+type PickupCalendarMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PickupCalendarMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PickupCalendarMultiError) AllErrors() []error { return m }
+
+// PickupCalendarValidationError is the validation error returned by
+// PickupCalendar.Validate if the designated constraints aren't met.
+type PickupCalendarValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PickupCalendarValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PickupCalendarValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PickupCalendarValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PickupCalendarValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PickupCalendarValidationError) ErrorName() string { return "PickupCalendarValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PickupCalendarValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPickupCalendar.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PickupCalendarValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PickupCalendarValidationError{}
+
+// Validate checks the field values on UserDataRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDataRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserDataRequestMultiError, or
+// nil if none found.
+func (m *UserDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UserDataRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDataRequestMultiError is an error wrapping multiple validation errors
+// returned by UserDataRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDataRequestMultiError) AllErrors() []error { return m }
+
+// UserDataRequestValidationError is the validation error returned by
+// UserDataRequest.Validate if the designated constraints aren't met.
+type UserDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDataRequestValidationError) ErrorName() string { return "UserDataRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDataRequestValidationError{}
+
+// Validate checks the field values on UserDataExport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserDataExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDataExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserDataExportMultiError, or
+// nil if none found.
+func (m *UserDataExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDataExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Bundle
+
+	if len(errors) > 0 {
+		return UserDataExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDataExportMultiError is an error wrapping multiple validation errors
+// returned by UserDataExport.ValidateAll() if the designated constraints aren't
+// met.
+type UserDataExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDataExportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDataExportMultiError) AllErrors() []error { return m }
+
+// UserDataExportValidationError is the validation error returned by
+// UserDataExport.Validate if the designated constraints aren't met.
+type UserDataExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDataExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDataExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDataExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDataExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDataExportValidationError) ErrorName() string { return "UserDataExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDataExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDataExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDataExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDataExportValidationError{}
+
+// Validate checks the field values on AnonymizeUserDataResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *AnonymizeUserDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AnonymizeUserDataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AnonymizeUserDataResponseMultiError, or nil if none found.
+func (m *AnonymizeUserDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AnonymizeUserDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Orders
+
+	// no validation rules for ArchivedOrders
+
+	// no validation rules for OutboxEvents
+
+	if len(errors) > 0 {
+		return AnonymizeUserDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnonymizeUserDataResponseMultiError is an error wrapping multiple validation
+// errors returned by AnonymizeUserDataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AnonymizeUserDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnonymizeUserDataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnonymizeUserDataResponseMultiError) AllErrors() []error { return m }
+
+// AnonymizeUserDataResponseValidationError is the validation error returned by
+// AnonymizeUserDataResponse.Validate if the designated constraints aren't met.
+type AnonymizeUserDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnonymizeUserDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnonymizeUserDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnonymizeUserDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnonymizeUserDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnonymizeUserDataResponseValidationError) ErrorName() string {
+	return "AnonymizeUserDataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AnonymizeUserDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnonymizeUserDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnonymizeUserDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnonymizeUserDataResponseValidationError{}
+
+// Validate checks the field values on CheckConsistencyRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *CheckConsistencyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckConsistencyRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// CheckConsistencyRequestMultiError, or nil if none found.
+func (m *CheckConsistencyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckConsistencyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Repair
+
+	if len(errors) > 0 {
+		return CheckConsistencyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckConsistencyRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckConsistencyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckConsistencyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckConsistencyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckConsistencyRequestMultiError) AllErrors() []error { return m }
+
+// CheckConsistencyRequestValidationError is the validation error returned by
+// CheckConsistencyRequest.Validate if the designated constraints aren't met.
+type CheckConsistencyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckConsistencyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckConsistencyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckConsistencyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckConsistencyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckConsistencyRequestValidationError) ErrorName() string {
+	return "CheckConsistencyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckConsistencyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckConsistencyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckConsistencyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckConsistencyRequestValidationError{}
+
+// Validate checks the field values on ConsistencyIssue with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyIssue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyIssue with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ConsistencyIssueMultiError, or nil if none found.
+func (m *ConsistencyIssue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyIssue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Kind
+
+	// no validation rules for Details
+
+	// no validation rules for Repaired
+
+	if len(errors) > 0 {
+		return ConsistencyIssueMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyIssueMultiError is an error wrapping multiple validation errors
+// returned by ConsistencyIssue.ValidateAll() if the designated constraints
+// aren't met.
+type ConsistencyIssueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyIssueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyIssueMultiError) AllErrors() []error { return m }
+
+// ConsistencyIssueValidationError is the validation error returned by
+// ConsistencyIssue.Validate if the designated constraints aren't met.
+type ConsistencyIssueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyIssueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyIssueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyIssueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyIssueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyIssueValidationError) ErrorName() string { return "ConsistencyIssueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConsistencyIssueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyIssue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyIssueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyIssueValidationError{}
+
+// Validate checks the field values on ConsistencyReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyReport with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ConsistencyReportMultiError, or nil if none found.
+func (m *ConsistencyReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CheckedOrders
+
+	for idx, item := range m.GetIssues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConsistencyReportValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConsistencyReportValidationError{
+						field:  fmt.Sprintf("Issues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConsistencyReportValidationError{
+					field:  fmt.Sprintf("Issues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Repaired
+
+	if len(errors) > 0 {
+		return ConsistencyReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyReportMultiError is an error wrapping multiple validation errors
+// returned by ConsistencyReport.ValidateAll() if the designated constraints
+// aren't met.
+type ConsistencyReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyReportMultiError) AllErrors() []error { return m }
+
+// ConsistencyReportValidationError is the validation error returned by
+// ConsistencyReport.Validate if the designated constraints aren't met.
+type ConsistencyReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyReportValidationError) ErrorName() string {
+	return "ConsistencyReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsistencyReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyReportValidationError{}
+
+// Validate checks the field values on ListAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ListAuditLogRequestMultiError, or nil if none found.
+func (m *ListAuditLogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OperatorId
+
+	// no validation rules for Method
+
+	// no validation rules for Source
+
+	// no validation rules for ResultCode
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditLogRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditLogRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditLogRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditLogRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditLogRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditLogRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Page
+
+	if m.GetLimit() > 1000 {
+		err := ListAuditLogRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAuditLogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAuditLogRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAuditLogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditLogRequestValidationError is the validation error returned by
+// ListAuditLogRequest.Validate if the designated constraints aren't met.
+type ListAuditLogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogRequestValidationError) ErrorName() string {
+	return "ListAuditLogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogRequestValidationError{}
+
+// Validate checks the field values on AuditRecord with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *AuditRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in AuditRecordMultiError, or nil if
+// none found.
+func (m *AuditRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditRecordValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditRecordValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OperatorId
+
+	// no validation rules for Role
+
+	// no validation rules for Source
+
+	// no validation rules for Method
+
+	// no validation rules for Request
+
+	// no validation rules for ResultCode
+
+	// no validation rules for CorrelationId
+
+	if len(errors) > 0 {
+		return AuditRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditRecordMultiError is an error wrapping multiple validation errors
+// returned by AuditRecord.ValidateAll() if the designated constraints aren't
+// met.
+type AuditRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditRecordMultiError) AllErrors() []error { return m }
+
+// AuditRecordValidationError is the validation error returned by
+// AuditRecord.Validate if the designated constraints aren't met.
+type AuditRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditRecordValidationError) ErrorName() string { return "AuditRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditRecordValidationError{}
+
+// Validate checks the field values on AuditLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *AuditLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in AuditLogMultiError, or nil if none found.
+func (m *AuditLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditLogValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditLogValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditLogValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return AuditLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditLogMultiError is an error wrapping multiple validation errors returned
+// by AuditLog.ValidateAll() if the designated constraints aren't met.
+type AuditLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditLogMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditLogMultiError) AllErrors() []error { return m }
+
+// AuditLogValidationError is the validation error returned by AuditLog.Validate
+// if the designated constraints aren't met.
+type AuditLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditLogValidationError) ErrorName() string { return "AuditLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditLogValidationError{}
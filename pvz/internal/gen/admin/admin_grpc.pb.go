@@ -19,8 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_SetWorkerCount_FullMethodName = "/admin.AdminService/SetWorkerCount"
-	AdminService_GetWorkerStats_FullMethodName = "/admin.AdminService/GetWorkerStats"
+	AdminService_SetWorkerCount_FullMethodName    = "/admin.AdminService/SetWorkerCount"
+	AdminService_GetWorkerStats_FullMethodName    = "/admin.AdminService/GetWorkerStats"
+	AdminService_GetPickupCalendar_FullMethodName = "/admin.AdminService/GetPickupCalendar"
+	AdminService_SetPickupCalendar_FullMethodName = "/admin.AdminService/SetPickupCalendar"
+	AdminService_ExportUserData_FullMethodName    = "/admin.AdminService/ExportUserData"
+	AdminService_AnonymizeUserData_FullMethodName = "/admin.AdminService/AnonymizeUserData"
+	AdminService_CheckConsistency_FullMethodName  = "/admin.AdminService/CheckConsistency"
+	AdminService_ListAuditLog_FullMethodName      = "/admin.AdminService/ListAuditLog"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -29,6 +35,16 @@ const (
 type AdminServiceClient interface {
 	SetWorkerCount(ctx context.Context, in *SetWorkerCountRequest, opts ...grpc.CallOption) (*SetWorkerCountResponse, error)
 	GetWorkerStats(ctx context.Context, in *GetWorkerStatsRequest, opts ...grpc.CallOption) (*GetWorkerStatsResponse, error)
+	GetPickupCalendar(ctx context.Context, in *GetPickupCalendarRequest, opts ...grpc.CallOption) (*PickupCalendar, error)
+	SetPickupCalendar(ctx context.Context, in *SetPickupCalendarRequest, opts ...grpc.CallOption) (*PickupCalendar, error)
+	// ExportUserData returns every order, history entry and outbox payload of the user as a JSON bundle
+	ExportUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error)
+	// AnonymizeUserData erases the user's identity while keeping aggregate and financial records
+	AnonymizeUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*AnonymizeUserDataResponse, error)
+	// CheckConsistency replays the history of every order and reports where the stored order or its outbox events drifted
+	CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*ConsistencyReport, error)
+	// ListAuditLog returns recorded mutating calls of operators, newest first
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error)
 }
 
 type adminServiceClient struct {
@@ -59,12 +75,82 @@ func (c *adminServiceClient) GetWorkerStats(ctx context.Context, in *GetWorkerSt
 	return out, nil
 }
 
+func (c *adminServiceClient) GetPickupCalendar(ctx context.Context, in *GetPickupCalendarRequest, opts ...grpc.CallOption) (*PickupCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupCalendar)
+	err := c.cc.Invoke(ctx, AdminService_GetPickupCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetPickupCalendar(ctx context.Context, in *SetPickupCalendarRequest, opts ...grpc.CallOption) (*PickupCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupCalendar)
+	err := c.cc.Invoke(ctx, AdminService_SetPickupCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, AdminService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AnonymizeUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*AnonymizeUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnonymizeUserDataResponse)
+	err := c.cc.Invoke(ctx, AdminService_AnonymizeUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*ConsistencyReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsistencyReport)
+	err := c.cc.Invoke(ctx, AdminService_CheckConsistency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLog)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
 type AdminServiceServer interface {
 	SetWorkerCount(context.Context, *SetWorkerCountRequest) (*SetWorkerCountResponse, error)
 	GetWorkerStats(context.Context, *GetWorkerStatsRequest) (*GetWorkerStatsResponse, error)
+	GetPickupCalendar(context.Context, *GetPickupCalendarRequest) (*PickupCalendar, error)
+	SetPickupCalendar(context.Context, *SetPickupCalendarRequest) (*PickupCalendar, error)
+	// ExportUserData returns every order, history entry and outbox payload of the user as a JSON bundle
+	ExportUserData(context.Context, *UserDataRequest) (*UserDataExport, error)
+	// AnonymizeUserData erases the user's identity while keeping aggregate and financial records
+	AnonymizeUserData(context.Context, *UserDataRequest) (*AnonymizeUserDataResponse, error)
+	// CheckConsistency replays the history of every order and reports where the stored order or its outbox events drifted
+	CheckConsistency(context.Context, *CheckConsistencyRequest) (*ConsistencyReport, error)
+	// ListAuditLog returns recorded mutating calls of operators, newest first
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*AuditLog, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -81,6 +167,24 @@ func (UnimplementedAdminServiceServer) SetWorkerCount(context.Context, *SetWorke
 func (UnimplementedAdminServiceServer) GetWorkerStats(context.Context, *GetWorkerStatsRequest) (*GetWorkerStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerStats not implemented")
 }
+func (UnimplementedAdminServiceServer) GetPickupCalendar(context.Context, *GetPickupCalendarRequest) (*PickupCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickupCalendar not implemented")
+}
+func (UnimplementedAdminServiceServer) SetPickupCalendar(context.Context, *SetPickupCalendarRequest) (*PickupCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPickupCalendar not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportUserData(context.Context, *UserDataRequest) (*UserDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedAdminServiceServer) AnonymizeUserData(context.Context, *UserDataRequest) (*AnonymizeUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymizeUserData not implemented")
+}
+func (UnimplementedAdminServiceServer) CheckConsistency(context.Context, *CheckConsistencyRequest) (*ConsistencyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConsistency not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*AuditLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -138,6 +242,114 @@ func _AdminService_GetWorkerStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetPickupCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPickupCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPickupCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetPickupCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPickupCalendar(ctx, req.(*GetPickupCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetPickupCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPickupCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetPickupCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetPickupCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetPickupCalendar(ctx, req.(*SetPickupCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportUserData(ctx, req.(*UserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AnonymizeUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AnonymizeUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AnonymizeUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AnonymizeUserData(ctx, req.(*UserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CheckConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CheckConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CheckConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CheckConsistency(ctx, req.(*CheckConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +365,30 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkerStats",
 			Handler:    _AdminService_GetWorkerStats_Handler,
 		},
+		{
+			MethodName: "GetPickupCalendar",
+			Handler:    _AdminService_GetPickupCalendar_Handler,
+		},
+		{
+			MethodName: "SetPickupCalendar",
+			Handler:    _AdminService_SetPickupCalendar_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _AdminService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "AnonymizeUserData",
+			Handler:    _AdminService_AnonymizeUserData_Handler,
+		},
+		{
+			MethodName: "CheckConsistency",
+			Handler:    _AdminService_CheckConsistency_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _AdminService_ListAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin.proto",
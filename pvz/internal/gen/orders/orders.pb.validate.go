@@ -79,15 +79,33 @@ func (m *AcceptOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetExpiresAt() == nil {
-		err := AcceptOrderRequestValidationError{
-			field:  "ExpiresAt",
-			reason: "value is required",
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if m.GetWeight() <= 0 {
@@ -112,6 +130,12 @@ func (m *AcceptOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Fragile
+
+	// no validation rules for Hazardous
+
+	// no validation rules for AgeRestricted
+
 	if m.Package != nil {
 
 		if _, ok := _AcceptOrderRequest_Package_NotInLookup[m.GetPackage()]; ok {
@@ -399,6 +423,10 @@ func (m *ProcessOrdersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for IdentityVerified
+
+	// no validation rules for Atomic
+
 	if len(errors) > 0 {
 		return ProcessOrdersRequestMultiError(errors)
 	}
@@ -518,6 +546,8 @@ func (m *ListOrdersRequest) validate(all bool) error {
 
 	// no validation rules for InPvz
 
+	// no validation rules for PageToken
+
 	if m.LastN != nil {
 
 		if m.GetLastN() < 1 {
@@ -789,6 +819,8 @@ func (m *ListReturnsRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for PageToken
+
 	if m.Pagination != nil {
 
 		if all {
@@ -969,6 +1001,10 @@ func (m *ImportOrdersRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for Atomic
+
+	// no validation rules for ValidateOnly
+
 	if len(errors) > 0 {
 		return ImportOrdersRequestMultiError(errors)
 	}
@@ -1082,6 +1118,81 @@ func (m *GetHistoryRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for PageToken
+
+	// no validation rules for Events
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := ActorType_name[int32(m.GetActorType())]; !ok {
+		err := GetHistoryRequestValidationError{
+			field:  "ActorType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Ascending
+
 	if m.Pagination != nil {
 
 		if all {
@@ -1115,6 +1226,36 @@ func (m *GetHistoryRequest) validate(all bool) error {
 
 	}
 
+	if m.UserId != nil {
+
+		if m.GetUserId() <= 0 {
+			err := GetHistoryRequestValidationError{
+				field:  "UserId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ActorId != nil {
+
+		if m.GetActorId() <= 0 {
+			err := GetHistoryRequestValidationError{
+				field:  "ActorId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return GetHistoryRequestMultiError(errors)
 	}
@@ -1221,6 +1362,39 @@ func (m *OrderResponse) validate(all bool) error {
 
 	// no validation rules for OrderId
 
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StoragePolicy
+
+	// no validation rules for Version
+
 	if len(errors) > 0 {
 		return OrderResponseMultiError(errors)
 	}
@@ -1491,6 +1665,8 @@ func (m *OrdersList) validate(all bool) error {
 
 	// no validation rules for Total
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return OrdersListMultiError(errors)
 	}
@@ -1624,6 +1800,8 @@ func (m *ReturnsList) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return ReturnsListMultiError(errors)
 	}
@@ -1757,6 +1935,8 @@ func (m *OrderHistoryList) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return OrderHistoryListMultiError(errors)
 	}
@@ -1893,6 +2073,42 @@ func (m *ImportResult) validate(all bool) error {
 
 	}
 
+	// no validation rules for Valid
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportResultValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportResultValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportResultValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ImportResultMultiError(errors)
 	}
@@ -2138,6 +2354,74 @@ func (m *Order) validate(all bool) error {
 
 	// no validation rules for TotalPrice
 
+	// no validation rules for Fragile
+
+	// no validation rules for Hazardous
+
+	// no validation rules for AgeRestricted
+
+	// no validation rules for StoragePolicy
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedStatusAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "UpdatedStatusAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "UpdatedStatusAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedStatusAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "UpdatedStatusAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Package != nil {
 		// no validation rules for Package
 	}
@@ -2274,12 +2558,78 @@ func (m *OrderHistory) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return OrderHistoryMultiError(errors)
+	// no validation rules for OperatorId
+
+	if all {
+		switch v := interface{}(m.GetActor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "Actor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetActor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderHistoryValidationError{
+				field:  "Actor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	return nil
-}
+	// no validation rules for PreviousStatus
+
+	// no validation rules for NewStatus
+
+	// no validation rules for CorrelationId
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderHistoryValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderHistoryValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderHistoryMultiError(errors)
+	}
+
+	return nil
+}
 
 // OrderHistoryMultiError is an error wrapping multiple validation errors
 // returned by OrderHistory.ValidateAll() if the designated constraints aren't met.
@@ -2350,3 +2700,3436 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = OrderHistoryValidationError{}
+
+// Validate checks the field values on IssueAllReadyRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *IssueAllReadyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueAllReadyRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// IssueAllReadyRequestMultiError, or nil if none found.
+func (m *IssueAllReadyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueAllReadyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := IssueAllReadyRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IdentityVerified
+
+	if len(errors) > 0 {
+		return IssueAllReadyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueAllReadyRequestMultiError is an error wrapping multiple validation
+// errors returned by IssueAllReadyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IssueAllReadyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueAllReadyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueAllReadyRequestMultiError) AllErrors() []error { return m }
+
+// IssueAllReadyRequestValidationError is the validation error returned by
+// IssueAllReadyRequest.Validate if the designated constraints aren't met.
+type IssueAllReadyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueAllReadyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueAllReadyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueAllReadyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueAllReadyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueAllReadyRequestValidationError) ErrorName() string {
+	return "IssueAllReadyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueAllReadyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueAllReadyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueAllReadyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueAllReadyRequestValidationError{}
+
+// Validate checks the field values on IssueReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueReceipt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in IssueReceiptMultiError, or nil if
+// none found.
+func (m *IssueReceipt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueReceipt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	for idx, item := range m.GetIssued() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IssueReceiptValidationError{
+						field:  fmt.Sprintf("Issued[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IssueReceiptValidationError{
+						field:  fmt.Sprintf("Issued[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IssueReceiptValidationError{
+					field:  fmt.Sprintf("Issued[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSkipped() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IssueReceiptValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IssueReceiptValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IssueReceiptValidationError{
+					field:  fmt.Sprintf("Skipped[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalAmount
+
+	if len(errors) > 0 {
+		return IssueReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueReceiptMultiError is an error wrapping multiple validation errors
+// returned by IssueReceipt.ValidateAll() if the designated constraints aren't
+// met.
+type IssueReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueReceiptMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueReceiptMultiError) AllErrors() []error { return m }
+
+// IssueReceiptValidationError is the validation error returned by
+// IssueReceipt.Validate if the designated constraints aren't met.
+type IssueReceiptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueReceiptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueReceiptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueReceiptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueReceiptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueReceiptValidationError) ErrorName() string { return "IssueReceiptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueReceiptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueReceipt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueReceiptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueReceiptValidationError{}
+
+// Validate checks the field values on RegisterShipmentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterShipmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterShipmentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// RegisterShipmentRequestMultiError, or nil if none found.
+func (m *RegisterShipmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterShipmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSenderId() <= 0 {
+		err := RegisterShipmentRequestValidationError{
+			field:  "SenderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDestinationPointId() <= 0 {
+		err := RegisterShipmentRequestValidationError{
+			field:  "DestinationPointId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWeight() <= 0 {
+		err := RegisterShipmentRequestValidationError{
+			field:  "Weight",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() <= 0 {
+		err := RegisterShipmentRequestValidationError{
+			field:  "Price",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Fragile
+
+	// no validation rules for Hazardous
+
+	// no validation rules for AgeRestricted
+
+	if m.Package != nil {
+
+		if _, ok := _RegisterShipmentRequest_Package_NotInLookup[m.GetPackage()]; ok {
+			err := RegisterShipmentRequestValidationError{
+				field:  "Package",
+				reason: "value must not be in list [PACKAGE_TYPE_UNSPECIFIED]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := PackageType_name[int32(m.GetPackage())]; !ok {
+			err := RegisterShipmentRequestValidationError{
+				field:  "Package",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RegisterShipmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterShipmentRequestMultiError is an error wrapping multiple validation
+// errors returned by RegisterShipmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterShipmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterShipmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterShipmentRequestMultiError) AllErrors() []error { return m }
+
+// RegisterShipmentRequestValidationError is the validation error returned by
+// RegisterShipmentRequest.Validate if the designated constraints aren't met.
+type RegisterShipmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterShipmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterShipmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterShipmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterShipmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterShipmentRequestValidationError) ErrorName() string {
+	return "RegisterShipmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterShipmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterShipmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterShipmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterShipmentRequestValidationError{}
+
+var _RegisterShipmentRequest_Package_NotInLookup = map[PackageType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on HandOverShipmentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *HandOverShipmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandOverShipmentRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// HandOverShipmentRequestMultiError, or nil if none found.
+func (m *HandOverShipmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandOverShipmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetShipmentId() <= 0 {
+		err := HandOverShipmentRequestValidationError{
+			field:  "ShipmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCourierId() <= 0 {
+		err := HandOverShipmentRequestValidationError{
+			field:  "CourierId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return HandOverShipmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandOverShipmentRequestMultiError is an error wrapping multiple validation
+// errors returned by HandOverShipmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type HandOverShipmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandOverShipmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandOverShipmentRequestMultiError) AllErrors() []error { return m }
+
+// HandOverShipmentRequestValidationError is the validation error returned by
+// HandOverShipmentRequest.Validate if the designated constraints aren't met.
+type HandOverShipmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandOverShipmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandOverShipmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandOverShipmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandOverShipmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandOverShipmentRequestValidationError) ErrorName() string {
+	return "HandOverShipmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HandOverShipmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandOverShipmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandOverShipmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandOverShipmentRequestValidationError{}
+
+// Validate checks the field values on ShipmentIdRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ShipmentIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShipmentIdRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ShipmentIdRequestMultiError, or nil if none found.
+func (m *ShipmentIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShipmentIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetShipmentId() <= 0 {
+		err := ShipmentIdRequestValidationError{
+			field:  "ShipmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ShipmentIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShipmentIdRequestMultiError is an error wrapping multiple validation errors
+// returned by ShipmentIdRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ShipmentIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShipmentIdRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShipmentIdRequestMultiError) AllErrors() []error { return m }
+
+// ShipmentIdRequestValidationError is the validation error returned by
+// ShipmentIdRequest.Validate if the designated constraints aren't met.
+type ShipmentIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShipmentIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShipmentIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShipmentIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShipmentIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShipmentIdRequestValidationError) ErrorName() string {
+	return "ShipmentIdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShipmentIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShipmentIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShipmentIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShipmentIdRequestValidationError{}
+
+// Validate checks the field values on Shipment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Shipment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Shipment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in ShipmentMultiError, or nil if none found.
+func (m *Shipment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Shipment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShipmentId
+
+	// no validation rules for SenderId
+
+	// no validation rules for DestinationPointId
+
+	// no validation rules for Status
+
+	// no validation rules for CourierId
+
+	// no validation rules for Weight
+
+	// no validation rules for TotalPrice
+
+	// no validation rules for Fragile
+
+	// no validation rules for Hazardous
+
+	// no validation rules for AgeRestricted
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShipmentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShipmentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShipmentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedStatusAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShipmentValidationError{
+					field:  "UpdatedStatusAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShipmentValidationError{
+					field:  "UpdatedStatusAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedStatusAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShipmentValidationError{
+				field:  "UpdatedStatusAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Package != nil {
+		// no validation rules for Package
+	}
+
+	if len(errors) > 0 {
+		return ShipmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShipmentMultiError is an error wrapping multiple validation errors returned
+// by Shipment.ValidateAll() if the designated constraints aren't met.
+type ShipmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShipmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShipmentMultiError) AllErrors() []error { return m }
+
+// ShipmentValidationError is the validation error returned by Shipment.Validate
+// if the designated constraints aren't met.
+type ShipmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShipmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShipmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShipmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShipmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShipmentValidationError) ErrorName() string { return "ShipmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShipmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShipment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShipmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShipmentValidationError{}
+
+// Validate checks the field values on ShipmentHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ShipmentHistoryEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShipmentHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ShipmentHistoryEntryMultiError, or nil if none found.
+func (m *ShipmentHistoryEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShipmentHistoryEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShipmentHistoryEntryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShipmentHistoryEntryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShipmentHistoryEntryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ShipmentHistoryEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShipmentHistoryEntryMultiError is an error wrapping multiple validation
+// errors returned by ShipmentHistoryEntry.ValidateAll() if the designated
+// constraints aren't met.
+type ShipmentHistoryEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShipmentHistoryEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShipmentHistoryEntryMultiError) AllErrors() []error { return m }
+
+// ShipmentHistoryEntryValidationError is the validation error returned by
+// ShipmentHistoryEntry.Validate if the designated constraints aren't met.
+type ShipmentHistoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShipmentHistoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShipmentHistoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShipmentHistoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShipmentHistoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShipmentHistoryEntryValidationError) ErrorName() string {
+	return "ShipmentHistoryEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShipmentHistoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShipmentHistoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShipmentHistoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShipmentHistoryEntryValidationError{}
+
+// Validate checks the field values on ShipmentDetails with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ShipmentDetails) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShipmentDetails with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShipmentDetailsMultiError, or
+// nil if none found.
+func (m *ShipmentDetails) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShipmentDetails) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShipment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShipmentDetailsValidationError{
+					field:  "Shipment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShipmentDetailsValidationError{
+					field:  "Shipment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShipment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShipmentDetailsValidationError{
+				field:  "Shipment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ShipmentDetailsValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ShipmentDetailsValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ShipmentDetailsValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ShipmentDetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShipmentDetailsMultiError is an error wrapping multiple validation errors
+// returned by ShipmentDetails.ValidateAll() if the designated constraints
+// aren't met.
+type ShipmentDetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShipmentDetailsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShipmentDetailsMultiError) AllErrors() []error { return m }
+
+// ShipmentDetailsValidationError is the validation error returned by
+// ShipmentDetails.Validate if the designated constraints aren't met.
+type ShipmentDetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShipmentDetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShipmentDetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShipmentDetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShipmentDetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShipmentDetailsValidationError) ErrorName() string { return "ShipmentDetailsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShipmentDetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShipmentDetails.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShipmentDetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShipmentDetailsValidationError{}
+
+// Validate checks the field values on UndoResult with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *UndoResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UndoResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in UndoResultMultiError, or nil if none
+// found.
+func (m *UndoResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UndoResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for UndoneEvent
+
+	// no validation rules for Status
+
+	// no validation rules for Removed
+
+	if len(errors) > 0 {
+		return UndoResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// UndoResultMultiError is an error wrapping multiple validation errors returned
+// by UndoResult.ValidateAll() if the designated constraints aren't met.
+type UndoResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UndoResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UndoResultMultiError) AllErrors() []error { return m }
+
+// UndoResultValidationError is the validation error returned by
+// UndoResult.Validate if the designated constraints aren't met.
+type UndoResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UndoResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UndoResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UndoResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UndoResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UndoResultValidationError) ErrorName() string { return "UndoResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UndoResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUndoResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UndoResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UndoResultValidationError{}
+
+// Validate checks the field values on SearchOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *SearchOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// SearchOrdersRequestMultiError, or nil if none found.
+func (m *SearchOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InPvz
+
+	// no validation rules for Statuses
+
+	// no validation rules for Packages
+
+	if all {
+		switch v := interface{}(m.GetCreatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "CreatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "CreatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "CreatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "CreatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "ExpiresFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "ExpiresFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "ExpiresFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "ExpiresTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "ExpiresTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "ExpiresTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "UpdatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "UpdatedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "UpdatedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "UpdatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "UpdatedTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "UpdatedTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiringWithin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "ExpiringWithin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchOrdersRequestValidationError{
+					field:  "ExpiringWithin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiringWithin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchOrdersRequestValidationError{
+				field:  "ExpiringWithin",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortDesc
+
+	// no validation rules for PageToken
+
+	if m.UserId != nil {
+
+		if m.GetUserId() <= 0 {
+			err := SearchOrdersRequestValidationError{
+				field:  "UserId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PriceMin != nil {
+
+		if m.GetPriceMin() < 0 {
+			err := SearchOrdersRequestValidationError{
+				field:  "PriceMin",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PriceMax != nil {
+
+		if m.GetPriceMax() < 0 {
+			err := SearchOrdersRequestValidationError{
+				field:  "PriceMax",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.WeightMin != nil {
+
+		if m.GetWeightMin() < 0 {
+			err := SearchOrdersRequestValidationError{
+				field:  "WeightMin",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.WeightMax != nil {
+
+		if m.GetWeightMax() < 0 {
+			err := SearchOrdersRequestValidationError{
+				field:  "WeightMax",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Pagination != nil {
+
+		if all {
+			switch v := interface{}(m.GetPagination()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchOrdersRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchOrdersRequestValidationError{
+						field:  "Pagination",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchOrdersRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchOrdersRequestMultiError) AllErrors() []error { return m }
+
+// SearchOrdersRequestValidationError is the validation error returned by
+// SearchOrdersRequest.Validate if the designated constraints aren't met.
+type SearchOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchOrdersRequestValidationError) ErrorName() string {
+	return "SearchOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchOrdersRequestValidationError{}
+
+// Validate checks the field values on OrderDetails with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderDetails) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderDetails with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in OrderDetailsMultiError, or nil if
+// none found.
+func (m *OrderDetails) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderDetails) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderDetailsValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderDetailsValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderDetailsValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStorageDeadline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsValidationError{
+					field:  "StorageDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsValidationError{
+					field:  "StorageDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsValidationError{
+				field:  "StorageDeadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReturnDeadline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsValidationError{
+					field:  "ReturnDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsValidationError{
+					field:  "ReturnDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsValidationError{
+				field:  "ReturnDeadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderDetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderDetailsMultiError is an error wrapping multiple validation errors
+// returned by OrderDetails.ValidateAll() if the designated constraints aren't
+// met.
+type OrderDetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderDetailsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderDetailsMultiError) AllErrors() []error { return m }
+
+// OrderDetailsValidationError is the validation error returned by
+// OrderDetails.Validate if the designated constraints aren't met.
+type OrderDetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderDetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderDetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderDetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderDetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderDetailsValidationError) ErrorName() string { return "OrderDetailsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderDetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderDetails.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderDetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderDetailsValidationError{}
+
+// Validate checks the field values on ScrollOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ScrollOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScrollOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ScrollOrdersRequestMultiError, or nil if none found.
+func (m *ScrollOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScrollOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ScrollOrdersRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	if m.Limit != nil {
+
+		if m.GetLimit() < 1 {
+			err := ScrollOrdersRequestValidationError{
+				field:  "Limit",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ScrollOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScrollOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by ScrollOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ScrollOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScrollOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScrollOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ScrollOrdersRequestValidationError is the validation error returned by
+// ScrollOrdersRequest.Validate if the designated constraints aren't met.
+type ScrollOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScrollOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScrollOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScrollOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScrollOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScrollOrdersRequestValidationError) ErrorName() string {
+	return "ScrollOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScrollOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScrollOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScrollOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScrollOrdersRequestValidationError{}
+
+// Validate checks the field values on ScrollOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the first error encountered is returned, or nil if there are no violations.
+func (m *ScrollOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScrollOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ScrollOrdersResponseMultiError, or nil if none found.
+func (m *ScrollOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScrollOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScrollOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScrollOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScrollOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ScrollOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScrollOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by ScrollOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ScrollOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScrollOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScrollOrdersResponseMultiError) AllErrors() []error { return m }
+
+// ScrollOrdersResponseValidationError is the validation error returned by
+// ScrollOrdersResponse.Validate if the designated constraints aren't met.
+type ScrollOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScrollOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScrollOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScrollOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScrollOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScrollOrdersResponseValidationError) ErrorName() string {
+	return "ScrollOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScrollOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScrollOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScrollOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScrollOrdersResponseValidationError{}
+
+// Validate checks the field values on ExportRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExportRequestMultiError, or
+// nil if none found.
+func (m *ExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ExportRequest_Dataset_NotInLookup[m.GetDataset()]; ok {
+		err := ExportRequestValidationError{
+			field:  "Dataset",
+			reason: "value must not be in list [EXPORT_DATASET_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ExportDataset_name[int32(m.GetDataset())]; !ok {
+		err := ExportRequestValidationError{
+			field:  "Dataset",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ExportRequest_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ExportRequestValidationError{
+			field:  "Format",
+			reason: "value must not be in list [EXPORT_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ExportFormat_name[int32(m.GetFormat())]; !ok {
+		err := ExportRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.OrderId != nil {
+
+		if m.GetOrderId() <= 0 {
+			err := ExportRequestValidationError{
+				field:  "OrderId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportRequest.ValidateAll() if the designated constraints aren't
+// met.
+type ExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportRequestMultiError) AllErrors() []error { return m }
+
+// ExportRequestValidationError is the validation error returned by
+// ExportRequest.Validate if the designated constraints aren't met.
+type ExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRequestValidationError) ErrorName() string { return "ExportRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRequestValidationError{}
+
+var _ExportRequest_Dataset_NotInLookup = map[ExportDataset]struct{}{
+	0: {},
+}
+
+var _ExportRequest_Format_NotInLookup = map[ExportFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ExportChunk with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *ExportChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in ExportChunkMultiError, or nil if
+// none found.
+func (m *ExportChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for ContentType
+
+	if len(errors) > 0 {
+		return ExportChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportChunkMultiError is an error wrapping multiple validation errors
+// returned by ExportChunk.ValidateAll() if the designated constraints aren't
+// met.
+type ExportChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportChunkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportChunkMultiError) AllErrors() []error { return m }
+
+// ExportChunkValidationError is the validation error returned by
+// ExportChunk.Validate if the designated constraints aren't met.
+type ExportChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportChunkValidationError) ErrorName() string { return "ExportChunkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportChunkValidationError{}
+
+// Validate checks the field values on ImportRowReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportRowReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRowReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportRowReportMultiError, or
+// nil if none found.
+func (m *ImportRowReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRowReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	// no validation rules for OrderId
+
+	// no validation rules for Valid
+
+	// no validation rules for TotalPrice
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ImportRowReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRowReportMultiError is an error wrapping multiple validation errors
+// returned by ImportRowReport.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRowReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRowReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRowReportMultiError) AllErrors() []error { return m }
+
+// ImportRowReportValidationError is the validation error returned by
+// ImportRowReport.Validate if the designated constraints aren't met.
+type ImportRowReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRowReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRowReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRowReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRowReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRowReportValidationError) ErrorName() string { return "ImportRowReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRowReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRowReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRowReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRowReportValidationError{}
+
+// Validate checks the field values on ImportJobIdRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportJobIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJobIdRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ImportJobIdRequestMultiError, or nil if none found.
+func (m *ImportJobIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJobIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetJobId() <= 0 {
+		err := ImportJobIdRequestValidationError{
+			field:  "JobId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImportJobIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobIdRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportJobIdRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportJobIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobIdRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobIdRequestMultiError) AllErrors() []error { return m }
+
+// ImportJobIdRequestValidationError is the validation error returned by
+// ImportJobIdRequest.Validate if the designated constraints aren't met.
+type ImportJobIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobIdRequestValidationError) ErrorName() string {
+	return "ImportJobIdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportJobIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJobIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobIdRequestValidationError{}
+
+// Validate checks the field values on ImportJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *ImportJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the result
+// is a list of violation errors wrapped in ImportJobMultiError, or nil if none
+// found.
+func (m *ImportJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for Status
+
+	// no validation rules for Atomic
+
+	// no validation rules for Total
+
+	// no validation rules for Processed
+
+	// no validation rules for Imported
+
+	// no validation rules for Failed
+
+	// no validation rules for OperatorId
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.FinishedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetFinishedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportJobValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobMultiError is an error wrapping multiple validation errors returned
+// by ImportJob.ValidateAll() if the designated constraints aren't met.
+type ImportJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobMultiError) AllErrors() []error { return m }
+
+// ImportJobValidationError is the validation error returned by
+// ImportJob.Validate if the designated constraints aren't met.
+type ImportJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobValidationError) ErrorName() string { return "ImportJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobValidationError{}
+
+// Validate checks the field values on ImportJobFailure with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportJobFailure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJobFailure with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ImportJobFailureMultiError, or nil if none found.
+func (m *ImportJobFailure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJobFailure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	// no validation rules for OrderId
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ImportJobFailureMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobFailureMultiError is an error wrapping multiple validation errors
+// returned by ImportJobFailure.ValidateAll() if the designated constraints
+// aren't met.
+type ImportJobFailureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobFailureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobFailureMultiError) AllErrors() []error { return m }
+
+// ImportJobFailureValidationError is the validation error returned by
+// ImportJobFailure.Validate if the designated constraints aren't met.
+type ImportJobFailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobFailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobFailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobFailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobFailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobFailureValidationError) ErrorName() string { return "ImportJobFailureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportJobFailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJobFailure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobFailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobFailureValidationError{}
+
+// Validate checks the field values on ImportJobFailures with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportJobFailures) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJobFailures with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ImportJobFailuresMultiError, or nil if none found.
+func (m *ImportJobFailures) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJobFailures) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportJobFailuresValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportJobFailuresValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportJobFailuresValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportJobFailuresMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobFailuresMultiError is an error wrapping multiple validation errors
+// returned by ImportJobFailures.ValidateAll() if the designated constraints
+// aren't met.
+type ImportJobFailuresMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobFailuresMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobFailuresMultiError) AllErrors() []error { return m }
+
+// ImportJobFailuresValidationError is the validation error returned by
+// ImportJobFailures.Validate if the designated constraints aren't met.
+type ImportJobFailuresValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobFailuresValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobFailuresValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobFailuresValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobFailuresValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobFailuresValidationError) ErrorName() string {
+	return "ImportJobFailuresValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportJobFailuresValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJobFailures.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobFailuresValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobFailuresValidationError{}
+
+// Validate checks the field values on ImportOrdersChunk with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportOrdersChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportOrdersChunk with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// the result is a list of violation errors wrapped in
+// ImportOrdersChunkMultiError, or nil if none found.
+func (m *ImportOrdersChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportOrdersChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetOrders()); l < 1 || l > 1000 {
+		err := ImportOrdersChunkValidationError{
+			field:  "Orders",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportOrdersChunkValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportOrdersChunkValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportOrdersChunkValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ValidateOnly
+
+	if len(errors) > 0 {
+		return ImportOrdersChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportOrdersChunkMultiError is an error wrapping multiple validation errors
+// returned by ImportOrdersChunk.ValidateAll() if the designated constraints
+// aren't met.
+type ImportOrdersChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportOrdersChunkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportOrdersChunkMultiError) AllErrors() []error { return m }
+
+// ImportOrdersChunkValidationError is the validation error returned by
+// ImportOrdersChunk.Validate if the designated constraints aren't met.
+type ImportOrdersChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportOrdersChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportOrdersChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportOrdersChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportOrdersChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportOrdersChunkValidationError) ErrorName() string {
+	return "ImportOrdersChunkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportOrdersChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportOrdersChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportOrdersChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportOrdersChunkValidationError{}
+
+// Validate checks the field values on Actor with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Actor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Actor with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in ActorMultiError, or nil if none found.
+func (m *Actor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Actor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ActorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActorMultiError is an error wrapping multiple validation errors returned by
+// Actor.ValidateAll() if the designated constraints aren't met.
+type ActorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActorMultiError) AllErrors() []error { return m }
+
+// ActorValidationError is the validation error returned by Actor.Validate if
+// the designated constraints aren't met.
+type ActorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActorValidationError) ErrorName() string { return "ActorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActorValidationError{}
@@ -19,13 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrdersService_AcceptOrder_FullMethodName   = "/orders.OrdersService/AcceptOrder"
-	OrdersService_ReturnOrder_FullMethodName   = "/orders.OrdersService/ReturnOrder"
-	OrdersService_ProcessOrders_FullMethodName = "/orders.OrdersService/ProcessOrders"
-	OrdersService_ListOrders_FullMethodName    = "/orders.OrdersService/ListOrders"
-	OrdersService_ListReturns_FullMethodName   = "/orders.OrdersService/ListReturns"
-	OrdersService_GetHistory_FullMethodName    = "/orders.OrdersService/GetHistory"
-	OrdersService_ImportOrders_FullMethodName  = "/orders.OrdersService/ImportOrders"
+	OrdersService_AcceptOrder_FullMethodName           = "/orders.OrdersService/AcceptOrder"
+	OrdersService_ReturnOrder_FullMethodName           = "/orders.OrdersService/ReturnOrder"
+	OrdersService_ProcessOrders_FullMethodName         = "/orders.OrdersService/ProcessOrders"
+	OrdersService_IssueAllReady_FullMethodName         = "/orders.OrdersService/IssueAllReady"
+	OrdersService_UndoLastOperation_FullMethodName     = "/orders.OrdersService/UndoLastOperation"
+	OrdersService_GetOrder_FullMethodName              = "/orders.OrdersService/GetOrder"
+	OrdersService_ListOrders_FullMethodName            = "/orders.OrdersService/ListOrders"
+	OrdersService_ScrollOrders_FullMethodName          = "/orders.OrdersService/ScrollOrders"
+	OrdersService_SearchOrders_FullMethodName          = "/orders.OrdersService/SearchOrders"
+	OrdersService_ListReturns_FullMethodName           = "/orders.OrdersService/ListReturns"
+	OrdersService_GetHistory_FullMethodName            = "/orders.OrdersService/GetHistory"
+	OrdersService_ImportOrders_FullMethodName          = "/orders.OrdersService/ImportOrders"
+	OrdersService_ImportOrdersStream_FullMethodName    = "/orders.OrdersService/ImportOrdersStream"
+	OrdersService_SubmitImportJob_FullMethodName       = "/orders.OrdersService/SubmitImportJob"
+	OrdersService_GetImportJob_FullMethodName          = "/orders.OrdersService/GetImportJob"
+	OrdersService_WatchImportJob_FullMethodName        = "/orders.OrdersService/WatchImportJob"
+	OrdersService_ListImportJobFailures_FullMethodName = "/orders.OrdersService/ListImportJobFailures"
+	OrdersService_CancelImportJob_FullMethodName       = "/orders.OrdersService/CancelImportJob"
+	OrdersService_ExportOrders_FullMethodName          = "/orders.OrdersService/ExportOrders"
+	OrdersService_RegisterShipment_FullMethodName      = "/orders.OrdersService/RegisterShipment"
+	OrdersService_HandOverShipment_FullMethodName      = "/orders.OrdersService/HandOverShipment"
+	OrdersService_GetShipment_FullMethodName           = "/orders.OrdersService/GetShipment"
 )
 
 // OrdersServiceClient is the client API for OrdersService service.
@@ -35,10 +50,32 @@ type OrdersServiceClient interface {
 	AcceptOrder(ctx context.Context, in *AcceptOrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
 	ReturnOrder(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*OrderResponse, error)
 	ProcessOrders(ctx context.Context, in *ProcessOrdersRequest, opts ...grpc.CallOption) (*ProcessResult, error)
+	IssueAllReady(ctx context.Context, in *IssueAllReadyRequest, opts ...grpc.CallOption) (*IssueReceipt, error)
+	UndoLastOperation(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*UndoResult, error)
+	// GetOrder is declared before the static GET routes under /v1/orders so that they take precedence over {order_id}
+	GetOrder(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*OrderDetails, error)
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*OrdersList, error)
+	ScrollOrders(ctx context.Context, in *ScrollOrdersRequest, opts ...grpc.CallOption) (*ScrollOrdersResponse, error)
+	SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*OrdersList, error)
 	ListReturns(ctx context.Context, in *ListReturnsRequest, opts ...grpc.CallOption) (*ReturnsList, error)
 	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*OrderHistoryList, error)
 	ImportOrders(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportResult, error)
+	// ImportOrdersStream imports orders sent in chunks over a client stream and returns the aggregated result once the stream is closed.
+	// Each chunk is imported before the next one is read, so a client sending faster than the server imports is slowed down by flow control.
+	ImportOrdersStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportOrdersChunk, ImportResult], error)
+	// SubmitImportJob queues the import to run in the background and returns the job without waiting for it
+	SubmitImportJob(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	GetImportJob(ctx context.Context, in *ImportJobIdRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	// WatchImportJob streams the job state on every progress update until the job finishes
+	WatchImportJob(ctx context.Context, in *ImportJobIdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportJob], error)
+	ListImportJobFailures(ctx context.Context, in *ImportJobIdRequest, opts ...grpc.CallOption) (*ImportJobFailures, error)
+	// CancelImportJob drops a queued job or stops a running one; orders imported before stay imported
+	CancelImportJob(ctx context.Context, in *ImportJobIdRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	// ExportOrders streams a dataset as CSV or NDJSON; the HTTP download at GET /v1/orders/export is served by a custom gateway route
+	ExportOrders(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	RegisterShipment(ctx context.Context, in *RegisterShipmentRequest, opts ...grpc.CallOption) (*Shipment, error)
+	HandOverShipment(ctx context.Context, in *HandOverShipmentRequest, opts ...grpc.CallOption) (*Shipment, error)
+	GetShipment(ctx context.Context, in *ShipmentIdRequest, opts ...grpc.CallOption) (*ShipmentDetails, error)
 }
 
 type ordersServiceClient struct {
@@ -79,6 +116,36 @@ func (c *ordersServiceClient) ProcessOrders(ctx context.Context, in *ProcessOrde
 	return out, nil
 }
 
+func (c *ordersServiceClient) IssueAllReady(ctx context.Context, in *IssueAllReadyRequest, opts ...grpc.CallOption) (*IssueReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueReceipt)
+	err := c.cc.Invoke(ctx, OrdersService_IssueAllReady_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) UndoLastOperation(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*UndoResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoResult)
+	err := c.cc.Invoke(ctx, OrdersService_UndoLastOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetOrder(ctx context.Context, in *OrderIdRequest, opts ...grpc.CallOption) (*OrderDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderDetails)
+	err := c.cc.Invoke(ctx, OrdersService_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ordersServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*OrdersList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OrdersList)
@@ -89,6 +156,26 @@ func (c *ordersServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequ
 	return out, nil
 }
 
+func (c *ordersServiceClient) ScrollOrders(ctx context.Context, in *ScrollOrdersRequest, opts ...grpc.CallOption) (*ScrollOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScrollOrdersResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ScrollOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*OrdersList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrdersList)
+	err := c.cc.Invoke(ctx, OrdersService_SearchOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ordersServiceClient) ListReturns(ctx context.Context, in *ListReturnsRequest, opts ...grpc.CallOption) (*ReturnsList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReturnsList)
@@ -119,6 +206,127 @@ func (c *ordersServiceClient) ImportOrders(ctx context.Context, in *ImportOrders
 	return out, nil
 }
 
+func (c *ordersServiceClient) ImportOrdersStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportOrdersChunk, ImportResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrdersService_ServiceDesc.Streams[0], OrdersService_ImportOrdersStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportOrdersChunk, ImportResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_ImportOrdersStreamClient = grpc.ClientStreamingClient[ImportOrdersChunk, ImportResult]
+
+func (c *ordersServiceClient) SubmitImportJob(ctx context.Context, in *ImportOrdersRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, OrdersService_SubmitImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetImportJob(ctx context.Context, in *ImportJobIdRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, OrdersService_GetImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) WatchImportJob(ctx context.Context, in *ImportJobIdRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ImportJob], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrdersService_ServiceDesc.Streams[1], OrdersService_WatchImportJob_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportJobIdRequest, ImportJob]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_WatchImportJobClient = grpc.ServerStreamingClient[ImportJob]
+
+func (c *ordersServiceClient) ListImportJobFailures(ctx context.Context, in *ImportJobIdRequest, opts ...grpc.CallOption) (*ImportJobFailures, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJobFailures)
+	err := c.cc.Invoke(ctx, OrdersService_ListImportJobFailures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CancelImportJob(ctx context.Context, in *ImportJobIdRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, OrdersService_CancelImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ExportOrders(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrdersService_ServiceDesc.Streams[2], OrdersService_ExportOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_ExportOrdersClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *ordersServiceClient) RegisterShipment(ctx context.Context, in *RegisterShipmentRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, OrdersService_RegisterShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) HandOverShipment(ctx context.Context, in *HandOverShipmentRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, OrdersService_HandOverShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetShipment(ctx context.Context, in *ShipmentIdRequest, opts ...grpc.CallOption) (*ShipmentDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentDetails)
+	err := c.cc.Invoke(ctx, OrdersService_GetShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrdersServiceServer is the server API for OrdersService service.
 // All implementations must embed UnimplementedOrdersServiceServer
 // for forward compatibility.
@@ -126,10 +334,32 @@ type OrdersServiceServer interface {
 	AcceptOrder(context.Context, *AcceptOrderRequest) (*OrderResponse, error)
 	ReturnOrder(context.Context, *OrderIdRequest) (*OrderResponse, error)
 	ProcessOrders(context.Context, *ProcessOrdersRequest) (*ProcessResult, error)
+	IssueAllReady(context.Context, *IssueAllReadyRequest) (*IssueReceipt, error)
+	UndoLastOperation(context.Context, *OrderIdRequest) (*UndoResult, error)
+	// GetOrder is declared before the static GET routes under /v1/orders so that they take precedence over {order_id}
+	GetOrder(context.Context, *OrderIdRequest) (*OrderDetails, error)
 	ListOrders(context.Context, *ListOrdersRequest) (*OrdersList, error)
+	ScrollOrders(context.Context, *ScrollOrdersRequest) (*ScrollOrdersResponse, error)
+	SearchOrders(context.Context, *SearchOrdersRequest) (*OrdersList, error)
 	ListReturns(context.Context, *ListReturnsRequest) (*ReturnsList, error)
 	GetHistory(context.Context, *GetHistoryRequest) (*OrderHistoryList, error)
 	ImportOrders(context.Context, *ImportOrdersRequest) (*ImportResult, error)
+	// ImportOrdersStream imports orders sent in chunks over a client stream and returns the aggregated result once the stream is closed.
+	// Each chunk is imported before the next one is read, so a client sending faster than the server imports is slowed down by flow control.
+	ImportOrdersStream(grpc.ClientStreamingServer[ImportOrdersChunk, ImportResult]) error
+	// SubmitImportJob queues the import to run in the background and returns the job without waiting for it
+	SubmitImportJob(context.Context, *ImportOrdersRequest) (*ImportJob, error)
+	GetImportJob(context.Context, *ImportJobIdRequest) (*ImportJob, error)
+	// WatchImportJob streams the job state on every progress update until the job finishes
+	WatchImportJob(*ImportJobIdRequest, grpc.ServerStreamingServer[ImportJob]) error
+	ListImportJobFailures(context.Context, *ImportJobIdRequest) (*ImportJobFailures, error)
+	// CancelImportJob drops a queued job or stops a running one; orders imported before stay imported
+	CancelImportJob(context.Context, *ImportJobIdRequest) (*ImportJob, error)
+	// ExportOrders streams a dataset as CSV or NDJSON; the HTTP download at GET /v1/orders/export is served by a custom gateway route
+	ExportOrders(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	RegisterShipment(context.Context, *RegisterShipmentRequest) (*Shipment, error)
+	HandOverShipment(context.Context, *HandOverShipmentRequest) (*Shipment, error)
+	GetShipment(context.Context, *ShipmentIdRequest) (*ShipmentDetails, error)
 	mustEmbedUnimplementedOrdersServiceServer()
 }
 
@@ -149,9 +379,24 @@ func (UnimplementedOrdersServiceServer) ReturnOrder(context.Context, *OrderIdReq
 func (UnimplementedOrdersServiceServer) ProcessOrders(context.Context, *ProcessOrdersRequest) (*ProcessResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessOrders not implemented")
 }
+func (UnimplementedOrdersServiceServer) IssueAllReady(context.Context, *IssueAllReadyRequest) (*IssueReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueAllReady not implemented")
+}
+func (UnimplementedOrdersServiceServer) UndoLastOperation(context.Context, *OrderIdRequest) (*UndoResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoLastOperation not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetOrder(context.Context, *OrderIdRequest) (*OrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
 func (UnimplementedOrdersServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*OrdersList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
 }
+func (UnimplementedOrdersServiceServer) ScrollOrders(context.Context, *ScrollOrdersRequest) (*ScrollOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScrollOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) SearchOrders(context.Context, *SearchOrdersRequest) (*OrdersList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchOrders not implemented")
+}
 func (UnimplementedOrdersServiceServer) ListReturns(context.Context, *ListReturnsRequest) (*ReturnsList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReturns not implemented")
 }
@@ -161,6 +406,36 @@ func (UnimplementedOrdersServiceServer) GetHistory(context.Context, *GetHistoryR
 func (UnimplementedOrdersServiceServer) ImportOrders(context.Context, *ImportOrdersRequest) (*ImportResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportOrders not implemented")
 }
+func (UnimplementedOrdersServiceServer) ImportOrdersStream(grpc.ClientStreamingServer[ImportOrdersChunk, ImportResult]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportOrdersStream not implemented")
+}
+func (UnimplementedOrdersServiceServer) SubmitImportJob(context.Context, *ImportOrdersRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitImportJob not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetImportJob(context.Context, *ImportJobIdRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedOrdersServiceServer) WatchImportJob(*ImportJobIdRequest, grpc.ServerStreamingServer[ImportJob]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchImportJob not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListImportJobFailures(context.Context, *ImportJobIdRequest) (*ImportJobFailures, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImportJobFailures not implemented")
+}
+func (UnimplementedOrdersServiceServer) CancelImportJob(context.Context, *ImportJobIdRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelImportJob not implemented")
+}
+func (UnimplementedOrdersServiceServer) ExportOrders(*ExportRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOrders not implemented")
+}
+func (UnimplementedOrdersServiceServer) RegisterShipment(context.Context, *RegisterShipmentRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterShipment not implemented")
+}
+func (UnimplementedOrdersServiceServer) HandOverShipment(context.Context, *HandOverShipmentRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandOverShipment not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetShipment(context.Context, *ShipmentIdRequest) (*ShipmentDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipment not implemented")
+}
 func (UnimplementedOrdersServiceServer) mustEmbedUnimplementedOrdersServiceServer() {}
 func (UnimplementedOrdersServiceServer) testEmbeddedByValue()                       {}
 
@@ -236,6 +511,60 @@ func _OrdersService_ProcessOrders_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_IssueAllReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueAllReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).IssueAllReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_IssueAllReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).IssueAllReady(ctx, req.(*IssueAllReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_UndoLastOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).UndoLastOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_UndoLastOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).UndoLastOperation(ctx, req.(*OrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetOrder(ctx, req.(*OrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrdersService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrdersRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +583,42 @@ func _OrdersService_ListOrders_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_ScrollOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScrollOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ScrollOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ScrollOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ScrollOrders(ctx, req.(*ScrollOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_SearchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).SearchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_SearchOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).SearchOrders(ctx, req.(*SearchOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrdersService_ListReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListReturnsRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +673,161 @@ func _OrdersService_ImportOrders_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_ImportOrdersStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrdersServiceServer).ImportOrdersStream(&grpc.GenericServerStream[ImportOrdersChunk, ImportResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_ImportOrdersStreamServer = grpc.ClientStreamingServer[ImportOrdersChunk, ImportResult]
+
+func _OrdersService_SubmitImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).SubmitImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_SubmitImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).SubmitImportJob(ctx, req.(*ImportOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportJobIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetImportJob(ctx, req.(*ImportJobIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_WatchImportJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImportJobIdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrdersServiceServer).WatchImportJob(m, &grpc.GenericServerStream[ImportJobIdRequest, ImportJob]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_WatchImportJobServer = grpc.ServerStreamingServer[ImportJob]
+
+func _OrdersService_ListImportJobFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportJobIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListImportJobFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListImportJobFailures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListImportJobFailures(ctx, req.(*ImportJobIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CancelImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportJobIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CancelImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CancelImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CancelImportJob(ctx, req.(*ImportJobIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ExportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrdersServiceServer).ExportOrders(m, &grpc.GenericServerStream[ExportRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_ExportOrdersServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _OrdersService_RegisterShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).RegisterShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_RegisterShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).RegisterShipment(ctx, req.(*RegisterShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_HandOverShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandOverShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).HandOverShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_HandOverShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).HandOverShipment(ctx, req.(*HandOverShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetShipment(ctx, req.(*ShipmentIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrdersService_ServiceDesc is the grpc.ServiceDesc for OrdersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,10 +847,30 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessOrders",
 			Handler:    _OrdersService_ProcessOrders_Handler,
 		},
+		{
+			MethodName: "IssueAllReady",
+			Handler:    _OrdersService_IssueAllReady_Handler,
+		},
+		{
+			MethodName: "UndoLastOperation",
+			Handler:    _OrdersService_UndoLastOperation_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _OrdersService_GetOrder_Handler,
+		},
 		{
 			MethodName: "ListOrders",
 			Handler:    _OrdersService_ListOrders_Handler,
 		},
+		{
+			MethodName: "ScrollOrders",
+			Handler:    _OrdersService_ScrollOrders_Handler,
+		},
+		{
+			MethodName: "SearchOrders",
+			Handler:    _OrdersService_SearchOrders_Handler,
+		},
 		{
 			MethodName: "ListReturns",
 			Handler:    _OrdersService_ListReturns_Handler,
@@ -343,7 +883,51 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportOrders",
 			Handler:    _OrdersService_ImportOrders_Handler,
 		},
+		{
+			MethodName: "SubmitImportJob",
+			Handler:    _OrdersService_SubmitImportJob_Handler,
+		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _OrdersService_GetImportJob_Handler,
+		},
+		{
+			MethodName: "ListImportJobFailures",
+			Handler:    _OrdersService_ListImportJobFailures_Handler,
+		},
+		{
+			MethodName: "CancelImportJob",
+			Handler:    _OrdersService_CancelImportJob_Handler,
+		},
+		{
+			MethodName: "RegisterShipment",
+			Handler:    _OrdersService_RegisterShipment_Handler,
+		},
+		{
+			MethodName: "HandOverShipment",
+			Handler:    _OrdersService_HandOverShipment_Handler,
+		},
+		{
+			MethodName: "GetShipment",
+			Handler:    _OrdersService_GetShipment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportOrdersStream",
+			Handler:       _OrdersService_ImportOrdersStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchImportJob",
+			Handler:       _OrdersService_WatchImportJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportOrders",
+			Handler:       _OrdersService_ExportOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "orders.proto",
 }
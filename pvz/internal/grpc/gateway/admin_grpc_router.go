@@ -2,10 +2,21 @@ package gateway
 
 import (
 	"context"
+	"encoding/json"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
 	pb "pvz-cli/internal/gen/admin"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/services"
 	"pvz-cli/internal/workerpool"
+	"pvz-cli/pkg/cache"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type workerStats struct {
@@ -19,13 +30,33 @@ type workerStats struct {
 // AdminGRPCRouter is a gRPC server implementation for managing worker pool settings and retrieving statistics.
 type AdminGRPCRouter struct {
 	pb.UnimplementedAdminServiceServer
-	pool workerpool.WorkerPool
+	pool           workerpool.WorkerPool
+	calendarSvc    services.CalendarService
+	userDataSvc    services.UserDataService
+	consistencySvc services.ConsistencyService
+	auditSvc       services.AuditService
+	responsesCache cache.Cache[string, any]
 }
 
-// NewAdminGRPCRouter creates a new instance of AdminGRPCRouter with the provided worker pool for managing worker operations.
-func NewAdminGRPCRouter(pool workerpool.WorkerPool) *AdminGRPCRouter {
+// NewAdminGRPCRouter creates a new instance of AdminGRPCRouter with the provided worker pool, calendar, user data,
+// consistency and audit services.
+// The responses cache is invalidated when user data is anonymized or orders are repaired so cached order lists and
+// histories do not outlive the change.
+func NewAdminGRPCRouter(
+	pool workerpool.WorkerPool,
+	calendarSvc services.CalendarService,
+	userDataSvc services.UserDataService,
+	consistencySvc services.ConsistencyService,
+	auditSvc services.AuditService,
+	responsesCache cache.Cache[string, any],
+) *AdminGRPCRouter {
 	return &AdminGRPCRouter{
-		pool: pool,
+		pool:           pool,
+		calendarSvc:    calendarSvc,
+		userDataSvc:    userDataSvc,
+		consistencySvc: consistencySvc,
+		auditSvc:       auditSvc,
+		responsesCache: responsesCache,
 	}
 }
 
@@ -70,6 +101,156 @@ func (r *AdminGRPCRouter) GetWorkerStats(
 	}, nil
 }
 
+// GetPickupCalendar returns the pickup-point calendar currently stored.
+func (r *AdminGRPCRouter) GetPickupCalendar(
+	ctx context.Context,
+	req *pb.GetPickupCalendarRequest,
+) (*pb.PickupCalendar, error) {
+	cal, err := r.calendarSvc.GetCalendar(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toPbPickupCalendar(cal), nil
+}
+
+// SetPickupCalendar replaces the pickup-point calendar with opening hours, weekly days off and holidays from the request.
+func (r *AdminGRPCRouter) SetPickupCalendar(
+	ctx context.Context,
+	req *pb.SetPickupCalendarRequest,
+) (*pb.PickupCalendar, error) {
+	cal, err := fromPbPickupCalendar(req.Calendar)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	updated, err := r.calendarSvc.UpdateCalendar(ctx, cal)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return toPbPickupCalendar(updated), nil
+}
+
+// ExportUserData returns everything stored about the user as a JSON bundle.
+func (r *AdminGRPCRouter) ExportUserData(
+	ctx context.Context,
+	req *pb.UserDataRequest,
+) (*pb.UserDataExport, error) {
+	export, err := r.userDataSvc.ExportUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	bundle, err := json.Marshal(export)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode export bundle: %v", err)
+	}
+	return &pb.UserDataExport{
+		UserId: req.UserId,
+		Bundle: string(bundle),
+	}, nil
+}
+
+// AnonymizeUserData erases the user's identity from orders and events and reports how many records were changed.
+// It is refused while the user still has orders at the pickup point.
+func (r *AdminGRPCRouter) AnonymizeUserData(
+	ctx context.Context,
+	req *pb.UserDataRequest,
+) (*pb.AnonymizeUserDataResponse, error) {
+	res, err := r.userDataSvc.AnonymizeUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	// cached lists and histories still carry the user's ID and their actor entries
+	r.responsesCache.InvalidatePattern("^ListOrders:")
+	r.responsesCache.InvalidatePattern("^OrderHistory:")
+	return &pb.AnonymizeUserDataResponse{
+		UserId:            res.UserID,
+		Orders:            uint32(res.Orders),
+		ArchivedOrders:    uint32(res.ArchivedOrders),
+		OutboxEvents:      uint32(res.OutboxEvents),
+		Shipments:         uint32(res.Shipments),
+		ImportJobFailures: uint32(res.ImportJobFailures),
+		IdempotencyKeys:   uint32(res.IdempotencyKeys),
+	}, nil
+}
+
+// CheckConsistency compares every order with its history and outbox events, repairing what it can when asked to.
+func (r *AdminGRPCRouter) CheckConsistency(
+	ctx context.Context,
+	req *pb.CheckConsistencyRequest,
+) (*pb.ConsistencyReport, error) {
+	report, err := r.consistencySvc.Check(ctx, req.Repair)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	if report.Repaired > 0 {
+		r.responsesCache.InvalidatePattern("^ListOrders:")
+	}
+	issues := make([]*pb.ConsistencyIssue, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		issues = append(issues, &pb.ConsistencyIssue{
+			OrderId:  issue.OrderID,
+			Kind:     string(issue.Kind),
+			Details:  issue.Details,
+			Repaired: issue.Repaired,
+		})
+	}
+	return &pb.ConsistencyReport{
+		CheckedOrders: uint32(report.CheckedOrders),
+		Issues:        issues,
+		Repaired:      uint32(report.Repaired),
+	}, nil
+}
+
+// ListAuditLog returns a page of recorded mutating calls matching the request, newest first.
+func (r *AdminGRPCRouter) ListAuditLog(
+	ctx context.Context,
+	req *pb.ListAuditLogRequest,
+) (*pb.AuditLog, error) {
+	filter := requests.AuditLogFilter{
+		OperatorID: strings.TrimSpace(req.OperatorId),
+		Method:     strings.TrimSpace(req.Method),
+		Source:     models.AuditSource(strings.ToLower(strings.TrimSpace(req.Source))),
+		ResultCode: strings.TrimSpace(req.ResultCode),
+		Page:       constants.DefaultAuditPage,
+		Limit:      constants.DefaultAuditLimit,
+	}
+	if req.Page > 0 {
+		filter.Page = int(req.Page)
+	}
+	if req.Limit > 0 {
+		filter.Limit = int(req.Limit)
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+	records, total, err := r.auditSvc.List(ctx, filter)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	out := make([]*pb.AuditRecord, 0, len(records))
+	for _, rec := range records {
+		out = append(out, &pb.AuditRecord{
+			Id:            rec.ID,
+			Timestamp:     timestamppb.New(rec.Timestamp),
+			OperatorId:    rec.OperatorID,
+			Role:          rec.Role,
+			Source:        string(rec.Source),
+			Method:        rec.Method,
+			Request:       string(rec.Request),
+			ResultCode:    rec.ResultCode,
+			CorrelationId: rec.CorrelationID,
+		})
+	}
+	return &pb.AuditLog{
+		Records: out,
+		Total:   uint32(total),
+	}, nil
+}
+
 func (r *AdminGRPCRouter) parseStats(stats map[string]interface{}) (*workerStats, error) {
 	activeWorkers, ok := stats["worker_count"].(int32)
 	if !ok {
@@ -99,3 +280,43 @@ func (r *AdminGRPCRouter) parseStats(stats map[string]interface{}) (*workerStats
 		isShutdown:    isShutdown,
 	}, nil
 }
+
+func toPbPickupCalendar(cal models.PickupCalendar) *pb.PickupCalendar {
+	daysOff := make([]uint32, 0, len(cal.WeeklyDaysOff))
+	for _, wd := range cal.WeeklyDaysOff {
+		daysOff = append(daysOff, uint32(wd))
+	}
+	holidays := make([]string, 0, len(cal.Holidays))
+	for _, h := range cal.Holidays {
+		holidays = append(holidays, h.Format(constants.TimeLayout))
+	}
+	return &pb.PickupCalendar{
+		OpensAt:       cal.OpensAt,
+		ClosesAt:      cal.ClosesAt,
+		WeeklyDaysOff: daysOff,
+		Holidays:      holidays,
+		BusinessDays:  cal.BusinessDays,
+	}
+}
+
+func fromPbPickupCalendar(in *pb.PickupCalendar) (models.PickupCalendar, error) {
+	daysOff := make([]time.Weekday, 0, len(in.WeeklyDaysOff))
+	for _, wd := range in.WeeklyDaysOff {
+		daysOff = append(daysOff, time.Weekday(wd))
+	}
+	holidays := make([]time.Time, 0, len(in.Holidays))
+	for _, raw := range in.Holidays {
+		h, err := time.Parse(constants.TimeLayout, raw)
+		if err != nil {
+			return models.PickupCalendar{}, apperrors.Newf(apperrors.ValidationFailed, "invalid holiday date %q", raw)
+		}
+		holidays = append(holidays, h)
+	}
+	return models.PickupCalendar{
+		OpensAt:       in.OpensAt,
+		ClosesAt:      in.ClosesAt,
+		WeeklyDaysOff: daysOff,
+		Holidays:      holidays,
+		BusinessDays:  in.BusinessDays,
+	}, nil
+}
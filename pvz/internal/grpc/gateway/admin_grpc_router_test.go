@@ -0,0 +1,118 @@
+package gateway
+
+import (
+	"context"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
+	pb "pvz-cli/internal/gen/admin"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	svcmocks "pvz-cli/internal/usecases/services/mocks"
+	"pvz-cli/pkg/cache"
+	"pvz-cli/pkg/cache/policies"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestAdminGRPCRouter_ListAuditLog verifies that the request is mapped to a filter with default paging and that
+// the records and total are returned.
+func TestAdminGRPCRouter_ListAuditLog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	from := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	at := from.Add(time.Hour)
+
+	auditSvc := svcmocks.NewAuditServiceMock(t)
+	auditSvc.ListMock.Expect(ctx, requests.AuditLogFilter{
+		OperatorID: "op-1",
+		Method:     "AcceptOrder",
+		Source:     models.AuditSourceGRPC,
+		ResultCode: "OK",
+		From:       &from,
+		Page:       constants.DefaultAuditPage,
+		Limit:      constants.DefaultAuditLimit,
+	}).Return([]models.AuditRecord{{
+		ID:            3,
+		Timestamp:     at,
+		OperatorID:    "op-1",
+		Role:          "operator",
+		Source:        models.AuditSourceGRPC,
+		Method:        "/orders.OrdersService/AcceptOrder",
+		Request:       []byte(`{"order_id":"1"}`),
+		ResultCode:    "OK",
+		CorrelationID: "corr-1",
+	}}, 7, nil)
+	router := NewAdminGRPCRouter(nil, nil, nil, nil, auditSvc, cache.NewNoopCache())
+
+	resp, err := router.ListAuditLog(ctx, &pb.ListAuditLogRequest{
+		OperatorId: " op-1 ",
+		Method:     "AcceptOrder",
+		Source:     "GRPC",
+		ResultCode: "OK",
+		From:       timestamppb.New(from),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), resp.Total)
+	require.Len(t, resp.Records, 1)
+	rec := resp.Records[0]
+	require.Equal(t, uint64(3), rec.Id)
+	require.Equal(t, at, rec.Timestamp.AsTime())
+	require.Equal(t, "op-1", rec.OperatorId)
+	require.Equal(t, "operator", rec.Role)
+	require.Equal(t, "grpc", rec.Source)
+	require.Equal(t, "/orders.OrdersService/AcceptOrder", rec.Method)
+	require.Equal(t, `{"order_id":"1"}`, rec.Request)
+	require.Equal(t, "corr-1", rec.CorrelationId)
+}
+
+// TestAdminGRPCRouter_ListAuditLog_InvalidFilter verifies that a filter rejected by the service is an invalid argument.
+func TestAdminGRPCRouter_ListAuditLog_InvalidFilter(t *testing.T) {
+	t.Parallel()
+	auditSvc := svcmocks.NewAuditServiceMock(t)
+	var filter requests.AuditLogFilter
+	auditSvc.ListMock.Set(func(_ context.Context, f requests.AuditLogFilter) ([]models.AuditRecord, int, error) {
+		filter = f
+		return nil, 0, apperrors.Newf(apperrors.ValidationFailed, "invalid source: ftp")
+	})
+	router := NewAdminGRPCRouter(nil, nil, nil, nil, auditSvc, cache.NewNoopCache())
+
+	_, err := router.ListAuditLog(context.Background(), &pb.ListAuditLogRequest{Source: "ftp", Page: 2, Limit: 5})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, 2, filter.Page)
+	require.Equal(t, 5, filter.Limit)
+}
+
+// TestAdminGRPCRouter_AnonymizeUserData verifies that cached order lists and histories are dropped after the
+// erasure and that a client with orders at the pickup point is refused.
+func TestAdminGRPCRouter_AnonymizeUserData(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	responses := cache.NewInMemoryShardedCache[string, any](1, policies.NewTTLPolicy[string, any](), "test", "admin_router",
+		prometheus.NewRegistry())
+	t.Cleanup(func() { _ = responses.Close() })
+	userDataSvc := svcmocks.NewUserDataServiceMock(t)
+	userDataSvc.AnonymizeUserDataMock.Set(func(_ context.Context, userID uint64) (models.AnonymizeResult, error) {
+		if userID == 43 {
+			return models.AnonymizeResult{}, apperrors.Newf(apperrors.UserHasActiveOrders, "user 43 still has orders")
+		}
+		return models.AnonymizeResult{UserID: userID, Orders: 2}, nil
+	})
+	router := NewAdminGRPCRouter(nil, nil, userDataSvc, nil, nil, responses)
+
+	responses.Set("ListOrders:42", "list", time.Hour)
+	responses.Set("OrderHistory:1", "history", time.Hour)
+	_, err := router.AnonymizeUserData(ctx, &pb.UserDataRequest{UserId: 43})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Equal(t, 2, responses.Size())
+
+	resp, err := router.AnonymizeUserData(ctx, &pb.UserDataRequest{UserId: 42})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), resp.Orders)
+	require.Zero(t, responses.Size())
+}
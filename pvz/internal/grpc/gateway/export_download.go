@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"pvz-cli/internal/common/tabular"
+	pb "pvz-cli/internal/gen/orders"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportDownloadPath is the HTTP route of ExportOrders. The generated gateway handler separates
+// streamed messages with newlines and wraps them in JSON, so the download is served by exportDownloadHandler instead.
+const exportDownloadPath = "/v1/orders/export"
+
+// exportDownloadHandler serves ExportOrders as a file download: the chunks of the stream are written
+// to the response body as they arrive, with the Content-Type of the format and an attachment Content-Disposition.
+// Query parameters are those of ExportRequest; dataset and format also accept short names such as orders or csv.
+func exportDownloadHandler(mux *runtime.ServeMux, client pb.OrdersServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request, _ map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		fail := func(err error) {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		}
+
+		annotatedContext, err := runtime.AnnotateContext(ctx, mux, req, "/orders.OrdersService/ExportOrders", runtime.WithHTTPPathPattern(exportDownloadPath))
+		if err != nil {
+			fail(err)
+			return
+		}
+		query := req.URL.Query()
+		expandExportEnum(query, "dataset", "EXPORT_DATASET_")
+		expandExportEnum(query, "format", "EXPORT_FORMAT_")
+		var protoReq pb.ExportRequest
+		if err := runtime.PopulateQueryParameters(&protoReq, query, utilities.NewDoubleArray(nil)); err != nil {
+			fail(status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		stream, err := client.ExportOrders(annotatedContext, &protoReq)
+		if err != nil {
+			fail(err)
+			return
+		}
+		// errors such as validation failures arrive with the first message, before any byte is written
+		chunk, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			fail(err)
+			return
+		}
+
+		// large exports outlive the server write timeout
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		dataset := strings.ToLower(strings.TrimPrefix(protoReq.Dataset.String(), "EXPORT_DATASET_"))
+		format := tabular.Format(strings.ToLower(strings.TrimPrefix(protoReq.Format.String(), "EXPORT_FORMAT_")))
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", dataset+format.Extension()))
+		w.WriteHeader(http.StatusOK)
+
+		for chunk != nil {
+			if _, err := w.Write(chunk.Data); err != nil {
+				return
+			}
+			chunk, err = stream.Recv()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				// the status line is already sent; dropping the connection tells the client the file is incomplete
+				log.Printf("export download aborted: %v", err)
+				panic(http.ErrAbortHandler)
+			}
+		}
+	}
+}
+
+// expandExportEnum rewrites a short enum value such as csv into its full name EXPORT_FORMAT_CSV
+func expandExportEnum(query url.Values, key, prefix string) {
+	v := query.Get(key)
+	if v == "" || strings.HasPrefix(v, prefix) {
+		return
+	}
+	if _, err := strconv.Atoi(v); err == nil {
+		return
+	}
+	query.Set(key, prefix+strings.ToUpper(v))
+}
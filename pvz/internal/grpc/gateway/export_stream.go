@@ -0,0 +1,20 @@
+package gateway
+
+import (
+	pb "pvz-cli/internal/gen/orders"
+)
+
+// exportChunkWriter sends every write of the export encoder as one ExportChunk.
+// The tabular writers buffer their output, so chunks are a few kilobytes rather than one row each.
+type exportChunkWriter struct {
+	stream      pb.OrdersService_ExportOrdersServer
+	contentType string
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	// Send encodes the message before returning, so p may be reused by the caller afterwards
+	if err := w.stream.Send(&pb.ExportChunk{Data: p, ContentType: w.contentType}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -33,13 +33,23 @@ func GRPCGatewayErrorHandler(
 		code = string(appErr.Code)
 		message = appErr.Message
 		switch appErr.Code {
-		case apperrors.OrderAlreadyExists:
+		case apperrors.OrderAlreadyExists, apperrors.ConcurrentModification:
 			httpStatus = http.StatusConflict
-		case apperrors.OrderNotFound:
+		case apperrors.OrderNotFound, apperrors.ShipmentNotFound, apperrors.ImportJobNotFound:
 			httpStatus = http.StatusNotFound
 		case apperrors.StorageExpired,
-			apperrors.WeightTooHeavy:
+			apperrors.WeightTooHeavy,
+			apperrors.IdentityRequired,
+			apperrors.PickupPointClosed,
+			apperrors.UndoNotPossible,
+			apperrors.ImportJobNotCancellable,
+			apperrors.AtomicNotSupported,
+			apperrors.UserHasActiveOrders:
 			httpStatus = http.StatusPreconditionFailed
+		case apperrors.ImportQueueFull:
+			httpStatus = http.StatusTooManyRequests
+		case apperrors.UndoForbidden:
+			httpStatus = http.StatusForbidden
 		default:
 			httpStatus = http.StatusBadRequest
 		}
@@ -64,8 +74,10 @@ func grpcCodeToHTTPStatus(code codes.Code) int {
 		return http.StatusBadRequest
 	case codes.NotFound:
 		return http.StatusNotFound
-	case codes.AlreadyExists:
+	case codes.AlreadyExists, codes.Aborted:
 		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusUnprocessableEntity
 	case codes.ResourceExhausted:
 		return http.StatusTooManyRequests
 	case codes.PermissionDenied:
@@ -8,6 +8,8 @@ import (
 	pb "pvz-cli/internal/gen/orders"
 	"pvz-cli/internal/grpc/mappers"
 	"pvz-cli/internal/usecases/handlers"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -58,6 +60,10 @@ func (r *GRPCRouter) ReturnOrder(
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
+	dto.ExpectedVersion, err = expectedVersionFromContext(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 
 	res, err := r.facadeHandler.HandleReturnOrder(ctx, dto)
 	if err != nil {
@@ -85,6 +91,60 @@ func (r *GRPCRouter) ProcessOrders(
 	return r.facadeMapper.ToPbProcessResult(resp), nil
 }
 
+// IssueAllReady handles the IssueAllReady gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) IssueAllReady(
+	ctx context.Context,
+	req *pb.IssueAllReadyRequest,
+) (*pb.IssueReceipt, error) {
+	dto, err := r.facadeMapper.FromPbIssueAllReadyRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleIssueAllReady(ctx, dto)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbIssueReceipt(resp), nil
+}
+
+// UndoLastOperation handles the UndoLastOperation gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) UndoLastOperation(
+	ctx context.Context,
+	req *pb.OrderIdRequest,
+) (*pb.UndoResult, error) {
+	dto, err := r.facadeMapper.FromPbUndoRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleUndoLastOperation(ctx, dto)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbUndoResult(resp), nil
+}
+
+// GetOrder handles the GetOrder gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) GetOrder(
+	ctx context.Context,
+	req *pb.OrderIdRequest,
+) (*pb.OrderDetails, error) {
+	id, err := r.facadeMapper.FromPbGetOrderRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleGetOrder(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbOrderDetails(resp), nil
+}
+
 // ListOrders handles the ListOrders gRPC request and delegates to the facade handler.
 func (r *GRPCRouter) ListOrders(
 	ctx context.Context,
@@ -103,6 +163,42 @@ func (r *GRPCRouter) ListOrders(
 	return r.facadeMapper.ToPbOrdersList(resp), nil
 }
 
+// ScrollOrders handles the ScrollOrders gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) ScrollOrders(
+	ctx context.Context,
+	req *pb.ScrollOrdersRequest,
+) (*pb.ScrollOrdersResponse, error) {
+	dto, err := r.facadeMapper.FromPbScrollOrdersRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleListOrders(ctx, dto)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbScrollOrdersResponse(resp), nil
+}
+
+// SearchOrders handles the SearchOrders gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) SearchOrders(
+	ctx context.Context,
+	req *pb.SearchOrdersRequest,
+) (*pb.OrdersList, error) {
+	dto, err := r.facadeMapper.FromPbSearchOrdersRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleSearchOrders(ctx, dto)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbOrdersList(resp), nil
+}
+
 // ListReturns handles the ListReturns gRPC request and delegates to the facade handler.
 func (r *GRPCRouter) ListReturns(
 	ctx context.Context,
@@ -123,7 +219,11 @@ func (r *GRPCRouter) GetHistory(
 	ctx context.Context,
 	req *pb.GetHistoryRequest,
 ) (*pb.OrderHistoryList, error) {
-	dto := r.facadeMapper.FromPbOrderHistoryRequest(req)
+	dto, err := r.facadeMapper.FromPbOrderHistoryRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
 	resp, err := r.facadeHandler.HandleOrderHistory(ctx, dto)
 	if err != nil {
 		return nil, toGRPCError(err)
@@ -147,6 +247,196 @@ func (r *GRPCRouter) ImportOrders(
 	return r.facadeMapper.ToPbImportResult(resp), nil
 }
 
+// ImportOrdersStream imports the chunks of a client stream one by one and returns the aggregated result.
+// The next chunk is received only after the previous one is imported, so flow control holds back a faster client.
+// Unary interceptors do not run for streams, so every chunk is validated here.
+func (r *GRPCRouter) ImportOrdersStream(stream pb.OrdersService_ImportOrdersStreamServer) error {
+	next := func() (requests.ImportOrdersRequest, error) {
+		chunk, err := stream.Recv()
+		if err != nil {
+			return requests.ImportOrdersRequest{}, err
+		}
+		if err := chunk.Validate(); err != nil {
+			return requests.ImportOrdersRequest{}, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+		}
+		return r.facadeMapper.FromPbImportOrdersChunk(chunk), nil
+	}
+
+	resp, err := r.facadeHandler.HandleImportOrdersStream(stream.Context(), next)
+	if err != nil {
+		return toGRPCError(err)
+	}
+
+	return stream.SendAndClose(r.facadeMapper.ToPbImportResult(resp))
+}
+
+// SubmitImportJob queues the import as a background job and returns the job without waiting for it.
+func (r *GRPCRouter) SubmitImportJob(
+	ctx context.Context,
+	req *pb.ImportOrdersRequest,
+) (*pb.ImportJob, error) {
+	dto := r.facadeMapper.FromPbImportOrdersRequest(req)
+
+	resp, err := r.facadeHandler.HandleSubmitImportJob(ctx, dto)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbImportJob(resp), nil
+}
+
+// GetImportJob handles the GetImportJob gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) GetImportJob(
+	ctx context.Context,
+	req *pb.ImportJobIdRequest,
+) (*pb.ImportJob, error) {
+	id, err := r.facadeMapper.FromPbImportJobIdRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleGetImportJob(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbImportJob(resp), nil
+}
+
+// WatchImportJob streams the job state on every progress update until the job finishes.
+// Unary interceptors do not run for streams, so the request is validated here.
+func (r *GRPCRouter) WatchImportJob(
+	req *pb.ImportJobIdRequest,
+	stream pb.OrdersService_WatchImportJobServer,
+) error {
+	if err := req.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	id, err := r.facadeMapper.FromPbImportJobIdRequest(req)
+	if err != nil {
+		return toGRPCError(err)
+	}
+
+	err = r.facadeHandler.HandleWatchImportJob(stream.Context(), id, func(resp responses.ImportJobResponse) error {
+		return stream.Send(r.facadeMapper.ToPbImportJob(resp))
+	})
+	if err != nil {
+		return toGRPCError(err)
+	}
+	return nil
+}
+
+// ListImportJobFailures handles the ListImportJobFailures gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) ListImportJobFailures(
+	ctx context.Context,
+	req *pb.ImportJobIdRequest,
+) (*pb.ImportJobFailures, error) {
+	id, err := r.facadeMapper.FromPbImportJobIdRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleListImportJobFailures(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbImportJobFailures(resp), nil
+}
+
+// CancelImportJob handles the CancelImportJob gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) CancelImportJob(
+	ctx context.Context,
+	req *pb.ImportJobIdRequest,
+) (*pb.ImportJob, error) {
+	id, err := r.facadeMapper.FromPbImportJobIdRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleCancelImportJob(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbImportJob(resp), nil
+}
+
+// ExportOrders streams the requested dataset as chunks of the encoded file.
+// Unary interceptors do not run for streams, so the request is validated here.
+func (r *GRPCRouter) ExportOrders(
+	req *pb.ExportRequest,
+	stream pb.OrdersService_ExportOrdersServer,
+) error {
+	if err := req.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+	dto, err := r.facadeMapper.FromPbExportRequest(req)
+	if err != nil {
+		return toGRPCError(err)
+	}
+
+	w := &exportChunkWriter{stream: stream, contentType: dto.Format.ContentType()}
+	if _, err := r.facadeHandler.HandleExport(stream.Context(), dto, w); err != nil {
+		return toGRPCError(err)
+	}
+	return nil
+}
+
+// RegisterShipment handles the RegisterShipment gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) RegisterShipment(
+	ctx context.Context,
+	req *pb.RegisterShipmentRequest,
+) (*pb.Shipment, error) {
+	dto, err := r.facadeMapper.FromPbRegisterShipmentRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleRegisterShipment(ctx, dto)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbShipment(resp), nil
+}
+
+// HandOverShipment handles the HandOverShipment gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) HandOverShipment(
+	ctx context.Context,
+	req *pb.HandOverShipmentRequest,
+) (*pb.Shipment, error) {
+	dto, err := r.facadeMapper.FromPbHandOverShipmentRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleHandOverShipment(ctx, dto)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbShipment(resp), nil
+}
+
+// GetShipment handles the GetShipment gRPC request and delegates to the facade handler.
+func (r *GRPCRouter) GetShipment(
+	ctx context.Context,
+	req *pb.ShipmentIdRequest,
+) (*pb.ShipmentDetails, error) {
+	id, err := r.facadeMapper.FromPbShipmentIdRequest(req)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	resp, err := r.facadeHandler.HandleGetShipment(ctx, id)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return r.facadeMapper.ToPbShipmentDetails(resp), nil
+}
+
 func toGRPCError(err error) error {
 	if _, ok := status.FromError(err); ok {
 		return err
@@ -156,8 +446,17 @@ func toGRPCError(err error) error {
 		switch appErr.Code {
 		case apperrors.OrderAlreadyExists:
 			return status.Error(codes.AlreadyExists, appErr.Message)
-		case apperrors.OrderNotFound:
+		case apperrors.OrderNotFound, apperrors.ShipmentNotFound, apperrors.ImportJobNotFound:
 			return status.Error(codes.NotFound, appErr.Message)
+		case apperrors.ConcurrentModification:
+			return status.Error(codes.Aborted, appErr.Message)
+		case apperrors.UndoForbidden:
+			return status.Error(codes.PermissionDenied, appErr.Message)
+		case apperrors.UndoNotPossible, apperrors.ImportJobNotCancellable, apperrors.AtomicNotSupported,
+			apperrors.UserHasActiveOrders:
+			return status.Error(codes.FailedPrecondition, appErr.Message)
+		case apperrors.ImportQueueFull:
+			return status.Error(codes.ResourceExhausted, appErr.Message)
 		default:
 			return status.Error(codes.InvalidArgument, appErr.Message)
 		}
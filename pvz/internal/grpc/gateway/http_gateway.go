@@ -13,9 +13,32 @@ import (
 	"net/http"
 	adminpb "pvz-cli/internal/gen/admin"
 	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/grpc/interceptors"
+	"strings"
 	"time"
 )
 
+// incomingHeaderMatcher forwards the Idempotency-Key, X-Operator-ID, X-Correlation-ID, X-API-Key and If-Match headers to gRPC metadata in addition to the default headers.
+// Authorization is always forwarded by the gateway itself.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, interceptors.IdempotencyKeyHeader) {
+		return interceptors.IdempotencyKeyHeader, true
+	}
+	if strings.EqualFold(key, interceptors.OperatorIDHeader) {
+		return interceptors.OperatorIDHeader, true
+	}
+	if strings.EqualFold(key, interceptors.CorrelationIDHeader) {
+		return interceptors.CorrelationIDHeader, true
+	}
+	if strings.EqualFold(key, interceptors.APIKeyHeader) {
+		return interceptors.APIKeyHeader, true
+	}
+	if strings.EqualFold(key, ifMatchHeader) {
+		return ifMatchHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 // RunHTTPGateway starts the HTTP <-> gRPC reverse-proxy. It connects to the gRPC server at grpcAddr and serves HTTP on httpAddr.
 func RunHTTPGateway(ctx context.Context, ordersGrpcAddr, adminGrpcAddr, httpAddr string) error {
 	dialer := func(ctx context.Context, addr string) (net.Conn, error) {
@@ -23,6 +46,7 @@ func RunHTTPGateway(ctx context.Context, ordersGrpcAddr, adminGrpcAddr, httpAddr
 	}
 	mux := runtime.NewServeMux(
 		runtime.WithErrorHandler(GRPCGatewayErrorHandler),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
 				UseProtoNames:   true,
@@ -31,20 +55,33 @@ func RunHTTPGateway(ctx context.Context, ordersGrpcAddr, adminGrpcAddr, httpAddr
 			},
 		}),
 	)
+	ordersDialOpts := []grpc.DialOption{
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			MinConnectTimeout: 5 * time.Second,
+		}),
+	}
 	if err := pb.RegisterOrdersServiceHandlerFromEndpoint(
 		ctx,
 		mux,
 		ordersGrpcAddr,
-		[]grpc.DialOption{
-			grpc.WithContextDialer(dialer),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithConnectParams(grpc.ConnectParams{
-				MinConnectTimeout: 5 * time.Second,
-			}),
-		},
+		ordersDialOpts,
 	); err != nil {
 		return fmt.Errorf("failed to register grpc gateway handler: %w", err)
 	}
+	exportConn, err := grpc.NewClient(ordersGrpcAddr, ordersDialOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to dial orders service for export downloads: %w", err)
+	}
+	go func() {
+		<-ctx.Done()
+		_ = exportConn.Close()
+	}()
+	// registered after the generated routes so that it takes precedence over GET /v1/orders/{order_id}
+	if err := mux.HandlePath(http.MethodGet, exportDownloadPath, exportDownloadHandler(mux, pb.NewOrdersServiceClient(exportConn))); err != nil {
+		return fmt.Errorf("failed to register export download handler: %w", err)
+	}
 	if err := adminpb.RegisterAdminServiceHandlerFromEndpoint(
 		ctx,
 		mux,
@@ -62,7 +99,7 @@ func RunHTTPGateway(ctx context.Context, ordersGrpcAddr, adminGrpcAddr, httpAddr
 		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
 		AllowedHeaders:   []string{"*"},
 		AllowCredentials: true,
-	}).Handler(mux)
+	}).Handler(withoutWriteDeadline(mux, isImportJobWatchPath))
 
 	srv := &http.Server{
 		Addr:              httpAddr,
@@ -83,3 +120,20 @@ func RunHTTPGateway(ctx context.Context, ordersGrpcAddr, adminGrpcAddr, httpAddr
 
 	return srv.ListenAndServe()
 }
+
+// withoutWriteDeadline lifts the server write timeout for long-lived streaming responses matched by isStream
+func withoutWriteDeadline(next http.Handler, isStream func(*http.Request) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if isStream(req) {
+			_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// isImportJobWatchPath matches GET /v1/import-jobs/{job_id}/watch, which streams until the job finishes
+func isImportJobWatchPath(req *http.Request) bool {
+	return req.Method == http.MethodGet &&
+		strings.HasPrefix(req.URL.Path, "/v1/import-jobs/") &&
+		strings.HasSuffix(req.URL.Path, "/watch")
+}
@@ -0,0 +1,34 @@
+package gateway
+
+import (
+	"context"
+	"pvz-cli/internal/common/apperrors"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ifMatchHeader carries the order version a client expects, as an HTTP If-Match header or gRPC metadata.
+const ifMatchHeader = "if-match"
+
+// expectedVersionFromContext returns the version from the If-Match metadata, or nil when the client did not send one.
+// Both plain (5) and entity-tag ("5", W/"5") forms are accepted.
+func expectedVersionFromContext(ctx context.Context) (*int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	vals := md.Get(ifMatchHeader)
+	if len(vals) == 0 || strings.TrimSpace(vals[0]) == "" || strings.TrimSpace(vals[0]) == "*" {
+		return nil, nil
+	}
+	raw := strings.TrimSpace(vals[0])
+	raw = strings.TrimPrefix(raw, "W/")
+	raw = strings.Trim(raw, `"`)
+	version, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || version < 0 {
+		return nil, apperrors.Newf(apperrors.ValidationFailed, "invalid If-Match value %q", vals[0])
+	}
+	return &version, nil
+}
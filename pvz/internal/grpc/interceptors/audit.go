@@ -0,0 +1,105 @@
+package interceptors
+
+import (
+	"context"
+	"pvz-cli/internal/common/operator"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/services"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// AuditInterceptor records every call to one of methods in the audit log once the call completes, whatever its result.
+// It must run before ValidationInterceptor and AuthInterceptor so that rejected calls are recorded too, and after
+// CorrelationIDInterceptor and OperatorInterceptor. The record carries the operator resolved by AuthInterceptor;
+// a call rejected before it is authenticated is recorded with an empty operator.
+// A failure to record is logged and does not fail the call.
+func AuditInterceptor(svc services.AuditService, methods ...string) grpc.UnaryServerInterceptor {
+	audited := auditedMethods(methods)
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := audited[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+		call := &auditCall{}
+		resp, err := handler(context.WithValue(ctx, auditCallCtxKey{}, call), req)
+		var request []byte
+		if msg, ok := req.(proto.Message); ok {
+			request, _ = protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+		}
+		recordCall(call.callerContext(ctx), svc, info.FullMethod, request, err)
+		return resp, err
+	}
+}
+
+// AuditStreamInterceptor is the streaming counterpart of AuditInterceptor; the messages of a stream are not recorded.
+func AuditStreamInterceptor(svc services.AuditService, methods ...string) grpc.StreamServerInterceptor {
+	audited := auditedMethods(methods)
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if _, ok := audited[info.FullMethod]; !ok {
+			return handler(srv, ss)
+		}
+		call := &auditCall{}
+		ctx := ss.Context()
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: context.WithValue(ctx, auditCallCtxKey{}, call)})
+		recordCall(call.callerContext(ctx), svc, info.FullMethod, nil, err)
+		return err
+	}
+}
+
+type auditCallCtxKey struct{}
+
+// auditCall passes the context of an authenticated call back out to the audit interceptor wrapping AuthInterceptor
+type auditCall struct {
+	authenticated context.Context
+}
+
+// callerContext returns the context carrying the authenticated caller, or ctx without the unverified operator when
+// the call was rejected before it was authenticated
+func (c *auditCall) callerContext(ctx context.Context) context.Context {
+	if c.authenticated != nil {
+		return c.authenticated
+	}
+	return operator.WithID(ctx, "")
+}
+
+// markAuthenticated hands the caller identity of an authenticated call to the enclosing audit interceptor, if any
+func markAuthenticated(ctx context.Context) {
+	if call, ok := ctx.Value(auditCallCtxKey{}).(*auditCall); ok {
+		call.authenticated = ctx
+	}
+}
+
+func auditedMethods(methods []string) map[string]struct{} {
+	audited := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		audited[m] = struct{}{}
+	}
+	return audited
+}
+
+func recordCall(ctx context.Context, svc services.AuditService, method string, request []byte, callErr error) {
+	// the call has completed, so a client that has already gone away must not cancel its record
+	err := svc.Record(context.WithoutCancel(ctx), models.AuditRecord{
+		Source:     models.AuditSourceGRPC,
+		Method:     method,
+		Request:    request,
+		ResultCode: status.Code(callErr).String(),
+	})
+	if err != nil {
+		logger.Warn("failed to record audited call", zap.String("method", method), zap.Error(err))
+	}
+}
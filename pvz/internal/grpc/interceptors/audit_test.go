@@ -0,0 +1,168 @@
+package interceptors
+
+import (
+	"context"
+	"path/filepath"
+	"pvz-cli/internal/common/auth"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/services"
+	"pvz-cli/pkg/clock"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const unauditedMethod = "/orders.OrdersService/ListOrders"
+
+// chainUnary runs handler behind the given unary interceptors, the first one being the outermost
+func chainUnary(ctx context.Context, method string, handler grpc.UnaryHandler, chain ...grpc.UnaryServerInterceptor) error {
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	for i := len(chain) - 1; i >= 0; i-- {
+		next, interceptor := handler, chain[i]
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	_, err := handler(ctx, nil)
+	return err
+}
+
+// listAudit returns the whole audit log of repo, newest first
+func listAudit(t *testing.T, repo repositories.AuditRepository) []models.AuditRecord {
+	t.Helper()
+	records, _, err := repo.List(context.Background(), requests.AuditLogFilter{Page: 1, Limit: 100})
+	require.NoError(t, err)
+	return records
+}
+
+// TestAuditInterceptor verifies that audited calls are recorded with their result code and caller, including calls
+// rejected by authentication, and that other calls are not recorded.
+func TestAuditInterceptor(t *testing.T) {
+	t.Parallel()
+	authn := auth.NewLocalAuthenticator(&clock.FakeClock{}, map[string]auth.Principal{
+		"op-key": {OperatorID: "op-1", Role: auth.RoleOperator},
+	}, nil)
+	roles := MethodRoles{
+		operatorMethod:   auth.RoleOperator,
+		supervisorMethod: auth.RoleSupervisor,
+		unauditedMethod:  auth.RoleOperator,
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		md         metadata.MD
+		authn      auth.Authenticator
+		handlerErr error
+		want       []models.AuditRecord
+	}{
+		{
+			name:   "successful call",
+			method: operatorMethod,
+			md:     metadata.Pairs(APIKeyHeader, "op-key", CorrelationIDHeader, "corr-1"),
+			authn:  authn,
+			want: []models.AuditRecord{{
+				OperatorID: "op-1", Role: string(auth.RoleOperator), Method: operatorMethod,
+				ResultCode: codes.OK.String(), CorrelationID: "corr-1",
+			}},
+		},
+		{
+			name:       "failed call",
+			method:     operatorMethod,
+			md:         metadata.Pairs(APIKeyHeader, "op-key", CorrelationIDHeader, "corr-2"),
+			authn:      authn,
+			handlerErr: status.Error(codes.NotFound, "ORDER_NOT_FOUND"),
+			want: []models.AuditRecord{{
+				OperatorID: "op-1", Role: string(auth.RoleOperator), Method: operatorMethod,
+				ResultCode: codes.NotFound.String(), CorrelationID: "corr-2",
+			}},
+		},
+		{
+			name:   "unauthenticated call drops the claimed operator",
+			method: operatorMethod,
+			md:     metadata.Pairs(OperatorIDHeader, "spoofed", CorrelationIDHeader, "corr-3"),
+			authn:  authn,
+			want: []models.AuditRecord{{
+				Method: operatorMethod, ResultCode: codes.Unauthenticated.String(), CorrelationID: "corr-3",
+			}},
+		},
+		{
+			name:   "call denied by role keeps the operator",
+			method: supervisorMethod,
+			md:     metadata.Pairs(APIKeyHeader, "op-key", CorrelationIDHeader, "corr-4"),
+			authn:  authn,
+			want: []models.AuditRecord{{
+				OperatorID: "op-1", Role: string(auth.RoleOperator), Method: supervisorMethod,
+				ResultCode: codes.PermissionDenied.String(), CorrelationID: "corr-4",
+			}},
+		},
+		{
+			name:   "disabled auth records the operator header",
+			method: operatorMethod,
+			md:     metadata.Pairs(OperatorIDHeader, "op-9", CorrelationIDHeader, "corr-5"),
+			want: []models.AuditRecord{{
+				OperatorID: "op-9", Method: operatorMethod, ResultCode: codes.OK.String(), CorrelationID: "corr-5",
+			}},
+		},
+		{
+			name:   "unaudited method",
+			method: unauditedMethod,
+			md:     metadata.Pairs(APIKeyHeader, "op-key"),
+			authn:  authn,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := repositories.NewFileAuditRepository(filepath.Join(t.TempDir(), "audit.ndjson"))
+			svc := services.NewDefaultAuditService(&clock.FakeClock{}, repo)
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			err := chainUnary(ctx, tt.method, func(context.Context, interface{}) (interface{}, error) {
+				return nil, tt.handlerErr
+			},
+				CorrelationIDInterceptor(),
+				OperatorInterceptor(),
+				AuditInterceptor(svc, operatorMethod, supervisorMethod),
+				AuthInterceptor(tt.authn, roles),
+			)
+			records := listAudit(t, repo)
+			for i := range records {
+				require.Equal(t, status.Code(err).String(), records[i].ResultCode)
+				records[i].ID, records[i].Source, records[i].Timestamp = 0, "", time.Time{}
+			}
+			require.Equal(t, tt.want, records)
+		})
+	}
+}
+
+// TestAuditStreamInterceptor verifies that a streamed call rejected by authentication is recorded.
+func TestAuditStreamInterceptor(t *testing.T) {
+	t.Parallel()
+	authn := auth.NewLocalAuthenticator(&clock.FakeClock{}, nil, nil)
+	repo := repositories.NewFileAuditRepository(filepath.Join(t.TempDir(), "audit.ndjson"))
+	svc := services.NewDefaultAuditService(&clock.FakeClock{}, repo)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(OperatorIDHeader, "spoofed"))
+
+	err := chainStream(ctx, func(interface{}, grpc.ServerStream) error { return nil },
+		OperatorStreamInterceptor(),
+		AuditStreamInterceptor(svc, streamMethod),
+		AuthStreamInterceptor(authn, MethodRoles{streamMethod: auth.RoleSupervisor}),
+	)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	records := listAudit(t, repo)
+	require.Len(t, records, 1)
+	require.Equal(t, models.AuditSourceGRPC, records[0].Source)
+	require.Equal(t, streamMethod, records[0].Method)
+	require.Equal(t, codes.Unauthenticated.String(), records[0].ResultCode)
+	require.Empty(t, records[0].OperatorID)
+}
@@ -0,0 +1,169 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"pvz-cli/internal/common/auth"
+	"pvz-cli/internal/common/operator"
+	"pvz-cli/pkg/clock"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	operatorMethod   = "/orders.OrdersService/AcceptOrder"
+	supervisorMethod = "/orders.OrdersService/ImportOrders"
+	healthMethod     = "/grpc.health.v1.Health/Check"
+)
+
+var jwtSecret = []byte("jwt-secret")
+
+// signJWT returns an HS256 token for the subject and role expiring at exp
+func signJWT(t *testing.T, sub string, role auth.Role, exp time.Time) string {
+	t.Helper()
+	enc := base64.RawURLEncoding
+	h, err := json.Marshal(map[string]any{"alg": "HS256", "typ": "JWT"})
+	require.NoError(t, err)
+	c, err := json.Marshal(map[string]any{"sub": sub, "role": role, "exp": exp.Unix()})
+	require.NoError(t, err)
+	unsigned := enc.EncodeToString(h) + "." + enc.EncodeToString(c)
+	mac := hmac.New(sha256.New, jwtSecret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + enc.EncodeToString(mac.Sum(nil))
+}
+
+// TestAuthInterceptor covers authentication by API key and JWT and the role check of the called method.
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+	clk := &clock.FakeClock{}
+	authn := auth.NewLocalAuthenticator(clk, map[string]auth.Principal{
+		"key-1": {OperatorID: "op-1", Role: auth.RoleOperator},
+	}, jwtSecret)
+	roles := MethodRoles{
+		operatorMethod:   auth.RoleOperator,
+		supervisorMethod: auth.RoleSupervisor,
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		md           metadata.MD
+		wantCode     codes.Code
+		wantOperator string
+	}{
+		{
+			name:     "missing credentials",
+			method:   operatorMethod,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "invalid API key",
+			method:   operatorMethod,
+			md:       metadata.Pairs(APIKeyHeader, "key-2"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "authorization without Bearer",
+			method:   operatorMethod,
+			md:       metadata.Pairs(AuthorizationHeader, "Basic b3A6cHc="),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:         "valid API key replaces the operator header",
+			method:       operatorMethod,
+			md:           metadata.Pairs(APIKeyHeader, "key-1", OperatorIDHeader, "spoofed"),
+			wantCode:     codes.OK,
+			wantOperator: "op-1",
+		},
+		{
+			name:         "valid JWT",
+			method:       supervisorMethod,
+			md:           metadata.Pairs(AuthorizationHeader, "Bearer "+signJWT(t, "sup-1", auth.RoleSupervisor, clk.After(time.Hour))),
+			wantCode:     codes.OK,
+			wantOperator: "sup-1",
+		},
+		{
+			name:     "expired JWT",
+			method:   operatorMethod,
+			md:       metadata.Pairs(AuthorizationHeader, "Bearer "+signJWT(t, "sup-1", auth.RoleSupervisor, clk.Now())),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "insufficient role",
+			method:   supervisorMethod,
+			md:       metadata.Pairs(APIKeyHeader, "key-1"),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "unmapped method",
+			method:   "/orders.OrdersService/NewMethod",
+			md:       metadata.Pairs(AuthorizationHeader, "Bearer "+signJWT(t, "adm-1", auth.RoleAdmin, clk.After(time.Hour))),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "health check needs no credentials",
+			method:   healthMethod,
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			// the operator interceptor runs first and takes the header as is
+			ctx = operator.WithID(ctx, "spoofed")
+			called := false
+			var operatorID string
+			_, err := AuthInterceptor(authn, roles)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					called = true
+					operatorID = operator.IDFromContext(ctx)
+					return nil, nil
+				})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.wantCode == codes.OK, called)
+			if tt.wantOperator != "" {
+				require.Equal(t, tt.wantOperator, operatorID)
+			}
+		})
+	}
+}
+
+// TestAuthStreamInterceptor verifies that streamed calls are authenticated and checked like unary ones.
+func TestAuthStreamInterceptor(t *testing.T) {
+	t.Parallel()
+	authn := auth.NewLocalAuthenticator(&clock.FakeClock{}, map[string]auth.Principal{
+		"op-key":  {OperatorID: "op-1", Role: auth.RoleOperator},
+		"sup-key": {OperatorID: "sup-1", Role: auth.RoleSupervisor},
+	}, nil)
+	roles := MethodRoles{streamMethod: auth.RoleSupervisor}
+	handler := func(_ interface{}, ss grpc.ServerStream) error {
+		p, ok := auth.PrincipalFromContext(ss.Context())
+		require.True(t, ok)
+		require.Equal(t, "sup-1", p.OperatorID)
+		return nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyHeader, "sup-key"))
+	require.NoError(t, chainStream(ctx, handler, AuthStreamInterceptor(authn, roles)))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyHeader, "op-key"))
+	err := chainStream(ctx, handler, AuthStreamInterceptor(authn, roles))
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = chainStream(context.Background(), handler, AuthStreamInterceptor(authn, roles))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -2,19 +2,18 @@ package interceptors
 
 import (
 	"context"
+	"pvz-cli/internal/common/correlation"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
-const correlationIDHeader = "x-correlation-id"
-
-type correlationIDCtxKey struct{}
-
-var correlationIDKey = correlationIDCtxKey{}
+// CorrelationIDHeader is the metadata key carrying the correlation ID of a call.
+const CorrelationIDHeader = "x-correlation-id"
 
 // CorrelationIDInterceptor extracts or injects X-Correlation-ID into context and metadata.
+// The ID is stored with correlation.WithID, so services can record it along with the changes they make.
 func CorrelationIDInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -22,19 +21,34 @@ func CorrelationIDInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			md = metadata.New(nil)
-		}
-		var corrID string
-		if vals := md[correlationIDHeader]; len(vals) > 0 && vals[0] != "" {
-			corrID = vals[0]
-		} else {
-			corrID = uuid.NewString()
-			md.Set(correlationIDHeader, corrID)
-			ctx = metadata.NewIncomingContext(ctx, md)
-		}
-		ctx = context.WithValue(ctx, correlationIDKey, corrID)
-		return handler(ctx, req)
+		return handler(withCorrelationID(ctx), req)
+	}
+}
+
+// CorrelationIDStreamInterceptor is the streaming counterpart of CorrelationIDInterceptor.
+func CorrelationIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withCorrelationID(ss.Context())})
+	}
+}
+
+func withCorrelationID(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	var corrID string
+	if vals := md[CorrelationIDHeader]; len(vals) > 0 && vals[0] != "" {
+		corrID = vals[0]
+	} else {
+		corrID = uuid.NewString()
+		md.Set(CorrelationIDHeader, corrID)
+		ctx = metadata.NewIncomingContext(ctx, md)
 	}
+	return correlation.WithID(ctx, corrID)
 }
@@ -0,0 +1,196 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/operator"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/pkg/clock"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// IdempotencyKeyHeader is the metadata key clients set to make retries of mutating calls safe.
+const IdempotencyKeyHeader = "idempotency-key"
+
+// IdempotencyInterceptor replays the stored response when a call to one of methods is retried with the same
+// Idempotency-Key and payload, and rejects a key reused with a different payload. Calls without the key pass through.
+// The key is reserved before the handler runs, so a retry arriving while the first call is still running is rejected
+// instead of repeating the mutation; a failed call releases the key. Keys are scoped to the calling operator
+// and may be reused once ttl has passed since the first call.
+func IdempotencyInterceptor(
+	store repositories.IdempotencyRepository,
+	clk clock.Clock,
+	ttl time.Duration,
+	methods ...string,
+) grpc.UnaryServerInterceptor {
+	guarded := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		guarded[m] = struct{}{}
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := guarded[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+		key := idempotencyKeyFromContext(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		hash, err := requestHash(info.FullMethod, msg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash request: %v", err)
+		}
+
+		scope := operator.IDFromContext(ctx)
+		now := clk.Now()
+		rec, reserved, err := store.Reserve(ctx, models.IdempotencyRecord{
+			Scope:       scope,
+			Key:         key,
+			Method:      info.FullMethod,
+			RequestHash: hash,
+			CreatedAt:   now,
+		}, now.Add(-ttl))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to reserve idempotency key: %v", err)
+		}
+		if !reserved {
+			switch {
+			case rec.Method != info.FullMethod || rec.RequestHash != hash:
+				return nil, status.Errorf(codes.FailedPrecondition,
+					"%s: idempotency key %q was already used with a different request", apperrors.IdempotencyKeyReused, key)
+			case rec.Pending():
+				return nil, status.Errorf(codes.Aborted,
+					"%s: a request with idempotency key %q is still in progress", apperrors.IdempotencyKeyInProgress, key)
+			}
+			return replay(rec.Response)
+		}
+
+		// the reservation outlives a canceled call, so it is completed or released regardless of ctx
+		storeCtx := context.WithoutCancel(ctx)
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if relErr := store.Release(storeCtx, scope, key); relErr != nil {
+				logger.Warn("failed to release idempotency key", zap.String("method", info.FullMethod), zap.Error(relErr))
+			}
+			return nil, err
+		}
+		payload, err := packResponse(resp)
+		if err != nil {
+			err = store.Release(storeCtx, scope, key)
+		} else {
+			err = store.Complete(storeCtx, scope, key, payload, responseUserIDs(resp.(proto.Message)))
+		}
+		if err != nil {
+			logger.Warn("failed to store idempotent response", zap.String("method", info.FullMethod), zap.Error(err))
+		}
+		return resp, nil
+	}
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if vals := md.Get(IdempotencyKeyHeader); len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
+
+func requestHash(method string, msg proto.Message) (string, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.New()
+	sum.Write([]byte(method))
+	sum.Write(raw)
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+// packResponse marshals the response as Any, so replay can restore it without knowing its type
+func packResponse(resp interface{}) ([]byte, error) {
+	out, ok := resp.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("response of type %T is not a proto message", resp)
+	}
+	packed, err := anypb.New(out)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(packed)
+}
+
+// userIDFields are the response fields that identify a client: order recipients and shipment senders
+var userIDFields = map[protoreflect.Name]struct{}{
+	"user_id":   {},
+	"sender_id": {},
+}
+
+// responseUserIDs collects the distinct clients named anywhere in the response, so the stored copy can be found
+// when the client's data is exported or erased
+func responseUserIDs(msg proto.Message) []uint64 {
+	var ids []uint64
+	seen := make(map[uint64]struct{})
+	var walk func(m protoreflect.Message)
+	walk = func(m protoreflect.Message) {
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			switch {
+			case fd.IsMap():
+			case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+				for i := 0; i < v.List().Len(); i++ {
+					walk(v.List().Get(i).Message())
+				}
+			case fd.Kind() == protoreflect.MessageKind:
+				walk(v.Message())
+			case fd.Kind() == protoreflect.Uint64Kind && !fd.IsList():
+				if _, ok := userIDFields[fd.Name()]; !ok {
+					return true
+				}
+				if _, ok := seen[v.Uint()]; !ok {
+					seen[v.Uint()] = struct{}{}
+					ids = append(ids, v.Uint())
+				}
+			}
+			return true
+		})
+	}
+	walk(msg.ProtoReflect())
+	return ids
+}
+
+func replay(payload []byte) (interface{}, error) {
+	var packed anypb.Any
+	if err := proto.Unmarshal(payload, &packed); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode stored response: %v", err)
+	}
+	resp, err := packed.UnmarshalNew()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode stored response: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,197 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/operator"
+	"pvz-cli/internal/data/repositories"
+	repmocks "pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/data/storage"
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/pkg/clock"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const idempotentMethod = "/orders.OrdersService/AcceptOrder"
+
+// movableClock is a clock the test can move forward
+type movableClock struct {
+	clock.FakeClock
+	offset time.Duration
+}
+
+func (c *movableClock) Now() time.Time {
+	return c.FakeClock.Now().Add(c.offset)
+}
+
+// idempotencyHarness runs calls through the interceptor backed by a snapshot repository and counts handler runs
+type idempotencyHarness struct {
+	intercept grpc.UnaryServerInterceptor
+	clk       *movableClock
+	calls     atomic.Int32
+	handler   grpc.UnaryHandler
+}
+
+func newIdempotencyHarness(t *testing.T) *idempotencyHarness {
+	t.Helper()
+	repo := repositories.NewSnapshotIdempotencyRepository(storage.NewJSONStorage(filepath.Join(t.TempDir(), "storage.json")))
+	h := &idempotencyHarness{clk: &movableClock{}}
+	h.intercept = IdempotencyInterceptor(repo, h.clk, time.Hour, idempotentMethod)
+	h.handler = func(_ context.Context, req interface{}) (interface{}, error) {
+		n := h.calls.Add(1)
+		return wrapperspb.String(fmt.Sprintf("%s#%d", req.(*wrapperspb.StringValue).GetValue(), n)), nil
+	}
+	return h
+}
+
+func (h *idempotencyHarness) call(ctx context.Context, key, payload string) (interface{}, error) {
+	if key != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyHeader, key))
+	}
+	return h.intercept(ctx, wrapperspb.String(payload), &grpc.UnaryServerInfo{FullMethod: idempotentMethod}, h.handler)
+}
+
+// TestIdempotencyInterceptor covers replays, reused keys and calls the interceptor lets through.
+func TestIdempotencyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retry replays the first response", func(t *testing.T) {
+		t.Parallel()
+		h := newIdempotencyHarness(t)
+		first, err := h.call(context.Background(), "k1", "accept")
+		require.NoError(t, err)
+		second, err := h.call(context.Background(), "k1", "accept")
+		require.NoError(t, err)
+		require.True(t, proto.Equal(first.(proto.Message), second.(proto.Message)))
+		require.Equal(t, int32(1), h.calls.Load())
+	})
+
+	t.Run("different payload under the same key is rejected", func(t *testing.T) {
+		t.Parallel()
+		h := newIdempotencyHarness(t)
+		_, err := h.call(context.Background(), "k1", "accept")
+		require.NoError(t, err)
+		_, err = h.call(context.Background(), "k1", "accept other")
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), string(apperrors.IdempotencyKeyReused))
+		require.Equal(t, int32(1), h.calls.Load())
+	})
+
+	t.Run("call without a key is not deduplicated", func(t *testing.T) {
+		t.Parallel()
+		repo := repmocks.NewIdempotencyRepositoryMock(t)
+		h := newIdempotencyHarness(t)
+		h.intercept = IdempotencyInterceptor(repo, h.clk, time.Hour, idempotentMethod)
+		for i := 0; i < 2; i++ {
+			_, err := h.call(context.Background(), "", "accept")
+			require.NoError(t, err)
+		}
+		require.Equal(t, int32(2), h.calls.Load())
+	})
+
+	t.Run("failed call releases the key", func(t *testing.T) {
+		t.Parallel()
+		h := newIdempotencyHarness(t)
+		succeed := h.handler
+		h.handler = func(context.Context, interface{}) (interface{}, error) {
+			h.calls.Add(1)
+			return nil, errors.New("storage down")
+		}
+		_, err := h.call(context.Background(), "k1", "accept")
+		require.Error(t, err)
+		h.handler = succeed
+		_, err = h.call(context.Background(), "k1", "accept")
+		require.NoError(t, err)
+		require.Equal(t, int32(2), h.calls.Load())
+	})
+
+	t.Run("retry during the first call is rejected", func(t *testing.T) {
+		t.Parallel()
+		h := newIdempotencyHarness(t)
+		started, release := make(chan struct{}), make(chan struct{})
+		succeed := h.handler
+		h.handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(started)
+			<-release
+			return succeed(ctx, req)
+		}
+		done := make(chan error, 1)
+		go func() {
+			_, err := h.call(context.Background(), "k1", "accept")
+			done <- err
+		}()
+		<-started
+		_, err := h.call(context.Background(), "k1", "accept")
+		close(release)
+		require.Equal(t, codes.Aborted, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), string(apperrors.IdempotencyKeyInProgress))
+		require.NoError(t, <-done)
+		require.Equal(t, int32(1), h.calls.Load())
+	})
+
+	t.Run("keys of different operators do not collide", func(t *testing.T) {
+		t.Parallel()
+		h := newIdempotencyHarness(t)
+		_, err := h.call(operator.WithID(context.Background(), "op-1"), "k1", "accept")
+		require.NoError(t, err)
+		_, err = h.call(operator.WithID(context.Background(), "op-2"), "k1", "accept other")
+		require.NoError(t, err)
+		require.Equal(t, int32(2), h.calls.Load())
+	})
+
+	t.Run("expired key runs the call again", func(t *testing.T) {
+		t.Parallel()
+		h := newIdempotencyHarness(t)
+		_, err := h.call(context.Background(), "k1", "accept")
+		require.NoError(t, err)
+		h.clk.offset = time.Hour + time.Second
+		_, err = h.call(context.Background(), "k1", "accept other")
+		require.NoError(t, err)
+		require.Equal(t, int32(2), h.calls.Load())
+	})
+}
+
+// TestResponseUserIDs verifies that recipients and senders are collected once from nested and repeated messages.
+func TestResponseUserIDs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		resp proto.Message
+		want []uint64
+	}{
+		{
+			name: "receipt with its orders",
+			resp: &pb.IssueReceipt{UserId: 7, Issued: []*pb.Order{{OrderId: 1, UserId: 7}, {OrderId: 2, UserId: 9}}},
+			want: []uint64{7, 9},
+		},
+		{
+			name: "shipment sender",
+			resp: &pb.Shipment{ShipmentId: 1, SenderId: 8, CourierId: 3},
+			want: []uint64{8},
+		},
+		{
+			name: "no client",
+			resp: &pb.UndoResult{OrderId: 1},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.ElementsMatch(t, tt.want, responseUserIDs(tt.resp))
+		})
+	}
+}
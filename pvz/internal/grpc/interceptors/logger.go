@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/correlation"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
@@ -60,7 +61,7 @@ func LoggingInterceptor() grpc.UnaryServerInterceptor {
 		elapsed := time.Since(start)
 		st, _ := status.FromError(err)
 		code := st.Code().String()
-		corrID, _ := ctx.Value(correlationIDKey).(string)
+		corrID := correlation.IDFromContext(ctx)
 		spanCtx := trace.SpanContextFromContext(ctx)
 		traceID := ""
 		if spanCtx.IsValid() {
@@ -80,6 +81,35 @@ func LoggingInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// LoggingStreamInterceptor is the streaming counterpart of LoggingInterceptor.
+// It logs the call once the stream ends; the streamed messages are not logged.
+func LoggingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		elapsed := time.Since(start)
+		ctx := ss.Context()
+		traceID := ""
+		if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+			traceID = spanCtx.TraceID().String()
+		}
+
+		logger.Info("gRPC stream",
+			zap.String("method", info.FullMethod),
+			zap.String("status", status.Code(err).String()),
+			zap.Duration("latency", elapsed),
+			zap.String("corr_id", correlation.IDFromContext(ctx)),
+			zap.String("trace_id", traceID),
+		)
+		return err
+	}
+}
+
 // CloseLogFile for safely closing log file
 func CloseLogFile() error {
 	if logFile != nil {
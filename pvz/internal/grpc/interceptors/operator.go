@@ -0,0 +1,45 @@
+package interceptors
+
+import (
+	"context"
+	"pvz-cli/internal/common/operator"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// OperatorIDHeader is the metadata key identifying the pickup-point operator making the call.
+const OperatorIDHeader = "x-operator-id"
+
+// OperatorInterceptor puts the operator identity from the x-operator-id metadata into the request context.
+func OperatorInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(withOperatorID(ctx), req)
+	}
+}
+
+// OperatorStreamInterceptor is the streaming counterpart of OperatorInterceptor.
+func OperatorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withOperatorID(ss.Context())})
+	}
+}
+
+func withOperatorID(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(OperatorIDHeader); len(vals) > 0 && vals[0] != "" {
+			ctx = operator.WithID(ctx, vals[0])
+		}
+	}
+	return ctx
+}
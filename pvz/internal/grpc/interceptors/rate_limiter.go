@@ -15,14 +15,13 @@ import (
 var (
 	once    sync.Once
 	limiter *rate.Limiter
+
+	errRateLimited = status.Error(codes.ResourceExhausted, "RATE_LIMITED: too many requests")
 )
 
 // RateLimitInterceptor returns a UnaryServerInterceptor that allows up to default RPS.
 func RateLimitInterceptor() grpc.UnaryServerInterceptor {
-	once.Do(func() {
-		limiter = rate.NewLimiter(rate.Every(time.Second/constants.DefaultRPS), constants.DefaultBurst)
-	})
-
+	initLimiter()
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -30,8 +29,31 @@ func RateLimitInterceptor() grpc.UnaryServerInterceptor {
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
 		if !limiter.Allow() {
-			return nil, status.Error(codes.ResourceExhausted, "RATE_LIMITED: too many requests")
+			return nil, errRateLimited
 		}
 		return handler(ctx, req)
 	}
 }
+
+// RateLimitStreamInterceptor is the streaming counterpart of RateLimitInterceptor. Opening a stream counts
+// as one request against the limit shared with unary calls; the messages of the stream are not limited.
+func RateLimitStreamInterceptor() grpc.StreamServerInterceptor {
+	initLimiter()
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !limiter.Allow() {
+			return errRateLimited
+		}
+		return handler(srv, ss)
+	}
+}
+
+func initLimiter() {
+	once.Do(func() {
+		limiter = rate.NewLimiter(rate.Every(time.Second/constants.DefaultRPS), constants.DefaultBurst)
+	})
+}
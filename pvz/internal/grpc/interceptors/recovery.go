@@ -17,12 +17,28 @@ func RecoveryInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (resp interface{}, err error) {
-		defer func() {
-			if r := recover(); r != nil {
-				err = status.Errorf(codes.Internal, "panic: %v", r)
-				fmt.Printf("[PANIC] in %s: %v\n", info.FullMethod, r)
-			}
-		}()
+		defer recoverPanic(info.FullMethod, &err)
 		return handler(ctx, req)
 	}
 }
+
+// RecoveryStreamInterceptor is the streaming counterpart of RecoveryInterceptor.
+func RecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer recoverPanic(info.FullMethod, &err)
+		return handler(srv, ss)
+	}
+}
+
+// recoverPanic turns a panic of the handler into an Internal error; it must be deferred directly
+func recoverPanic(method string, err *error) {
+	if r := recover(); r != nil {
+		*err = status.Errorf(codes.Internal, "panic: %v", r)
+		fmt.Printf("[PANIC] in %s: %v\n", method, r)
+	}
+}
@@ -0,0 +1,70 @@
+package interceptors
+
+import (
+	"context"
+	"pvz-cli/internal/common/correlation"
+	"pvz-cli/internal/common/operator"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const streamMethod = "/orders.OrdersService/ImportOrdersStream"
+
+// chainStream runs handler behind the given stream interceptors, the first one being the outermost
+func chainStream(ctx context.Context, handler grpc.StreamHandler, chain ...grpc.StreamServerInterceptor) error {
+	info := &grpc.StreamServerInfo{FullMethod: streamMethod, IsClientStream: true}
+	for i := len(chain) - 1; i >= 0; i-- {
+		next, interceptor := handler, chain[i]
+		handler = func(srv interface{}, ss grpc.ServerStream) error {
+			return interceptor(srv, ss, info, next)
+		}
+	}
+	return handler(nil, &contextStream{ctx: ctx})
+}
+
+// TestStreamInterceptors verifies that streamed calls get the same recovery and call context as unary ones.
+func TestStreamInterceptors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic becomes an internal error", func(t *testing.T) {
+		t.Parallel()
+		err := chainStream(context.Background(), func(interface{}, grpc.ServerStream) error {
+			panic("boom")
+		}, RecoveryStreamInterceptor())
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("correlation and operator IDs reach the handler", func(t *testing.T) {
+		t.Parallel()
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			CorrelationIDHeader, "corr-1",
+			OperatorIDHeader, "op-7",
+		))
+		var corrID, operatorID string
+		err := chainStream(ctx, func(_ interface{}, ss grpc.ServerStream) error {
+			corrID = correlation.IDFromContext(ss.Context())
+			operatorID = operator.IDFromContext(ss.Context())
+			return nil
+		}, RecoveryStreamInterceptor(), CorrelationIDStreamInterceptor(), OperatorStreamInterceptor(),
+			TracingStreamInterceptor(), LoggingStreamInterceptor())
+		require.NoError(t, err)
+		require.Equal(t, "corr-1", corrID)
+		require.Equal(t, "op-7", operatorID)
+	})
+
+	t.Run("missing correlation ID is generated", func(t *testing.T) {
+		t.Parallel()
+		var corrID string
+		err := chainStream(context.Background(), func(_ interface{}, ss grpc.ServerStream) error {
+			corrID = correlation.IDFromContext(ss.Context())
+			return nil
+		}, CorrelationIDStreamInterceptor())
+		require.NoError(t, err)
+		require.NotEmpty(t, corrID)
+	})
+}
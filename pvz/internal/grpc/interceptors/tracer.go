@@ -21,20 +21,46 @@ func TracingInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(md))
-		}
-		tracer := otel.Tracer("pvz")
-		ctx, span := tracer.Start(ctx, info.FullMethod, trace.WithSpanKind(trace.SpanKindServer))
+		ctx, span := startServerSpan(ctx, info.FullMethod)
 		defer span.End()
-		span.SetAttributes(attribute.String("rpc.method", info.FullMethod))
 		resp, err := handler(ctx, req)
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		} else {
-			span.SetStatus(codes.Ok, "OK")
-		}
+		setSpanStatus(span, err)
 		return resp, err
 	}
 }
+
+// TracingStreamInterceptor is the streaming counterpart of TracingInterceptor; one span covers the whole stream.
+func TracingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		defer span.End()
+		err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		setSpanStatus(span, err)
+		return err
+	}
+}
+
+// startServerSpan continues the trace propagated in the metadata with a span for the called method
+func startServerSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(md))
+	}
+	tracer := otel.Tracer("pvz")
+	ctx, span := tracer.Start(ctx, method, trace.WithSpanKind(trace.SpanKindServer))
+	span.SetAttributes(attribute.String("rpc.method", method))
+	return ctx, span
+}
+
+func setSpanStatus(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetStatus(codes.Ok, "OK")
+	}
+}
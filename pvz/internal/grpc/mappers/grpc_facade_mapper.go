@@ -14,20 +14,52 @@ type GRPCFacadeMapper interface {
 	// FromPbReturnOrderRequest maps protobuf OrderIdRequest to internal ReturnOrderRequest.
 	FromPbReturnOrderRequest(*pb.OrderIdRequest) (requests.ReturnOrderRequest, error)
 
+	// FromPbUndoRequest maps protobuf OrderIdRequest to internal UndoRequest.
+	FromPbUndoRequest(*pb.OrderIdRequest) (requests.UndoRequest, error)
+
 	// FromPbProcessOrdersRequest maps protobuf ProcessOrdersRequest to internal ProcessOrdersRequest.
 	FromPbProcessOrdersRequest(*pb.ProcessOrdersRequest) (requests.ProcessOrdersRequest, error)
 
+	// FromPbIssueAllReadyRequest maps protobuf IssueAllReadyRequest to internal IssueAllReadyRequest.
+	FromPbIssueAllReadyRequest(*pb.IssueAllReadyRequest) (requests.IssueAllReadyRequest, error)
+
+	// FromPbGetOrderRequest extracts the order ID from protobuf OrderIdRequest.
+	FromPbGetOrderRequest(*pb.OrderIdRequest) (uint64, error)
+
 	// FromPbListOrdersRequest maps protobuf OrdersFilterRequest to internal OrdersFilterRequest.
 	FromPbListOrdersRequest(*pb.ListOrdersRequest) (requests.OrdersFilterRequest, error)
 
+	// FromPbScrollOrdersRequest maps protobuf ScrollOrdersRequest to internal OrdersFilterRequest.
+	FromPbScrollOrdersRequest(*pb.ScrollOrdersRequest) (requests.OrdersFilterRequest, error)
+
+	// FromPbSearchOrdersRequest maps protobuf SearchOrdersRequest to internal OrdersFilterRequest.
+	FromPbSearchOrdersRequest(*pb.SearchOrdersRequest) (requests.OrdersFilterRequest, error)
+
 	// FromPbListReturnsRequest maps protobuf ListReturnsRequest to internal ListReturnsRequest.
 	FromPbListReturnsRequest(*pb.ListReturnsRequest) requests.OrdersFilterRequest
 
 	// FromPbOrderHistoryRequest maps protobuf GetHistoryRequest to internal OrderHistoryFilter.
-	FromPbOrderHistoryRequest(in *pb.GetHistoryRequest) requests.OrderHistoryFilter
+	FromPbOrderHistoryRequest(in *pb.GetHistoryRequest) (requests.OrderHistoryFilter, error)
 
 	// FromPbImportOrdersRequest maps protobuf ImportOrdersRequest to internal ImportOrdersRequest.
 	FromPbImportOrdersRequest(*pb.ImportOrdersRequest) requests.ImportOrdersRequest
+	// FromPbImportOrdersChunk maps a protobuf ImportOrdersChunk of a streamed import to internal ImportOrdersRequest.
+	FromPbImportOrdersChunk(*pb.ImportOrdersChunk) requests.ImportOrdersRequest
+
+	// FromPbImportJobIdRequest extracts the job ID from protobuf ImportJobIdRequest.
+	FromPbImportJobIdRequest(*pb.ImportJobIdRequest) (uint64, error)
+
+	// FromPbExportRequest maps protobuf ExportRequest to internal ExportRequest.
+	FromPbExportRequest(*pb.ExportRequest) (requests.ExportRequest, error)
+
+	// FromPbRegisterShipmentRequest maps protobuf RegisterShipmentRequest to internal RegisterShipmentRequest.
+	FromPbRegisterShipmentRequest(*pb.RegisterShipmentRequest) (requests.RegisterShipmentRequest, error)
+
+	// FromPbHandOverShipmentRequest maps protobuf HandOverShipmentRequest to internal HandOverShipmentRequest.
+	FromPbHandOverShipmentRequest(*pb.HandOverShipmentRequest) (requests.HandOverShipmentRequest, error)
+
+	// FromPbShipmentIdRequest extracts the shipment ID from protobuf ShipmentIdRequest.
+	FromPbShipmentIdRequest(*pb.ShipmentIdRequest) (uint64, error)
 
 	// ToPbAcceptOrderResponse maps internal AcceptOrderResponse to protobuf OrderResponse.
 	ToPbAcceptOrderResponse(res responses.AcceptOrderResponse) *pb.OrderResponse
@@ -35,9 +67,15 @@ type GRPCFacadeMapper interface {
 	// ToPbReturnOrderResponse maps internal ReturnOrderResponse to protobuf OrderResponse.
 	ToPbReturnOrderResponse(res responses.ReturnOrderResponse) *pb.OrderResponse
 
+	// ToPbUndoResult maps internal UndoResponse to protobuf UndoResult.
+	ToPbUndoResult(res responses.UndoResponse) *pb.UndoResult
+
 	// ToPbProcessResult maps internal ProcessOrdersResponse to protobuf ProcessResult.
 	ToPbProcessResult(res responses.ProcessOrdersResponse) *pb.ProcessResult
 
+	// ToPbIssueReceipt maps internal IssueReceiptResponse to protobuf IssueReceipt.
+	ToPbIssueReceipt(res responses.IssueReceiptResponse) *pb.IssueReceipt
+
 	// ToPbOrdersList maps internal ListOrdersResponse to protobuf OrdersList.
 	ToPbOrdersList(res responses.ListOrdersResponse) *pb.OrdersList
 
@@ -49,4 +87,22 @@ type GRPCFacadeMapper interface {
 
 	// ToPbImportResult maps internal ImportOrdersResponse to protobuf ImportResult.
 	ToPbImportResult(res responses.ImportOrdersResponse) *pb.ImportResult
+
+	// ToPbImportJob maps internal ImportJobResponse to protobuf ImportJob.
+	ToPbImportJob(res responses.ImportJobResponse) *pb.ImportJob
+
+	// ToPbImportJobFailures maps internal ImportJobFailuresResponse to protobuf ImportJobFailures.
+	ToPbImportJobFailures(res responses.ImportJobFailuresResponse) *pb.ImportJobFailures
+
+	// ToPbScrollOrdersResponse maps internal ListOrdersResponse to protobuf ScrollOrdersResponse.
+	ToPbScrollOrdersResponse(res responses.ListOrdersResponse) *pb.ScrollOrdersResponse
+
+	// ToPbOrderDetails maps internal OrderDetailsResponse to protobuf OrderDetails.
+	ToPbOrderDetails(res responses.OrderDetailsResponse) *pb.OrderDetails
+
+	// ToPbShipment maps internal ShipmentResponse to protobuf Shipment.
+	ToPbShipment(res responses.ShipmentResponse) *pb.Shipment
+
+	// ToPbShipmentDetails maps internal ShipmentResponse to protobuf ShipmentDetails.
+	ToPbShipmentDetails(res responses.ShipmentResponse) *pb.ShipmentDetails
 }
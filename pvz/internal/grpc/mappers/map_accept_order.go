@@ -4,8 +4,12 @@ import (
 	"pvz-cli/internal/common/constants"
 	"pvz-cli/internal/common/utils"
 	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
 	"pvz-cli/internal/usecases/responses"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // FromPbAcceptOrderRequest converts a gRPC AcceptOrderRequest into an internal request model.
@@ -17,6 +21,10 @@ func (f *DefaultGRPCFacadeMapper) FromPbAcceptOrderRequest(in *pb.AcceptOrderReq
 		return requests.AcceptOrderRequest{}, err
 	}
 	pkg := fromPbPackageTypePtr(in.Package)
+	var expiresAt time.Time
+	if in.ExpiresAt != nil {
+		expiresAt = in.ExpiresAt.AsTime()
+	}
 
 	if err := utils.ValidateFractionDigits("weight", in.Weight, constants.WeightFractionDigit); err != nil {
 		return requests.AcceptOrderRequest{}, err
@@ -28,17 +36,25 @@ func (f *DefaultGRPCFacadeMapper) FromPbAcceptOrderRequest(in *pb.AcceptOrderReq
 	return requests.AcceptOrderRequest{
 		OrderID:   in.OrderId,
 		UserID:    in.UserId,
-		ExpiresAt: in.ExpiresAt.AsTime(),
+		ExpiresAt: expiresAt,
 		Weight:    in.Weight,
 		Price:     in.Price,
 		Package:   pkg,
+		Handling: models.HandlingFlags{
+			Fragile:       in.Fragile,
+			Hazardous:     in.Hazardous,
+			AgeRestricted: in.AgeRestricted,
+		},
 	}, nil
 }
 
 // ToPbAcceptOrderResponse converts an internal AcceptOrderResponse into a gRPC OrderResponse.
 func (f *DefaultGRPCFacadeMapper) ToPbAcceptOrderResponse(res responses.AcceptOrderResponse) *pb.OrderResponse {
 	return &pb.OrderResponse{
-		OrderId: res.OrderID,
-		Status:  pb.OrderStatus_ORDER_STATUS_ACCEPTED,
+		OrderId:       res.OrderID,
+		Status:        pb.OrderStatus_ORDER_STATUS_ACCEPTED,
+		ExpiresAt:     timestamppb.New(res.ExpiresAt),
+		StoragePolicy: res.StoragePolicy,
+		Version:       res.Version,
 	}
 }
@@ -0,0 +1,43 @@
+package mappers
+
+import (
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/tabular"
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/usecases/requests"
+)
+
+// FromPbExportRequest maps a gRPC ExportRequest to the internal request model.
+func (f *DefaultGRPCFacadeMapper) FromPbExportRequest(in *pb.ExportRequest) (requests.ExportRequest, error) {
+	var req requests.ExportRequest
+	switch in.Dataset {
+	case pb.ExportDataset_EXPORT_DATASET_ORDERS:
+		req.Dataset = requests.ExportOrders
+	case pb.ExportDataset_EXPORT_DATASET_RETURNS:
+		req.Dataset = requests.ExportReturns
+	case pb.ExportDataset_EXPORT_DATASET_HISTORY:
+		req.Dataset = requests.ExportHistory
+	default:
+		return requests.ExportRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid ExportRequest.dataset: unknown dataset %d", in.Dataset)
+	}
+	switch in.Format {
+	case pb.ExportFormat_EXPORT_FORMAT_CSV:
+		req.Format = tabular.CSV
+	case pb.ExportFormat_EXPORT_FORMAT_NDJSON:
+		req.Format = tabular.NDJSON
+	default:
+		return requests.ExportRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid ExportRequest.format: unknown format %d", in.Format)
+	}
+
+	if in.Filter != nil {
+		filter, err := f.FromPbSearchOrdersRequest(in.Filter)
+		if err != nil {
+			return requests.ExportRequest{}, err
+		}
+		req.Orders = filter
+	} else {
+		req.Orders = requests.NewOrdersFilter()
+	}
+	req.History.OrderID = in.OrderId
+	return req, nil
+}
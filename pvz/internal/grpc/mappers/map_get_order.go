@@ -0,0 +1,34 @@
+package mappers
+
+import (
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/usecases/responses"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromPbGetOrderRequest extracts the order ID from a gRPC OrderIdRequest.
+func (f *DefaultGRPCFacadeMapper) FromPbGetOrderRequest(in *pb.OrderIdRequest) (uint64, error) {
+	if err := providedOrderIDCheck(in.OrderId); err != nil {
+		return 0, err
+	}
+	return in.OrderId, nil
+}
+
+// ToPbOrderDetails maps the internal OrderDetailsResponse to a gRPC OrderDetails.
+func (f *DefaultGRPCFacadeMapper) ToPbOrderDetails(res responses.OrderDetailsResponse) *pb.OrderDetails {
+	return &pb.OrderDetails{
+		Order:           toPbOrder(res.Order),
+		History:         toPbOrderHistory(res.History),
+		StorageDeadline: toPbTimestampPtr(res.StorageDeadline),
+		ReturnDeadline:  toPbTimestampPtr(res.ReturnDeadline),
+	}
+}
+
+func toPbTimestampPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,71 @@
+package mappers
+
+import (
+	"pvz-cli/internal/common/apperrors"
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/responses"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromPbImportJobIdRequest extracts the job ID from protobuf ImportJobIdRequest.
+func (f *DefaultGRPCFacadeMapper) FromPbImportJobIdRequest(in *pb.ImportJobIdRequest) (uint64, error) {
+	if in.JobId == 0 {
+		return 0, apperrors.Newf(apperrors.InvalidID, "invalid ImportJobIdRequest.job_id: value must be greater than or equal to 1")
+	}
+	return in.JobId, nil
+}
+
+// ToPbImportJob maps the internal ImportJobResponse to a gRPC ImportJob.
+func (f *DefaultGRPCFacadeMapper) ToPbImportJob(res responses.ImportJobResponse) *pb.ImportJob {
+	job := res.Job
+	out := &pb.ImportJob{
+		JobId:      job.JobID,
+		Status:     toPbImportJobStatus(job.Status),
+		Atomic:     job.Atomic,
+		Total:      clampInt32(job.Total),
+		Processed:  clampInt32(job.Processed),
+		Imported:   clampInt32(job.Imported),
+		Failed:     clampInt32(job.Failed),
+		OperatorId: job.OperatorID,
+		Error:      job.Error,
+		CreatedAt:  timestamppb.New(job.CreatedAt),
+		UpdatedAt:  timestamppb.New(job.UpdatedAt),
+	}
+	if job.FinishedAt != nil {
+		out.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	return out
+}
+
+// ToPbImportJobFailures maps the internal ImportJobFailuresResponse to gRPC ImportJobFailures.
+func (f *DefaultGRPCFacadeMapper) ToPbImportJobFailures(res responses.ImportJobFailuresResponse) *pb.ImportJobFailures {
+	failures := make([]*pb.ImportJobFailure, 0, len(res.Failures))
+	for _, fl := range res.Failures {
+		failures = append(failures, &pb.ImportJobFailure{
+			Row:     uint32(fl.ItemNumber), // #nosec G115 -- item numbers are positions in the request
+			OrderId: fl.OrderID,
+			Code:    fl.Code,
+			Reason:  fl.Reason,
+		})
+	}
+	return &pb.ImportJobFailures{JobId: res.JobID, Failures: failures}
+}
+
+func toPbImportJobStatus(s models.ImportJobStatus) pb.ImportJobStatus {
+	switch s {
+	case models.ImportJobQueued:
+		return pb.ImportJobStatus_IMPORT_JOB_STATUS_QUEUED
+	case models.ImportJobRunning:
+		return pb.ImportJobStatus_IMPORT_JOB_STATUS_RUNNING
+	case models.ImportJobCompleted:
+		return pb.ImportJobStatus_IMPORT_JOB_STATUS_COMPLETED
+	case models.ImportJobFailed:
+		return pb.ImportJobStatus_IMPORT_JOB_STATUS_FAILED
+	case models.ImportJobCancelled:
+		return pb.ImportJobStatus_IMPORT_JOB_STATUS_CANCELLED
+	default:
+		return pb.ImportJobStatus_IMPORT_JOB_STATUS_UNSPECIFIED
+	}
+}
@@ -28,10 +28,20 @@ func (f *DefaultGRPCFacadeMapper) FromPbImportOrdersRequest(
 	}
 
 	return requests.ImportOrdersRequest{
-		Statuses: statuses,
+		Statuses:     statuses,
+		Atomic:       in.Atomic,
+		ValidateOnly: in.ValidateOnly,
 	}
 }
 
+// FromPbImportOrdersChunk maps a chunk of a streamed import to internal model; items are numbered within the chunk.
+func (f *DefaultGRPCFacadeMapper) FromPbImportOrdersChunk(in *pb.ImportOrdersChunk) requests.ImportOrdersRequest {
+	return f.FromPbImportOrdersRequest(&pb.ImportOrdersRequest{
+		Orders:       in.Orders,
+		ValidateOnly: in.ValidateOnly,
+	})
+}
+
 // ToPbImportResult maps internal ImportOrdersResponse to protobuf ImportResult.
 func (f *DefaultGRPCFacadeMapper) ToPbImportResult(res responses.ImportOrdersResponse) *pb.ImportResult {
 	fails := make([]*pb.FailedBatchedOrder, 0, len(res.Statuses))
@@ -44,14 +54,39 @@ func (f *DefaultGRPCFacadeMapper) ToPbImportResult(res responses.ImportOrdersRes
 			})
 		}
 	}
-	var imported int32
-	if res.Imported > math.MaxInt32 {
-		imported = math.MaxInt32
-	} else {
-		imported = int32(res.Imported) // #nosec G115
-	}
-	return &pb.ImportResult{
+	imported := clampInt32(res.Imported)
+	result := &pb.ImportResult{
 		Imported: imported,
 		Errors:   fails,
 	}
+	if res.ValidateOnly {
+		result.Valid = clampInt32(res.Valid)
+		result.Rows = toPbImportRowReports(res.Statuses)
+	}
+	return result
+}
+
+func toPbImportRowReports(statuses []requests.ImportOrderStatus) []*pb.ImportRowReport {
+	rows := make([]*pb.ImportRowReport, 0, len(statuses))
+	for _, st := range statuses {
+		row := &pb.ImportRowReport{
+			Row:        uint32(st.ItemNumber), // #nosec G115 -- item numbers are positions in the request
+			OrderId:    st.OrderID,
+			Valid:      st.Error == nil,
+			TotalPrice: st.TotalPrice,
+		}
+		if st.Error != nil {
+			row.Code = apperrors.CodeFromError(st.Error)
+			row.Reason = apperrors.MessageFromError(st.Error)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func clampInt32(n int) int32 {
+	if n > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(n) // #nosec G115
 }
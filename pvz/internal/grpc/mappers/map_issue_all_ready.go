@@ -0,0 +1,41 @@
+package mappers
+
+import (
+	"pvz-cli/internal/common/apperrors"
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// FromPbIssueAllReadyRequest maps a gRPC IssueAllReadyRequest to the internal request model.
+func (f *DefaultGRPCFacadeMapper) FromPbIssueAllReadyRequest(in *pb.IssueAllReadyRequest) (requests.IssueAllReadyRequest, error) {
+	if err := providedUserIDCheck(in.UserId); err != nil {
+		return requests.IssueAllReadyRequest{}, err
+	}
+	return requests.IssueAllReadyRequest{
+		UserID:           in.UserId,
+		IdentityVerified: in.IdentityVerified,
+	}, nil
+}
+
+// ToPbIssueReceipt maps the internal IssueReceiptResponse to a gRPC IssueReceipt.
+func (f *DefaultGRPCFacadeMapper) ToPbIssueReceipt(res responses.IssueReceiptResponse) *pb.IssueReceipt {
+	issued := make([]*pb.Order, 0, len(res.Issued))
+	for _, o := range res.Issued {
+		issued = append(issued, toPbOrder(o))
+	}
+	skipped := make([]*pb.FailedBatchedOrder, 0, len(res.Skipped))
+	for _, report := range res.Skipped {
+		skipped = append(skipped, &pb.FailedBatchedOrder{
+			OrderId: report.OrderID,
+			Code:    apperrors.CodeFromError(report.Error),
+			Reason:  apperrors.MessageFromError(report.Error),
+		})
+	}
+	return &pb.IssueReceipt{
+		UserId:      res.UserID,
+		Issued:      issued,
+		Skipped:     skipped,
+		TotalAmount: res.Total,
+	}
+}
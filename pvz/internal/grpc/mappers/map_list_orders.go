@@ -21,6 +21,7 @@ func (f *DefaultGRPCFacadeMapper) FromPbListOrdersRequest(in *pb.ListOrdersReque
 	}
 
 	opts = append(opts, collectPaginationOptions(in.Pagination)...)
+	opts = append(opts, requests.WithPageToken(in.PageToken))
 	return requests.NewOrdersFilter(opts...), nil
 }
 
@@ -42,7 +43,8 @@ func (f *DefaultGRPCFacadeMapper) ToPbOrdersList(res responses.ListOrdersRespons
 	}
 
 	return &pb.OrdersList{
-		Orders: pbOrders,
-		Total:  totalValue,
+		Orders:        pbOrders,
+		Total:         totalValue,
+		NextPageToken: res.NextPageToken,
 	}
 }
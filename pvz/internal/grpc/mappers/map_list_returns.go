@@ -11,8 +11,10 @@ import (
 func (f *DefaultGRPCFacadeMapper) FromPbListReturnsRequest(in *pb.ListReturnsRequest) requests.OrdersFilterRequest {
 	opts := []requests.FilterOption{
 		requests.WithStatus(models.Returned),
+		requests.WithSort(requests.SortByUpdatedStatusAt, false),
 	}
 	opts = append(opts, collectPaginationOptions(in.Pagination)...)
+	opts = append(opts, requests.WithPageToken(in.PageToken))
 	return requests.NewOrdersFilter(opts...)
 }
 
@@ -24,6 +26,7 @@ func (f *DefaultGRPCFacadeMapper) ToPbReturnsList(res responses.ListOrdersRespon
 		pbOrders = append(pbOrders, toPbOrder(r))
 	}
 	return &pb.ReturnsList{
-		Returns: pbOrders,
+		Returns:       pbOrders,
+		NextPageToken: res.NextPageToken,
 	}
 }
@@ -1,20 +1,45 @@
 package mappers
 
 import (
+	"encoding/json"
+	"pvz-cli/internal/common/apperrors"
 	"pvz-cli/internal/common/constants"
 	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
 	"pvz-cli/internal/usecases/responses"
 
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // FromPbOrderHistoryRequest maps a gRPC GetHistoryRequest to the internal request model.
-func (f *DefaultGRPCFacadeMapper) FromPbOrderHistoryRequest(in *pb.GetHistoryRequest) requests.OrderHistoryFilter {
+func (f *DefaultGRPCFacadeMapper) FromPbOrderHistoryRequest(in *pb.GetHistoryRequest) (requests.OrderHistoryFilter, error) {
 	var req requests.OrderHistoryFilter
 	if in.OrderId != 0 {
 		req.OrderID = &in.OrderId
 	}
+	req.UserID = in.UserId
+	for _, e := range in.Events {
+		event, err := fromPbEventType(e)
+		if err != nil {
+			return requests.OrderHistoryFilter{}, err
+		}
+		req.Events = append(req.Events, event)
+	}
+	req.From = fromPbTimePtr(in.From)
+	req.To = fromPbTimePtr(in.To)
+	if in.ActorType != pb.ActorType_ACTOR_TYPE_UNSPECIFIED {
+		actorType := fromPbActorType(in.ActorType)
+		req.ActorType = &actorType
+	}
+	if in.ActorId != nil {
+		if req.ActorType == nil {
+			return requests.OrderHistoryFilter{}, apperrors.Newf(apperrors.ValidationFailed, "invalid GetHistoryRequest.actor_id: actor_type is required")
+		}
+		req.ActorID = in.ActorId
+	}
+	req.Asc = in.Ascending
 	req.Page = constants.DefaultHistoryPage
 	req.Limit = constants.DefaultHistoryLimit
 	if in.Pagination != nil {
@@ -25,20 +50,96 @@ func (f *DefaultGRPCFacadeMapper) FromPbOrderHistoryRequest(in *pb.GetHistoryReq
 			req.Limit = limit
 		}
 	}
-	return req
+	req.PageToken = in.PageToken
+	return req, nil
 }
 
 // ToPbOrderHistoryList maps internal OrderHistoryResponse to protobuf OrderHistoryList.
 func (f *DefaultGRPCFacadeMapper) ToPbOrderHistoryList(res responses.OrderHistoryResponse) *pb.OrderHistoryList {
-	history := make([]*pb.OrderHistory, 0, len(res.History))
-	for _, e := range res.History {
+	return &pb.OrderHistoryList{
+		History:       toPbOrderHistory(res.History),
+		NextPageToken: res.NextPageToken,
+	}
+}
+
+func toPbOrderHistory(entries []models.HistoryEntry) []*pb.OrderHistory {
+	history := make([]*pb.OrderHistory, 0, len(entries))
+	for _, e := range entries {
 		history = append(history, &pb.OrderHistory{
-			OrderId:   e.OrderID,
-			EventType: toPbEventType(e.Event),
-			CreatedAt: timestamppb.New(e.Timestamp),
+			OrderId:        e.OrderID,
+			EventType:      toPbEventType(e.Event),
+			CreatedAt:      timestamppb.New(e.Timestamp),
+			OperatorId:     e.OperatorID,
+			Actor:          toPbActor(e.Actor()),
+			PreviousStatus: toPbOrderStatus(e.PreviousStatus),
+			NewStatus:      toPbOrderStatus(e.NewStatus),
+			CorrelationId:  e.CorrelationID,
+			Metadata:       toPbHistoryMetadata(e.Metadata),
 		})
 	}
-	return &pb.OrderHistoryList{
-		History: history,
+	return history
+}
+
+func toPbActor(a models.Actor) *pb.Actor {
+	if a.Type == "" {
+		return nil
+	}
+	return &pb.Actor{Type: toPbActorType(a.Type), Id: a.ID}
+}
+
+func fromPbActorType(t pb.ActorType) models.ActorType {
+	switch t {
+	case pb.ActorType_ACTOR_TYPE_COURIER:
+		return models.ActorCourier
+	case pb.ActorType_ACTOR_TYPE_CLIENT:
+		return models.ActorClient
+	default:
+		return models.ActorOperator
+	}
+}
+
+func fromPbEventType(e pb.EventType) (models.EventType, error) {
+	switch e {
+	case pb.EventType_EVENT_ACCEPTED:
+		return models.EventAccepted, nil
+	case pb.EventType_EVENT_ISSUED:
+		return models.EventIssued, nil
+	case pb.EventType_EVENT_RETURNED_FROM_CLIENT:
+		return models.EventReturnedByClient, nil
+	case pb.EventType_EVENT_RETURNED_TO_WAREHOUSE:
+		return models.EventReturnedToWarehouse, nil
+	case pb.EventType_EVENT_UNDONE:
+		return models.EventUndone, nil
+	default:
+		return 0, apperrors.Newf(apperrors.ValidationFailed, "invalid GetHistoryRequest.events: unsupported event %s", e)
+	}
+}
+
+func toPbActorType(t models.ActorType) pb.ActorType {
+	switch t {
+	case models.ActorCourier:
+		return pb.ActorType_ACTOR_TYPE_COURIER
+	case models.ActorClient:
+		return pb.ActorType_ACTOR_TYPE_CLIENT
+	case models.ActorOperator:
+		return pb.ActorType_ACTOR_TYPE_OPERATOR
+	default:
+		return pb.ActorType_ACTOR_TYPE_UNSPECIFIED
+	}
+}
+
+// toPbHistoryMetadata converts the stored JSON object; entries without metadata or with a malformed one get none
+func toPbHistoryMetadata(raw json.RawMessage) *structpb.Struct {
+	if len(raw) == 0 {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+	out, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil
 	}
+	return out
 }
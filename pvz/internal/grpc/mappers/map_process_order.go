@@ -22,9 +22,11 @@ func (f *DefaultGRPCFacadeMapper) FromPbProcessOrdersRequest(in *pb.ProcessOrder
 		action = "unknown"
 	}
 	return requests.ProcessOrdersRequest{
-		UserID:   in.UserId,
-		OrderIDs: in.OrderIds,
-		Action:   action,
+		UserID:           in.UserId,
+		OrderIDs:         in.OrderIds,
+		Action:           action,
+		IdentityVerified: in.IdentityVerified,
+		Atomic:           in.Atomic,
 	}, nil
 }
 
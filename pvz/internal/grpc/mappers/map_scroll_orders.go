@@ -0,0 +1,34 @@
+package mappers
+
+import (
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// FromPbScrollOrdersRequest maps a gRPC ScrollOrdersRequest to the same filter the CLI scroll-orders command uses.
+func (f *DefaultGRPCFacadeMapper) FromPbScrollOrdersRequest(in *pb.ScrollOrdersRequest) (requests.OrdersFilterRequest, error) {
+	if err := providedUserIDCheck(in.UserId); err != nil {
+		return requests.OrdersFilterRequest{}, err
+	}
+	opts := []requests.FilterOption{
+		requests.WithUserID(in.UserId),
+		requests.WithPageToken(in.Cursor),
+	}
+	if in.Limit != nil {
+		opts = append(opts, requests.WithLimit(int(*in.Limit)))
+	}
+	return requests.NewOrdersFilter(opts...), nil
+}
+
+// ToPbScrollOrdersResponse maps the internal ListOrdersResponse to a gRPC ScrollOrdersResponse.
+func (f *DefaultGRPCFacadeMapper) ToPbScrollOrdersResponse(res responses.ListOrdersResponse) *pb.ScrollOrdersResponse {
+	orders := make([]*pb.Order, 0, len(res.Orders))
+	for _, o := range res.Orders {
+		orders = append(orders, toPbOrder(o))
+	}
+	return &pb.ScrollOrdersResponse{
+		Orders:     orders,
+		NextCursor: res.NextPageToken,
+	}
+}
@@ -0,0 +1,107 @@
+package mappers
+
+import (
+	"pvz-cli/internal/common/apperrors"
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromPbSearchOrdersRequest maps a gRPC SearchOrdersRequest to the internal request model.
+func (f *DefaultGRPCFacadeMapper) FromPbSearchOrdersRequest(in *pb.SearchOrdersRequest) (requests.OrdersFilterRequest, error) {
+	var opts []requests.FilterOption
+	if in.UserId != nil {
+		if err := providedUserIDCheck(*in.UserId); err != nil {
+			return requests.OrdersFilterRequest{}, err
+		}
+		opts = append(opts, requests.WithUserID(*in.UserId))
+	}
+	if in.InPvz {
+		opts = append(opts, requests.WithInPvz(true))
+	}
+	sortBy, err := fromPbOrderSortField(in.SortBy)
+	if err != nil {
+		return requests.OrdersFilterRequest{}, err
+	}
+	opts = append(opts, requests.WithSort(sortBy, in.SortDesc))
+	opts = append(opts, collectPaginationOptions(in.Pagination)...)
+	opts = append(opts, requests.WithPageToken(in.PageToken))
+	filter := requests.NewOrdersFilter(opts...)
+
+	for _, st := range in.Statuses {
+		status, err := fromPbOrderStatus(st)
+		if err != nil {
+			return requests.OrdersFilterRequest{}, err
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+	for _, p := range in.Packages {
+		pkg := fromPbPackageType(p)
+		if pkg == models.PackageNone || pkg == unknownPackage {
+			return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid SearchOrdersRequest.packages: unknown package type %d", p)
+		}
+		filter.Packages = append(filter.Packages, pkg)
+	}
+
+	filter.CreatedFrom = fromPbTimePtr(in.CreatedFrom)
+	filter.CreatedTo = fromPbTimePtr(in.CreatedTo)
+	filter.ExpiresFrom = fromPbTimePtr(in.ExpiresFrom)
+	filter.ExpiresTo = fromPbTimePtr(in.ExpiresTo)
+	filter.UpdatedFrom = fromPbTimePtr(in.UpdatedFrom)
+	filter.UpdatedTo = fromPbTimePtr(in.UpdatedTo)
+	filter.PriceMin = in.PriceMin
+	filter.PriceMax = in.PriceMax
+	filter.WeightMin = in.WeightMin
+	filter.WeightMax = in.WeightMax
+	if in.ExpiringWithin != nil {
+		d := in.ExpiringWithin.AsDuration()
+		if d <= 0 {
+			return requests.OrdersFilterRequest{}, apperrors.Newf(apperrors.ValidationFailed, "invalid SearchOrdersRequest.expiring_within: value must be greater than 0")
+		}
+		filter.ExpiringWithin = &d
+	}
+	return filter, nil
+}
+
+func fromPbOrderStatus(s pb.OrderStatus) (models.OrderStatus, error) {
+	switch s {
+	case pb.OrderStatus_ORDER_STATUS_ACCEPTED:
+		return models.Accepted, nil
+	case pb.OrderStatus_ORDER_STATUS_RETURNED_BY_CLIENT:
+		return models.Returned, nil
+	case pb.OrderStatus_ORDER_STATUS_ISSUED:
+		return models.Issued, nil
+	default:
+		return 0, apperrors.Newf(apperrors.ValidationFailed, "invalid SearchOrdersRequest.statuses: unsupported status %s", s)
+	}
+}
+
+func fromPbOrderSortField(s pb.OrderSortField) (requests.OrderSortField, error) {
+	switch s {
+	case pb.OrderSortField_ORDER_SORT_FIELD_UNSPECIFIED:
+		return "", nil
+	case pb.OrderSortField_ORDER_SORT_FIELD_CREATED_AT:
+		return requests.SortByCreatedAt, nil
+	case pb.OrderSortField_ORDER_SORT_FIELD_EXPIRES_AT:
+		return requests.SortByExpiresAt, nil
+	case pb.OrderSortField_ORDER_SORT_FIELD_UPDATED_STATUS_AT:
+		return requests.SortByUpdatedStatusAt, nil
+	case pb.OrderSortField_ORDER_SORT_FIELD_PRICE:
+		return requests.SortByPrice, nil
+	case pb.OrderSortField_ORDER_SORT_FIELD_WEIGHT:
+		return requests.SortByWeight, nil
+	default:
+		return "", apperrors.Newf(apperrors.ValidationFailed, "invalid SearchOrdersRequest.sort_by: unknown sort field %d", s)
+	}
+}
+
+func fromPbTimePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
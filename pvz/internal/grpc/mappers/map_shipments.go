@@ -0,0 +1,113 @@
+package mappers
+
+import (
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/common/utils"
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromPbRegisterShipmentRequest maps a gRPC RegisterShipmentRequest to the internal request model.
+func (f *DefaultGRPCFacadeMapper) FromPbRegisterShipmentRequest(in *pb.RegisterShipmentRequest) (requests.RegisterShipmentRequest, error) {
+	if err := providedUserIDCheck(in.SenderId); err != nil {
+		return requests.RegisterShipmentRequest{}, err
+	}
+	if err := utils.ValidateFractionDigits("weight", in.Weight, constants.WeightFractionDigit); err != nil {
+		return requests.RegisterShipmentRequest{}, err
+	}
+	if err := utils.ValidateFractionDigits("price", in.Price, constants.PriceFractionDigit); err != nil {
+		return requests.RegisterShipmentRequest{}, err
+	}
+	return requests.RegisterShipmentRequest{
+		SenderID:           in.SenderId,
+		DestinationPointID: in.DestinationPointId,
+		Weight:             in.Weight,
+		Price:              in.Price,
+		Package:            fromPbPackageTypePtr(in.Package),
+		Handling: models.HandlingFlags{
+			Fragile:       in.Fragile,
+			Hazardous:     in.Hazardous,
+			AgeRestricted: in.AgeRestricted,
+		},
+	}, nil
+}
+
+// FromPbHandOverShipmentRequest maps a gRPC HandOverShipmentRequest to the internal request model.
+func (f *DefaultGRPCFacadeMapper) FromPbHandOverShipmentRequest(in *pb.HandOverShipmentRequest) (requests.HandOverShipmentRequest, error) {
+	if err := providedShipmentIDCheck(in.ShipmentId); err != nil {
+		return requests.HandOverShipmentRequest{}, err
+	}
+	return requests.HandOverShipmentRequest{
+		ShipmentID: in.ShipmentId,
+		CourierID:  in.CourierId,
+	}, nil
+}
+
+// FromPbShipmentIdRequest extracts the shipment ID from a gRPC ShipmentIdRequest.
+func (f *DefaultGRPCFacadeMapper) FromPbShipmentIdRequest(in *pb.ShipmentIdRequest) (uint64, error) {
+	if err := providedShipmentIDCheck(in.ShipmentId); err != nil {
+		return 0, err
+	}
+	return in.ShipmentId, nil
+}
+
+// ToPbShipment maps the internal ShipmentResponse to a gRPC Shipment.
+func (f *DefaultGRPCFacadeMapper) ToPbShipment(res responses.ShipmentResponse) *pb.Shipment {
+	return toPbShipment(res.Shipment)
+}
+
+// ToPbShipmentDetails maps the internal ShipmentResponse to a gRPC ShipmentDetails with history.
+func (f *DefaultGRPCFacadeMapper) ToPbShipmentDetails(res responses.ShipmentResponse) *pb.ShipmentDetails {
+	history := make([]*pb.ShipmentHistoryEntry, 0, len(res.History))
+	for _, e := range res.History {
+		history = append(history, &pb.ShipmentHistoryEntry{
+			Status:    toPbShipmentStatus(e.Status),
+			CreatedAt: timestamppb.New(e.Timestamp),
+		})
+	}
+	return &pb.ShipmentDetails{
+		Shipment: toPbShipment(res.Shipment),
+		History:  history,
+	}
+}
+
+func toPbShipment(s models.Shipment) *pb.Shipment {
+	return &pb.Shipment{
+		ShipmentId:         s.ShipmentID,
+		SenderId:           s.SenderID,
+		DestinationPointId: s.DestinationPointID,
+		Status:             toPbShipmentStatus(s.Status),
+		CourierId:          s.CourierID,
+		Weight:             s.Weight,
+		TotalPrice:         s.Price,
+		Package:            toPbPackageTypePtr(s.Package),
+		Fragile:            s.Fragile,
+		Hazardous:          s.Hazardous,
+		AgeRestricted:      s.AgeRestricted,
+		CreatedAt:          timestamppb.New(s.CreatedAt),
+		UpdatedStatusAt:    timestamppb.New(s.UpdatedStatusAt),
+	}
+}
+
+func toPbShipmentStatus(s models.ShipmentStatus) pb.ShipmentStatus {
+	switch s {
+	case models.ShipmentRegistered:
+		return pb.ShipmentStatus_SHIPMENT_STATUS_REGISTERED
+	case models.ShipmentHandedOver:
+		return pb.ShipmentStatus_SHIPMENT_STATUS_HANDED_OVER
+	default:
+		return pb.ShipmentStatus_SHIPMENT_STATUS_UNSPECIFIED
+	}
+}
+
+func providedShipmentIDCheck(id uint64) error {
+	if id == 0 {
+		return apperrors.Newf(apperrors.InvalidID, "invalid ShipmentIdRequest.shipment_id: value must be greater than or equal to 1")
+	}
+	return nil
+}
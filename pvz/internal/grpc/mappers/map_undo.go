@@ -0,0 +1,31 @@
+package mappers
+
+import (
+	pb "pvz-cli/internal/gen/orders"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// FromPbUndoRequest maps a gRPC OrderIdRequest to the internal UndoRequest.
+func (f *DefaultGRPCFacadeMapper) FromPbUndoRequest(in *pb.OrderIdRequest) (requests.UndoRequest, error) {
+	if err := providedOrderIDCheck(in.OrderId); err != nil {
+		return requests.UndoRequest{}, err
+	}
+
+	return requests.UndoRequest{
+		OrderID: in.OrderId,
+	}, nil
+}
+
+// ToPbUndoResult maps the internal UndoResponse to a gRPC UndoResult.
+func (f *DefaultGRPCFacadeMapper) ToPbUndoResult(res responses.UndoResponse) *pb.UndoResult {
+	out := &pb.UndoResult{
+		OrderId:     res.OrderID,
+		UndoneEvent: toPbEventType(res.Undone),
+		Removed:     res.Removed,
+	}
+	if !res.Removed {
+		out.Status = toPbOrderStatus(res.Status)
+	}
+	return out
+}
@@ -13,13 +13,20 @@ const unknownPackage = -1
 
 func toPbOrder(o models.Order) *pb.Order {
 	return &pb.Order{
-		OrderId:    o.OrderID,
-		UserId:     o.UserID,
-		Status:     toPbOrderStatus(o.Status),
-		ExpiresAt:  timestamppb.New(o.ExpiresAt),
-		Weight:     o.Weight,
-		TotalPrice: o.Price,
-		Package:    toPbPackageTypePtr(o.Package),
+		OrderId:         o.OrderID,
+		UserId:          o.UserID,
+		Status:          toPbOrderStatus(o.Status),
+		ExpiresAt:       timestamppb.New(o.ExpiresAt),
+		Weight:          o.Weight,
+		TotalPrice:      o.Price,
+		Package:         toPbPackageTypePtr(o.Package),
+		Fragile:         o.Fragile,
+		Hazardous:       o.Hazardous,
+		AgeRestricted:   o.AgeRestricted,
+		StoragePolicy:   o.StoragePolicy,
+		Version:         o.Version,
+		CreatedAt:       timestamppb.New(o.CreatedAt),
+		UpdatedStatusAt: timestamppb.New(o.UpdatedStatusAt),
 	}
 }
 
@@ -31,6 +38,8 @@ func toPbOrderStatus(s models.OrderStatus) pb.OrderStatus {
 		return pb.OrderStatus_ORDER_STATUS_RETURNED_BY_CLIENT
 	case models.Issued:
 		return pb.OrderStatus_ORDER_STATUS_ISSUED
+	case models.ReturnedToWarehouse:
+		return pb.OrderStatus_ORDER_STATUS_RETURNED_TO_WAREHOUSE
 	default:
 		return pb.OrderStatus_ORDER_STATUS_UNSPECIFIED
 	}
@@ -71,6 +80,8 @@ func toPbEventType(e models.EventType) pb.EventType {
 		return pb.EventType_EVENT_RETURNED_FROM_CLIENT
 	case models.EventReturnedToWarehouse:
 		return pb.EventType_EVENT_RETURNED_TO_WAREHOUSE
+	case models.EventUndone:
+		return pb.EventType_EVENT_UNDONE
 	default:
 		return pb.EventType_EVENT_UNSPECIFIED
 	}
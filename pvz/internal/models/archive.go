@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// ArchivedOrder is an order in a terminal state moved out of the live orders table.
+type ArchivedOrder struct {
+	Order
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// ArchivedHistoryEntry is a history entry moved to the archive together with its order.
+type ArchivedHistoryEntry struct {
+	HistoryEntry
+	ArchivedAt time.Time `json:"archived_at"`
+}
+
+// ArchiveStats summarizes a single run of the archival job.
+type ArchiveStats struct {
+	Archived int
+	Purged   int
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditSource names the interface a mutating call came through.
+type AuditSource string
+
+const (
+	// AuditSourceGRPC is a call to the gRPC servers, directly or through the HTTP gateway
+	AuditSourceGRPC AuditSource = "grpc"
+	// AuditSourceCLI is a command of the interactive CLI
+	AuditSourceCLI AuditSource = "cli"
+)
+
+// AuditRecord is a mutating call as recorded in the audit log.
+type AuditRecord struct {
+	ID        uint64    `json:"id" db:"id"`
+	Timestamp time.Time `json:"timestamp" db:"timestamp"`
+	// OperatorID and Role identify the caller; both are empty for anonymous calls
+	OperatorID string      `json:"operator_id,omitempty" db:"operator_id"`
+	Role       string      `json:"role,omitempty" db:"role"`
+	Source     AuditSource `json:"source" db:"source"`
+	// Method is the full gRPC method name, or the facade operation such as AcceptOrder for the CLI
+	Method string `json:"method" db:"method"`
+	// Request is the request as JSON with client identities redacted and bulk payloads shortened
+	Request json.RawMessage `json:"request,omitempty" db:"request"`
+	// ResultCode is the gRPC status code for gRPC calls and the application error code for CLI calls, OK on success
+	ResultCode    string `json:"result_code" db:"result_code"`
+	CorrelationID string `json:"correlation_id,omitempty" db:"correlation_id"`
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"pvz-cli/internal/common/constants"
+	"time"
+)
+
+// PickupCalendar describes opening hours, weekly days off and holidays of the pickup point.
+// An empty calendar means the pickup point is always open.
+type PickupCalendar struct {
+	OpensAt       string         `json:"opens_at"`
+	ClosesAt      string         `json:"closes_at"`
+	WeeklyDaysOff []time.Weekday `json:"weekly_days_off"`
+	Holidays      []time.Time    `json:"holidays"`
+	BusinessDays  bool           `json:"business_days"`
+}
+
+// IsDayOff reports whether the pickup point is closed for the whole day containing t
+func (c PickupCalendar) IsDayOff(t time.Time) bool {
+	for _, wd := range c.WeeklyDaysOff {
+		if t.Weekday() == wd {
+			return true
+		}
+	}
+	y, m, d := t.Date()
+	for _, h := range c.Holidays {
+		hy, hm, hd := h.Date()
+		if hy == y && hm == m && hd == d {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOpenAt reports whether the pickup point is open at the moment t
+func (c PickupCalendar) IsOpenAt(t time.Time) bool {
+	if c.IsDayOff(t) {
+		return false
+	}
+	if c.OpensAt == "" || c.ClosesAt == "" {
+		return true
+	}
+	opens, err := time.Parse(constants.ClockLayout, c.OpensAt)
+	if err != nil {
+		return true
+	}
+	closes, err := time.Parse(constants.ClockLayout, c.ClosesAt)
+	if err != nil {
+		return true
+	}
+	minute := t.Hour()*60 + t.Minute()
+	return minute >= opens.Hour()*60+opens.Minute() && minute < closes.Hour()*60+closes.Minute()
+}
+
+// HasWorkingDays reports whether at least one weekday is not a day off
+func (c PickupCalendar) HasWorkingDays() bool {
+	off := make(map[time.Weekday]struct{}, len(c.WeeklyDaysOff))
+	for _, wd := range c.WeeklyDaysOff {
+		off[wd] = struct{}{}
+	}
+	return len(off) < 7
+}
+
+// AddDays shifts from by the given number of days, counting only business days when BusinessDays is enabled
+func (c PickupCalendar) AddDays(from time.Time, days int) time.Time {
+	if !c.BusinessDays || !c.HasWorkingDays() {
+		return from.AddDate(0, 0, days)
+	}
+	t := from
+	for days > 0 {
+		t = t.AddDate(0, 0, 1)
+		if !c.IsDayOff(t) {
+			days--
+		}
+	}
+	return t
+}
+
+// Deadline returns the moment the period d elapses after from, counting whole days as business days when enabled
+func (c PickupCalendar) Deadline(from time.Time, d time.Duration) time.Time {
+	if !c.BusinessDays {
+		return from.Add(d)
+	}
+	day := 24 * time.Hour
+	return c.AddDays(from, int(d/day)).Add(d % day)
+}
+
+// StorageExpiry converts the requested expiry date into the one counted in business days from acceptance when enabled
+func (c PickupCalendar) StorageExpiry(acceptedAt, requested time.Time) time.Time {
+	if !c.BusinessDays {
+		return requested
+	}
+	ay, am, ad := acceptedAt.Date()
+	ry, rm, rd := requested.Date()
+	days := int(time.Date(ry, rm, rd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+	if days <= 0 {
+		return requested
+	}
+	ey, em, ed := c.AddDays(acceptedAt, days).Date()
+	return time.Date(ey, em, ed, requested.Hour(), requested.Minute(), requested.Second(), requested.Nanosecond(), requested.Location())
+}
@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// ConsistencyIssueKind names a kind of drift between a stored order, its history and its outbox events.
+type ConsistencyIssueKind string
+
+const (
+	// IssueMissingAccepted is an order whose history has no ACCEPTED event, so its state cannot be replayed
+	IssueMissingAccepted ConsistencyIssueKind = "MISSING_ACCEPTED"
+	// IssueImpossibleSequence is an event the status rules do not allow in the status replayed before it
+	IssueImpossibleSequence ConsistencyIssueKind = "IMPOSSIBLE_SEQUENCE"
+	// IssueStatusMismatch is a stored order whose status or presence differs from the state replayed from its history
+	IssueStatusMismatch ConsistencyIssueKind = "STATUS_MISMATCH"
+	// IssueMissingOutboxEvent is a history entry without the outbox event written together with it
+	IssueMissingOutboxEvent ConsistencyIssueKind = "MISSING_OUTBOX_EVENT"
+)
+
+// ConsistencyIssue is a single drift found for an order; Repaired is set when the repair mode fixed it.
+type ConsistencyIssue struct {
+	OrderID  uint64
+	Kind     ConsistencyIssueKind
+	Details  string
+	Repaired bool
+}
+
+// ConsistencyReport summarizes a consistency check over all live orders.
+type ConsistencyReport struct {
+	CheckedOrders int
+	Issues        []ConsistencyIssue
+	Repaired      int
+}
+
+// OrderRecord is a live order as stored, together with its history and outbox events, as read by the consistency check.
+type OrderRecord struct {
+	OrderID uint64
+	// Order is the stored row; nil when only the history of a removed order is left
+	Order *Order
+	// Removed marks a row kept after the order left the pickup point or its acceptance was undone
+	Removed bool
+	// History is ordered oldest first
+	History []HistoryEntry
+	// OutboxEvents are the outbox events of the order; empty where no outbox is stored
+	OutboxEvents []OutboxEventRef
+}
+
+// OutboxEventRef identifies an outbox event by the Kafka event type and time of its payload.
+type OutboxEventRef struct {
+	EventType string
+	Timestamp time.Time
+}
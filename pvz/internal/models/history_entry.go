@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -13,13 +14,34 @@ const (
 	EventIssued              EventType = 2
 	EventReturnedByClient    EventType = 3
 	EventReturnedToWarehouse EventType = 4
+	// EventUndone compensates the previous transition of the order
+	EventUndone EventType = 5
 )
 
 // HistoryEntry represents a single event in order lifecycle history
 type HistoryEntry struct {
+	// ID orders entries with equal timestamps; zero for entries stored before IDs were assigned in file storage
+	ID        uint64    `json:"id,omitempty" db:"id"`
 	OrderID   uint64    `json:"order_id" db:"order_id"`
 	Event     EventType `json:"event_type" db:"event"`
 	Timestamp time.Time `json:"timestamp" db:"timestamp"`
+	// OperatorID identifies the pickup-point operator who performed the operation, empty if unknown
+	OperatorID string `json:"operator_id,omitempty" db:"operator_id"`
+	// ActorType and ActorID identify who performed the action, the same actor the Kafka event carries
+	ActorType ActorType `json:"actor_type,omitempty" db:"actor_type"`
+	ActorID   uint64    `json:"actor_id,omitempty" db:"actor_id"`
+	// PreviousStatus and NewStatus are zero when the order did not exist before or does not exist after the event
+	PreviousStatus OrderStatus `json:"previous_status,omitempty" db:"previous_status"`
+	NewStatus      OrderStatus `json:"new_status,omitempty" db:"new_status"`
+	// CorrelationID is the correlation ID of the request that caused the event, empty if unknown
+	CorrelationID string `json:"correlation_id,omitempty" db:"correlation_id"`
+	// Metadata holds event specific details as a JSON object, nil if there are none
+	Metadata json.RawMessage `json:"metadata,omitempty" db:"metadata"`
+}
+
+// Actor returns the actor who performed the action
+func (e HistoryEntry) Actor() Actor {
+	return Actor{Type: e.ActorType, ID: e.ActorID}
 }
 
 func (e EventType) String() string {
@@ -32,6 +54,8 @@ func (e EventType) String() string {
 		return "RETURNED_BY_CLIENT"
 	case EventReturnedToWarehouse:
 		return "RETURNED_TO_WAREHOUSE"
+	case EventUndone:
+		return "UNDONE"
 	default:
 		return "UNKNOWN"
 	}
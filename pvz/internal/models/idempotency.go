@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// IdempotencyRecord stores the response of a mutating call made with an Idempotency-Key so a retry can replay it.
+// The record is saved without a response when the call starts and is pending until the response is stored.
+type IdempotencyRecord struct {
+	// Scope is the operator who made the call; keys of different operators never collide
+	Scope       string    `json:"scope" db:"scope"`
+	Key         string    `json:"key" db:"key"`
+	Method      string    `json:"method" db:"method"`
+	RequestHash string    `json:"request_hash" db:"request_hash"`
+	Response    []byte    `json:"response" db:"response"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	// UserIDs are the clients mentioned in the response, so their data can be exported and erased
+	UserIDs []uint64 `json:"user_ids,omitempty" db:"user_ids"`
+}
+
+// Pending reports whether the call that reserved the key has not finished yet
+func (r IdempotencyRecord) Pending() bool {
+	return r.Response == nil
+}
@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// ImportJob is an order import processed in the background, with its progress counters
+type ImportJob struct {
+	JobID  uint64          `json:"job_id" db:"id"`
+	Status ImportJobStatus `json:"status" db:"status"`
+	Atomic bool            `json:"atomic" db:"atomic"`
+	// Total is the number of orders submitted; Processed of them have been attempted so far
+	Total      int        `json:"total" db:"total"`
+	Processed  int        `json:"processed" db:"processed"`
+	Imported   int        `json:"imported" db:"imported"`
+	Failed     int        `json:"failed" db:"failed"`
+	OperatorID string     `json:"operator_id,omitempty" db:"operator_id"`
+	Error      string     `json:"error,omitempty" db:"error"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at" db:"updated_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty" db:"finished_at"`
+	// InstanceID is the server that queued the job and is the only one to run it
+	InstanceID string `json:"instance_id,omitempty" db:"instance_id"`
+}
+
+// ImportJobStatus represents the current state of an import job
+type ImportJobStatus int32
+
+// Available import job statuses
+const (
+	ImportJobQueued    ImportJobStatus = 1
+	ImportJobRunning   ImportJobStatus = 2
+	ImportJobCompleted ImportJobStatus = 3
+	ImportJobFailed    ImportJobStatus = 4
+	ImportJobCancelled ImportJobStatus = 5
+)
+
+// Available import job statuses in strings (not for manual use, only for String())
+const (
+	importJobQueuedStr    = "QUEUED"
+	importJobRunningStr   = "RUNNING"
+	importJobCompletedStr = "COMPLETED"
+	importJobFailedStr    = "FAILED"
+	importJobCancelledStr = "CANCELLED"
+)
+
+func (s ImportJobStatus) String() string {
+	switch s {
+	case ImportJobQueued:
+		return importJobQueuedStr
+	case ImportJobRunning:
+		return importJobRunningStr
+	case ImportJobCompleted:
+		return importJobCompletedStr
+	case ImportJobFailed:
+		return importJobFailedStr
+	case ImportJobCancelled:
+		return importJobCancelledStr
+	default:
+		return unknownStr
+	}
+}
+
+// Finished reports whether the job reached a final status and will not change anymore
+func (s ImportJobStatus) Finished() bool {
+	return s == ImportJobCompleted || s == ImportJobFailed || s == ImportJobCancelled
+}
+
+// ImportJobFailure is an order of an import job that was not imported
+type ImportJobFailure struct {
+	JobID uint64 `json:"job_id" db:"job_id"`
+	// ItemNumber is the position of the order in the import file
+	ItemNumber int    `json:"item_number" db:"item_number"`
+	OrderID    uint64 `json:"order_id" db:"order_id"`
+	// UserID is the client the rejected order was addressed to, if the import file named one
+	UserID uint64 `json:"user_id,omitempty" db:"user_id"`
+	Code   string `json:"code" db:"code"`
+	Reason string `json:"reason" db:"reason"`
+}
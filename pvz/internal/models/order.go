@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 )
 
@@ -15,6 +16,17 @@ type Order struct {
 	Package         PackageType `json:"package" db:"package"`
 	Weight          float32     `json:"weight" db:"weight"`
 	Price           float32     `json:"price" db:"price"`
+	StoragePolicy   string      `json:"storage_policy,omitempty" db:"storage_policy"`
+	// Version is incremented on every save and guards against lost updates; zero means the order was never stored
+	Version int64 `json:"version" db:"version"`
+	HandlingFlags
+}
+
+// HandlingFlags describes special handling requirements of an order
+type HandlingFlags struct {
+	Fragile       bool `json:"fragile" db:"fragile"`
+	Hazardous     bool `json:"hazardous" db:"hazardous"`
+	AgeRestricted bool `json:"age_restricted" db:"age_restricted"`
 }
 
 // OrderStatus represents the current state of an order in the system
@@ -25,14 +37,17 @@ const (
 	Accepted OrderStatus = 1
 	Returned OrderStatus = 2
 	Issued   OrderStatus = 3
+	// ReturnedToWarehouse is the status an order leaves the pickup point with; it is recorded in history only
+	ReturnedToWarehouse OrderStatus = 4
 )
 
 // Available order statuses in strings (not for manual use, only for String())
 const (
-	acceptedStr = "ACCEPTED"
-	returnedStr = "RETURNED"
-	issuedStr   = "ISSUED"
-	unknownStr  = "UNKNOWN"
+	acceptedStr  = "ACCEPTED"
+	returnedStr  = "RETURNED"
+	issuedStr    = "ISSUED"
+	warehouseStr = "RETURNED_TO_WAREHOUSE"
+	unknownStr   = "UNKNOWN"
 )
 
 func (s OrderStatus) String() string {
@@ -43,6 +58,8 @@ func (s OrderStatus) String() string {
 		return returnedStr
 	case Issued:
 		return issuedStr
+	case ReturnedToWarehouse:
+		return warehouseStr
 	default:
 		return unknownStr
 	}
@@ -90,3 +107,28 @@ func (p PackageType) String() string {
 		return packageUnknownStr
 	}
 }
+
+// Available handling flags in strings (not for manual use, only for String())
+const (
+	fragileStr       = "fragile"
+	hazardousStr     = "hazardous"
+	ageRestrictedStr = "age-restricted"
+	noHandlingStr    = "none"
+)
+
+func (f HandlingFlags) String() string {
+	var parts []string
+	if f.Fragile {
+		parts = append(parts, fragileStr)
+	}
+	if f.Hazardous {
+		parts = append(parts, hazardousStr)
+	}
+	if f.AgeRestricted {
+		parts = append(parts, ageRestrictedStr)
+	}
+	if len(parts) == 0 {
+		return noHandlingStr
+	}
+	return strings.Join(parts, ",")
+}
@@ -12,12 +12,22 @@ const (
 	OutboxStatusFailed     OutboxStatus = 4
 )
 
+// OutboxAggregate names the kind of entity an outbox event is about
+type OutboxAggregate string
+
+const (
+	OutboxAggregateOrder    OutboxAggregate = "order"
+	OutboxAggregateShipment OutboxAggregate = "shipment"
+)
+
 // ActorType represents a string-based designation for different types of actors in the system.
 type ActorType string
 
 const (
 	ActorCourier ActorType = "courier"
 	ActorClient  ActorType = "client"
+	// ActorOperator is a pickup-point operator acting on their own behalf, e.g. when undoing a mistake
+	ActorOperator ActorType = "operator"
 )
 
 func (s OutboxStatus) String() string {
@@ -37,8 +47,10 @@ func (s OutboxStatus) String() string {
 
 // OutboxEvent represents an event stored in the outbox table for eventual processing and delivery.
 type OutboxEvent struct {
-	EventID       uint64       `db:"id"`
-	OrderID       uint64       `db:"order_id"`
+	EventID       uint64          `db:"id"`
+	AggregateType OutboxAggregate `db:"aggregate_type"`
+	// AggregateID is the ID of the order or shipment the event is about
+	AggregateID   uint64       `db:"aggregate_id"`
 	Payload       string       `db:"payload"`
 	Status        OutboxStatus `db:"status"`
 	Error         string       `db:"error"`
@@ -55,6 +67,10 @@ type KafkaEvent struct {
 	Actor     Actor     `json:"actor"`
 	Order     Order     `json:"order"`
 	Source    string    `json:"source"`
+	// Operator identifies the pickup-point operator who performed the operation
+	Operator string `json:"operator,omitempty"`
+	// UndoneEvent names the compensated transition of an order_transition_undone event
+	UndoneEvent string `json:"undone_event,omitempty"`
 }
 
 // Actor represents an entity involved in an event, characterized by its type and ID.
@@ -74,6 +90,8 @@ func MapEventTypeToKafkaEvent(eventType EventType) string {
 		return "order_returned_by_client"
 	case EventReturnedToWarehouse:
 		return "order_returned_to_courier"
+	case EventUndone:
+		return "order_transition_undone"
 	default:
 		return "unknown"
 	}
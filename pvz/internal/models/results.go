@@ -1,7 +1,37 @@
 package models
 
+import "time"
+
 // BatchEntryProcessedResult represents the result of processing a batch entry, including the OrderID and any associated error.
 type BatchEntryProcessedResult struct {
 	OrderID uint64
 	Error   error
+	// TotalPrice is the computed price of a validated order; it is only set by validate-only imports
+	TotalPrice float32
+}
+
+// UndoResult describes a reverted transition: which event was undone and the order state after it.
+// Removed is set when undoing an acceptance took the order out of the pickup point.
+type UndoResult struct {
+	OrderID uint64
+	Undone  EventType
+	Status  OrderStatus
+	Removed bool
+}
+
+// IssueReceipt summarizes a grouped pickup: the issued orders, their total amount and the skipped orders with reasons.
+type IssueReceipt struct {
+	UserID  uint64
+	Issued  []Order
+	Skipped []BatchEntryProcessedResult
+	Total   float32
+}
+
+// OrderDetails is a single order together with its latest history entries and the deadline that currently applies to it.
+// StorageDeadline is set while the order waits for the client, ReturnDeadline while an issued order can still be returned.
+type OrderDetails struct {
+	Order           Order
+	History         []HistoryEntry
+	StorageDeadline *time.Time
+	ReturnDeadline  *time.Time
 }
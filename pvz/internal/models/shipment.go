@@ -0,0 +1,73 @@
+package models
+
+import "time"
+
+// Shipment represents an outbound parcel dropped off by a sender to be shipped to another pickup point
+type Shipment struct {
+	ShipmentID         uint64         `json:"shipment_id" db:"id"`
+	SenderID           uint64         `json:"sender_id" db:"sender_id"`
+	DestinationPointID uint64         `json:"destination_point_id" db:"destination_point_id"`
+	Status             ShipmentStatus `json:"status" db:"status"`
+	CourierID          uint64         `json:"courier_id,omitempty" db:"courier_id"`
+	CreatedAt          time.Time      `json:"created_at" db:"created_at"`
+	UpdatedStatusAt    time.Time      `json:"updated_status_at" db:"updated_status_at"`
+	Package            PackageType    `json:"package" db:"package"`
+	Weight             float32        `json:"weight" db:"weight"`
+	Price              float32        `json:"price" db:"price"`
+	HandlingFlags
+}
+
+// ShipmentStatus represents the current state of an outbound shipment
+type ShipmentStatus int32
+
+// Available shipment statuses throughout the drop-off lifecycle
+const (
+	ShipmentRegistered ShipmentStatus = 1
+	ShipmentHandedOver ShipmentStatus = 2
+)
+
+// Available shipment statuses in strings (not for manual use, only for String())
+const (
+	shipmentRegisteredStr = "REGISTERED"
+	shipmentHandedOverStr = "HANDED_OVER"
+)
+
+func (s ShipmentStatus) String() string {
+	switch s {
+	case ShipmentRegistered:
+		return shipmentRegisteredStr
+	case ShipmentHandedOver:
+		return shipmentHandedOverStr
+	default:
+		return unknownStr
+	}
+}
+
+// ShipmentHistoryEntry represents a single status change in shipment lifecycle history
+type ShipmentHistoryEntry struct {
+	ShipmentID uint64         `json:"shipment_id" db:"shipment_id"`
+	Status     ShipmentStatus `json:"status" db:"status"`
+	Timestamp  time.Time      `json:"timestamp" db:"timestamp"`
+}
+
+// ShipmentKafkaEvent is the outbox payload published on shipment status changes
+type ShipmentKafkaEvent struct {
+	EventID   uint64    `json:"event_id"`
+	EventType string    `json:"event_type"`
+	Timestamp time.Time `json:"timestamp"`
+	Actor     Actor     `json:"actor"`
+	Shipment  Shipment  `json:"shipment"`
+	Source    string    `json:"source"`
+}
+
+// MapShipmentStatusToKafkaEvent maps a ShipmentStatus to its corresponding Kafka event as a string representation.
+func MapShipmentStatusToKafkaEvent(status ShipmentStatus) string {
+	switch status {
+	case ShipmentRegistered:
+		return "shipment_registered"
+	case ShipmentHandedOver:
+		return "shipment_handed_over"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,35 @@
+package models
+
+// StoragePolicyManual is recorded on orders whose expiry date was supplied explicitly
+const StoragePolicyManual = "manual"
+
+// StoragePolicy describes how many days an order is kept at the pickup point when no expiry date is supplied
+type StoragePolicy struct {
+	Name string
+	Days int
+}
+
+// OrderCategory groups orders by their handling requirements for storage rules
+type OrderCategory string
+
+// Available order categories
+const (
+	CategoryStandard      OrderCategory = "standard"
+	CategoryFragile       OrderCategory = "fragile"
+	CategoryHazardous     OrderCategory = "hazardous"
+	CategoryAgeRestricted OrderCategory = "age-restricted"
+)
+
+// Category returns the most restrictive category implied by the handling flags
+func (f HandlingFlags) Category() OrderCategory {
+	switch {
+	case f.Hazardous:
+		return CategoryHazardous
+	case f.AgeRestricted:
+		return CategoryAgeRestricted
+	case f.Fragile:
+		return CategoryFragile
+	default:
+		return CategoryStandard
+	}
+}
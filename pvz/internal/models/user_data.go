@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AnonymousUserID replaces the client identifier in records of a user whose data was erased.
+const AnonymousUserID uint64 = 0
+
+// UserDataExport is the bundle of everything stored about a single client.
+type UserDataExport struct {
+	UserID         uint64                 `json:"user_id"`
+	ExportedAt     time.Time              `json:"exported_at"`
+	Orders         []Order                `json:"orders"`
+	ArchivedOrders []ArchivedOrder        `json:"archived_orders"`
+	History        []HistoryEntry         `json:"history"`
+	OutboxEvents   []UserDataOutboxRecord `json:"outbox_events"`
+	// Shipments are the parcels the client dropped off as a sender
+	Shipments       []Shipment             `json:"shipments"`
+	ShipmentHistory []ShipmentHistoryEntry `json:"shipment_history"`
+	// IdempotencyRecords are stored responses of retried calls that mention the client
+	IdempotencyRecords []IdempotencyRecord `json:"idempotency_records"`
+	ImportJobFailures  []ImportJobFailure  `json:"import_job_failures"`
+}
+
+// UserDataOutboxRecord is an outbox event of one of the client's orders or shipments with its raw payload.
+type UserDataOutboxRecord struct {
+	EventID       uint64          `json:"event_id" db:"id"`
+	AggregateType OutboxAggregate `json:"aggregate_type" db:"aggregate_type"`
+	AggregateID   uint64          `json:"aggregate_id" db:"aggregate_id"`
+	Status        OutboxStatus    `json:"status" db:"status"`
+	CreatedAt     time.Time       `json:"created_at" db:"created_at"`
+	Payload       json.RawMessage `json:"payload" db:"payload"`
+}
+
+// AnonymizeResult reports how many records were stripped of the client's identity.
+type AnonymizeResult struct {
+	UserID            uint64
+	Orders            int
+	ArchivedOrders    int
+	OutboxEvents      int
+	Shipments         int
+	ImportJobFailures int
+	// IdempotencyKeys are deleted rather than rewritten, so a retry with such a key runs the call again
+	IdempotencyKeys int
+}
@@ -21,8 +21,12 @@ func (f *DefaultFacadeHandler) HandleAcceptOrder(ctx context.Context, req reques
 	f.responsesCache.Invalidate(fmt.Sprintf("OrderHistory:%d", order.OrderID))
 	f.metrics.IncOrdersServed(1)
 	return responses.AcceptOrderResponse{
-		OrderID: order.OrderID,
-		Package: order.Package,
-		Price:   order.Price,
+		OrderID:       order.OrderID,
+		Package:       order.Package,
+		Price:         order.Price,
+		Handling:      order.HandlingFlags,
+		ExpiresAt:     order.ExpiresAt,
+		StoragePolicy: order.StoragePolicy,
+		Version:       order.Version,
 	}, nil
 }
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+	"pvz-cli/internal/usecases/services"
+)
+
+var _ FacadeHandler = (*AuditFacadeHandler)(nil)
+
+// AuditFacadeHandler records the mutating calls made through the wrapped facade in the audit log.
+// It wraps the facade of the CLI only: gRPC calls are recorded by the audit interceptor, with their gRPC method names.
+type AuditFacadeHandler struct {
+	FacadeHandler
+	audit  services.AuditService
+	source models.AuditSource
+}
+
+// NewAuditFacadeHandler creates a facade that records mutating calls to next as coming from source
+func NewAuditFacadeHandler(next FacadeHandler, audit services.AuditService, source models.AuditSource) *AuditFacadeHandler {
+	return &AuditFacadeHandler{FacadeHandler: next, audit: audit, source: source}
+}
+
+// HandleAcceptOrder accepts an order and records the call
+func (h *AuditFacadeHandler) HandleAcceptOrder(
+	ctx context.Context,
+	req requests.AcceptOrderRequest,
+) (responses.AcceptOrderResponse, error) {
+	resp, err := h.FacadeHandler.HandleAcceptOrder(ctx, req)
+	h.record(ctx, "AcceptOrder", req, err)
+	return resp, err
+}
+
+// HandleReturnOrder returns an order to the courier and records the call
+func (h *AuditFacadeHandler) HandleReturnOrder(
+	ctx context.Context,
+	req requests.ReturnOrderRequest,
+) (responses.ReturnOrderResponse, error) {
+	resp, err := h.FacadeHandler.HandleReturnOrder(ctx, req)
+	h.record(ctx, "ReturnOrder", req, err)
+	return resp, err
+}
+
+// HandleProcessOrders issues or accepts returns of orders and records the call
+func (h *AuditFacadeHandler) HandleProcessOrders(
+	ctx context.Context,
+	req requests.ProcessOrdersRequest,
+) (responses.ProcessOrdersResponse, error) {
+	resp, err := h.FacadeHandler.HandleProcessOrders(ctx, req)
+	h.record(ctx, "ProcessOrders", req, err)
+	return resp, err
+}
+
+// HandleIssueAllReady issues every ready order of a client and records the call
+func (h *AuditFacadeHandler) HandleIssueAllReady(
+	ctx context.Context,
+	req requests.IssueAllReadyRequest,
+) (responses.IssueReceiptResponse, error) {
+	resp, err := h.FacadeHandler.HandleIssueAllReady(ctx, req)
+	h.record(ctx, "IssueAllReady", req, err)
+	return resp, err
+}
+
+// HandleUndoLastOperation undoes the last transition of an order and records the call
+func (h *AuditFacadeHandler) HandleUndoLastOperation(
+	ctx context.Context,
+	req requests.UndoRequest,
+) (responses.UndoResponse, error) {
+	resp, err := h.FacadeHandler.HandleUndoLastOperation(ctx, req)
+	h.record(ctx, "UndoLastOperation", req, err)
+	return resp, err
+}
+
+// HandleImportOrders imports a batch of orders and records the call
+func (h *AuditFacadeHandler) HandleImportOrders(
+	ctx context.Context,
+	req requests.ImportOrdersRequest,
+) (responses.ImportOrdersResponse, error) {
+	resp, err := h.FacadeHandler.HandleImportOrders(ctx, req)
+	h.record(ctx, "ImportOrders", req, err)
+	return resp, err
+}
+
+// HandleImportOrdersStream imports orders chunk by chunk and records the call without its chunks
+func (h *AuditFacadeHandler) HandleImportOrdersStream(
+	ctx context.Context,
+	next func() (requests.ImportOrdersRequest, error),
+) (responses.ImportOrdersResponse, error) {
+	resp, err := h.FacadeHandler.HandleImportOrdersStream(ctx, next)
+	h.record(ctx, "ImportOrdersStream", nil, err)
+	return resp, err
+}
+
+// HandleSubmitImportJob queues a background import and records the call
+func (h *AuditFacadeHandler) HandleSubmitImportJob(
+	ctx context.Context,
+	req requests.ImportOrdersRequest,
+) (responses.ImportJobResponse, error) {
+	resp, err := h.FacadeHandler.HandleSubmitImportJob(ctx, req)
+	h.record(ctx, "SubmitImportJob", req, err)
+	return resp, err
+}
+
+// HandleCancelImportJob cancels a background import and records the call
+func (h *AuditFacadeHandler) HandleCancelImportJob(ctx context.Context, jobID uint64) (responses.ImportJobResponse, error) {
+	resp, err := h.FacadeHandler.HandleCancelImportJob(ctx, jobID)
+	h.record(ctx, "CancelImportJob", map[string]uint64{"job_id": jobID}, err)
+	return resp, err
+}
+
+// HandleRegisterShipment registers a shipment and records the call
+func (h *AuditFacadeHandler) HandleRegisterShipment(
+	ctx context.Context,
+	req requests.RegisterShipmentRequest,
+) (responses.ShipmentResponse, error) {
+	resp, err := h.FacadeHandler.HandleRegisterShipment(ctx, req)
+	h.record(ctx, "RegisterShipment", req, err)
+	return resp, err
+}
+
+// HandleHandOverShipment hands a shipment over to a courier and records the call
+func (h *AuditFacadeHandler) HandleHandOverShipment(
+	ctx context.Context,
+	req requests.HandOverShipmentRequest,
+) (responses.ShipmentResponse, error) {
+	resp, err := h.FacadeHandler.HandleHandOverShipment(ctx, req)
+	h.record(ctx, "HandOverShipment", req, err)
+	return resp, err
+}
+
+// HandleCheckConsistency checks and optionally repairs orders and records the call
+func (h *AuditFacadeHandler) HandleCheckConsistency(
+	ctx context.Context,
+	req requests.ConsistencyCheckRequest,
+) (responses.ConsistencyReportResponse, error) {
+	resp, err := h.FacadeHandler.HandleCheckConsistency(ctx, req)
+	h.record(ctx, "CheckConsistency", req, err)
+	return resp, err
+}
+
+func (h *AuditFacadeHandler) record(ctx context.Context, method string, req any, callErr error) {
+	var request []byte
+	if req != nil {
+		request, _ = json.Marshal(req)
+	}
+	err := h.audit.Record(context.WithoutCancel(ctx), models.AuditRecord{
+		Source:     h.source,
+		Method:     method,
+		Request:    request,
+		ResultCode: apperrors.CodeFromError(callErr),
+	})
+	if err != nil {
+		slog.Warn("failed to record audited call", "method", method, "error", err)
+	}
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/operator"
+	repomocks "pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/metrics"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/services"
+	svcmocks "pvz-cli/internal/usecases/services/mocks"
+	"pvz-cli/pkg/cache"
+	"pvz-cli/pkg/clock"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuditFacadeHandler verifies that mutating CLI calls are recorded with their application result code and that
+// read-only calls are not recorded.
+func TestAuditFacadeHandler(t *testing.T) {
+	t.Parallel()
+	ctx := operator.WithID(context.Background(), "cli-op")
+	orderSvc := svcmocks.NewOrderServiceMock(t)
+	orderSvc.AcceptOrderMock.Set(func(_ context.Context, req requests.AcceptOrderRequest) (models.Order, error) {
+		if req.OrderID == 2 {
+			return models.Order{}, apperrors.Newf(apperrors.OrderAlreadyExists, "order 2 already exists")
+		}
+		return models.Order{OrderID: req.OrderID, UserID: req.UserID}, nil
+	})
+	orderSvc.ListOrdersMock.Return(nil, 0, 0, nil)
+	m, _ := metrics.NewNoopHandlerMetrics()
+	next := NewDefaultFacadeHandler(orderSvc, nil, nil, nil, nil, nil, cache.NewNoopCache(), m, testPageTokens)
+
+	repo := repomocks.NewAuditRepositoryMock(t)
+	var recorded []models.AuditRecord
+	repo.SaveMock.Set(func(_ context.Context, rec models.AuditRecord) error {
+		recorded = append(recorded, rec)
+		return nil
+	})
+	h := NewAuditFacadeHandler(next, services.NewDefaultAuditService(&clock.FakeClock{}, repo), models.AuditSourceCLI)
+
+	_, err := h.HandleAcceptOrder(ctx, requests.AcceptOrderRequest{OrderID: 1, UserID: 42})
+	require.NoError(t, err)
+	_, err = h.HandleAcceptOrder(ctx, requests.AcceptOrderRequest{OrderID: 2, UserID: 42})
+	require.Equal(t, string(apperrors.OrderAlreadyExists), apperrors.CodeFromError(err))
+	_, err = h.HandleListOrders(ctx, requests.OrdersFilterRequest{})
+	require.NoError(t, err)
+
+	require.Len(t, recorded, 2)
+	for i, code := range []string{"OK", string(apperrors.OrderAlreadyExists)} {
+		require.Equal(t, models.AuditSourceCLI, recorded[i].Source)
+		require.Equal(t, "AcceptOrder", recorded[i].Method)
+		require.Equal(t, "cli-op", recorded[i].OperatorID)
+		require.Equal(t, code, recorded[i].ResultCode)
+		require.Contains(t, string(recorded[i].Request), `"UserID":"[REDACTED]"`)
+	}
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// HandleCheckConsistency checks stored orders against their history and outbox events, repairing them on request
+func (f *DefaultFacadeHandler) HandleCheckConsistency(
+	ctx context.Context,
+	req requests.ConsistencyCheckRequest,
+) (responses.ConsistencyReportResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ConsistencyReportResponse{}, ctx.Err()
+	}
+
+	report, err := f.consistency.Check(ctx, req.Repair)
+	if err != nil {
+		return responses.ConsistencyReportResponse{}, err
+	}
+
+	// repairs change stored orders only; history is never rewritten, so cached history stays valid
+	if report.Repaired > 0 {
+		f.responsesCache.InvalidatePattern("^ListOrders:")
+	}
+	return responses.ConsistencyReportResponse{
+		CheckedOrders: report.CheckedOrders,
+		Issues:        report.Issues,
+		Repaired:      report.Repaired,
+	}, nil
+}
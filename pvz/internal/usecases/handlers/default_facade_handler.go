@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"pvz-cli/internal/common/cursor"
 	"pvz-cli/internal/metrics"
 	"pvz-cli/internal/usecases/services"
 	"pvz-cli/pkg/cache"
@@ -10,23 +11,38 @@ var _ FacadeHandler = (*DefaultFacadeHandler)(nil)
 
 // DefaultFacadeHandler is the default implementation of the FacadeHandler interface.
 type DefaultFacadeHandler struct {
-	orderService   services.OrderService
-	historyService services.HistoryService
-	responsesCache cache.Cache[string, any]
-	metrics        metrics.HandlerMetrics
+	orderService    services.OrderService
+	historyService  services.HistoryService
+	shipmentService services.ShipmentService
+	exportService   services.ExportService
+	importJobs      services.ImportJobService
+	consistency     services.ConsistencyService
+	responsesCache  cache.Cache[string, any]
+	metrics         metrics.HandlerMetrics
+	pageTokens      cursor.Codec
 }
 
 // NewDefaultFacadeHandler constructs a new DefaultFacadeHandler with the provided services.
 func NewDefaultFacadeHandler(
 	orderSvc services.OrderService,
 	historySvc services.HistoryService,
+	shipmentSvc services.ShipmentService,
+	exportSvc services.ExportService,
+	importJobSvc services.ImportJobService,
+	consistencySvc services.ConsistencyService,
 	responsesCache cache.Cache[string, any],
 	metrics metrics.HandlerMetrics,
+	pageTokens cursor.Codec,
 ) *DefaultFacadeHandler {
 	return &DefaultFacadeHandler{
-		orderService:   orderSvc,
-		historyService: historySvc,
-		responsesCache: responsesCache,
-		metrics:        metrics,
+		orderService:    orderSvc,
+		historyService:  historySvc,
+		shipmentService: shipmentSvc,
+		exportService:   exportSvc,
+		importJobs:      importJobSvc,
+		consistency:     consistencySvc,
+		responsesCache:  responsesCache,
+		metrics:         metrics,
+		pageTokens:      pageTokens,
 	}
 }
@@ -3,10 +3,15 @@ package handlers
 import (
 	"context"
 	"errors"
+	"io"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/cursor"
+	"pvz-cli/internal/common/utils"
 	"pvz-cli/internal/metrics"
 	svcmocks "pvz-cli/internal/usecases/services/mocks"
 	"pvz-cli/pkg/cache"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"pvz-cli/internal/models"
@@ -14,7 +19,10 @@ import (
 	"pvz-cli/internal/usecases/responses"
 )
 
-var errListFail = errors.New("fail")
+var (
+	errListFail    = errors.New("fail")
+	testPageTokens = cursor.NewHMACCodec([]byte("test"))
+)
 
 // TestDefaultFacadeHandler_HandleAcceptOrder_ContextCanceled tests HandleAcceptOrder with a canceled context, expecting context.Canceled error.
 func TestDefaultFacadeHandler_HandleAcceptOrder_ContextCanceled(t *testing.T) {
@@ -24,7 +32,7 @@ func TestDefaultFacadeHandler_HandleAcceptOrder_ContextCanceled(t *testing.T) {
 	svc := svcmocks.NewOrderServiceMock(t)
 	c := cache.NewNoopCache()
 	m, _ := metrics.NewNoopHandlerMetrics()
-	h := NewDefaultFacadeHandler(svc, nil, c, m)
+	h := NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 	_, err := h.HandleAcceptOrder(ctx, requests.AcceptOrderRequest{OrderID: 5})
 	require.ErrorIs(t, err, context.Canceled)
 }
@@ -51,7 +59,7 @@ func TestDefaultFacadeHandler_HandleListOrders(t *testing.T) {
 				svc := svcmocks.NewOrderServiceMock(t)
 				c := cache.NewNoopCache()
 				m, _ := metrics.NewNoopHandlerMetrics()
-				return NewDefaultFacadeHandler(svc, nil, c, m)
+				return NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 			},
 			expectErr: context.Canceled,
 		},
@@ -63,7 +71,7 @@ func TestDefaultFacadeHandler_HandleListOrders(t *testing.T) {
 				svc.ListOrdersMock.Expect(ctx, requests.OrdersFilterRequest{}).Return(nil, 0, 0, errListFail)
 				c := cache.NewNoopCache()
 				m, _ := metrics.NewNoopHandlerMetrics()
-				return NewDefaultFacadeHandler(svc, nil, c, m)
+				return NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 			},
 			expectErr: errListFail,
 		},
@@ -76,7 +84,7 @@ func TestDefaultFacadeHandler_HandleListOrders(t *testing.T) {
 					Return([]models.Order{{OrderID: 10}}, 10, 1, nil)
 				c := cache.NewNoopCache()
 				m, _ := metrics.NewNoopHandlerMetrics()
-				return NewDefaultFacadeHandler(svc, nil, c, m)
+				return NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 			},
 			wantResp: responses.ListOrdersResponse{
 				Orders: []models.Order{{OrderID: 10}},
@@ -124,7 +132,7 @@ func TestDefaultFacadeHandler_HandleOrderHistory(t *testing.T) {
 				svc := svcmocks.NewOrderServiceMock(t)
 				c := cache.NewNoopCache()
 				m, _ := metrics.NewNoopHandlerMetrics()
-				return NewDefaultFacadeHandler(svc, nil, c, m)
+				return NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 			},
 			expectErr: context.Canceled,
 		},
@@ -136,7 +144,7 @@ func TestDefaultFacadeHandler_HandleOrderHistory(t *testing.T) {
 				hsvc.ListMock.Expect(ctx, requests.OrderHistoryFilter{}).Return(nil, errListFail)
 				c := cache.NewNoopCache()
 				m, _ := metrics.NewNoopHandlerMetrics()
-				return NewDefaultFacadeHandler(nil, hsvc, c, m)
+				return NewDefaultFacadeHandler(nil, hsvc, nil, nil, nil, nil, c, m, testPageTokens)
 			},
 			expectErr: errListFail,
 		},
@@ -148,7 +156,7 @@ func TestDefaultFacadeHandler_HandleOrderHistory(t *testing.T) {
 				hsvc.ListMock.Expect(ctx, requests.OrderHistoryFilter{}).Return([]models.HistoryEntry{{OrderID: 5}}, nil)
 				c := cache.NewNoopCache()
 				m, _ := metrics.NewNoopHandlerMetrics()
-				return NewDefaultFacadeHandler(nil, hsvc, c, m)
+				return NewDefaultFacadeHandler(nil, hsvc, nil, nil, nil, nil, c, m, testPageTokens)
 			},
 			wantResp: responses.OrderHistoryResponse{History: []models.HistoryEntry{{OrderID: 5}}},
 		},
@@ -195,7 +203,7 @@ func TestDefaultFacadeHandler_HandleReturnOrder(t *testing.T) {
 				svc := svcmocks.NewOrderServiceMock(t)
 				c := cache.NewNoopCache()
 				m, _ := metrics.NewNoopHandlerMetrics()
-				return NewDefaultFacadeHandler(svc, nil, c, m)
+				return NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 			},
 			expectErr: context.Canceled,
 		},
@@ -208,7 +216,7 @@ func TestDefaultFacadeHandler_HandleReturnOrder(t *testing.T) {
 				svc.ReturnToCourierMock.Expect(ctx, requests.ReturnOrderRequest{OrderID: 8}).Return(errListFail)
 				c := cache.NewNoopCache()
 				m, _ := metrics.NewNoopHandlerMetrics()
-				return NewDefaultFacadeHandler(svc, nil, c, m)
+				return NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 			},
 			expectErr: errListFail,
 		},
@@ -221,7 +229,7 @@ func TestDefaultFacadeHandler_HandleReturnOrder(t *testing.T) {
 				svc.ReturnToCourierMock.Expect(ctx, requests.ReturnOrderRequest{OrderID: 8}).Return(nil)
 				c := cache.NewNoopCache()
 				m, _ := metrics.NewNoopHandlerMetrics()
-				return NewDefaultFacadeHandler(svc, nil, c, m)
+				return NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 			},
 			wantResp: responses.ReturnOrderResponse{OrderID: 8},
 		},
@@ -263,7 +271,7 @@ func TestDefaultFacadeHandler_HandleImportOrders(t *testing.T) {
 		}, nil)
 	c := cache.NewNoopCache()
 	m, _ := metrics.NewNoopHandlerMetrics()
-	h := NewDefaultFacadeHandler(svc, nil, c, m)
+	h := NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 	resp, err := h.HandleImportOrders(ctx, requests.ImportOrdersRequest{Statuses: statuses})
 	require.NoError(t, err)
 	require.Equal(t, 1, resp.Imported)
@@ -272,6 +280,135 @@ func TestDefaultFacadeHandler_HandleImportOrders(t *testing.T) {
 	require.EqualError(t, resp.Statuses[2].Error, "already invalid")
 }
 
+// TestDefaultFacadeHandler_HandleImportOrders_ValidateOnly verifies that a dry run reports valid orders with their prices instead of importing them.
+func TestDefaultFacadeHandler_HandleImportOrders_ValidateOnly(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	req := requests.ImportOrdersRequest{
+		ValidateOnly: true,
+		Statuses: []requests.ImportOrderStatus{
+			{ItemNumber: 1, OrderID: 1, Request: &requests.AcceptOrderRequest{OrderID: 1}},
+			{ItemNumber: 2, OrderID: 2, Request: &requests.AcceptOrderRequest{OrderID: 2}},
+		},
+	}
+	svc := svcmocks.NewOrderServiceMock(t)
+	defer svc.MinimockFinish()
+	svc.ImportOrdersMock.Expect(ctx, req).Return([]models.BatchEntryProcessedResult{
+		{OrderID: 1, TotalPrice: 120},
+		{OrderID: 2, Error: errors.New("too heavy")},
+	}, nil)
+	m, _ := metrics.NewNoopHandlerMetrics()
+	h := NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, cache.NewNoopCache(), m, testPageTokens)
+
+	resp, err := h.HandleImportOrders(ctx, req)
+	require.NoError(t, err)
+	require.True(t, resp.ValidateOnly)
+	require.Zero(t, resp.Imported)
+	require.Equal(t, 1, resp.Valid)
+	require.Equal(t, float32(120), resp.Statuses[0].TotalPrice)
+	require.EqualError(t, resp.Statuses[1].Error, "too heavy")
+}
+
+// TestDefaultFacadeHandler_HandleImportOrdersStream verifies that streamed chunks are imported one by one and aggregated with items numbered across the stream.
+func TestDefaultFacadeHandler_HandleImportOrdersStream(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	chunk := func(validateOnly bool, ids ...uint64) requests.ImportOrdersRequest {
+		statuses := make([]requests.ImportOrderStatus, len(ids))
+		for i, id := range ids {
+			statuses[i] = requests.ImportOrderStatus{ItemNumber: i + 1, OrderID: id, Request: &requests.AcceptOrderRequest{OrderID: id}}
+		}
+		return requests.ImportOrdersRequest{Statuses: statuses, ValidateOnly: validateOnly}
+	}
+	stream := func(chunks ...requests.ImportOrdersRequest) func() (requests.ImportOrdersRequest, error) {
+		return func() (requests.ImportOrdersRequest, error) {
+			if len(chunks) == 0 {
+				return requests.ImportOrdersRequest{}, io.EOF
+			}
+			c := chunks[0]
+			chunks = chunks[1:]
+			return c, nil
+		}
+	}
+	m, _ := metrics.NewNoopHandlerMetrics()
+
+	t.Run("chunks are aggregated", func(t *testing.T) {
+		t.Parallel()
+		svc := svcmocks.NewOrderServiceMock(t)
+		svc.ImportOrdersMock.Set(func(_ context.Context, req requests.ImportOrdersRequest) ([]models.BatchEntryProcessedResult, error) {
+			results := make([]models.BatchEntryProcessedResult, len(req.Statuses))
+			for i, st := range req.Statuses {
+				results[i].OrderID = st.OrderID
+				if st.OrderID == 3 {
+					results[i].Error = errors.New("fail3")
+				}
+			}
+			return results, nil
+		})
+		h := NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, cache.NewNoopCache(), m, testPageTokens)
+
+		resp, err := h.HandleImportOrdersStream(ctx, stream(chunk(false, 1, 2), chunk(false, 3, 4)))
+		require.NoError(t, err)
+		require.Equal(t, 3, resp.Imported)
+		require.Len(t, resp.Statuses, 1)
+		require.Equal(t, 3, resp.Statuses[0].ItemNumber)
+		require.EqualError(t, resp.Statuses[0].Error, "fail3")
+		require.Len(t, svc.ImportOrdersMock.Calls(), 2)
+	})
+
+	t.Run("orders repeated across chunks are rejected", func(t *testing.T) {
+		t.Parallel()
+		svc := svcmocks.NewOrderServiceMock(t)
+		var passed []uint64
+		svc.ImportOrdersMock.Set(func(_ context.Context, req requests.ImportOrdersRequest) ([]models.BatchEntryProcessedResult, error) {
+			results := make([]models.BatchEntryProcessedResult, len(req.Statuses))
+			for i, st := range req.Statuses {
+				results[i] = models.BatchEntryProcessedResult{OrderID: st.OrderID, Error: st.Error}
+				if st.Error == nil {
+					passed = append(passed, st.OrderID)
+				}
+			}
+			return results, nil
+		})
+		h := NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, cache.NewNoopCache(), m, testPageTokens)
+
+		failed := chunk(false, 3)
+		failed.Statuses[0].Error = errors.New("invalid weight")
+		resp, err := h.HandleImportOrdersStream(ctx, stream(chunk(false, 1, 2), chunk(false, 2, 3, 1), failed))
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2, 3}, passed)
+		require.Equal(t, 3, resp.Imported)
+		require.Len(t, resp.Statuses, 3)
+		for i, want := range []string{
+			"INVALID_BATCH_ENTRY: order #3: order 2 is already listed as order #2",
+			"INVALID_BATCH_ENTRY: order #5: order 1 is already listed as order #1",
+			"invalid weight",
+		} {
+			require.EqualError(t, resp.Statuses[i].Error, want)
+			require.Nil(t, resp.Statuses[i].Request)
+		}
+		require.Equal(t, string(apperrors.InvalidBatchEntry), apperrors.CodeFromError(resp.Statuses[0].Error))
+	})
+
+	t.Run("validate_only must not change", func(t *testing.T) {
+		t.Parallel()
+		svc := svcmocks.NewOrderServiceMock(t)
+		svc.ImportOrdersMock.Return([]models.BatchEntryProcessedResult{{OrderID: 1}}, nil)
+		h := NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, cache.NewNoopCache(), m, testPageTokens)
+
+		_, err := h.HandleImportOrdersStream(ctx, stream(chunk(true, 1), chunk(false, 2)))
+		require.Equal(t, string(apperrors.ValidationFailed), apperrors.CodeFromError(err))
+	})
+
+	t.Run("empty stream", func(t *testing.T) {
+		t.Parallel()
+		h := NewDefaultFacadeHandler(svcmocks.NewOrderServiceMock(t), nil, nil, nil, nil, nil, cache.NewNoopCache(), m, testPageTokens)
+
+		_, err := h.HandleImportOrdersStream(ctx, stream())
+		require.Equal(t, string(apperrors.ValidationFailed), apperrors.CodeFromError(err))
+	})
+}
+
 // TestDefaultFacadeHandler_HandleProcessOrders tests the HandleProcessOrders function ensuring actions like issue and return work correctly.
 func TestDefaultFacadeHandler_HandleProcessOrders(t *testing.T) {
 	t.Parallel()
@@ -286,7 +423,7 @@ func TestDefaultFacadeHandler_HandleProcessOrders(t *testing.T) {
 			Return([]models.BatchEntryProcessedResult{{OrderID: 11}}, nil)
 		c := cache.NewNoopCache()
 		m, _ := metrics.NewNoopHandlerMetrics()
-		h := NewDefaultFacadeHandler(svc, nil, c, m)
+		h := NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
 		resp1, err1 := h.HandleProcessOrders(ctx, requests.ProcessOrdersRequest{
 			UserID:   1,
 			OrderIDs: []uint64{10},
@@ -303,3 +440,110 @@ func TestDefaultFacadeHandler_HandleProcessOrders(t *testing.T) {
 		require.ElementsMatch(t, []uint64{11}, resp2.Processed)
 	})
 }
+
+// TestDefaultFacadeHandler_HandleIssueAllReady tests that the grouped pickup receipt is passed through with skipped reasons.
+func TestDefaultFacadeHandler_HandleIssueAllReady(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	req := requests.IssueAllReadyRequest{UserID: 7}
+	skipErr := errors.New("expired")
+	svc := svcmocks.NewOrderServiceMock(t)
+	svc.IssueAllReadyMock.Expect(ctx, req).Return(models.IssueReceipt{
+		UserID:  7,
+		Issued:  []models.Order{{OrderID: 1, Price: 10}, {OrderID: 2, Price: 15}},
+		Skipped: []models.BatchEntryProcessedResult{{OrderID: 3, Error: skipErr}},
+		Total:   25,
+	}, nil)
+	c := cache.NewNoopCache()
+	m, _ := metrics.NewNoopHandlerMetrics()
+	h := NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
+
+	resp, err := h.HandleIssueAllReady(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), resp.UserID)
+	require.Len(t, resp.Issued, 2)
+	require.Equal(t, float32(25), resp.Total)
+	require.Equal(t, []responses.ProcessFailReport{{OrderID: 3, Error: skipErr}}, resp.Skipped)
+}
+
+// TestDefaultFacadeHandler_OrdersPageTokens verifies that a full page yields a token that continues after its last order.
+func TestDefaultFacadeHandler_OrdersPageTokens(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	last := models.Order{OrderID: 7, Price: 150}
+	svc := svcmocks.NewOrderServiceMock(t)
+	svc.ListOrdersMock.Set(func(_ context.Context, f requests.OrdersFilterRequest) ([]models.Order, uint64, int, error) {
+		if f.After == nil {
+			return []models.Order{{OrderID: 3, Price: 100}, last}, last.OrderID, 3, nil
+		}
+		require.Equal(t, requests.OrderCursor{SortBy: requests.SortByPrice, Num: 150, ID: 7}, *f.After)
+		return []models.Order{{OrderID: 9, Price: 200}}, 9, 3, nil
+	})
+	c := cache.NewNoopCache()
+	m, _ := metrics.NewNoopHandlerMetrics()
+	h := NewDefaultFacadeHandler(svc, nil, nil, nil, nil, nil, c, m, testPageTokens)
+	req := requests.NewOrdersFilter(requests.WithLimit(2), requests.WithSort(requests.SortByPrice, false))
+
+	first, err := h.HandleSearchOrders(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, first.NextPageToken)
+
+	req.PageToken = first.NextPageToken
+	second, err := h.HandleSearchOrders(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, second.Orders, 1)
+	require.Empty(t, second.NextPageToken)
+
+	t.Run("token of another sort order is rejected", func(t *testing.T) {
+		t.Parallel()
+		other := req
+		other.SortDesc = true
+		_, err := h.HandleSearchOrders(ctx, other)
+		var ae *apperrors.AppError
+		require.ErrorAs(t, err, &ae)
+		require.Equal(t, apperrors.ValidationFailed, ae.Code)
+	})
+
+	t.Run("tampered token is rejected", func(t *testing.T) {
+		t.Parallel()
+		tampered := req
+		tampered.PageToken = "x" + req.PageToken
+		_, err := h.HandleSearchOrders(ctx, tampered)
+		var ae *apperrors.AppError
+		require.ErrorAs(t, err, &ae)
+		require.Equal(t, apperrors.ValidationFailed, ae.Code)
+	})
+}
+
+// TestDefaultFacadeHandler_HistoryPageTokens verifies that history tokens keep the listing direction.
+func TestDefaultFacadeHandler_HistoryPageTokens(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	at := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+	hsvc := svcmocks.NewHistoryServiceMock(t)
+	hsvc.ListMock.Set(func(_ context.Context, f requests.OrderHistoryFilter) ([]models.HistoryEntry, error) {
+		if f.After == nil {
+			return []models.HistoryEntry{{ID: 1, Timestamp: at}}, nil
+		}
+		require.Equal(t, requests.HistoryCursor{At: at, ID: 1, Asc: true}, *f.After)
+		return []models.HistoryEntry{}, nil
+	})
+	m, _ := metrics.NewNoopHandlerMetrics()
+	h := NewDefaultFacadeHandler(nil, hsvc, nil, nil, nil, nil, cache.NewNoopCache(), m, testPageTokens)
+	req := requests.OrderHistoryFilter{UserID: utils.Ptr(uint64(5)), Asc: true, Page: 1, Limit: 1}
+
+	first, err := h.HandleOrderHistory(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, first.NextPageToken)
+
+	req.PageToken = first.NextPageToken
+	second, err := h.HandleOrderHistory(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, second.NextPageToken)
+
+	req.Asc = false
+	_, err = h.HandleOrderHistory(ctx, req)
+	var ae *apperrors.AppError
+	require.ErrorAs(t, err, &ae)
+	require.Equal(t, apperrors.ValidationFailed, ae.Code)
+}
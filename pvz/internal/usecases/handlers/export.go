@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// HandleExport streams the requested dataset to w; exports bypass the response cache
+func (f *DefaultFacadeHandler) HandleExport(ctx context.Context, req requests.ExportRequest, w io.Writer) (responses.ExportResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ExportResponse{}, ctx.Err()
+	}
+	rows, err := f.exportService.Export(ctx, req, w)
+	if err != nil {
+		return responses.ExportResponse{Rows: rows}, err
+	}
+	return responses.ExportResponse{Rows: rows}, nil
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"io"
 	"pvz-cli/internal/usecases/requests"
 	"pvz-cli/internal/usecases/responses"
 )
@@ -11,7 +12,25 @@ type FacadeHandler interface {
 	HandleAcceptOrder(ctx context.Context, req requests.AcceptOrderRequest) (responses.AcceptOrderResponse, error)
 	HandleReturnOrder(ctx context.Context, req requests.ReturnOrderRequest) (responses.ReturnOrderResponse, error)
 	HandleProcessOrders(ctx context.Context, req requests.ProcessOrdersRequest) (responses.ProcessOrdersResponse, error)
+	HandleIssueAllReady(ctx context.Context, req requests.IssueAllReadyRequest) (responses.IssueReceiptResponse, error)
+	HandleUndoLastOperation(ctx context.Context, req requests.UndoRequest) (responses.UndoResponse, error)
 	HandleListOrders(ctx context.Context, req requests.OrdersFilterRequest) (responses.ListOrdersResponse, error)
+	HandleSearchOrders(ctx context.Context, req requests.OrdersFilterRequest) (responses.ListOrdersResponse, error)
+	HandleGetOrder(ctx context.Context, orderID uint64) (responses.OrderDetailsResponse, error)
 	HandleOrderHistory(ctx context.Context, req requests.OrderHistoryFilter) (responses.OrderHistoryResponse, error)
 	HandleImportOrders(ctx context.Context, req requests.ImportOrdersRequest) (responses.ImportOrdersResponse, error)
+	HandleImportOrdersStream(
+		ctx context.Context,
+		next func() (requests.ImportOrdersRequest, error),
+	) (responses.ImportOrdersResponse, error)
+	HandleSubmitImportJob(ctx context.Context, req requests.ImportOrdersRequest) (responses.ImportJobResponse, error)
+	HandleGetImportJob(ctx context.Context, jobID uint64) (responses.ImportJobResponse, error)
+	HandleWatchImportJob(ctx context.Context, jobID uint64, fn func(responses.ImportJobResponse) error) error
+	HandleListImportJobFailures(ctx context.Context, jobID uint64) (responses.ImportJobFailuresResponse, error)
+	HandleCancelImportJob(ctx context.Context, jobID uint64) (responses.ImportJobResponse, error)
+	HandleRegisterShipment(ctx context.Context, req requests.RegisterShipmentRequest) (responses.ShipmentResponse, error)
+	HandleHandOverShipment(ctx context.Context, req requests.HandOverShipmentRequest) (responses.ShipmentResponse, error)
+	HandleGetShipment(ctx context.Context, shipmentID uint64) (responses.ShipmentResponse, error)
+	HandleExport(ctx context.Context, req requests.ExportRequest, w io.Writer) (responses.ExportResponse, error)
+	HandleCheckConsistency(ctx context.Context, req requests.ConsistencyCheckRequest) (responses.ConsistencyReportResponse, error)
 }
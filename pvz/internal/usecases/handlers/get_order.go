@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// HandleGetOrder returns a single order together with its latest history and current deadlines
+func (f *DefaultFacadeHandler) HandleGetOrder(ctx context.Context, orderID uint64) (responses.OrderDetailsResponse, error) {
+	if ctx.Err() != nil {
+		return responses.OrderDetailsResponse{}, ctx.Err()
+	}
+	details, err := f.orderService.GetOrder(ctx, orderID)
+	if err != nil {
+		return responses.OrderDetailsResponse{}, err
+	}
+	return responses.OrderDetailsResponse{
+		Order:           details.Order,
+		History:         details.History,
+		StorageDeadline: details.StorageDeadline,
+		ReturnDeadline:  details.ReturnDeadline,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+	"pvz-cli/pkg/cache"
+)
+
+// InvalidateImportedOrders returns a callback dropping the cached responses made stale by orders
+// imported in the background, the same ones HandleImportOrders drops for a synchronous import.
+func InvalidateImportedOrders(responsesCache cache.Cache[string, any]) func(orderIDs []uint64) {
+	return func(orderIDs []uint64) {
+		for _, id := range orderIDs {
+			responsesCache.Invalidate(fmt.Sprintf("OrderHistory:%d", id))
+		}
+		if len(orderIDs) > 0 {
+			responsesCache.InvalidatePattern("^ListOrders:")
+		}
+	}
+}
+
+// HandleSubmitImportJob queues the import to run in the background and returns the job right away
+func (f *DefaultFacadeHandler) HandleSubmitImportJob(
+	ctx context.Context,
+	req requests.ImportOrdersRequest,
+) (responses.ImportJobResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ImportJobResponse{}, ctx.Err()
+	}
+	job, err := f.importJobs.Submit(ctx, req)
+	if err != nil {
+		return responses.ImportJobResponse{}, err
+	}
+	return responses.ImportJobResponse{Job: job}, nil
+}
+
+// HandleGetImportJob returns the status and progress of an import job
+func (f *DefaultFacadeHandler) HandleGetImportJob(ctx context.Context, jobID uint64) (responses.ImportJobResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ImportJobResponse{}, ctx.Err()
+	}
+	job, err := f.importJobs.Get(ctx, jobID)
+	if err != nil {
+		return responses.ImportJobResponse{}, err
+	}
+	return responses.ImportJobResponse{Job: job}, nil
+}
+
+// HandleWatchImportJob reports the progress of an import job to fn until the job finishes
+func (f *DefaultFacadeHandler) HandleWatchImportJob(
+	ctx context.Context,
+	jobID uint64,
+	fn func(responses.ImportJobResponse) error,
+) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return f.importJobs.Watch(ctx, jobID, func(job models.ImportJob) error {
+		return fn(responses.ImportJobResponse{Job: job})
+	})
+}
+
+// HandleListImportJobFailures returns the orders an import job failed to import
+func (f *DefaultFacadeHandler) HandleListImportJobFailures(
+	ctx context.Context,
+	jobID uint64,
+) (responses.ImportJobFailuresResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ImportJobFailuresResponse{}, ctx.Err()
+	}
+	failures, err := f.importJobs.ListFailures(ctx, jobID)
+	if err != nil {
+		return responses.ImportJobFailuresResponse{}, err
+	}
+	return responses.ImportJobFailuresResponse{JobID: jobID, Failures: failures}, nil
+}
+
+// HandleCancelImportJob stops a queued or running import job
+func (f *DefaultFacadeHandler) HandleCancelImportJob(ctx context.Context, jobID uint64) (responses.ImportJobResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ImportJobResponse{}, ctx.Err()
+	}
+	job, err := f.importJobs.Cancel(ctx, jobID)
+	if err != nil {
+		return responses.ImportJobResponse{}, err
+	}
+	return responses.ImportJobResponse{Job: job}, nil
+}
@@ -2,13 +2,17 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"pvz-cli/internal/common/apperrors"
 	"pvz-cli/internal/usecases/requests"
 	"pvz-cli/internal/usecases/responses"
 )
 
 // HandleImportOrders executes the import-orders command by invoking HandleAcceptOrder for each parsed order.
 // It returns an ImportOrdersResponse containing the count of successfully imported orders and a map of failures.
+// A validate-only import stores nothing and reports the count of valid orders with their computed prices instead.
 func (f *DefaultFacadeHandler) HandleImportOrders(
 	ctx context.Context,
 	req requests.ImportOrdersRequest,
@@ -22,6 +26,21 @@ func (f *DefaultFacadeHandler) HandleImportOrders(
 	}
 	statuses := make([]requests.ImportOrderStatus, len(req.Statuses))
 	copy(statuses, req.Statuses)
+	if req.ValidateOnly {
+		valid := 0
+		for i, r := range batchResults {
+			statuses[i].Error = r.Error
+			statuses[i].TotalPrice = r.TotalPrice
+			if r.Error == nil {
+				valid++
+			}
+		}
+		return responses.ImportOrdersResponse{
+			Valid:        valid,
+			Statuses:     statuses,
+			ValidateOnly: true,
+		}, nil
+	}
 	importedCount := 0
 	for i, r := range batchResults {
 		statuses[i].Error = r.Error
@@ -39,3 +58,76 @@ func (f *DefaultFacadeHandler) HandleImportOrders(
 		Statuses: statuses,
 	}, nil
 }
+
+// HandleImportOrdersStream imports the chunks returned by next one after another until next returns io.EOF.
+// Items are renumbered to their position in the whole stream, and an order whose ID already appeared earlier
+// in the stream is failed as repeated, as the CLI does for an import file. The aggregated response keeps the
+// failed orders only, or every order for a validate-only import, so memory does not grow with the imported ones.
+func (f *DefaultFacadeHandler) HandleImportOrdersStream(
+	ctx context.Context,
+	next func() (requests.ImportOrdersRequest, error),
+) (responses.ImportOrdersResponse, error) {
+	var (
+		total  responses.ImportOrdersResponse
+		chunks int
+		items  int
+		// firstSeen maps the ID of every order accepted for import so far to its item number
+		firstSeen = make(map[uint64]int)
+	)
+	for {
+		if err := ctx.Err(); err != nil {
+			return responses.ImportOrdersResponse{}, err
+		}
+		chunk, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return responses.ImportOrdersResponse{}, err
+		}
+		if chunks == 0 {
+			total.ValidateOnly = chunk.ValidateOnly
+		} else if chunk.ValidateOnly != total.ValidateOnly {
+			return responses.ImportOrdersResponse{}, apperrors.Newf(apperrors.ValidationFailed,
+				"validate_only of chunk %d differs from the first chunk", chunks+1)
+		}
+		chunks++
+
+		for i := range chunk.Statuses {
+			chunk.Statuses[i].ItemNumber += items
+			chunk.Statuses[i] = checkRepeated(chunk.Statuses[i], firstSeen)
+		}
+		items += len(chunk.Statuses)
+		resp, err := f.HandleImportOrders(ctx, chunk)
+		if err != nil {
+			return responses.ImportOrdersResponse{}, err
+		}
+		total.Imported += resp.Imported
+		total.Valid += resp.Valid
+		for _, st := range resp.Statuses {
+			if st.Error == nil && !total.ValidateOnly {
+				continue
+			}
+			st.Request = nil
+			total.Statuses = append(total.Statuses, st)
+		}
+	}
+	if chunks == 0 {
+		return responses.ImportOrdersResponse{}, apperrors.Newf(apperrors.ValidationFailed, "import stream contains no orders")
+	}
+	return total, nil
+}
+
+// checkRepeated fails an order whose ID already appeared earlier in the stream
+func checkRepeated(st requests.ImportOrderStatus, firstSeen map[uint64]int) requests.ImportOrderStatus {
+	if st.Error != nil {
+		return st
+	}
+	if first, ok := firstSeen[st.OrderID]; ok {
+		st.Request = nil
+		st.Error = apperrors.Newf(apperrors.InvalidBatchEntry, "order #%d: order %d is already listed as order #%d", st.ItemNumber, st.OrderID, first)
+		return st
+	}
+	firstSeen[st.OrderID] = st.ItemNumber
+	return st
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// HandleIssueAllReady issues every ready order of the client and builds the consolidated receipt
+func (f *DefaultFacadeHandler) HandleIssueAllReady(
+	ctx context.Context,
+	req requests.IssueAllReadyRequest,
+) (responses.IssueReceiptResponse, error) {
+	if ctx.Err() != nil {
+		return responses.IssueReceiptResponse{}, ctx.Err()
+	}
+
+	receipt, err := f.orderService.IssueAllReady(ctx, req)
+	if err != nil {
+		return responses.IssueReceiptResponse{}, err
+	}
+
+	if len(receipt.Issued) > 0 {
+		f.responsesCache.InvalidatePattern("^ListOrders:")
+	}
+	for _, o := range receipt.Issued {
+		f.responsesCache.Invalidate(fmt.Sprintf("OrderHistory:%d", o.OrderID))
+	}
+	f.metrics.IncOrdersServed(float64(len(receipt.Issued)))
+
+	res := responses.IssueReceiptResponse{
+		UserID:  receipt.UserID,
+		Issued:  receipt.Issued,
+		Skipped: make([]responses.ProcessFailReport, 0, len(receipt.Skipped)),
+		Total:   receipt.Total,
+	}
+	for _, s := range receipt.Skipped {
+		res.Skipped = append(res.Skipped, responses.ProcessFailReport{OrderID: s.OrderID, Error: s.Error})
+	}
+	return res, nil
+}
@@ -34,8 +34,8 @@ func (f *DefaultFacadeHandler) HandleListOrders(ctx context.Context, req request
 		limit = *req.Limit
 	}
 	key := fmt.Sprintf(
-		"ListOrders:user=%d;inPvz=%t;page=%d;limit=%d",
-		uid, inPvz, page, limit,
+		"ListOrders:user=%d;inPvz=%t;page=%d;limit=%d;token=%s",
+		uid, inPvz, page, limit, req.PageToken,
 	)
 	if raw, ok := f.responsesCache.Get(key); ok {
 		if cached, ok2 := raw.(responses.ListOrdersResponse); ok2 {
@@ -43,14 +43,23 @@ func (f *DefaultFacadeHandler) HandleListOrders(ctx context.Context, req request
 			return cached, nil
 		}
 	}
+	req, err := f.resolveOrdersPageToken(req)
+	if err != nil {
+		return responses.ListOrdersResponse{}, err
+	}
 	orders, nextID, total, err := f.orderService.ListOrders(ctx, req)
 	if err != nil {
 		return responses.ListOrdersResponse{}, err
 	}
+	nextToken, err := f.nextOrdersPageToken(req, orders)
+	if err != nil {
+		return responses.ListOrdersResponse{}, err
+	}
 	resp := responses.ListOrdersResponse{
-		Orders: orders,
-		NextID: &nextID,
-		Total:  utils.Ptr(total),
+		Orders:        orders,
+		NextID:        &nextID,
+		Total:         utils.Ptr(total),
+		NextPageToken: nextToken,
 	}
 	f.responsesCache.Set(key, resp, listCacheTTL)
 	f.metrics.IncOrdersServed(float64(len(resp.Orders)))
@@ -15,28 +15,39 @@ func (f *DefaultFacadeHandler) HandleOrderHistory(ctx context.Context, req reque
 	if ctx.Err() != nil {
 		return responses.OrderHistoryResponse{}, ctx.Err()
 	}
-	if req.OrderID != nil {
+	// only the unfiltered first page of a single order is cached, so invalidation by order ID stays exact
+	if req.OrderID != nil && !req.Narrowed() && !req.Asc && req.PageToken == "" {
 		key := fmt.Sprintf("OrderHistory:%d", *req.OrderID)
 		if raw, ok := f.responsesCache.Get(key); ok {
 			if resp, ok := raw.(responses.OrderHistoryResponse); ok {
 				return resp, nil
 			}
 		}
-		entries, err := f.historyService.List(ctx, req)
+		resp, err := f.listHistory(ctx, req)
 		if err != nil {
 			return responses.OrderHistoryResponse{}, err
 		}
-
-		resp := responses.OrderHistoryResponse{History: entries}
 		f.responsesCache.Set(key, resp, historyCacheTTL)
 		return resp, nil
 	}
+	return f.listHistory(ctx, req)
+}
+
+func (f *DefaultFacadeHandler) listHistory(ctx context.Context, req requests.OrderHistoryFilter) (responses.OrderHistoryResponse, error) {
+	req, err := f.resolveHistoryPageToken(req)
+	if err != nil {
+		return responses.OrderHistoryResponse{}, err
+	}
 	entries, err := f.historyService.List(ctx, req)
 	if err != nil {
 		return responses.OrderHistoryResponse{}, err
 	}
-
+	nextToken, err := f.nextHistoryPageToken(req, entries)
+	if err != nil {
+		return responses.OrderHistoryResponse{}, err
+	}
 	return responses.OrderHistoryResponse{
-		History: entries,
+		History:       entries,
+		NextPageToken: nextToken,
 	}, nil
 }
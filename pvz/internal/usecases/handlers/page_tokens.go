@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+)
+
+// Page token kinds; a token issued by one listing is rejected by the other
+const (
+	ordersTokenKind  = "orders"
+	historyTokenKind = "history"
+)
+
+// resolveOrdersPageToken decodes the page token of req into its keyset cursor
+func (f *DefaultFacadeHandler) resolveOrdersPageToken(req requests.OrdersFilterRequest) (requests.OrdersFilterRequest, error) {
+	if req.PageToken == "" {
+		return req, nil
+	}
+	var c requests.OrderCursor
+	if err := f.pageTokens.Decode(ordersTokenKind, req.PageToken, &c); err != nil {
+		return req, apperrors.Newf(apperrors.ValidationFailed, "%v", err)
+	}
+	if !c.Matches(req) {
+		return req, apperrors.Newf(apperrors.ValidationFailed, "page token was issued for another sort order")
+	}
+	req.After = &c
+	return req, nil
+}
+
+// nextOrdersPageToken returns the token of the page following orders, or an empty string on the last page
+func (f *DefaultFacadeHandler) nextOrdersPageToken(req requests.OrdersFilterRequest, orders []models.Order) (string, error) {
+	limit := constants.DefaultLimit
+	if req.Limit != nil {
+		limit = *req.Limit
+	}
+	if req.Last != nil || limit <= 0 || len(orders) < limit {
+		return "", nil
+	}
+	token, err := f.pageTokens.Encode(ordersTokenKind, req.CursorAt(orders[len(orders)-1]))
+	if err != nil {
+		return "", apperrors.Newf(apperrors.InternalError, "failed to encode page token: %v", err)
+	}
+	return token, nil
+}
+
+// resolveHistoryPageToken decodes the page token of req into its keyset cursor
+func (f *DefaultFacadeHandler) resolveHistoryPageToken(req requests.OrderHistoryFilter) (requests.OrderHistoryFilter, error) {
+	if req.PageToken == "" {
+		return req, nil
+	}
+	var c requests.HistoryCursor
+	if err := f.pageTokens.Decode(historyTokenKind, req.PageToken, &c); err != nil {
+		return req, apperrors.Newf(apperrors.ValidationFailed, "%v", err)
+	}
+	if !c.Matches(req) {
+		return req, apperrors.Newf(apperrors.ValidationFailed, "page token was issued for another sort order")
+	}
+	req.After = &c
+	return req, nil
+}
+
+// nextHistoryPageToken returns the token of the page following entries, or an empty string on the last page
+func (f *DefaultFacadeHandler) nextHistoryPageToken(req requests.OrderHistoryFilter, entries []models.HistoryEntry) (string, error) {
+	if req.Limit <= 0 || len(entries) < req.Limit {
+		return "", nil
+	}
+	last := entries[len(entries)-1]
+	token, err := f.pageTokens.Encode(historyTokenKind, requests.HistoryCursor{At: last.Timestamp, ID: last.ID, Asc: req.Asc})
+	if err != nil {
+		return "", apperrors.Newf(apperrors.InternalError, "failed to encode page token: %v", err)
+	}
+	return token, nil
+}
@@ -26,8 +26,10 @@ func (f *DefaultFacadeHandler) HandleProcessOrders(
 	case constants.ActionIssue:
 		results, err = f.orderService.IssueOrders(ctx,
 			requests.IssueOrdersRequest{
-				UserID:   req.UserID,
-				OrderIDs: req.OrderIDs,
+				UserID:           req.UserID,
+				OrderIDs:         req.OrderIDs,
+				IdentityVerified: req.IdentityVerified,
+				Atomic:           req.Atomic,
 			})
 
 	case constants.ActionReturn:
@@ -35,6 +37,7 @@ func (f *DefaultFacadeHandler) HandleProcessOrders(
 			requests.ClientReturnsRequest{
 				UserID:   req.UserID,
 				OrderIDs: req.OrderIDs,
+				Atomic:   req.Atomic,
 			})
 
 	default:
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// HandleSearchOrders processes the search-orders request and returns the result.
+// Search results are not cached: the filter space is too wide for useful cache keys.
+func (f *DefaultFacadeHandler) HandleSearchOrders(ctx context.Context, req requests.OrdersFilterRequest) (responses.ListOrdersResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ListOrdersResponse{}, ctx.Err()
+	}
+	req, err := f.resolveOrdersPageToken(req)
+	if err != nil {
+		return responses.ListOrdersResponse{}, err
+	}
+	orders, nextID, total, err := f.orderService.ListOrders(ctx, req)
+	if err != nil {
+		return responses.ListOrdersResponse{}, err
+	}
+	nextToken, err := f.nextOrdersPageToken(req, orders)
+	if err != nil {
+		return responses.ListOrdersResponse{}, err
+	}
+	resp := responses.ListOrdersResponse{
+		Orders:        orders,
+		NextID:        &nextID,
+		Total:         utils.Ptr(total),
+		NextPageToken: nextToken,
+	}
+	f.metrics.IncOrdersServed(float64(len(resp.Orders)))
+	return resp, nil
+}
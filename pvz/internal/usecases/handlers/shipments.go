@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// HandleRegisterShipment registers an outbound parcel dropped off by a sender
+func (f *DefaultFacadeHandler) HandleRegisterShipment(
+	ctx context.Context,
+	req requests.RegisterShipmentRequest,
+) (responses.ShipmentResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ShipmentResponse{}, ctx.Err()
+	}
+	shipment, err := f.shipmentService.RegisterShipment(ctx, req)
+	if err != nil {
+		return responses.ShipmentResponse{}, err
+	}
+	return responses.ShipmentResponse{Shipment: shipment}, nil
+}
+
+// HandleHandOverShipment hands a registered shipment over to a courier
+func (f *DefaultFacadeHandler) HandleHandOverShipment(
+	ctx context.Context,
+	req requests.HandOverShipmentRequest,
+) (responses.ShipmentResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ShipmentResponse{}, ctx.Err()
+	}
+	shipment, err := f.shipmentService.HandOverShipment(ctx, req)
+	if err != nil {
+		return responses.ShipmentResponse{}, err
+	}
+	return responses.ShipmentResponse{Shipment: shipment}, nil
+}
+
+// HandleGetShipment returns the shipment status together with its history
+func (f *DefaultFacadeHandler) HandleGetShipment(ctx context.Context, shipmentID uint64) (responses.ShipmentResponse, error) {
+	if ctx.Err() != nil {
+		return responses.ShipmentResponse{}, ctx.Err()
+	}
+	shipment, history, err := f.shipmentService.GetShipment(ctx, shipmentID)
+	if err != nil {
+		return responses.ShipmentResponse{}, err
+	}
+	return responses.ShipmentResponse{Shipment: shipment, History: history}, nil
+}
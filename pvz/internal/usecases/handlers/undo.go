@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/responses"
+)
+
+// HandleUndoLastOperation reverts the last operation performed on the order by the same operator
+func (f *DefaultFacadeHandler) HandleUndoLastOperation(ctx context.Context, req requests.UndoRequest) (responses.UndoResponse, error) {
+	if ctx.Err() != nil {
+		return responses.UndoResponse{}, ctx.Err()
+	}
+
+	res, err := f.orderService.UndoLastOperation(ctx, req)
+	if err != nil {
+		return responses.UndoResponse{}, err
+	}
+
+	f.responsesCache.InvalidatePattern("^ListOrders:")
+	f.responsesCache.Invalidate(fmt.Sprintf("OrderHistory:%d", req.OrderID))
+	return responses.UndoResponse{
+		OrderID: res.OrderID,
+		Undone:  res.Undone,
+		Status:  res.Status,
+		Removed: res.Removed,
+	}, nil
+}
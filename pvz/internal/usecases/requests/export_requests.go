@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"fmt"
+	"pvz-cli/internal/common/tabular"
+	"strings"
+)
+
+// ExportDataset names the records an export contains
+type ExportDataset string
+
+// Available export datasets
+const (
+	ExportOrders  ExportDataset = "orders"
+	ExportReturns ExportDataset = "returns"
+	ExportHistory ExportDataset = "history"
+)
+
+// ParseExportDataset converts a case-insensitive dataset name into an ExportDataset
+func ParseExportDataset(s string) (ExportDataset, error) {
+	switch d := ExportDataset(strings.ToLower(strings.TrimSpace(s))); d {
+	case ExportOrders, ExportReturns, ExportHistory:
+		return d, nil
+	default:
+		return "", fmt.Errorf("unknown dataset %q, expected orders, returns or history", s)
+	}
+}
+
+// ExportRequest describes which records to export and in which format.
+// Orders is the filter of the orders and returns datasets, History the filter of the history dataset;
+// paging fields of both are ignored because an export contains every matching record.
+type ExportRequest struct {
+	Dataset ExportDataset
+	Format  tabular.Format
+	Orders  OrdersFilterRequest
+	History OrderHistoryFilter
+}
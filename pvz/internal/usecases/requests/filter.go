@@ -7,16 +7,117 @@ import (
 	"time"
 )
 
+// OrderSortField names an order attribute that search results can be sorted by.
+type OrderSortField string
+
+// Sortable order attributes; results with equal values are ordered by order ID.
+const (
+	SortByCreatedAt       OrderSortField = "created_at"
+	SortByExpiresAt       OrderSortField = "expires_at"
+	SortByUpdatedStatusAt OrderSortField = "updated_status_at"
+	SortByPrice           OrderSortField = "price"
+	SortByWeight          OrderSortField = "weight"
+)
+
+// ParseOrderSortField converts a field name into an OrderSortField; an empty name means the default order.
+func ParseOrderSortField(raw string) (OrderSortField, bool) {
+	switch f := OrderSortField(raw); f {
+	case "", SortByCreatedAt, SortByExpiresAt, SortByUpdatedStatusAt, SortByPrice, SortByWeight:
+		return f, true
+	default:
+		return "", false
+	}
+}
+
 // OrdersFilterRequest defines a flexible filter used by both ListOrders and ListReturns handlers.
+// Time ranges include the lower bound and exclude the upper one; price and weight ranges include both bounds.
 type OrdersFilterRequest struct {
 	UserID        *uint64
 	InPvz         *bool
-	LastID        *uint64
 	Page          *int
 	Limit         *int
 	Last          *int
 	Status        *models.OrderStatus
 	LastCreatedAt *time.Time
+
+	Statuses    []models.OrderStatus
+	Packages    []models.PackageType
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	ExpiresFrom *time.Time
+	ExpiresTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	PriceMin    *float32
+	PriceMax    *float32
+	WeightMin   *float32
+	WeightMax   *float32
+	// ExpiringWithin keeps orders whose storage ends within the given duration from now;
+	// the service resolves it into ExpiresFrom/ExpiresTo before querying storage
+	ExpiringWithin *time.Duration
+	SortBy         OrderSortField
+	SortDesc       bool
+	// PageToken is the opaque token of the page to continue from; the facade decodes it into After
+	PageToken string
+	// After continues the listing past this keyset position instead of using page offsets
+	After *OrderCursor
+}
+
+// OrderCursor is the keyset position of an order: its value of the sort field and its ID as a tie-breaker.
+type OrderCursor struct {
+	SortBy OrderSortField `json:"s"`
+	Desc   bool           `json:"d,omitempty"`
+	// At holds the value of a time sort field, Num the value of price or weight
+	At  time.Time `json:"t"`
+	Num float32   `json:"n,omitempty"`
+	ID  uint64    `json:"i"`
+}
+
+// SortField returns the effective sort field of the filter.
+func (f OrdersFilterRequest) SortField() OrderSortField {
+	if f.SortBy == "" {
+		return SortByCreatedAt
+	}
+	return f.SortBy
+}
+
+// CursorAt returns the keyset position of order o in the sort order of the filter.
+func (f OrdersFilterRequest) CursorAt(o models.Order) OrderCursor {
+	c := OrderCursor{SortBy: f.SortField(), Desc: f.SortDesc, ID: o.OrderID}
+	switch c.SortBy {
+	case SortByExpiresAt:
+		c.At = o.ExpiresAt
+	case SortByUpdatedStatusAt:
+		c.At = o.UpdatedStatusAt
+	case SortByPrice:
+		c.Num = o.Price
+	case SortByWeight:
+		c.Num = o.Weight
+	default:
+		c.At = o.CreatedAt
+	}
+	return c
+}
+
+// Matches reports whether the cursor was issued for the sort order of filter f.
+func (c OrderCursor) Matches(f OrdersFilterRequest) bool {
+	return c.SortBy == f.SortField() && c.Desc == f.SortDesc
+}
+
+// ResolveExpiringWithin narrows the expiry range to [now, now+ExpiringWithin) and clears ExpiringWithin.
+func (f OrdersFilterRequest) ResolveExpiringWithin(now time.Time) OrdersFilterRequest {
+	if f.ExpiringWithin == nil {
+		return f
+	}
+	from, to := now, now.Add(*f.ExpiringWithin)
+	if f.ExpiresFrom == nil || f.ExpiresFrom.Before(from) {
+		f.ExpiresFrom = &from
+	}
+	if f.ExpiresTo == nil || f.ExpiresTo.After(to) {
+		f.ExpiresTo = &to
+	}
+	f.ExpiringWithin = nil
+	return f
 }
 
 // NewOrdersFilter creates a new OrdersFilterRequest with default pagination values. Optional modifiers can be applied via functional options.
@@ -44,9 +145,9 @@ func WithInPvz(inPvz bool) FilterOption {
 	return func(f *OrdersFilterRequest) { f.InPvz = utils.Ptr(inPvz) }
 }
 
-// WithLastID sets the pagination cursor.
-func WithLastID(id uint64) FilterOption {
-	return func(f *OrdersFilterRequest) { f.LastID = utils.Ptr(id) }
+// WithPageToken sets the opaque token of the page to continue from.
+func WithPageToken(token string) FilterOption {
+	return func(f *OrdersFilterRequest) { f.PageToken = token }
 }
 
 // WithPage sets the page number.
@@ -68,3 +169,11 @@ func WithStatus(status models.OrderStatus) FilterOption {
 func WithLast(last int) FilterOption {
 	return func(f *OrdersFilterRequest) { f.Last = utils.Ptr(last) }
 }
+
+// WithSort sets the sort field and direction.
+func WithSort(field OrderSortField, desc bool) FilterOption {
+	return func(f *OrdersFilterRequest) {
+		f.SortBy = field
+		f.SortDesc = desc
+	}
+}
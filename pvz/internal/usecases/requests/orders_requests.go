@@ -23,42 +23,76 @@ type AcceptOrderRequest struct {
 	Weight    float32
 	Price     float32
 	Package   models.PackageType
+	Handling  models.HandlingFlags
+	// SubmittedAt, when set, is the moment the order was handed over at the counter, e.g. the submission of
+	// a background import job; the opening hours are checked against it instead of the current time
+	SubmittedAt time.Time
 }
 
 // ReturnOrderRequest contains parameters for returning an order to courier
 type ReturnOrderRequest struct {
 	OrderID uint64
+	// ExpectedVersion, when set, rejects the return if the order was changed since the client read it
+	ExpectedVersion *int64
+}
+
+// UndoRequest identifies the order whose most recent transition should be reverted
+type UndoRequest struct {
+	OrderID uint64
+}
+
+// ConsistencyCheckRequest asks to check stored orders against their history; Repair also fixes what can be fixed
+type ConsistencyCheckRequest struct {
+	Repair bool
 }
 
 // ProcessOrdersRequest aggregates user ID, list of order IDs, and the action to be performed.
 type ProcessOrdersRequest struct {
-	UserID   uint64
-	OrderIDs []uint64
-	Action   ProcessAction
+	UserID           uint64
+	OrderIDs         []uint64
+	Action           ProcessAction
+	IdentityVerified bool
+	// Atomic applies the whole batch in one transaction or nothing at all
+	Atomic bool
 }
 
 // IssueOrdersRequest contains parameters for issuing orders to clients
 type IssueOrdersRequest struct {
-	OrderIDs []uint64
-	UserID   uint64
+	OrderIDs         []uint64
+	UserID           uint64
+	IdentityVerified bool
+	Atomic           bool
+}
+
+// IssueAllReadyRequest contains parameters for issuing every ready order of a client at once
+type IssueAllReadyRequest struct {
+	UserID           uint64
+	IdentityVerified bool
 }
 
 // ClientReturnsRequest contains parameters for processing client returns
 type ClientReturnsRequest struct {
 	OrderIDs []uint64
 	UserID   uint64
+	Atomic   bool
 }
 
 // ScrollOrdersRequest contains parameters for infinite scroll orders listing
 type ScrollOrdersRequest struct {
-	UserID uint64
-	Limit  *int
-	LastID *uint64
+	UserID    uint64
+	Limit     *int
+	PageToken string
 }
 
 // ImportOrdersRequest contains a list of accept order request to be performed.
 type ImportOrdersRequest struct {
 	Statuses []ImportOrderStatus
+	// Atomic imports all orders in one transaction or none of them
+	Atomic bool
+	// ValidateOnly runs parsing, validation and pricing for every order without storing anything
+	ValidateOnly bool
+	// SubmittedAt, when set, is passed on to every accepted order, see AcceptOrderRequest
+	SubmittedAt time.Time
 }
 
 // ImportOrderStatus represents one item in import batch for request
@@ -67,11 +101,60 @@ type ImportOrderStatus struct {
 	OrderID    uint64
 	Request    *AcceptOrderRequest
 	Error      error
+	// TotalPrice is the computed price of the order, reported by validate-only imports
+	TotalPrice float32
 }
 
-// OrderHistoryFilter contains optional pagination parameter for getting order history entries
+// OrderHistoryFilter contains optional filters and pagination parameters for getting order history entries;
+// every set filter narrows the result
 type OrderHistoryFilter struct {
 	OrderID *uint64
-	Page    int
-	Limit   int
+	// UserID keeps the entries of the user's orders
+	UserID *uint64
+	// Events keeps the entries of any of the listed event types
+	Events []models.EventType
+	// From and To bound the entry time to [From, To)
+	From *time.Time
+	To   *time.Time
+	// ActorType and ActorID keep the actions of one actor; ActorID requires ActorType
+	ActorType *models.ActorType
+	ActorID   *uint64
+	// Asc lists the oldest entries first; history is listed newest first by default
+	Asc   bool
+	Page  int
+	Limit int
+	// PageToken is the opaque token of the page to continue from; the facade decodes it into After
+	PageToken string
+	// After continues the listing past this keyset position instead of using page offsets
+	After *HistoryCursor
+}
+
+// Narrowed reports whether any filter besides the order ID is set.
+func (f OrderHistoryFilter) Narrowed() bool {
+	return f.UserID != nil || len(f.Events) > 0 || f.From != nil || f.To != nil || f.ActorType != nil || f.ActorID != nil
+}
+
+// HistoryCursor is the keyset position of a history entry in the listing order given by Asc.
+type HistoryCursor struct {
+	At  time.Time `json:"t"`
+	ID  uint64    `json:"i"`
+	Asc bool      `json:"a,omitempty"`
+}
+
+// Matches reports whether the cursor was issued for the listing order of filter f.
+func (c HistoryCursor) Matches(f OrderHistoryFilter) bool {
+	return c.Asc == f.Asc
+}
+
+// AuditLogFilter narrows the audit log; empty fields match every record.
+type AuditLogFilter struct {
+	OperatorID string
+	// Method matches the full gRPC method name or its last segment, so AcceptOrder matches both sources
+	Method     string
+	Source     models.AuditSource
+	ResultCode string
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	Limit      int
 }
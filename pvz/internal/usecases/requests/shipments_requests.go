@@ -0,0 +1,19 @@
+package requests
+
+import "pvz-cli/internal/models"
+
+// RegisterShipmentRequest contains parameters for registering an outbound parcel drop-off
+type RegisterShipmentRequest struct {
+	SenderID           uint64
+	DestinationPointID uint64
+	Weight             float32
+	Price              float32
+	Package            models.PackageType
+	Handling           models.HandlingFlags
+}
+
+// HandOverShipmentRequest contains parameters for handing a registered shipment over to a courier
+type HandOverShipmentRequest struct {
+	ShipmentID uint64
+	CourierID  uint64
+}
@@ -3,13 +3,18 @@ package responses
 import (
 	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
+	"time"
 )
 
 // AcceptOrderResponse represents the result of successfully accepting an order.
 type AcceptOrderResponse struct {
-	OrderID uint64
-	Package models.PackageType
-	Price   float32
+	OrderID       uint64
+	Package       models.PackageType
+	Price         float32
+	Handling      models.HandlingFlags
+	ExpiresAt     time.Time
+	StoragePolicy string
+	Version       int64
 }
 
 // ReturnOrderResponse represents a successful order return operation.
@@ -29,20 +34,74 @@ type ProcessFailReport struct {
 	Error   error
 }
 
+// IssueReceiptResponse represents the consolidated receipt of a grouped pickup.
+type IssueReceiptResponse struct {
+	UserID  uint64
+	Issued  []models.Order
+	Skipped []ProcessFailReport
+	Total   float32
+}
+
 // ListOrdersResponse represents a list of orders, total count and pagination metadata.
 type ListOrdersResponse struct {
 	Orders []models.Order
 	NextID *uint64
 	Total  *int
+	// NextPageToken continues the listing after the last returned order; empty on the last page
+	NextPageToken string
 }
 
 // OrderHistoryResponse contains a list of order history entries.
 type OrderHistoryResponse struct {
 	History []models.HistoryEntry
+	// NextPageToken continues the listing after the last returned entry; empty on the last page
+	NextPageToken string
 }
 
 // ImportOrdersResponse represents the result of an import-orders operation.
 type ImportOrdersResponse struct {
 	Imported int
 	Statuses []requests.ImportOrderStatus
+	// ValidateOnly marks a dry run: nothing is imported and Valid counts the orders that would be
+	ValidateOnly bool
+	Valid        int
+}
+
+// ImportJobResponse represents the state of a background import job.
+type ImportJobResponse struct {
+	Job models.ImportJob
+}
+
+// ImportJobFailuresResponse represents the orders a background import job failed to import.
+type ImportJobFailuresResponse struct {
+	JobID    uint64
+	Failures []models.ImportJobFailure
+}
+
+// UndoResponse represents the result of undoing the last operation on an order.
+type UndoResponse struct {
+	OrderID uint64
+	Undone  models.EventType
+	Status  models.OrderStatus
+	Removed bool
+}
+
+// ConsistencyReportResponse represents the drift found between orders, their history and outbox events.
+type ConsistencyReportResponse struct {
+	CheckedOrders int
+	Issues        []models.ConsistencyIssue
+	Repaired      int
+}
+
+// OrderDetailsResponse represents a single order with its latest history entries and current deadlines.
+type OrderDetailsResponse struct {
+	Order           models.Order
+	History         []models.HistoryEntry
+	StorageDeadline *time.Time
+	ReturnDeadline  *time.Time
+}
+
+// ExportResponse represents the result of an export written to the caller's writer.
+type ExportResponse struct {
+	Rows int
 }
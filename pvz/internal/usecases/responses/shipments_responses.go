@@ -0,0 +1,9 @@
+package responses
+
+import "pvz-cli/internal/models"
+
+// ShipmentResponse represents an outbound shipment together with its status history.
+type ShipmentResponse struct {
+	Shipment models.Shipment
+	History  []models.ShipmentHistoryEntry
+}
@@ -0,0 +1,15 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package services
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+)
+
+// AuditService records mutating calls of operators and lists them for review
+type AuditService interface {
+	// Record stores the call, taking the operator, role and correlation ID from ctx
+	Record(ctx context.Context, rec models.AuditRecord) error
+	List(ctx context.Context, filter requests.AuditLogFilter) ([]models.AuditRecord, int, error)
+}
@@ -0,0 +1,14 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package services
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+)
+
+// CalendarService manages the pickup-point calendar used for storage and return deadlines
+type CalendarService interface {
+	GetCalendar(ctx context.Context) (models.PickupCalendar, error)
+	UpdateCalendar(ctx context.Context, cal models.PickupCalendar) (models.PickupCalendar, error)
+	Current() models.PickupCalendar
+}
@@ -0,0 +1,14 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package services
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+)
+
+// ConsistencyService checks that stored orders agree with their history and outbox events
+type ConsistencyService interface {
+	// Check replays the history of every live order and reports the drift; with repair it also fixes
+	// what the history determines unambiguously
+	Check(ctx context.Context, repair bool) (models.ConsistencyReport, error)
+}
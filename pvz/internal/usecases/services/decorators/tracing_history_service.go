@@ -50,6 +50,10 @@ func (t *TracingHistoryService) List(ctx context.Context, filter requests.OrderH
 	if filter.OrderID != nil {
 		attrs = append(attrs, attribute.Int64("filter.order_id", int64(*filter.OrderID)))
 	}
+	if filter.UserID != nil {
+		attrs = append(attrs, attribute.Int64("filter.user_id", int64(*filter.UserID)))
+	}
+	attrs = append(attrs, attribute.Bool("filter.narrowed", filter.Narrowed()), attribute.Bool("filter.asc", filter.Asc))
 	ctx, span := t.tracer.Start(ctx, "HistoryService.List", trace.WithAttributes(attrs...))
 	defer span.End()
 	entries, err := t.inner.List(ctx, filter)
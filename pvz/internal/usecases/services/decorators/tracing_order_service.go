@@ -63,6 +63,45 @@ func (t TracingOrderService) IssueOrders(ctx context.Context, req requests.Issue
 	return results, err
 }
 
+// UndoLastOperation reverts the most recent transition of an order and traces the outcome.
+func (t TracingOrderService) UndoLastOperation(ctx context.Context, req requests.UndoRequest) (models.UndoResult, error) {
+	ctx, span := t.tracer.Start(ctx, "OrderService.UndoLastOperation",
+		trace.WithAttributes(
+			attribute.String("order.order_id", strconv.FormatUint(req.OrderID, 10)),
+		),
+	)
+	defer span.End()
+	res, err := t.inner.UndoLastOperation(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetAttributes(attribute.String("order.undone_event", res.Undone.String()))
+	}
+	return res, err
+}
+
+// IssueAllReady issues all ready orders of a user and returns the consolidated receipt or an error if listing fails.
+func (t TracingOrderService) IssueAllReady(ctx context.Context, req requests.IssueAllReadyRequest) (models.IssueReceipt, error) {
+	ctx, span := t.tracer.Start(ctx, "OrderService.IssueAllReady",
+		trace.WithAttributes(
+			attribute.String("order.user_id", strconv.FormatUint(req.UserID, 10)),
+		),
+	)
+	defer span.End()
+	receipt, err := t.inner.IssueAllReady(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetAttributes(
+			attribute.Int("orders.issued", len(receipt.Issued)),
+			attribute.Int("orders.skipped", len(receipt.Skipped)),
+		)
+	}
+	return receipt, err
+}
+
 // ListOrders retrieves a filtered list of orders, along with pagination details and potential error information.
 func (t TracingOrderService) ListOrders(ctx context.Context, filter requests.OrdersFilterRequest) ([]models.Order, uint64, int, error) {
 	var attrs []attribute.KeyValue
@@ -130,10 +169,29 @@ func (t TracingOrderService) ListReturns(ctx context.Context, filter requests.Or
 	return orders, nil
 }
 
+// GetOrder loads a single order with its latest history and deadlines and traces the lookup.
+func (t TracingOrderService) GetOrder(ctx context.Context, orderID uint64) (models.OrderDetails, error) {
+	ctx, span := t.tracer.Start(ctx, "OrderService.GetOrder",
+		trace.WithAttributes(
+			attribute.String("order.order_id", strconv.FormatUint(orderID, 10)),
+		),
+	)
+	defer span.End()
+	details, err := t.inner.GetOrder(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return details, err
+}
+
 // ImportOrders processes a batch import of orders and returns the results of the operation or an error if one occurs.
 func (t TracingOrderService) ImportOrders(ctx context.Context, req requests.ImportOrdersRequest) ([]models.BatchEntryProcessedResult, error) {
 	ctx, span := t.tracer.Start(ctx, "OrderService.ImportOrders",
-		trace.WithAttributes(attribute.Int("orders.count", len(req.Statuses))),
+		trace.WithAttributes(
+			attribute.Int("orders.count", len(req.Statuses)),
+			attribute.Bool("import.validate_only", req.ValidateOnly),
+		),
 	)
 	defer span.End()
 	results, err := t.inner.ImportOrders(ctx, req)
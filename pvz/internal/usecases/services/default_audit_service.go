@@ -0,0 +1,133 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/auth"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/common/correlation"
+	"pvz-cli/internal/common/operator"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/pkg/clock"
+	"strings"
+)
+
+const (
+	// auditMaxItems is the longest list kept in a recorded request; imports carry whole batches of orders
+	auditMaxItems = 10
+	// auditMaxString is the longest string kept in a recorded request
+	auditMaxString = 256
+	auditRedacted  = "[REDACTED]"
+)
+
+// auditRedactedKeys are client identities, which anonymization must be able to erase; keys are compared
+// lowercased and without underscores, so both proto and Go field names match
+var auditRedactedKeys = map[string]struct{}{
+	"userid":   {},
+	"senderid": {},
+}
+
+var _ AuditService = (*DefaultAuditService)(nil)
+
+// DefaultAuditService is a default implementation of the AuditService interface
+type DefaultAuditService struct {
+	clk       clock.Clock
+	auditRepo repositories.AuditRepository
+}
+
+// NewDefaultAuditService creates a new instance of DefaultAuditService
+func NewDefaultAuditService(clk clock.Clock, auditRepo repositories.AuditRepository) *DefaultAuditService {
+	return &DefaultAuditService{clk: clk, auditRepo: auditRepo}
+}
+
+// Record sanitizes the request of the call and appends the call to the audit log
+func (s *DefaultAuditService) Record(ctx context.Context, rec models.AuditRecord) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	rec.Timestamp = s.clk.Now().UTC()
+	rec.OperatorID = operator.IDFromContext(ctx)
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		rec.Role = string(p.Role)
+	}
+	rec.CorrelationID = correlation.IDFromContext(ctx)
+	request, err := sanitizeAuditRequest(rec.Request)
+	if err != nil {
+		return apperrors.Newf(apperrors.InternalError, "failed to sanitize request of %s: %v", rec.Method, err)
+	}
+	rec.Request = request
+	if err := s.auditRepo.Save(ctx, rec); err != nil {
+		return apperrors.Newf(apperrors.InternalError, "failed to record %s: %v", rec.Method, err)
+	}
+	return nil
+}
+
+// List returns a page of the audit log matching the filter, newest first, and the number of all matching records
+func (s *DefaultAuditService) List(ctx context.Context, filter requests.AuditLogFilter) ([]models.AuditRecord, int, error) {
+	if ctx.Err() != nil {
+		return nil, 0, ctx.Err()
+	}
+	switch {
+	case filter.Page <= 0:
+		return nil, 0, apperrors.Newf(apperrors.ValidationFailed, "page must be greater than 0")
+	case filter.Limit <= 0 || filter.Limit > constants.MaxAuditLimit:
+		return nil, 0, apperrors.Newf(apperrors.ValidationFailed, "limit must be between 1 and %d", constants.MaxAuditLimit)
+	case filter.Source != "" && filter.Source != models.AuditSourceGRPC && filter.Source != models.AuditSourceCLI:
+		return nil, 0, apperrors.Newf(apperrors.ValidationFailed, "invalid source: %s", filter.Source)
+	case filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To):
+		return nil, 0, apperrors.Newf(apperrors.ValidationFailed, "from must be before to")
+	}
+	records, total, err := s.auditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, 0, apperrors.Newf(apperrors.InternalError, "failed to list audit log: %v", err)
+	}
+	return records, total, nil
+}
+
+// sanitizeAuditRequest redacts client identities and shortens long lists and strings of a JSON request
+func sanitizeAuditRequest(raw json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(sanitizeAuditValue(v))
+}
+
+func sanitizeAuditValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			if _, ok := auditRedactedKeys[strings.ReplaceAll(strings.ToLower(k), "_", "")]; ok {
+				val[k] = auditRedacted
+				continue
+			}
+			val[k] = sanitizeAuditValue(item)
+		}
+		return val
+	case []any:
+		if len(val) > auditMaxItems {
+			return fmt.Sprintf("[%d items]", len(val))
+		}
+		for i, item := range val {
+			val[i] = sanitizeAuditValue(item)
+		}
+		return val
+	case string:
+		if r := []rune(val); len(r) > auditMaxString {
+			return string(r[:auditMaxString]) + "…"
+		}
+		return val
+	default:
+		return val
+	}
+}
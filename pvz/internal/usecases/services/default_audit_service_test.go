@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/auth"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/common/correlation"
+	"pvz-cli/internal/common/operator"
+	"pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/pkg/clock"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDefaultAuditService_Record verifies that the caller comes from the context and the request is sanitized.
+func TestDefaultAuditService_Record(t *testing.T) {
+	t.Parallel()
+	clk := &clock.FakeClock{}
+	repo := mocks.NewAuditRepositoryMock(t)
+	svc := NewDefaultAuditService(clk, repo)
+
+	ctx := operator.WithID(context.Background(), "op-7")
+	ctx = auth.WithPrincipal(ctx, auth.Principal{OperatorID: "op-7", Role: auth.RoleSupervisor})
+	ctx = correlation.WithID(ctx, "corr-1")
+
+	var saved models.AuditRecord
+	repo.SaveMock.Set(func(_ context.Context, rec models.AuditRecord) error {
+		saved = rec
+		return nil
+	})
+
+	orders := make([]map[string]any, 12)
+	for i := range orders {
+		orders[i] = map[string]any{"order_id": i + 1}
+	}
+	request, err := json.Marshal(map[string]any{
+		"order_id": 1,
+		"user_id":  42,
+		"UserID":   42,
+		"comment":  strings.Repeat("x", 300),
+		"orders":   orders,
+		"nested":   map[string]any{"sender_id": 7, "weight": 1.5},
+	})
+	require.NoError(t, err)
+
+	err = svc.Record(ctx, models.AuditRecord{
+		Source:     models.AuditSourceGRPC,
+		Method:     "/orders.OrdersService/ImportOrders",
+		Request:    request,
+		ResultCode: "OK",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, clk.Now().UTC(), saved.Timestamp)
+	require.Equal(t, "op-7", saved.OperatorID)
+	require.Equal(t, string(auth.RoleSupervisor), saved.Role)
+	require.Equal(t, "corr-1", saved.CorrelationID)
+	require.Equal(t, "OK", saved.ResultCode)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(saved.Request, &got))
+	require.Equal(t, float64(1), got["order_id"])
+	require.Equal(t, "[REDACTED]", got["user_id"])
+	require.Equal(t, "[REDACTED]", got["UserID"])
+	require.Equal(t, strings.Repeat("x", 256)+"…", got["comment"])
+	require.Equal(t, "[12 items]", got["orders"])
+	require.Equal(t, map[string]any{"sender_id": "[REDACTED]", "weight": 1.5}, got["nested"])
+}
+
+// TestDefaultAuditService_RecordFailure verifies that a repository failure is reported as an internal error.
+func TestDefaultAuditService_RecordFailure(t *testing.T) {
+	t.Parallel()
+	repo := mocks.NewAuditRepositoryMock(t)
+	repo.SaveMock.Return(errors.New("disk full"))
+	svc := NewDefaultAuditService(&clock.FakeClock{}, repo)
+
+	err := svc.Record(context.Background(), models.AuditRecord{Method: "AcceptOrder"})
+	var ae *apperrors.AppError
+	require.ErrorAs(t, err, &ae)
+	require.Equal(t, apperrors.InternalError, ae.Code)
+}
+
+// TestDefaultAuditService_List covers filter validation and passing a valid filter to the repository.
+func TestDefaultAuditService_List(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	valid := requests.AuditLogFilter{Page: 1, Limit: 10}
+	tests := []struct {
+		name     string
+		filter   func(f requests.AuditLogFilter) requests.AuditLogFilter
+		wantCode apperrors.ErrorCode
+	}{
+		{
+			name:     "zero page",
+			filter:   func(f requests.AuditLogFilter) requests.AuditLogFilter { f.Page = 0; return f },
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name: "limit above max",
+			filter: func(f requests.AuditLogFilter) requests.AuditLogFilter {
+				f.Limit = constants.MaxAuditLimit + 1
+				return f
+			},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name:     "unknown source",
+			filter:   func(f requests.AuditLogFilter) requests.AuditLogFilter { f.Source = "http"; return f },
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name: "from after to",
+			filter: func(f requests.AuditLogFilter) requests.AuditLogFilter {
+				f.From, f.To = &to, &from
+				return f
+			},
+			wantCode: apperrors.ValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			svc := NewDefaultAuditService(&clock.FakeClock{}, mocks.NewAuditRepositoryMock(t))
+			_, _, err := svc.List(context.Background(), tt.filter(valid))
+			var ae *apperrors.AppError
+			require.ErrorAs(t, err, &ae)
+			require.Equal(t, tt.wantCode, ae.Code)
+		})
+	}
+
+	t.Run("valid filter", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewAuditRepositoryMock(t)
+		svc := NewDefaultAuditService(&clock.FakeClock{}, repo)
+		ctx := context.Background()
+		filter := valid
+		filter.Source, filter.From, filter.To = models.AuditSourceCLI, &from, &to
+		want := []models.AuditRecord{{ID: 3, Method: "AcceptOrder", Source: models.AuditSourceCLI}}
+		repo.ListMock.Expect(ctx, filter).Return(want, 5, nil)
+
+		records, total, err := svc.List(ctx, filter)
+		require.NoError(t, err)
+		require.Equal(t, want, records)
+		require.Equal(t, 5, total)
+	})
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/services/validators"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	_ CalendarService             = (*DefaultCalendarService)(nil)
+	_ validators.CalendarProvider = (*DefaultCalendarService)(nil)
+)
+
+// DefaultCalendarService is a default implementation of the CalendarService interface.
+// It keeps the last loaded calendar in memory so validators can use it without hitting storage.
+type DefaultCalendarService struct {
+	calendarRepo repositories.CalendarRepository
+	mu           sync.RWMutex
+	current      models.PickupCalendar
+}
+
+// NewDefaultCalendarService creates a new instance of DefaultCalendarService
+func NewDefaultCalendarService(calendarRepo repositories.CalendarRepository) *DefaultCalendarService {
+	return &DefaultCalendarService{calendarRepo: calendarRepo}
+}
+
+// GetCalendar loads the calendar from the repository and refreshes the in-memory copy
+func (s *DefaultCalendarService) GetCalendar(ctx context.Context) (models.PickupCalendar, error) {
+	if ctx.Err() != nil {
+		return models.PickupCalendar{}, ctx.Err()
+	}
+	cal, err := s.calendarRepo.Get(ctx)
+	if err != nil {
+		return models.PickupCalendar{}, apperrors.Newf(apperrors.InternalError, "failed to load pickup calendar: %v", err)
+	}
+	s.setCurrent(cal)
+	return cal, nil
+}
+
+// UpdateCalendar validates and persists the calendar, replacing the one in effect
+func (s *DefaultCalendarService) UpdateCalendar(ctx context.Context, cal models.PickupCalendar) (models.PickupCalendar, error) {
+	if ctx.Err() != nil {
+		return models.PickupCalendar{}, ctx.Err()
+	}
+	normalized, err := normalizeCalendar(cal)
+	if err != nil {
+		return models.PickupCalendar{}, err
+	}
+	if err := s.calendarRepo.Save(ctx, normalized); err != nil {
+		return models.PickupCalendar{}, apperrors.Newf(apperrors.InternalError, "failed to save pickup calendar: %v", err)
+	}
+	s.setCurrent(normalized)
+	return normalized, nil
+}
+
+// Current returns the calendar currently in effect
+func (s *DefaultCalendarService) Current() models.PickupCalendar {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current
+}
+
+func (s *DefaultCalendarService) setCurrent(cal models.PickupCalendar) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = cal
+}
+
+func normalizeCalendar(cal models.PickupCalendar) (models.PickupCalendar, error) {
+	if (cal.OpensAt == "") != (cal.ClosesAt == "") {
+		return models.PickupCalendar{}, apperrors.Newf(apperrors.ValidationFailed, "opening and closing time must be set together")
+	}
+	if cal.OpensAt != "" {
+		opens, err := time.Parse(constants.ClockLayout, cal.OpensAt)
+		if err != nil {
+			return models.PickupCalendar{}, apperrors.Newf(apperrors.ValidationFailed, "invalid opening time %q", cal.OpensAt)
+		}
+		closes, err := time.Parse(constants.ClockLayout, cal.ClosesAt)
+		if err != nil {
+			return models.PickupCalendar{}, apperrors.Newf(apperrors.ValidationFailed, "invalid closing time %q", cal.ClosesAt)
+		}
+		if !opens.Before(closes) {
+			return models.PickupCalendar{}, apperrors.Newf(apperrors.ValidationFailed, "opening time must be before closing time")
+		}
+	}
+	seen := make(map[time.Weekday]struct{}, len(cal.WeeklyDaysOff))
+	daysOff := make([]time.Weekday, 0, len(cal.WeeklyDaysOff))
+	for _, wd := range cal.WeeklyDaysOff {
+		if wd < time.Sunday || wd > time.Saturday {
+			return models.PickupCalendar{}, apperrors.Newf(apperrors.ValidationFailed, "invalid weekday %d", wd)
+		}
+		if _, ok := seen[wd]; ok {
+			continue
+		}
+		seen[wd] = struct{}{}
+		daysOff = append(daysOff, wd)
+	}
+	sort.Slice(daysOff, func(i, j int) bool { return daysOff[i] < daysOff[j] })
+	cal.WeeklyDaysOff = daysOff
+	if !cal.HasWorkingDays() {
+		return models.PickupCalendar{}, apperrors.Newf(apperrors.ValidationFailed, "pickup point must have at least one working day")
+	}
+	holidays := make([]time.Time, 0, len(cal.Holidays))
+	for _, h := range cal.Holidays {
+		y, m, d := h.Date()
+		holidays = append(holidays, time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+	}
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].Before(holidays[j]) })
+	cal.Holidays = holidays
+	return cal, nil
+}
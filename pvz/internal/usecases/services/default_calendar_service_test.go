@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/models"
+)
+
+// TestDefaultCalendarService_UpdateCalendar validates calendar normalization and persistence.
+func TestDefaultCalendarService_UpdateCalendar(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		input    models.PickupCalendar
+		saveErr  error
+		want     models.PickupCalendar
+		wantCode apperrors.ErrorCode
+	}{
+		{
+			name: "normalizes days off and holidays",
+			input: models.PickupCalendar{
+				OpensAt:       "09:00",
+				ClosesAt:      "21:00",
+				WeeklyDaysOff: []time.Weekday{time.Sunday, time.Saturday, time.Sunday},
+				Holidays:      []time.Time{time.Date(2025, 12, 31, 15, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+				BusinessDays:  true,
+			},
+			want: models.PickupCalendar{
+				OpensAt:       "09:00",
+				ClosesAt:      "21:00",
+				WeeklyDaysOff: []time.Weekday{time.Sunday, time.Saturday},
+				Holidays:      []time.Time{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)},
+				BusinessDays:  true,
+			},
+		},
+		{
+			name:     "only opening time set",
+			input:    models.PickupCalendar{OpensAt: "09:00"},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name:     "malformed closing time",
+			input:    models.PickupCalendar{OpensAt: "09:00", ClosesAt: "9pm"},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name:     "opens after closing",
+			input:    models.PickupCalendar{OpensAt: "21:00", ClosesAt: "09:00"},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name:     "invalid weekday",
+			input:    models.PickupCalendar{WeeklyDaysOff: []time.Weekday{7}},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name: "no working days",
+			input: models.PickupCalendar{WeeklyDaysOff: []time.Weekday{
+				time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+			}},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name:     "repository failure",
+			input:    models.PickupCalendar{},
+			saveErr:  errors.New("db unreachable"),
+			wantCode: apperrors.InternalError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			repo := mocks.NewCalendarRepositoryMock(t)
+			if tt.wantCode == "" || tt.saveErr != nil {
+				repo.SaveMock.Return(tt.saveErr)
+			}
+			svc := NewDefaultCalendarService(repo)
+
+			got, err := svc.UpdateCalendar(ctx, tt.input)
+			if tt.wantCode != "" {
+				var ae *apperrors.AppError
+				require.ErrorAs(t, err, &ae)
+				require.Equal(t, tt.wantCode, ae.Code)
+				require.Equal(t, models.PickupCalendar{}, svc.Current())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.want, svc.Current())
+		})
+	}
+}
+
+// TestDefaultCalendarService_GetCalendar verifies that loading refreshes the calendar in effect.
+func TestDefaultCalendarService_GetCalendar(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	cal := models.PickupCalendar{OpensAt: "10:00", ClosesAt: "20:00", WeeklyDaysOff: []time.Weekday{time.Monday}}
+	repo := mocks.NewCalendarRepositoryMock(t)
+	repo.GetMock.Expect(ctx).Return(cal, nil)
+	svc := NewDefaultCalendarService(repo)
+
+	got, err := svc.GetCalendar(ctx)
+	require.NoError(t, err)
+	require.Equal(t, cal, got)
+	require.Equal(t, cal, svc.Current())
+}
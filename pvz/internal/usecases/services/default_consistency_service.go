@@ -0,0 +1,325 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"slices"
+	"time"
+)
+
+var _ ConsistencyService = (*DefaultConsistencyService)(nil)
+
+// DefaultConsistencyService is a default implementation of the ConsistencyService interface.
+// History is the source of truth: a stored order is compared with the state its history replays to.
+type DefaultConsistencyService struct {
+	txRunner        db.TxRunner
+	consistencyRepo repositories.ConsistencyRepository
+	orderRepo       repositories.OrderRepository
+	outboxRepo      repositories.OutboxRepository
+	checkOutbox     bool
+}
+
+// NewDefaultConsistencyService creates a new instance of DefaultConsistencyService.
+// checkOutbox enables the outbox check and must be false where outbox events are not stored.
+func NewDefaultConsistencyService(
+	txRunner db.TxRunner,
+	consistencyRepo repositories.ConsistencyRepository,
+	orderRepo repositories.OrderRepository,
+	outboxRepo repositories.OutboxRepository,
+	checkOutbox bool,
+) *DefaultConsistencyService {
+	return &DefaultConsistencyService{
+		txRunner:        txRunner,
+		consistencyRepo: consistencyRepo,
+		orderRepo:       orderRepo,
+		outboxRepo:      outboxRepo,
+		checkOutbox:     checkOutbox,
+	}
+}
+
+// Check replays the history of every live order through the status rules of the order service.
+// With repair, a stored status that differs from a fully replayed history is overwritten and missing outbox events
+// are enqueued again; orders without ACCEPTED or with an impossible sequence are only reported.
+func (s *DefaultConsistencyService) Check(ctx context.Context, repair bool) (models.ConsistencyReport, error) {
+	if ctx.Err() != nil {
+		return models.ConsistencyReport{}, ctx.Err()
+	}
+	report := models.ConsistencyReport{Issues: []models.ConsistencyIssue{}}
+	err := s.consistencyRepo.Scan(ctx, func(rec models.OrderRecord) error {
+		report.CheckedOrders++
+		issues, err := s.checkOrder(ctx, rec, repair)
+		if err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			if issue.Repaired {
+				report.Repaired++
+			}
+		}
+		report.Issues = append(report.Issues, issues...)
+		return nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return models.ConsistencyReport{}, ctx.Err()
+		}
+		return models.ConsistencyReport{}, apperrors.Newf(apperrors.InternalError, "failed to check consistency: %v", err)
+	}
+	return report, nil
+}
+
+func (s *DefaultConsistencyService) checkOrder(ctx context.Context, rec models.OrderRecord, repair bool) ([]models.ConsistencyIssue, error) {
+	var (
+		issues   []models.ConsistencyIssue
+		restored *models.Order
+		remove   bool
+	)
+	state, issue := replayHistory(rec)
+	if issue != nil {
+		issues = append(issues, *issue)
+	} else if details := stateMismatch(rec, state); details != "" {
+		issues = append(issues, models.ConsistencyIssue{OrderID: rec.OrderID, Kind: models.IssueStatusMismatch, Details: details})
+		switch {
+		case state.exists && rec.Order != nil:
+			order := *rec.Order
+			order.Status = state.status
+			order.UpdatedStatusAt = state.at
+			if rec.Removed {
+				// a removed row is revived the way a new acceptance revives it
+				order.Version = 0
+			}
+			restored = &order
+		case !state.exists:
+			remove = true
+		}
+	}
+	statusIssues := len(issues)
+
+	var missing []models.HistoryEntry
+	if s.checkOutbox {
+		missing = missingOutboxEvents(rec)
+		for _, h := range missing {
+			issues = append(issues, models.ConsistencyIssue{
+				OrderID: rec.OrderID,
+				Kind:    models.IssueMissingOutboxEvent,
+				Details: fmt.Sprintf("%s at %s has no %s outbox event", h.Event, h.Timestamp.Format(time.RFC3339Nano), models.MapEventTypeToKafkaEvent(h.Event)),
+			})
+		}
+	}
+
+	// outbox payloads carry the order, so events of an order without a stored row cannot be rebuilt
+	if rec.Order == nil {
+		missing = nil
+	}
+	if !repair || (restored == nil && !remove && len(missing) == 0) {
+		return issues, nil
+	}
+	if err := s.repairOrder(ctx, rec, restored, remove, missing); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		for i := range issues {
+			issues[i].Details += fmt.Sprintf("; repair failed: %v", err)
+		}
+		return issues, nil
+	}
+	for i := range issues {
+		fixedStatus := i < statusIssues && (restored != nil || remove)
+		fixedOutbox := i >= statusIssues && len(missing) > 0
+		issues[i].Repaired = fixedStatus || fixedOutbox
+	}
+	return issues, nil
+}
+
+// repairOrder stores the replayed state of the order and enqueues its missing outbox events in one transaction
+func (s *DefaultConsistencyService) repairOrder(
+	ctx context.Context,
+	rec models.OrderRecord,
+	restored *models.Order,
+	remove bool,
+	missing []models.HistoryEntry,
+) error {
+	payloadOrder := models.Order{}
+	if rec.Order != nil {
+		payloadOrder = *rec.Order
+	}
+	if restored != nil {
+		payloadOrder = *restored
+	}
+	return s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
+		txCtx := ctxWithTx(ctx, tx)
+		switch {
+		case restored != nil:
+			if _, err := s.orderRepo.Save(txCtx, *restored); err != nil {
+				return orderSaveError(rec.OrderID, err, "failed to save order %d: %v")
+			}
+		case remove:
+			// remove is only set for a stored order
+			if err := s.orderRepo.Delete(txCtx, rec.OrderID, rec.Order.Version); err != nil {
+				return orderSaveError(rec.OrderID, err, "failed to remove order %d: %v")
+			}
+		}
+		for _, h := range missing {
+			eventID, err := utils.GenerateID()
+			if err != nil {
+				return apperrors.Newf(apperrors.InternalError, "failed to generate event ID for order %d: %v", rec.OrderID, err)
+			}
+			payload, err := marshalEvent(models.KafkaEvent{
+				EventID:     eventID,
+				EventType:   models.MapEventTypeToKafkaEvent(h.Event),
+				Timestamp:   h.Timestamp,
+				Actor:       h.Actor(),
+				Order:       payloadOrder,
+				Source:      SourceName,
+				Operator:    h.OperatorID,
+				UndoneEvent: undoneKafkaEvent(h),
+			})
+			if err != nil {
+				return err
+			}
+			if err := s.outboxRepo.Create(txCtx, eventID, models.OutboxAggregateOrder, rec.OrderID, payload); err != nil {
+				return apperrors.Newf(apperrors.InternalError, "failed to enqueue outbox event for order %d: %v", rec.OrderID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// replayedState is the order state a history leads to; at is the time of the transition that produced it
+type replayedState struct {
+	exists bool
+	status models.OrderStatus
+	at     time.Time
+}
+
+func (st replayedState) String() string {
+	if !st.exists {
+		return "ABSENT"
+	}
+	return st.status.String()
+}
+
+// replayHistory applies the status rules of the order service to the history, oldest first.
+// UNDONE restores the state before the latest transition that has not been undone yet, as UndoLastOperation does.
+// A replay that cannot complete returns the issue that stopped it.
+func replayHistory(rec models.OrderRecord) (replayedState, *models.ConsistencyIssue) {
+	if !slices.ContainsFunc(rec.History, func(h models.HistoryEntry) bool { return h.Event == models.EventAccepted }) {
+		return replayedState{}, &models.ConsistencyIssue{
+			OrderID: rec.OrderID,
+			Kind:    models.IssueMissingAccepted,
+			Details: "history has no ACCEPTED event",
+		}
+	}
+	var (
+		state    replayedState
+		undoable []replayedState
+		last     models.EventType
+	)
+	for _, h := range rec.History {
+		var (
+			next    replayedState
+			allowed bool
+		)
+		switch h.Event {
+		case models.EventAccepted:
+			allowed = !state.exists
+			next = replayedState{exists: true, status: models.Accepted, at: h.Timestamp}
+		case models.EventIssued:
+			allowed = state.exists && state.status == models.Accepted
+			next = replayedState{exists: true, status: models.Issued, at: h.Timestamp}
+		case models.EventReturnedByClient:
+			allowed = state.exists && state.status == models.Issued
+			next = replayedState{exists: true, status: models.Returned, at: h.Timestamp}
+		case models.EventReturnedToWarehouse:
+			allowed = state.exists && (state.status == models.Accepted || state.status == models.Returned)
+		case models.EventUndone:
+			allowed = len(undoable) > 0 && last != models.EventUndone && last != models.EventReturnedToWarehouse
+			if allowed {
+				next = undoable[len(undoable)-1]
+				undoable = undoable[:len(undoable)-1]
+			}
+		}
+		if !allowed {
+			return state, &models.ConsistencyIssue{
+				OrderID: rec.OrderID,
+				Kind:    models.IssueImpossibleSequence,
+				Details: fmt.Sprintf("%s at %s is not allowed when the order is %s", h.Event, h.Timestamp.Format(time.RFC3339Nano), state),
+			}
+		}
+		switch h.Event {
+		case models.EventUndone:
+		case models.EventReturnedToWarehouse:
+			undoable = nil
+		default:
+			undoable = append(undoable, state)
+		}
+		state, last = next, h.Event
+	}
+	return state, nil
+}
+
+// stateMismatch describes how the stored order differs from the replayed state, or returns an empty string
+func stateMismatch(rec models.OrderRecord, state replayedState) string {
+	stored := rec.Order != nil && !rec.Removed
+	switch {
+	case state.exists && rec.Order == nil:
+		return fmt.Sprintf("history ends in %s but the order is not stored", state)
+	case state.exists && !stored:
+		return fmt.Sprintf("history ends in %s but the order is removed", state)
+	case !state.exists && stored:
+		return fmt.Sprintf("order is stored as %s but its history ends with the order removed", rec.Order.Status)
+	case state.exists && rec.Order.Status != state.status:
+		return fmt.Sprintf("order is stored as %s but its history ends in %s", rec.Order.Status, state)
+	default:
+		return ""
+	}
+}
+
+// missingOutboxEvents returns the history entries without an outbox event of the same type and time.
+// Times are compared at microsecond precision, the precision history is stored with.
+func missingOutboxEvents(rec models.OrderRecord) []models.HistoryEntry {
+	type eventKey struct {
+		eventType string
+		at        int64
+	}
+	remaining := make(map[eventKey]int, len(rec.OutboxEvents))
+	for _, e := range rec.OutboxEvents {
+		remaining[eventKey{e.EventType, e.Timestamp.UnixMicro()}]++
+	}
+	var missing []models.HistoryEntry
+	for _, h := range rec.History {
+		key := eventKey{models.MapEventTypeToKafkaEvent(h.Event), h.Timestamp.UnixMicro()}
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+		missing = append(missing, h)
+	}
+	return missing
+}
+
+// undoneKafkaEvent names the compensated transition of an UNDONE entry from its metadata, empty for other entries
+func undoneKafkaEvent(h models.HistoryEntry) string {
+	if h.Event != models.EventUndone || len(h.Metadata) == 0 {
+		return ""
+	}
+	var meta struct {
+		UndoneEvent string `json:"undone_event"`
+	}
+	if err := json.Unmarshal(h.Metadata, &meta); err != nil {
+		return ""
+	}
+	for _, e := range []models.EventType{models.EventAccepted, models.EventIssued, models.EventReturnedByClient} {
+		if e.String() == meta.UndoneEvent {
+			return models.MapEventTypeToKafkaEvent(e)
+		}
+	}
+	return ""
+}
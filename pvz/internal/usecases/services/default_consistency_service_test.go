@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type consistencyDeps struct {
+	checkRepo  *mocks.ConsistencyRepositoryMock
+	orderRepo  *mocks.OrderRepositoryMock
+	outboxRepo *mocks.OutboxRepositoryMock
+}
+
+func newConsistencyService(t *testing.T, checkOutbox bool, records ...models.OrderRecord) (*DefaultConsistencyService, consistencyDeps) {
+	deps := consistencyDeps{
+		checkRepo:  mocks.NewConsistencyRepositoryMock(t),
+		orderRepo:  mocks.NewOrderRepositoryMock(t),
+		outboxRepo: mocks.NewOutboxRepositoryMock(t),
+	}
+	deps.checkRepo.ScanMock.Set(func(ctx context.Context, fn func(models.OrderRecord) error) error {
+		for _, rec := range records {
+			if err := fn(rec); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	svc := NewDefaultConsistencyService(db.NewNoOpTxRunner(), deps.checkRepo, deps.orderRepo, deps.outboxRepo, checkOutbox)
+	return svc, deps
+}
+
+func historyOf(orderID uint64, start time.Time, events ...models.EventType) []models.HistoryEntry {
+	history := make([]models.HistoryEntry, 0, len(events))
+	for i, e := range events {
+		history = append(history, models.HistoryEntry{
+			ID:        uint64(i + 1),
+			OrderID:   orderID,
+			Event:     e,
+			Timestamp: start.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	return history
+}
+
+// TestDefaultConsistencyService_Check covers the issues reported for each kind of drift without repair.
+func TestDefaultConsistencyService_Check(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		record   models.OrderRecord
+		wantKind []models.ConsistencyIssueKind
+	}{
+		{
+			name: "consistent order",
+			record: models.OrderRecord{
+				OrderID: 1,
+				Order:   &models.Order{OrderID: 1, Status: models.Issued},
+				History: historyOf(1, start, models.EventAccepted, models.EventIssued),
+			},
+		},
+		{
+			name: "undone issue replays to accepted",
+			record: models.OrderRecord{
+				OrderID: 2,
+				Order:   &models.Order{OrderID: 2, Status: models.Accepted},
+				History: historyOf(2, start, models.EventAccepted, models.EventIssued, models.EventUndone),
+			},
+		},
+		{
+			name: "missing accepted",
+			record: models.OrderRecord{
+				OrderID: 3,
+				Order:   &models.Order{OrderID: 3, Status: models.Issued},
+				History: historyOf(3, start, models.EventIssued),
+			},
+			wantKind: []models.ConsistencyIssueKind{models.IssueMissingAccepted},
+		},
+		{
+			name: "impossible sequence",
+			record: models.OrderRecord{
+				OrderID: 4,
+				Order:   &models.Order{OrderID: 4, Status: models.Returned},
+				History: historyOf(4, start, models.EventAccepted, models.EventReturnedByClient),
+			},
+			wantKind: []models.ConsistencyIssueKind{models.IssueImpossibleSequence},
+		},
+		{
+			name: "status mismatch",
+			record: models.OrderRecord{
+				OrderID: 5,
+				Order:   &models.Order{OrderID: 5, Status: models.Accepted},
+				History: historyOf(5, start, models.EventAccepted, models.EventIssued),
+			},
+			wantKind: []models.ConsistencyIssueKind{models.IssueStatusMismatch},
+		},
+		{
+			name: "stored after return to warehouse",
+			record: models.OrderRecord{
+				OrderID: 6,
+				Order:   &models.Order{OrderID: 6, Status: models.Accepted},
+				History: historyOf(6, start, models.EventAccepted, models.EventReturnedToWarehouse),
+			},
+			wantKind: []models.ConsistencyIssueKind{models.IssueStatusMismatch},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			svc, _ := newConsistencyService(t, false, tt.record)
+
+			report, err := svc.Check(context.Background(), false)
+			require.NoError(t, err)
+			require.Equal(t, 1, report.CheckedOrders)
+			require.Zero(t, report.Repaired)
+			kinds := make([]models.ConsistencyIssueKind, 0, len(report.Issues))
+			for _, issue := range report.Issues {
+				require.Equal(t, tt.record.OrderID, issue.OrderID)
+				require.False(t, issue.Repaired)
+				kinds = append(kinds, issue.Kind)
+			}
+			require.ElementsMatch(t, tt.wantKind, kinds)
+		})
+	}
+}
+
+// TestDefaultConsistencyService_CheckRepair verifies that a mismatched status is restored from history and
+// missing outbox events are enqueued again with the history timestamps.
+func TestDefaultConsistencyService_CheckRepair(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+	history := historyOf(7, start, models.EventAccepted, models.EventIssued)
+	rec := models.OrderRecord{
+		OrderID: 7,
+		Order:   &models.Order{OrderID: 7, UserID: 42, Status: models.Accepted},
+		History: history,
+		OutboxEvents: []models.OutboxEventRef{
+			{EventType: models.MapEventTypeToKafkaEvent(models.EventAccepted), Timestamp: history[0].Timestamp},
+		},
+	}
+	svc, deps := newConsistencyService(t, true, rec)
+	deps.orderRepo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
+		require.Equal(t, models.Issued, order.Status)
+		require.Equal(t, history[1].Timestamp, order.UpdatedStatusAt)
+		return order.Version + 1, nil
+	})
+	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
+		var event models.KafkaEvent
+		require.NoError(t, json.Unmarshal(payload, &event))
+		require.Equal(t, uint64(7), orderID)
+		require.Equal(t, models.MapEventTypeToKafkaEvent(models.EventIssued), event.EventType)
+		require.True(t, history[1].Timestamp.Equal(event.Timestamp))
+		require.Equal(t, models.Issued, event.Order.Status)
+		return nil
+	})
+
+	report, err := svc.Check(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 2)
+	require.Equal(t, 2, report.Repaired)
+	require.Equal(t, models.IssueStatusMismatch, report.Issues[0].Kind)
+	require.Equal(t, models.IssueMissingOutboxEvent, report.Issues[1].Kind)
+	require.Equal(t, uint64(1), deps.orderRepo.SaveAfterCounter())
+	require.Equal(t, uint64(1), deps.outboxRepo.CreateAfterCounter())
+}
+
+// TestDefaultConsistencyService_CheckRepairFailure verifies that a failed repair is reported on the issue
+// instead of stopping the check.
+func TestDefaultConsistencyService_CheckRepairFailure(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+	rec := models.OrderRecord{
+		OrderID: 8,
+		Order:   &models.Order{OrderID: 8, Status: models.Issued},
+		History: historyOf(8, start, models.EventAccepted),
+	}
+	svc, deps := newConsistencyService(t, false, rec)
+	deps.orderRepo.SaveMock.Return(0, errors.New("db down"))
+
+	report, err := svc.Check(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 1)
+	require.False(t, report.Issues[0].Repaired)
+	require.Contains(t, report.Issues[0].Details, "repair failed")
+	require.Zero(t, report.Repaired)
+}
+
+// TestDefaultConsistencyService_CheckScanFailure verifies that storage failures are reported as internal errors.
+func TestDefaultConsistencyService_CheckScanFailure(t *testing.T) {
+	t.Parallel()
+	deps := consistencyDeps{
+		checkRepo:  mocks.NewConsistencyRepositoryMock(t),
+		orderRepo:  mocks.NewOrderRepositoryMock(t),
+		outboxRepo: mocks.NewOutboxRepositoryMock(t),
+	}
+	deps.checkRepo.ScanMock.Return(errors.New("db down"))
+	svc := NewDefaultConsistencyService(db.NewNoOpTxRunner(), deps.checkRepo, deps.orderRepo, deps.outboxRepo, false)
+
+	_, err := svc.Check(context.Background(), false)
+	var ae *apperrors.AppError
+	require.ErrorAs(t, err, &ae)
+	require.Equal(t, apperrors.InternalError, ae.Code)
+}
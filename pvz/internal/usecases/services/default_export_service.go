@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/tabular"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/pkg/clock"
+)
+
+var _ ExportService = (*DefaultExportService)(nil)
+
+// Columns of exported records, in output order
+var (
+	orderExportColumns = []string{
+		"order_id", "user_id", "status", "created_at", "updated_status_at", "expires_at",
+		"package", "weight", "price", "fragile", "hazardous", "age_restricted", "storage_policy",
+	}
+	historyExportColumns = []string{
+		"order_id", "event", "timestamp", "operator_id", "actor_type", "actor_id",
+		"previous_status", "new_status", "correlation_id", "metadata",
+	}
+)
+
+// DefaultExportService is a default implementation of the ExportService interface.
+// Records are read from the repositories one at a time, so an export never holds the whole dataset in memory.
+type DefaultExportService struct {
+	clk         clock.Clock
+	orderRepo   repositories.OrderRepository
+	historyRepo repositories.HistoryRepository
+}
+
+// NewDefaultExportService creates a new instance of DefaultExportService
+func NewDefaultExportService(clk clock.Clock, orderRepo repositories.OrderRepository, historyRepo repositories.HistoryRepository) *DefaultExportService {
+	return &DefaultExportService{clk: clk, orderRepo: orderRepo, historyRepo: historyRepo}
+}
+
+// Export writes every record of the requested dataset that matches the filter to w in the requested format
+func (s *DefaultExportService) Export(ctx context.Context, req requests.ExportRequest, w io.Writer) (int, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	if _, err := tabular.ParseFormat(string(req.Format)); err != nil {
+		return 0, apperrors.Newf(apperrors.ValidationFailed, "%v", err)
+	}
+
+	n := 0
+	var err error
+	switch req.Dataset {
+	case requests.ExportOrders, requests.ExportReturns:
+		filter := req.Orders
+		if req.Dataset == requests.ExportReturns {
+			filter.Status = utils.Ptr(models.Returned)
+			// returns are sorted by return date unless the caller asks otherwise
+			if filter.SortBy == "" {
+				filter.SortBy = requests.SortByUpdatedStatusAt
+			}
+		}
+		if err := validateFilterRanges(filter); err != nil {
+			return 0, err
+		}
+		filter = filter.ResolveExpiringWithin(s.clk.Now())
+		err = s.write(w, req.Format, orderExportColumns, func(out tabular.Writer) error {
+			return s.orderRepo.Stream(ctx, filter, func(o models.Order) error {
+				n++
+				return out.Write(orderExportRecord(o))
+			})
+		})
+	case requests.ExportHistory:
+		err = s.write(w, req.Format, historyExportColumns, func(out tabular.Writer) error {
+			return s.historyRepo.Stream(ctx, req.History, func(e models.HistoryEntry) error {
+				n++
+				return out.Write(historyExportRecord(e))
+			})
+		})
+	default:
+		return 0, apperrors.Newf(apperrors.ValidationFailed, "unknown export dataset %q", req.Dataset)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return n, ctx.Err()
+		}
+		var appErr *apperrors.AppError
+		if errors.As(err, &appErr) {
+			return n, err
+		}
+		return n, apperrors.Newf(apperrors.InternalError, "failed to export %s: %v", req.Dataset, err)
+	}
+	return n, nil
+}
+
+// write creates a writer for the format, lets fill produce the records and flushes the remaining output
+func (s *DefaultExportService) write(w io.Writer, f tabular.Format, columns []string, fill func(tabular.Writer) error) error {
+	out, err := tabular.NewWriter(f, w, columns)
+	if err != nil {
+		return err
+	}
+	if err := fill(out); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+func orderExportRecord(o models.Order) []any {
+	return []any{
+		o.OrderID, o.UserID, o.Status, o.CreatedAt, o.UpdatedStatusAt, o.ExpiresAt,
+		o.Package, o.Weight, o.Price, o.Fragile, o.Hazardous, o.AgeRestricted, o.StoragePolicy,
+	}
+}
+
+func historyExportRecord(e models.HistoryEntry) []any {
+	return []any{
+		e.OrderID, e.Event, e.Timestamp, e.OperatorID, e.ActorType, e.ActorID,
+		historyStatus(e.PreviousStatus), historyStatus(e.NewStatus), e.CorrelationID, e.Metadata,
+	}
+}
+
+// historyStatus leaves the status empty when the order did not exist before or after the event
+func historyStatus(s models.OrderStatus) any {
+	if s == 0 {
+		return nil
+	}
+	return s
+}
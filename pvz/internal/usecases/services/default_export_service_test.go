@@ -0,0 +1,126 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/tabular"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/pkg/clock"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDefaultExportService_Export verifies that returns are exported as CSV rows streamed from the repository.
+func TestDefaultExportService_Export(t *testing.T) {
+	t.Parallel()
+	clk := &clock.FakeClock{}
+	orderRepo := mocks.NewOrderRepositoryMock(t)
+	svc := NewDefaultExportService(clk, orderRepo, mocks.NewHistoryRepositoryMock(t))
+	at := time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC)
+	orderRepo.StreamMock.Set(func(_ context.Context, filter requests.OrdersFilterRequest, fn func(models.Order) error) error {
+		require.Equal(t, models.Returned, *filter.Status)
+		require.Equal(t, requests.SortByUpdatedStatusAt, filter.SortBy)
+		return fn(models.Order{
+			OrderID: 1, UserID: 2, Status: models.Returned, CreatedAt: at, UpdatedStatusAt: at, ExpiresAt: at,
+			Package: models.PackageBox, Weight: 1.5, Price: 100, HandlingFlags: models.HandlingFlags{Fragile: true},
+		})
+	})
+
+	var buf bytes.Buffer
+	n, err := svc.Export(context.Background(), requests.ExportRequest{Dataset: requests.ExportReturns, Format: tabular.CSV}, &buf)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, strings.Join(orderExportColumns, ","), lines[0])
+	require.Equal(t, "1,2,RETURNED,2025-07-01T10:00:00Z,2025-07-01T10:00:00Z,2025-07-01T10:00:00Z,box,1.5,100,true,false,false,", lines[1])
+}
+
+// TestDefaultExportService_ExportHistory verifies that history entries are exported as NDJSON lines.
+func TestDefaultExportService_ExportHistory(t *testing.T) {
+	t.Parallel()
+	historyRepo := mocks.NewHistoryRepositoryMock(t)
+	svc := NewDefaultExportService(&clock.FakeClock{}, mocks.NewOrderRepositoryMock(t), historyRepo)
+	at := time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC)
+	historyRepo.StreamMock.Set(func(_ context.Context, _ requests.OrderHistoryFilter, fn func(models.HistoryEntry) error) error {
+		return fn(models.HistoryEntry{
+			OrderID:        1,
+			Event:          models.EventIssued,
+			Timestamp:      at,
+			OperatorID:     "op-1",
+			ActorType:      models.ActorClient,
+			ActorID:        42,
+			PreviousStatus: models.Accepted,
+			NewStatus:      models.Issued,
+			CorrelationID:  "corr-1",
+			Metadata:       json.RawMessage(`{"note":"ok"}`),
+		})
+	})
+
+	var buf bytes.Buffer
+	n, err := svc.Export(context.Background(), requests.ExportRequest{Dataset: requests.ExportHistory, Format: tabular.NDJSON}, &buf)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, `{"order_id":1,"event":"ISSUED","timestamp":"2025-07-01T10:00:00Z","operator_id":"op-1",`+
+		`"actor_type":"client","actor_id":42,"previous_status":"ACCEPTED","new_status":"ISSUED",`+
+		`"correlation_id":"corr-1","metadata":{"note":"ok"}}`+"\n", buf.String())
+}
+
+// TestDefaultExportService_Failures covers invalid requests and repository failures.
+func TestDefaultExportService_Failures(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		req      requests.ExportRequest
+		setup    func(repo *mocks.OrderRepositoryMock)
+		wantCode apperrors.ErrorCode
+	}{
+		{
+			name:     "unknown format",
+			req:      requests.ExportRequest{Dataset: requests.ExportOrders, Format: "xlsx"},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name:     "unknown dataset",
+			req:      requests.ExportRequest{Dataset: "users", Format: tabular.CSV},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name: "inverted price range",
+			req: requests.ExportRequest{Dataset: requests.ExportOrders, Format: tabular.CSV, Orders: requests.OrdersFilterRequest{
+				PriceMin: utils.Ptr(float32(200)), PriceMax: utils.Ptr(float32(100)),
+			}},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name: "repository failure",
+			req:  requests.ExportRequest{Dataset: requests.ExportOrders, Format: tabular.CSV},
+			setup: func(repo *mocks.OrderRepositoryMock) {
+				repo.StreamMock.Return(errors.New("db down"))
+			},
+			wantCode: apperrors.InternalError,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewOrderRepositoryMock(t)
+			if tc.setup != nil {
+				tc.setup(repo)
+			}
+			svc := NewDefaultExportService(&clock.FakeClock{}, repo, mocks.NewHistoryRepositoryMock(t))
+			_, err := svc.Export(context.Background(), tc.req, &bytes.Buffer{})
+			var ae *apperrors.AppError
+			require.ErrorAs(t, err, &ae)
+			require.Equal(t, tc.wantCode, ae.Code)
+		})
+	}
+}
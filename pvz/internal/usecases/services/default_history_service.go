@@ -41,7 +41,8 @@ func (s *DefaultHistoryService) List(ctx context.Context, filter requests.OrderH
 	if err != nil {
 		return nil, apperrors.Newf(apperrors.InternalError, "failed to load history list: %v", err)
 	}
-	if count == 0 && filter.OrderID != nil {
+	// a narrowed filter may legitimately match no entries of an existing order
+	if count == 0 && filter.OrderID != nil && !filter.Narrowed() {
 		return nil, apperrors.Newf(apperrors.OrderNotFound, "order %d not found", *filter.OrderID)
 	}
 	return entries, nil
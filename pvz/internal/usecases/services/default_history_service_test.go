@@ -79,6 +79,16 @@ func TestDefaultHistoryService_List(t *testing.T) {
 			mockCount:   0,
 			wantErrCode: utils.Ptr(apperrors.OrderNotFound),
 		},
+		{
+			name: "narrowed filter without matches returns empty",
+			filter: requests.OrderHistoryFilter{
+				OrderID: utils.Ptr(uint64(52)),
+				Events:  []models.EventType{models.EventIssued},
+			},
+			mockReturn:  nil,
+			mockCount:   0,
+			wantEntries: []models.HistoryEntry(nil),
+		},
 		{
 			name:        "db error",
 			filter:      requests.OrderHistoryFilter{OrderID: utils.Ptr(uint64(52))},
@@ -0,0 +1,426 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/common/correlation"
+	"pvz-cli/internal/common/operator"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/pkg/clock"
+	"strings"
+	"sync"
+	"time"
+)
+
+var _ ImportJobService = (*DefaultImportJobService)(nil)
+
+// interruptedReason is recorded for jobs left unfinished by a previous run of the server
+const interruptedReason = "interrupted by a server restart"
+
+// DefaultImportJobService queues submitted imports in memory and runs them on a fixed number of runners.
+// A runner hands the orders of a job to OrderService chunk by chunk, so they are accepted on the worker pool,
+// and persists the progress and the failed orders after every chunk.
+type DefaultImportJobService struct {
+	clk          clock.Clock
+	repo         repositories.ImportJobRepository
+	orderSvc     OrderService
+	workers      int
+	chunkSize    int
+	pollInterval time.Duration
+	// atomicBatches tells whether the storage can apply an atomic import, see DefaultOrderService
+	atomicBatches bool
+	// onImported is told about imported orders, e.g. to drop cached responses they made stale; may be nil
+	onImported func(orderIDs []uint64)
+	// instanceID marks the jobs queued by this server, so a restart fails only its own unfinished jobs
+	// and leaves the ones run by other servers sharing the storage
+	instanceID string
+	// startedAt separates jobs of this process from the ones interrupted by a restart
+	startedAt time.Time
+	queue     chan uint64
+
+	mu   sync.Mutex
+	jobs map[uint64]*importJobHandle
+	// queued counts the queue slots taken by submitted jobs, including the ones still being saved,
+	// so a full queue is detected before a job is stored
+	queued int
+}
+
+// importJobHandle is the in-memory part of a queued or running job
+type importJobHandle struct {
+	job models.ImportJob
+	req requests.ImportOrdersRequest
+	// correlationID is the one of the submitting call, carried into the orders imported by the job
+	correlationID string
+	started       bool
+	// cancelled is set when the job is stopped by Cancel rather than by a shutdown
+	cancelled bool
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewDefaultImportJobService creates a new instance of DefaultImportJobService
+func NewDefaultImportJobService(
+	clk clock.Clock,
+	repo repositories.ImportJobRepository,
+	orderSvc OrderService,
+	workers int,
+	queueSize int,
+	chunkSize int,
+	atomicBatches bool,
+	onImported func(orderIDs []uint64),
+	instanceID string,
+) *DefaultImportJobService {
+	return &DefaultImportJobService{
+		clk:           clk,
+		repo:          repo,
+		orderSvc:      orderSvc,
+		workers:       workers,
+		chunkSize:     chunkSize,
+		pollInterval:  constants.ImportJobPollInterval,
+		atomicBatches: atomicBatches,
+		onImported:    onImported,
+		instanceID:    instanceID,
+		startedAt:     clk.Now(),
+		queue:         make(chan uint64, queueSize),
+		jobs:          make(map[uint64]*importJobHandle),
+	}
+}
+
+// Submit persists a new queued job for the import and returns it without waiting for any order to be imported.
+// A queue slot is taken before the job is saved, so a full queue stores nothing.
+func (s *DefaultImportJobService) Submit(ctx context.Context, req requests.ImportOrdersRequest) (models.ImportJob, error) {
+	if ctx.Err() != nil {
+		return models.ImportJob{}, ctx.Err()
+	}
+	if req.ValidateOnly {
+		return models.ImportJob{}, apperrors.Newf(apperrors.ValidationFailed, "a dry run cannot be submitted as an import job")
+	}
+	if len(req.Statuses) == 0 {
+		return models.ImportJob{}, apperrors.Newf(apperrors.ValidationFailed, "import has no orders")
+	}
+	if req.Atomic && !s.atomicBatches {
+		return models.ImportJob{}, apperrors.Newf(apperrors.AtomicNotSupported, "atomic imports are not supported by the file storage")
+	}
+	if !s.takeQueueSlot() {
+		return models.ImportJob{}, apperrors.Newf(apperrors.ImportQueueFull, "import queue is full, %d jobs are waiting", cap(s.queue))
+	}
+	id, err := utils.GenerateID()
+	if err != nil {
+		s.releaseQueueSlot()
+		return models.ImportJob{}, apperrors.Newf(apperrors.InternalError, "failed to generate import job id: %v", err)
+	}
+
+	now := s.clk.Now()
+	job := models.ImportJob{
+		JobID:      id,
+		Status:     models.ImportJobQueued,
+		Atomic:     req.Atomic,
+		Total:      len(req.Statuses),
+		OperatorID: operator.IDFromContext(ctx),
+		InstanceID: s.instanceID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.repo.Save(ctx, job); err != nil {
+		s.releaseQueueSlot()
+		return models.ImportJob{}, apperrors.Newf(apperrors.InternalError, "failed to save import job: %v", err)
+	}
+
+	s.mu.Lock()
+	s.jobs[id] = &importJobHandle{job: job, req: req, correlationID: correlation.IDFromContext(ctx), done: make(chan struct{})}
+	s.mu.Unlock()
+	// the slot taken above guarantees room in the queue
+	s.queue <- id
+	return job, nil
+}
+
+// takeQueueSlot reserves room in the queue for a job about to be submitted and reports false when the queue is full
+func (s *DefaultImportJobService) takeQueueSlot() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.queued >= cap(s.queue) {
+		return false
+	}
+	s.queued++
+	return true
+}
+
+// releaseQueueSlot frees the room of a job that was dequeued or never made it into the queue
+func (s *DefaultImportJobService) releaseQueueSlot() {
+	s.mu.Lock()
+	s.queued--
+	s.mu.Unlock()
+}
+
+// Get returns the current state of an import job
+func (s *DefaultImportJobService) Get(ctx context.Context, jobID uint64) (models.ImportJob, error) {
+	if ctx.Err() != nil {
+		return models.ImportJob{}, ctx.Err()
+	}
+	job, err := s.repo.Load(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrImportJobNotFound) {
+			return models.ImportJob{}, apperrors.Newf(apperrors.ImportJobNotFound, "import job %d not found", jobID)
+		}
+		return models.ImportJob{}, apperrors.Newf(apperrors.InternalError, "failed to load import job %d: %v", jobID, err)
+	}
+	return job, nil
+}
+
+// Watch polls the job and reports every change of its state, starting with the current one
+func (s *DefaultImportJobService) Watch(ctx context.Context, jobID uint64, fn func(models.ImportJob) error) error {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	var last *models.ImportJob
+	for {
+		job, err := s.Get(ctx, jobID)
+		if err != nil {
+			return err
+		}
+		if last == nil || importJobChanged(*last, job) {
+			if err := fn(job); err != nil {
+				return err
+			}
+			last = &job
+		}
+		if job.Status.Finished() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func importJobChanged(prev, cur models.ImportJob) bool {
+	return prev.Status != cur.Status || prev.Processed != cur.Processed || !prev.UpdatedAt.Equal(cur.UpdatedAt)
+}
+
+// ListFailures returns the orders the job failed to import so far, in the order of the import file
+func (s *DefaultImportJobService) ListFailures(ctx context.Context, jobID uint64) ([]models.ImportJobFailure, error) {
+	if _, err := s.Get(ctx, jobID); err != nil {
+		return nil, err
+	}
+	failures, err := s.repo.ListFailures(ctx, jobID)
+	if err != nil {
+		return nil, apperrors.Newf(apperrors.InternalError, "failed to list failures of import job %d: %v", jobID, err)
+	}
+	return failures, nil
+}
+
+// Cancel drops a queued job or stops a running one after the orders already handed to the worker pool.
+// Orders imported before the cancellation stay imported, unless the job is atomic.
+func (s *DefaultImportJobService) Cancel(ctx context.Context, jobID uint64) (models.ImportJob, error) {
+	if ctx.Err() != nil {
+		return models.ImportJob{}, ctx.Err()
+	}
+	s.mu.Lock()
+	h, ok := s.jobs[jobID]
+	if !ok {
+		s.mu.Unlock()
+		job, err := s.Get(ctx, jobID)
+		if err != nil {
+			return models.ImportJob{}, err
+		}
+		if job.Status.Finished() {
+			return models.ImportJob{}, apperrors.Newf(apperrors.ImportJobNotCancellable,
+				"import job %d is already %s", jobID, strings.ToLower(job.Status.String()))
+		}
+		return models.ImportJob{}, apperrors.Newf(apperrors.ImportJobNotCancellable, "import job %d is not run by this server", jobID)
+	}
+	if !h.started {
+		delete(s.jobs, jobID)
+		s.mu.Unlock()
+		job := h.job
+		if err := s.finish(ctx, &job, models.ImportJobCancelled, ""); err != nil {
+			return models.ImportJob{}, apperrors.Newf(apperrors.InternalError, "failed to save import job %d: %v", jobID, err)
+		}
+		return job, nil
+	}
+	h.cancelled = true
+	h.cancel()
+	s.mu.Unlock()
+
+	select {
+	case <-h.done:
+	case <-ctx.Done():
+		return models.ImportJob{}, ctx.Err()
+	}
+	return s.Get(ctx, jobID)
+}
+
+// Run fails the jobs of this instance interrupted by a previous shutdown and then processes queued jobs until ctx
+// is canceled. Jobs still queued at shutdown are failed on the next start of the same instance.
+func (s *DefaultImportJobService) Run(ctx context.Context) error {
+	if n, err := s.repo.FailUnfinished(ctx, s.instanceID, s.startedAt, interruptedReason, s.clk.Now()); err != nil {
+		slog.Error("failed to fail interrupted import jobs", "error", err)
+	} else if n > 0 {
+		slog.Warn("import jobs interrupted by a restart marked as failed", "count", n)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case id := <-s.queue:
+					s.releaseQueueSlot()
+					s.process(ctx, id)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// process runs a single job; ctx is the lifetime of the runner, the job itself can also be canceled by Cancel
+func (s *DefaultImportJobService) process(ctx context.Context, jobID uint64) {
+	s.mu.Lock()
+	h, ok := s.jobs[jobID]
+	if !ok {
+		// cancelled while queued
+		s.mu.Unlock()
+		return
+	}
+	jobCtx, cancel := context.WithCancel(correlation.WithID(operator.WithID(ctx, h.job.OperatorID), h.correlationID))
+	h.started = true
+	h.cancel = cancel
+	s.mu.Unlock()
+	defer func() {
+		cancel()
+		s.mu.Lock()
+		delete(s.jobs, jobID)
+		s.mu.Unlock()
+		close(h.done)
+	}()
+
+	job := &h.job
+	job.Status = models.ImportJobRunning
+	job.UpdatedAt = s.clk.Now()
+	if err := s.repo.Save(context.WithoutCancel(ctx), *job); err != nil {
+		slog.Error("failed to save import job", "job_id", jobID, "error", err)
+	}
+
+	completed, err := s.importChunks(jobCtx, job, h.req)
+	s.mu.Lock()
+	cancelled := h.cancelled
+	s.mu.Unlock()
+	switch {
+	case err != nil:
+		_ = s.finish(ctx, job, models.ImportJobFailed, err.Error())
+	case completed:
+		_ = s.finish(ctx, job, models.ImportJobCompleted, "")
+	case cancelled:
+		_ = s.finish(ctx, job, models.ImportJobCancelled, "")
+	default:
+		_ = s.finish(ctx, job, models.ImportJobFailed, "interrupted by a server shutdown")
+	}
+}
+
+// importChunks imports the orders of the job chunk by chunk and reports whether all of them were processed
+// before ctx was canceled. An atomic job is imported as a single chunk, as it must be applied all at once.
+func (s *DefaultImportJobService) importChunks(ctx context.Context, job *models.ImportJob, req requests.ImportOrdersRequest) (bool, error) {
+	saveCtx := context.WithoutCancel(ctx)
+	chunkSize := s.chunkSize
+	if req.Atomic {
+		chunkSize = len(req.Statuses)
+	}
+	for start := 0; start < len(req.Statuses); start += chunkSize {
+		if ctx.Err() != nil {
+			return false, nil
+		}
+		end := min(start+chunkSize, len(req.Statuses))
+		// the orders were handed over when the job was submitted, so the opening hours are checked at that moment
+		chunk := requests.ImportOrdersRequest{Statuses: req.Statuses[start:end], Atomic: req.Atomic, SubmittedAt: job.CreatedAt}
+		results, err := s.orderSvc.ImportOrders(ctx, chunk)
+		if err != nil {
+			if ctx.Err() != nil {
+				return false, nil
+			}
+			return false, err
+		}
+
+		// once the job is canceled, a failed order may only have been cut short,
+		// so the failures of the interrupted chunk are left unprocessed
+		interrupted := ctx.Err() != nil
+		var (
+			failures []models.ImportJobFailure
+			imported []uint64
+		)
+		for i, r := range results {
+			st := chunk.Statuses[i]
+			orderID := r.OrderID
+			if orderID == 0 {
+				orderID = st.OrderID
+			}
+			if r.Error == nil {
+				imported = append(imported, orderID)
+				continue
+			}
+			if interrupted {
+				continue
+			}
+			item := st.ItemNumber
+			if item == 0 {
+				item = start + i + 1
+			}
+			failure := models.ImportJobFailure{
+				JobID:      job.JobID,
+				ItemNumber: item,
+				OrderID:    orderID,
+				Code:       apperrors.CodeFromError(r.Error),
+				Reason:     r.Error.Error(),
+			}
+			if st.Request != nil {
+				failure.UserID = st.Request.UserID
+			}
+			failures = append(failures, failure)
+		}
+		if len(imported) > 0 && s.onImported != nil {
+			s.onImported(imported)
+		}
+		if len(failures) > 0 {
+			if err := s.repo.SaveFailures(saveCtx, failures); err != nil {
+				return false, apperrors.Newf(apperrors.InternalError, "failed to save import failures: %v", err)
+			}
+		}
+		job.Processed += len(imported) + len(failures)
+		job.Imported += len(imported)
+		job.Failed += len(failures)
+		job.UpdatedAt = s.clk.Now()
+		if err := s.repo.Save(saveCtx, *job); err != nil {
+			return false, apperrors.Newf(apperrors.InternalError, "failed to save import job progress: %v", err)
+		}
+		if interrupted {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// finish moves the job into a final status and persists it even if ctx is already canceled
+func (s *DefaultImportJobService) finish(ctx context.Context, job *models.ImportJob, status models.ImportJobStatus, reason string) error {
+	now := s.clk.Now()
+	job.Status = status
+	job.Error = reason
+	job.UpdatedAt = now
+	job.FinishedAt = &now
+	err := s.repo.Save(context.WithoutCancel(ctx), *job)
+	if err != nil {
+		slog.Error("failed to save import job", "job_id", job.JobID, "status", status.String(), "error", err)
+	}
+	return err
+}
@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/correlation"
+	"pvz-cli/internal/data/repositories"
+	repmocks "pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	svcmocks "pvz-cli/internal/usecases/services/mocks"
+	"pvz-cli/pkg/clock"
+	"sync"
+	"testing"
+	"time"
+)
+
+// importJobStore backs the repository mock with a map, so a test can observe the saved job states
+type importJobStore struct {
+	mu       sync.Mutex
+	jobs     map[uint64]models.ImportJob
+	failures []models.ImportJobFailure
+	finished chan models.ImportJob
+	// interruptedOf lists the instances whose unfinished jobs were failed on start
+	interruptedOf []string
+}
+
+func newImportJobRepo(t *testing.T) (*repmocks.ImportJobRepositoryMock, *importJobStore) {
+	store := &importJobStore{jobs: make(map[uint64]models.ImportJob), finished: make(chan models.ImportJob, 10)}
+	repo := repmocks.NewImportJobRepositoryMock(t)
+	repo.SaveMock.Optional().Set(func(_ context.Context, job models.ImportJob) error {
+		store.mu.Lock()
+		store.jobs[job.JobID] = job
+		store.mu.Unlock()
+		if job.Status.Finished() {
+			store.finished <- job
+		}
+		return nil
+	})
+	repo.LoadMock.Optional().Set(func(_ context.Context, id uint64) (models.ImportJob, error) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		job, ok := store.jobs[id]
+		if !ok {
+			return models.ImportJob{}, repositories.ErrImportJobNotFound
+		}
+		return job, nil
+	})
+	repo.SaveFailuresMock.Optional().Set(func(_ context.Context, failures []models.ImportJobFailure) error {
+		store.mu.Lock()
+		store.failures = append(store.failures, failures...)
+		store.mu.Unlock()
+		return nil
+	})
+	repo.ListFailuresMock.Optional().Set(func(_ context.Context, jobID uint64) ([]models.ImportJobFailure, error) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		var out []models.ImportJobFailure
+		for _, f := range store.failures {
+			if f.JobID == jobID {
+				out = append(out, f)
+			}
+		}
+		return out, nil
+	})
+	repo.FailUnfinishedMock.Optional().Set(func(_ context.Context, instanceID string, _ time.Time, _ string, _ time.Time) (int, error) {
+		store.mu.Lock()
+		store.interruptedOf = append(store.interruptedOf, instanceID)
+		store.mu.Unlock()
+		return 0, nil
+	})
+	return repo, store
+}
+
+func importStatuses(ids ...uint64) []requests.ImportOrderStatus {
+	statuses := make([]requests.ImportOrderStatus, len(ids))
+	for i, id := range ids {
+		statuses[i] = requests.ImportOrderStatus{
+			ItemNumber: i + 1,
+			OrderID:    id,
+			Request:    &requests.AcceptOrderRequest{OrderID: id, UserID: 100 + id},
+		}
+	}
+	return statuses
+}
+
+func waitFinished(t *testing.T, store *importJobStore) models.ImportJob {
+	t.Helper()
+	select {
+	case job := <-store.finished:
+		return job
+	case <-time.After(5 * time.Second):
+		t.Fatal("import job did not finish")
+		return models.ImportJob{}
+	}
+}
+
+// TestDefaultImportJobService_Run verifies that a job is imported chunk by chunk with its progress and failures persisted.
+func TestDefaultImportJobService_Run(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	repo, store := newImportJobRepo(t)
+	orderSvc := svcmocks.NewOrderServiceMock(t)
+	// submittedAt and correlationIDs collect what every chunk is passed with, only one runner calls the mock
+	var (
+		submittedAt    []time.Time
+		correlationIDs []string
+	)
+	orderSvc.ImportOrdersMock.Set(func(ctx context.Context, req requests.ImportOrdersRequest) ([]models.BatchEntryProcessedResult, error) {
+		submittedAt = append(submittedAt, req.SubmittedAt)
+		correlationIDs = append(correlationIDs, correlation.IDFromContext(ctx))
+		results := make([]models.BatchEntryProcessedResult, len(req.Statuses))
+		for i, st := range req.Statuses {
+			results[i].OrderID = st.OrderID
+			if st.OrderID == 3 {
+				results[i].Error = apperrors.Newf(apperrors.OrderAlreadyExists, "order 3 already exists")
+			}
+		}
+		return results, nil
+	})
+	var (
+		importedMu sync.Mutex
+		imported   []uint64
+	)
+	svc := NewDefaultImportJobService(&clock.FakeClock{}, repo, orderSvc, 1, 4, 2, true, func(ids []uint64) {
+		importedMu.Lock()
+		imported = append(imported, ids...)
+		importedMu.Unlock()
+	}, "pvz-1")
+	go func() { _ = svc.Run(ctx) }()
+
+	job, err := svc.Submit(correlation.WithID(ctx, "corr-1"), requests.ImportOrdersRequest{Statuses: importStatuses(1, 2, 3, 4, 5)})
+	require.NoError(t, err)
+	require.Equal(t, models.ImportJobQueued, job.Status)
+	require.Equal(t, 5, job.Total)
+	require.Equal(t, "pvz-1", job.InstanceID)
+
+	done := waitFinished(t, store)
+	require.Equal(t, job.JobID, done.JobID)
+	require.Equal(t, models.ImportJobCompleted, done.Status)
+	require.Equal(t, 5, done.Processed)
+	require.Equal(t, 4, done.Imported)
+	require.Equal(t, 1, done.Failed)
+	require.NotNil(t, done.FinishedAt)
+	require.Len(t, orderSvc.ImportOrdersMock.Calls(), 3)
+	require.Equal(t, []time.Time{job.CreatedAt, job.CreatedAt, job.CreatedAt}, submittedAt)
+	require.Equal(t, []string{"corr-1", "corr-1", "corr-1"}, correlationIDs)
+	store.mu.Lock()
+	require.Equal(t, []string{"pvz-1"}, store.interruptedOf)
+	store.mu.Unlock()
+
+	failures, err := svc.ListFailures(ctx, job.JobID)
+	require.NoError(t, err)
+	require.Equal(t, []models.ImportJobFailure{{
+		JobID:      job.JobID,
+		ItemNumber: 3,
+		OrderID:    3,
+		UserID:     103,
+		Code:       string(apperrors.OrderAlreadyExists),
+		Reason:     "ORDER_ALREADY_EXISTS: order 3 already exists",
+	}}, failures)
+	importedMu.Lock()
+	require.ElementsMatch(t, []uint64{1, 2, 4, 5}, imported)
+	importedMu.Unlock()
+}
+
+// TestDefaultImportJobService_Cancel covers cancelling queued, running and finished jobs.
+func TestDefaultImportJobService_Cancel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("queued job is never started", func(t *testing.T) {
+		t.Parallel()
+		ctx := context.Background()
+		repo, _ := newImportJobRepo(t)
+		svc := NewDefaultImportJobService(&clock.FakeClock{}, repo, svcmocks.NewOrderServiceMock(t), 1, 4, 2, true, nil, "pvz-1")
+
+		job, err := svc.Submit(ctx, requests.ImportOrdersRequest{Statuses: importStatuses(1, 2)})
+		require.NoError(t, err)
+		cancelled, err := svc.Cancel(ctx, job.JobID)
+		require.NoError(t, err)
+		require.Equal(t, models.ImportJobCancelled, cancelled.Status)
+
+		// the runner finds the job cancelled and skips it, so the order service is never called
+		svc.process(ctx, job.JobID)
+		_, err = svc.Cancel(ctx, job.JobID)
+		require.Equal(t, string(apperrors.ImportJobNotCancellable), apperrors.CodeFromError(err))
+	})
+
+	t.Run("running job stops after the current chunk", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		repo, store := newImportJobRepo(t)
+		orderSvc := svcmocks.NewOrderServiceMock(t)
+		started := make(chan struct{})
+		release := make(chan struct{})
+		orderSvc.ImportOrdersMock.Set(func(_ context.Context, req requests.ImportOrdersRequest) ([]models.BatchEntryProcessedResult, error) {
+			close(started)
+			<-release
+			results := make([]models.BatchEntryProcessedResult, len(req.Statuses))
+			for i, st := range req.Statuses {
+				results[i].OrderID = st.OrderID
+			}
+			return results, nil
+		})
+		svc := NewDefaultImportJobService(&clock.FakeClock{}, repo, orderSvc, 1, 4, 2, true, nil, "pvz-1")
+		go func() { _ = svc.Run(ctx) }()
+
+		job, err := svc.Submit(ctx, requests.ImportOrdersRequest{Statuses: importStatuses(1, 2, 3, 4)})
+		require.NoError(t, err)
+		<-started
+
+		type cancelResult struct {
+			job models.ImportJob
+			err error
+		}
+		res := make(chan cancelResult, 1)
+		go func() {
+			j, err := svc.Cancel(ctx, job.JobID)
+			res <- cancelResult{j, err}
+		}()
+		// the first chunk completes, the second one is never started
+		require.Eventually(t, func() bool {
+			svc.mu.Lock()
+			defer svc.mu.Unlock()
+			return svc.jobs[job.JobID].cancelled
+		}, time.Second, 10*time.Millisecond)
+		close(release)
+
+		r := <-res
+		require.NoError(t, r.err)
+		require.Equal(t, models.ImportJobCancelled, r.job.Status)
+		require.Equal(t, 2, r.job.Imported)
+		require.Equal(t, models.ImportJobCancelled, waitFinished(t, store).Status)
+		require.Len(t, orderSvc.ImportOrdersMock.Calls(), 1)
+	})
+}
+
+// TestDefaultImportJobService_Failures verifies rejected submissions and unknown jobs.
+func TestDefaultImportJobService_Failures(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo, store := newImportJobRepo(t)
+	svc := NewDefaultImportJobService(&clock.FakeClock{}, repo, svcmocks.NewOrderServiceMock(t), 1, 1, 2, true, nil, "pvz-1")
+
+	_, err := svc.Submit(ctx, requests.ImportOrdersRequest{Statuses: importStatuses(1), ValidateOnly: true})
+	require.Equal(t, string(apperrors.ValidationFailed), apperrors.CodeFromError(err))
+
+	_, err = svc.Get(ctx, 42)
+	require.Equal(t, string(apperrors.ImportJobNotFound), apperrors.CodeFromError(err))
+
+	// an atomic job is rejected before it is saved when the storage cannot apply it, so the repository is never called
+	fileSvc := NewDefaultImportJobService(&clock.FakeClock{}, repmocks.NewImportJobRepositoryMock(t), svcmocks.NewOrderServiceMock(t), 1, 1, 2, false, nil, "pvz-1")
+	_, err = fileSvc.Submit(ctx, requests.ImportOrdersRequest{Statuses: importStatuses(1), Atomic: true})
+	require.Equal(t, string(apperrors.AtomicNotSupported), apperrors.CodeFromError(err))
+
+	// no runner is started, so the second job does not fit into the queue of one
+	_, err = svc.Submit(ctx, requests.ImportOrdersRequest{Statuses: importStatuses(1)})
+	require.NoError(t, err)
+	_, err = svc.Submit(ctx, requests.ImportOrdersRequest{Statuses: importStatuses(2)})
+	require.Equal(t, string(apperrors.ImportQueueFull), apperrors.CodeFromError(err))
+	// the rejected job is not stored, so no failed job without an ID handed to the caller is left behind
+	store.mu.Lock()
+	require.Len(t, store.jobs, 1)
+	store.mu.Unlock()
+}
+
+// TestDefaultImportJobService_SubmitSaveFails verifies that a job that could not be saved gives its queue slot back.
+func TestDefaultImportJobService_SubmitSaveFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := repmocks.NewImportJobRepositoryMock(t)
+	saves := 0
+	repo.SaveMock.Set(func(context.Context, models.ImportJob) error {
+		saves++
+		if saves == 1 {
+			return errors.New("db down")
+		}
+		return nil
+	})
+	svc := NewDefaultImportJobService(&clock.FakeClock{}, repo, svcmocks.NewOrderServiceMock(t), 1, 1, 2, true, nil, "pvz-1")
+
+	_, err := svc.Submit(ctx, requests.ImportOrdersRequest{Statuses: importStatuses(1)})
+	require.Equal(t, string(apperrors.InternalError), apperrors.CodeFromError(err))
+
+	job, err := svc.Submit(ctx, requests.ImportOrdersRequest{Statuses: importStatuses(1)})
+	require.NoError(t, err)
+	require.Equal(t, models.ImportJobQueued, job.Status)
+}
@@ -3,17 +3,23 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/jackc/pgx/v5"
 	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/common/correlation"
+	"pvz-cli/internal/common/operator"
 	"pvz-cli/internal/common/utils"
 	"pvz-cli/internal/data/repositories"
 	"pvz-cli/internal/infrastructure/db"
 	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/services/strategies"
 	"pvz-cli/internal/usecases/services/validators"
 	"pvz-cli/internal/workerpool"
 	"pvz-cli/pkg/clock"
 	"sync"
+	"time"
 )
 
 const SourceName = "pvz-api"
@@ -30,6 +36,10 @@ type DefaultOrderService struct {
 	packagePricingSvc PackagePricingService
 	historySvc        HistoryService
 	actorSvc          ActorService
+	calendarSvc       CalendarService
+	storagePolicy     strategies.StoragePolicyStrategy
+	undoGracePeriod   time.Duration
+	atomicBatches     bool
 	validator         validators.OrderValidator
 }
 
@@ -43,6 +53,10 @@ func NewDefaultOrderService(
 	packagePricingService PackagePricingService,
 	historyService HistoryService,
 	actorSvc ActorService,
+	calendarSvc CalendarService,
+	storagePolicy strategies.StoragePolicyStrategy,
+	undoGracePeriod time.Duration,
+	atomicBatches bool,
 	validator validators.OrderValidator) *DefaultOrderService {
 	return &DefaultOrderService{
 		clk:               clk,
@@ -53,6 +67,10 @@ func NewDefaultOrderService(
 		packagePricingSvc: packagePricingService,
 		historySvc:        historyService,
 		actorSvc:          actorSvc,
+		calendarSvc:       calendarSvc,
+		storagePolicy:     storagePolicy,
+		undoGracePeriod:   undoGracePeriod,
+		atomicBatches:     atomicBatches,
 		validator:         validator,
 	}
 }
@@ -62,23 +80,61 @@ func (s *DefaultOrderService) AcceptOrder(ctx context.Context, req requests.Acce
 	if ctx.Err() != nil {
 		return models.Order{}, ctx.Err()
 	}
+	m, err := s.prepareAccept(ctx, req)
+	if err != nil {
+		return models.Order{}, err
+	}
+	err = s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
+		version, err := s.applyMutation(ctxWithTx(ctx, tx), m)
+		m.order.Version = version
+		return err
+	})
+	if err != nil {
+		return models.Order{}, err
+	}
+	return m.order, nil
+}
+
+// prepareAccept validates and prices an incoming order and builds its accepted state, event and history entry
+func (s *DefaultOrderService) prepareAccept(ctx context.Context, req requests.AcceptOrderRequest) (orderMutation, error) {
+	order, err := s.evaluateAccept(ctx, req)
+	if err != nil {
+		return orderMutation{}, err
+	}
+	return s.buildMutation(ctx, order, 0, models.EventAccepted, order.CreatedAt, map[string]any{
+		"package":        order.Package.String(),
+		"weight":         order.Weight,
+		"price":          order.Price,
+		"storage_policy": order.StoragePolicy,
+	})
+}
+
+// evaluateAccept validates and prices an incoming order and returns the order it would become, without side effects
+func (s *DefaultOrderService) evaluateAccept(ctx context.Context, req requests.AcceptOrderRequest) (models.Order, error) {
 	existing, err := s.orderRepo.Load(ctx, req.OrderID)
 	if err != nil {
 		existing = models.Order{}
 	}
 
+	now := s.clk.Now()
+	policy := models.StoragePolicy{Name: models.StoragePolicyManual}
+	if req.ExpiresAt.IsZero() {
+		policy = s.storagePolicy.Resolve(req.Package, req.Handling)
+		req.ExpiresAt = now.AddDate(0, 0, policy.Days)
+	}
+	// the limits apply to the date the order is actually kept until, which business days may push further
+	req.ExpiresAt = s.calendarSvc.Current().StorageExpiry(now, req.ExpiresAt)
+
 	if err := s.validator.ValidateAccept(existing, req); err != nil {
 		return models.Order{}, err
 	}
 
-	totalPrice, err := s.packagePricingSvc.Evaluate(req.Package, req.Weight, req.Price)
+	totalPrice, err := s.packagePricingSvc.Evaluate(req.Package, req.Weight, req.Price, req.Handling)
 	if err != nil {
 		return models.Order{}, err
 	}
 
-	now := s.clk.Now()
-
-	order := models.Order{
+	return models.Order{
 		OrderID:         req.OrderID,
 		UserID:          req.UserID,
 		CreatedAt:       now,
@@ -88,142 +144,116 @@ func (s *DefaultOrderService) AcceptOrder(ctx context.Context, req requests.Acce
 		Weight:          req.Weight,
 		Price:           totalPrice,
 		Package:         req.Package,
-	}
+		StoragePolicy:   policy.Name,
+		HandlingFlags:   req.Handling,
+	}, nil
+}
 
-	actor, err := s.actorSvc.DetermineActor(ctx, models.EventAccepted, order.UserID)
-	if err != nil {
-		return models.Order{}, err
+// IssueOrders processes multiple orders for issuance to clients
+func (s *DefaultOrderService) IssueOrders(
+	ctx context.Context,
+	req requests.IssueOrdersRequest,
+) ([]models.BatchEntryProcessedResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
+	prepare := func(i int) (orderMutation, error) {
+		return s.prepareIssue(ctx, req.OrderIDs[i], req)
+	}
+	if req.Atomic {
+		if err := s.checkAtomicSupported(); err != nil {
+			return nil, err
+		}
+		return s.applyAtomically(ctx, req.OrderIDs, prepare), nil
+	}
+	results, _ := s.applyConcurrently(ctx, req.OrderIDs, prepare)
+	return results, nil
+}
 
-	eventID, err := s.generateEventID(order.OrderID)
+// prepareIssue loads and validates an order for issuance and builds its issued state, event and history entry
+func (s *DefaultOrderService) prepareIssue(ctx context.Context, id uint64, req requests.IssueOrdersRequest) (orderMutation, error) {
+	order, err := s.orderRepo.Load(ctx, id)
 	if err != nil {
-		return models.Order{}, err
+		return orderMutation{}, apperrors.Newf(apperrors.OrderNotFound, "order %d not found", id)
 	}
-	event := models.KafkaEvent{
-		EventID:   eventID,
-		EventType: models.MapEventTypeToKafkaEvent(models.EventAccepted),
-		Timestamp: now,
-		Actor:     actor,
-		Order:     order,
-		Source:    SourceName,
+	if err := s.validator.ValidateIssue(order, req); err != nil {
+		return orderMutation{}, err
 	}
+	now := s.clk.Now()
+	previous := order.Status
+	order.Status = models.Issued
+	order.UpdatedStatusAt = now
+	return s.buildMutation(ctx, order, previous, models.EventIssued, now, nil)
+}
 
-	payloadBytes, err := marshalEvent(event)
+// IssueAllReady issues every accepted order of the user that passes issue validation and returns a consolidated receipt
+func (s *DefaultOrderService) IssueAllReady(ctx context.Context, req requests.IssueAllReadyRequest) (models.IssueReceipt, error) {
+	if err := ctx.Err(); err != nil {
+		return models.IssueReceipt{}, err
+	}
+	candidates, err := s.listReadyOrders(ctx, req.UserID)
 	if err != nil {
-		return models.Order{}, err
+		return models.IssueReceipt{}, err
 	}
-	entry := models.HistoryEntry{
-		OrderID:   order.OrderID,
-		Event:     models.EventAccepted,
-		Timestamp: now,
+	receipt := models.IssueReceipt{UserID: req.UserID}
+	if len(candidates) == 0 {
+		return receipt, nil
 	}
 
-	err = s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
-		txCtx := ctxWithTx(ctx, tx)
-		if err := s.orderRepo.Save(txCtx, order); err != nil {
-			return apperrors.Newf(apperrors.InternalError, "failed to save order %d: %v", req.OrderID, err)
-		}
-		if err := s.outboxRepo.Create(txCtx, eventID, order.OrderID, payloadBytes); err != nil {
-			return apperrors.Newf(apperrors.InternalError, "failed to enqueue outbox event: %v", err)
-		}
-		if err := s.historySvc.Record(txCtx, entry); err != nil {
-			return apperrors.Newf(apperrors.InternalError, "failed to record history entry for order %d: %v", req.OrderID, err)
+	issueReq := requests.IssueOrdersRequest{
+		UserID:           req.UserID,
+		OrderIDs:         make([]uint64, 0, len(candidates)),
+		IdentityVerified: req.IdentityVerified,
+	}
+	for _, o := range candidates {
+		issueReq.OrderIDs = append(issueReq.OrderIDs, o.OrderID)
+	}
+	eligible := make([]uint64, 0, len(candidates))
+	for _, o := range candidates {
+		if err := s.validator.ValidateIssue(o, issueReq); err != nil {
+			receipt.Skipped = append(receipt.Skipped, models.BatchEntryProcessedResult{OrderID: o.OrderID, Error: err})
+			continue
 		}
-		return nil
-	})
+		eligible = append(eligible, o.OrderID)
+	}
+	if len(eligible) == 0 {
+		return receipt, nil
+	}
 
-	if err != nil {
-		return models.Order{}, err
+	issueReq.OrderIDs = eligible
+	// the receipt lists the orders as they were saved, with their issue time and new version
+	results, saved := s.applyConcurrently(ctx, eligible, func(i int) (orderMutation, error) {
+		return s.prepareIssue(ctx, eligible[i], issueReq)
+	})
+	for i, r := range results {
+		if r.Error != nil {
+			receipt.Skipped = append(receipt.Skipped, r)
+			continue
+		}
+		receipt.Issued = append(receipt.Issued, saved[i])
+		receipt.Total += saved[i].Price
 	}
-	return order, nil
+	return receipt, nil
 }
 
-// IssueOrders processes multiple orders for issuance to clients
-func (s *DefaultOrderService) IssueOrders(
-	ctx context.Context,
-	req requests.IssueOrdersRequest,
-) ([]models.BatchEntryProcessedResult, error) {
-	if err := ctx.Err(); err != nil {
-		return nil, err
-	}
-	n := len(req.OrderIDs)
-	results := make([]models.BatchEntryProcessedResult, n)
-	var wg sync.WaitGroup
-	for i, id := range req.OrderIDs {
-		wg.Add(1)
-		i, id := i, id
-		s.pool.Submit(func() {
-			defer wg.Done()
-			res := models.BatchEntryProcessedResult{OrderID: id}
-			order, err := s.orderRepo.Load(ctx, id)
-			if err != nil {
-				res.Error = apperrors.Newf(apperrors.OrderNotFound, "order %d not found", id)
-				results[i] = res
-				return
-			}
-			if err := s.validator.ValidateIssue(order, req); err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			eventID, err := s.generateEventID(order.OrderID)
-			if err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			now := s.clk.Now()
-			actor, err := s.actorSvc.DetermineActor(ctx, models.EventIssued, order.UserID)
-			if err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			order.Status = models.Issued
-			order.UpdatedStatusAt = now
-			evt := models.KafkaEvent{
-				EventID:   eventID,
-				EventType: models.MapEventTypeToKafkaEvent(models.EventIssued),
-				Timestamp: now,
-				Actor:     actor,
-				Order:     order,
-				Source:    SourceName,
-			}
-			payloadBytes, err := marshalEvent(evt)
-			if err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			entry := models.HistoryEntry{
-				OrderID:   id,
-				Event:     models.EventIssued,
-				Timestamp: now,
-			}
-			err = s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
-				txCtx := ctxWithTx(ctx, tx)
-				if err := s.orderRepo.Save(txCtx, order); err != nil {
-					return apperrors.Newf(apperrors.InternalError, "failed to save order %d: %v", id, err)
-				}
-				if err := s.outboxRepo.Create(txCtx, eventID, id, payloadBytes); err != nil {
-					return apperrors.Newf(apperrors.InternalError, "failed to enqueue issue-event for order %d: %v", id, err)
-				}
-				if err := s.historySvc.Record(txCtx, entry); err != nil {
-					return apperrors.Newf(apperrors.InternalError, "failed to record history entry for order %d: %v", id, err)
-				}
-				return nil
-			})
-			if err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			results[i] = res
-		})
+// listReadyOrders walks all pages of the user's accepted orders
+func (s *DefaultOrderService) listReadyOrders(ctx context.Context, userID uint64) ([]models.Order, error) {
+	var orders []models.Order
+	for page := constants.DefaultPage; ; page++ {
+		filter := requests.NewOrdersFilter(
+			requests.WithUserID(userID),
+			requests.WithStatus(models.Accepted),
+			requests.WithPage(page),
+		)
+		batch, total, err := s.orderRepo.List(ctx, filter)
+		if err != nil {
+			return nil, apperrors.Newf(apperrors.InternalError, "failed to list orders of user %d: %v", userID, err)
+		}
+		orders = append(orders, batch...)
+		if len(batch) == 0 || len(orders) >= total {
+			return orders, nil
+		}
 	}
-
-	wg.Wait()
-	return results, nil
 }
 
 // ListOrders retrieves filtered and paginated list of orders
@@ -231,6 +261,10 @@ func (s *DefaultOrderService) ListOrders(ctx context.Context, filter requests.Or
 	if ctx.Err() != nil {
 		return nil, 0, 0, ctx.Err()
 	}
+	if err := validateFilterRanges(filter); err != nil {
+		return nil, 0, 0, err
+	}
+	filter = filter.ResolveExpiringWithin(s.clk.Now())
 	result, total, err := s.orderRepo.List(ctx, filter)
 	if err != nil {
 		return nil, 0, 0, apperrors.Newf(apperrors.InternalError, "failed to list orders: %v", err)
@@ -252,6 +286,39 @@ func (s *DefaultOrderService) ListOrders(ctx context.Context, filter requests.Or
 	return result, nextLastID, total, nil
 }
 
+// validateFilterRanges rejects search ranges whose lower bound is past the upper one
+func validateFilterRanges(filter requests.OrdersFilterRequest) error {
+	timeRanges := []struct {
+		name     string
+		from, to *time.Time
+	}{
+		{"created", filter.CreatedFrom, filter.CreatedTo},
+		{"expires", filter.ExpiresFrom, filter.ExpiresTo},
+		{"updated", filter.UpdatedFrom, filter.UpdatedTo},
+	}
+	for _, r := range timeRanges {
+		if r.from != nil && r.to != nil && r.to.Before(*r.from) {
+			return apperrors.Newf(apperrors.ValidationFailed, "%s range is empty: to is before from", r.name)
+		}
+	}
+	numRanges := []struct {
+		name     string
+		min, max *float32
+	}{
+		{"price", filter.PriceMin, filter.PriceMax},
+		{"weight", filter.WeightMin, filter.WeightMax},
+	}
+	for _, r := range numRanges {
+		if r.min != nil && r.max != nil && *r.max < *r.min {
+			return apperrors.Newf(apperrors.ValidationFailed, "%s range is empty: max is less than min", r.name)
+		}
+	}
+	if filter.ExpiringWithin != nil && *filter.ExpiringWithin <= 0 {
+		return apperrors.Newf(apperrors.ValidationFailed, "expiring within must be positive")
+	}
+	return nil
+}
+
 // CreateClientReturns processes multiple client return requests
 func (s *DefaultOrderService) CreateClientReturns(
 	ctx context.Context,
@@ -260,86 +327,35 @@ func (s *DefaultOrderService) CreateClientReturns(
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	n := len(req.OrderIDs)
-	results := make([]models.BatchEntryProcessedResult, n)
-	var wg sync.WaitGroup
-	for i, id := range req.OrderIDs {
-		wg.Add(1)
-		i, id := i, id
-		s.pool.Submit(func() {
-			defer wg.Done()
-			res := models.BatchEntryProcessedResult{OrderID: id}
-			order, err := s.orderRepo.Load(ctx, id)
-			if err != nil {
-				res.Error = apperrors.Newf(apperrors.OrderNotFound, "order %d not found", id)
-				results[i] = res
-				return
-			}
-			if err := s.validator.ValidateClientReturn(order, req); err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			now := s.clk.Now()
-			actor, err := s.actorSvc.DetermineActor(ctx, models.EventReturnedByClient, order.UserID)
-			if err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			order.Status = models.Returned
-			order.UpdatedStatusAt = now
-			eventID, err := s.generateEventID(order.OrderID)
-			if err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			event := models.KafkaEvent{
-				EventID:   eventID,
-				EventType: models.MapEventTypeToKafkaEvent(models.EventReturnedByClient),
-				Timestamp: now,
-				Actor:     actor,
-				Order:     order,
-				Source:    SourceName,
-			}
-			payloadBytes, err := marshalEvent(event)
-			if err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			entry := models.HistoryEntry{
-				OrderID:   id,
-				Event:     models.EventReturnedByClient,
-				Timestamp: now,
-			}
-			err = s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
-				txCtx := ctxWithTx(ctx, tx)
-				if err := s.orderRepo.Save(txCtx, order); err != nil {
-					return apperrors.Newf(apperrors.InternalError, "failed to save returned order %d: %v", id, err)
-				}
-				if err := s.outboxRepo.Create(txCtx, eventID, id, payloadBytes); err != nil {
-					return apperrors.Newf(apperrors.InternalError, "failed to enqueue return-event for order %d: %v", id, err)
-				}
-				if err := s.historySvc.Record(txCtx, entry); err != nil {
-					return apperrors.Newf(apperrors.InternalError, "failed to record history entry for order %d: %v", id, err)
-				}
-				return nil
-			})
-			if err != nil {
-				res.Error = err
-				results[i] = res
-				return
-			}
-			results[i] = res
-		})
+	prepare := func(i int) (orderMutation, error) {
+		return s.prepareClientReturn(ctx, req.OrderIDs[i], req)
 	}
-
-	wg.Wait()
+	if req.Atomic {
+		if err := s.checkAtomicSupported(); err != nil {
+			return nil, err
+		}
+		return s.applyAtomically(ctx, req.OrderIDs, prepare), nil
+	}
+	results, _ := s.applyConcurrently(ctx, req.OrderIDs, prepare)
 	return results, nil
 }
 
+// prepareClientReturn loads and validates an order for a client return and builds its returned state, event and history entry
+func (s *DefaultOrderService) prepareClientReturn(ctx context.Context, id uint64, req requests.ClientReturnsRequest) (orderMutation, error) {
+	order, err := s.orderRepo.Load(ctx, id)
+	if err != nil {
+		return orderMutation{}, apperrors.Newf(apperrors.OrderNotFound, "order %d not found", id)
+	}
+	if err := s.validator.ValidateClientReturn(order, req); err != nil {
+		return orderMutation{}, err
+	}
+	now := s.clk.Now()
+	previous := order.Status
+	order.Status = models.Returned
+	order.UpdatedStatusAt = now
+	return s.buildMutation(ctx, order, previous, models.EventReturnedByClient, now, nil)
+}
+
 // ReturnToCourier processes return of order back to courier/warehouse
 func (s *DefaultOrderService) ReturnToCourier(ctx context.Context, req requests.ReturnOrderRequest) error {
 	if ctx.Err() != nil {
@@ -351,6 +367,11 @@ func (s *DefaultOrderService) ReturnToCourier(ctx context.Context, req requests.
 		return apperrors.Newf(apperrors.OrderNotFound, "order %d not found", orderID)
 	}
 
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != o.Version {
+		return apperrors.Newf(apperrors.ConcurrentModification,
+			"order %d has version %d, expected %d", orderID, o.Version, *req.ExpectedVersion)
+	}
+
 	if err := s.validator.ValidateReturnToCourier(o); err != nil {
 		return err
 	}
@@ -371,23 +392,22 @@ func (s *DefaultOrderService) ReturnToCourier(ctx context.Context, req requests.
 		Actor:     actor,
 		Order:     o,
 		Source:    SourceName,
+		Operator:  operator.IDFromContext(ctx),
 	}
 	payloadBytes, err := marshalEvent(event)
 	if err != nil {
 		return err
 	}
-	entry := models.HistoryEntry{
-		OrderID:   orderID,
-		Event:     models.EventReturnedToWarehouse,
-		Timestamp: now,
-	}
+	entry := newHistoryEntry(ctx, orderID, models.EventReturnedToWarehouse, now, actor)
+	entry.PreviousStatus = o.Status
+	entry.NewStatus = models.ReturnedToWarehouse
 
 	err = s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
 		txCtx := ctxWithTx(ctx, tx)
-		if err := s.orderRepo.Delete(txCtx, orderID); err != nil {
-			return apperrors.Newf(apperrors.InternalError, "failed to delete order %d: %v", orderID, err)
+		if err := s.orderRepo.Delete(txCtx, orderID, o.Version); err != nil {
+			return orderSaveError(orderID, err, "failed to delete order %d: %v")
 		}
-		if err := s.outboxRepo.Create(txCtx, eventID, orderID, payloadBytes); err != nil {
+		if err := s.outboxRepo.Create(txCtx, eventID, models.OutboxAggregateOrder, orderID, payloadBytes); err != nil {
 			return apperrors.Newf(apperrors.InternalError, "failed to enqueue return-event: %v", err)
 		}
 		if err := s.historySvc.Record(txCtx, entry); err != nil {
@@ -402,11 +422,123 @@ func (s *DefaultOrderService) ReturnToCourier(ctx context.Context, req requests.
 	return nil
 }
 
+// UndoLastOperation reverts the most recent transition of an order if the same operator asks within the grace period.
+// Nothing is deleted from history: a compensating UNDONE entry and an order_transition_undone event are written instead.
+func (s *DefaultOrderService) UndoLastOperation(ctx context.Context, req requests.UndoRequest) (models.UndoResult, error) {
+	if ctx.Err() != nil {
+		return models.UndoResult{}, ctx.Err()
+	}
+	op := operator.IDFromContext(ctx)
+	if op == "" {
+		return models.UndoResult{}, apperrors.Newf(apperrors.UndoForbidden, "operator identity is required to undo an operation")
+	}
+
+	entries, err := s.historySvc.List(ctx, requests.OrderHistoryFilter{OrderID: &req.OrderID, Page: constants.DefaultHistoryPage, Limit: 2})
+	if err != nil {
+		return models.UndoResult{}, apperrors.Newf(apperrors.InternalError, "failed to load history of order %d: %v", req.OrderID, err)
+	}
+	if len(entries) == 0 {
+		return models.UndoResult{}, apperrors.Newf(apperrors.OrderNotFound, "order %d not found", req.OrderID)
+	}
+	last := entries[0]
+	now := s.clk.Now()
+	switch {
+	case last.Event == models.EventUndone:
+		return models.UndoResult{}, apperrors.Newf(apperrors.UndoNotPossible, "last operation on order %d is already undone", req.OrderID)
+	case last.Event == models.EventReturnedToWarehouse:
+		return models.UndoResult{}, apperrors.Newf(apperrors.UndoNotPossible, "order %d has already left the pickup point", req.OrderID)
+	case now.Sub(last.Timestamp) > s.undoGracePeriod:
+		return models.UndoResult{}, apperrors.Newf(apperrors.UndoNotPossible,
+			"grace period of %s for undoing %s on order %d has expired", s.undoGracePeriod, last.Event, req.OrderID)
+	case last.OperatorID != op:
+		return models.UndoResult{}, apperrors.Newf(apperrors.UndoForbidden,
+			"only the operator who performed %s on order %d may undo it", last.Event, req.OrderID)
+	}
+
+	order, err := s.orderRepo.Load(ctx, req.OrderID)
+	if err != nil {
+		return models.UndoResult{}, apperrors.Newf(apperrors.OrderNotFound, "order %d not found", req.OrderID)
+	}
+	previousAt := order.CreatedAt
+	if len(entries) > 1 {
+		previousAt = entries[1].Timestamp
+	}
+	result := models.UndoResult{OrderID: order.OrderID, Undone: last.Event}
+	undoneStatus := order.Status
+	switch {
+	case last.Event == models.EventAccepted && order.Status == models.Accepted:
+		result.Removed = true
+	case last.Event == models.EventIssued && order.Status == models.Issued:
+		order.Status = models.Accepted
+	case last.Event == models.EventReturnedByClient && order.Status == models.Returned:
+		order.Status = models.Issued
+	default:
+		return models.UndoResult{}, apperrors.Newf(apperrors.UndoNotPossible, "%s on order %d cannot be undone", last.Event, req.OrderID)
+	}
+	order.UpdatedStatusAt = previousAt
+	if !result.Removed {
+		result.Status = order.Status
+	}
+
+	eventID, err := s.generateEventID(order.OrderID)
+	if err != nil {
+		return models.UndoResult{}, err
+	}
+	actor := models.Actor{Type: models.ActorOperator}
+	payloadBytes, err := marshalEvent(models.KafkaEvent{
+		EventID:     eventID,
+		EventType:   models.MapEventTypeToKafkaEvent(models.EventUndone),
+		Timestamp:   now,
+		Actor:       actor,
+		Order:       order,
+		Source:      SourceName,
+		Operator:    op,
+		UndoneEvent: models.MapEventTypeToKafkaEvent(last.Event),
+	})
+	if err != nil {
+		return models.UndoResult{}, err
+	}
+	entry := newHistoryEntry(ctx, order.OrderID, models.EventUndone, now, actor)
+	entry.PreviousStatus = undoneStatus
+	if !result.Removed {
+		entry.NewStatus = order.Status
+	}
+	if entry.Metadata, err = historyMetadata(map[string]any{"undone_event": last.Event.String()}); err != nil {
+		return models.UndoResult{}, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
+		txCtx := ctxWithTx(ctx, tx)
+		if result.Removed {
+			if err := s.orderRepo.Delete(txCtx, order.OrderID, order.Version); err != nil {
+				return orderSaveError(order.OrderID, err, "failed to remove order %d: %v")
+			}
+		} else if _, err := s.orderRepo.Save(txCtx, order); err != nil {
+			return orderSaveError(order.OrderID, err, "failed to save order %d: %v")
+		}
+		if err := s.outboxRepo.Create(txCtx, eventID, models.OutboxAggregateOrder, order.OrderID, payloadBytes); err != nil {
+			return apperrors.Newf(apperrors.InternalError, "failed to enqueue undo-event for order %d: %v", order.OrderID, err)
+		}
+		if err := s.historySvc.Record(txCtx, entry); err != nil {
+			return apperrors.Newf(apperrors.InternalError, "failed to record history entry for order %d: %v", order.OrderID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.UndoResult{}, err
+	}
+	return result, nil
+}
+
 // ListReturns retrieves paginated list of return entries sorted by return date
 func (s *DefaultOrderService) ListReturns(ctx context.Context, filter requests.OrdersFilterRequest) ([]models.Order, error) {
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
+	// returns are sorted by return date unless the caller asks otherwise
+	if filter.SortBy == "" {
+		filter.SortBy = requests.SortByUpdatedStatusAt
+	}
 	orders, _, err := s.orderRepo.List(ctx, filter)
 	if err != nil {
 		return nil, apperrors.Newf(apperrors.InternalError, "failed to list returns: %v", err)
@@ -415,7 +547,39 @@ func (s *DefaultOrderService) ListReturns(ctx context.Context, filter requests.O
 	return orders, nil
 }
 
+// GetOrder loads a single order with its latest history entries and the deadline that applies to its current status:
+// the storage deadline while it waits for the client, the return deadline once it is issued.
+func (s *DefaultOrderService) GetOrder(ctx context.Context, orderID uint64) (models.OrderDetails, error) {
+	if ctx.Err() != nil {
+		return models.OrderDetails{}, ctx.Err()
+	}
+	order, err := s.orderRepo.Load(ctx, orderID)
+	if err != nil {
+		return models.OrderDetails{}, apperrors.Newf(apperrors.OrderNotFound, "order %d not found", orderID)
+	}
+	history, err := s.historySvc.List(ctx, requests.OrderHistoryFilter{
+		OrderID: &orderID,
+		Page:    constants.DefaultHistoryPage,
+		Limit:   constants.OrderHistoryPreview,
+	})
+	if err != nil {
+		return models.OrderDetails{}, apperrors.Newf(apperrors.InternalError, "failed to load history of order %d: %v", orderID, err)
+	}
+
+	details := models.OrderDetails{Order: order, History: history}
+	switch order.Status {
+	case models.Accepted:
+		storageDeadline := order.ExpiresAt
+		details.StorageDeadline = &storageDeadline
+	case models.Issued:
+		returnDeadline := s.calendarSvc.Current().Deadline(order.UpdatedStatusAt, constants.ReturnWindow)
+		details.ReturnDeadline = &returnDeadline
+	}
+	return details, nil
+}
+
 // ImportOrders imports multiple orders concurrently, processing each status and returning a batch of results with errors, if any.
+// With req.Atomic set, every order is validated first and all of them are stored in one transaction, or none is.
 func (s *DefaultOrderService) ImportOrders(
 	ctx context.Context,
 	req requests.ImportOrdersRequest,
@@ -423,19 +587,42 @@ func (s *DefaultOrderService) ImportOrders(
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
+	if req.ValidateOnly {
+		return s.validateImport(ctx, req), nil
+	}
+	if req.Atomic {
+		if err := s.checkAtomicSupported(); err != nil {
+			return nil, err
+		}
+		ids := make([]uint64, len(req.Statuses))
+		for i, st := range req.Statuses {
+			ids[i] = st.OrderID
+			if ids[i] == 0 && st.Request != nil {
+				ids[i] = st.Request.OrderID
+			}
+		}
+		return s.applyAtomically(ctx, ids, func(i int) (orderMutation, error) {
+			st := req.Statuses[i]
+			if st.Error != nil {
+				return orderMutation{}, st.Error
+			}
+			return s.prepareAccept(ctx, importedAccept(req, st))
+		}), nil
+	}
+
 	n := len(req.Statuses)
 	results := make([]models.BatchEntryProcessedResult, n)
 	var wg sync.WaitGroup
 	for i, st := range req.Statuses {
 		if st.Error != nil {
-			results[i] = models.BatchEntryProcessedResult{OrderID: st.Request.OrderID, Error: st.Error}
+			results[i] = models.BatchEntryProcessedResult{OrderID: st.OrderID, Error: st.Error}
 			continue
 		}
 		wg.Add(1)
 		i, st := i, st
 		s.pool.Submit(func() {
 			defer wg.Done()
-			order, err := s.AcceptOrder(ctx, *st.Request)
+			order, err := s.AcceptOrder(ctx, importedAccept(req, st))
 			results[i] = models.BatchEntryProcessedResult{OrderID: order.OrderID, Error: err}
 		})
 	}
@@ -443,6 +630,249 @@ func (s *DefaultOrderService) ImportOrders(
 	return results, nil
 }
 
+// importedAccept returns the accept request of an imported order, stamped with the submission time of the import
+func importedAccept(req requests.ImportOrdersRequest, st requests.ImportOrderStatus) requests.AcceptOrderRequest {
+	accept := *st.Request
+	if !req.SubmittedAt.IsZero() {
+		accept.SubmittedAt = req.SubmittedAt
+	}
+	return accept
+}
+
+// validateImport runs every order of the import through validation and pricing without writing anything.
+// An order ID repeated in the request is reported for the later rows, as a real import would reject them.
+func (s *DefaultOrderService) validateImport(ctx context.Context, req requests.ImportOrdersRequest) []models.BatchEntryProcessedResult {
+	results := make([]models.BatchEntryProcessedResult, len(req.Statuses))
+	firstSeen := make(map[uint64]int, len(req.Statuses))
+	for i, st := range req.Statuses {
+		results[i].OrderID = st.OrderID
+		if st.Error != nil {
+			results[i].Error = st.Error
+			continue
+		}
+		if ctx.Err() != nil {
+			results[i].Error = ctx.Err()
+			continue
+		}
+		id := st.Request.OrderID
+		results[i].OrderID = id
+		if first, ok := firstSeen[id]; ok {
+			results[i].Error = apperrors.Newf(apperrors.OrderAlreadyExists, "order %d is already imported by item #%d", id, first)
+			continue
+		}
+		order, err := s.evaluateAccept(ctx, importedAccept(req, st))
+		if err != nil {
+			results[i].Error = err
+			continue
+		}
+		firstSeen[id] = st.ItemNumber
+		results[i].TotalPrice = order.Price
+	}
+	return results
+}
+
+// orderMutation is a validated order state change together with the outbox event and history entry that record it
+type orderMutation struct {
+	order   models.Order
+	eventID uint64
+	payload []byte
+	entry   models.HistoryEntry
+}
+
+// buildMutation determines the actor and prepares the outbox event and history entry for the order entering event
+// from the previous status; metadata, if any, is stored with the history entry.
+func (s *DefaultOrderService) buildMutation(
+	ctx context.Context,
+	order models.Order,
+	previous models.OrderStatus,
+	event models.EventType,
+	now time.Time,
+	metadata map[string]any,
+) (orderMutation, error) {
+	actor, err := s.actorSvc.DetermineActor(ctx, event, order.UserID)
+	if err != nil {
+		return orderMutation{}, err
+	}
+	eventID, err := s.generateEventID(order.OrderID)
+	if err != nil {
+		return orderMutation{}, err
+	}
+	payloadBytes, err := marshalEvent(models.KafkaEvent{
+		EventID:   eventID,
+		EventType: models.MapEventTypeToKafkaEvent(event),
+		Timestamp: now,
+		Actor:     actor,
+		Order:     order,
+		Source:    SourceName,
+		Operator:  operator.IDFromContext(ctx),
+	})
+	if err != nil {
+		return orderMutation{}, err
+	}
+	entry := newHistoryEntry(ctx, order.OrderID, event, now, actor)
+	entry.PreviousStatus = previous
+	entry.NewStatus = order.Status
+	if entry.Metadata, err = historyMetadata(metadata); err != nil {
+		return orderMutation{}, err
+	}
+	return orderMutation{
+		order:   order,
+		eventID: eventID,
+		payload: payloadBytes,
+		entry:   entry,
+	}, nil
+}
+
+// newHistoryEntry starts a history entry of event performed by actor, attributed to the operator and correlation ID of ctx
+func newHistoryEntry(ctx context.Context, orderID uint64, event models.EventType, at time.Time, actor models.Actor) models.HistoryEntry {
+	return models.HistoryEntry{
+		OrderID:       orderID,
+		Event:         event,
+		Timestamp:     at,
+		OperatorID:    operator.IDFromContext(ctx),
+		ActorType:     actor.Type,
+		ActorID:       actor.ID,
+		CorrelationID: correlation.IDFromContext(ctx),
+	}
+}
+
+// historyMetadata encodes the event details stored with a history entry; no details give nil metadata
+func historyMetadata(fields map[string]any) (json.RawMessage, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, apperrors.Newf(apperrors.InternalError, "failed to marshal history metadata: %v", err)
+	}
+	return b, nil
+}
+
+// applyMutation stores the order, its outbox event and its history entry and returns the version the order was stored with;
+// it must run inside a transaction
+func (s *DefaultOrderService) applyMutation(txCtx context.Context, m orderMutation) (int64, error) {
+	id := m.order.OrderID
+	version, err := s.orderRepo.Save(txCtx, m.order)
+	if err != nil {
+		return 0, orderSaveError(id, err, "failed to save order %d: %v")
+	}
+	if err := s.outboxRepo.Create(txCtx, m.eventID, models.OutboxAggregateOrder, id, m.payload); err != nil {
+		return 0, apperrors.Newf(apperrors.InternalError, "failed to enqueue outbox event for order %d: %v", id, err)
+	}
+	if err := s.historySvc.Record(txCtx, m.entry); err != nil {
+		return 0, apperrors.Newf(apperrors.InternalError, "failed to record history entry for order %d: %v", id, err)
+	}
+	return version, nil
+}
+
+// applyConcurrently prepares and stores each order independently on the worker pool, one transaction per order.
+// Along with the results it returns the saved state of every stored order, with the version it was stored with,
+// at the index of its result; entries of failed orders are zero.
+func (s *DefaultOrderService) applyConcurrently(
+	ctx context.Context,
+	ids []uint64,
+	prepare func(i int) (orderMutation, error),
+) ([]models.BatchEntryProcessedResult, []models.Order) {
+	results := make([]models.BatchEntryProcessedResult, len(ids))
+	saved := make([]models.Order, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		i, id := i, id
+		s.pool.Submit(func() {
+			defer wg.Done()
+			res := models.BatchEntryProcessedResult{OrderID: id}
+			m, err := prepare(i)
+			if err == nil {
+				err = s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
+					version, err := s.applyMutation(ctxWithTx(ctx, tx), m)
+					m.order.Version = version
+					return err
+				})
+			}
+			res.Error = err
+			results[i] = res
+			if err == nil {
+				saved[i] = m.order
+			}
+		})
+	}
+	wg.Wait()
+	return results, saved
+}
+
+// checkAtomicSupported rejects an atomic batch when the storage cannot roll back a batch that failed halfway
+func (s *DefaultOrderService) checkAtomicSupported() error {
+	if !s.atomicBatches {
+		return apperrors.Newf(apperrors.AtomicNotSupported, "atomic batches are not supported by the file storage")
+	}
+	return nil
+}
+
+// applyAtomically prepares every order first and stores all of them in a single transaction only if none failed.
+// An order ID repeated in the batch is reported for the later rows, since its second save could never succeed.
+// When the batch is aborted, orders that were fine on their own are reported with BATCH_ABORTED.
+func (s *DefaultOrderService) applyAtomically(
+	ctx context.Context,
+	ids []uint64,
+	prepare func(i int) (orderMutation, error),
+) []models.BatchEntryProcessedResult {
+	results := make([]models.BatchEntryProcessedResult, len(ids))
+	mutations := make([]orderMutation, 0, len(ids))
+	firstSeen := make(map[uint64]int, len(ids))
+	failed := false
+	for i, id := range ids {
+		results[i].OrderID = id
+		if first, ok := firstSeen[id]; ok {
+			results[i].Error = apperrors.Newf(apperrors.InvalidBatchEntry,
+				"order %d is repeated in the batch, first at position %d", id, first+1)
+			failed = true
+			continue
+		}
+		m, err := prepare(i)
+		if err != nil {
+			results[i].Error = err
+			failed = true
+			continue
+		}
+		firstSeen[id] = i
+		mutations = append(mutations, m)
+	}
+
+	if !failed {
+		failedAt := -1
+		err := s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
+			txCtx := ctxWithTx(ctx, tx)
+			for j, m := range mutations {
+				if _, err := s.applyMutation(txCtx, m); err != nil {
+					failedAt = j
+					return err
+				}
+			}
+			return nil
+		})
+		if err == nil {
+			return results
+		}
+		// nothing failed to prepare, so every row has its mutation at the same index
+		if failedAt >= 0 {
+			results[failedAt].Error = err
+		} else {
+			for i := range results {
+				results[i].Error = err
+			}
+		}
+	}
+
+	for i := range results {
+		if results[i].Error == nil {
+			results[i].Error = apperrors.Newf(apperrors.BatchAborted,
+				"order %d was not processed because the atomic batch failed", results[i].OrderID)
+		}
+	}
+	return results
+}
+
 func marshalEvent(e models.KafkaEvent) ([]byte, error) {
 	payloadBytes, err := json.Marshal(e)
 	if err != nil {
@@ -451,6 +881,15 @@ func marshalEvent(e models.KafkaEvent) ([]byte, error) {
 	return payloadBytes, nil
 }
 
+// orderSaveError maps a failed conditional save to CONCURRENT_MODIFICATION or to an internal error described by format
+func orderSaveError(orderID uint64, err error, format string) error {
+	if errors.Is(err, repositories.ErrConcurrentModification) {
+		return apperrors.Newf(apperrors.ConcurrentModification,
+			"order %d was modified concurrently, reload it and retry", orderID)
+	}
+	return apperrors.Newf(apperrors.InternalError, format, orderID, err)
+}
+
 func ctxWithTx(ctx context.Context, tx pgx.Tx) context.Context {
 	return db.WithTxContext(ctx, tx)
 }
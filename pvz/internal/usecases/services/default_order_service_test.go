@@ -5,12 +5,18 @@ import (
 	"errors"
 	"github.com/stretchr/testify/require"
 	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/common/correlation"
+	"pvz-cli/internal/common/operator"
 	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/repositories"
 	repmocks "pvz-cli/internal/data/repositories/mocks"
 	"pvz-cli/internal/infrastructure/db"
 	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
 	svcmocks "pvz-cli/internal/usecases/services/mocks"
+	stratmocks "pvz-cli/internal/usecases/services/strategies/mocks"
+	"pvz-cli/internal/usecases/services/validators"
 	valmocks "pvz-cli/internal/usecases/services/validators/mocks"
 	"pvz-cli/pkg/clock"
 	"pvz-cli/tests/builders"
@@ -78,15 +84,18 @@ func TestDefaultOrderService_AcceptOrder_Success(t *testing.T) {
 	}
 	deps.repo.LoadMock.Expect(deps.ctx, req.OrderID).Return(models.Order{}, apperrors.Newf(apperrors.OrderNotFound, "order not found"))
 	deps.validator.ValidateAcceptMock.Expect(models.Order{}, req).Return(nil)
-	deps.pricing.EvaluateMock.Expect(req.Package, req.Weight, req.Price).Return(125.0, nil)
-	deps.actorSvc.DetermineActorMock.Expect(deps.ctx, models.EventAccepted, req.UserID).Return(models.Actor{}, nil)
-	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) error {
+	deps.pricing.EvaluateMock.Expect(req.Package, req.Weight, req.Price, req.Handling).Return(125.0, nil)
+	deps.actorSvc.DetermineActorMock.Expect(deps.ctx, models.EventAccepted, req.UserID).
+		Return(models.Actor{Type: models.ActorCourier, ID: 7}, nil)
+	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
 		require.Equal(t, req.OrderID, order.OrderID)
 		require.Equal(t, models.Accepted, order.Status)
 		require.Equal(t, float32(125.0), order.Price)
-		return nil
+		return order.Version + 1, nil
 	})
-	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, aggregate models.OutboxAggregate, orderID uint64, payload []byte) error {
+		require.Equal(t, models.OutboxAggregateOrder, aggregate)
+		require.Equal(t, req.OrderID, orderID)
 		require.Greater(t, len(payload), 0)
 		require.Contains(t, string(payload), "order_accepted")
 		return nil
@@ -95,12 +104,112 @@ func TestDefaultOrderService_AcceptOrder_Success(t *testing.T) {
 	deps.history.RecordMock.Set(func(ctx context.Context, entry models.HistoryEntry) error {
 		require.Equal(t, req.OrderID, entry.OrderID)
 		require.Equal(t, models.EventAccepted, entry.Event)
+		require.Equal(t, models.Actor{Type: models.ActorCourier, ID: 7}, entry.Actor())
+		require.Zero(t, entry.PreviousStatus)
+		require.Equal(t, models.Accepted, entry.NewStatus)
+		require.JSONEq(t, `{"package":"`+models.PackageBox.String()+`","weight":2,"price":125,"storage_policy":"`+
+			models.StoragePolicyManual+`"}`, string(entry.Metadata))
 		return nil
 	})
 	order, err := deps.svc.AcceptOrder(deps.ctx, req)
 	require.NoError(t, err)
 	require.Equal(t, req.OrderID, order.OrderID)
 	require.Equal(t, models.Accepted, order.Status)
+	require.Equal(t, models.StoragePolicyManual, order.StoragePolicy)
+}
+
+func TestDefaultOrderService_AcceptOrder_BusinessDaysExpiry(t *testing.T) {
+	t.Parallel()
+	deps := newTestOrderService(t)
+	deps.calendar.CurrentMock.Return(models.PickupCalendar{
+		WeeklyDaysOff: []time.Weekday{time.Saturday, time.Sunday},
+		BusinessDays:  true,
+	})
+
+	req := newAcceptOrderRequest(1, models.PackageBox, 2.0, time.Date(2025, 6, 28, 0, 0, 0, 0, time.UTC))
+	deps.repo.LoadMock.Expect(deps.ctx, req.OrderID).Return(models.Order{}, apperrors.Newf(apperrors.OrderNotFound, "order not found"))
+	// the validator checks the expiry counted in business days rather than the requested one
+	validated := req
+	validated.ExpiresAt = time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	deps.validator.ValidateAcceptMock.Expect(models.Order{}, validated).Return(nil)
+	deps.pricing.EvaluateMock.Expect(req.Package, req.Weight, req.Price, req.Handling).Return(125.0, nil)
+	deps.actorSvc.DetermineActorMock.Expect(deps.ctx, models.EventAccepted, req.UserID).Return(models.Actor{}, nil)
+	deps.repo.SaveMock.Return(1, nil)
+	deps.outboxRepo.CreateMock.Return(nil)
+	deps.history.RecordMock.Return(nil)
+
+	order, err := deps.svc.AcceptOrder(deps.ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), order.ExpiresAt)
+}
+
+// TestDefaultOrderService_AcceptOrder_HazardousBusinessDaysExpiry verifies that the hazardous storage cap applies
+// to the expiry counted in business days, which may exceed the cap even when the requested date does not.
+func TestDefaultOrderService_AcceptOrder_HazardousBusinessDaysExpiry(t *testing.T) {
+	t.Parallel()
+	deps := newTestOrderService(t)
+	deps.calendar.CurrentMock.Return(models.PickupCalendar{
+		WeeklyDaysOff: []time.Weekday{time.Saturday, time.Sunday},
+		BusinessDays:  true,
+	})
+	deps.svc.validator = validators.NewDefaultOrderValidator(deps.clk, deps.calendar)
+
+	// 36 hours from Thursday noon end on Saturday, which business days move to Monday, 84 hours after acceptance
+	req := newAcceptOrderRequest(1, models.PackageBox, 2.0, time.Date(2025, 6, 28, 0, 0, 0, 0, time.UTC))
+	req.Handling.Hazardous = true
+	deps.repo.LoadMock.Expect(deps.ctx, req.OrderID).Return(models.Order{}, repositories.ErrOrderNotFound)
+
+	_, err := deps.svc.AcceptOrder(deps.ctx, req)
+	var ae *apperrors.AppError
+	require.ErrorAs(t, err, &ae)
+	require.Equal(t, apperrors.ValidationFailed, ae.Code)
+}
+
+// TestDefaultOrderService_AcceptOrder_RevivedVersion verifies that the version reported by the repository is returned,
+// as an acceptance reviving a removed order continues its version.
+func TestDefaultOrderService_AcceptOrder_RevivedVersion(t *testing.T) {
+	t.Parallel()
+	deps := newTestOrderService(t)
+
+	req := newAcceptOrderRequest(1, models.PackageBox, 2.0, deps.clk.After(48*time.Hour))
+	deps.repo.LoadMock.Expect(deps.ctx, req.OrderID).Return(models.Order{}, apperrors.Newf(apperrors.OrderNotFound, "order not found"))
+	deps.validator.ValidateAcceptMock.Expect(models.Order{}, req).Return(nil)
+	deps.pricing.EvaluateMock.Expect(req.Package, req.Weight, req.Price, req.Handling).Return(125.0, nil)
+	deps.actorSvc.DetermineActorMock.Expect(deps.ctx, models.EventAccepted, req.UserID).Return(models.Actor{}, nil)
+	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
+		require.Zero(t, order.Version)
+		return 4, nil
+	})
+	deps.outboxRepo.CreateMock.Return(nil)
+	deps.history.RecordMock.Return(nil)
+
+	order, err := deps.svc.AcceptOrder(deps.ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), order.Version)
+}
+
+func TestDefaultOrderService_AcceptOrder_StoragePolicy(t *testing.T) {
+	t.Parallel()
+	deps := newTestOrderService(t)
+
+	req := newAcceptOrderRequest(1, models.PackageBox, 2.0, time.Time{})
+	req.Handling = models.HandlingFlags{Hazardous: true}
+	withExpiry := req
+	withExpiry.ExpiresAt = deps.clk.After(72 * time.Hour)
+
+	deps.storage.ResolveMock.Expect(req.Package, req.Handling).Return(models.StoragePolicy{Name: "category:hazardous", Days: 3})
+	deps.repo.LoadMock.Expect(deps.ctx, req.OrderID).Return(models.Order{}, apperrors.Newf(apperrors.OrderNotFound, "order not found"))
+	deps.validator.ValidateAcceptMock.Expect(models.Order{}, withExpiry).Return(nil)
+	deps.pricing.EvaluateMock.Expect(req.Package, req.Weight, req.Price, req.Handling).Return(125.0, nil)
+	deps.actorSvc.DetermineActorMock.Expect(deps.ctx, models.EventAccepted, req.UserID).Return(models.Actor{}, nil)
+	deps.repo.SaveMock.Return(1, nil)
+	deps.outboxRepo.CreateMock.Return(nil)
+	deps.history.RecordMock.Return(nil)
+
+	order, err := deps.svc.AcceptOrder(deps.ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, withExpiry.ExpiresAt, order.ExpiresAt)
+	require.Equal(t, "category:hazardous", order.StoragePolicy)
 }
 
 func TestDefaultOrderService_AcceptOrder_FailureCases(t *testing.T) {
@@ -164,14 +273,14 @@ func TestDefaultOrderService_IssueOrders_Success(t *testing.T) {
 		return models.Actor{}, nil
 	})
 	saveCallCount := 0
-	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) error {
+	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
 		saveCallCount++
 		require.Equal(t, models.Issued, order.Status)
 		require.Contains(t, []uint64{1, 2}, order.OrderID)
-		return nil
+		return order.Version + 1, nil
 	})
 	outboxCallCount := 0
-	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
 		outboxCallCount++
 		require.Greater(t, len(payload), 0)
 		require.Contains(t, string(payload), "order_issued")
@@ -181,6 +290,8 @@ func TestDefaultOrderService_IssueOrders_Success(t *testing.T) {
 	deps.history.RecordMock.Set(func(ctx context.Context, entry models.HistoryEntry) error {
 		historyCallCount++
 		require.Equal(t, models.EventIssued, entry.Event)
+		require.Equal(t, models.Accepted, entry.PreviousStatus)
+		require.Equal(t, models.Issued, entry.NewStatus)
 		require.Contains(t, []uint64{1, 2}, entry.OrderID)
 		return nil
 	})
@@ -196,7 +307,318 @@ func TestDefaultOrderService_IssueOrders_Success(t *testing.T) {
 	require.Equal(t, 2, historyCallCount)
 }
 
+// TestDefaultOrderService_IssueOrders_Atomic verifies that an atomic batch is applied only when every order passes validation.
+func TestDefaultOrderService_IssueOrders_Atomic(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		invalid   bool
+		wantSaves int
+		wantCodes []apperrors.ErrorCode
+	}{
+		{
+			name:      "all valid orders are committed together",
+			wantSaves: 2,
+			wantCodes: []apperrors.ErrorCode{"", ""},
+		},
+		{
+			name:      "one invalid order aborts the batch",
+			invalid:   true,
+			wantCodes: []apperrors.ErrorCode{apperrors.BatchAborted, apperrors.StorageExpired},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			deps := newTestOrderService(t)
+			req := requests.IssueOrdersRequest{OrderIDs: []uint64{1, 2}, UserID: 42, Atomic: true}
+			order1 := builders.NewOrderBuilder(deps.clk).WithID(1).WithUserID(42).WithStatus(models.Accepted).Build()
+			order2 := builders.NewOrderBuilder(deps.clk).WithID(2).WithUserID(42).WithStatus(models.Accepted).Build()
+			deps.repo.LoadMock.When(deps.ctx, uint64(1)).Then(order1, nil)
+			deps.repo.LoadMock.When(deps.ctx, uint64(2)).Then(order2, nil)
+			deps.validator.ValidateIssueMock.When(order1, req).Then(nil)
+			var secondErr error
+			if tt.invalid {
+				secondErr = apperrors.Newf(apperrors.StorageExpired, "expired")
+			}
+			deps.validator.ValidateIssueMock.When(order2, req).Then(secondErr)
+			deps.actorSvc.DetermineActorMock.Return(models.Actor{}, nil)
+			saves := 0
+			if tt.wantSaves > 0 {
+				deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
+					saves++
+					return order.Version + 1, nil
+				})
+				deps.outboxRepo.CreateMock.Return(nil)
+				deps.history.RecordMock.Return(nil)
+			}
+
+			results, err := deps.svc.IssueOrders(deps.ctx, req)
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+			for i, res := range results {
+				require.Equal(t, req.OrderIDs[i], res.OrderID)
+				if tt.wantCodes[i] == "" {
+					require.NoError(t, res.Error)
+					continue
+				}
+				var ae *apperrors.AppError
+				require.ErrorAs(t, res.Error, &ae)
+				require.Equal(t, tt.wantCodes[i], ae.Code)
+			}
+			require.Equal(t, tt.wantSaves, saves)
+		})
+	}
+}
+
+// TestDefaultOrderService_IssueOrders_AtomicFailedRow verifies that a repeated order is rejected before the transaction
+// and that a failed save is reported for the row that failed.
+func TestDefaultOrderService_IssueOrders_AtomicFailedRow(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		orderIDs  []uint64
+		saveErr   error
+		wantCodes []apperrors.ErrorCode
+	}{
+		{
+			name:      "repeated order",
+			orderIDs:  []uint64{1, 2, 1},
+			wantCodes: []apperrors.ErrorCode{apperrors.BatchAborted, apperrors.BatchAborted, apperrors.InvalidBatchEntry},
+		},
+		{
+			name:      "concurrent modification of the second order",
+			orderIDs:  []uint64{1, 2},
+			saveErr:   repositories.ErrConcurrentModification,
+			wantCodes: []apperrors.ErrorCode{apperrors.BatchAborted, apperrors.ConcurrentModification},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			deps := newTestOrderService(t)
+			req := requests.IssueOrdersRequest{OrderIDs: tt.orderIDs, UserID: 42, Atomic: true}
+			for _, id := range []uint64{1, 2} {
+				order := builders.NewOrderBuilder(deps.clk).WithID(id).WithUserID(42).WithStatus(models.Accepted).Build()
+				deps.repo.LoadMock.When(deps.ctx, id).Then(order, nil)
+				deps.validator.ValidateIssueMock.When(order, req).Then(nil)
+			}
+			deps.actorSvc.DetermineActorMock.Return(models.Actor{}, nil)
+			if tt.saveErr != nil {
+				deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
+					if order.OrderID == 2 {
+						return 0, tt.saveErr
+					}
+					return order.Version + 1, nil
+				})
+				deps.outboxRepo.CreateMock.Return(nil)
+				deps.history.RecordMock.Return(nil)
+			}
+
+			results, err := deps.svc.IssueOrders(deps.ctx, req)
+			require.NoError(t, err)
+			require.Len(t, results, len(tt.wantCodes))
+			for i, res := range results {
+				require.Equal(t, tt.orderIDs[i], res.OrderID)
+				var ae *apperrors.AppError
+				require.ErrorAs(t, res.Error, &ae)
+				require.Equal(t, tt.wantCodes[i], ae.Code)
+			}
+		})
+	}
+}
+
+// TestDefaultOrderService_AtomicNotSupported verifies that atomic batches are rejected as a whole
+// when the storage cannot roll them back, before any order is loaded.
+func TestDefaultOrderService_AtomicNotSupported(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	svc := NewDefaultOrderService(&clock.FakeClock{}, &SyncPoolStub{}, db.NewNoOpTxRunner(), repmocks.NewOrderRepositoryMock(t),
+		nil, nil, nil, nil, nil, nil, 0, false, nil)
+	tests := []struct {
+		name string
+		call func() ([]models.BatchEntryProcessedResult, error)
+	}{
+		{
+			name: "issue",
+			call: func() ([]models.BatchEntryProcessedResult, error) {
+				return svc.IssueOrders(ctx, requests.IssueOrdersRequest{OrderIDs: []uint64{1, 2}, UserID: 42, Atomic: true})
+			},
+		},
+		{
+			name: "client return",
+			call: func() ([]models.BatchEntryProcessedResult, error) {
+				return svc.CreateClientReturns(ctx, requests.ClientReturnsRequest{OrderIDs: []uint64{1, 2}, UserID: 42, Atomic: true})
+			},
+		},
+		{
+			name: "import",
+			call: func() ([]models.BatchEntryProcessedResult, error) {
+				return svc.ImportOrders(ctx, requests.ImportOrdersRequest{
+					Atomic:   true,
+					Statuses: []requests.ImportOrderStatus{{ItemNumber: 1, OrderID: 5, Request: &requests.AcceptOrderRequest{OrderID: 5}}},
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			results, err := tt.call()
+			require.Nil(t, results)
+			var ae *apperrors.AppError
+			require.ErrorAs(t, err, &ae)
+			require.Equal(t, apperrors.AtomicNotSupported, ae.Code)
+		})
+	}
+}
+
+// TestDefaultOrderService_ImportOrders_AtomicParseError verifies that a malformed row keeps the atomic import from storing anything.
+func TestDefaultOrderService_ImportOrders_AtomicParseError(t *testing.T) {
+	t.Parallel()
+	deps := newTestOrderService(t)
+	valid := requests.AcceptOrderRequest{
+		OrderID:   5,
+		UserID:    42,
+		ExpiresAt: deps.clk.Now().Add(48 * time.Hour),
+		Weight:    1,
+		Price:     100,
+		Package:   models.PackageBag,
+	}
+	req := requests.ImportOrdersRequest{
+		Atomic: true,
+		Statuses: []requests.ImportOrderStatus{
+			{ItemNumber: 1, OrderID: 5, Request: &valid},
+			{ItemNumber: 2, OrderID: 6, Request: &requests.AcceptOrderRequest{}, Error: apperrors.Newf(apperrors.ValidationFailed, "bad weight")},
+		},
+	}
+	deps.repo.LoadMock.Return(models.Order{}, repositories.ErrOrderNotFound)
+	deps.validator.ValidateAcceptMock.Return(nil)
+	deps.pricing.EvaluateMock.Return(110, nil)
+	deps.actorSvc.DetermineActorMock.Return(models.Actor{}, nil)
+
+	results, err := deps.svc.ImportOrders(deps.ctx, req)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	var ae *apperrors.AppError
+	require.ErrorAs(t, results[0].Error, &ae)
+	require.Equal(t, apperrors.BatchAborted, ae.Code)
+	require.ErrorAs(t, results[1].Error, &ae)
+	require.Equal(t, apperrors.ValidationFailed, ae.Code)
+	require.Equal(t, uint64(6), results[1].OrderID)
+}
+
+// TestDefaultOrderService_ImportOrders_ValidateOnly verifies that a dry run prices valid rows, reports invalid and repeated ones and stores nothing.
+func TestDefaultOrderService_ImportOrders_ValidateOnly(t *testing.T) {
+	t.Parallel()
+	deps := newTestOrderService(t)
+	accept := func(id uint64, weight float32) *requests.AcceptOrderRequest {
+		return &requests.AcceptOrderRequest{
+			OrderID:   id,
+			UserID:    42,
+			ExpiresAt: deps.clk.Now().Add(48 * time.Hour),
+			Weight:    weight,
+			Price:     100,
+			Package:   models.PackageBag,
+		}
+	}
+	req := requests.ImportOrdersRequest{
+		ValidateOnly: true,
+		Statuses: []requests.ImportOrderStatus{
+			{ItemNumber: 1, OrderID: 5, Request: accept(5, 1)},
+			{ItemNumber: 2, OrderID: 6, Error: apperrors.Newf(apperrors.InvalidBatchEntry, "bad weight")},
+			{ItemNumber: 3, OrderID: 7, Request: accept(7, 50)},
+			{ItemNumber: 4, OrderID: 5, Request: accept(5, 1)},
+		},
+	}
+	deps.repo.LoadMock.Return(models.Order{}, repositories.ErrOrderNotFound)
+	deps.validator.ValidateAcceptMock.Set(func(_ models.Order, r requests.AcceptOrderRequest) error {
+		if r.Weight > 30 {
+			return apperrors.Newf(apperrors.WeightTooHeavy, "too heavy")
+		}
+		return nil
+	})
+	deps.pricing.EvaluateMock.Return(110, nil)
+
+	results, err := deps.svc.ImportOrders(deps.ctx, req)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	require.NoError(t, results[0].Error)
+	require.Equal(t, float32(110), results[0].TotalPrice)
+	wantCodes := []apperrors.ErrorCode{"", apperrors.InvalidBatchEntry, apperrors.WeightTooHeavy, apperrors.OrderAlreadyExists}
+	for i, code := range wantCodes[1:] {
+		var ae *apperrors.AppError
+		require.ErrorAs(t, results[i+1].Error, &ae)
+		require.Equal(t, code, ae.Code)
+	}
+	require.Equal(t, uint64(6), results[1].OrderID)
+}
+
 // TestDefaultOrderService_IssueOrders_FailureCases ensures the IssueOrders function properly handles various failure scenarios.
+func TestDefaultOrderService_IssueAllReady(t *testing.T) {
+	t.Parallel()
+	deps := newTestOrderService(t)
+	ready := builders.NewOrderBuilder(deps.clk).WithID(1).WithUserID(42).WithStatus(models.Accepted).WithPrice(120).
+		WithUpdatedStatusAt(deps.clk.Now().Add(-24 * time.Hour)).Build()
+	ready.Version = 4
+	restricted := builders.NewOrderBuilder(deps.clk).WithID(2).WithUserID(42).WithStatus(models.Accepted).WithPrice(80).Build()
+	restricted.AgeRestricted = true
+	another := builders.NewOrderBuilder(deps.clk).WithID(3).WithUserID(42).WithStatus(models.Accepted).WithPrice(30.5).Build()
+
+	deps.repo.ListMock.Set(func(ctx context.Context, filter requests.OrdersFilterRequest) ([]models.Order, int, error) {
+		require.Equal(t, uint64(42), *filter.UserID)
+		require.Equal(t, models.Accepted, *filter.Status)
+		return []models.Order{ready, restricted, another}, 3, nil
+	})
+	deps.validator.ValidateIssueMock.Set(func(o models.Order, req requests.IssueOrdersRequest) error {
+		if o.AgeRestricted {
+			return apperrors.Newf(apperrors.IdentityRequired, "order %d is age-restricted", o.OrderID)
+		}
+		return nil
+	})
+	deps.repo.LoadMock.When(deps.ctx, uint64(1)).Then(ready, nil)
+	deps.repo.LoadMock.When(deps.ctx, uint64(3)).Then(another, nil)
+	deps.actorSvc.DetermineActorMock.Return(models.Actor{}, nil)
+	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
+		if order.OrderID == 3 {
+			return 0, errors.New("db down")
+		}
+		return order.Version + 1, nil
+	})
+	deps.outboxRepo.CreateMock.Return(nil)
+	deps.history.RecordMock.Return(nil)
+
+	receipt, err := deps.svc.IssueAllReady(deps.ctx, requests.IssueAllReadyRequest{UserID: 42})
+	require.NoError(t, err)
+	require.Len(t, receipt.Issued, 1)
+	require.Equal(t, uint64(1), receipt.Issued[0].OrderID)
+	require.Equal(t, models.Issued, receipt.Issued[0].Status)
+	require.Equal(t, deps.clk.Now(), receipt.Issued[0].UpdatedStatusAt)
+	require.Equal(t, int64(5), receipt.Issued[0].Version)
+	require.Equal(t, float32(120), receipt.Total)
+	require.Len(t, receipt.Skipped, 2)
+	require.Equal(t, uint64(2), receipt.Skipped[0].OrderID)
+	require.Equal(t, string(apperrors.IdentityRequired), apperrors.CodeFromError(receipt.Skipped[0].Error))
+	require.Equal(t, uint64(3), receipt.Skipped[1].OrderID)
+	require.Equal(t, string(apperrors.InternalError), apperrors.CodeFromError(receipt.Skipped[1].Error))
+}
+
+func TestDefaultOrderService_IssueAllReady_ListFails(t *testing.T) {
+	t.Parallel()
+	deps := newTestOrderService(t)
+	deps.repo.ListMock.Return(nil, 0, errors.New("db down"))
+
+	_, err := deps.svc.IssueAllReady(deps.ctx, requests.IssueAllReadyRequest{UserID: 42})
+	var ae *apperrors.AppError
+	require.ErrorAs(t, err, &ae)
+	require.Equal(t, apperrors.InternalError, ae.Code)
+}
+
 func TestDefaultOrderService_IssueOrders_FailureCases(t *testing.T) {
 	t.Parallel()
 	type tc struct {
@@ -225,6 +647,11 @@ func TestDefaultOrderService_IssueOrders_FailureCases(t *testing.T) {
 			saveErr:     apperrors.Newf(apperrors.InternalError, "db"),
 			wantErrCode: apperrors.InternalError,
 		},
+		{
+			name:        "concurrent modification",
+			saveErr:     repositories.ErrConcurrentModification,
+			wantErrCode: apperrors.ConcurrentModification,
+		},
 	}
 
 	for _, tc := range cases {
@@ -252,13 +679,13 @@ func TestDefaultOrderService_IssueOrders_FailureCases(t *testing.T) {
 					require.Equal(t, uint64(42), userID)
 					return models.Actor{}, nil
 				})
-				deps.repo.SaveMock.Set(func(ctx context.Context, savedOrder models.Order) error {
+				deps.repo.SaveMock.Set(func(ctx context.Context, savedOrder models.Order) (int64, error) {
 					require.Equal(t, models.Issued, savedOrder.Status)
 					require.Equal(t, uint64(7), savedOrder.OrderID)
-					return tc.saveErr
+					return 0, tc.saveErr
 				})
 				if tc.saveErr == nil {
-					deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+					deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
 						return nil
 					})
 					deps.history.RecordMock.Set(func(ctx context.Context, entry models.HistoryEntry) error {
@@ -308,14 +735,14 @@ func TestDefaultOrderService_CreateClientReturns_Success(t *testing.T) {
 		return models.Actor{}, nil
 	})
 	saveCallCount := 0
-	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) error {
+	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
 		saveCallCount++
 		require.Equal(t, models.Returned, order.Status)
 		require.Contains(t, []uint64{1, 2}, order.OrderID)
-		return nil
+		return order.Version + 1, nil
 	})
 	outboxCallCount := 0
-	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
 		outboxCallCount++
 		require.Greater(t, len(payload), 0)
 		require.Contains(t, string(payload), "order_returned_by_client")
@@ -395,13 +822,13 @@ func TestDefaultOrderService_CreateClientReturns_FailureCases(t *testing.T) {
 					require.Equal(t, uint64(123), userID)
 					return models.Actor{}, nil
 				})
-				deps.repo.SaveMock.Set(func(ctx context.Context, savedOrder models.Order) error {
+				deps.repo.SaveMock.Set(func(ctx context.Context, savedOrder models.Order) (int64, error) {
 					require.Equal(t, models.Returned, savedOrder.Status)
 					require.Equal(t, uint64(42), savedOrder.OrderID)
-					return tc.saveErr
+					return 0, tc.saveErr
 				})
 				if tc.saveErr == nil {
-					deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+					deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
 						return nil
 					})
 					deps.history.RecordMock.Set(func(ctx context.Context, entry models.HistoryEntry) error {
@@ -433,6 +860,7 @@ func TestDefaultOrderService_ReturnToCourier_Success(t *testing.T) {
 		OrderID: 99,
 		UserID:  42,
 		Status:  models.Returned,
+		Version: 3,
 	}
 	deps.repo.LoadMock.
 		Expect(deps.ctx, uint64(99)).
@@ -446,13 +874,14 @@ func TestDefaultOrderService_ReturnToCourier_Success(t *testing.T) {
 		return models.Actor{}, nil
 	})
 	deleteCallCount := 0
-	deps.repo.DeleteMock.Set(func(ctx context.Context, orderID uint64) error {
+	deps.repo.DeleteMock.Set(func(ctx context.Context, orderID uint64, version int64) error {
 		deleteCallCount++
 		require.Equal(t, uint64(99), orderID)
+		require.Equal(t, int64(3), version)
 		return nil
 	})
 	outboxCallCount := 0
-	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
 		outboxCallCount++
 		require.Greater(t, len(payload), 0)
 		require.Contains(t, string(payload), "order_returned_to_courier")
@@ -505,12 +934,26 @@ func TestDefaultOrderService_ReturnToCourier_Failures(t *testing.T) {
 				deps.actorSvc.DetermineActorMock.Set(func(ctx context.Context, event models.EventType, userID uint64) (models.Actor, error) {
 					return models.Actor{}, nil
 				})
-				deps.repo.DeleteMock.Set(func(ctx context.Context, orderID uint64) error {
+				deps.repo.DeleteMock.Set(func(ctx context.Context, orderID uint64, version int64) error {
 					return errors.New("db")
 				})
 			},
 			wantCode: apperrors.InternalError,
 		},
+		{
+			name: "modified after load",
+			setup: func(deps orderSvcDeps) {
+				order := models.Order{OrderID: 1, UserID: 42, Version: 2}
+				deps.repo.LoadMock.Expect(deps.ctx, uint64(1)).Return(order, nil)
+				deps.validator.ValidateReturnToCourierMock.Expect(order).Return(nil)
+				deps.actorSvc.DetermineActorMock.Return(models.Actor{}, nil)
+				deps.repo.DeleteMock.Set(func(ctx context.Context, orderID uint64, version int64) error {
+					require.Equal(t, int64(2), version)
+					return repositories.ErrConcurrentModification
+				})
+			},
+			wantCode: apperrors.ConcurrentModification,
+		},
 		{
 			name: "history record fails",
 			setup: func(deps orderSvcDeps) {
@@ -520,10 +963,8 @@ func TestDefaultOrderService_ReturnToCourier_Failures(t *testing.T) {
 				deps.actorSvc.DetermineActorMock.Set(func(ctx context.Context, event models.EventType, userID uint64) (models.Actor, error) {
 					return models.Actor{}, nil
 				})
-				deps.repo.DeleteMock.Set(func(ctx context.Context, orderID uint64) error {
-					return nil
-				})
-				deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+				deps.repo.DeleteMock.Return(nil)
+				deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
 					return nil
 				})
 				deps.history.RecordMock.Set(func(ctx context.Context, entry models.HistoryEntry) error {
@@ -549,6 +990,151 @@ func TestDefaultOrderService_ReturnToCourier_Failures(t *testing.T) {
 	}
 }
 
+// TestDefaultOrderService_ReturnToCourier_StaleVersion verifies that an outdated If-Match version is rejected before any write.
+func TestDefaultOrderService_ReturnToCourier_StaleVersion(t *testing.T) {
+	t.Parallel()
+	deps := newTestOrderService(t)
+	order := models.Order{OrderID: 1, UserID: 42, Status: models.Returned, Version: 3}
+	deps.repo.LoadMock.Expect(deps.ctx, uint64(1)).Return(order, nil)
+
+	err := deps.svc.ReturnToCourier(deps.ctx, requests.ReturnOrderRequest{OrderID: 1, ExpectedVersion: utils.Ptr(int64(2))})
+	var ae *apperrors.AppError
+	require.ErrorAs(t, err, &ae)
+	require.Equal(t, apperrors.ConcurrentModification, ae.Code)
+}
+
+// TestDefaultOrderService_UndoLastOperation tests reverting the last transition of an order within the grace period.
+func TestDefaultOrderService_UndoLastOperation(t *testing.T) {
+	t.Parallel()
+	type tc struct {
+		name        string
+		operatorID  string
+		entries     []models.HistoryEntry
+		order       models.Order
+		wantCode    apperrors.ErrorCode
+		wantStatus  models.OrderStatus
+		wantRemoved bool
+	}
+	now := (&clock.FakeClock{}).Now()
+	entry := func(event models.EventType, ago time.Duration, op string) models.HistoryEntry {
+		return models.HistoryEntry{OrderID: 1, Event: event, Timestamp: now.Add(-ago), OperatorID: op}
+	}
+	cases := []tc{
+		{
+			name:       "undo issue",
+			operatorID: "op1",
+			entries:    []models.HistoryEntry{entry(models.EventIssued, time.Minute, "op1"), entry(models.EventAccepted, time.Hour, "op1")},
+			order:      models.Order{OrderID: 1, UserID: 42, Status: models.Issued},
+			wantStatus: models.Accepted,
+		},
+		{
+			name:       "undo client return",
+			operatorID: "op1",
+			entries:    []models.HistoryEntry{entry(models.EventReturnedByClient, time.Minute, "op1"), entry(models.EventIssued, time.Hour, "op2")},
+			order:      models.Order{OrderID: 1, UserID: 42, Status: models.Returned},
+			wantStatus: models.Issued,
+		},
+		{
+			name:        "undo accept removes order",
+			operatorID:  "op1",
+			entries:     []models.HistoryEntry{entry(models.EventAccepted, time.Minute, "op1")},
+			order:       models.Order{OrderID: 1, UserID: 42, Status: models.Accepted},
+			wantRemoved: true,
+		},
+		{
+			name:     "no operator",
+			entries:  []models.HistoryEntry{entry(models.EventIssued, time.Minute, "op1")},
+			wantCode: apperrors.UndoForbidden,
+		},
+		{
+			name:       "other operator",
+			operatorID: "op2",
+			entries:    []models.HistoryEntry{entry(models.EventIssued, time.Minute, "op1")},
+			wantCode:   apperrors.UndoForbidden,
+		},
+		{
+			name:       "grace period expired",
+			operatorID: "op1",
+			entries:    []models.HistoryEntry{entry(models.EventIssued, time.Hour, "op1")},
+			wantCode:   apperrors.UndoNotPossible,
+		},
+		{
+			name:       "already undone",
+			operatorID: "op1",
+			entries:    []models.HistoryEntry{entry(models.EventUndone, time.Minute, "op1"), entry(models.EventIssued, 2*time.Minute, "op1")},
+			wantCode:   apperrors.UndoNotPossible,
+		},
+		{
+			name:       "returned to warehouse",
+			operatorID: "op1",
+			entries:    []models.HistoryEntry{entry(models.EventReturnedToWarehouse, time.Minute, "op1")},
+			wantCode:   apperrors.UndoNotPossible,
+		},
+		{
+			name:       "no history",
+			operatorID: "op1",
+			wantCode:   apperrors.OrderNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			deps := newTestOrderService(t)
+			ctx := deps.ctx
+			if tc.operatorID != "" {
+				ctx = operator.WithID(ctx, tc.operatorID)
+			}
+			ctx = correlation.WithID(ctx, "corr-1")
+			deps.history.ListMock.Optional().Set(func(ctx context.Context, filter requests.OrderHistoryFilter) ([]models.HistoryEntry, error) {
+				require.Equal(t, uint64(1), *filter.OrderID)
+				return tc.entries, nil
+			})
+
+			if tc.wantCode == "" {
+				deps.repo.LoadMock.Return(tc.order, nil)
+				if tc.wantRemoved {
+					deps.repo.DeleteMock.Return(nil)
+				} else {
+					deps.repo.SaveMock.Set(func(ctx context.Context, o models.Order) (int64, error) {
+						require.Equal(t, tc.wantStatus, o.Status)
+						require.Equal(t, tc.entries[1].Timestamp, o.UpdatedStatusAt)
+						return o.Version + 1, nil
+					})
+				}
+				deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
+					require.Contains(t, string(payload), "order_transition_undone")
+					require.Contains(t, string(payload), tc.operatorID)
+					return nil
+				})
+				deps.history.RecordMock.Set(func(ctx context.Context, e models.HistoryEntry) error {
+					require.Equal(t, models.EventUndone, e.Event)
+					require.Equal(t, tc.operatorID, e.OperatorID)
+					require.Equal(t, models.ActorOperator, e.ActorType)
+					require.Equal(t, "corr-1", e.CorrelationID)
+					require.Equal(t, tc.order.Status, e.PreviousStatus)
+					require.Equal(t, tc.wantStatus, e.NewStatus)
+					require.JSONEq(t, `{"undone_event":"`+tc.entries[0].Event.String()+`"}`, string(e.Metadata))
+					return nil
+				})
+			}
+
+			res, err := deps.svc.UndoLastOperation(ctx, requests.UndoRequest{OrderID: 1})
+			if tc.wantCode != "" {
+				var ae *apperrors.AppError
+				require.ErrorAs(t, err, &ae)
+				require.Equal(t, tc.wantCode, ae.Code)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.entries[0].Event, res.Undone)
+			require.Equal(t, tc.wantRemoved, res.Removed)
+			require.Equal(t, tc.wantStatus, res.Status)
+		})
+	}
+}
+
 // TestDefaultOrderService_ListOrders tests the ListOrders method of DefaultOrderService with mock dependencies and varying scenarios.
 func TestDefaultOrderService_ListOrders(t *testing.T) {
 	t.Parallel()
@@ -581,18 +1167,109 @@ func TestDefaultOrderService_ListOrders(t *testing.T) {
 	require.Equal(t, 2, cnt)
 }
 
+// TestDefaultOrderService_ListOrders_Search verifies that search filters are resolved before querying the repository.
+func TestDefaultOrderService_ListOrders_Search(t *testing.T) {
+	t.Parallel()
+
+	t.Run("expiring within becomes expiry range", func(t *testing.T) {
+		t.Parallel()
+		deps := newTestOrderServiceMinimal(t)
+		now := deps.clk.Now()
+		later := now.Add(48 * time.Hour)
+		deps.repo.ListMock.
+			Expect(deps.ctx, requests.OrdersFilterRequest{
+				ExpiresFrom: &now,
+				ExpiresTo:   utils.Ptr(now.Add(24 * time.Hour)),
+				SortBy:      requests.SortByExpiresAt,
+			}).
+			Return(nil, 0, nil)
+		_, _, _, err := deps.svc.ListOrders(deps.ctx, requests.OrdersFilterRequest{
+			ExpiresTo:      &later,
+			ExpiringWithin: utils.Ptr(24 * time.Hour),
+			SortBy:         requests.SortByExpiresAt,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("inverted price range is rejected", func(t *testing.T) {
+		t.Parallel()
+		deps := newTestOrderServiceMinimal(t)
+		_, _, _, err := deps.svc.ListOrders(deps.ctx, requests.OrdersFilterRequest{
+			PriceMin: utils.Ptr(float32(200)),
+			PriceMax: utils.Ptr(float32(100)),
+		})
+		var ae *apperrors.AppError
+		require.ErrorAs(t, err, &ae)
+		require.Equal(t, apperrors.ValidationFailed, ae.Code)
+	})
+}
+
+// TestDefaultOrderService_GetOrder tests the single-order lookup with its latest history and status-dependent deadlines.
+func TestDefaultOrderService_GetOrder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accepted order has storage deadline", func(t *testing.T) {
+		t.Parallel()
+		deps := newTestOrderService(t)
+		expires := deps.clk.Now().Add(72 * time.Hour)
+		order := builders.NewOrderBuilder(deps.clk).WithID(1).WithStatus(models.Accepted).WithExpiresAt(expires).Build()
+		history := []models.HistoryEntry{{OrderID: 1, Event: models.EventAccepted, Timestamp: deps.clk.Now()}}
+		deps.repo.LoadMock.Expect(deps.ctx, uint64(1)).Return(order, nil)
+		deps.history.ListMock.
+			Expect(deps.ctx, requests.OrderHistoryFilter{
+				OrderID: utils.Ptr(uint64(1)),
+				Page:    constants.DefaultHistoryPage,
+				Limit:   constants.OrderHistoryPreview,
+			}).
+			Return(history, nil)
+
+		details, err := deps.svc.GetOrder(deps.ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, order, details.Order)
+		require.Equal(t, history, details.History)
+		require.Equal(t, &expires, details.StorageDeadline)
+		require.Nil(t, details.ReturnDeadline)
+	})
+
+	t.Run("issued order has return deadline", func(t *testing.T) {
+		t.Parallel()
+		deps := newTestOrderService(t)
+		issuedAt := deps.clk.Now().Add(-time.Hour)
+		order := builders.NewOrderBuilder(deps.clk).WithID(2).WithStatus(models.Issued).WithUpdatedStatusAt(issuedAt).Build()
+		deps.repo.LoadMock.Expect(deps.ctx, uint64(2)).Return(order, nil)
+		deps.history.ListMock.Return(nil, nil)
+
+		details, err := deps.svc.GetOrder(deps.ctx, 2)
+		require.NoError(t, err)
+		require.Nil(t, details.StorageDeadline)
+		require.Equal(t, issuedAt.Add(constants.ReturnWindow), *details.ReturnDeadline)
+	})
+
+	t.Run("missing order is not found", func(t *testing.T) {
+		t.Parallel()
+		deps := newTestOrderService(t)
+		deps.repo.LoadMock.Expect(deps.ctx, uint64(3)).Return(models.Order{}, errors.New("order not found"))
+
+		_, err := deps.svc.GetOrder(deps.ctx, 3)
+		var ae *apperrors.AppError
+		require.ErrorAs(t, err, &ae)
+		require.Equal(t, apperrors.OrderNotFound, ae.Code)
+	})
+}
+
 // TestDefaultOrderService_ListReturns verifies the behavior of ListReturns in DefaultOrderService.
 func TestDefaultOrderService_ListReturns(t *testing.T) {
 	t.Parallel()
 	deps := newTestOrderServiceMinimal(t)
 	expected := []models.Order{{OrderID: 5}, {OrderID: 6}}
+	byReturnDate := requests.OrdersFilterRequest{SortBy: requests.SortByUpdatedStatusAt}
 	deps.repo.ListMock.
-		Expect(deps.ctx, requests.OrdersFilterRequest{}).
+		Expect(deps.ctx, byReturnDate).
 		Return(nil, 0, errors.New("err"))
 	_, err := deps.svc.ListReturns(deps.ctx, requests.OrdersFilterRequest{})
 	require.Error(t, err)
 	deps.repo.ListMock.
-		Expect(deps.ctx, requests.OrdersFilterRequest{}).
+		Expect(deps.ctx, byReturnDate).
 		Return(expected, 0, nil)
 	res, err := deps.svc.ListReturns(deps.ctx, requests.OrdersFilterRequest{})
 	require.NoError(t, err)
@@ -668,6 +1345,8 @@ type orderSvcDeps struct {
 	history    *svcmocks.HistoryServiceMock
 	pricing    *svcmocks.PackagePricingServiceMock
 	actorSvc   *svcmocks.ActorServiceMock
+	calendar   *svcmocks.CalendarServiceMock
+	storage    *stratmocks.StoragePolicyStrategyMock
 	validator  *valmocks.OrderValidatorMock
 	txRunner   *db.NoOpTxRunner
 	ctx        context.Context
@@ -680,13 +1359,16 @@ func newTestOrderService(t *testing.T) orderSvcDeps {
 	history := svcmocks.NewHistoryServiceMock(t)
 	pricing := svcmocks.NewPackagePricingServiceMock(t)
 	actorSvc := svcmocks.NewActorServiceMock(t)
+	calendar := svcmocks.NewCalendarServiceMock(t)
+	calendar.CurrentMock.Optional().Return(models.PickupCalendar{})
+	storage := stratmocks.NewStoragePolicyStrategyMock(t)
 	validator := valmocks.NewOrderValidatorMock(t)
 	txRunner := db.NewNoOpTxRunner()
 	clk := &clock.FakeClock{}
 	pool := &SyncPoolStub{}
 	ctx := context.Background()
-	svc := NewDefaultOrderService(clk, pool, txRunner, repo, outboxRepo, pricing, history, actorSvc, validator)
-	return orderSvcDeps{svc, repo, outboxRepo, history, pricing, actorSvc, validator, txRunner, ctx, clk}
+	svc := NewDefaultOrderService(clk, pool, txRunner, repo, outboxRepo, pricing, history, actorSvc, calendar, storage, constants.DefaultUndoGracePeriod, true, validator)
+	return orderSvcDeps{svc, repo, outboxRepo, history, pricing, actorSvc, calendar, storage, validator, txRunner, ctx, clk}
 }
 
 type orderSvcDepsMinimal struct {
@@ -701,7 +1383,7 @@ func newTestOrderServiceMinimal(t *testing.T) orderSvcDepsMinimal {
 	clk := &clock.FakeClock{}
 	pool := &SyncPoolStub{}
 	txRunner := db.NewNoOpTxRunner()
-	svc := NewDefaultOrderService(clk, pool, txRunner, repo, nil, nil, nil, nil, nil)
+	svc := NewDefaultOrderService(clk, pool, txRunner, repo, nil, nil, nil, nil, nil, nil, 0, true, nil)
 	ctx := context.Background()
 	return orderSvcDepsMinimal{svc: svc, repo: repo, ctx: ctx, clk: clk}
 }
@@ -777,7 +1459,7 @@ func mockAcceptFailureEvaluation(deps orderSvcDeps, req requests.AcceptOrderRequ
 		Expect(models.Order{}, req).
 		Return(nil)
 	deps.pricing.EvaluateMock.
-		Expect(req.Package, req.Weight, req.Price).
+		Expect(req.Package, req.Weight, req.Price, req.Handling).
 		Return(0, mockErr)
 }
 
@@ -787,13 +1469,13 @@ func mockAcceptFailureSave(deps orderSvcDeps, req requests.AcceptOrderRequest, m
 		Expect(models.Order{}, req).
 		Return(nil)
 	deps.pricing.EvaluateMock.
-		Expect(req.Package, req.Weight, req.Price).
+		Expect(req.Package, req.Weight, req.Price, req.Handling).
 		Return(75.0, nil)
 	deps.actorSvc.DetermineActorMock.
 		Expect(deps.ctx, models.EventAccepted, req.UserID).
 		Return(models.Actor{}, nil)
-	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) error {
-		return mockErr
+	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
+		return 0, mockErr
 	})
 }
 
@@ -803,15 +1485,15 @@ func mockAcceptFailureOutbox(deps orderSvcDeps, req requests.AcceptOrderRequest,
 		Expect(models.Order{}, req).
 		Return(nil)
 	deps.pricing.EvaluateMock.
-		Expect(req.Package, req.Weight, req.Price).
+		Expect(req.Package, req.Weight, req.Price, req.Handling).
 		Return(75.0, nil)
 	deps.actorSvc.DetermineActorMock.
 		Expect(deps.ctx, models.EventAccepted, req.UserID).
 		Return(models.Actor{}, nil)
-	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) error {
-		return nil
+	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
+		return order.Version + 1, nil
 	})
-	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
 		return mockErr
 	})
 }
@@ -822,15 +1504,15 @@ func mockAcceptFailureRecord(deps orderSvcDeps, req requests.AcceptOrderRequest,
 		Expect(models.Order{}, req).
 		Return(nil)
 	deps.pricing.EvaluateMock.
-		Expect(req.Package, req.Weight, req.Price).
+		Expect(req.Package, req.Weight, req.Price, req.Handling).
 		Return(75.0, nil)
 	deps.actorSvc.DetermineActorMock.
 		Expect(deps.ctx, models.EventAccepted, req.UserID).
 		Return(models.Actor{}, nil)
-	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) error {
-		return nil
+	deps.repo.SaveMock.Set(func(ctx context.Context, order models.Order) (int64, error) {
+		return order.Version + 1, nil
 	})
-	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, orderID uint64, payload []byte) error {
+	deps.outboxRepo.CreateMock.Set(func(ctx context.Context, eventID uint64, _ models.OutboxAggregate, orderID uint64, payload []byte) error {
 		return nil
 	})
 	deps.history.RecordMock.Set(func(ctx context.Context, entry models.HistoryEntry) error {
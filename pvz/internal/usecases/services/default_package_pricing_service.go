@@ -23,8 +23,8 @@ func NewDefaultPackagePricingService(v validators.PackageValidator, s strategies
 	}
 }
 
-// Evaluate calculates package surcharge and validates weight constraints for given package type
-func (s *DefaultPackagePricingService) Evaluate(pkg models.PackageType, weight float32, price float32) (float32, error) {
+// Evaluate calculates package surcharge and validates weight and handling constraints for given package type
+func (s *DefaultPackagePricingService) Evaluate(pkg models.PackageType, weight float32, price float32, flags models.HandlingFlags) (float32, error) {
 	if weight <= 0 {
 		return 0, apperrors.Newf(apperrors.ValidationFailed, "weight must be > 0")
 	}
@@ -32,7 +32,7 @@ func (s *DefaultPackagePricingService) Evaluate(pkg models.PackageType, weight f
 		return 0, apperrors.Newf(apperrors.ValidationFailed, "price must be > 0")
 	}
 
-	if err := s.validator.Validate(pkg, weight); err != nil {
+	if err := s.validator.Validate(pkg, weight, flags); err != nil {
 		return 0, err
 	}
 
@@ -19,9 +19,9 @@ func TestDefaultPackagePricingService_Evaluate_Success(t *testing.T) {
 	pkg := models.PackageBox
 	weight, price := float32(2), float32(100)
 	surcharge := float32(25)
-	v.ValidateMock.Expect(pkg, weight).Return(nil)
+	v.ValidateMock.Expect(pkg, weight, models.HandlingFlags{}).Return(nil)
 	s.GetSurchargeMock.Expect(pkg).Return(surcharge)
-	got, err := svc.Evaluate(pkg, weight, price)
+	got, err := svc.Evaluate(pkg, weight, price, models.HandlingFlags{})
 	require.NoError(t, err)
 	require.Equal(t, price+surcharge, got)
 }
@@ -35,8 +35,8 @@ func TestDefaultPackagePricingService_Evaluate_ValidationError(t *testing.T) {
 	pkg := models.PackageBox
 	weight, price := float32(100), float32(100)
 	vErr := apperrors.Newf(apperrors.ValidationFailed, "too heavy")
-	v.ValidateMock.Expect(pkg, weight).Return(vErr)
-	_, err := svc.Evaluate(pkg, weight, price)
+	v.ValidateMock.Expect(pkg, weight, models.HandlingFlags{}).Return(vErr)
+	_, err := svc.Evaluate(pkg, weight, price, models.HandlingFlags{})
 	require.Error(t, err)
 	var ae *apperrors.AppError
 	require.ErrorAs(t, err, &ae)
@@ -62,7 +62,7 @@ func TestDefaultPackagePricingService_Evaluate_InvalidParams(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			_, err := svc.Evaluate(models.PackageBox, tc.weight, tc.price)
+			_, err := svc.Evaluate(models.PackageBox, tc.weight, tc.price, models.HandlingFlags{})
 			require.Error(t, err)
 			var ae *apperrors.AppError
 			require.ErrorAs(t, err, &ae)
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/internal/usecases/services/validators"
+	"pvz-cli/pkg/clock"
+)
+
+var _ ShipmentService = (*DefaultShipmentService)(nil)
+
+// DefaultShipmentService is a default implementation of the ShipmentService interface
+type DefaultShipmentService struct {
+	clk               clock.Clock
+	txRunner          db.TxRunner
+	shipmentRepo      repositories.ShipmentRepository
+	outboxRepo        repositories.OutboxRepository
+	packagePricingSvc PackagePricingService
+	validator         validators.ShipmentValidator
+}
+
+// NewDefaultShipmentService creates a new instance of DefaultShipmentService
+func NewDefaultShipmentService(
+	clk clock.Clock,
+	txRunner db.TxRunner,
+	shipmentRepo repositories.ShipmentRepository,
+	outboxRepo repositories.OutboxRepository,
+	packagePricingService PackagePricingService,
+	validator validators.ShipmentValidator) *DefaultShipmentService {
+	return &DefaultShipmentService{
+		clk:               clk,
+		txRunner:          txRunner,
+		shipmentRepo:      shipmentRepo,
+		outboxRepo:        outboxRepo,
+		packagePricingSvc: packagePricingService,
+		validator:         validator,
+	}
+}
+
+// RegisterShipment registers a parcel dropped off by a sender and prices it like an inbound order
+func (s *DefaultShipmentService) RegisterShipment(ctx context.Context, req requests.RegisterShipmentRequest) (models.Shipment, error) {
+	if ctx.Err() != nil {
+		return models.Shipment{}, ctx.Err()
+	}
+	if err := s.validator.ValidateRegister(req); err != nil {
+		return models.Shipment{}, err
+	}
+	totalPrice, err := s.packagePricingSvc.Evaluate(req.Package, req.Weight, req.Price, req.Handling)
+	if err != nil {
+		return models.Shipment{}, err
+	}
+	id, err := utils.GenerateID()
+	if err != nil {
+		return models.Shipment{}, apperrors.Newf(apperrors.InternalError, "failed to generate shipment id: %v", err)
+	}
+
+	now := s.clk.Now()
+	shipment := models.Shipment{
+		ShipmentID:         id,
+		SenderID:           req.SenderID,
+		DestinationPointID: req.DestinationPointID,
+		Status:             models.ShipmentRegistered,
+		CreatedAt:          now,
+		UpdatedStatusAt:    now,
+		Package:            req.Package,
+		Weight:             req.Weight,
+		Price:              totalPrice,
+		HandlingFlags:      req.Handling,
+	}
+	actor := models.Actor{Type: models.ActorClient, ID: req.SenderID}
+	if err := s.persistTransition(ctx, shipment, actor); err != nil {
+		return models.Shipment{}, err
+	}
+	return shipment, nil
+}
+
+// HandOverShipment hands a registered shipment over to a courier
+func (s *DefaultShipmentService) HandOverShipment(ctx context.Context, req requests.HandOverShipmentRequest) (models.Shipment, error) {
+	if ctx.Err() != nil {
+		return models.Shipment{}, ctx.Err()
+	}
+	shipment, err := s.load(ctx, req.ShipmentID)
+	if err != nil {
+		return models.Shipment{}, err
+	}
+	if err := s.validator.ValidateHandOver(shipment, req); err != nil {
+		return models.Shipment{}, err
+	}
+
+	shipment.Status = models.ShipmentHandedOver
+	shipment.CourierID = req.CourierID
+	shipment.UpdatedStatusAt = s.clk.Now()
+	actor := models.Actor{Type: models.ActorCourier, ID: req.CourierID}
+	if err := s.persistTransition(ctx, shipment, actor); err != nil {
+		return models.Shipment{}, err
+	}
+	return shipment, nil
+}
+
+// GetShipment returns the shipment with its status history
+func (s *DefaultShipmentService) GetShipment(ctx context.Context, id uint64) (models.Shipment, []models.ShipmentHistoryEntry, error) {
+	if ctx.Err() != nil {
+		return models.Shipment{}, nil, ctx.Err()
+	}
+	shipment, err := s.load(ctx, id)
+	if err != nil {
+		return models.Shipment{}, nil, err
+	}
+	history, err := s.shipmentRepo.ListHistory(ctx, id)
+	if err != nil {
+		return models.Shipment{}, nil, apperrors.Newf(apperrors.InternalError, "failed to list history of shipment %d: %v", id, err)
+	}
+	return shipment, history, nil
+}
+
+func (s *DefaultShipmentService) load(ctx context.Context, id uint64) (models.Shipment, error) {
+	shipment, err := s.shipmentRepo.Load(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrShipmentNotFound) {
+			return models.Shipment{}, apperrors.Newf(apperrors.ShipmentNotFound, "shipment %d not found", id)
+		}
+		return models.Shipment{}, apperrors.Newf(apperrors.InternalError, "failed to load shipment %d: %v", id, err)
+	}
+	return shipment, nil
+}
+
+// persistTransition saves the shipment, its history entry and the outbox event in one transaction
+func (s *DefaultShipmentService) persistTransition(ctx context.Context, shipment models.Shipment, actor models.Actor) error {
+	eventID, err := utils.GenerateID()
+	if err != nil {
+		return apperrors.Newf(apperrors.InternalError, "failed to save event for shipment %d: %v", shipment.ShipmentID, err)
+	}
+	payloadBytes, err := json.Marshal(models.ShipmentKafkaEvent{
+		EventID:   eventID,
+		EventType: models.MapShipmentStatusToKafkaEvent(shipment.Status),
+		Timestamp: shipment.UpdatedStatusAt,
+		Actor:     actor,
+		Shipment:  shipment,
+		Source:    SourceName,
+	})
+	if err != nil {
+		return apperrors.Newf(apperrors.InternalError, "failed to marshal event: %v", err)
+	}
+	entry := models.ShipmentHistoryEntry{
+		ShipmentID: shipment.ShipmentID,
+		Status:     shipment.Status,
+		Timestamp:  shipment.UpdatedStatusAt,
+	}
+
+	return s.txRunner.WithTx(ctx, func(tx pgx.Tx) error {
+		txCtx := ctxWithTx(ctx, tx)
+		if err := s.shipmentRepo.Save(txCtx, shipment); err != nil {
+			return apperrors.Newf(apperrors.InternalError, "failed to save shipment %d: %v", shipment.ShipmentID, err)
+		}
+		if err := s.outboxRepo.Create(txCtx, eventID, models.OutboxAggregateShipment, shipment.ShipmentID, payloadBytes); err != nil {
+			return apperrors.Newf(apperrors.InternalError, "failed to enqueue outbox event: %v", err)
+		}
+		if err := s.shipmentRepo.SaveHistory(txCtx, entry); err != nil {
+			return apperrors.Newf(apperrors.InternalError, "failed to record history entry for shipment %d: %v", shipment.ShipmentID, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/data/repositories"
+	repmocks "pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/infrastructure/db"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	svcmocks "pvz-cli/internal/usecases/services/mocks"
+	valmocks "pvz-cli/internal/usecases/services/validators/mocks"
+	"pvz-cli/pkg/clock"
+	"testing"
+)
+
+type shipmentServiceDeps struct {
+	clk       clock.Clock
+	shipments *repmocks.ShipmentRepositoryMock
+	outbox    *repmocks.OutboxRepositoryMock
+	pricing   *svcmocks.PackagePricingServiceMock
+	validator *valmocks.ShipmentValidatorMock
+}
+
+func newShipmentService(t *testing.T) (*DefaultShipmentService, shipmentServiceDeps) {
+	deps := shipmentServiceDeps{
+		clk:       &clock.FakeClock{},
+		shipments: repmocks.NewShipmentRepositoryMock(t),
+		outbox:    repmocks.NewOutboxRepositoryMock(t),
+		pricing:   svcmocks.NewPackagePricingServiceMock(t),
+		validator: valmocks.NewShipmentValidatorMock(t),
+	}
+	svc := NewDefaultShipmentService(deps.clk, db.NewNoOpTxRunner(), deps.shipments, deps.outbox, deps.pricing, deps.validator)
+	return svc, deps
+}
+
+// TestDefaultShipmentService_RegisterShipment verifies that a drop-off is priced and persisted as registered.
+func TestDefaultShipmentService_RegisterShipment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	svc, deps := newShipmentService(t)
+	req := requests.RegisterShipmentRequest{
+		SenderID:           10,
+		DestinationPointID: 42,
+		Weight:             3,
+		Price:              100,
+		Package:            models.PackageBox,
+		Handling:           models.HandlingFlags{Fragile: true},
+	}
+
+	deps.validator.ValidateRegisterMock.Expect(req).Return(nil)
+	deps.pricing.EvaluateMock.Expect(req.Package, req.Weight, req.Price, req.Handling).Return(125.0, nil)
+	deps.shipments.SaveMock.Set(func(_ context.Context, s models.Shipment) error {
+		require.Equal(t, models.ShipmentRegistered, s.Status)
+		require.Equal(t, float32(125.0), s.Price)
+		return nil
+	})
+	var shipmentID uint64
+	deps.outbox.CreateMock.Set(func(_ context.Context, _ uint64, aggregate models.OutboxAggregate, aggregateID uint64, _ []byte) error {
+		// shipment events are kept apart from order events sharing the outbox
+		require.Equal(t, models.OutboxAggregateShipment, aggregate)
+		shipmentID = aggregateID
+		return nil
+	})
+	deps.shipments.SaveHistoryMock.Return(nil)
+
+	got, err := svc.RegisterShipment(ctx, req)
+	require.NoError(t, err)
+	require.NotZero(t, got.ShipmentID)
+	require.Equal(t, got.ShipmentID, shipmentID)
+	require.Equal(t, req.SenderID, got.SenderID)
+	require.Equal(t, req.DestinationPointID, got.DestinationPointID)
+	require.Equal(t, models.ShipmentRegistered, got.Status)
+	require.Equal(t, deps.clk.Now(), got.CreatedAt)
+}
+
+// TestDefaultShipmentService_HandOverShipment covers hand-over transitions and their failure modes.
+func TestDefaultShipmentService_HandOverShipment(t *testing.T) {
+	t.Parallel()
+	registered := models.Shipment{ShipmentID: 7, SenderID: 10, DestinationPointID: 42, Status: models.ShipmentRegistered}
+	tests := []struct {
+		name        string
+		loaded      models.Shipment
+		loadErr     error
+		validateErr error
+		wantCode    apperrors.ErrorCode
+	}{
+		{
+			name:   "hands over registered shipment",
+			loaded: registered,
+		},
+		{
+			name:     "shipment not found",
+			loadErr:  repositories.ErrShipmentNotFound,
+			wantCode: apperrors.ShipmentNotFound,
+		},
+		{
+			name:     "repository failure",
+			loadErr:  errors.New("db unreachable"),
+			wantCode: apperrors.InternalError,
+		},
+		{
+			name:        "already handed over",
+			loaded:      models.Shipment{ShipmentID: 7, Status: models.ShipmentHandedOver},
+			validateErr: apperrors.Newf(apperrors.ValidationFailed, "shipment 7 is not awaiting hand-over"),
+			wantCode:    apperrors.ValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			svc, deps := newShipmentService(t)
+			req := requests.HandOverShipmentRequest{ShipmentID: 7, CourierID: 99}
+
+			deps.shipments.LoadMock.Expect(ctx, req.ShipmentID).Return(tt.loaded, tt.loadErr)
+			if tt.loadErr == nil {
+				deps.validator.ValidateHandOverMock.Expect(tt.loaded, req).Return(tt.validateErr)
+			}
+			if tt.wantCode == "" {
+				deps.shipments.SaveMock.Return(nil)
+				deps.outbox.CreateMock.Return(nil)
+				deps.shipments.SaveHistoryMock.Return(nil)
+			}
+
+			got, err := svc.HandOverShipment(ctx, req)
+			if tt.wantCode != "" {
+				var ae *apperrors.AppError
+				require.ErrorAs(t, err, &ae)
+				require.Equal(t, tt.wantCode, ae.Code)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, models.ShipmentHandedOver, got.Status)
+			require.Equal(t, req.CourierID, got.CourierID)
+			require.Equal(t, deps.clk.Now(), got.UpdatedStatusAt)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/pkg/clock"
+)
+
+var _ UserDataService = (*DefaultUserDataService)(nil)
+
+// DefaultUserDataService is a default implementation of the UserDataService interface
+type DefaultUserDataService struct {
+	clk          clock.Clock
+	userDataRepo repositories.UserDataRepository
+}
+
+// NewDefaultUserDataService creates a new instance of DefaultUserDataService
+func NewDefaultUserDataService(clk clock.Clock, userDataRepo repositories.UserDataRepository) *DefaultUserDataService {
+	return &DefaultUserDataService{clk: clk, userDataRepo: userDataRepo}
+}
+
+// ExportUserData collects every order, history entry and outbox payload of the user into one bundle
+func (s *DefaultUserDataService) ExportUserData(ctx context.Context, userID uint64) (models.UserDataExport, error) {
+	if ctx.Err() != nil {
+		return models.UserDataExport{}, ctx.Err()
+	}
+	if userID == models.AnonymousUserID {
+		return models.UserDataExport{}, apperrors.Newf(apperrors.ValidationFailed, "user_id must be greater than 0")
+	}
+	out, err := s.userDataRepo.Export(ctx, userID)
+	if err != nil {
+		return models.UserDataExport{}, apperrors.Newf(apperrors.InternalError, "failed to export data of user %d: %v", userID, err)
+	}
+	out.ExportedAt = s.clk.Now()
+	return out, nil
+}
+
+// AnonymizeUserData detaches the user's identity from their orders and events while keeping amounts and statuses
+func (s *DefaultUserDataService) AnonymizeUserData(ctx context.Context, userID uint64) (models.AnonymizeResult, error) {
+	if ctx.Err() != nil {
+		return models.AnonymizeResult{}, ctx.Err()
+	}
+	if userID == models.AnonymousUserID {
+		return models.AnonymizeResult{}, apperrors.Newf(apperrors.ValidationFailed, "user_id must be greater than 0")
+	}
+	res, err := s.userDataRepo.Anonymize(ctx, userID)
+	if errors.Is(err, repositories.ErrUserHasActiveOrders) {
+		return models.AnonymizeResult{}, apperrors.Newf(apperrors.UserHasActiveOrders,
+			"user %d still has orders at the pickup point", userID)
+	}
+	if err != nil {
+		return models.AnonymizeResult{}, apperrors.Newf(apperrors.InternalError, "failed to anonymize data of user %d: %v", userID, err)
+	}
+	return res, nil
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/models"
+	"pvz-cli/pkg/clock"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDefaultUserDataService_ExportUserData verifies that the bundle from the repository is stamped with the export time.
+func TestDefaultUserDataService_ExportUserData(t *testing.T) {
+	t.Parallel()
+	clk := &clock.FakeClock{}
+	repo := mocks.NewUserDataRepositoryMock(t)
+	svc := NewDefaultUserDataService(clk, repo)
+	ctx := context.Background()
+	repo.ExportMock.Expect(ctx, uint64(42)).Return(models.UserDataExport{
+		UserID: 42,
+		Orders: []models.Order{{OrderID: 1, UserID: 42}},
+	}, nil)
+
+	out, err := svc.ExportUserData(ctx, 42)
+	require.NoError(t, err)
+	require.Equal(t, clk.Now(), out.ExportedAt)
+	require.Len(t, out.Orders, 1)
+}
+
+// TestDefaultUserDataService_Failures covers invalid user IDs and repository failures of both operations.
+func TestDefaultUserDataService_Failures(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		userID   uint64
+		setup    func(repo *mocks.UserDataRepositoryMock)
+		call     func(svc *DefaultUserDataService, userID uint64) error
+		wantCode apperrors.ErrorCode
+	}{
+		{
+			name:   "export anonymous user",
+			userID: models.AnonymousUserID,
+			call: func(svc *DefaultUserDataService, userID uint64) error {
+				_, err := svc.ExportUserData(context.Background(), userID)
+				return err
+			},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name:   "export repository failure",
+			userID: 42,
+			setup: func(repo *mocks.UserDataRepositoryMock) {
+				repo.ExportMock.Return(models.UserDataExport{}, errors.New("db down"))
+			},
+			call: func(svc *DefaultUserDataService, userID uint64) error {
+				_, err := svc.ExportUserData(context.Background(), userID)
+				return err
+			},
+			wantCode: apperrors.InternalError,
+		},
+		{
+			name:   "anonymize anonymous user",
+			userID: models.AnonymousUserID,
+			call: func(svc *DefaultUserDataService, userID uint64) error {
+				_, err := svc.AnonymizeUserData(context.Background(), userID)
+				return err
+			},
+			wantCode: apperrors.ValidationFailed,
+		},
+		{
+			name:   "anonymize repository failure",
+			userID: 42,
+			setup: func(repo *mocks.UserDataRepositoryMock) {
+				repo.AnonymizeMock.Return(models.AnonymizeResult{}, errors.New("db down"))
+			},
+			call: func(svc *DefaultUserDataService, userID uint64) error {
+				_, err := svc.AnonymizeUserData(context.Background(), userID)
+				return err
+			},
+			wantCode: apperrors.InternalError,
+		},
+		{
+			name:   "anonymize with orders at the pickup point",
+			userID: 42,
+			setup: func(repo *mocks.UserDataRepositoryMock) {
+				repo.AnonymizeMock.Return(models.AnonymizeResult{}, repositories.ErrUserHasActiveOrders)
+			},
+			call: func(svc *DefaultUserDataService, userID uint64) error {
+				_, err := svc.AnonymizeUserData(context.Background(), userID)
+				return err
+			},
+			wantCode: apperrors.UserHasActiveOrders,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := mocks.NewUserDataRepositoryMock(t)
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+			svc := NewDefaultUserDataService(&clock.FakeClock{}, repo)
+			err := tt.call(svc, tt.userID)
+			var ae *apperrors.AppError
+			require.ErrorAs(t, err, &ae)
+			require.Equal(t, tt.wantCode, ae.Code)
+		})
+	}
+}
+
+// TestDefaultUserDataService_AnonymizeUserData verifies that the counts reported by the repository are returned.
+func TestDefaultUserDataService_AnonymizeUserData(t *testing.T) {
+	t.Parallel()
+	repo := mocks.NewUserDataRepositoryMock(t)
+	svc := NewDefaultUserDataService(&clock.FakeClock{}, repo)
+	ctx := context.Background()
+	want := models.AnonymizeResult{UserID: 42, Orders: 3, ArchivedOrders: 1, OutboxEvents: 5}
+	repo.AnonymizeMock.Expect(ctx, uint64(42)).Return(want, nil)
+
+	res, err := svc.AnonymizeUserData(ctx, 42)
+	require.NoError(t, err)
+	require.Equal(t, want, res)
+}
@@ -0,0 +1,14 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package services
+
+import (
+	"context"
+	"io"
+	"pvz-cli/internal/usecases/requests"
+)
+
+// ExportService writes orders, returns or order history matching a filter as CSV or NDJSON
+type ExportService interface {
+	// Export streams the matching records to w and returns how many were written
+	Export(ctx context.Context, req requests.ExportRequest, w io.Writer) (int, error)
+}
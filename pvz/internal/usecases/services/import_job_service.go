@@ -0,0 +1,20 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package services
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+)
+
+// ImportJobService runs order imports in the background and tracks their progress
+type ImportJobService interface {
+	Submit(ctx context.Context, req requests.ImportOrdersRequest) (models.ImportJob, error)
+	Get(ctx context.Context, jobID uint64) (models.ImportJob, error)
+	// Watch calls fn with the job state whenever it changes, until the job finishes or fn returns an error
+	Watch(ctx context.Context, jobID uint64, fn func(models.ImportJob) error) error
+	ListFailures(ctx context.Context, jobID uint64) ([]models.ImportJobFailure, error)
+	Cancel(ctx context.Context, jobID uint64) (models.ImportJob, error)
+	// Run processes queued jobs until ctx is canceled
+	Run(ctx context.Context) error
+}
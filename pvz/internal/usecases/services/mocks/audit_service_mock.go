@@ -0,0 +1,773 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditServiceMock implements mm_services.AuditService
+type AuditServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcList          func(ctx context.Context, filter requests.AuditLogFilter) (aa1 []models.AuditRecord, i1 int, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, filter requests.AuditLogFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mAuditServiceMockList
+
+	funcRecord          func(ctx context.Context, rec models.AuditRecord) (err error)
+	funcRecordOrigin    string
+	inspectFuncRecord   func(ctx context.Context, rec models.AuditRecord)
+	afterRecordCounter  uint64
+	beforeRecordCounter uint64
+	RecordMock          mAuditServiceMockRecord
+}
+
+// NewAuditServiceMock returns a mock for mm_services.AuditService
+func NewAuditServiceMock(t minimock.Tester) *AuditServiceMock {
+	m := &AuditServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListMock = mAuditServiceMockList{mock: m}
+	m.ListMock.callArgs = []*AuditServiceMockListParams{}
+
+	m.RecordMock = mAuditServiceMockRecord{mock: m}
+	m.RecordMock.callArgs = []*AuditServiceMockRecordParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditServiceMockList struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockListExpectation
+	expectations       []*AuditServiceMockListExpectation
+
+	callArgs []*AuditServiceMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditServiceMockListExpectation specifies expectation struct of the AuditService.List
+type AuditServiceMockListExpectation struct {
+	mock               *AuditServiceMock
+	params             *AuditServiceMockListParams
+	paramPtrs          *AuditServiceMockListParamPtrs
+	expectationOrigins AuditServiceMockListExpectationOrigins
+	results            *AuditServiceMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditServiceMockListParams contains parameters of the AuditService.List
+type AuditServiceMockListParams struct {
+	ctx    context.Context
+	filter requests.AuditLogFilter
+}
+
+// AuditServiceMockListParamPtrs contains pointers to parameters of the AuditService.List
+type AuditServiceMockListParamPtrs struct {
+	ctx    *context.Context
+	filter *requests.AuditLogFilter
+}
+
+// AuditServiceMockListResults contains results of the AuditService.List
+type AuditServiceMockListResults struct {
+	aa1 []models.AuditRecord
+	i1  int
+	err error
+}
+
+// AuditServiceMockListOrigins contains origins of expectations of the AuditService.List
+type AuditServiceMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mAuditServiceMockList) Optional() *mAuditServiceMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for AuditService.List
+func (mmList *mAuditServiceMockList) Expect(ctx context.Context, filter requests.AuditLogFilter) *mAuditServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("AuditServiceMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &AuditServiceMockListParams{ctx, filter}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.List
+func (mmList *mAuditServiceMockList) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("AuditServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &AuditServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectFilterParam2 sets up expected param filter for AuditService.List
+func (mmList *mAuditServiceMockList) ExpectFilterParam2(filter requests.AuditLogFilter) *mAuditServiceMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditServiceMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("AuditServiceMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &AuditServiceMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+	mmList.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.List
+func (mmList *mAuditServiceMockList) Inspect(f func(ctx context.Context, filter requests.AuditLogFilter)) *mAuditServiceMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by AuditService.List
+func (mmList *mAuditServiceMockList) Return(aa1 []models.AuditRecord, i1 int, err error) *AuditServiceMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditServiceMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &AuditServiceMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &AuditServiceMockListResults{aa1, i1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the AuditService.List method
+func (mmList *mAuditServiceMockList) Set(f func(ctx context.Context, filter requests.AuditLogFilter) (aa1 []models.AuditRecord, i1 int, err error)) *AuditServiceMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the AuditService.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the AuditService.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the AuditService.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mAuditServiceMockList) When(ctx context.Context, filter requests.AuditLogFilter) *AuditServiceMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("AuditServiceMock.List mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockListExpectation{
+		mock:               mmList.mock,
+		params:             &AuditServiceMockListParams{ctx, filter},
+		expectationOrigins: AuditServiceMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.List return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockListExpectation) Then(aa1 []models.AuditRecord, i1 int, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockListResults{aa1, i1, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.List should be invoked
+func (mmList *mAuditServiceMockList) Times(n uint64) *mAuditServiceMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of AuditServiceMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mAuditServiceMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_services.AuditService
+func (mmList *AuditServiceMock) List(ctx context.Context, filter requests.AuditLogFilter) (aa1 []models.AuditRecord, i1 int, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
+	}
+
+	mm_params := AuditServiceMockListParams{ctx, filter}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.i1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockListParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("AuditServiceMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("AuditServiceMock.List got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("AuditServiceMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the AuditServiceMock.List")
+		}
+		return (*mm_results).aa1, (*mm_results).i1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
+	}
+	mmList.t.Fatalf("Unexpected call to AuditServiceMock.List. %v %v", ctx, filter)
+	return
+}
+
+// ListAfterCounter returns a count of finished AuditServiceMock.List invocations
+func (mmList *AuditServiceMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of AuditServiceMock.List invocations
+func (mmList *AuditServiceMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mAuditServiceMockList) Calls() []*AuditServiceMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditServiceMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to AuditServiceMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mAuditServiceMockRecord struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockRecordExpectation
+	expectations       []*AuditServiceMockRecordExpectation
+
+	callArgs []*AuditServiceMockRecordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuditServiceMockRecordExpectation specifies expectation struct of the AuditService.Record
+type AuditServiceMockRecordExpectation struct {
+	mock               *AuditServiceMock
+	params             *AuditServiceMockRecordParams
+	paramPtrs          *AuditServiceMockRecordParamPtrs
+	expectationOrigins AuditServiceMockRecordExpectationOrigins
+	results            *AuditServiceMockRecordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuditServiceMockRecordParams contains parameters of the AuditService.Record
+type AuditServiceMockRecordParams struct {
+	ctx context.Context
+	rec models.AuditRecord
+}
+
+// AuditServiceMockRecordParamPtrs contains pointers to parameters of the AuditService.Record
+type AuditServiceMockRecordParamPtrs struct {
+	ctx *context.Context
+	rec *models.AuditRecord
+}
+
+// AuditServiceMockRecordResults contains results of the AuditService.Record
+type AuditServiceMockRecordResults struct {
+	err error
+}
+
+// AuditServiceMockRecordOrigins contains origins of expectations of the AuditService.Record
+type AuditServiceMockRecordExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originRec string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecord *mAuditServiceMockRecord) Optional() *mAuditServiceMockRecord {
+	mmRecord.optional = true
+	return mmRecord
+}
+
+// Expect sets up expected params for AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) Expect(ctx context.Context, rec models.AuditRecord) *mAuditServiceMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditServiceMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by ExpectParams functions")
+	}
+
+	mmRecord.defaultExpectation.params = &AuditServiceMockRecordParams{ctx, rec}
+	mmRecord.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecord.expectations {
+		if minimock.Equal(e.params, mmRecord.defaultExpectation.params) {
+			mmRecord.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecord.defaultExpectation.params)
+		}
+	}
+
+	return mmRecord
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditServiceMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &AuditServiceMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecord.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecord
+}
+
+// ExpectRecParam2 sets up expected param rec for AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) ExpectRecParam2(rec models.AuditRecord) *mAuditServiceMockRecord {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditServiceMockRecordExpectation{}
+	}
+
+	if mmRecord.defaultExpectation.params != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Expect")
+	}
+
+	if mmRecord.defaultExpectation.paramPtrs == nil {
+		mmRecord.defaultExpectation.paramPtrs = &AuditServiceMockRecordParamPtrs{}
+	}
+	mmRecord.defaultExpectation.paramPtrs.rec = &rec
+	mmRecord.defaultExpectation.expectationOrigins.originRec = minimock.CallerInfo(1)
+
+	return mmRecord
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) Inspect(f func(ctx context.Context, rec models.AuditRecord)) *mAuditServiceMockRecord {
+	if mmRecord.mock.inspectFuncRecord != nil {
+		mmRecord.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.Record")
+	}
+
+	mmRecord.mock.inspectFuncRecord = f
+
+	return mmRecord
+}
+
+// Return sets up results that will be returned by AuditService.Record
+func (mmRecord *mAuditServiceMockRecord) Return(err error) *AuditServiceMock {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	if mmRecord.defaultExpectation == nil {
+		mmRecord.defaultExpectation = &AuditServiceMockRecordExpectation{mock: mmRecord.mock}
+	}
+	mmRecord.defaultExpectation.results = &AuditServiceMockRecordResults{err}
+	mmRecord.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecord.mock
+}
+
+// Set uses given function f to mock the AuditService.Record method
+func (mmRecord *mAuditServiceMockRecord) Set(f func(ctx context.Context, rec models.AuditRecord) (err error)) *AuditServiceMock {
+	if mmRecord.defaultExpectation != nil {
+		mmRecord.mock.t.Fatalf("Default expectation is already set for the AuditService.Record method")
+	}
+
+	if len(mmRecord.expectations) > 0 {
+		mmRecord.mock.t.Fatalf("Some expectations are already set for the AuditService.Record method")
+	}
+
+	mmRecord.mock.funcRecord = f
+	mmRecord.mock.funcRecordOrigin = minimock.CallerInfo(1)
+	return mmRecord.mock
+}
+
+// When sets expectation for the AuditService.Record which will trigger the result defined by the following
+// Then helper
+func (mmRecord *mAuditServiceMockRecord) When(ctx context.Context, rec models.AuditRecord) *AuditServiceMockRecordExpectation {
+	if mmRecord.mock.funcRecord != nil {
+		mmRecord.mock.t.Fatalf("AuditServiceMock.Record mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockRecordExpectation{
+		mock:               mmRecord.mock,
+		params:             &AuditServiceMockRecordParams{ctx, rec},
+		expectationOrigins: AuditServiceMockRecordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecord.expectations = append(mmRecord.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.Record return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockRecordExpectation) Then(err error) *AuditServiceMock {
+	e.results = &AuditServiceMockRecordResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.Record should be invoked
+func (mmRecord *mAuditServiceMockRecord) Times(n uint64) *mAuditServiceMockRecord {
+	if n == 0 {
+		mmRecord.mock.t.Fatalf("Times of AuditServiceMock.Record mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecord.expectedInvocations, n)
+	mmRecord.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecord
+}
+
+func (mmRecord *mAuditServiceMockRecord) invocationsDone() bool {
+	if len(mmRecord.expectations) == 0 && mmRecord.defaultExpectation == nil && mmRecord.mock.funcRecord == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecord.mock.afterRecordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecord.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Record implements mm_services.AuditService
+func (mmRecord *AuditServiceMock) Record(ctx context.Context, rec models.AuditRecord) (err error) {
+	mm_atomic.AddUint64(&mmRecord.beforeRecordCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecord.afterRecordCounter, 1)
+
+	mmRecord.t.Helper()
+
+	if mmRecord.inspectFuncRecord != nil {
+		mmRecord.inspectFuncRecord(ctx, rec)
+	}
+
+	mm_params := AuditServiceMockRecordParams{ctx, rec}
+
+	// Record call args
+	mmRecord.RecordMock.mutex.Lock()
+	mmRecord.RecordMock.callArgs = append(mmRecord.RecordMock.callArgs, &mm_params)
+	mmRecord.RecordMock.mutex.Unlock()
+
+	for _, e := range mmRecord.RecordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecord.RecordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecord.RecordMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecord.RecordMock.defaultExpectation.params
+		mm_want_ptrs := mmRecord.RecordMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockRecordParams{ctx, rec}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecord.t.Errorf("AuditServiceMock.Record got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecord.RecordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rec != nil && !minimock.Equal(*mm_want_ptrs.rec, mm_got.rec) {
+				mmRecord.t.Errorf("AuditServiceMock.Record got unexpected parameter rec, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecord.RecordMock.defaultExpectation.expectationOrigins.originRec, *mm_want_ptrs.rec, mm_got.rec, minimock.Diff(*mm_want_ptrs.rec, mm_got.rec))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecord.t.Errorf("AuditServiceMock.Record got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecord.RecordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecord.RecordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecord.t.Fatal("No results are set for the AuditServiceMock.Record")
+		}
+		return (*mm_results).err
+	}
+	if mmRecord.funcRecord != nil {
+		return mmRecord.funcRecord(ctx, rec)
+	}
+	mmRecord.t.Fatalf("Unexpected call to AuditServiceMock.Record. %v %v", ctx, rec)
+	return
+}
+
+// RecordAfterCounter returns a count of finished AuditServiceMock.Record invocations
+func (mmRecord *AuditServiceMock) RecordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.afterRecordCounter)
+}
+
+// RecordBeforeCounter returns a count of AuditServiceMock.Record invocations
+func (mmRecord *AuditServiceMock) RecordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecord.beforeRecordCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.Record.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecord *mAuditServiceMockRecord) Calls() []*AuditServiceMockRecordParams {
+	mmRecord.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockRecordParams, len(mmRecord.callArgs))
+	copy(argCopy, mmRecord.callArgs)
+
+	mmRecord.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordDone returns true if the count of the Record invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockRecordDone() bool {
+	if m.RecordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordMock.invocationsDone()
+}
+
+// MinimockRecordInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockRecordInspect() {
+	for _, e := range m.RecordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.Record at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordCounter := mm_atomic.LoadUint64(&m.afterRecordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordMock.defaultExpectation != nil && afterRecordCounter < 1 {
+		if m.RecordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuditServiceMock.Record at\n%s", m.RecordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.Record at\n%s with params: %#v", m.RecordMock.defaultExpectation.expectationOrigins.origin, *m.RecordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecord != nil && afterRecordCounter < 1 {
+		m.t.Errorf("Expected call to AuditServiceMock.Record at\n%s", m.funcRecordOrigin)
+	}
+
+	if !m.RecordMock.invocationsDone() && afterRecordCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.Record at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordMock.expectedInvocations), m.RecordMock.expectedInvocationsOrigin, afterRecordCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListInspect()
+
+			m.MinimockRecordInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListDone() &&
+		m.MinimockRecordDone()
+}
@@ -0,0 +1,939 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// CalendarServiceMock implements mm_services.CalendarService
+type CalendarServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCurrent          func() (p1 models.PickupCalendar)
+	funcCurrentOrigin    string
+	inspectFuncCurrent   func()
+	afterCurrentCounter  uint64
+	beforeCurrentCounter uint64
+	CurrentMock          mCalendarServiceMockCurrent
+
+	funcGetCalendar          func(ctx context.Context) (p1 models.PickupCalendar, err error)
+	funcGetCalendarOrigin    string
+	inspectFuncGetCalendar   func(ctx context.Context)
+	afterGetCalendarCounter  uint64
+	beforeGetCalendarCounter uint64
+	GetCalendarMock          mCalendarServiceMockGetCalendar
+
+	funcUpdateCalendar          func(ctx context.Context, cal models.PickupCalendar) (p1 models.PickupCalendar, err error)
+	funcUpdateCalendarOrigin    string
+	inspectFuncUpdateCalendar   func(ctx context.Context, cal models.PickupCalendar)
+	afterUpdateCalendarCounter  uint64
+	beforeUpdateCalendarCounter uint64
+	UpdateCalendarMock          mCalendarServiceMockUpdateCalendar
+}
+
+// NewCalendarServiceMock returns a mock for mm_services.CalendarService
+func NewCalendarServiceMock(t minimock.Tester) *CalendarServiceMock {
+	m := &CalendarServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CurrentMock = mCalendarServiceMockCurrent{mock: m}
+
+	m.GetCalendarMock = mCalendarServiceMockGetCalendar{mock: m}
+	m.GetCalendarMock.callArgs = []*CalendarServiceMockGetCalendarParams{}
+
+	m.UpdateCalendarMock = mCalendarServiceMockUpdateCalendar{mock: m}
+	m.UpdateCalendarMock.callArgs = []*CalendarServiceMockUpdateCalendarParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCalendarServiceMockCurrent struct {
+	optional           bool
+	mock               *CalendarServiceMock
+	defaultExpectation *CalendarServiceMockCurrentExpectation
+	expectations       []*CalendarServiceMockCurrentExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CalendarServiceMockCurrentExpectation specifies expectation struct of the CalendarService.Current
+type CalendarServiceMockCurrentExpectation struct {
+	mock *CalendarServiceMock
+
+	results      *CalendarServiceMockCurrentResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// CalendarServiceMockCurrentResults contains results of the CalendarService.Current
+type CalendarServiceMockCurrentResults struct {
+	p1 models.PickupCalendar
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCurrent *mCalendarServiceMockCurrent) Optional() *mCalendarServiceMockCurrent {
+	mmCurrent.optional = true
+	return mmCurrent
+}
+
+// Expect sets up expected params for CalendarService.Current
+func (mmCurrent *mCalendarServiceMockCurrent) Expect() *mCalendarServiceMockCurrent {
+	if mmCurrent.mock.funcCurrent != nil {
+		mmCurrent.mock.t.Fatalf("CalendarServiceMock.Current mock is already set by Set")
+	}
+
+	if mmCurrent.defaultExpectation == nil {
+		mmCurrent.defaultExpectation = &CalendarServiceMockCurrentExpectation{}
+	}
+
+	return mmCurrent
+}
+
+// Inspect accepts an inspector function that has same arguments as the CalendarService.Current
+func (mmCurrent *mCalendarServiceMockCurrent) Inspect(f func()) *mCalendarServiceMockCurrent {
+	if mmCurrent.mock.inspectFuncCurrent != nil {
+		mmCurrent.mock.t.Fatalf("Inspect function is already set for CalendarServiceMock.Current")
+	}
+
+	mmCurrent.mock.inspectFuncCurrent = f
+
+	return mmCurrent
+}
+
+// Return sets up results that will be returned by CalendarService.Current
+func (mmCurrent *mCalendarServiceMockCurrent) Return(p1 models.PickupCalendar) *CalendarServiceMock {
+	if mmCurrent.mock.funcCurrent != nil {
+		mmCurrent.mock.t.Fatalf("CalendarServiceMock.Current mock is already set by Set")
+	}
+
+	if mmCurrent.defaultExpectation == nil {
+		mmCurrent.defaultExpectation = &CalendarServiceMockCurrentExpectation{mock: mmCurrent.mock}
+	}
+	mmCurrent.defaultExpectation.results = &CalendarServiceMockCurrentResults{p1}
+	mmCurrent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCurrent.mock
+}
+
+// Set uses given function f to mock the CalendarService.Current method
+func (mmCurrent *mCalendarServiceMockCurrent) Set(f func() (p1 models.PickupCalendar)) *CalendarServiceMock {
+	if mmCurrent.defaultExpectation != nil {
+		mmCurrent.mock.t.Fatalf("Default expectation is already set for the CalendarService.Current method")
+	}
+
+	if len(mmCurrent.expectations) > 0 {
+		mmCurrent.mock.t.Fatalf("Some expectations are already set for the CalendarService.Current method")
+	}
+
+	mmCurrent.mock.funcCurrent = f
+	mmCurrent.mock.funcCurrentOrigin = minimock.CallerInfo(1)
+	return mmCurrent.mock
+}
+
+// Times sets number of times CalendarService.Current should be invoked
+func (mmCurrent *mCalendarServiceMockCurrent) Times(n uint64) *mCalendarServiceMockCurrent {
+	if n == 0 {
+		mmCurrent.mock.t.Fatalf("Times of CalendarServiceMock.Current mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCurrent.expectedInvocations, n)
+	mmCurrent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCurrent
+}
+
+func (mmCurrent *mCalendarServiceMockCurrent) invocationsDone() bool {
+	if len(mmCurrent.expectations) == 0 && mmCurrent.defaultExpectation == nil && mmCurrent.mock.funcCurrent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCurrent.mock.afterCurrentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCurrent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Current implements mm_services.CalendarService
+func (mmCurrent *CalendarServiceMock) Current() (p1 models.PickupCalendar) {
+	mm_atomic.AddUint64(&mmCurrent.beforeCurrentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCurrent.afterCurrentCounter, 1)
+
+	mmCurrent.t.Helper()
+
+	if mmCurrent.inspectFuncCurrent != nil {
+		mmCurrent.inspectFuncCurrent()
+	}
+
+	if mmCurrent.CurrentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCurrent.CurrentMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmCurrent.CurrentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCurrent.t.Fatal("No results are set for the CalendarServiceMock.Current")
+		}
+		return (*mm_results).p1
+	}
+	if mmCurrent.funcCurrent != nil {
+		return mmCurrent.funcCurrent()
+	}
+	mmCurrent.t.Fatalf("Unexpected call to CalendarServiceMock.Current.")
+	return
+}
+
+// CurrentAfterCounter returns a count of finished CalendarServiceMock.Current invocations
+func (mmCurrent *CalendarServiceMock) CurrentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCurrent.afterCurrentCounter)
+}
+
+// CurrentBeforeCounter returns a count of CalendarServiceMock.Current invocations
+func (mmCurrent *CalendarServiceMock) CurrentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCurrent.beforeCurrentCounter)
+}
+
+// MinimockCurrentDone returns true if the count of the Current invocations corresponds
+// the number of defined expectations
+func (m *CalendarServiceMock) MinimockCurrentDone() bool {
+	if m.CurrentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CurrentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CurrentMock.invocationsDone()
+}
+
+// MinimockCurrentInspect logs each unmet expectation
+func (m *CalendarServiceMock) MinimockCurrentInspect() {
+	for _, e := range m.CurrentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to CalendarServiceMock.Current")
+		}
+	}
+
+	afterCurrentCounter := mm_atomic.LoadUint64(&m.afterCurrentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CurrentMock.defaultExpectation != nil && afterCurrentCounter < 1 {
+		m.t.Errorf("Expected call to CalendarServiceMock.Current at\n%s", m.CurrentMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCurrent != nil && afterCurrentCounter < 1 {
+		m.t.Errorf("Expected call to CalendarServiceMock.Current at\n%s", m.funcCurrentOrigin)
+	}
+
+	if !m.CurrentMock.invocationsDone() && afterCurrentCounter > 0 {
+		m.t.Errorf("Expected %d calls to CalendarServiceMock.Current at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CurrentMock.expectedInvocations), m.CurrentMock.expectedInvocationsOrigin, afterCurrentCounter)
+	}
+}
+
+type mCalendarServiceMockGetCalendar struct {
+	optional           bool
+	mock               *CalendarServiceMock
+	defaultExpectation *CalendarServiceMockGetCalendarExpectation
+	expectations       []*CalendarServiceMockGetCalendarExpectation
+
+	callArgs []*CalendarServiceMockGetCalendarParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CalendarServiceMockGetCalendarExpectation specifies expectation struct of the CalendarService.GetCalendar
+type CalendarServiceMockGetCalendarExpectation struct {
+	mock               *CalendarServiceMock
+	params             *CalendarServiceMockGetCalendarParams
+	paramPtrs          *CalendarServiceMockGetCalendarParamPtrs
+	expectationOrigins CalendarServiceMockGetCalendarExpectationOrigins
+	results            *CalendarServiceMockGetCalendarResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CalendarServiceMockGetCalendarParams contains parameters of the CalendarService.GetCalendar
+type CalendarServiceMockGetCalendarParams struct {
+	ctx context.Context
+}
+
+// CalendarServiceMockGetCalendarParamPtrs contains pointers to parameters of the CalendarService.GetCalendar
+type CalendarServiceMockGetCalendarParamPtrs struct {
+	ctx *context.Context
+}
+
+// CalendarServiceMockGetCalendarResults contains results of the CalendarService.GetCalendar
+type CalendarServiceMockGetCalendarResults struct {
+	p1  models.PickupCalendar
+	err error
+}
+
+// CalendarServiceMockGetCalendarOrigins contains origins of expectations of the CalendarService.GetCalendar
+type CalendarServiceMockGetCalendarExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) Optional() *mCalendarServiceMockGetCalendar {
+	mmGetCalendar.optional = true
+	return mmGetCalendar
+}
+
+// Expect sets up expected params for CalendarService.GetCalendar
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) Expect(ctx context.Context) *mCalendarServiceMockGetCalendar {
+	if mmGetCalendar.mock.funcGetCalendar != nil {
+		mmGetCalendar.mock.t.Fatalf("CalendarServiceMock.GetCalendar mock is already set by Set")
+	}
+
+	if mmGetCalendar.defaultExpectation == nil {
+		mmGetCalendar.defaultExpectation = &CalendarServiceMockGetCalendarExpectation{}
+	}
+
+	if mmGetCalendar.defaultExpectation.paramPtrs != nil {
+		mmGetCalendar.mock.t.Fatalf("CalendarServiceMock.GetCalendar mock is already set by ExpectParams functions")
+	}
+
+	mmGetCalendar.defaultExpectation.params = &CalendarServiceMockGetCalendarParams{ctx}
+	mmGetCalendar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCalendar.expectations {
+		if minimock.Equal(e.params, mmGetCalendar.defaultExpectation.params) {
+			mmGetCalendar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCalendar.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCalendar
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CalendarService.GetCalendar
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) ExpectCtxParam1(ctx context.Context) *mCalendarServiceMockGetCalendar {
+	if mmGetCalendar.mock.funcGetCalendar != nil {
+		mmGetCalendar.mock.t.Fatalf("CalendarServiceMock.GetCalendar mock is already set by Set")
+	}
+
+	if mmGetCalendar.defaultExpectation == nil {
+		mmGetCalendar.defaultExpectation = &CalendarServiceMockGetCalendarExpectation{}
+	}
+
+	if mmGetCalendar.defaultExpectation.params != nil {
+		mmGetCalendar.mock.t.Fatalf("CalendarServiceMock.GetCalendar mock is already set by Expect")
+	}
+
+	if mmGetCalendar.defaultExpectation.paramPtrs == nil {
+		mmGetCalendar.defaultExpectation.paramPtrs = &CalendarServiceMockGetCalendarParamPtrs{}
+	}
+	mmGetCalendar.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCalendar.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCalendar
+}
+
+// Inspect accepts an inspector function that has same arguments as the CalendarService.GetCalendar
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) Inspect(f func(ctx context.Context)) *mCalendarServiceMockGetCalendar {
+	if mmGetCalendar.mock.inspectFuncGetCalendar != nil {
+		mmGetCalendar.mock.t.Fatalf("Inspect function is already set for CalendarServiceMock.GetCalendar")
+	}
+
+	mmGetCalendar.mock.inspectFuncGetCalendar = f
+
+	return mmGetCalendar
+}
+
+// Return sets up results that will be returned by CalendarService.GetCalendar
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) Return(p1 models.PickupCalendar, err error) *CalendarServiceMock {
+	if mmGetCalendar.mock.funcGetCalendar != nil {
+		mmGetCalendar.mock.t.Fatalf("CalendarServiceMock.GetCalendar mock is already set by Set")
+	}
+
+	if mmGetCalendar.defaultExpectation == nil {
+		mmGetCalendar.defaultExpectation = &CalendarServiceMockGetCalendarExpectation{mock: mmGetCalendar.mock}
+	}
+	mmGetCalendar.defaultExpectation.results = &CalendarServiceMockGetCalendarResults{p1, err}
+	mmGetCalendar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCalendar.mock
+}
+
+// Set uses given function f to mock the CalendarService.GetCalendar method
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) Set(f func(ctx context.Context) (p1 models.PickupCalendar, err error)) *CalendarServiceMock {
+	if mmGetCalendar.defaultExpectation != nil {
+		mmGetCalendar.mock.t.Fatalf("Default expectation is already set for the CalendarService.GetCalendar method")
+	}
+
+	if len(mmGetCalendar.expectations) > 0 {
+		mmGetCalendar.mock.t.Fatalf("Some expectations are already set for the CalendarService.GetCalendar method")
+	}
+
+	mmGetCalendar.mock.funcGetCalendar = f
+	mmGetCalendar.mock.funcGetCalendarOrigin = minimock.CallerInfo(1)
+	return mmGetCalendar.mock
+}
+
+// When sets expectation for the CalendarService.GetCalendar which will trigger the result defined by the following
+// Then helper
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) When(ctx context.Context) *CalendarServiceMockGetCalendarExpectation {
+	if mmGetCalendar.mock.funcGetCalendar != nil {
+		mmGetCalendar.mock.t.Fatalf("CalendarServiceMock.GetCalendar mock is already set by Set")
+	}
+
+	expectation := &CalendarServiceMockGetCalendarExpectation{
+		mock:               mmGetCalendar.mock,
+		params:             &CalendarServiceMockGetCalendarParams{ctx},
+		expectationOrigins: CalendarServiceMockGetCalendarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCalendar.expectations = append(mmGetCalendar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CalendarService.GetCalendar return parameters for the expectation previously defined by the When method
+func (e *CalendarServiceMockGetCalendarExpectation) Then(p1 models.PickupCalendar, err error) *CalendarServiceMock {
+	e.results = &CalendarServiceMockGetCalendarResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times CalendarService.GetCalendar should be invoked
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) Times(n uint64) *mCalendarServiceMockGetCalendar {
+	if n == 0 {
+		mmGetCalendar.mock.t.Fatalf("Times of CalendarServiceMock.GetCalendar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCalendar.expectedInvocations, n)
+	mmGetCalendar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCalendar
+}
+
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) invocationsDone() bool {
+	if len(mmGetCalendar.expectations) == 0 && mmGetCalendar.defaultExpectation == nil && mmGetCalendar.mock.funcGetCalendar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCalendar.mock.afterGetCalendarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCalendar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCalendar implements mm_services.CalendarService
+func (mmGetCalendar *CalendarServiceMock) GetCalendar(ctx context.Context) (p1 models.PickupCalendar, err error) {
+	mm_atomic.AddUint64(&mmGetCalendar.beforeGetCalendarCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCalendar.afterGetCalendarCounter, 1)
+
+	mmGetCalendar.t.Helper()
+
+	if mmGetCalendar.inspectFuncGetCalendar != nil {
+		mmGetCalendar.inspectFuncGetCalendar(ctx)
+	}
+
+	mm_params := CalendarServiceMockGetCalendarParams{ctx}
+
+	// Record call args
+	mmGetCalendar.GetCalendarMock.mutex.Lock()
+	mmGetCalendar.GetCalendarMock.callArgs = append(mmGetCalendar.GetCalendarMock.callArgs, &mm_params)
+	mmGetCalendar.GetCalendarMock.mutex.Unlock()
+
+	for _, e := range mmGetCalendar.GetCalendarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetCalendar.GetCalendarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCalendar.GetCalendarMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCalendar.GetCalendarMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCalendar.GetCalendarMock.defaultExpectation.paramPtrs
+
+		mm_got := CalendarServiceMockGetCalendarParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCalendar.t.Errorf("CalendarServiceMock.GetCalendar got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCalendar.GetCalendarMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCalendar.t.Errorf("CalendarServiceMock.GetCalendar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCalendar.GetCalendarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCalendar.GetCalendarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCalendar.t.Fatal("No results are set for the CalendarServiceMock.GetCalendar")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetCalendar.funcGetCalendar != nil {
+		return mmGetCalendar.funcGetCalendar(ctx)
+	}
+	mmGetCalendar.t.Fatalf("Unexpected call to CalendarServiceMock.GetCalendar. %v", ctx)
+	return
+}
+
+// GetCalendarAfterCounter returns a count of finished CalendarServiceMock.GetCalendar invocations
+func (mmGetCalendar *CalendarServiceMock) GetCalendarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCalendar.afterGetCalendarCounter)
+}
+
+// GetCalendarBeforeCounter returns a count of CalendarServiceMock.GetCalendar invocations
+func (mmGetCalendar *CalendarServiceMock) GetCalendarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCalendar.beforeGetCalendarCounter)
+}
+
+// Calls returns a list of arguments used in each call to CalendarServiceMock.GetCalendar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCalendar *mCalendarServiceMockGetCalendar) Calls() []*CalendarServiceMockGetCalendarParams {
+	mmGetCalendar.mutex.RLock()
+
+	argCopy := make([]*CalendarServiceMockGetCalendarParams, len(mmGetCalendar.callArgs))
+	copy(argCopy, mmGetCalendar.callArgs)
+
+	mmGetCalendar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCalendarDone returns true if the count of the GetCalendar invocations corresponds
+// the number of defined expectations
+func (m *CalendarServiceMock) MinimockGetCalendarDone() bool {
+	if m.GetCalendarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCalendarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCalendarMock.invocationsDone()
+}
+
+// MinimockGetCalendarInspect logs each unmet expectation
+func (m *CalendarServiceMock) MinimockGetCalendarInspect() {
+	for _, e := range m.GetCalendarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CalendarServiceMock.GetCalendar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCalendarCounter := mm_atomic.LoadUint64(&m.afterGetCalendarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCalendarMock.defaultExpectation != nil && afterGetCalendarCounter < 1 {
+		if m.GetCalendarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CalendarServiceMock.GetCalendar at\n%s", m.GetCalendarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CalendarServiceMock.GetCalendar at\n%s with params: %#v", m.GetCalendarMock.defaultExpectation.expectationOrigins.origin, *m.GetCalendarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCalendar != nil && afterGetCalendarCounter < 1 {
+		m.t.Errorf("Expected call to CalendarServiceMock.GetCalendar at\n%s", m.funcGetCalendarOrigin)
+	}
+
+	if !m.GetCalendarMock.invocationsDone() && afterGetCalendarCounter > 0 {
+		m.t.Errorf("Expected %d calls to CalendarServiceMock.GetCalendar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCalendarMock.expectedInvocations), m.GetCalendarMock.expectedInvocationsOrigin, afterGetCalendarCounter)
+	}
+}
+
+type mCalendarServiceMockUpdateCalendar struct {
+	optional           bool
+	mock               *CalendarServiceMock
+	defaultExpectation *CalendarServiceMockUpdateCalendarExpectation
+	expectations       []*CalendarServiceMockUpdateCalendarExpectation
+
+	callArgs []*CalendarServiceMockUpdateCalendarParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CalendarServiceMockUpdateCalendarExpectation specifies expectation struct of the CalendarService.UpdateCalendar
+type CalendarServiceMockUpdateCalendarExpectation struct {
+	mock               *CalendarServiceMock
+	params             *CalendarServiceMockUpdateCalendarParams
+	paramPtrs          *CalendarServiceMockUpdateCalendarParamPtrs
+	expectationOrigins CalendarServiceMockUpdateCalendarExpectationOrigins
+	results            *CalendarServiceMockUpdateCalendarResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CalendarServiceMockUpdateCalendarParams contains parameters of the CalendarService.UpdateCalendar
+type CalendarServiceMockUpdateCalendarParams struct {
+	ctx context.Context
+	cal models.PickupCalendar
+}
+
+// CalendarServiceMockUpdateCalendarParamPtrs contains pointers to parameters of the CalendarService.UpdateCalendar
+type CalendarServiceMockUpdateCalendarParamPtrs struct {
+	ctx *context.Context
+	cal *models.PickupCalendar
+}
+
+// CalendarServiceMockUpdateCalendarResults contains results of the CalendarService.UpdateCalendar
+type CalendarServiceMockUpdateCalendarResults struct {
+	p1  models.PickupCalendar
+	err error
+}
+
+// CalendarServiceMockUpdateCalendarOrigins contains origins of expectations of the CalendarService.UpdateCalendar
+type CalendarServiceMockUpdateCalendarExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originCal string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) Optional() *mCalendarServiceMockUpdateCalendar {
+	mmUpdateCalendar.optional = true
+	return mmUpdateCalendar
+}
+
+// Expect sets up expected params for CalendarService.UpdateCalendar
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) Expect(ctx context.Context, cal models.PickupCalendar) *mCalendarServiceMockUpdateCalendar {
+	if mmUpdateCalendar.mock.funcUpdateCalendar != nil {
+		mmUpdateCalendar.mock.t.Fatalf("CalendarServiceMock.UpdateCalendar mock is already set by Set")
+	}
+
+	if mmUpdateCalendar.defaultExpectation == nil {
+		mmUpdateCalendar.defaultExpectation = &CalendarServiceMockUpdateCalendarExpectation{}
+	}
+
+	if mmUpdateCalendar.defaultExpectation.paramPtrs != nil {
+		mmUpdateCalendar.mock.t.Fatalf("CalendarServiceMock.UpdateCalendar mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateCalendar.defaultExpectation.params = &CalendarServiceMockUpdateCalendarParams{ctx, cal}
+	mmUpdateCalendar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateCalendar.expectations {
+		if minimock.Equal(e.params, mmUpdateCalendar.defaultExpectation.params) {
+			mmUpdateCalendar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateCalendar.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateCalendar
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CalendarService.UpdateCalendar
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) ExpectCtxParam1(ctx context.Context) *mCalendarServiceMockUpdateCalendar {
+	if mmUpdateCalendar.mock.funcUpdateCalendar != nil {
+		mmUpdateCalendar.mock.t.Fatalf("CalendarServiceMock.UpdateCalendar mock is already set by Set")
+	}
+
+	if mmUpdateCalendar.defaultExpectation == nil {
+		mmUpdateCalendar.defaultExpectation = &CalendarServiceMockUpdateCalendarExpectation{}
+	}
+
+	if mmUpdateCalendar.defaultExpectation.params != nil {
+		mmUpdateCalendar.mock.t.Fatalf("CalendarServiceMock.UpdateCalendar mock is already set by Expect")
+	}
+
+	if mmUpdateCalendar.defaultExpectation.paramPtrs == nil {
+		mmUpdateCalendar.defaultExpectation.paramPtrs = &CalendarServiceMockUpdateCalendarParamPtrs{}
+	}
+	mmUpdateCalendar.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateCalendar.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateCalendar
+}
+
+// ExpectCalParam2 sets up expected param cal for CalendarService.UpdateCalendar
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) ExpectCalParam2(cal models.PickupCalendar) *mCalendarServiceMockUpdateCalendar {
+	if mmUpdateCalendar.mock.funcUpdateCalendar != nil {
+		mmUpdateCalendar.mock.t.Fatalf("CalendarServiceMock.UpdateCalendar mock is already set by Set")
+	}
+
+	if mmUpdateCalendar.defaultExpectation == nil {
+		mmUpdateCalendar.defaultExpectation = &CalendarServiceMockUpdateCalendarExpectation{}
+	}
+
+	if mmUpdateCalendar.defaultExpectation.params != nil {
+		mmUpdateCalendar.mock.t.Fatalf("CalendarServiceMock.UpdateCalendar mock is already set by Expect")
+	}
+
+	if mmUpdateCalendar.defaultExpectation.paramPtrs == nil {
+		mmUpdateCalendar.defaultExpectation.paramPtrs = &CalendarServiceMockUpdateCalendarParamPtrs{}
+	}
+	mmUpdateCalendar.defaultExpectation.paramPtrs.cal = &cal
+	mmUpdateCalendar.defaultExpectation.expectationOrigins.originCal = minimock.CallerInfo(1)
+
+	return mmUpdateCalendar
+}
+
+// Inspect accepts an inspector function that has same arguments as the CalendarService.UpdateCalendar
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) Inspect(f func(ctx context.Context, cal models.PickupCalendar)) *mCalendarServiceMockUpdateCalendar {
+	if mmUpdateCalendar.mock.inspectFuncUpdateCalendar != nil {
+		mmUpdateCalendar.mock.t.Fatalf("Inspect function is already set for CalendarServiceMock.UpdateCalendar")
+	}
+
+	mmUpdateCalendar.mock.inspectFuncUpdateCalendar = f
+
+	return mmUpdateCalendar
+}
+
+// Return sets up results that will be returned by CalendarService.UpdateCalendar
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) Return(p1 models.PickupCalendar, err error) *CalendarServiceMock {
+	if mmUpdateCalendar.mock.funcUpdateCalendar != nil {
+		mmUpdateCalendar.mock.t.Fatalf("CalendarServiceMock.UpdateCalendar mock is already set by Set")
+	}
+
+	if mmUpdateCalendar.defaultExpectation == nil {
+		mmUpdateCalendar.defaultExpectation = &CalendarServiceMockUpdateCalendarExpectation{mock: mmUpdateCalendar.mock}
+	}
+	mmUpdateCalendar.defaultExpectation.results = &CalendarServiceMockUpdateCalendarResults{p1, err}
+	mmUpdateCalendar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateCalendar.mock
+}
+
+// Set uses given function f to mock the CalendarService.UpdateCalendar method
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) Set(f func(ctx context.Context, cal models.PickupCalendar) (p1 models.PickupCalendar, err error)) *CalendarServiceMock {
+	if mmUpdateCalendar.defaultExpectation != nil {
+		mmUpdateCalendar.mock.t.Fatalf("Default expectation is already set for the CalendarService.UpdateCalendar method")
+	}
+
+	if len(mmUpdateCalendar.expectations) > 0 {
+		mmUpdateCalendar.mock.t.Fatalf("Some expectations are already set for the CalendarService.UpdateCalendar method")
+	}
+
+	mmUpdateCalendar.mock.funcUpdateCalendar = f
+	mmUpdateCalendar.mock.funcUpdateCalendarOrigin = minimock.CallerInfo(1)
+	return mmUpdateCalendar.mock
+}
+
+// When sets expectation for the CalendarService.UpdateCalendar which will trigger the result defined by the following
+// Then helper
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) When(ctx context.Context, cal models.PickupCalendar) *CalendarServiceMockUpdateCalendarExpectation {
+	if mmUpdateCalendar.mock.funcUpdateCalendar != nil {
+		mmUpdateCalendar.mock.t.Fatalf("CalendarServiceMock.UpdateCalendar mock is already set by Set")
+	}
+
+	expectation := &CalendarServiceMockUpdateCalendarExpectation{
+		mock:               mmUpdateCalendar.mock,
+		params:             &CalendarServiceMockUpdateCalendarParams{ctx, cal},
+		expectationOrigins: CalendarServiceMockUpdateCalendarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateCalendar.expectations = append(mmUpdateCalendar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CalendarService.UpdateCalendar return parameters for the expectation previously defined by the When method
+func (e *CalendarServiceMockUpdateCalendarExpectation) Then(p1 models.PickupCalendar, err error) *CalendarServiceMock {
+	e.results = &CalendarServiceMockUpdateCalendarResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times CalendarService.UpdateCalendar should be invoked
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) Times(n uint64) *mCalendarServiceMockUpdateCalendar {
+	if n == 0 {
+		mmUpdateCalendar.mock.t.Fatalf("Times of CalendarServiceMock.UpdateCalendar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateCalendar.expectedInvocations, n)
+	mmUpdateCalendar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateCalendar
+}
+
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) invocationsDone() bool {
+	if len(mmUpdateCalendar.expectations) == 0 && mmUpdateCalendar.defaultExpectation == nil && mmUpdateCalendar.mock.funcUpdateCalendar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateCalendar.mock.afterUpdateCalendarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateCalendar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateCalendar implements mm_services.CalendarService
+func (mmUpdateCalendar *CalendarServiceMock) UpdateCalendar(ctx context.Context, cal models.PickupCalendar) (p1 models.PickupCalendar, err error) {
+	mm_atomic.AddUint64(&mmUpdateCalendar.beforeUpdateCalendarCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateCalendar.afterUpdateCalendarCounter, 1)
+
+	mmUpdateCalendar.t.Helper()
+
+	if mmUpdateCalendar.inspectFuncUpdateCalendar != nil {
+		mmUpdateCalendar.inspectFuncUpdateCalendar(ctx, cal)
+	}
+
+	mm_params := CalendarServiceMockUpdateCalendarParams{ctx, cal}
+
+	// Record call args
+	mmUpdateCalendar.UpdateCalendarMock.mutex.Lock()
+	mmUpdateCalendar.UpdateCalendarMock.callArgs = append(mmUpdateCalendar.UpdateCalendarMock.callArgs, &mm_params)
+	mmUpdateCalendar.UpdateCalendarMock.mutex.Unlock()
+
+	for _, e := range mmUpdateCalendar.UpdateCalendarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmUpdateCalendar.UpdateCalendarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateCalendar.UpdateCalendarMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateCalendar.UpdateCalendarMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateCalendar.UpdateCalendarMock.defaultExpectation.paramPtrs
+
+		mm_got := CalendarServiceMockUpdateCalendarParams{ctx, cal}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateCalendar.t.Errorf("CalendarServiceMock.UpdateCalendar got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateCalendar.UpdateCalendarMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cal != nil && !minimock.Equal(*mm_want_ptrs.cal, mm_got.cal) {
+				mmUpdateCalendar.t.Errorf("CalendarServiceMock.UpdateCalendar got unexpected parameter cal, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateCalendar.UpdateCalendarMock.defaultExpectation.expectationOrigins.originCal, *mm_want_ptrs.cal, mm_got.cal, minimock.Diff(*mm_want_ptrs.cal, mm_got.cal))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateCalendar.t.Errorf("CalendarServiceMock.UpdateCalendar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateCalendar.UpdateCalendarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateCalendar.UpdateCalendarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateCalendar.t.Fatal("No results are set for the CalendarServiceMock.UpdateCalendar")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmUpdateCalendar.funcUpdateCalendar != nil {
+		return mmUpdateCalendar.funcUpdateCalendar(ctx, cal)
+	}
+	mmUpdateCalendar.t.Fatalf("Unexpected call to CalendarServiceMock.UpdateCalendar. %v %v", ctx, cal)
+	return
+}
+
+// UpdateCalendarAfterCounter returns a count of finished CalendarServiceMock.UpdateCalendar invocations
+func (mmUpdateCalendar *CalendarServiceMock) UpdateCalendarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateCalendar.afterUpdateCalendarCounter)
+}
+
+// UpdateCalendarBeforeCounter returns a count of CalendarServiceMock.UpdateCalendar invocations
+func (mmUpdateCalendar *CalendarServiceMock) UpdateCalendarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateCalendar.beforeUpdateCalendarCounter)
+}
+
+// Calls returns a list of arguments used in each call to CalendarServiceMock.UpdateCalendar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateCalendar *mCalendarServiceMockUpdateCalendar) Calls() []*CalendarServiceMockUpdateCalendarParams {
+	mmUpdateCalendar.mutex.RLock()
+
+	argCopy := make([]*CalendarServiceMockUpdateCalendarParams, len(mmUpdateCalendar.callArgs))
+	copy(argCopy, mmUpdateCalendar.callArgs)
+
+	mmUpdateCalendar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateCalendarDone returns true if the count of the UpdateCalendar invocations corresponds
+// the number of defined expectations
+func (m *CalendarServiceMock) MinimockUpdateCalendarDone() bool {
+	if m.UpdateCalendarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateCalendarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateCalendarMock.invocationsDone()
+}
+
+// MinimockUpdateCalendarInspect logs each unmet expectation
+func (m *CalendarServiceMock) MinimockUpdateCalendarInspect() {
+	for _, e := range m.UpdateCalendarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CalendarServiceMock.UpdateCalendar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateCalendarCounter := mm_atomic.LoadUint64(&m.afterUpdateCalendarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateCalendarMock.defaultExpectation != nil && afterUpdateCalendarCounter < 1 {
+		if m.UpdateCalendarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CalendarServiceMock.UpdateCalendar at\n%s", m.UpdateCalendarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CalendarServiceMock.UpdateCalendar at\n%s with params: %#v", m.UpdateCalendarMock.defaultExpectation.expectationOrigins.origin, *m.UpdateCalendarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateCalendar != nil && afterUpdateCalendarCounter < 1 {
+		m.t.Errorf("Expected call to CalendarServiceMock.UpdateCalendar at\n%s", m.funcUpdateCalendarOrigin)
+	}
+
+	if !m.UpdateCalendarMock.invocationsDone() && afterUpdateCalendarCounter > 0 {
+		m.t.Errorf("Expected %d calls to CalendarServiceMock.UpdateCalendar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateCalendarMock.expectedInvocations), m.UpdateCalendarMock.expectedInvocationsOrigin, afterUpdateCalendarCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CalendarServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCurrentInspect()
+
+			m.MinimockGetCalendarInspect()
+
+			m.MinimockUpdateCalendarInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CalendarServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CalendarServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCurrentDone() &&
+		m.MinimockGetCalendarDone() &&
+		m.MinimockUpdateCalendarDone()
+}
@@ -0,0 +1,416 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ConsistencyServiceMock implements mm_services.ConsistencyService
+type ConsistencyServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheck          func(ctx context.Context, repair bool) (c2 models.ConsistencyReport, err error)
+	funcCheckOrigin    string
+	inspectFuncCheck   func(ctx context.Context, repair bool)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mConsistencyServiceMockCheck
+}
+
+// NewConsistencyServiceMock returns a mock for mm_services.ConsistencyService
+func NewConsistencyServiceMock(t minimock.Tester) *ConsistencyServiceMock {
+	m := &ConsistencyServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckMock = mConsistencyServiceMockCheck{mock: m}
+	m.CheckMock.callArgs = []*ConsistencyServiceMockCheckParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mConsistencyServiceMockCheck struct {
+	optional           bool
+	mock               *ConsistencyServiceMock
+	defaultExpectation *ConsistencyServiceMockCheckExpectation
+	expectations       []*ConsistencyServiceMockCheckExpectation
+
+	callArgs []*ConsistencyServiceMockCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ConsistencyServiceMockCheckExpectation specifies expectation struct of the ConsistencyService.Check
+type ConsistencyServiceMockCheckExpectation struct {
+	mock               *ConsistencyServiceMock
+	params             *ConsistencyServiceMockCheckParams
+	paramPtrs          *ConsistencyServiceMockCheckParamPtrs
+	expectationOrigins ConsistencyServiceMockCheckExpectationOrigins
+	results            *ConsistencyServiceMockCheckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ConsistencyServiceMockCheckParams contains parameters of the ConsistencyService.Check
+type ConsistencyServiceMockCheckParams struct {
+	ctx    context.Context
+	repair bool
+}
+
+// ConsistencyServiceMockCheckParamPtrs contains pointers to parameters of the ConsistencyService.Check
+type ConsistencyServiceMockCheckParamPtrs struct {
+	ctx    *context.Context
+	repair *bool
+}
+
+// ConsistencyServiceMockCheckResults contains results of the ConsistencyService.Check
+type ConsistencyServiceMockCheckResults struct {
+	c2  models.ConsistencyReport
+	err error
+}
+
+// ConsistencyServiceMockCheckOrigins contains origins of expectations of the ConsistencyService.Check
+type ConsistencyServiceMockCheckExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originRepair string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheck *mConsistencyServiceMockCheck) Optional() *mConsistencyServiceMockCheck {
+	mmCheck.optional = true
+	return mmCheck
+}
+
+// Expect sets up expected params for ConsistencyService.Check
+func (mmCheck *mConsistencyServiceMockCheck) Expect(ctx context.Context, repair bool) *mConsistencyServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("ConsistencyServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &ConsistencyServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("ConsistencyServiceMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &ConsistencyServiceMockCheckParams{ctx, repair}
+	mmCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ConsistencyService.Check
+func (mmCheck *mConsistencyServiceMockCheck) ExpectCtxParam1(ctx context.Context) *mConsistencyServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("ConsistencyServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &ConsistencyServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("ConsistencyServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &ConsistencyServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheck.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// ExpectRepairParam2 sets up expected param repair for ConsistencyService.Check
+func (mmCheck *mConsistencyServiceMockCheck) ExpectRepairParam2(repair bool) *mConsistencyServiceMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("ConsistencyServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &ConsistencyServiceMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("ConsistencyServiceMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &ConsistencyServiceMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.repair = &repair
+	mmCheck.defaultExpectation.expectationOrigins.originRepair = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the ConsistencyService.Check
+func (mmCheck *mConsistencyServiceMockCheck) Inspect(f func(ctx context.Context, repair bool)) *mConsistencyServiceMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for ConsistencyServiceMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by ConsistencyService.Check
+func (mmCheck *mConsistencyServiceMockCheck) Return(c2 models.ConsistencyReport, err error) *ConsistencyServiceMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("ConsistencyServiceMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &ConsistencyServiceMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &ConsistencyServiceMockCheckResults{c2, err}
+	mmCheck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the ConsistencyService.Check method
+func (mmCheck *mConsistencyServiceMockCheck) Set(f func(ctx context.Context, repair bool) (c2 models.ConsistencyReport, err error)) *ConsistencyServiceMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the ConsistencyService.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the ConsistencyService.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	mmCheck.mock.funcCheckOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// When sets expectation for the ConsistencyService.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mConsistencyServiceMockCheck) When(ctx context.Context, repair bool) *ConsistencyServiceMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("ConsistencyServiceMock.Check mock is already set by Set")
+	}
+
+	expectation := &ConsistencyServiceMockCheckExpectation{
+		mock:               mmCheck.mock,
+		params:             &ConsistencyServiceMockCheckParams{ctx, repair},
+		expectationOrigins: ConsistencyServiceMockCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ConsistencyService.Check return parameters for the expectation previously defined by the When method
+func (e *ConsistencyServiceMockCheckExpectation) Then(c2 models.ConsistencyReport, err error) *ConsistencyServiceMock {
+	e.results = &ConsistencyServiceMockCheckResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times ConsistencyService.Check should be invoked
+func (mmCheck *mConsistencyServiceMockCheck) Times(n uint64) *mConsistencyServiceMockCheck {
+	if n == 0 {
+		mmCheck.mock.t.Fatalf("Times of ConsistencyServiceMock.Check mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheck.expectedInvocations, n)
+	mmCheck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheck
+}
+
+func (mmCheck *mConsistencyServiceMockCheck) invocationsDone() bool {
+	if len(mmCheck.expectations) == 0 && mmCheck.defaultExpectation == nil && mmCheck.mock.funcCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheck.mock.afterCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Check implements mm_services.ConsistencyService
+func (mmCheck *ConsistencyServiceMock) Check(ctx context.Context, repair bool) (c2 models.ConsistencyReport, err error) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	mmCheck.t.Helper()
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(ctx, repair)
+	}
+
+	mm_params := ConsistencyServiceMockCheckParams{ctx, repair}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := ConsistencyServiceMockCheckParams{ctx, repair}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheck.t.Errorf("ConsistencyServiceMock.Check got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.repair != nil && !minimock.Equal(*mm_want_ptrs.repair, mm_got.repair) {
+				mmCheck.t.Errorf("ConsistencyServiceMock.Check got unexpected parameter repair, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originRepair, *mm_want_ptrs.repair, mm_got.repair, minimock.Diff(*mm_want_ptrs.repair, mm_got.repair))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("ConsistencyServiceMock.Check got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheck.CheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the ConsistencyServiceMock.Check")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(ctx, repair)
+	}
+	mmCheck.t.Fatalf("Unexpected call to ConsistencyServiceMock.Check. %v %v", ctx, repair)
+	return
+}
+
+// CheckAfterCounter returns a count of finished ConsistencyServiceMock.Check invocations
+func (mmCheck *ConsistencyServiceMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of ConsistencyServiceMock.Check invocations
+func (mmCheck *ConsistencyServiceMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConsistencyServiceMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mConsistencyServiceMockCheck) Calls() []*ConsistencyServiceMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*ConsistencyServiceMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *ConsistencyServiceMock) MinimockCheckDone() bool {
+	if m.CheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckMock.invocationsDone()
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *ConsistencyServiceMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConsistencyServiceMock.Check at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ConsistencyServiceMock.Check at\n%s", m.CheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ConsistencyServiceMock.Check at\n%s with params: %#v", m.CheckMock.defaultExpectation.expectationOrigins.origin, *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && afterCheckCounter < 1 {
+		m.t.Errorf("Expected call to ConsistencyServiceMock.Check at\n%s", m.funcCheckOrigin)
+	}
+
+	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to ConsistencyServiceMock.Check at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), m.CheckMock.expectedInvocationsOrigin, afterCheckCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ConsistencyServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ConsistencyServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ConsistencyServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckDone()
+}
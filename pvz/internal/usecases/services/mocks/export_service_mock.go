@@ -0,0 +1,448 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+	"pvz-cli/internal/usecases/requests"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ExportServiceMock implements mm_services.ExportService
+type ExportServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcExport          func(ctx context.Context, req requests.ExportRequest, w io.Writer) (i1 int, err error)
+	funcExportOrigin    string
+	inspectFuncExport   func(ctx context.Context, req requests.ExportRequest, w io.Writer)
+	afterExportCounter  uint64
+	beforeExportCounter uint64
+	ExportMock          mExportServiceMockExport
+}
+
+// NewExportServiceMock returns a mock for mm_services.ExportService
+func NewExportServiceMock(t minimock.Tester) *ExportServiceMock {
+	m := &ExportServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ExportMock = mExportServiceMockExport{mock: m}
+	m.ExportMock.callArgs = []*ExportServiceMockExportParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mExportServiceMockExport struct {
+	optional           bool
+	mock               *ExportServiceMock
+	defaultExpectation *ExportServiceMockExportExpectation
+	expectations       []*ExportServiceMockExportExpectation
+
+	callArgs []*ExportServiceMockExportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExportServiceMockExportExpectation specifies expectation struct of the ExportService.Export
+type ExportServiceMockExportExpectation struct {
+	mock               *ExportServiceMock
+	params             *ExportServiceMockExportParams
+	paramPtrs          *ExportServiceMockExportParamPtrs
+	expectationOrigins ExportServiceMockExportExpectationOrigins
+	results            *ExportServiceMockExportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ExportServiceMockExportParams contains parameters of the ExportService.Export
+type ExportServiceMockExportParams struct {
+	ctx context.Context
+	req requests.ExportRequest
+	w   io.Writer
+}
+
+// ExportServiceMockExportParamPtrs contains pointers to parameters of the ExportService.Export
+type ExportServiceMockExportParamPtrs struct {
+	ctx *context.Context
+	req *requests.ExportRequest
+	w   *io.Writer
+}
+
+// ExportServiceMockExportResults contains results of the ExportService.Export
+type ExportServiceMockExportResults struct {
+	i1  int
+	err error
+}
+
+// ExportServiceMockExportOrigins contains origins of expectations of the ExportService.Export
+type ExportServiceMockExportExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+	originW   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExport *mExportServiceMockExport) Optional() *mExportServiceMockExport {
+	mmExport.optional = true
+	return mmExport
+}
+
+// Expect sets up expected params for ExportService.Export
+func (mmExport *mExportServiceMockExport) Expect(ctx context.Context, req requests.ExportRequest, w io.Writer) *mExportServiceMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &ExportServiceMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.paramPtrs != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by ExpectParams functions")
+	}
+
+	mmExport.defaultExpectation.params = &ExportServiceMockExportParams{ctx, req, w}
+	mmExport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExport.expectations {
+		if minimock.Equal(e.params, mmExport.defaultExpectation.params) {
+			mmExport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExport.defaultExpectation.params)
+		}
+	}
+
+	return mmExport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ExportService.Export
+func (mmExport *mExportServiceMockExport) ExpectCtxParam1(ctx context.Context) *mExportServiceMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &ExportServiceMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &ExportServiceMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// ExpectReqParam2 sets up expected param req for ExportService.Export
+func (mmExport *mExportServiceMockExport) ExpectReqParam2(req requests.ExportRequest) *mExportServiceMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &ExportServiceMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &ExportServiceMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.req = &req
+	mmExport.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// ExpectWParam3 sets up expected param w for ExportService.Export
+func (mmExport *mExportServiceMockExport) ExpectWParam3(w io.Writer) *mExportServiceMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &ExportServiceMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &ExportServiceMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.w = &w
+	mmExport.defaultExpectation.expectationOrigins.originW = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExportService.Export
+func (mmExport *mExportServiceMockExport) Inspect(f func(ctx context.Context, req requests.ExportRequest, w io.Writer)) *mExportServiceMockExport {
+	if mmExport.mock.inspectFuncExport != nil {
+		mmExport.mock.t.Fatalf("Inspect function is already set for ExportServiceMock.Export")
+	}
+
+	mmExport.mock.inspectFuncExport = f
+
+	return mmExport
+}
+
+// Return sets up results that will be returned by ExportService.Export
+func (mmExport *mExportServiceMockExport) Return(i1 int, err error) *ExportServiceMock {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &ExportServiceMockExportExpectation{mock: mmExport.mock}
+	}
+	mmExport.defaultExpectation.results = &ExportServiceMockExportResults{i1, err}
+	mmExport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// Set uses given function f to mock the ExportService.Export method
+func (mmExport *mExportServiceMockExport) Set(f func(ctx context.Context, req requests.ExportRequest, w io.Writer) (i1 int, err error)) *ExportServiceMock {
+	if mmExport.defaultExpectation != nil {
+		mmExport.mock.t.Fatalf("Default expectation is already set for the ExportService.Export method")
+	}
+
+	if len(mmExport.expectations) > 0 {
+		mmExport.mock.t.Fatalf("Some expectations are already set for the ExportService.Export method")
+	}
+
+	mmExport.mock.funcExport = f
+	mmExport.mock.funcExportOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// When sets expectation for the ExportService.Export which will trigger the result defined by the following
+// Then helper
+func (mmExport *mExportServiceMockExport) When(ctx context.Context, req requests.ExportRequest, w io.Writer) *ExportServiceMockExportExpectation {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("ExportServiceMock.Export mock is already set by Set")
+	}
+
+	expectation := &ExportServiceMockExportExpectation{
+		mock:               mmExport.mock,
+		params:             &ExportServiceMockExportParams{ctx, req, w},
+		expectationOrigins: ExportServiceMockExportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExport.expectations = append(mmExport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ExportService.Export return parameters for the expectation previously defined by the When method
+func (e *ExportServiceMockExportExpectation) Then(i1 int, err error) *ExportServiceMock {
+	e.results = &ExportServiceMockExportResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ExportService.Export should be invoked
+func (mmExport *mExportServiceMockExport) Times(n uint64) *mExportServiceMockExport {
+	if n == 0 {
+		mmExport.mock.t.Fatalf("Times of ExportServiceMock.Export mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExport.expectedInvocations, n)
+	mmExport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExport
+}
+
+func (mmExport *mExportServiceMockExport) invocationsDone() bool {
+	if len(mmExport.expectations) == 0 && mmExport.defaultExpectation == nil && mmExport.mock.funcExport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExport.mock.afterExportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Export implements mm_services.ExportService
+func (mmExport *ExportServiceMock) Export(ctx context.Context, req requests.ExportRequest, w io.Writer) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmExport.beforeExportCounter, 1)
+	defer mm_atomic.AddUint64(&mmExport.afterExportCounter, 1)
+
+	mmExport.t.Helper()
+
+	if mmExport.inspectFuncExport != nil {
+		mmExport.inspectFuncExport(ctx, req, w)
+	}
+
+	mm_params := ExportServiceMockExportParams{ctx, req, w}
+
+	// Record call args
+	mmExport.ExportMock.mutex.Lock()
+	mmExport.ExportMock.callArgs = append(mmExport.ExportMock.callArgs, &mm_params)
+	mmExport.ExportMock.mutex.Unlock()
+
+	for _, e := range mmExport.ExportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmExport.ExportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExport.ExportMock.defaultExpectation.Counter, 1)
+		mm_want := mmExport.ExportMock.defaultExpectation.params
+		mm_want_ptrs := mmExport.ExportMock.defaultExpectation.paramPtrs
+
+		mm_got := ExportServiceMockExportParams{ctx, req, w}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExport.t.Errorf("ExportServiceMock.Export got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmExport.t.Errorf("ExportServiceMock.Export got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+			if mm_want_ptrs.w != nil && !minimock.Equal(*mm_want_ptrs.w, mm_got.w) {
+				mmExport.t.Errorf("ExportServiceMock.Export got unexpected parameter w, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originW, *mm_want_ptrs.w, mm_got.w, minimock.Diff(*mm_want_ptrs.w, mm_got.w))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExport.t.Errorf("ExportServiceMock.Export got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExport.ExportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExport.ExportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExport.t.Fatal("No results are set for the ExportServiceMock.Export")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmExport.funcExport != nil {
+		return mmExport.funcExport(ctx, req, w)
+	}
+	mmExport.t.Fatalf("Unexpected call to ExportServiceMock.Export. %v %v %v", ctx, req, w)
+	return
+}
+
+// ExportAfterCounter returns a count of finished ExportServiceMock.Export invocations
+func (mmExport *ExportServiceMock) ExportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.afterExportCounter)
+}
+
+// ExportBeforeCounter returns a count of ExportServiceMock.Export invocations
+func (mmExport *ExportServiceMock) ExportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.beforeExportCounter)
+}
+
+// Calls returns a list of arguments used in each call to ExportServiceMock.Export.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExport *mExportServiceMockExport) Calls() []*ExportServiceMockExportParams {
+	mmExport.mutex.RLock()
+
+	argCopy := make([]*ExportServiceMockExportParams, len(mmExport.callArgs))
+	copy(argCopy, mmExport.callArgs)
+
+	mmExport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportDone returns true if the count of the Export invocations corresponds
+// the number of defined expectations
+func (m *ExportServiceMock) MinimockExportDone() bool {
+	if m.ExportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportMock.invocationsDone()
+}
+
+// MinimockExportInspect logs each unmet expectation
+func (m *ExportServiceMock) MinimockExportInspect() {
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ExportServiceMock.Export at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportCounter := mm_atomic.LoadUint64(&m.afterExportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportMock.defaultExpectation != nil && afterExportCounter < 1 {
+		if m.ExportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ExportServiceMock.Export at\n%s", m.ExportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ExportServiceMock.Export at\n%s with params: %#v", m.ExportMock.defaultExpectation.expectationOrigins.origin, *m.ExportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExport != nil && afterExportCounter < 1 {
+		m.t.Errorf("Expected call to ExportServiceMock.Export at\n%s", m.funcExportOrigin)
+	}
+
+	if !m.ExportMock.invocationsDone() && afterExportCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExportServiceMock.Export at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportMock.expectedInvocations), m.ExportMock.expectedInvocationsOrigin, afterExportCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ExportServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockExportInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ExportServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ExportServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockExportDone()
+}
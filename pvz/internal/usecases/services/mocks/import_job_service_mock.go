@@ -0,0 +1,2195 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ImportJobServiceMock implements mm_services.ImportJobService
+type ImportJobServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCancel          func(ctx context.Context, jobID uint64) (i1 models.ImportJob, err error)
+	funcCancelOrigin    string
+	inspectFuncCancel   func(ctx context.Context, jobID uint64)
+	afterCancelCounter  uint64
+	beforeCancelCounter uint64
+	CancelMock          mImportJobServiceMockCancel
+
+	funcGet          func(ctx context.Context, jobID uint64) (i1 models.ImportJob, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, jobID uint64)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mImportJobServiceMockGet
+
+	funcListFailures          func(ctx context.Context, jobID uint64) (ia1 []models.ImportJobFailure, err error)
+	funcListFailuresOrigin    string
+	inspectFuncListFailures   func(ctx context.Context, jobID uint64)
+	afterListFailuresCounter  uint64
+	beforeListFailuresCounter uint64
+	ListFailuresMock          mImportJobServiceMockListFailures
+
+	funcRun          func(ctx context.Context) (err error)
+	funcRunOrigin    string
+	inspectFuncRun   func(ctx context.Context)
+	afterRunCounter  uint64
+	beforeRunCounter uint64
+	RunMock          mImportJobServiceMockRun
+
+	funcSubmit          func(ctx context.Context, req requests.ImportOrdersRequest) (i1 models.ImportJob, err error)
+	funcSubmitOrigin    string
+	inspectFuncSubmit   func(ctx context.Context, req requests.ImportOrdersRequest)
+	afterSubmitCounter  uint64
+	beforeSubmitCounter uint64
+	SubmitMock          mImportJobServiceMockSubmit
+
+	funcWatch          func(ctx context.Context, jobID uint64, fn func(models.ImportJob) error) (err error)
+	funcWatchOrigin    string
+	inspectFuncWatch   func(ctx context.Context, jobID uint64, fn func(models.ImportJob) error)
+	afterWatchCounter  uint64
+	beforeWatchCounter uint64
+	WatchMock          mImportJobServiceMockWatch
+}
+
+// NewImportJobServiceMock returns a mock for mm_services.ImportJobService
+func NewImportJobServiceMock(t minimock.Tester) *ImportJobServiceMock {
+	m := &ImportJobServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CancelMock = mImportJobServiceMockCancel{mock: m}
+	m.CancelMock.callArgs = []*ImportJobServiceMockCancelParams{}
+
+	m.GetMock = mImportJobServiceMockGet{mock: m}
+	m.GetMock.callArgs = []*ImportJobServiceMockGetParams{}
+
+	m.ListFailuresMock = mImportJobServiceMockListFailures{mock: m}
+	m.ListFailuresMock.callArgs = []*ImportJobServiceMockListFailuresParams{}
+
+	m.RunMock = mImportJobServiceMockRun{mock: m}
+	m.RunMock.callArgs = []*ImportJobServiceMockRunParams{}
+
+	m.SubmitMock = mImportJobServiceMockSubmit{mock: m}
+	m.SubmitMock.callArgs = []*ImportJobServiceMockSubmitParams{}
+
+	m.WatchMock = mImportJobServiceMockWatch{mock: m}
+	m.WatchMock.callArgs = []*ImportJobServiceMockWatchParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mImportJobServiceMockCancel struct {
+	optional           bool
+	mock               *ImportJobServiceMock
+	defaultExpectation *ImportJobServiceMockCancelExpectation
+	expectations       []*ImportJobServiceMockCancelExpectation
+
+	callArgs []*ImportJobServiceMockCancelParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobServiceMockCancelExpectation specifies expectation struct of the ImportJobService.Cancel
+type ImportJobServiceMockCancelExpectation struct {
+	mock               *ImportJobServiceMock
+	params             *ImportJobServiceMockCancelParams
+	paramPtrs          *ImportJobServiceMockCancelParamPtrs
+	expectationOrigins ImportJobServiceMockCancelExpectationOrigins
+	results            *ImportJobServiceMockCancelResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobServiceMockCancelParams contains parameters of the ImportJobService.Cancel
+type ImportJobServiceMockCancelParams struct {
+	ctx   context.Context
+	jobID uint64
+}
+
+// ImportJobServiceMockCancelParamPtrs contains pointers to parameters of the ImportJobService.Cancel
+type ImportJobServiceMockCancelParamPtrs struct {
+	ctx   *context.Context
+	jobID *uint64
+}
+
+// ImportJobServiceMockCancelResults contains results of the ImportJobService.Cancel
+type ImportJobServiceMockCancelResults struct {
+	i1  models.ImportJob
+	err error
+}
+
+// ImportJobServiceMockCancelOrigins contains origins of expectations of the ImportJobService.Cancel
+type ImportJobServiceMockCancelExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originJobID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancel *mImportJobServiceMockCancel) Optional() *mImportJobServiceMockCancel {
+	mmCancel.optional = true
+	return mmCancel
+}
+
+// Expect sets up expected params for ImportJobService.Cancel
+func (mmCancel *mImportJobServiceMockCancel) Expect(ctx context.Context, jobID uint64) *mImportJobServiceMockCancel {
+	if mmCancel.mock.funcCancel != nil {
+		mmCancel.mock.t.Fatalf("ImportJobServiceMock.Cancel mock is already set by Set")
+	}
+
+	if mmCancel.defaultExpectation == nil {
+		mmCancel.defaultExpectation = &ImportJobServiceMockCancelExpectation{}
+	}
+
+	if mmCancel.defaultExpectation.paramPtrs != nil {
+		mmCancel.mock.t.Fatalf("ImportJobServiceMock.Cancel mock is already set by ExpectParams functions")
+	}
+
+	mmCancel.defaultExpectation.params = &ImportJobServiceMockCancelParams{ctx, jobID}
+	mmCancel.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCancel.expectations {
+		if minimock.Equal(e.params, mmCancel.defaultExpectation.params) {
+			mmCancel.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancel.defaultExpectation.params)
+		}
+	}
+
+	return mmCancel
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobService.Cancel
+func (mmCancel *mImportJobServiceMockCancel) ExpectCtxParam1(ctx context.Context) *mImportJobServiceMockCancel {
+	if mmCancel.mock.funcCancel != nil {
+		mmCancel.mock.t.Fatalf("ImportJobServiceMock.Cancel mock is already set by Set")
+	}
+
+	if mmCancel.defaultExpectation == nil {
+		mmCancel.defaultExpectation = &ImportJobServiceMockCancelExpectation{}
+	}
+
+	if mmCancel.defaultExpectation.params != nil {
+		mmCancel.mock.t.Fatalf("ImportJobServiceMock.Cancel mock is already set by Expect")
+	}
+
+	if mmCancel.defaultExpectation.paramPtrs == nil {
+		mmCancel.defaultExpectation.paramPtrs = &ImportJobServiceMockCancelParamPtrs{}
+	}
+	mmCancel.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCancel.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCancel
+}
+
+// ExpectJobIDParam2 sets up expected param jobID for ImportJobService.Cancel
+func (mmCancel *mImportJobServiceMockCancel) ExpectJobIDParam2(jobID uint64) *mImportJobServiceMockCancel {
+	if mmCancel.mock.funcCancel != nil {
+		mmCancel.mock.t.Fatalf("ImportJobServiceMock.Cancel mock is already set by Set")
+	}
+
+	if mmCancel.defaultExpectation == nil {
+		mmCancel.defaultExpectation = &ImportJobServiceMockCancelExpectation{}
+	}
+
+	if mmCancel.defaultExpectation.params != nil {
+		mmCancel.mock.t.Fatalf("ImportJobServiceMock.Cancel mock is already set by Expect")
+	}
+
+	if mmCancel.defaultExpectation.paramPtrs == nil {
+		mmCancel.defaultExpectation.paramPtrs = &ImportJobServiceMockCancelParamPtrs{}
+	}
+	mmCancel.defaultExpectation.paramPtrs.jobID = &jobID
+	mmCancel.defaultExpectation.expectationOrigins.originJobID = minimock.CallerInfo(1)
+
+	return mmCancel
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobService.Cancel
+func (mmCancel *mImportJobServiceMockCancel) Inspect(f func(ctx context.Context, jobID uint64)) *mImportJobServiceMockCancel {
+	if mmCancel.mock.inspectFuncCancel != nil {
+		mmCancel.mock.t.Fatalf("Inspect function is already set for ImportJobServiceMock.Cancel")
+	}
+
+	mmCancel.mock.inspectFuncCancel = f
+
+	return mmCancel
+}
+
+// Return sets up results that will be returned by ImportJobService.Cancel
+func (mmCancel *mImportJobServiceMockCancel) Return(i1 models.ImportJob, err error) *ImportJobServiceMock {
+	if mmCancel.mock.funcCancel != nil {
+		mmCancel.mock.t.Fatalf("ImportJobServiceMock.Cancel mock is already set by Set")
+	}
+
+	if mmCancel.defaultExpectation == nil {
+		mmCancel.defaultExpectation = &ImportJobServiceMockCancelExpectation{mock: mmCancel.mock}
+	}
+	mmCancel.defaultExpectation.results = &ImportJobServiceMockCancelResults{i1, err}
+	mmCancel.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCancel.mock
+}
+
+// Set uses given function f to mock the ImportJobService.Cancel method
+func (mmCancel *mImportJobServiceMockCancel) Set(f func(ctx context.Context, jobID uint64) (i1 models.ImportJob, err error)) *ImportJobServiceMock {
+	if mmCancel.defaultExpectation != nil {
+		mmCancel.mock.t.Fatalf("Default expectation is already set for the ImportJobService.Cancel method")
+	}
+
+	if len(mmCancel.expectations) > 0 {
+		mmCancel.mock.t.Fatalf("Some expectations are already set for the ImportJobService.Cancel method")
+	}
+
+	mmCancel.mock.funcCancel = f
+	mmCancel.mock.funcCancelOrigin = minimock.CallerInfo(1)
+	return mmCancel.mock
+}
+
+// When sets expectation for the ImportJobService.Cancel which will trigger the result defined by the following
+// Then helper
+func (mmCancel *mImportJobServiceMockCancel) When(ctx context.Context, jobID uint64) *ImportJobServiceMockCancelExpectation {
+	if mmCancel.mock.funcCancel != nil {
+		mmCancel.mock.t.Fatalf("ImportJobServiceMock.Cancel mock is already set by Set")
+	}
+
+	expectation := &ImportJobServiceMockCancelExpectation{
+		mock:               mmCancel.mock,
+		params:             &ImportJobServiceMockCancelParams{ctx, jobID},
+		expectationOrigins: ImportJobServiceMockCancelExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCancel.expectations = append(mmCancel.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobService.Cancel return parameters for the expectation previously defined by the When method
+func (e *ImportJobServiceMockCancelExpectation) Then(i1 models.ImportJob, err error) *ImportJobServiceMock {
+	e.results = &ImportJobServiceMockCancelResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobService.Cancel should be invoked
+func (mmCancel *mImportJobServiceMockCancel) Times(n uint64) *mImportJobServiceMockCancel {
+	if n == 0 {
+		mmCancel.mock.t.Fatalf("Times of ImportJobServiceMock.Cancel mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancel.expectedInvocations, n)
+	mmCancel.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCancel
+}
+
+func (mmCancel *mImportJobServiceMockCancel) invocationsDone() bool {
+	if len(mmCancel.expectations) == 0 && mmCancel.defaultExpectation == nil && mmCancel.mock.funcCancel == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancel.mock.afterCancelCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancel.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Cancel implements mm_services.ImportJobService
+func (mmCancel *ImportJobServiceMock) Cancel(ctx context.Context, jobID uint64) (i1 models.ImportJob, err error) {
+	mm_atomic.AddUint64(&mmCancel.beforeCancelCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancel.afterCancelCounter, 1)
+
+	mmCancel.t.Helper()
+
+	if mmCancel.inspectFuncCancel != nil {
+		mmCancel.inspectFuncCancel(ctx, jobID)
+	}
+
+	mm_params := ImportJobServiceMockCancelParams{ctx, jobID}
+
+	// Record call args
+	mmCancel.CancelMock.mutex.Lock()
+	mmCancel.CancelMock.callArgs = append(mmCancel.CancelMock.callArgs, &mm_params)
+	mmCancel.CancelMock.mutex.Unlock()
+
+	for _, e := range mmCancel.CancelMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCancel.CancelMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancel.CancelMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancel.CancelMock.defaultExpectation.params
+		mm_want_ptrs := mmCancel.CancelMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobServiceMockCancelParams{ctx, jobID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancel.t.Errorf("ImportJobServiceMock.Cancel got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancel.CancelMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.jobID != nil && !minimock.Equal(*mm_want_ptrs.jobID, mm_got.jobID) {
+				mmCancel.t.Errorf("ImportJobServiceMock.Cancel got unexpected parameter jobID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancel.CancelMock.defaultExpectation.expectationOrigins.originJobID, *mm_want_ptrs.jobID, mm_got.jobID, minimock.Diff(*mm_want_ptrs.jobID, mm_got.jobID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancel.t.Errorf("ImportJobServiceMock.Cancel got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCancel.CancelMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancel.CancelMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancel.t.Fatal("No results are set for the ImportJobServiceMock.Cancel")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCancel.funcCancel != nil {
+		return mmCancel.funcCancel(ctx, jobID)
+	}
+	mmCancel.t.Fatalf("Unexpected call to ImportJobServiceMock.Cancel. %v %v", ctx, jobID)
+	return
+}
+
+// CancelAfterCounter returns a count of finished ImportJobServiceMock.Cancel invocations
+func (mmCancel *ImportJobServiceMock) CancelAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancel.afterCancelCounter)
+}
+
+// CancelBeforeCounter returns a count of ImportJobServiceMock.Cancel invocations
+func (mmCancel *ImportJobServiceMock) CancelBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancel.beforeCancelCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobServiceMock.Cancel.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancel *mImportJobServiceMockCancel) Calls() []*ImportJobServiceMockCancelParams {
+	mmCancel.mutex.RLock()
+
+	argCopy := make([]*ImportJobServiceMockCancelParams, len(mmCancel.callArgs))
+	copy(argCopy, mmCancel.callArgs)
+
+	mmCancel.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelDone returns true if the count of the Cancel invocations corresponds
+// the number of defined expectations
+func (m *ImportJobServiceMock) MinimockCancelDone() bool {
+	if m.CancelMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelMock.invocationsDone()
+}
+
+// MinimockCancelInspect logs each unmet expectation
+func (m *ImportJobServiceMock) MinimockCancelInspect() {
+	for _, e := range m.CancelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Cancel at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCancelCounter := mm_atomic.LoadUint64(&m.afterCancelCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelMock.defaultExpectation != nil && afterCancelCounter < 1 {
+		if m.CancelMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Cancel at\n%s", m.CancelMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Cancel at\n%s with params: %#v", m.CancelMock.defaultExpectation.expectationOrigins.origin, *m.CancelMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancel != nil && afterCancelCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobServiceMock.Cancel at\n%s", m.funcCancelOrigin)
+	}
+
+	if !m.CancelMock.invocationsDone() && afterCancelCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobServiceMock.Cancel at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelMock.expectedInvocations), m.CancelMock.expectedInvocationsOrigin, afterCancelCounter)
+	}
+}
+
+type mImportJobServiceMockGet struct {
+	optional           bool
+	mock               *ImportJobServiceMock
+	defaultExpectation *ImportJobServiceMockGetExpectation
+	expectations       []*ImportJobServiceMockGetExpectation
+
+	callArgs []*ImportJobServiceMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobServiceMockGetExpectation specifies expectation struct of the ImportJobService.Get
+type ImportJobServiceMockGetExpectation struct {
+	mock               *ImportJobServiceMock
+	params             *ImportJobServiceMockGetParams
+	paramPtrs          *ImportJobServiceMockGetParamPtrs
+	expectationOrigins ImportJobServiceMockGetExpectationOrigins
+	results            *ImportJobServiceMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobServiceMockGetParams contains parameters of the ImportJobService.Get
+type ImportJobServiceMockGetParams struct {
+	ctx   context.Context
+	jobID uint64
+}
+
+// ImportJobServiceMockGetParamPtrs contains pointers to parameters of the ImportJobService.Get
+type ImportJobServiceMockGetParamPtrs struct {
+	ctx   *context.Context
+	jobID *uint64
+}
+
+// ImportJobServiceMockGetResults contains results of the ImportJobService.Get
+type ImportJobServiceMockGetResults struct {
+	i1  models.ImportJob
+	err error
+}
+
+// ImportJobServiceMockGetOrigins contains origins of expectations of the ImportJobService.Get
+type ImportJobServiceMockGetExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originJobID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mImportJobServiceMockGet) Optional() *mImportJobServiceMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for ImportJobService.Get
+func (mmGet *mImportJobServiceMockGet) Expect(ctx context.Context, jobID uint64) *mImportJobServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ImportJobServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ImportJobServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("ImportJobServiceMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &ImportJobServiceMockGetParams{ctx, jobID}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobService.Get
+func (mmGet *mImportJobServiceMockGet) ExpectCtxParam1(ctx context.Context) *mImportJobServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ImportJobServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ImportJobServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ImportJobServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ImportJobServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectJobIDParam2 sets up expected param jobID for ImportJobService.Get
+func (mmGet *mImportJobServiceMockGet) ExpectJobIDParam2(jobID uint64) *mImportJobServiceMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ImportJobServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ImportJobServiceMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ImportJobServiceMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ImportJobServiceMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.jobID = &jobID
+	mmGet.defaultExpectation.expectationOrigins.originJobID = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobService.Get
+func (mmGet *mImportJobServiceMockGet) Inspect(f func(ctx context.Context, jobID uint64)) *mImportJobServiceMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for ImportJobServiceMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by ImportJobService.Get
+func (mmGet *mImportJobServiceMockGet) Return(i1 models.ImportJob, err error) *ImportJobServiceMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ImportJobServiceMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ImportJobServiceMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &ImportJobServiceMockGetResults{i1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the ImportJobService.Get method
+func (mmGet *mImportJobServiceMockGet) Set(f func(ctx context.Context, jobID uint64) (i1 models.ImportJob, err error)) *ImportJobServiceMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the ImportJobService.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the ImportJobService.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the ImportJobService.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mImportJobServiceMockGet) When(ctx context.Context, jobID uint64) *ImportJobServiceMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ImportJobServiceMock.Get mock is already set by Set")
+	}
+
+	expectation := &ImportJobServiceMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &ImportJobServiceMockGetParams{ctx, jobID},
+		expectationOrigins: ImportJobServiceMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobService.Get return parameters for the expectation previously defined by the When method
+func (e *ImportJobServiceMockGetExpectation) Then(i1 models.ImportJob, err error) *ImportJobServiceMock {
+	e.results = &ImportJobServiceMockGetResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobService.Get should be invoked
+func (mmGet *mImportJobServiceMockGet) Times(n uint64) *mImportJobServiceMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of ImportJobServiceMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mImportJobServiceMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_services.ImportJobService
+func (mmGet *ImportJobServiceMock) Get(ctx context.Context, jobID uint64) (i1 models.ImportJob, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, jobID)
+	}
+
+	mm_params := ImportJobServiceMockGetParams{ctx, jobID}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobServiceMockGetParams{ctx, jobID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("ImportJobServiceMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.jobID != nil && !minimock.Equal(*mm_want_ptrs.jobID, mm_got.jobID) {
+				mmGet.t.Errorf("ImportJobServiceMock.Get got unexpected parameter jobID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originJobID, *mm_want_ptrs.jobID, mm_got.jobID, minimock.Diff(*mm_want_ptrs.jobID, mm_got.jobID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("ImportJobServiceMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the ImportJobServiceMock.Get")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, jobID)
+	}
+	mmGet.t.Fatalf("Unexpected call to ImportJobServiceMock.Get. %v %v", ctx, jobID)
+	return
+}
+
+// GetAfterCounter returns a count of finished ImportJobServiceMock.Get invocations
+func (mmGet *ImportJobServiceMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of ImportJobServiceMock.Get invocations
+func (mmGet *ImportJobServiceMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobServiceMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mImportJobServiceMockGet) Calls() []*ImportJobServiceMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*ImportJobServiceMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *ImportJobServiceMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *ImportJobServiceMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobServiceMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobServiceMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mImportJobServiceMockListFailures struct {
+	optional           bool
+	mock               *ImportJobServiceMock
+	defaultExpectation *ImportJobServiceMockListFailuresExpectation
+	expectations       []*ImportJobServiceMockListFailuresExpectation
+
+	callArgs []*ImportJobServiceMockListFailuresParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobServiceMockListFailuresExpectation specifies expectation struct of the ImportJobService.ListFailures
+type ImportJobServiceMockListFailuresExpectation struct {
+	mock               *ImportJobServiceMock
+	params             *ImportJobServiceMockListFailuresParams
+	paramPtrs          *ImportJobServiceMockListFailuresParamPtrs
+	expectationOrigins ImportJobServiceMockListFailuresExpectationOrigins
+	results            *ImportJobServiceMockListFailuresResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobServiceMockListFailuresParams contains parameters of the ImportJobService.ListFailures
+type ImportJobServiceMockListFailuresParams struct {
+	ctx   context.Context
+	jobID uint64
+}
+
+// ImportJobServiceMockListFailuresParamPtrs contains pointers to parameters of the ImportJobService.ListFailures
+type ImportJobServiceMockListFailuresParamPtrs struct {
+	ctx   *context.Context
+	jobID *uint64
+}
+
+// ImportJobServiceMockListFailuresResults contains results of the ImportJobService.ListFailures
+type ImportJobServiceMockListFailuresResults struct {
+	ia1 []models.ImportJobFailure
+	err error
+}
+
+// ImportJobServiceMockListFailuresOrigins contains origins of expectations of the ImportJobService.ListFailures
+type ImportJobServiceMockListFailuresExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originJobID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListFailures *mImportJobServiceMockListFailures) Optional() *mImportJobServiceMockListFailures {
+	mmListFailures.optional = true
+	return mmListFailures
+}
+
+// Expect sets up expected params for ImportJobService.ListFailures
+func (mmListFailures *mImportJobServiceMockListFailures) Expect(ctx context.Context, jobID uint64) *mImportJobServiceMockListFailures {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobServiceMock.ListFailures mock is already set by Set")
+	}
+
+	if mmListFailures.defaultExpectation == nil {
+		mmListFailures.defaultExpectation = &ImportJobServiceMockListFailuresExpectation{}
+	}
+
+	if mmListFailures.defaultExpectation.paramPtrs != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobServiceMock.ListFailures mock is already set by ExpectParams functions")
+	}
+
+	mmListFailures.defaultExpectation.params = &ImportJobServiceMockListFailuresParams{ctx, jobID}
+	mmListFailures.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListFailures.expectations {
+		if minimock.Equal(e.params, mmListFailures.defaultExpectation.params) {
+			mmListFailures.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListFailures.defaultExpectation.params)
+		}
+	}
+
+	return mmListFailures
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobService.ListFailures
+func (mmListFailures *mImportJobServiceMockListFailures) ExpectCtxParam1(ctx context.Context) *mImportJobServiceMockListFailures {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobServiceMock.ListFailures mock is already set by Set")
+	}
+
+	if mmListFailures.defaultExpectation == nil {
+		mmListFailures.defaultExpectation = &ImportJobServiceMockListFailuresExpectation{}
+	}
+
+	if mmListFailures.defaultExpectation.params != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobServiceMock.ListFailures mock is already set by Expect")
+	}
+
+	if mmListFailures.defaultExpectation.paramPtrs == nil {
+		mmListFailures.defaultExpectation.paramPtrs = &ImportJobServiceMockListFailuresParamPtrs{}
+	}
+	mmListFailures.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListFailures.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListFailures
+}
+
+// ExpectJobIDParam2 sets up expected param jobID for ImportJobService.ListFailures
+func (mmListFailures *mImportJobServiceMockListFailures) ExpectJobIDParam2(jobID uint64) *mImportJobServiceMockListFailures {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobServiceMock.ListFailures mock is already set by Set")
+	}
+
+	if mmListFailures.defaultExpectation == nil {
+		mmListFailures.defaultExpectation = &ImportJobServiceMockListFailuresExpectation{}
+	}
+
+	if mmListFailures.defaultExpectation.params != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobServiceMock.ListFailures mock is already set by Expect")
+	}
+
+	if mmListFailures.defaultExpectation.paramPtrs == nil {
+		mmListFailures.defaultExpectation.paramPtrs = &ImportJobServiceMockListFailuresParamPtrs{}
+	}
+	mmListFailures.defaultExpectation.paramPtrs.jobID = &jobID
+	mmListFailures.defaultExpectation.expectationOrigins.originJobID = minimock.CallerInfo(1)
+
+	return mmListFailures
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobService.ListFailures
+func (mmListFailures *mImportJobServiceMockListFailures) Inspect(f func(ctx context.Context, jobID uint64)) *mImportJobServiceMockListFailures {
+	if mmListFailures.mock.inspectFuncListFailures != nil {
+		mmListFailures.mock.t.Fatalf("Inspect function is already set for ImportJobServiceMock.ListFailures")
+	}
+
+	mmListFailures.mock.inspectFuncListFailures = f
+
+	return mmListFailures
+}
+
+// Return sets up results that will be returned by ImportJobService.ListFailures
+func (mmListFailures *mImportJobServiceMockListFailures) Return(ia1 []models.ImportJobFailure, err error) *ImportJobServiceMock {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobServiceMock.ListFailures mock is already set by Set")
+	}
+
+	if mmListFailures.defaultExpectation == nil {
+		mmListFailures.defaultExpectation = &ImportJobServiceMockListFailuresExpectation{mock: mmListFailures.mock}
+	}
+	mmListFailures.defaultExpectation.results = &ImportJobServiceMockListFailuresResults{ia1, err}
+	mmListFailures.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListFailures.mock
+}
+
+// Set uses given function f to mock the ImportJobService.ListFailures method
+func (mmListFailures *mImportJobServiceMockListFailures) Set(f func(ctx context.Context, jobID uint64) (ia1 []models.ImportJobFailure, err error)) *ImportJobServiceMock {
+	if mmListFailures.defaultExpectation != nil {
+		mmListFailures.mock.t.Fatalf("Default expectation is already set for the ImportJobService.ListFailures method")
+	}
+
+	if len(mmListFailures.expectations) > 0 {
+		mmListFailures.mock.t.Fatalf("Some expectations are already set for the ImportJobService.ListFailures method")
+	}
+
+	mmListFailures.mock.funcListFailures = f
+	mmListFailures.mock.funcListFailuresOrigin = minimock.CallerInfo(1)
+	return mmListFailures.mock
+}
+
+// When sets expectation for the ImportJobService.ListFailures which will trigger the result defined by the following
+// Then helper
+func (mmListFailures *mImportJobServiceMockListFailures) When(ctx context.Context, jobID uint64) *ImportJobServiceMockListFailuresExpectation {
+	if mmListFailures.mock.funcListFailures != nil {
+		mmListFailures.mock.t.Fatalf("ImportJobServiceMock.ListFailures mock is already set by Set")
+	}
+
+	expectation := &ImportJobServiceMockListFailuresExpectation{
+		mock:               mmListFailures.mock,
+		params:             &ImportJobServiceMockListFailuresParams{ctx, jobID},
+		expectationOrigins: ImportJobServiceMockListFailuresExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListFailures.expectations = append(mmListFailures.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobService.ListFailures return parameters for the expectation previously defined by the When method
+func (e *ImportJobServiceMockListFailuresExpectation) Then(ia1 []models.ImportJobFailure, err error) *ImportJobServiceMock {
+	e.results = &ImportJobServiceMockListFailuresResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobService.ListFailures should be invoked
+func (mmListFailures *mImportJobServiceMockListFailures) Times(n uint64) *mImportJobServiceMockListFailures {
+	if n == 0 {
+		mmListFailures.mock.t.Fatalf("Times of ImportJobServiceMock.ListFailures mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListFailures.expectedInvocations, n)
+	mmListFailures.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListFailures
+}
+
+func (mmListFailures *mImportJobServiceMockListFailures) invocationsDone() bool {
+	if len(mmListFailures.expectations) == 0 && mmListFailures.defaultExpectation == nil && mmListFailures.mock.funcListFailures == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListFailures.mock.afterListFailuresCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListFailures.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListFailures implements mm_services.ImportJobService
+func (mmListFailures *ImportJobServiceMock) ListFailures(ctx context.Context, jobID uint64) (ia1 []models.ImportJobFailure, err error) {
+	mm_atomic.AddUint64(&mmListFailures.beforeListFailuresCounter, 1)
+	defer mm_atomic.AddUint64(&mmListFailures.afterListFailuresCounter, 1)
+
+	mmListFailures.t.Helper()
+
+	if mmListFailures.inspectFuncListFailures != nil {
+		mmListFailures.inspectFuncListFailures(ctx, jobID)
+	}
+
+	mm_params := ImportJobServiceMockListFailuresParams{ctx, jobID}
+
+	// Record call args
+	mmListFailures.ListFailuresMock.mutex.Lock()
+	mmListFailures.ListFailuresMock.callArgs = append(mmListFailures.ListFailuresMock.callArgs, &mm_params)
+	mmListFailures.ListFailuresMock.mutex.Unlock()
+
+	for _, e := range mmListFailures.ListFailuresMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmListFailures.ListFailuresMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListFailures.ListFailuresMock.defaultExpectation.Counter, 1)
+		mm_want := mmListFailures.ListFailuresMock.defaultExpectation.params
+		mm_want_ptrs := mmListFailures.ListFailuresMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobServiceMockListFailuresParams{ctx, jobID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListFailures.t.Errorf("ImportJobServiceMock.ListFailures got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFailures.ListFailuresMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.jobID != nil && !minimock.Equal(*mm_want_ptrs.jobID, mm_got.jobID) {
+				mmListFailures.t.Errorf("ImportJobServiceMock.ListFailures got unexpected parameter jobID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListFailures.ListFailuresMock.defaultExpectation.expectationOrigins.originJobID, *mm_want_ptrs.jobID, mm_got.jobID, minimock.Diff(*mm_want_ptrs.jobID, mm_got.jobID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListFailures.t.Errorf("ImportJobServiceMock.ListFailures got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListFailures.ListFailuresMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListFailures.ListFailuresMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListFailures.t.Fatal("No results are set for the ImportJobServiceMock.ListFailures")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmListFailures.funcListFailures != nil {
+		return mmListFailures.funcListFailures(ctx, jobID)
+	}
+	mmListFailures.t.Fatalf("Unexpected call to ImportJobServiceMock.ListFailures. %v %v", ctx, jobID)
+	return
+}
+
+// ListFailuresAfterCounter returns a count of finished ImportJobServiceMock.ListFailures invocations
+func (mmListFailures *ImportJobServiceMock) ListFailuresAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFailures.afterListFailuresCounter)
+}
+
+// ListFailuresBeforeCounter returns a count of ImportJobServiceMock.ListFailures invocations
+func (mmListFailures *ImportJobServiceMock) ListFailuresBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListFailures.beforeListFailuresCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobServiceMock.ListFailures.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListFailures *mImportJobServiceMockListFailures) Calls() []*ImportJobServiceMockListFailuresParams {
+	mmListFailures.mutex.RLock()
+
+	argCopy := make([]*ImportJobServiceMockListFailuresParams, len(mmListFailures.callArgs))
+	copy(argCopy, mmListFailures.callArgs)
+
+	mmListFailures.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListFailuresDone returns true if the count of the ListFailures invocations corresponds
+// the number of defined expectations
+func (m *ImportJobServiceMock) MinimockListFailuresDone() bool {
+	if m.ListFailuresMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListFailuresMock.invocationsDone()
+}
+
+// MinimockListFailuresInspect logs each unmet expectation
+func (m *ImportJobServiceMock) MinimockListFailuresInspect() {
+	for _, e := range m.ListFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobServiceMock.ListFailures at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListFailuresCounter := mm_atomic.LoadUint64(&m.afterListFailuresCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListFailuresMock.defaultExpectation != nil && afterListFailuresCounter < 1 {
+		if m.ListFailuresMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobServiceMock.ListFailures at\n%s", m.ListFailuresMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobServiceMock.ListFailures at\n%s with params: %#v", m.ListFailuresMock.defaultExpectation.expectationOrigins.origin, *m.ListFailuresMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListFailures != nil && afterListFailuresCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobServiceMock.ListFailures at\n%s", m.funcListFailuresOrigin)
+	}
+
+	if !m.ListFailuresMock.invocationsDone() && afterListFailuresCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobServiceMock.ListFailures at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListFailuresMock.expectedInvocations), m.ListFailuresMock.expectedInvocationsOrigin, afterListFailuresCounter)
+	}
+}
+
+type mImportJobServiceMockRun struct {
+	optional           bool
+	mock               *ImportJobServiceMock
+	defaultExpectation *ImportJobServiceMockRunExpectation
+	expectations       []*ImportJobServiceMockRunExpectation
+
+	callArgs []*ImportJobServiceMockRunParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobServiceMockRunExpectation specifies expectation struct of the ImportJobService.Run
+type ImportJobServiceMockRunExpectation struct {
+	mock               *ImportJobServiceMock
+	params             *ImportJobServiceMockRunParams
+	paramPtrs          *ImportJobServiceMockRunParamPtrs
+	expectationOrigins ImportJobServiceMockRunExpectationOrigins
+	results            *ImportJobServiceMockRunResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobServiceMockRunParams contains parameters of the ImportJobService.Run
+type ImportJobServiceMockRunParams struct {
+	ctx context.Context
+}
+
+// ImportJobServiceMockRunParamPtrs contains pointers to parameters of the ImportJobService.Run
+type ImportJobServiceMockRunParamPtrs struct {
+	ctx *context.Context
+}
+
+// ImportJobServiceMockRunResults contains results of the ImportJobService.Run
+type ImportJobServiceMockRunResults struct {
+	err error
+}
+
+// ImportJobServiceMockRunOrigins contains origins of expectations of the ImportJobService.Run
+type ImportJobServiceMockRunExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRun *mImportJobServiceMockRun) Optional() *mImportJobServiceMockRun {
+	mmRun.optional = true
+	return mmRun
+}
+
+// Expect sets up expected params for ImportJobService.Run
+func (mmRun *mImportJobServiceMockRun) Expect(ctx context.Context) *mImportJobServiceMockRun {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("ImportJobServiceMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &ImportJobServiceMockRunExpectation{}
+	}
+
+	if mmRun.defaultExpectation.paramPtrs != nil {
+		mmRun.mock.t.Fatalf("ImportJobServiceMock.Run mock is already set by ExpectParams functions")
+	}
+
+	mmRun.defaultExpectation.params = &ImportJobServiceMockRunParams{ctx}
+	mmRun.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRun.expectations {
+		if minimock.Equal(e.params, mmRun.defaultExpectation.params) {
+			mmRun.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRun.defaultExpectation.params)
+		}
+	}
+
+	return mmRun
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobService.Run
+func (mmRun *mImportJobServiceMockRun) ExpectCtxParam1(ctx context.Context) *mImportJobServiceMockRun {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("ImportJobServiceMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &ImportJobServiceMockRunExpectation{}
+	}
+
+	if mmRun.defaultExpectation.params != nil {
+		mmRun.mock.t.Fatalf("ImportJobServiceMock.Run mock is already set by Expect")
+	}
+
+	if mmRun.defaultExpectation.paramPtrs == nil {
+		mmRun.defaultExpectation.paramPtrs = &ImportJobServiceMockRunParamPtrs{}
+	}
+	mmRun.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRun.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRun
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobService.Run
+func (mmRun *mImportJobServiceMockRun) Inspect(f func(ctx context.Context)) *mImportJobServiceMockRun {
+	if mmRun.mock.inspectFuncRun != nil {
+		mmRun.mock.t.Fatalf("Inspect function is already set for ImportJobServiceMock.Run")
+	}
+
+	mmRun.mock.inspectFuncRun = f
+
+	return mmRun
+}
+
+// Return sets up results that will be returned by ImportJobService.Run
+func (mmRun *mImportJobServiceMockRun) Return(err error) *ImportJobServiceMock {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("ImportJobServiceMock.Run mock is already set by Set")
+	}
+
+	if mmRun.defaultExpectation == nil {
+		mmRun.defaultExpectation = &ImportJobServiceMockRunExpectation{mock: mmRun.mock}
+	}
+	mmRun.defaultExpectation.results = &ImportJobServiceMockRunResults{err}
+	mmRun.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRun.mock
+}
+
+// Set uses given function f to mock the ImportJobService.Run method
+func (mmRun *mImportJobServiceMockRun) Set(f func(ctx context.Context) (err error)) *ImportJobServiceMock {
+	if mmRun.defaultExpectation != nil {
+		mmRun.mock.t.Fatalf("Default expectation is already set for the ImportJobService.Run method")
+	}
+
+	if len(mmRun.expectations) > 0 {
+		mmRun.mock.t.Fatalf("Some expectations are already set for the ImportJobService.Run method")
+	}
+
+	mmRun.mock.funcRun = f
+	mmRun.mock.funcRunOrigin = minimock.CallerInfo(1)
+	return mmRun.mock
+}
+
+// When sets expectation for the ImportJobService.Run which will trigger the result defined by the following
+// Then helper
+func (mmRun *mImportJobServiceMockRun) When(ctx context.Context) *ImportJobServiceMockRunExpectation {
+	if mmRun.mock.funcRun != nil {
+		mmRun.mock.t.Fatalf("ImportJobServiceMock.Run mock is already set by Set")
+	}
+
+	expectation := &ImportJobServiceMockRunExpectation{
+		mock:               mmRun.mock,
+		params:             &ImportJobServiceMockRunParams{ctx},
+		expectationOrigins: ImportJobServiceMockRunExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRun.expectations = append(mmRun.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobService.Run return parameters for the expectation previously defined by the When method
+func (e *ImportJobServiceMockRunExpectation) Then(err error) *ImportJobServiceMock {
+	e.results = &ImportJobServiceMockRunResults{err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobService.Run should be invoked
+func (mmRun *mImportJobServiceMockRun) Times(n uint64) *mImportJobServiceMockRun {
+	if n == 0 {
+		mmRun.mock.t.Fatalf("Times of ImportJobServiceMock.Run mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRun.expectedInvocations, n)
+	mmRun.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRun
+}
+
+func (mmRun *mImportJobServiceMockRun) invocationsDone() bool {
+	if len(mmRun.expectations) == 0 && mmRun.defaultExpectation == nil && mmRun.mock.funcRun == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRun.mock.afterRunCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRun.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Run implements mm_services.ImportJobService
+func (mmRun *ImportJobServiceMock) Run(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmRun.beforeRunCounter, 1)
+	defer mm_atomic.AddUint64(&mmRun.afterRunCounter, 1)
+
+	mmRun.t.Helper()
+
+	if mmRun.inspectFuncRun != nil {
+		mmRun.inspectFuncRun(ctx)
+	}
+
+	mm_params := ImportJobServiceMockRunParams{ctx}
+
+	// Record call args
+	mmRun.RunMock.mutex.Lock()
+	mmRun.RunMock.callArgs = append(mmRun.RunMock.callArgs, &mm_params)
+	mmRun.RunMock.mutex.Unlock()
+
+	for _, e := range mmRun.RunMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRun.RunMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRun.RunMock.defaultExpectation.Counter, 1)
+		mm_want := mmRun.RunMock.defaultExpectation.params
+		mm_want_ptrs := mmRun.RunMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobServiceMockRunParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRun.t.Errorf("ImportJobServiceMock.Run got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRun.RunMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRun.t.Errorf("ImportJobServiceMock.Run got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRun.RunMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRun.RunMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRun.t.Fatal("No results are set for the ImportJobServiceMock.Run")
+		}
+		return (*mm_results).err
+	}
+	if mmRun.funcRun != nil {
+		return mmRun.funcRun(ctx)
+	}
+	mmRun.t.Fatalf("Unexpected call to ImportJobServiceMock.Run. %v", ctx)
+	return
+}
+
+// RunAfterCounter returns a count of finished ImportJobServiceMock.Run invocations
+func (mmRun *ImportJobServiceMock) RunAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRun.afterRunCounter)
+}
+
+// RunBeforeCounter returns a count of ImportJobServiceMock.Run invocations
+func (mmRun *ImportJobServiceMock) RunBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRun.beforeRunCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobServiceMock.Run.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRun *mImportJobServiceMockRun) Calls() []*ImportJobServiceMockRunParams {
+	mmRun.mutex.RLock()
+
+	argCopy := make([]*ImportJobServiceMockRunParams, len(mmRun.callArgs))
+	copy(argCopy, mmRun.callArgs)
+
+	mmRun.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRunDone returns true if the count of the Run invocations corresponds
+// the number of defined expectations
+func (m *ImportJobServiceMock) MinimockRunDone() bool {
+	if m.RunMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RunMock.invocationsDone()
+}
+
+// MinimockRunInspect logs each unmet expectation
+func (m *ImportJobServiceMock) MinimockRunInspect() {
+	for _, e := range m.RunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Run at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRunCounter := mm_atomic.LoadUint64(&m.afterRunCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RunMock.defaultExpectation != nil && afterRunCounter < 1 {
+		if m.RunMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Run at\n%s", m.RunMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Run at\n%s with params: %#v", m.RunMock.defaultExpectation.expectationOrigins.origin, *m.RunMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRun != nil && afterRunCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobServiceMock.Run at\n%s", m.funcRunOrigin)
+	}
+
+	if !m.RunMock.invocationsDone() && afterRunCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobServiceMock.Run at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RunMock.expectedInvocations), m.RunMock.expectedInvocationsOrigin, afterRunCounter)
+	}
+}
+
+type mImportJobServiceMockSubmit struct {
+	optional           bool
+	mock               *ImportJobServiceMock
+	defaultExpectation *ImportJobServiceMockSubmitExpectation
+	expectations       []*ImportJobServiceMockSubmitExpectation
+
+	callArgs []*ImportJobServiceMockSubmitParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobServiceMockSubmitExpectation specifies expectation struct of the ImportJobService.Submit
+type ImportJobServiceMockSubmitExpectation struct {
+	mock               *ImportJobServiceMock
+	params             *ImportJobServiceMockSubmitParams
+	paramPtrs          *ImportJobServiceMockSubmitParamPtrs
+	expectationOrigins ImportJobServiceMockSubmitExpectationOrigins
+	results            *ImportJobServiceMockSubmitResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobServiceMockSubmitParams contains parameters of the ImportJobService.Submit
+type ImportJobServiceMockSubmitParams struct {
+	ctx context.Context
+	req requests.ImportOrdersRequest
+}
+
+// ImportJobServiceMockSubmitParamPtrs contains pointers to parameters of the ImportJobService.Submit
+type ImportJobServiceMockSubmitParamPtrs struct {
+	ctx *context.Context
+	req *requests.ImportOrdersRequest
+}
+
+// ImportJobServiceMockSubmitResults contains results of the ImportJobService.Submit
+type ImportJobServiceMockSubmitResults struct {
+	i1  models.ImportJob
+	err error
+}
+
+// ImportJobServiceMockSubmitOrigins contains origins of expectations of the ImportJobService.Submit
+type ImportJobServiceMockSubmitExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSubmit *mImportJobServiceMockSubmit) Optional() *mImportJobServiceMockSubmit {
+	mmSubmit.optional = true
+	return mmSubmit
+}
+
+// Expect sets up expected params for ImportJobService.Submit
+func (mmSubmit *mImportJobServiceMockSubmit) Expect(ctx context.Context, req requests.ImportOrdersRequest) *mImportJobServiceMockSubmit {
+	if mmSubmit.mock.funcSubmit != nil {
+		mmSubmit.mock.t.Fatalf("ImportJobServiceMock.Submit mock is already set by Set")
+	}
+
+	if mmSubmit.defaultExpectation == nil {
+		mmSubmit.defaultExpectation = &ImportJobServiceMockSubmitExpectation{}
+	}
+
+	if mmSubmit.defaultExpectation.paramPtrs != nil {
+		mmSubmit.mock.t.Fatalf("ImportJobServiceMock.Submit mock is already set by ExpectParams functions")
+	}
+
+	mmSubmit.defaultExpectation.params = &ImportJobServiceMockSubmitParams{ctx, req}
+	mmSubmit.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSubmit.expectations {
+		if minimock.Equal(e.params, mmSubmit.defaultExpectation.params) {
+			mmSubmit.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSubmit.defaultExpectation.params)
+		}
+	}
+
+	return mmSubmit
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobService.Submit
+func (mmSubmit *mImportJobServiceMockSubmit) ExpectCtxParam1(ctx context.Context) *mImportJobServiceMockSubmit {
+	if mmSubmit.mock.funcSubmit != nil {
+		mmSubmit.mock.t.Fatalf("ImportJobServiceMock.Submit mock is already set by Set")
+	}
+
+	if mmSubmit.defaultExpectation == nil {
+		mmSubmit.defaultExpectation = &ImportJobServiceMockSubmitExpectation{}
+	}
+
+	if mmSubmit.defaultExpectation.params != nil {
+		mmSubmit.mock.t.Fatalf("ImportJobServiceMock.Submit mock is already set by Expect")
+	}
+
+	if mmSubmit.defaultExpectation.paramPtrs == nil {
+		mmSubmit.defaultExpectation.paramPtrs = &ImportJobServiceMockSubmitParamPtrs{}
+	}
+	mmSubmit.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSubmit.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSubmit
+}
+
+// ExpectReqParam2 sets up expected param req for ImportJobService.Submit
+func (mmSubmit *mImportJobServiceMockSubmit) ExpectReqParam2(req requests.ImportOrdersRequest) *mImportJobServiceMockSubmit {
+	if mmSubmit.mock.funcSubmit != nil {
+		mmSubmit.mock.t.Fatalf("ImportJobServiceMock.Submit mock is already set by Set")
+	}
+
+	if mmSubmit.defaultExpectation == nil {
+		mmSubmit.defaultExpectation = &ImportJobServiceMockSubmitExpectation{}
+	}
+
+	if mmSubmit.defaultExpectation.params != nil {
+		mmSubmit.mock.t.Fatalf("ImportJobServiceMock.Submit mock is already set by Expect")
+	}
+
+	if mmSubmit.defaultExpectation.paramPtrs == nil {
+		mmSubmit.defaultExpectation.paramPtrs = &ImportJobServiceMockSubmitParamPtrs{}
+	}
+	mmSubmit.defaultExpectation.paramPtrs.req = &req
+	mmSubmit.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmSubmit
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobService.Submit
+func (mmSubmit *mImportJobServiceMockSubmit) Inspect(f func(ctx context.Context, req requests.ImportOrdersRequest)) *mImportJobServiceMockSubmit {
+	if mmSubmit.mock.inspectFuncSubmit != nil {
+		mmSubmit.mock.t.Fatalf("Inspect function is already set for ImportJobServiceMock.Submit")
+	}
+
+	mmSubmit.mock.inspectFuncSubmit = f
+
+	return mmSubmit
+}
+
+// Return sets up results that will be returned by ImportJobService.Submit
+func (mmSubmit *mImportJobServiceMockSubmit) Return(i1 models.ImportJob, err error) *ImportJobServiceMock {
+	if mmSubmit.mock.funcSubmit != nil {
+		mmSubmit.mock.t.Fatalf("ImportJobServiceMock.Submit mock is already set by Set")
+	}
+
+	if mmSubmit.defaultExpectation == nil {
+		mmSubmit.defaultExpectation = &ImportJobServiceMockSubmitExpectation{mock: mmSubmit.mock}
+	}
+	mmSubmit.defaultExpectation.results = &ImportJobServiceMockSubmitResults{i1, err}
+	mmSubmit.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSubmit.mock
+}
+
+// Set uses given function f to mock the ImportJobService.Submit method
+func (mmSubmit *mImportJobServiceMockSubmit) Set(f func(ctx context.Context, req requests.ImportOrdersRequest) (i1 models.ImportJob, err error)) *ImportJobServiceMock {
+	if mmSubmit.defaultExpectation != nil {
+		mmSubmit.mock.t.Fatalf("Default expectation is already set for the ImportJobService.Submit method")
+	}
+
+	if len(mmSubmit.expectations) > 0 {
+		mmSubmit.mock.t.Fatalf("Some expectations are already set for the ImportJobService.Submit method")
+	}
+
+	mmSubmit.mock.funcSubmit = f
+	mmSubmit.mock.funcSubmitOrigin = minimock.CallerInfo(1)
+	return mmSubmit.mock
+}
+
+// When sets expectation for the ImportJobService.Submit which will trigger the result defined by the following
+// Then helper
+func (mmSubmit *mImportJobServiceMockSubmit) When(ctx context.Context, req requests.ImportOrdersRequest) *ImportJobServiceMockSubmitExpectation {
+	if mmSubmit.mock.funcSubmit != nil {
+		mmSubmit.mock.t.Fatalf("ImportJobServiceMock.Submit mock is already set by Set")
+	}
+
+	expectation := &ImportJobServiceMockSubmitExpectation{
+		mock:               mmSubmit.mock,
+		params:             &ImportJobServiceMockSubmitParams{ctx, req},
+		expectationOrigins: ImportJobServiceMockSubmitExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSubmit.expectations = append(mmSubmit.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobService.Submit return parameters for the expectation previously defined by the When method
+func (e *ImportJobServiceMockSubmitExpectation) Then(i1 models.ImportJob, err error) *ImportJobServiceMock {
+	e.results = &ImportJobServiceMockSubmitResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobService.Submit should be invoked
+func (mmSubmit *mImportJobServiceMockSubmit) Times(n uint64) *mImportJobServiceMockSubmit {
+	if n == 0 {
+		mmSubmit.mock.t.Fatalf("Times of ImportJobServiceMock.Submit mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSubmit.expectedInvocations, n)
+	mmSubmit.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSubmit
+}
+
+func (mmSubmit *mImportJobServiceMockSubmit) invocationsDone() bool {
+	if len(mmSubmit.expectations) == 0 && mmSubmit.defaultExpectation == nil && mmSubmit.mock.funcSubmit == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSubmit.mock.afterSubmitCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSubmit.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Submit implements mm_services.ImportJobService
+func (mmSubmit *ImportJobServiceMock) Submit(ctx context.Context, req requests.ImportOrdersRequest) (i1 models.ImportJob, err error) {
+	mm_atomic.AddUint64(&mmSubmit.beforeSubmitCounter, 1)
+	defer mm_atomic.AddUint64(&mmSubmit.afterSubmitCounter, 1)
+
+	mmSubmit.t.Helper()
+
+	if mmSubmit.inspectFuncSubmit != nil {
+		mmSubmit.inspectFuncSubmit(ctx, req)
+	}
+
+	mm_params := ImportJobServiceMockSubmitParams{ctx, req}
+
+	// Record call args
+	mmSubmit.SubmitMock.mutex.Lock()
+	mmSubmit.SubmitMock.callArgs = append(mmSubmit.SubmitMock.callArgs, &mm_params)
+	mmSubmit.SubmitMock.mutex.Unlock()
+
+	for _, e := range mmSubmit.SubmitMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmSubmit.SubmitMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSubmit.SubmitMock.defaultExpectation.Counter, 1)
+		mm_want := mmSubmit.SubmitMock.defaultExpectation.params
+		mm_want_ptrs := mmSubmit.SubmitMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobServiceMockSubmitParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSubmit.t.Errorf("ImportJobServiceMock.Submit got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSubmit.SubmitMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmSubmit.t.Errorf("ImportJobServiceMock.Submit got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSubmit.SubmitMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSubmit.t.Errorf("ImportJobServiceMock.Submit got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSubmit.SubmitMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSubmit.SubmitMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSubmit.t.Fatal("No results are set for the ImportJobServiceMock.Submit")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmSubmit.funcSubmit != nil {
+		return mmSubmit.funcSubmit(ctx, req)
+	}
+	mmSubmit.t.Fatalf("Unexpected call to ImportJobServiceMock.Submit. %v %v", ctx, req)
+	return
+}
+
+// SubmitAfterCounter returns a count of finished ImportJobServiceMock.Submit invocations
+func (mmSubmit *ImportJobServiceMock) SubmitAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubmit.afterSubmitCounter)
+}
+
+// SubmitBeforeCounter returns a count of ImportJobServiceMock.Submit invocations
+func (mmSubmit *ImportJobServiceMock) SubmitBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubmit.beforeSubmitCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobServiceMock.Submit.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSubmit *mImportJobServiceMockSubmit) Calls() []*ImportJobServiceMockSubmitParams {
+	mmSubmit.mutex.RLock()
+
+	argCopy := make([]*ImportJobServiceMockSubmitParams, len(mmSubmit.callArgs))
+	copy(argCopy, mmSubmit.callArgs)
+
+	mmSubmit.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSubmitDone returns true if the count of the Submit invocations corresponds
+// the number of defined expectations
+func (m *ImportJobServiceMock) MinimockSubmitDone() bool {
+	if m.SubmitMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SubmitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SubmitMock.invocationsDone()
+}
+
+// MinimockSubmitInspect logs each unmet expectation
+func (m *ImportJobServiceMock) MinimockSubmitInspect() {
+	for _, e := range m.SubmitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Submit at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSubmitCounter := mm_atomic.LoadUint64(&m.afterSubmitCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SubmitMock.defaultExpectation != nil && afterSubmitCounter < 1 {
+		if m.SubmitMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Submit at\n%s", m.SubmitMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Submit at\n%s with params: %#v", m.SubmitMock.defaultExpectation.expectationOrigins.origin, *m.SubmitMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSubmit != nil && afterSubmitCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobServiceMock.Submit at\n%s", m.funcSubmitOrigin)
+	}
+
+	if !m.SubmitMock.invocationsDone() && afterSubmitCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobServiceMock.Submit at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SubmitMock.expectedInvocations), m.SubmitMock.expectedInvocationsOrigin, afterSubmitCounter)
+	}
+}
+
+type mImportJobServiceMockWatch struct {
+	optional           bool
+	mock               *ImportJobServiceMock
+	defaultExpectation *ImportJobServiceMockWatchExpectation
+	expectations       []*ImportJobServiceMockWatchExpectation
+
+	callArgs []*ImportJobServiceMockWatchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ImportJobServiceMockWatchExpectation specifies expectation struct of the ImportJobService.Watch
+type ImportJobServiceMockWatchExpectation struct {
+	mock               *ImportJobServiceMock
+	params             *ImportJobServiceMockWatchParams
+	paramPtrs          *ImportJobServiceMockWatchParamPtrs
+	expectationOrigins ImportJobServiceMockWatchExpectationOrigins
+	results            *ImportJobServiceMockWatchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ImportJobServiceMockWatchParams contains parameters of the ImportJobService.Watch
+type ImportJobServiceMockWatchParams struct {
+	ctx   context.Context
+	jobID uint64
+	fn    func(models.ImportJob) error
+}
+
+// ImportJobServiceMockWatchParamPtrs contains pointers to parameters of the ImportJobService.Watch
+type ImportJobServiceMockWatchParamPtrs struct {
+	ctx   *context.Context
+	jobID *uint64
+	fn    *func(models.ImportJob) error
+}
+
+// ImportJobServiceMockWatchResults contains results of the ImportJobService.Watch
+type ImportJobServiceMockWatchResults struct {
+	err error
+}
+
+// ImportJobServiceMockWatchOrigins contains origins of expectations of the ImportJobService.Watch
+type ImportJobServiceMockWatchExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originJobID string
+	originFn    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWatch *mImportJobServiceMockWatch) Optional() *mImportJobServiceMockWatch {
+	mmWatch.optional = true
+	return mmWatch
+}
+
+// Expect sets up expected params for ImportJobService.Watch
+func (mmWatch *mImportJobServiceMockWatch) Expect(ctx context.Context, jobID uint64, fn func(models.ImportJob) error) *mImportJobServiceMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ImportJobServiceMockWatchExpectation{}
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by ExpectParams functions")
+	}
+
+	mmWatch.defaultExpectation.params = &ImportJobServiceMockWatchParams{ctx, jobID, fn}
+	mmWatch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWatch.expectations {
+		if minimock.Equal(e.params, mmWatch.defaultExpectation.params) {
+			mmWatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWatch.defaultExpectation.params)
+		}
+	}
+
+	return mmWatch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ImportJobService.Watch
+func (mmWatch *mImportJobServiceMockWatch) ExpectCtxParam1(ctx context.Context) *mImportJobServiceMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ImportJobServiceMockWatchExpectation{}
+	}
+
+	if mmWatch.defaultExpectation.params != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by Expect")
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs == nil {
+		mmWatch.defaultExpectation.paramPtrs = &ImportJobServiceMockWatchParamPtrs{}
+	}
+	mmWatch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWatch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWatch
+}
+
+// ExpectJobIDParam2 sets up expected param jobID for ImportJobService.Watch
+func (mmWatch *mImportJobServiceMockWatch) ExpectJobIDParam2(jobID uint64) *mImportJobServiceMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ImportJobServiceMockWatchExpectation{}
+	}
+
+	if mmWatch.defaultExpectation.params != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by Expect")
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs == nil {
+		mmWatch.defaultExpectation.paramPtrs = &ImportJobServiceMockWatchParamPtrs{}
+	}
+	mmWatch.defaultExpectation.paramPtrs.jobID = &jobID
+	mmWatch.defaultExpectation.expectationOrigins.originJobID = minimock.CallerInfo(1)
+
+	return mmWatch
+}
+
+// ExpectFnParam3 sets up expected param fn for ImportJobService.Watch
+func (mmWatch *mImportJobServiceMockWatch) ExpectFnParam3(fn func(models.ImportJob) error) *mImportJobServiceMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ImportJobServiceMockWatchExpectation{}
+	}
+
+	if mmWatch.defaultExpectation.params != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by Expect")
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs == nil {
+		mmWatch.defaultExpectation.paramPtrs = &ImportJobServiceMockWatchParamPtrs{}
+	}
+	mmWatch.defaultExpectation.paramPtrs.fn = &fn
+	mmWatch.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmWatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the ImportJobService.Watch
+func (mmWatch *mImportJobServiceMockWatch) Inspect(f func(ctx context.Context, jobID uint64, fn func(models.ImportJob) error)) *mImportJobServiceMockWatch {
+	if mmWatch.mock.inspectFuncWatch != nil {
+		mmWatch.mock.t.Fatalf("Inspect function is already set for ImportJobServiceMock.Watch")
+	}
+
+	mmWatch.mock.inspectFuncWatch = f
+
+	return mmWatch
+}
+
+// Return sets up results that will be returned by ImportJobService.Watch
+func (mmWatch *mImportJobServiceMockWatch) Return(err error) *ImportJobServiceMock {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by Set")
+	}
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &ImportJobServiceMockWatchExpectation{mock: mmWatch.mock}
+	}
+	mmWatch.defaultExpectation.results = &ImportJobServiceMockWatchResults{err}
+	mmWatch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWatch.mock
+}
+
+// Set uses given function f to mock the ImportJobService.Watch method
+func (mmWatch *mImportJobServiceMockWatch) Set(f func(ctx context.Context, jobID uint64, fn func(models.ImportJob) error) (err error)) *ImportJobServiceMock {
+	if mmWatch.defaultExpectation != nil {
+		mmWatch.mock.t.Fatalf("Default expectation is already set for the ImportJobService.Watch method")
+	}
+
+	if len(mmWatch.expectations) > 0 {
+		mmWatch.mock.t.Fatalf("Some expectations are already set for the ImportJobService.Watch method")
+	}
+
+	mmWatch.mock.funcWatch = f
+	mmWatch.mock.funcWatchOrigin = minimock.CallerInfo(1)
+	return mmWatch.mock
+}
+
+// When sets expectation for the ImportJobService.Watch which will trigger the result defined by the following
+// Then helper
+func (mmWatch *mImportJobServiceMockWatch) When(ctx context.Context, jobID uint64, fn func(models.ImportJob) error) *ImportJobServiceMockWatchExpectation {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("ImportJobServiceMock.Watch mock is already set by Set")
+	}
+
+	expectation := &ImportJobServiceMockWatchExpectation{
+		mock:               mmWatch.mock,
+		params:             &ImportJobServiceMockWatchParams{ctx, jobID, fn},
+		expectationOrigins: ImportJobServiceMockWatchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWatch.expectations = append(mmWatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ImportJobService.Watch return parameters for the expectation previously defined by the When method
+func (e *ImportJobServiceMockWatchExpectation) Then(err error) *ImportJobServiceMock {
+	e.results = &ImportJobServiceMockWatchResults{err}
+	return e.mock
+}
+
+// Times sets number of times ImportJobService.Watch should be invoked
+func (mmWatch *mImportJobServiceMockWatch) Times(n uint64) *mImportJobServiceMockWatch {
+	if n == 0 {
+		mmWatch.mock.t.Fatalf("Times of ImportJobServiceMock.Watch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWatch.expectedInvocations, n)
+	mmWatch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWatch
+}
+
+func (mmWatch *mImportJobServiceMockWatch) invocationsDone() bool {
+	if len(mmWatch.expectations) == 0 && mmWatch.defaultExpectation == nil && mmWatch.mock.funcWatch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWatch.mock.afterWatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWatch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Watch implements mm_services.ImportJobService
+func (mmWatch *ImportJobServiceMock) Watch(ctx context.Context, jobID uint64, fn func(models.ImportJob) error) (err error) {
+	mm_atomic.AddUint64(&mmWatch.beforeWatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmWatch.afterWatchCounter, 1)
+
+	mmWatch.t.Helper()
+
+	if mmWatch.inspectFuncWatch != nil {
+		mmWatch.inspectFuncWatch(ctx, jobID, fn)
+	}
+
+	mm_params := ImportJobServiceMockWatchParams{ctx, jobID, fn}
+
+	// Record call args
+	mmWatch.WatchMock.mutex.Lock()
+	mmWatch.WatchMock.callArgs = append(mmWatch.WatchMock.callArgs, &mm_params)
+	mmWatch.WatchMock.mutex.Unlock()
+
+	for _, e := range mmWatch.WatchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmWatch.WatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWatch.WatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmWatch.WatchMock.defaultExpectation.params
+		mm_want_ptrs := mmWatch.WatchMock.defaultExpectation.paramPtrs
+
+		mm_got := ImportJobServiceMockWatchParams{ctx, jobID, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWatch.t.Errorf("ImportJobServiceMock.Watch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatch.WatchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.jobID != nil && !minimock.Equal(*mm_want_ptrs.jobID, mm_got.jobID) {
+				mmWatch.t.Errorf("ImportJobServiceMock.Watch got unexpected parameter jobID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatch.WatchMock.defaultExpectation.expectationOrigins.originJobID, *mm_want_ptrs.jobID, mm_got.jobID, minimock.Diff(*mm_want_ptrs.jobID, mm_got.jobID))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmWatch.t.Errorf("ImportJobServiceMock.Watch got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatch.WatchMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWatch.t.Errorf("ImportJobServiceMock.Watch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWatch.WatchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWatch.WatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWatch.t.Fatal("No results are set for the ImportJobServiceMock.Watch")
+		}
+		return (*mm_results).err
+	}
+	if mmWatch.funcWatch != nil {
+		return mmWatch.funcWatch(ctx, jobID, fn)
+	}
+	mmWatch.t.Fatalf("Unexpected call to ImportJobServiceMock.Watch. %v %v %v", ctx, jobID, fn)
+	return
+}
+
+// WatchAfterCounter returns a count of finished ImportJobServiceMock.Watch invocations
+func (mmWatch *ImportJobServiceMock) WatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatch.afterWatchCounter)
+}
+
+// WatchBeforeCounter returns a count of ImportJobServiceMock.Watch invocations
+func (mmWatch *ImportJobServiceMock) WatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatch.beforeWatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to ImportJobServiceMock.Watch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWatch *mImportJobServiceMockWatch) Calls() []*ImportJobServiceMockWatchParams {
+	mmWatch.mutex.RLock()
+
+	argCopy := make([]*ImportJobServiceMockWatchParams, len(mmWatch.callArgs))
+	copy(argCopy, mmWatch.callArgs)
+
+	mmWatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWatchDone returns true if the count of the Watch invocations corresponds
+// the number of defined expectations
+func (m *ImportJobServiceMock) MinimockWatchDone() bool {
+	if m.WatchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WatchMock.invocationsDone()
+}
+
+// MinimockWatchInspect logs each unmet expectation
+func (m *ImportJobServiceMock) MinimockWatchInspect() {
+	for _, e := range m.WatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Watch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWatchCounter := mm_atomic.LoadUint64(&m.afterWatchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WatchMock.defaultExpectation != nil && afterWatchCounter < 1 {
+		if m.WatchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Watch at\n%s", m.WatchMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ImportJobServiceMock.Watch at\n%s with params: %#v", m.WatchMock.defaultExpectation.expectationOrigins.origin, *m.WatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWatch != nil && afterWatchCounter < 1 {
+		m.t.Errorf("Expected call to ImportJobServiceMock.Watch at\n%s", m.funcWatchOrigin)
+	}
+
+	if !m.WatchMock.invocationsDone() && afterWatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to ImportJobServiceMock.Watch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WatchMock.expectedInvocations), m.WatchMock.expectedInvocationsOrigin, afterWatchCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ImportJobServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCancelInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListFailuresInspect()
+
+			m.MinimockRunInspect()
+
+			m.MinimockSubmitInspect()
+
+			m.MinimockWatchInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ImportJobServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ImportJobServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCancelDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListFailuresDone() &&
+		m.MinimockRunDone() &&
+		m.MinimockSubmitDone() &&
+		m.MinimockWatchDone()
+}
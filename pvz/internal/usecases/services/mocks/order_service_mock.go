@@ -32,6 +32,13 @@ type OrderServiceMock struct {
 	beforeCreateClientReturnsCounter uint64
 	CreateClientReturnsMock          mOrderServiceMockCreateClientReturns
 
+	funcGetOrder          func(ctx context.Context, orderID uint64) (o1 models.OrderDetails, err error)
+	funcGetOrderOrigin    string
+	inspectFuncGetOrder   func(ctx context.Context, orderID uint64)
+	afterGetOrderCounter  uint64
+	beforeGetOrderCounter uint64
+	GetOrderMock          mOrderServiceMockGetOrder
+
 	funcImportOrders          func(ctx context.Context, req requests.ImportOrdersRequest) (ba1 []models.BatchEntryProcessedResult, err error)
 	funcImportOrdersOrigin    string
 	inspectFuncImportOrders   func(ctx context.Context, req requests.ImportOrdersRequest)
@@ -39,6 +46,13 @@ type OrderServiceMock struct {
 	beforeImportOrdersCounter uint64
 	ImportOrdersMock          mOrderServiceMockImportOrders
 
+	funcIssueAllReady          func(ctx context.Context, req requests.IssueAllReadyRequest) (i1 models.IssueReceipt, err error)
+	funcIssueAllReadyOrigin    string
+	inspectFuncIssueAllReady   func(ctx context.Context, req requests.IssueAllReadyRequest)
+	afterIssueAllReadyCounter  uint64
+	beforeIssueAllReadyCounter uint64
+	IssueAllReadyMock          mOrderServiceMockIssueAllReady
+
 	funcIssueOrders          func(ctx context.Context, req requests.IssueOrdersRequest) (ba1 []models.BatchEntryProcessedResult, err error)
 	funcIssueOrdersOrigin    string
 	inspectFuncIssueOrders   func(ctx context.Context, req requests.IssueOrdersRequest)
@@ -66,6 +80,13 @@ type OrderServiceMock struct {
 	afterReturnToCourierCounter  uint64
 	beforeReturnToCourierCounter uint64
 	ReturnToCourierMock          mOrderServiceMockReturnToCourier
+
+	funcUndoLastOperation          func(ctx context.Context, req requests.UndoRequest) (u1 models.UndoResult, err error)
+	funcUndoLastOperationOrigin    string
+	inspectFuncUndoLastOperation   func(ctx context.Context, req requests.UndoRequest)
+	afterUndoLastOperationCounter  uint64
+	beforeUndoLastOperationCounter uint64
+	UndoLastOperationMock          mOrderServiceMockUndoLastOperation
 }
 
 // NewOrderServiceMock returns a mock for mm_services.OrderService
@@ -82,9 +103,15 @@ func NewOrderServiceMock(t minimock.Tester) *OrderServiceMock {
 	m.CreateClientReturnsMock = mOrderServiceMockCreateClientReturns{mock: m}
 	m.CreateClientReturnsMock.callArgs = []*OrderServiceMockCreateClientReturnsParams{}
 
+	m.GetOrderMock = mOrderServiceMockGetOrder{mock: m}
+	m.GetOrderMock.callArgs = []*OrderServiceMockGetOrderParams{}
+
 	m.ImportOrdersMock = mOrderServiceMockImportOrders{mock: m}
 	m.ImportOrdersMock.callArgs = []*OrderServiceMockImportOrdersParams{}
 
+	m.IssueAllReadyMock = mOrderServiceMockIssueAllReady{mock: m}
+	m.IssueAllReadyMock.callArgs = []*OrderServiceMockIssueAllReadyParams{}
+
 	m.IssueOrdersMock = mOrderServiceMockIssueOrders{mock: m}
 	m.IssueOrdersMock.callArgs = []*OrderServiceMockIssueOrdersParams{}
 
@@ -97,6 +124,9 @@ func NewOrderServiceMock(t minimock.Tester) *OrderServiceMock {
 	m.ReturnToCourierMock = mOrderServiceMockReturnToCourier{mock: m}
 	m.ReturnToCourierMock.callArgs = []*OrderServiceMockReturnToCourierParams{}
 
+	m.UndoLastOperationMock = mOrderServiceMockUndoLastOperation{mock: m}
+	m.UndoLastOperationMock.callArgs = []*OrderServiceMockUndoLastOperationParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -788,6 +818,349 @@ func (m *OrderServiceMock) MinimockCreateClientReturnsInspect() {
 	}
 }
 
+type mOrderServiceMockGetOrder struct {
+	optional           bool
+	mock               *OrderServiceMock
+	defaultExpectation *OrderServiceMockGetOrderExpectation
+	expectations       []*OrderServiceMockGetOrderExpectation
+
+	callArgs []*OrderServiceMockGetOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderServiceMockGetOrderExpectation specifies expectation struct of the OrderService.GetOrder
+type OrderServiceMockGetOrderExpectation struct {
+	mock               *OrderServiceMock
+	params             *OrderServiceMockGetOrderParams
+	paramPtrs          *OrderServiceMockGetOrderParamPtrs
+	expectationOrigins OrderServiceMockGetOrderExpectationOrigins
+	results            *OrderServiceMockGetOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderServiceMockGetOrderParams contains parameters of the OrderService.GetOrder
+type OrderServiceMockGetOrderParams struct {
+	ctx     context.Context
+	orderID uint64
+}
+
+// OrderServiceMockGetOrderParamPtrs contains pointers to parameters of the OrderService.GetOrder
+type OrderServiceMockGetOrderParamPtrs struct {
+	ctx     *context.Context
+	orderID *uint64
+}
+
+// OrderServiceMockGetOrderResults contains results of the OrderService.GetOrder
+type OrderServiceMockGetOrderResults struct {
+	o1  models.OrderDetails
+	err error
+}
+
+// OrderServiceMockGetOrderOrigins contains origins of expectations of the OrderService.GetOrder
+type OrderServiceMockGetOrderExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrder *mOrderServiceMockGetOrder) Optional() *mOrderServiceMockGetOrder {
+	mmGetOrder.optional = true
+	return mmGetOrder
+}
+
+// Expect sets up expected params for OrderService.GetOrder
+func (mmGetOrder *mOrderServiceMockGetOrder) Expect(ctx context.Context, orderID uint64) *mOrderServiceMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("OrderServiceMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &OrderServiceMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs != nil {
+		mmGetOrder.mock.t.Fatalf("OrderServiceMock.GetOrder mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrder.defaultExpectation.params = &OrderServiceMockGetOrderParams{ctx, orderID}
+	mmGetOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrder.expectations {
+		if minimock.Equal(e.params, mmGetOrder.defaultExpectation.params) {
+			mmGetOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderService.GetOrder
+func (mmGetOrder *mOrderServiceMockGetOrder) ExpectCtxParam1(ctx context.Context) *mOrderServiceMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("OrderServiceMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &OrderServiceMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.params != nil {
+		mmGetOrder.mock.t.Fatalf("OrderServiceMock.GetOrder mock is already set by Expect")
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs == nil {
+		mmGetOrder.defaultExpectation.paramPtrs = &OrderServiceMockGetOrderParamPtrs{}
+	}
+	mmGetOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrder
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for OrderService.GetOrder
+func (mmGetOrder *mOrderServiceMockGetOrder) ExpectOrderIDParam2(orderID uint64) *mOrderServiceMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("OrderServiceMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &OrderServiceMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.params != nil {
+		mmGetOrder.mock.t.Fatalf("OrderServiceMock.GetOrder mock is already set by Expect")
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs == nil {
+		mmGetOrder.defaultExpectation.paramPtrs = &OrderServiceMockGetOrderParamPtrs{}
+	}
+	mmGetOrder.defaultExpectation.paramPtrs.orderID = &orderID
+	mmGetOrder.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmGetOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderService.GetOrder
+func (mmGetOrder *mOrderServiceMockGetOrder) Inspect(f func(ctx context.Context, orderID uint64)) *mOrderServiceMockGetOrder {
+	if mmGetOrder.mock.inspectFuncGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("Inspect function is already set for OrderServiceMock.GetOrder")
+	}
+
+	mmGetOrder.mock.inspectFuncGetOrder = f
+
+	return mmGetOrder
+}
+
+// Return sets up results that will be returned by OrderService.GetOrder
+func (mmGetOrder *mOrderServiceMockGetOrder) Return(o1 models.OrderDetails, err error) *OrderServiceMock {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("OrderServiceMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &OrderServiceMockGetOrderExpectation{mock: mmGetOrder.mock}
+	}
+	mmGetOrder.defaultExpectation.results = &OrderServiceMockGetOrderResults{o1, err}
+	mmGetOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrder.mock
+}
+
+// Set uses given function f to mock the OrderService.GetOrder method
+func (mmGetOrder *mOrderServiceMockGetOrder) Set(f func(ctx context.Context, orderID uint64) (o1 models.OrderDetails, err error)) *OrderServiceMock {
+	if mmGetOrder.defaultExpectation != nil {
+		mmGetOrder.mock.t.Fatalf("Default expectation is already set for the OrderService.GetOrder method")
+	}
+
+	if len(mmGetOrder.expectations) > 0 {
+		mmGetOrder.mock.t.Fatalf("Some expectations are already set for the OrderService.GetOrder method")
+	}
+
+	mmGetOrder.mock.funcGetOrder = f
+	mmGetOrder.mock.funcGetOrderOrigin = minimock.CallerInfo(1)
+	return mmGetOrder.mock
+}
+
+// When sets expectation for the OrderService.GetOrder which will trigger the result defined by the following
+// Then helper
+func (mmGetOrder *mOrderServiceMockGetOrder) When(ctx context.Context, orderID uint64) *OrderServiceMockGetOrderExpectation {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("OrderServiceMock.GetOrder mock is already set by Set")
+	}
+
+	expectation := &OrderServiceMockGetOrderExpectation{
+		mock:               mmGetOrder.mock,
+		params:             &OrderServiceMockGetOrderParams{ctx, orderID},
+		expectationOrigins: OrderServiceMockGetOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrder.expectations = append(mmGetOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderService.GetOrder return parameters for the expectation previously defined by the When method
+func (e *OrderServiceMockGetOrderExpectation) Then(o1 models.OrderDetails, err error) *OrderServiceMock {
+	e.results = &OrderServiceMockGetOrderResults{o1, err}
+	return e.mock
+}
+
+// Times sets number of times OrderService.GetOrder should be invoked
+func (mmGetOrder *mOrderServiceMockGetOrder) Times(n uint64) *mOrderServiceMockGetOrder {
+	if n == 0 {
+		mmGetOrder.mock.t.Fatalf("Times of OrderServiceMock.GetOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrder.expectedInvocations, n)
+	mmGetOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrder
+}
+
+func (mmGetOrder *mOrderServiceMockGetOrder) invocationsDone() bool {
+	if len(mmGetOrder.expectations) == 0 && mmGetOrder.defaultExpectation == nil && mmGetOrder.mock.funcGetOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrder.mock.afterGetOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrder implements mm_services.OrderService
+func (mmGetOrder *OrderServiceMock) GetOrder(ctx context.Context, orderID uint64) (o1 models.OrderDetails, err error) {
+	mm_atomic.AddUint64(&mmGetOrder.beforeGetOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrder.afterGetOrderCounter, 1)
+
+	mmGetOrder.t.Helper()
+
+	if mmGetOrder.inspectFuncGetOrder != nil {
+		mmGetOrder.inspectFuncGetOrder(ctx, orderID)
+	}
+
+	mm_params := OrderServiceMockGetOrderParams{ctx, orderID}
+
+	// Record call args
+	mmGetOrder.GetOrderMock.mutex.Lock()
+	mmGetOrder.GetOrderMock.callArgs = append(mmGetOrder.GetOrderMock.callArgs, &mm_params)
+	mmGetOrder.GetOrderMock.mutex.Unlock()
+
+	for _, e := range mmGetOrder.GetOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.o1, e.results.err
+		}
+	}
+
+	if mmGetOrder.GetOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrder.GetOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrder.GetOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrder.GetOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderServiceMockGetOrderParams{ctx, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrder.t.Errorf("OrderServiceMock.GetOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmGetOrder.t.Errorf("OrderServiceMock.GetOrder got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrder.t.Errorf("OrderServiceMock.GetOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrder.GetOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrder.t.Fatal("No results are set for the OrderServiceMock.GetOrder")
+		}
+		return (*mm_results).o1, (*mm_results).err
+	}
+	if mmGetOrder.funcGetOrder != nil {
+		return mmGetOrder.funcGetOrder(ctx, orderID)
+	}
+	mmGetOrder.t.Fatalf("Unexpected call to OrderServiceMock.GetOrder. %v %v", ctx, orderID)
+	return
+}
+
+// GetOrderAfterCounter returns a count of finished OrderServiceMock.GetOrder invocations
+func (mmGetOrder *OrderServiceMock) GetOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrder.afterGetOrderCounter)
+}
+
+// GetOrderBeforeCounter returns a count of OrderServiceMock.GetOrder invocations
+func (mmGetOrder *OrderServiceMock) GetOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrder.beforeGetOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderServiceMock.GetOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrder *mOrderServiceMockGetOrder) Calls() []*OrderServiceMockGetOrderParams {
+	mmGetOrder.mutex.RLock()
+
+	argCopy := make([]*OrderServiceMockGetOrderParams, len(mmGetOrder.callArgs))
+	copy(argCopy, mmGetOrder.callArgs)
+
+	mmGetOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderDone returns true if the count of the GetOrder invocations corresponds
+// the number of defined expectations
+func (m *OrderServiceMock) MinimockGetOrderDone() bool {
+	if m.GetOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrderMock.invocationsDone()
+}
+
+// MinimockGetOrderInspect logs each unmet expectation
+func (m *OrderServiceMock) MinimockGetOrderInspect() {
+	for _, e := range m.GetOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderServiceMock.GetOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrderCounter := mm_atomic.LoadUint64(&m.afterGetOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderMock.defaultExpectation != nil && afterGetOrderCounter < 1 {
+		if m.GetOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderServiceMock.GetOrder at\n%s", m.GetOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderServiceMock.GetOrder at\n%s with params: %#v", m.GetOrderMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrder != nil && afterGetOrderCounter < 1 {
+		m.t.Errorf("Expected call to OrderServiceMock.GetOrder at\n%s", m.funcGetOrderOrigin)
+	}
+
+	if !m.GetOrderMock.invocationsDone() && afterGetOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderServiceMock.GetOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrderMock.expectedInvocations), m.GetOrderMock.expectedInvocationsOrigin, afterGetOrderCounter)
+	}
+}
+
 type mOrderServiceMockImportOrders struct {
 	optional           bool
 	mock               *OrderServiceMock
@@ -965,169 +1338,512 @@ func (mmImportOrders *mOrderServiceMockImportOrders) When(ctx context.Context, r
 		mmImportOrders.mock.t.Fatalf("OrderServiceMock.ImportOrders mock is already set by Set")
 	}
 
-	expectation := &OrderServiceMockImportOrdersExpectation{
-		mock:               mmImportOrders.mock,
-		params:             &OrderServiceMockImportOrdersParams{ctx, req},
-		expectationOrigins: OrderServiceMockImportOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrderServiceMockImportOrdersExpectation{
+		mock:               mmImportOrders.mock,
+		params:             &OrderServiceMockImportOrdersParams{ctx, req},
+		expectationOrigins: OrderServiceMockImportOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmImportOrders.expectations = append(mmImportOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderService.ImportOrders return parameters for the expectation previously defined by the When method
+func (e *OrderServiceMockImportOrdersExpectation) Then(ba1 []models.BatchEntryProcessedResult, err error) *OrderServiceMock {
+	e.results = &OrderServiceMockImportOrdersResults{ba1, err}
+	return e.mock
+}
+
+// Times sets number of times OrderService.ImportOrders should be invoked
+func (mmImportOrders *mOrderServiceMockImportOrders) Times(n uint64) *mOrderServiceMockImportOrders {
+	if n == 0 {
+		mmImportOrders.mock.t.Fatalf("Times of OrderServiceMock.ImportOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmImportOrders.expectedInvocations, n)
+	mmImportOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmImportOrders
+}
+
+func (mmImportOrders *mOrderServiceMockImportOrders) invocationsDone() bool {
+	if len(mmImportOrders.expectations) == 0 && mmImportOrders.defaultExpectation == nil && mmImportOrders.mock.funcImportOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmImportOrders.mock.afterImportOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmImportOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ImportOrders implements mm_services.OrderService
+func (mmImportOrders *OrderServiceMock) ImportOrders(ctx context.Context, req requests.ImportOrdersRequest) (ba1 []models.BatchEntryProcessedResult, err error) {
+	mm_atomic.AddUint64(&mmImportOrders.beforeImportOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmImportOrders.afterImportOrdersCounter, 1)
+
+	mmImportOrders.t.Helper()
+
+	if mmImportOrders.inspectFuncImportOrders != nil {
+		mmImportOrders.inspectFuncImportOrders(ctx, req)
+	}
+
+	mm_params := OrderServiceMockImportOrdersParams{ctx, req}
+
+	// Record call args
+	mmImportOrders.ImportOrdersMock.mutex.Lock()
+	mmImportOrders.ImportOrdersMock.callArgs = append(mmImportOrders.ImportOrdersMock.callArgs, &mm_params)
+	mmImportOrders.ImportOrdersMock.mutex.Unlock()
+
+	for _, e := range mmImportOrders.ImportOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmImportOrders.ImportOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImportOrders.ImportOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmImportOrders.ImportOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmImportOrders.ImportOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderServiceMockImportOrdersParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmImportOrders.t.Errorf("OrderServiceMock.ImportOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportOrders.ImportOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmImportOrders.t.Errorf("OrderServiceMock.ImportOrders got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportOrders.ImportOrdersMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImportOrders.t.Errorf("OrderServiceMock.ImportOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmImportOrders.ImportOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImportOrders.ImportOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImportOrders.t.Fatal("No results are set for the OrderServiceMock.ImportOrders")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmImportOrders.funcImportOrders != nil {
+		return mmImportOrders.funcImportOrders(ctx, req)
+	}
+	mmImportOrders.t.Fatalf("Unexpected call to OrderServiceMock.ImportOrders. %v %v", ctx, req)
+	return
+}
+
+// ImportOrdersAfterCounter returns a count of finished OrderServiceMock.ImportOrders invocations
+func (mmImportOrders *OrderServiceMock) ImportOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportOrders.afterImportOrdersCounter)
+}
+
+// ImportOrdersBeforeCounter returns a count of OrderServiceMock.ImportOrders invocations
+func (mmImportOrders *OrderServiceMock) ImportOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportOrders.beforeImportOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderServiceMock.ImportOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImportOrders *mOrderServiceMockImportOrders) Calls() []*OrderServiceMockImportOrdersParams {
+	mmImportOrders.mutex.RLock()
+
+	argCopy := make([]*OrderServiceMockImportOrdersParams, len(mmImportOrders.callArgs))
+	copy(argCopy, mmImportOrders.callArgs)
+
+	mmImportOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImportOrdersDone returns true if the count of the ImportOrders invocations corresponds
+// the number of defined expectations
+func (m *OrderServiceMock) MinimockImportOrdersDone() bool {
+	if m.ImportOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ImportOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ImportOrdersMock.invocationsDone()
+}
+
+// MinimockImportOrdersInspect logs each unmet expectation
+func (m *OrderServiceMock) MinimockImportOrdersInspect() {
+	for _, e := range m.ImportOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderServiceMock.ImportOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterImportOrdersCounter := mm_atomic.LoadUint64(&m.afterImportOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportOrdersMock.defaultExpectation != nil && afterImportOrdersCounter < 1 {
+		if m.ImportOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderServiceMock.ImportOrders at\n%s", m.ImportOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderServiceMock.ImportOrders at\n%s with params: %#v", m.ImportOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ImportOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImportOrders != nil && afterImportOrdersCounter < 1 {
+		m.t.Errorf("Expected call to OrderServiceMock.ImportOrders at\n%s", m.funcImportOrdersOrigin)
+	}
+
+	if !m.ImportOrdersMock.invocationsDone() && afterImportOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderServiceMock.ImportOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ImportOrdersMock.expectedInvocations), m.ImportOrdersMock.expectedInvocationsOrigin, afterImportOrdersCounter)
+	}
+}
+
+type mOrderServiceMockIssueAllReady struct {
+	optional           bool
+	mock               *OrderServiceMock
+	defaultExpectation *OrderServiceMockIssueAllReadyExpectation
+	expectations       []*OrderServiceMockIssueAllReadyExpectation
+
+	callArgs []*OrderServiceMockIssueAllReadyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderServiceMockIssueAllReadyExpectation specifies expectation struct of the OrderService.IssueAllReady
+type OrderServiceMockIssueAllReadyExpectation struct {
+	mock               *OrderServiceMock
+	params             *OrderServiceMockIssueAllReadyParams
+	paramPtrs          *OrderServiceMockIssueAllReadyParamPtrs
+	expectationOrigins OrderServiceMockIssueAllReadyExpectationOrigins
+	results            *OrderServiceMockIssueAllReadyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderServiceMockIssueAllReadyParams contains parameters of the OrderService.IssueAllReady
+type OrderServiceMockIssueAllReadyParams struct {
+	ctx context.Context
+	req requests.IssueAllReadyRequest
+}
+
+// OrderServiceMockIssueAllReadyParamPtrs contains pointers to parameters of the OrderService.IssueAllReady
+type OrderServiceMockIssueAllReadyParamPtrs struct {
+	ctx *context.Context
+	req *requests.IssueAllReadyRequest
+}
+
+// OrderServiceMockIssueAllReadyResults contains results of the OrderService.IssueAllReady
+type OrderServiceMockIssueAllReadyResults struct {
+	i1  models.IssueReceipt
+	err error
+}
+
+// OrderServiceMockIssueAllReadyOrigins contains origins of expectations of the OrderService.IssueAllReady
+type OrderServiceMockIssueAllReadyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) Optional() *mOrderServiceMockIssueAllReady {
+	mmIssueAllReady.optional = true
+	return mmIssueAllReady
+}
+
+// Expect sets up expected params for OrderService.IssueAllReady
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) Expect(ctx context.Context, req requests.IssueAllReadyRequest) *mOrderServiceMockIssueAllReady {
+	if mmIssueAllReady.mock.funcIssueAllReady != nil {
+		mmIssueAllReady.mock.t.Fatalf("OrderServiceMock.IssueAllReady mock is already set by Set")
+	}
+
+	if mmIssueAllReady.defaultExpectation == nil {
+		mmIssueAllReady.defaultExpectation = &OrderServiceMockIssueAllReadyExpectation{}
+	}
+
+	if mmIssueAllReady.defaultExpectation.paramPtrs != nil {
+		mmIssueAllReady.mock.t.Fatalf("OrderServiceMock.IssueAllReady mock is already set by ExpectParams functions")
+	}
+
+	mmIssueAllReady.defaultExpectation.params = &OrderServiceMockIssueAllReadyParams{ctx, req}
+	mmIssueAllReady.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIssueAllReady.expectations {
+		if minimock.Equal(e.params, mmIssueAllReady.defaultExpectation.params) {
+			mmIssueAllReady.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIssueAllReady.defaultExpectation.params)
+		}
+	}
+
+	return mmIssueAllReady
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderService.IssueAllReady
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) ExpectCtxParam1(ctx context.Context) *mOrderServiceMockIssueAllReady {
+	if mmIssueAllReady.mock.funcIssueAllReady != nil {
+		mmIssueAllReady.mock.t.Fatalf("OrderServiceMock.IssueAllReady mock is already set by Set")
+	}
+
+	if mmIssueAllReady.defaultExpectation == nil {
+		mmIssueAllReady.defaultExpectation = &OrderServiceMockIssueAllReadyExpectation{}
+	}
+
+	if mmIssueAllReady.defaultExpectation.params != nil {
+		mmIssueAllReady.mock.t.Fatalf("OrderServiceMock.IssueAllReady mock is already set by Expect")
+	}
+
+	if mmIssueAllReady.defaultExpectation.paramPtrs == nil {
+		mmIssueAllReady.defaultExpectation.paramPtrs = &OrderServiceMockIssueAllReadyParamPtrs{}
+	}
+	mmIssueAllReady.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIssueAllReady.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIssueAllReady
+}
+
+// ExpectReqParam2 sets up expected param req for OrderService.IssueAllReady
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) ExpectReqParam2(req requests.IssueAllReadyRequest) *mOrderServiceMockIssueAllReady {
+	if mmIssueAllReady.mock.funcIssueAllReady != nil {
+		mmIssueAllReady.mock.t.Fatalf("OrderServiceMock.IssueAllReady mock is already set by Set")
+	}
+
+	if mmIssueAllReady.defaultExpectation == nil {
+		mmIssueAllReady.defaultExpectation = &OrderServiceMockIssueAllReadyExpectation{}
+	}
+
+	if mmIssueAllReady.defaultExpectation.params != nil {
+		mmIssueAllReady.mock.t.Fatalf("OrderServiceMock.IssueAllReady mock is already set by Expect")
+	}
+
+	if mmIssueAllReady.defaultExpectation.paramPtrs == nil {
+		mmIssueAllReady.defaultExpectation.paramPtrs = &OrderServiceMockIssueAllReadyParamPtrs{}
+	}
+	mmIssueAllReady.defaultExpectation.paramPtrs.req = &req
+	mmIssueAllReady.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmIssueAllReady
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderService.IssueAllReady
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) Inspect(f func(ctx context.Context, req requests.IssueAllReadyRequest)) *mOrderServiceMockIssueAllReady {
+	if mmIssueAllReady.mock.inspectFuncIssueAllReady != nil {
+		mmIssueAllReady.mock.t.Fatalf("Inspect function is already set for OrderServiceMock.IssueAllReady")
+	}
+
+	mmIssueAllReady.mock.inspectFuncIssueAllReady = f
+
+	return mmIssueAllReady
+}
+
+// Return sets up results that will be returned by OrderService.IssueAllReady
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) Return(i1 models.IssueReceipt, err error) *OrderServiceMock {
+	if mmIssueAllReady.mock.funcIssueAllReady != nil {
+		mmIssueAllReady.mock.t.Fatalf("OrderServiceMock.IssueAllReady mock is already set by Set")
+	}
+
+	if mmIssueAllReady.defaultExpectation == nil {
+		mmIssueAllReady.defaultExpectation = &OrderServiceMockIssueAllReadyExpectation{mock: mmIssueAllReady.mock}
+	}
+	mmIssueAllReady.defaultExpectation.results = &OrderServiceMockIssueAllReadyResults{i1, err}
+	mmIssueAllReady.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIssueAllReady.mock
+}
+
+// Set uses given function f to mock the OrderService.IssueAllReady method
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) Set(f func(ctx context.Context, req requests.IssueAllReadyRequest) (i1 models.IssueReceipt, err error)) *OrderServiceMock {
+	if mmIssueAllReady.defaultExpectation != nil {
+		mmIssueAllReady.mock.t.Fatalf("Default expectation is already set for the OrderService.IssueAllReady method")
+	}
+
+	if len(mmIssueAllReady.expectations) > 0 {
+		mmIssueAllReady.mock.t.Fatalf("Some expectations are already set for the OrderService.IssueAllReady method")
+	}
+
+	mmIssueAllReady.mock.funcIssueAllReady = f
+	mmIssueAllReady.mock.funcIssueAllReadyOrigin = minimock.CallerInfo(1)
+	return mmIssueAllReady.mock
+}
+
+// When sets expectation for the OrderService.IssueAllReady which will trigger the result defined by the following
+// Then helper
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) When(ctx context.Context, req requests.IssueAllReadyRequest) *OrderServiceMockIssueAllReadyExpectation {
+	if mmIssueAllReady.mock.funcIssueAllReady != nil {
+		mmIssueAllReady.mock.t.Fatalf("OrderServiceMock.IssueAllReady mock is already set by Set")
+	}
+
+	expectation := &OrderServiceMockIssueAllReadyExpectation{
+		mock:               mmIssueAllReady.mock,
+		params:             &OrderServiceMockIssueAllReadyParams{ctx, req},
+		expectationOrigins: OrderServiceMockIssueAllReadyExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmImportOrders.expectations = append(mmImportOrders.expectations, expectation)
+	mmIssueAllReady.expectations = append(mmIssueAllReady.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrderService.ImportOrders return parameters for the expectation previously defined by the When method
-func (e *OrderServiceMockImportOrdersExpectation) Then(ba1 []models.BatchEntryProcessedResult, err error) *OrderServiceMock {
-	e.results = &OrderServiceMockImportOrdersResults{ba1, err}
+// Then sets up OrderService.IssueAllReady return parameters for the expectation previously defined by the When method
+func (e *OrderServiceMockIssueAllReadyExpectation) Then(i1 models.IssueReceipt, err error) *OrderServiceMock {
+	e.results = &OrderServiceMockIssueAllReadyResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times OrderService.ImportOrders should be invoked
-func (mmImportOrders *mOrderServiceMockImportOrders) Times(n uint64) *mOrderServiceMockImportOrders {
+// Times sets number of times OrderService.IssueAllReady should be invoked
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) Times(n uint64) *mOrderServiceMockIssueAllReady {
 	if n == 0 {
-		mmImportOrders.mock.t.Fatalf("Times of OrderServiceMock.ImportOrders mock can not be zero")
+		mmIssueAllReady.mock.t.Fatalf("Times of OrderServiceMock.IssueAllReady mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmImportOrders.expectedInvocations, n)
-	mmImportOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmImportOrders
+	mm_atomic.StoreUint64(&mmIssueAllReady.expectedInvocations, n)
+	mmIssueAllReady.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIssueAllReady
 }
 
-func (mmImportOrders *mOrderServiceMockImportOrders) invocationsDone() bool {
-	if len(mmImportOrders.expectations) == 0 && mmImportOrders.defaultExpectation == nil && mmImportOrders.mock.funcImportOrders == nil {
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) invocationsDone() bool {
+	if len(mmIssueAllReady.expectations) == 0 && mmIssueAllReady.defaultExpectation == nil && mmIssueAllReady.mock.funcIssueAllReady == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmImportOrders.mock.afterImportOrdersCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmImportOrders.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmIssueAllReady.mock.afterIssueAllReadyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIssueAllReady.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ImportOrders implements mm_services.OrderService
-func (mmImportOrders *OrderServiceMock) ImportOrders(ctx context.Context, req requests.ImportOrdersRequest) (ba1 []models.BatchEntryProcessedResult, err error) {
-	mm_atomic.AddUint64(&mmImportOrders.beforeImportOrdersCounter, 1)
-	defer mm_atomic.AddUint64(&mmImportOrders.afterImportOrdersCounter, 1)
+// IssueAllReady implements mm_services.OrderService
+func (mmIssueAllReady *OrderServiceMock) IssueAllReady(ctx context.Context, req requests.IssueAllReadyRequest) (i1 models.IssueReceipt, err error) {
+	mm_atomic.AddUint64(&mmIssueAllReady.beforeIssueAllReadyCounter, 1)
+	defer mm_atomic.AddUint64(&mmIssueAllReady.afterIssueAllReadyCounter, 1)
 
-	mmImportOrders.t.Helper()
+	mmIssueAllReady.t.Helper()
 
-	if mmImportOrders.inspectFuncImportOrders != nil {
-		mmImportOrders.inspectFuncImportOrders(ctx, req)
+	if mmIssueAllReady.inspectFuncIssueAllReady != nil {
+		mmIssueAllReady.inspectFuncIssueAllReady(ctx, req)
 	}
 
-	mm_params := OrderServiceMockImportOrdersParams{ctx, req}
+	mm_params := OrderServiceMockIssueAllReadyParams{ctx, req}
 
 	// Record call args
-	mmImportOrders.ImportOrdersMock.mutex.Lock()
-	mmImportOrders.ImportOrdersMock.callArgs = append(mmImportOrders.ImportOrdersMock.callArgs, &mm_params)
-	mmImportOrders.ImportOrdersMock.mutex.Unlock()
+	mmIssueAllReady.IssueAllReadyMock.mutex.Lock()
+	mmIssueAllReady.IssueAllReadyMock.callArgs = append(mmIssueAllReady.IssueAllReadyMock.callArgs, &mm_params)
+	mmIssueAllReady.IssueAllReadyMock.mutex.Unlock()
 
-	for _, e := range mmImportOrders.ImportOrdersMock.expectations {
+	for _, e := range mmIssueAllReady.IssueAllReadyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ba1, e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmImportOrders.ImportOrdersMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmImportOrders.ImportOrdersMock.defaultExpectation.Counter, 1)
-		mm_want := mmImportOrders.ImportOrdersMock.defaultExpectation.params
-		mm_want_ptrs := mmImportOrders.ImportOrdersMock.defaultExpectation.paramPtrs
+	if mmIssueAllReady.IssueAllReadyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIssueAllReady.IssueAllReadyMock.defaultExpectation.Counter, 1)
+		mm_want := mmIssueAllReady.IssueAllReadyMock.defaultExpectation.params
+		mm_want_ptrs := mmIssueAllReady.IssueAllReadyMock.defaultExpectation.paramPtrs
 
-		mm_got := OrderServiceMockImportOrdersParams{ctx, req}
+		mm_got := OrderServiceMockIssueAllReadyParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmImportOrders.t.Errorf("OrderServiceMock.ImportOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmImportOrders.ImportOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmIssueAllReady.t.Errorf("OrderServiceMock.IssueAllReady got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueAllReady.IssueAllReadyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmImportOrders.t.Errorf("OrderServiceMock.ImportOrders got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmImportOrders.ImportOrdersMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+				mmIssueAllReady.t.Errorf("OrderServiceMock.IssueAllReady got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIssueAllReady.IssueAllReadyMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmImportOrders.t.Errorf("OrderServiceMock.ImportOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmImportOrders.ImportOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmIssueAllReady.t.Errorf("OrderServiceMock.IssueAllReady got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIssueAllReady.IssueAllReadyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmImportOrders.ImportOrdersMock.defaultExpectation.results
+		mm_results := mmIssueAllReady.IssueAllReadyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmImportOrders.t.Fatal("No results are set for the OrderServiceMock.ImportOrders")
+			mmIssueAllReady.t.Fatal("No results are set for the OrderServiceMock.IssueAllReady")
 		}
-		return (*mm_results).ba1, (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmImportOrders.funcImportOrders != nil {
-		return mmImportOrders.funcImportOrders(ctx, req)
+	if mmIssueAllReady.funcIssueAllReady != nil {
+		return mmIssueAllReady.funcIssueAllReady(ctx, req)
 	}
-	mmImportOrders.t.Fatalf("Unexpected call to OrderServiceMock.ImportOrders. %v %v", ctx, req)
+	mmIssueAllReady.t.Fatalf("Unexpected call to OrderServiceMock.IssueAllReady. %v %v", ctx, req)
 	return
 }
 
-// ImportOrdersAfterCounter returns a count of finished OrderServiceMock.ImportOrders invocations
-func (mmImportOrders *OrderServiceMock) ImportOrdersAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmImportOrders.afterImportOrdersCounter)
+// IssueAllReadyAfterCounter returns a count of finished OrderServiceMock.IssueAllReady invocations
+func (mmIssueAllReady *OrderServiceMock) IssueAllReadyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueAllReady.afterIssueAllReadyCounter)
 }
 
-// ImportOrdersBeforeCounter returns a count of OrderServiceMock.ImportOrders invocations
-func (mmImportOrders *OrderServiceMock) ImportOrdersBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmImportOrders.beforeImportOrdersCounter)
+// IssueAllReadyBeforeCounter returns a count of OrderServiceMock.IssueAllReady invocations
+func (mmIssueAllReady *OrderServiceMock) IssueAllReadyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIssueAllReady.beforeIssueAllReadyCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrderServiceMock.ImportOrders.
+// Calls returns a list of arguments used in each call to OrderServiceMock.IssueAllReady.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmImportOrders *mOrderServiceMockImportOrders) Calls() []*OrderServiceMockImportOrdersParams {
-	mmImportOrders.mutex.RLock()
+func (mmIssueAllReady *mOrderServiceMockIssueAllReady) Calls() []*OrderServiceMockIssueAllReadyParams {
+	mmIssueAllReady.mutex.RLock()
 
-	argCopy := make([]*OrderServiceMockImportOrdersParams, len(mmImportOrders.callArgs))
-	copy(argCopy, mmImportOrders.callArgs)
+	argCopy := make([]*OrderServiceMockIssueAllReadyParams, len(mmIssueAllReady.callArgs))
+	copy(argCopy, mmIssueAllReady.callArgs)
 
-	mmImportOrders.mutex.RUnlock()
+	mmIssueAllReady.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockImportOrdersDone returns true if the count of the ImportOrders invocations corresponds
+// MinimockIssueAllReadyDone returns true if the count of the IssueAllReady invocations corresponds
 // the number of defined expectations
-func (m *OrderServiceMock) MinimockImportOrdersDone() bool {
-	if m.ImportOrdersMock.optional {
+func (m *OrderServiceMock) MinimockIssueAllReadyDone() bool {
+	if m.IssueAllReadyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ImportOrdersMock.expectations {
+	for _, e := range m.IssueAllReadyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ImportOrdersMock.invocationsDone()
+	return m.IssueAllReadyMock.invocationsDone()
 }
 
-// MinimockImportOrdersInspect logs each unmet expectation
-func (m *OrderServiceMock) MinimockImportOrdersInspect() {
-	for _, e := range m.ImportOrdersMock.expectations {
+// MinimockIssueAllReadyInspect logs each unmet expectation
+func (m *OrderServiceMock) MinimockIssueAllReadyInspect() {
+	for _, e := range m.IssueAllReadyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrderServiceMock.ImportOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrderServiceMock.IssueAllReady at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterImportOrdersCounter := mm_atomic.LoadUint64(&m.afterImportOrdersCounter)
+	afterIssueAllReadyCounter := mm_atomic.LoadUint64(&m.afterIssueAllReadyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ImportOrdersMock.defaultExpectation != nil && afterImportOrdersCounter < 1 {
-		if m.ImportOrdersMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrderServiceMock.ImportOrders at\n%s", m.ImportOrdersMock.defaultExpectation.returnOrigin)
+	if m.IssueAllReadyMock.defaultExpectation != nil && afterIssueAllReadyCounter < 1 {
+		if m.IssueAllReadyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderServiceMock.IssueAllReady at\n%s", m.IssueAllReadyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrderServiceMock.ImportOrders at\n%s with params: %#v", m.ImportOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ImportOrdersMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrderServiceMock.IssueAllReady at\n%s with params: %#v", m.IssueAllReadyMock.defaultExpectation.expectationOrigins.origin, *m.IssueAllReadyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcImportOrders != nil && afterImportOrdersCounter < 1 {
-		m.t.Errorf("Expected call to OrderServiceMock.ImportOrders at\n%s", m.funcImportOrdersOrigin)
+	if m.funcIssueAllReady != nil && afterIssueAllReadyCounter < 1 {
+		m.t.Errorf("Expected call to OrderServiceMock.IssueAllReady at\n%s", m.funcIssueAllReadyOrigin)
 	}
 
-	if !m.ImportOrdersMock.invocationsDone() && afterImportOrdersCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrderServiceMock.ImportOrders at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ImportOrdersMock.expectedInvocations), m.ImportOrdersMock.expectedInvocationsOrigin, afterImportOrdersCounter)
+	if !m.IssueAllReadyMock.invocationsDone() && afterIssueAllReadyCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderServiceMock.IssueAllReady at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IssueAllReadyMock.expectedInvocations), m.IssueAllReadyMock.expectedInvocationsOrigin, afterIssueAllReadyCounter)
 	}
 }
 
@@ -2504,6 +3220,349 @@ func (m *OrderServiceMock) MinimockReturnToCourierInspect() {
 	}
 }
 
+type mOrderServiceMockUndoLastOperation struct {
+	optional           bool
+	mock               *OrderServiceMock
+	defaultExpectation *OrderServiceMockUndoLastOperationExpectation
+	expectations       []*OrderServiceMockUndoLastOperationExpectation
+
+	callArgs []*OrderServiceMockUndoLastOperationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderServiceMockUndoLastOperationExpectation specifies expectation struct of the OrderService.UndoLastOperation
+type OrderServiceMockUndoLastOperationExpectation struct {
+	mock               *OrderServiceMock
+	params             *OrderServiceMockUndoLastOperationParams
+	paramPtrs          *OrderServiceMockUndoLastOperationParamPtrs
+	expectationOrigins OrderServiceMockUndoLastOperationExpectationOrigins
+	results            *OrderServiceMockUndoLastOperationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderServiceMockUndoLastOperationParams contains parameters of the OrderService.UndoLastOperation
+type OrderServiceMockUndoLastOperationParams struct {
+	ctx context.Context
+	req requests.UndoRequest
+}
+
+// OrderServiceMockUndoLastOperationParamPtrs contains pointers to parameters of the OrderService.UndoLastOperation
+type OrderServiceMockUndoLastOperationParamPtrs struct {
+	ctx *context.Context
+	req *requests.UndoRequest
+}
+
+// OrderServiceMockUndoLastOperationResults contains results of the OrderService.UndoLastOperation
+type OrderServiceMockUndoLastOperationResults struct {
+	u1  models.UndoResult
+	err error
+}
+
+// OrderServiceMockUndoLastOperationOrigins contains origins of expectations of the OrderService.UndoLastOperation
+type OrderServiceMockUndoLastOperationExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) Optional() *mOrderServiceMockUndoLastOperation {
+	mmUndoLastOperation.optional = true
+	return mmUndoLastOperation
+}
+
+// Expect sets up expected params for OrderService.UndoLastOperation
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) Expect(ctx context.Context, req requests.UndoRequest) *mOrderServiceMockUndoLastOperation {
+	if mmUndoLastOperation.mock.funcUndoLastOperation != nil {
+		mmUndoLastOperation.mock.t.Fatalf("OrderServiceMock.UndoLastOperation mock is already set by Set")
+	}
+
+	if mmUndoLastOperation.defaultExpectation == nil {
+		mmUndoLastOperation.defaultExpectation = &OrderServiceMockUndoLastOperationExpectation{}
+	}
+
+	if mmUndoLastOperation.defaultExpectation.paramPtrs != nil {
+		mmUndoLastOperation.mock.t.Fatalf("OrderServiceMock.UndoLastOperation mock is already set by ExpectParams functions")
+	}
+
+	mmUndoLastOperation.defaultExpectation.params = &OrderServiceMockUndoLastOperationParams{ctx, req}
+	mmUndoLastOperation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUndoLastOperation.expectations {
+		if minimock.Equal(e.params, mmUndoLastOperation.defaultExpectation.params) {
+			mmUndoLastOperation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUndoLastOperation.defaultExpectation.params)
+		}
+	}
+
+	return mmUndoLastOperation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrderService.UndoLastOperation
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) ExpectCtxParam1(ctx context.Context) *mOrderServiceMockUndoLastOperation {
+	if mmUndoLastOperation.mock.funcUndoLastOperation != nil {
+		mmUndoLastOperation.mock.t.Fatalf("OrderServiceMock.UndoLastOperation mock is already set by Set")
+	}
+
+	if mmUndoLastOperation.defaultExpectation == nil {
+		mmUndoLastOperation.defaultExpectation = &OrderServiceMockUndoLastOperationExpectation{}
+	}
+
+	if mmUndoLastOperation.defaultExpectation.params != nil {
+		mmUndoLastOperation.mock.t.Fatalf("OrderServiceMock.UndoLastOperation mock is already set by Expect")
+	}
+
+	if mmUndoLastOperation.defaultExpectation.paramPtrs == nil {
+		mmUndoLastOperation.defaultExpectation.paramPtrs = &OrderServiceMockUndoLastOperationParamPtrs{}
+	}
+	mmUndoLastOperation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUndoLastOperation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUndoLastOperation
+}
+
+// ExpectReqParam2 sets up expected param req for OrderService.UndoLastOperation
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) ExpectReqParam2(req requests.UndoRequest) *mOrderServiceMockUndoLastOperation {
+	if mmUndoLastOperation.mock.funcUndoLastOperation != nil {
+		mmUndoLastOperation.mock.t.Fatalf("OrderServiceMock.UndoLastOperation mock is already set by Set")
+	}
+
+	if mmUndoLastOperation.defaultExpectation == nil {
+		mmUndoLastOperation.defaultExpectation = &OrderServiceMockUndoLastOperationExpectation{}
+	}
+
+	if mmUndoLastOperation.defaultExpectation.params != nil {
+		mmUndoLastOperation.mock.t.Fatalf("OrderServiceMock.UndoLastOperation mock is already set by Expect")
+	}
+
+	if mmUndoLastOperation.defaultExpectation.paramPtrs == nil {
+		mmUndoLastOperation.defaultExpectation.paramPtrs = &OrderServiceMockUndoLastOperationParamPtrs{}
+	}
+	mmUndoLastOperation.defaultExpectation.paramPtrs.req = &req
+	mmUndoLastOperation.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmUndoLastOperation
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderService.UndoLastOperation
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) Inspect(f func(ctx context.Context, req requests.UndoRequest)) *mOrderServiceMockUndoLastOperation {
+	if mmUndoLastOperation.mock.inspectFuncUndoLastOperation != nil {
+		mmUndoLastOperation.mock.t.Fatalf("Inspect function is already set for OrderServiceMock.UndoLastOperation")
+	}
+
+	mmUndoLastOperation.mock.inspectFuncUndoLastOperation = f
+
+	return mmUndoLastOperation
+}
+
+// Return sets up results that will be returned by OrderService.UndoLastOperation
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) Return(u1 models.UndoResult, err error) *OrderServiceMock {
+	if mmUndoLastOperation.mock.funcUndoLastOperation != nil {
+		mmUndoLastOperation.mock.t.Fatalf("OrderServiceMock.UndoLastOperation mock is already set by Set")
+	}
+
+	if mmUndoLastOperation.defaultExpectation == nil {
+		mmUndoLastOperation.defaultExpectation = &OrderServiceMockUndoLastOperationExpectation{mock: mmUndoLastOperation.mock}
+	}
+	mmUndoLastOperation.defaultExpectation.results = &OrderServiceMockUndoLastOperationResults{u1, err}
+	mmUndoLastOperation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUndoLastOperation.mock
+}
+
+// Set uses given function f to mock the OrderService.UndoLastOperation method
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) Set(f func(ctx context.Context, req requests.UndoRequest) (u1 models.UndoResult, err error)) *OrderServiceMock {
+	if mmUndoLastOperation.defaultExpectation != nil {
+		mmUndoLastOperation.mock.t.Fatalf("Default expectation is already set for the OrderService.UndoLastOperation method")
+	}
+
+	if len(mmUndoLastOperation.expectations) > 0 {
+		mmUndoLastOperation.mock.t.Fatalf("Some expectations are already set for the OrderService.UndoLastOperation method")
+	}
+
+	mmUndoLastOperation.mock.funcUndoLastOperation = f
+	mmUndoLastOperation.mock.funcUndoLastOperationOrigin = minimock.CallerInfo(1)
+	return mmUndoLastOperation.mock
+}
+
+// When sets expectation for the OrderService.UndoLastOperation which will trigger the result defined by the following
+// Then helper
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) When(ctx context.Context, req requests.UndoRequest) *OrderServiceMockUndoLastOperationExpectation {
+	if mmUndoLastOperation.mock.funcUndoLastOperation != nil {
+		mmUndoLastOperation.mock.t.Fatalf("OrderServiceMock.UndoLastOperation mock is already set by Set")
+	}
+
+	expectation := &OrderServiceMockUndoLastOperationExpectation{
+		mock:               mmUndoLastOperation.mock,
+		params:             &OrderServiceMockUndoLastOperationParams{ctx, req},
+		expectationOrigins: OrderServiceMockUndoLastOperationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUndoLastOperation.expectations = append(mmUndoLastOperation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderService.UndoLastOperation return parameters for the expectation previously defined by the When method
+func (e *OrderServiceMockUndoLastOperationExpectation) Then(u1 models.UndoResult, err error) *OrderServiceMock {
+	e.results = &OrderServiceMockUndoLastOperationResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times OrderService.UndoLastOperation should be invoked
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) Times(n uint64) *mOrderServiceMockUndoLastOperation {
+	if n == 0 {
+		mmUndoLastOperation.mock.t.Fatalf("Times of OrderServiceMock.UndoLastOperation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUndoLastOperation.expectedInvocations, n)
+	mmUndoLastOperation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUndoLastOperation
+}
+
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) invocationsDone() bool {
+	if len(mmUndoLastOperation.expectations) == 0 && mmUndoLastOperation.defaultExpectation == nil && mmUndoLastOperation.mock.funcUndoLastOperation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUndoLastOperation.mock.afterUndoLastOperationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUndoLastOperation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UndoLastOperation implements mm_services.OrderService
+func (mmUndoLastOperation *OrderServiceMock) UndoLastOperation(ctx context.Context, req requests.UndoRequest) (u1 models.UndoResult, err error) {
+	mm_atomic.AddUint64(&mmUndoLastOperation.beforeUndoLastOperationCounter, 1)
+	defer mm_atomic.AddUint64(&mmUndoLastOperation.afterUndoLastOperationCounter, 1)
+
+	mmUndoLastOperation.t.Helper()
+
+	if mmUndoLastOperation.inspectFuncUndoLastOperation != nil {
+		mmUndoLastOperation.inspectFuncUndoLastOperation(ctx, req)
+	}
+
+	mm_params := OrderServiceMockUndoLastOperationParams{ctx, req}
+
+	// Record call args
+	mmUndoLastOperation.UndoLastOperationMock.mutex.Lock()
+	mmUndoLastOperation.UndoLastOperationMock.callArgs = append(mmUndoLastOperation.UndoLastOperationMock.callArgs, &mm_params)
+	mmUndoLastOperation.UndoLastOperationMock.mutex.Unlock()
+
+	for _, e := range mmUndoLastOperation.UndoLastOperationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmUndoLastOperation.UndoLastOperationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUndoLastOperation.UndoLastOperationMock.defaultExpectation.Counter, 1)
+		mm_want := mmUndoLastOperation.UndoLastOperationMock.defaultExpectation.params
+		mm_want_ptrs := mmUndoLastOperation.UndoLastOperationMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderServiceMockUndoLastOperationParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUndoLastOperation.t.Errorf("OrderServiceMock.UndoLastOperation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUndoLastOperation.UndoLastOperationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmUndoLastOperation.t.Errorf("OrderServiceMock.UndoLastOperation got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUndoLastOperation.UndoLastOperationMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUndoLastOperation.t.Errorf("OrderServiceMock.UndoLastOperation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUndoLastOperation.UndoLastOperationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUndoLastOperation.UndoLastOperationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUndoLastOperation.t.Fatal("No results are set for the OrderServiceMock.UndoLastOperation")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmUndoLastOperation.funcUndoLastOperation != nil {
+		return mmUndoLastOperation.funcUndoLastOperation(ctx, req)
+	}
+	mmUndoLastOperation.t.Fatalf("Unexpected call to OrderServiceMock.UndoLastOperation. %v %v", ctx, req)
+	return
+}
+
+// UndoLastOperationAfterCounter returns a count of finished OrderServiceMock.UndoLastOperation invocations
+func (mmUndoLastOperation *OrderServiceMock) UndoLastOperationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUndoLastOperation.afterUndoLastOperationCounter)
+}
+
+// UndoLastOperationBeforeCounter returns a count of OrderServiceMock.UndoLastOperation invocations
+func (mmUndoLastOperation *OrderServiceMock) UndoLastOperationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUndoLastOperation.beforeUndoLastOperationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderServiceMock.UndoLastOperation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUndoLastOperation *mOrderServiceMockUndoLastOperation) Calls() []*OrderServiceMockUndoLastOperationParams {
+	mmUndoLastOperation.mutex.RLock()
+
+	argCopy := make([]*OrderServiceMockUndoLastOperationParams, len(mmUndoLastOperation.callArgs))
+	copy(argCopy, mmUndoLastOperation.callArgs)
+
+	mmUndoLastOperation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUndoLastOperationDone returns true if the count of the UndoLastOperation invocations corresponds
+// the number of defined expectations
+func (m *OrderServiceMock) MinimockUndoLastOperationDone() bool {
+	if m.UndoLastOperationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UndoLastOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UndoLastOperationMock.invocationsDone()
+}
+
+// MinimockUndoLastOperationInspect logs each unmet expectation
+func (m *OrderServiceMock) MinimockUndoLastOperationInspect() {
+	for _, e := range m.UndoLastOperationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderServiceMock.UndoLastOperation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUndoLastOperationCounter := mm_atomic.LoadUint64(&m.afterUndoLastOperationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UndoLastOperationMock.defaultExpectation != nil && afterUndoLastOperationCounter < 1 {
+		if m.UndoLastOperationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderServiceMock.UndoLastOperation at\n%s", m.UndoLastOperationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderServiceMock.UndoLastOperation at\n%s with params: %#v", m.UndoLastOperationMock.defaultExpectation.expectationOrigins.origin, *m.UndoLastOperationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUndoLastOperation != nil && afterUndoLastOperationCounter < 1 {
+		m.t.Errorf("Expected call to OrderServiceMock.UndoLastOperation at\n%s", m.funcUndoLastOperationOrigin)
+	}
+
+	if !m.UndoLastOperationMock.invocationsDone() && afterUndoLastOperationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderServiceMock.UndoLastOperation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UndoLastOperationMock.expectedInvocations), m.UndoLastOperationMock.expectedInvocationsOrigin, afterUndoLastOperationCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *OrderServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
@@ -2512,8 +3571,12 @@ func (m *OrderServiceMock) MinimockFinish() {
 
 			m.MinimockCreateClientReturnsInspect()
 
+			m.MinimockGetOrderInspect()
+
 			m.MinimockImportOrdersInspect()
 
+			m.MinimockIssueAllReadyInspect()
+
 			m.MinimockIssueOrdersInspect()
 
 			m.MinimockListOrdersInspect()
@@ -2521,6 +3584,8 @@ func (m *OrderServiceMock) MinimockFinish() {
 			m.MinimockListReturnsInspect()
 
 			m.MinimockReturnToCourierInspect()
+
+			m.MinimockUndoLastOperationInspect()
 		}
 	})
 }
@@ -2546,9 +3611,12 @@ func (m *OrderServiceMock) minimockDone() bool {
 	return done &&
 		m.MinimockAcceptOrderDone() &&
 		m.MinimockCreateClientReturnsDone() &&
+		m.MinimockGetOrderDone() &&
 		m.MinimockImportOrdersDone() &&
+		m.MinimockIssueAllReadyDone() &&
 		m.MinimockIssueOrdersDone() &&
 		m.MinimockListOrdersDone() &&
 		m.MinimockListReturnsDone() &&
-		m.MinimockReturnToCourierDone()
+		m.MinimockReturnToCourierDone() &&
+		m.MinimockUndoLastOperationDone()
 }
@@ -16,9 +16,9 @@ type PackagePricingServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcEvaluate          func(pkg models.PackageType, weight float32, price float32) (surcharge float32, err error)
+	funcEvaluate          func(pkg models.PackageType, weight float32, price float32, flags models.HandlingFlags) (surcharge float32, err error)
 	funcEvaluateOrigin    string
-	inspectFuncEvaluate   func(pkg models.PackageType, weight float32, price float32)
+	inspectFuncEvaluate   func(pkg models.PackageType, weight float32, price float32, flags models.HandlingFlags)
 	afterEvaluateCounter  uint64
 	beforeEvaluateCounter uint64
 	EvaluateMock          mPackagePricingServiceMockEvaluate
@@ -69,6 +69,7 @@ type PackagePricingServiceMockEvaluateParams struct {
 	pkg    models.PackageType
 	weight float32
 	price  float32
+	flags  models.HandlingFlags
 }
 
 // PackagePricingServiceMockEvaluateParamPtrs contains pointers to parameters of the PackagePricingService.Evaluate
@@ -76,6 +77,7 @@ type PackagePricingServiceMockEvaluateParamPtrs struct {
 	pkg    *models.PackageType
 	weight *float32
 	price  *float32
+	flags  *models.HandlingFlags
 }
 
 // PackagePricingServiceMockEvaluateResults contains results of the PackagePricingService.Evaluate
@@ -90,6 +92,7 @@ type PackagePricingServiceMockEvaluateExpectationOrigins struct {
 	originPkg    string
 	originWeight string
 	originPrice  string
+	originFlags  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -103,7 +106,7 @@ func (mmEvaluate *mPackagePricingServiceMockEvaluate) Optional() *mPackagePricin
 }
 
 // Expect sets up expected params for PackagePricingService.Evaluate
-func (mmEvaluate *mPackagePricingServiceMockEvaluate) Expect(pkg models.PackageType, weight float32, price float32) *mPackagePricingServiceMockEvaluate {
+func (mmEvaluate *mPackagePricingServiceMockEvaluate) Expect(pkg models.PackageType, weight float32, price float32, flags models.HandlingFlags) *mPackagePricingServiceMockEvaluate {
 	if mmEvaluate.mock.funcEvaluate != nil {
 		mmEvaluate.mock.t.Fatalf("PackagePricingServiceMock.Evaluate mock is already set by Set")
 	}
@@ -116,7 +119,7 @@ func (mmEvaluate *mPackagePricingServiceMockEvaluate) Expect(pkg models.PackageT
 		mmEvaluate.mock.t.Fatalf("PackagePricingServiceMock.Evaluate mock is already set by ExpectParams functions")
 	}
 
-	mmEvaluate.defaultExpectation.params = &PackagePricingServiceMockEvaluateParams{pkg, weight, price}
+	mmEvaluate.defaultExpectation.params = &PackagePricingServiceMockEvaluateParams{pkg, weight, price, flags}
 	mmEvaluate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmEvaluate.expectations {
 		if minimock.Equal(e.params, mmEvaluate.defaultExpectation.params) {
@@ -196,8 +199,31 @@ func (mmEvaluate *mPackagePricingServiceMockEvaluate) ExpectPriceParam3(price fl
 	return mmEvaluate
 }
 
+// ExpectFlagsParam4 sets up expected param flags for PackagePricingService.Evaluate
+func (mmEvaluate *mPackagePricingServiceMockEvaluate) ExpectFlagsParam4(flags models.HandlingFlags) *mPackagePricingServiceMockEvaluate {
+	if mmEvaluate.mock.funcEvaluate != nil {
+		mmEvaluate.mock.t.Fatalf("PackagePricingServiceMock.Evaluate mock is already set by Set")
+	}
+
+	if mmEvaluate.defaultExpectation == nil {
+		mmEvaluate.defaultExpectation = &PackagePricingServiceMockEvaluateExpectation{}
+	}
+
+	if mmEvaluate.defaultExpectation.params != nil {
+		mmEvaluate.mock.t.Fatalf("PackagePricingServiceMock.Evaluate mock is already set by Expect")
+	}
+
+	if mmEvaluate.defaultExpectation.paramPtrs == nil {
+		mmEvaluate.defaultExpectation.paramPtrs = &PackagePricingServiceMockEvaluateParamPtrs{}
+	}
+	mmEvaluate.defaultExpectation.paramPtrs.flags = &flags
+	mmEvaluate.defaultExpectation.expectationOrigins.originFlags = minimock.CallerInfo(1)
+
+	return mmEvaluate
+}
+
 // Inspect accepts an inspector function that has same arguments as the PackagePricingService.Evaluate
-func (mmEvaluate *mPackagePricingServiceMockEvaluate) Inspect(f func(pkg models.PackageType, weight float32, price float32)) *mPackagePricingServiceMockEvaluate {
+func (mmEvaluate *mPackagePricingServiceMockEvaluate) Inspect(f func(pkg models.PackageType, weight float32, price float32, flags models.HandlingFlags)) *mPackagePricingServiceMockEvaluate {
 	if mmEvaluate.mock.inspectFuncEvaluate != nil {
 		mmEvaluate.mock.t.Fatalf("Inspect function is already set for PackagePricingServiceMock.Evaluate")
 	}
@@ -222,7 +248,7 @@ func (mmEvaluate *mPackagePricingServiceMockEvaluate) Return(surcharge float32,
 }
 
 // Set uses given function f to mock the PackagePricingService.Evaluate method
-func (mmEvaluate *mPackagePricingServiceMockEvaluate) Set(f func(pkg models.PackageType, weight float32, price float32) (surcharge float32, err error)) *PackagePricingServiceMock {
+func (mmEvaluate *mPackagePricingServiceMockEvaluate) Set(f func(pkg models.PackageType, weight float32, price float32, flags models.HandlingFlags) (surcharge float32, err error)) *PackagePricingServiceMock {
 	if mmEvaluate.defaultExpectation != nil {
 		mmEvaluate.mock.t.Fatalf("Default expectation is already set for the PackagePricingService.Evaluate method")
 	}
@@ -238,14 +264,14 @@ func (mmEvaluate *mPackagePricingServiceMockEvaluate) Set(f func(pkg models.Pack
 
 // When sets expectation for the PackagePricingService.Evaluate which will trigger the result defined by the following
 // Then helper
-func (mmEvaluate *mPackagePricingServiceMockEvaluate) When(pkg models.PackageType, weight float32, price float32) *PackagePricingServiceMockEvaluateExpectation {
+func (mmEvaluate *mPackagePricingServiceMockEvaluate) When(pkg models.PackageType, weight float32, price float32, flags models.HandlingFlags) *PackagePricingServiceMockEvaluateExpectation {
 	if mmEvaluate.mock.funcEvaluate != nil {
 		mmEvaluate.mock.t.Fatalf("PackagePricingServiceMock.Evaluate mock is already set by Set")
 	}
 
 	expectation := &PackagePricingServiceMockEvaluateExpectation{
 		mock:               mmEvaluate.mock,
-		params:             &PackagePricingServiceMockEvaluateParams{pkg, weight, price},
+		params:             &PackagePricingServiceMockEvaluateParams{pkg, weight, price, flags},
 		expectationOrigins: PackagePricingServiceMockEvaluateExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmEvaluate.expectations = append(mmEvaluate.expectations, expectation)
@@ -280,17 +306,17 @@ func (mmEvaluate *mPackagePricingServiceMockEvaluate) invocationsDone() bool {
 }
 
 // Evaluate implements mm_services.PackagePricingService
-func (mmEvaluate *PackagePricingServiceMock) Evaluate(pkg models.PackageType, weight float32, price float32) (surcharge float32, err error) {
+func (mmEvaluate *PackagePricingServiceMock) Evaluate(pkg models.PackageType, weight float32, price float32, flags models.HandlingFlags) (surcharge float32, err error) {
 	mm_atomic.AddUint64(&mmEvaluate.beforeEvaluateCounter, 1)
 	defer mm_atomic.AddUint64(&mmEvaluate.afterEvaluateCounter, 1)
 
 	mmEvaluate.t.Helper()
 
 	if mmEvaluate.inspectFuncEvaluate != nil {
-		mmEvaluate.inspectFuncEvaluate(pkg, weight, price)
+		mmEvaluate.inspectFuncEvaluate(pkg, weight, price, flags)
 	}
 
-	mm_params := PackagePricingServiceMockEvaluateParams{pkg, weight, price}
+	mm_params := PackagePricingServiceMockEvaluateParams{pkg, weight, price, flags}
 
 	// Record call args
 	mmEvaluate.EvaluateMock.mutex.Lock()
@@ -309,7 +335,7 @@ func (mmEvaluate *PackagePricingServiceMock) Evaluate(pkg models.PackageType, we
 		mm_want := mmEvaluate.EvaluateMock.defaultExpectation.params
 		mm_want_ptrs := mmEvaluate.EvaluateMock.defaultExpectation.paramPtrs
 
-		mm_got := PackagePricingServiceMockEvaluateParams{pkg, weight, price}
+		mm_got := PackagePricingServiceMockEvaluateParams{pkg, weight, price, flags}
 
 		if mm_want_ptrs != nil {
 
@@ -328,6 +354,11 @@ func (mmEvaluate *PackagePricingServiceMock) Evaluate(pkg models.PackageType, we
 					mmEvaluate.EvaluateMock.defaultExpectation.expectationOrigins.originPrice, *mm_want_ptrs.price, mm_got.price, minimock.Diff(*mm_want_ptrs.price, mm_got.price))
 			}
 
+			if mm_want_ptrs.flags != nil && !minimock.Equal(*mm_want_ptrs.flags, mm_got.flags) {
+				mmEvaluate.t.Errorf("PackagePricingServiceMock.Evaluate got unexpected parameter flags, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEvaluate.EvaluateMock.defaultExpectation.expectationOrigins.originFlags, *mm_want_ptrs.flags, mm_got.flags, minimock.Diff(*mm_want_ptrs.flags, mm_got.flags))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmEvaluate.t.Errorf("PackagePricingServiceMock.Evaluate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmEvaluate.EvaluateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -340,9 +371,9 @@ func (mmEvaluate *PackagePricingServiceMock) Evaluate(pkg models.PackageType, we
 		return (*mm_results).surcharge, (*mm_results).err
 	}
 	if mmEvaluate.funcEvaluate != nil {
-		return mmEvaluate.funcEvaluate(pkg, weight, price)
+		return mmEvaluate.funcEvaluate(pkg, weight, price, flags)
 	}
-	mmEvaluate.t.Fatalf("Unexpected call to PackagePricingServiceMock.Evaluate. %v %v %v", pkg, weight, price)
+	mmEvaluate.t.Fatalf("Unexpected call to PackagePricingServiceMock.Evaluate. %v %v %v %v", pkg, weight, price, flags)
 	return
 }
 
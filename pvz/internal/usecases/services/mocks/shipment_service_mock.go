@@ -0,0 +1,1130 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ShipmentServiceMock implements mm_services.ShipmentService
+type ShipmentServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetShipment          func(ctx context.Context, id uint64) (s1 models.Shipment, sa1 []models.ShipmentHistoryEntry, err error)
+	funcGetShipmentOrigin    string
+	inspectFuncGetShipment   func(ctx context.Context, id uint64)
+	afterGetShipmentCounter  uint64
+	beforeGetShipmentCounter uint64
+	GetShipmentMock          mShipmentServiceMockGetShipment
+
+	funcHandOverShipment          func(ctx context.Context, req requests.HandOverShipmentRequest) (s1 models.Shipment, err error)
+	funcHandOverShipmentOrigin    string
+	inspectFuncHandOverShipment   func(ctx context.Context, req requests.HandOverShipmentRequest)
+	afterHandOverShipmentCounter  uint64
+	beforeHandOverShipmentCounter uint64
+	HandOverShipmentMock          mShipmentServiceMockHandOverShipment
+
+	funcRegisterShipment          func(ctx context.Context, req requests.RegisterShipmentRequest) (s1 models.Shipment, err error)
+	funcRegisterShipmentOrigin    string
+	inspectFuncRegisterShipment   func(ctx context.Context, req requests.RegisterShipmentRequest)
+	afterRegisterShipmentCounter  uint64
+	beforeRegisterShipmentCounter uint64
+	RegisterShipmentMock          mShipmentServiceMockRegisterShipment
+}
+
+// NewShipmentServiceMock returns a mock for mm_services.ShipmentService
+func NewShipmentServiceMock(t minimock.Tester) *ShipmentServiceMock {
+	m := &ShipmentServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetShipmentMock = mShipmentServiceMockGetShipment{mock: m}
+	m.GetShipmentMock.callArgs = []*ShipmentServiceMockGetShipmentParams{}
+
+	m.HandOverShipmentMock = mShipmentServiceMockHandOverShipment{mock: m}
+	m.HandOverShipmentMock.callArgs = []*ShipmentServiceMockHandOverShipmentParams{}
+
+	m.RegisterShipmentMock = mShipmentServiceMockRegisterShipment{mock: m}
+	m.RegisterShipmentMock.callArgs = []*ShipmentServiceMockRegisterShipmentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mShipmentServiceMockGetShipment struct {
+	optional           bool
+	mock               *ShipmentServiceMock
+	defaultExpectation *ShipmentServiceMockGetShipmentExpectation
+	expectations       []*ShipmentServiceMockGetShipmentExpectation
+
+	callArgs []*ShipmentServiceMockGetShipmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ShipmentServiceMockGetShipmentExpectation specifies expectation struct of the ShipmentService.GetShipment
+type ShipmentServiceMockGetShipmentExpectation struct {
+	mock               *ShipmentServiceMock
+	params             *ShipmentServiceMockGetShipmentParams
+	paramPtrs          *ShipmentServiceMockGetShipmentParamPtrs
+	expectationOrigins ShipmentServiceMockGetShipmentExpectationOrigins
+	results            *ShipmentServiceMockGetShipmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ShipmentServiceMockGetShipmentParams contains parameters of the ShipmentService.GetShipment
+type ShipmentServiceMockGetShipmentParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// ShipmentServiceMockGetShipmentParamPtrs contains pointers to parameters of the ShipmentService.GetShipment
+type ShipmentServiceMockGetShipmentParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// ShipmentServiceMockGetShipmentResults contains results of the ShipmentService.GetShipment
+type ShipmentServiceMockGetShipmentResults struct {
+	s1  models.Shipment
+	sa1 []models.ShipmentHistoryEntry
+	err error
+}
+
+// ShipmentServiceMockGetShipmentOrigins contains origins of expectations of the ShipmentService.GetShipment
+type ShipmentServiceMockGetShipmentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetShipment *mShipmentServiceMockGetShipment) Optional() *mShipmentServiceMockGetShipment {
+	mmGetShipment.optional = true
+	return mmGetShipment
+}
+
+// Expect sets up expected params for ShipmentService.GetShipment
+func (mmGetShipment *mShipmentServiceMockGetShipment) Expect(ctx context.Context, id uint64) *mShipmentServiceMockGetShipment {
+	if mmGetShipment.mock.funcGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentServiceMock.GetShipment mock is already set by Set")
+	}
+
+	if mmGetShipment.defaultExpectation == nil {
+		mmGetShipment.defaultExpectation = &ShipmentServiceMockGetShipmentExpectation{}
+	}
+
+	if mmGetShipment.defaultExpectation.paramPtrs != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentServiceMock.GetShipment mock is already set by ExpectParams functions")
+	}
+
+	mmGetShipment.defaultExpectation.params = &ShipmentServiceMockGetShipmentParams{ctx, id}
+	mmGetShipment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetShipment.expectations {
+		if minimock.Equal(e.params, mmGetShipment.defaultExpectation.params) {
+			mmGetShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetShipment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ShipmentService.GetShipment
+func (mmGetShipment *mShipmentServiceMockGetShipment) ExpectCtxParam1(ctx context.Context) *mShipmentServiceMockGetShipment {
+	if mmGetShipment.mock.funcGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentServiceMock.GetShipment mock is already set by Set")
+	}
+
+	if mmGetShipment.defaultExpectation == nil {
+		mmGetShipment.defaultExpectation = &ShipmentServiceMockGetShipmentExpectation{}
+	}
+
+	if mmGetShipment.defaultExpectation.params != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentServiceMock.GetShipment mock is already set by Expect")
+	}
+
+	if mmGetShipment.defaultExpectation.paramPtrs == nil {
+		mmGetShipment.defaultExpectation.paramPtrs = &ShipmentServiceMockGetShipmentParamPtrs{}
+	}
+	mmGetShipment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetShipment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetShipment
+}
+
+// ExpectIdParam2 sets up expected param id for ShipmentService.GetShipment
+func (mmGetShipment *mShipmentServiceMockGetShipment) ExpectIdParam2(id uint64) *mShipmentServiceMockGetShipment {
+	if mmGetShipment.mock.funcGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentServiceMock.GetShipment mock is already set by Set")
+	}
+
+	if mmGetShipment.defaultExpectation == nil {
+		mmGetShipment.defaultExpectation = &ShipmentServiceMockGetShipmentExpectation{}
+	}
+
+	if mmGetShipment.defaultExpectation.params != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentServiceMock.GetShipment mock is already set by Expect")
+	}
+
+	if mmGetShipment.defaultExpectation.paramPtrs == nil {
+		mmGetShipment.defaultExpectation.paramPtrs = &ShipmentServiceMockGetShipmentParamPtrs{}
+	}
+	mmGetShipment.defaultExpectation.paramPtrs.id = &id
+	mmGetShipment.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentService.GetShipment
+func (mmGetShipment *mShipmentServiceMockGetShipment) Inspect(f func(ctx context.Context, id uint64)) *mShipmentServiceMockGetShipment {
+	if mmGetShipment.mock.inspectFuncGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("Inspect function is already set for ShipmentServiceMock.GetShipment")
+	}
+
+	mmGetShipment.mock.inspectFuncGetShipment = f
+
+	return mmGetShipment
+}
+
+// Return sets up results that will be returned by ShipmentService.GetShipment
+func (mmGetShipment *mShipmentServiceMockGetShipment) Return(s1 models.Shipment, sa1 []models.ShipmentHistoryEntry, err error) *ShipmentServiceMock {
+	if mmGetShipment.mock.funcGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentServiceMock.GetShipment mock is already set by Set")
+	}
+
+	if mmGetShipment.defaultExpectation == nil {
+		mmGetShipment.defaultExpectation = &ShipmentServiceMockGetShipmentExpectation{mock: mmGetShipment.mock}
+	}
+	mmGetShipment.defaultExpectation.results = &ShipmentServiceMockGetShipmentResults{s1, sa1, err}
+	mmGetShipment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetShipment.mock
+}
+
+// Set uses given function f to mock the ShipmentService.GetShipment method
+func (mmGetShipment *mShipmentServiceMockGetShipment) Set(f func(ctx context.Context, id uint64) (s1 models.Shipment, sa1 []models.ShipmentHistoryEntry, err error)) *ShipmentServiceMock {
+	if mmGetShipment.defaultExpectation != nil {
+		mmGetShipment.mock.t.Fatalf("Default expectation is already set for the ShipmentService.GetShipment method")
+	}
+
+	if len(mmGetShipment.expectations) > 0 {
+		mmGetShipment.mock.t.Fatalf("Some expectations are already set for the ShipmentService.GetShipment method")
+	}
+
+	mmGetShipment.mock.funcGetShipment = f
+	mmGetShipment.mock.funcGetShipmentOrigin = minimock.CallerInfo(1)
+	return mmGetShipment.mock
+}
+
+// When sets expectation for the ShipmentService.GetShipment which will trigger the result defined by the following
+// Then helper
+func (mmGetShipment *mShipmentServiceMockGetShipment) When(ctx context.Context, id uint64) *ShipmentServiceMockGetShipmentExpectation {
+	if mmGetShipment.mock.funcGetShipment != nil {
+		mmGetShipment.mock.t.Fatalf("ShipmentServiceMock.GetShipment mock is already set by Set")
+	}
+
+	expectation := &ShipmentServiceMockGetShipmentExpectation{
+		mock:               mmGetShipment.mock,
+		params:             &ShipmentServiceMockGetShipmentParams{ctx, id},
+		expectationOrigins: ShipmentServiceMockGetShipmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetShipment.expectations = append(mmGetShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentService.GetShipment return parameters for the expectation previously defined by the When method
+func (e *ShipmentServiceMockGetShipmentExpectation) Then(s1 models.Shipment, sa1 []models.ShipmentHistoryEntry, err error) *ShipmentServiceMock {
+	e.results = &ShipmentServiceMockGetShipmentResults{s1, sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ShipmentService.GetShipment should be invoked
+func (mmGetShipment *mShipmentServiceMockGetShipment) Times(n uint64) *mShipmentServiceMockGetShipment {
+	if n == 0 {
+		mmGetShipment.mock.t.Fatalf("Times of ShipmentServiceMock.GetShipment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetShipment.expectedInvocations, n)
+	mmGetShipment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetShipment
+}
+
+func (mmGetShipment *mShipmentServiceMockGetShipment) invocationsDone() bool {
+	if len(mmGetShipment.expectations) == 0 && mmGetShipment.defaultExpectation == nil && mmGetShipment.mock.funcGetShipment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetShipment.mock.afterGetShipmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetShipment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetShipment implements mm_services.ShipmentService
+func (mmGetShipment *ShipmentServiceMock) GetShipment(ctx context.Context, id uint64) (s1 models.Shipment, sa1 []models.ShipmentHistoryEntry, err error) {
+	mm_atomic.AddUint64(&mmGetShipment.beforeGetShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetShipment.afterGetShipmentCounter, 1)
+
+	mmGetShipment.t.Helper()
+
+	if mmGetShipment.inspectFuncGetShipment != nil {
+		mmGetShipment.inspectFuncGetShipment(ctx, id)
+	}
+
+	mm_params := ShipmentServiceMockGetShipmentParams{ctx, id}
+
+	// Record call args
+	mmGetShipment.GetShipmentMock.mutex.Lock()
+	mmGetShipment.GetShipmentMock.callArgs = append(mmGetShipment.GetShipmentMock.callArgs, &mm_params)
+	mmGetShipment.GetShipmentMock.mutex.Unlock()
+
+	for _, e := range mmGetShipment.GetShipmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetShipment.GetShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetShipment.GetShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetShipment.GetShipmentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetShipment.GetShipmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ShipmentServiceMockGetShipmentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetShipment.t.Errorf("ShipmentServiceMock.GetShipment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetShipment.GetShipmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetShipment.t.Errorf("ShipmentServiceMock.GetShipment got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetShipment.GetShipmentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetShipment.t.Errorf("ShipmentServiceMock.GetShipment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetShipment.GetShipmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetShipment.GetShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetShipment.t.Fatal("No results are set for the ShipmentServiceMock.GetShipment")
+		}
+		return (*mm_results).s1, (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetShipment.funcGetShipment != nil {
+		return mmGetShipment.funcGetShipment(ctx, id)
+	}
+	mmGetShipment.t.Fatalf("Unexpected call to ShipmentServiceMock.GetShipment. %v %v", ctx, id)
+	return
+}
+
+// GetShipmentAfterCounter returns a count of finished ShipmentServiceMock.GetShipment invocations
+func (mmGetShipment *ShipmentServiceMock) GetShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetShipment.afterGetShipmentCounter)
+}
+
+// GetShipmentBeforeCounter returns a count of ShipmentServiceMock.GetShipment invocations
+func (mmGetShipment *ShipmentServiceMock) GetShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetShipment.beforeGetShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentServiceMock.GetShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetShipment *mShipmentServiceMockGetShipment) Calls() []*ShipmentServiceMockGetShipmentParams {
+	mmGetShipment.mutex.RLock()
+
+	argCopy := make([]*ShipmentServiceMockGetShipmentParams, len(mmGetShipment.callArgs))
+	copy(argCopy, mmGetShipment.callArgs)
+
+	mmGetShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetShipmentDone returns true if the count of the GetShipment invocations corresponds
+// the number of defined expectations
+func (m *ShipmentServiceMock) MinimockGetShipmentDone() bool {
+	if m.GetShipmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetShipmentMock.invocationsDone()
+}
+
+// MinimockGetShipmentInspect logs each unmet expectation
+func (m *ShipmentServiceMock) MinimockGetShipmentInspect() {
+	for _, e := range m.GetShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentServiceMock.GetShipment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetShipmentCounter := mm_atomic.LoadUint64(&m.afterGetShipmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetShipmentMock.defaultExpectation != nil && afterGetShipmentCounter < 1 {
+		if m.GetShipmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ShipmentServiceMock.GetShipment at\n%s", m.GetShipmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ShipmentServiceMock.GetShipment at\n%s with params: %#v", m.GetShipmentMock.defaultExpectation.expectationOrigins.origin, *m.GetShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetShipment != nil && afterGetShipmentCounter < 1 {
+		m.t.Errorf("Expected call to ShipmentServiceMock.GetShipment at\n%s", m.funcGetShipmentOrigin)
+	}
+
+	if !m.GetShipmentMock.invocationsDone() && afterGetShipmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ShipmentServiceMock.GetShipment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetShipmentMock.expectedInvocations), m.GetShipmentMock.expectedInvocationsOrigin, afterGetShipmentCounter)
+	}
+}
+
+type mShipmentServiceMockHandOverShipment struct {
+	optional           bool
+	mock               *ShipmentServiceMock
+	defaultExpectation *ShipmentServiceMockHandOverShipmentExpectation
+	expectations       []*ShipmentServiceMockHandOverShipmentExpectation
+
+	callArgs []*ShipmentServiceMockHandOverShipmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ShipmentServiceMockHandOverShipmentExpectation specifies expectation struct of the ShipmentService.HandOverShipment
+type ShipmentServiceMockHandOverShipmentExpectation struct {
+	mock               *ShipmentServiceMock
+	params             *ShipmentServiceMockHandOverShipmentParams
+	paramPtrs          *ShipmentServiceMockHandOverShipmentParamPtrs
+	expectationOrigins ShipmentServiceMockHandOverShipmentExpectationOrigins
+	results            *ShipmentServiceMockHandOverShipmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ShipmentServiceMockHandOverShipmentParams contains parameters of the ShipmentService.HandOverShipment
+type ShipmentServiceMockHandOverShipmentParams struct {
+	ctx context.Context
+	req requests.HandOverShipmentRequest
+}
+
+// ShipmentServiceMockHandOverShipmentParamPtrs contains pointers to parameters of the ShipmentService.HandOverShipment
+type ShipmentServiceMockHandOverShipmentParamPtrs struct {
+	ctx *context.Context
+	req *requests.HandOverShipmentRequest
+}
+
+// ShipmentServiceMockHandOverShipmentResults contains results of the ShipmentService.HandOverShipment
+type ShipmentServiceMockHandOverShipmentResults struct {
+	s1  models.Shipment
+	err error
+}
+
+// ShipmentServiceMockHandOverShipmentOrigins contains origins of expectations of the ShipmentService.HandOverShipment
+type ShipmentServiceMockHandOverShipmentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) Optional() *mShipmentServiceMockHandOverShipment {
+	mmHandOverShipment.optional = true
+	return mmHandOverShipment
+}
+
+// Expect sets up expected params for ShipmentService.HandOverShipment
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) Expect(ctx context.Context, req requests.HandOverShipmentRequest) *mShipmentServiceMockHandOverShipment {
+	if mmHandOverShipment.mock.funcHandOverShipment != nil {
+		mmHandOverShipment.mock.t.Fatalf("ShipmentServiceMock.HandOverShipment mock is already set by Set")
+	}
+
+	if mmHandOverShipment.defaultExpectation == nil {
+		mmHandOverShipment.defaultExpectation = &ShipmentServiceMockHandOverShipmentExpectation{}
+	}
+
+	if mmHandOverShipment.defaultExpectation.paramPtrs != nil {
+		mmHandOverShipment.mock.t.Fatalf("ShipmentServiceMock.HandOverShipment mock is already set by ExpectParams functions")
+	}
+
+	mmHandOverShipment.defaultExpectation.params = &ShipmentServiceMockHandOverShipmentParams{ctx, req}
+	mmHandOverShipment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHandOverShipment.expectations {
+		if minimock.Equal(e.params, mmHandOverShipment.defaultExpectation.params) {
+			mmHandOverShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHandOverShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmHandOverShipment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ShipmentService.HandOverShipment
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) ExpectCtxParam1(ctx context.Context) *mShipmentServiceMockHandOverShipment {
+	if mmHandOverShipment.mock.funcHandOverShipment != nil {
+		mmHandOverShipment.mock.t.Fatalf("ShipmentServiceMock.HandOverShipment mock is already set by Set")
+	}
+
+	if mmHandOverShipment.defaultExpectation == nil {
+		mmHandOverShipment.defaultExpectation = &ShipmentServiceMockHandOverShipmentExpectation{}
+	}
+
+	if mmHandOverShipment.defaultExpectation.params != nil {
+		mmHandOverShipment.mock.t.Fatalf("ShipmentServiceMock.HandOverShipment mock is already set by Expect")
+	}
+
+	if mmHandOverShipment.defaultExpectation.paramPtrs == nil {
+		mmHandOverShipment.defaultExpectation.paramPtrs = &ShipmentServiceMockHandOverShipmentParamPtrs{}
+	}
+	mmHandOverShipment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHandOverShipment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHandOverShipment
+}
+
+// ExpectReqParam2 sets up expected param req for ShipmentService.HandOverShipment
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) ExpectReqParam2(req requests.HandOverShipmentRequest) *mShipmentServiceMockHandOverShipment {
+	if mmHandOverShipment.mock.funcHandOverShipment != nil {
+		mmHandOverShipment.mock.t.Fatalf("ShipmentServiceMock.HandOverShipment mock is already set by Set")
+	}
+
+	if mmHandOverShipment.defaultExpectation == nil {
+		mmHandOverShipment.defaultExpectation = &ShipmentServiceMockHandOverShipmentExpectation{}
+	}
+
+	if mmHandOverShipment.defaultExpectation.params != nil {
+		mmHandOverShipment.mock.t.Fatalf("ShipmentServiceMock.HandOverShipment mock is already set by Expect")
+	}
+
+	if mmHandOverShipment.defaultExpectation.paramPtrs == nil {
+		mmHandOverShipment.defaultExpectation.paramPtrs = &ShipmentServiceMockHandOverShipmentParamPtrs{}
+	}
+	mmHandOverShipment.defaultExpectation.paramPtrs.req = &req
+	mmHandOverShipment.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmHandOverShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentService.HandOverShipment
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) Inspect(f func(ctx context.Context, req requests.HandOverShipmentRequest)) *mShipmentServiceMockHandOverShipment {
+	if mmHandOverShipment.mock.inspectFuncHandOverShipment != nil {
+		mmHandOverShipment.mock.t.Fatalf("Inspect function is already set for ShipmentServiceMock.HandOverShipment")
+	}
+
+	mmHandOverShipment.mock.inspectFuncHandOverShipment = f
+
+	return mmHandOverShipment
+}
+
+// Return sets up results that will be returned by ShipmentService.HandOverShipment
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) Return(s1 models.Shipment, err error) *ShipmentServiceMock {
+	if mmHandOverShipment.mock.funcHandOverShipment != nil {
+		mmHandOverShipment.mock.t.Fatalf("ShipmentServiceMock.HandOverShipment mock is already set by Set")
+	}
+
+	if mmHandOverShipment.defaultExpectation == nil {
+		mmHandOverShipment.defaultExpectation = &ShipmentServiceMockHandOverShipmentExpectation{mock: mmHandOverShipment.mock}
+	}
+	mmHandOverShipment.defaultExpectation.results = &ShipmentServiceMockHandOverShipmentResults{s1, err}
+	mmHandOverShipment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHandOverShipment.mock
+}
+
+// Set uses given function f to mock the ShipmentService.HandOverShipment method
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) Set(f func(ctx context.Context, req requests.HandOverShipmentRequest) (s1 models.Shipment, err error)) *ShipmentServiceMock {
+	if mmHandOverShipment.defaultExpectation != nil {
+		mmHandOverShipment.mock.t.Fatalf("Default expectation is already set for the ShipmentService.HandOverShipment method")
+	}
+
+	if len(mmHandOverShipment.expectations) > 0 {
+		mmHandOverShipment.mock.t.Fatalf("Some expectations are already set for the ShipmentService.HandOverShipment method")
+	}
+
+	mmHandOverShipment.mock.funcHandOverShipment = f
+	mmHandOverShipment.mock.funcHandOverShipmentOrigin = minimock.CallerInfo(1)
+	return mmHandOverShipment.mock
+}
+
+// When sets expectation for the ShipmentService.HandOverShipment which will trigger the result defined by the following
+// Then helper
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) When(ctx context.Context, req requests.HandOverShipmentRequest) *ShipmentServiceMockHandOverShipmentExpectation {
+	if mmHandOverShipment.mock.funcHandOverShipment != nil {
+		mmHandOverShipment.mock.t.Fatalf("ShipmentServiceMock.HandOverShipment mock is already set by Set")
+	}
+
+	expectation := &ShipmentServiceMockHandOverShipmentExpectation{
+		mock:               mmHandOverShipment.mock,
+		params:             &ShipmentServiceMockHandOverShipmentParams{ctx, req},
+		expectationOrigins: ShipmentServiceMockHandOverShipmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHandOverShipment.expectations = append(mmHandOverShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentService.HandOverShipment return parameters for the expectation previously defined by the When method
+func (e *ShipmentServiceMockHandOverShipmentExpectation) Then(s1 models.Shipment, err error) *ShipmentServiceMock {
+	e.results = &ShipmentServiceMockHandOverShipmentResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times ShipmentService.HandOverShipment should be invoked
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) Times(n uint64) *mShipmentServiceMockHandOverShipment {
+	if n == 0 {
+		mmHandOverShipment.mock.t.Fatalf("Times of ShipmentServiceMock.HandOverShipment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHandOverShipment.expectedInvocations, n)
+	mmHandOverShipment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHandOverShipment
+}
+
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) invocationsDone() bool {
+	if len(mmHandOverShipment.expectations) == 0 && mmHandOverShipment.defaultExpectation == nil && mmHandOverShipment.mock.funcHandOverShipment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHandOverShipment.mock.afterHandOverShipmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHandOverShipment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HandOverShipment implements mm_services.ShipmentService
+func (mmHandOverShipment *ShipmentServiceMock) HandOverShipment(ctx context.Context, req requests.HandOverShipmentRequest) (s1 models.Shipment, err error) {
+	mm_atomic.AddUint64(&mmHandOverShipment.beforeHandOverShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmHandOverShipment.afterHandOverShipmentCounter, 1)
+
+	mmHandOverShipment.t.Helper()
+
+	if mmHandOverShipment.inspectFuncHandOverShipment != nil {
+		mmHandOverShipment.inspectFuncHandOverShipment(ctx, req)
+	}
+
+	mm_params := ShipmentServiceMockHandOverShipmentParams{ctx, req}
+
+	// Record call args
+	mmHandOverShipment.HandOverShipmentMock.mutex.Lock()
+	mmHandOverShipment.HandOverShipmentMock.callArgs = append(mmHandOverShipment.HandOverShipmentMock.callArgs, &mm_params)
+	mmHandOverShipment.HandOverShipmentMock.mutex.Unlock()
+
+	for _, e := range mmHandOverShipment.HandOverShipmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmHandOverShipment.HandOverShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHandOverShipment.HandOverShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmHandOverShipment.HandOverShipmentMock.defaultExpectation.params
+		mm_want_ptrs := mmHandOverShipment.HandOverShipmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ShipmentServiceMockHandOverShipmentParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHandOverShipment.t.Errorf("ShipmentServiceMock.HandOverShipment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHandOverShipment.HandOverShipmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmHandOverShipment.t.Errorf("ShipmentServiceMock.HandOverShipment got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHandOverShipment.HandOverShipmentMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHandOverShipment.t.Errorf("ShipmentServiceMock.HandOverShipment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHandOverShipment.HandOverShipmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHandOverShipment.HandOverShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHandOverShipment.t.Fatal("No results are set for the ShipmentServiceMock.HandOverShipment")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmHandOverShipment.funcHandOverShipment != nil {
+		return mmHandOverShipment.funcHandOverShipment(ctx, req)
+	}
+	mmHandOverShipment.t.Fatalf("Unexpected call to ShipmentServiceMock.HandOverShipment. %v %v", ctx, req)
+	return
+}
+
+// HandOverShipmentAfterCounter returns a count of finished ShipmentServiceMock.HandOverShipment invocations
+func (mmHandOverShipment *ShipmentServiceMock) HandOverShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHandOverShipment.afterHandOverShipmentCounter)
+}
+
+// HandOverShipmentBeforeCounter returns a count of ShipmentServiceMock.HandOverShipment invocations
+func (mmHandOverShipment *ShipmentServiceMock) HandOverShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHandOverShipment.beforeHandOverShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentServiceMock.HandOverShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHandOverShipment *mShipmentServiceMockHandOverShipment) Calls() []*ShipmentServiceMockHandOverShipmentParams {
+	mmHandOverShipment.mutex.RLock()
+
+	argCopy := make([]*ShipmentServiceMockHandOverShipmentParams, len(mmHandOverShipment.callArgs))
+	copy(argCopy, mmHandOverShipment.callArgs)
+
+	mmHandOverShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHandOverShipmentDone returns true if the count of the HandOverShipment invocations corresponds
+// the number of defined expectations
+func (m *ShipmentServiceMock) MinimockHandOverShipmentDone() bool {
+	if m.HandOverShipmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HandOverShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HandOverShipmentMock.invocationsDone()
+}
+
+// MinimockHandOverShipmentInspect logs each unmet expectation
+func (m *ShipmentServiceMock) MinimockHandOverShipmentInspect() {
+	for _, e := range m.HandOverShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentServiceMock.HandOverShipment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHandOverShipmentCounter := mm_atomic.LoadUint64(&m.afterHandOverShipmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HandOverShipmentMock.defaultExpectation != nil && afterHandOverShipmentCounter < 1 {
+		if m.HandOverShipmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ShipmentServiceMock.HandOverShipment at\n%s", m.HandOverShipmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ShipmentServiceMock.HandOverShipment at\n%s with params: %#v", m.HandOverShipmentMock.defaultExpectation.expectationOrigins.origin, *m.HandOverShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHandOverShipment != nil && afterHandOverShipmentCounter < 1 {
+		m.t.Errorf("Expected call to ShipmentServiceMock.HandOverShipment at\n%s", m.funcHandOverShipmentOrigin)
+	}
+
+	if !m.HandOverShipmentMock.invocationsDone() && afterHandOverShipmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ShipmentServiceMock.HandOverShipment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HandOverShipmentMock.expectedInvocations), m.HandOverShipmentMock.expectedInvocationsOrigin, afterHandOverShipmentCounter)
+	}
+}
+
+type mShipmentServiceMockRegisterShipment struct {
+	optional           bool
+	mock               *ShipmentServiceMock
+	defaultExpectation *ShipmentServiceMockRegisterShipmentExpectation
+	expectations       []*ShipmentServiceMockRegisterShipmentExpectation
+
+	callArgs []*ShipmentServiceMockRegisterShipmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ShipmentServiceMockRegisterShipmentExpectation specifies expectation struct of the ShipmentService.RegisterShipment
+type ShipmentServiceMockRegisterShipmentExpectation struct {
+	mock               *ShipmentServiceMock
+	params             *ShipmentServiceMockRegisterShipmentParams
+	paramPtrs          *ShipmentServiceMockRegisterShipmentParamPtrs
+	expectationOrigins ShipmentServiceMockRegisterShipmentExpectationOrigins
+	results            *ShipmentServiceMockRegisterShipmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ShipmentServiceMockRegisterShipmentParams contains parameters of the ShipmentService.RegisterShipment
+type ShipmentServiceMockRegisterShipmentParams struct {
+	ctx context.Context
+	req requests.RegisterShipmentRequest
+}
+
+// ShipmentServiceMockRegisterShipmentParamPtrs contains pointers to parameters of the ShipmentService.RegisterShipment
+type ShipmentServiceMockRegisterShipmentParamPtrs struct {
+	ctx *context.Context
+	req *requests.RegisterShipmentRequest
+}
+
+// ShipmentServiceMockRegisterShipmentResults contains results of the ShipmentService.RegisterShipment
+type ShipmentServiceMockRegisterShipmentResults struct {
+	s1  models.Shipment
+	err error
+}
+
+// ShipmentServiceMockRegisterShipmentOrigins contains origins of expectations of the ShipmentService.RegisterShipment
+type ShipmentServiceMockRegisterShipmentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) Optional() *mShipmentServiceMockRegisterShipment {
+	mmRegisterShipment.optional = true
+	return mmRegisterShipment
+}
+
+// Expect sets up expected params for ShipmentService.RegisterShipment
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) Expect(ctx context.Context, req requests.RegisterShipmentRequest) *mShipmentServiceMockRegisterShipment {
+	if mmRegisterShipment.mock.funcRegisterShipment != nil {
+		mmRegisterShipment.mock.t.Fatalf("ShipmentServiceMock.RegisterShipment mock is already set by Set")
+	}
+
+	if mmRegisterShipment.defaultExpectation == nil {
+		mmRegisterShipment.defaultExpectation = &ShipmentServiceMockRegisterShipmentExpectation{}
+	}
+
+	if mmRegisterShipment.defaultExpectation.paramPtrs != nil {
+		mmRegisterShipment.mock.t.Fatalf("ShipmentServiceMock.RegisterShipment mock is already set by ExpectParams functions")
+	}
+
+	mmRegisterShipment.defaultExpectation.params = &ShipmentServiceMockRegisterShipmentParams{ctx, req}
+	mmRegisterShipment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRegisterShipment.expectations {
+		if minimock.Equal(e.params, mmRegisterShipment.defaultExpectation.params) {
+			mmRegisterShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmRegisterShipment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ShipmentService.RegisterShipment
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) ExpectCtxParam1(ctx context.Context) *mShipmentServiceMockRegisterShipment {
+	if mmRegisterShipment.mock.funcRegisterShipment != nil {
+		mmRegisterShipment.mock.t.Fatalf("ShipmentServiceMock.RegisterShipment mock is already set by Set")
+	}
+
+	if mmRegisterShipment.defaultExpectation == nil {
+		mmRegisterShipment.defaultExpectation = &ShipmentServiceMockRegisterShipmentExpectation{}
+	}
+
+	if mmRegisterShipment.defaultExpectation.params != nil {
+		mmRegisterShipment.mock.t.Fatalf("ShipmentServiceMock.RegisterShipment mock is already set by Expect")
+	}
+
+	if mmRegisterShipment.defaultExpectation.paramPtrs == nil {
+		mmRegisterShipment.defaultExpectation.paramPtrs = &ShipmentServiceMockRegisterShipmentParamPtrs{}
+	}
+	mmRegisterShipment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRegisterShipment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRegisterShipment
+}
+
+// ExpectReqParam2 sets up expected param req for ShipmentService.RegisterShipment
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) ExpectReqParam2(req requests.RegisterShipmentRequest) *mShipmentServiceMockRegisterShipment {
+	if mmRegisterShipment.mock.funcRegisterShipment != nil {
+		mmRegisterShipment.mock.t.Fatalf("ShipmentServiceMock.RegisterShipment mock is already set by Set")
+	}
+
+	if mmRegisterShipment.defaultExpectation == nil {
+		mmRegisterShipment.defaultExpectation = &ShipmentServiceMockRegisterShipmentExpectation{}
+	}
+
+	if mmRegisterShipment.defaultExpectation.params != nil {
+		mmRegisterShipment.mock.t.Fatalf("ShipmentServiceMock.RegisterShipment mock is already set by Expect")
+	}
+
+	if mmRegisterShipment.defaultExpectation.paramPtrs == nil {
+		mmRegisterShipment.defaultExpectation.paramPtrs = &ShipmentServiceMockRegisterShipmentParamPtrs{}
+	}
+	mmRegisterShipment.defaultExpectation.paramPtrs.req = &req
+	mmRegisterShipment.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmRegisterShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentService.RegisterShipment
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) Inspect(f func(ctx context.Context, req requests.RegisterShipmentRequest)) *mShipmentServiceMockRegisterShipment {
+	if mmRegisterShipment.mock.inspectFuncRegisterShipment != nil {
+		mmRegisterShipment.mock.t.Fatalf("Inspect function is already set for ShipmentServiceMock.RegisterShipment")
+	}
+
+	mmRegisterShipment.mock.inspectFuncRegisterShipment = f
+
+	return mmRegisterShipment
+}
+
+// Return sets up results that will be returned by ShipmentService.RegisterShipment
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) Return(s1 models.Shipment, err error) *ShipmentServiceMock {
+	if mmRegisterShipment.mock.funcRegisterShipment != nil {
+		mmRegisterShipment.mock.t.Fatalf("ShipmentServiceMock.RegisterShipment mock is already set by Set")
+	}
+
+	if mmRegisterShipment.defaultExpectation == nil {
+		mmRegisterShipment.defaultExpectation = &ShipmentServiceMockRegisterShipmentExpectation{mock: mmRegisterShipment.mock}
+	}
+	mmRegisterShipment.defaultExpectation.results = &ShipmentServiceMockRegisterShipmentResults{s1, err}
+	mmRegisterShipment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRegisterShipment.mock
+}
+
+// Set uses given function f to mock the ShipmentService.RegisterShipment method
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) Set(f func(ctx context.Context, req requests.RegisterShipmentRequest) (s1 models.Shipment, err error)) *ShipmentServiceMock {
+	if mmRegisterShipment.defaultExpectation != nil {
+		mmRegisterShipment.mock.t.Fatalf("Default expectation is already set for the ShipmentService.RegisterShipment method")
+	}
+
+	if len(mmRegisterShipment.expectations) > 0 {
+		mmRegisterShipment.mock.t.Fatalf("Some expectations are already set for the ShipmentService.RegisterShipment method")
+	}
+
+	mmRegisterShipment.mock.funcRegisterShipment = f
+	mmRegisterShipment.mock.funcRegisterShipmentOrigin = minimock.CallerInfo(1)
+	return mmRegisterShipment.mock
+}
+
+// When sets expectation for the ShipmentService.RegisterShipment which will trigger the result defined by the following
+// Then helper
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) When(ctx context.Context, req requests.RegisterShipmentRequest) *ShipmentServiceMockRegisterShipmentExpectation {
+	if mmRegisterShipment.mock.funcRegisterShipment != nil {
+		mmRegisterShipment.mock.t.Fatalf("ShipmentServiceMock.RegisterShipment mock is already set by Set")
+	}
+
+	expectation := &ShipmentServiceMockRegisterShipmentExpectation{
+		mock:               mmRegisterShipment.mock,
+		params:             &ShipmentServiceMockRegisterShipmentParams{ctx, req},
+		expectationOrigins: ShipmentServiceMockRegisterShipmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRegisterShipment.expectations = append(mmRegisterShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentService.RegisterShipment return parameters for the expectation previously defined by the When method
+func (e *ShipmentServiceMockRegisterShipmentExpectation) Then(s1 models.Shipment, err error) *ShipmentServiceMock {
+	e.results = &ShipmentServiceMockRegisterShipmentResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times ShipmentService.RegisterShipment should be invoked
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) Times(n uint64) *mShipmentServiceMockRegisterShipment {
+	if n == 0 {
+		mmRegisterShipment.mock.t.Fatalf("Times of ShipmentServiceMock.RegisterShipment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRegisterShipment.expectedInvocations, n)
+	mmRegisterShipment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRegisterShipment
+}
+
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) invocationsDone() bool {
+	if len(mmRegisterShipment.expectations) == 0 && mmRegisterShipment.defaultExpectation == nil && mmRegisterShipment.mock.funcRegisterShipment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRegisterShipment.mock.afterRegisterShipmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRegisterShipment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RegisterShipment implements mm_services.ShipmentService
+func (mmRegisterShipment *ShipmentServiceMock) RegisterShipment(ctx context.Context, req requests.RegisterShipmentRequest) (s1 models.Shipment, err error) {
+	mm_atomic.AddUint64(&mmRegisterShipment.beforeRegisterShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmRegisterShipment.afterRegisterShipmentCounter, 1)
+
+	mmRegisterShipment.t.Helper()
+
+	if mmRegisterShipment.inspectFuncRegisterShipment != nil {
+		mmRegisterShipment.inspectFuncRegisterShipment(ctx, req)
+	}
+
+	mm_params := ShipmentServiceMockRegisterShipmentParams{ctx, req}
+
+	// Record call args
+	mmRegisterShipment.RegisterShipmentMock.mutex.Lock()
+	mmRegisterShipment.RegisterShipmentMock.callArgs = append(mmRegisterShipment.RegisterShipmentMock.callArgs, &mm_params)
+	mmRegisterShipment.RegisterShipmentMock.mutex.Unlock()
+
+	for _, e := range mmRegisterShipment.RegisterShipmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmRegisterShipment.RegisterShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRegisterShipment.RegisterShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmRegisterShipment.RegisterShipmentMock.defaultExpectation.params
+		mm_want_ptrs := mmRegisterShipment.RegisterShipmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ShipmentServiceMockRegisterShipmentParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRegisterShipment.t.Errorf("ShipmentServiceMock.RegisterShipment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterShipment.RegisterShipmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmRegisterShipment.t.Errorf("ShipmentServiceMock.RegisterShipment got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterShipment.RegisterShipmentMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterShipment.t.Errorf("ShipmentServiceMock.RegisterShipment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRegisterShipment.RegisterShipmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRegisterShipment.RegisterShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRegisterShipment.t.Fatal("No results are set for the ShipmentServiceMock.RegisterShipment")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmRegisterShipment.funcRegisterShipment != nil {
+		return mmRegisterShipment.funcRegisterShipment(ctx, req)
+	}
+	mmRegisterShipment.t.Fatalf("Unexpected call to ShipmentServiceMock.RegisterShipment. %v %v", ctx, req)
+	return
+}
+
+// RegisterShipmentAfterCounter returns a count of finished ShipmentServiceMock.RegisterShipment invocations
+func (mmRegisterShipment *ShipmentServiceMock) RegisterShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterShipment.afterRegisterShipmentCounter)
+}
+
+// RegisterShipmentBeforeCounter returns a count of ShipmentServiceMock.RegisterShipment invocations
+func (mmRegisterShipment *ShipmentServiceMock) RegisterShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterShipment.beforeRegisterShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentServiceMock.RegisterShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRegisterShipment *mShipmentServiceMockRegisterShipment) Calls() []*ShipmentServiceMockRegisterShipmentParams {
+	mmRegisterShipment.mutex.RLock()
+
+	argCopy := make([]*ShipmentServiceMockRegisterShipmentParams, len(mmRegisterShipment.callArgs))
+	copy(argCopy, mmRegisterShipment.callArgs)
+
+	mmRegisterShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRegisterShipmentDone returns true if the count of the RegisterShipment invocations corresponds
+// the number of defined expectations
+func (m *ShipmentServiceMock) MinimockRegisterShipmentDone() bool {
+	if m.RegisterShipmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RegisterShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RegisterShipmentMock.invocationsDone()
+}
+
+// MinimockRegisterShipmentInspect logs each unmet expectation
+func (m *ShipmentServiceMock) MinimockRegisterShipmentInspect() {
+	for _, e := range m.RegisterShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentServiceMock.RegisterShipment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRegisterShipmentCounter := mm_atomic.LoadUint64(&m.afterRegisterShipmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterShipmentMock.defaultExpectation != nil && afterRegisterShipmentCounter < 1 {
+		if m.RegisterShipmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ShipmentServiceMock.RegisterShipment at\n%s", m.RegisterShipmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ShipmentServiceMock.RegisterShipment at\n%s with params: %#v", m.RegisterShipmentMock.defaultExpectation.expectationOrigins.origin, *m.RegisterShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterShipment != nil && afterRegisterShipmentCounter < 1 {
+		m.t.Errorf("Expected call to ShipmentServiceMock.RegisterShipment at\n%s", m.funcRegisterShipmentOrigin)
+	}
+
+	if !m.RegisterShipmentMock.invocationsDone() && afterRegisterShipmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ShipmentServiceMock.RegisterShipment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RegisterShipmentMock.expectedInvocations), m.RegisterShipmentMock.expectedInvocationsOrigin, afterRegisterShipmentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ShipmentServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetShipmentInspect()
+
+			m.MinimockHandOverShipmentInspect()
+
+			m.MinimockRegisterShipmentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ShipmentServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ShipmentServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetShipmentDone() &&
+		m.MinimockHandOverShipmentDone() &&
+		m.MinimockRegisterShipmentDone()
+}
@@ -0,0 +1,772 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UserDataServiceMock implements mm_services.UserDataService
+type UserDataServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAnonymizeUserData          func(ctx context.Context, userID uint64) (a1 models.AnonymizeResult, err error)
+	funcAnonymizeUserDataOrigin    string
+	inspectFuncAnonymizeUserData   func(ctx context.Context, userID uint64)
+	afterAnonymizeUserDataCounter  uint64
+	beforeAnonymizeUserDataCounter uint64
+	AnonymizeUserDataMock          mUserDataServiceMockAnonymizeUserData
+
+	funcExportUserData          func(ctx context.Context, userID uint64) (u1 models.UserDataExport, err error)
+	funcExportUserDataOrigin    string
+	inspectFuncExportUserData   func(ctx context.Context, userID uint64)
+	afterExportUserDataCounter  uint64
+	beforeExportUserDataCounter uint64
+	ExportUserDataMock          mUserDataServiceMockExportUserData
+}
+
+// NewUserDataServiceMock returns a mock for mm_services.UserDataService
+func NewUserDataServiceMock(t minimock.Tester) *UserDataServiceMock {
+	m := &UserDataServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AnonymizeUserDataMock = mUserDataServiceMockAnonymizeUserData{mock: m}
+	m.AnonymizeUserDataMock.callArgs = []*UserDataServiceMockAnonymizeUserDataParams{}
+
+	m.ExportUserDataMock = mUserDataServiceMockExportUserData{mock: m}
+	m.ExportUserDataMock.callArgs = []*UserDataServiceMockExportUserDataParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserDataServiceMockAnonymizeUserData struct {
+	optional           bool
+	mock               *UserDataServiceMock
+	defaultExpectation *UserDataServiceMockAnonymizeUserDataExpectation
+	expectations       []*UserDataServiceMockAnonymizeUserDataExpectation
+
+	callArgs []*UserDataServiceMockAnonymizeUserDataParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserDataServiceMockAnonymizeUserDataExpectation specifies expectation struct of the UserDataService.AnonymizeUserData
+type UserDataServiceMockAnonymizeUserDataExpectation struct {
+	mock               *UserDataServiceMock
+	params             *UserDataServiceMockAnonymizeUserDataParams
+	paramPtrs          *UserDataServiceMockAnonymizeUserDataParamPtrs
+	expectationOrigins UserDataServiceMockAnonymizeUserDataExpectationOrigins
+	results            *UserDataServiceMockAnonymizeUserDataResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserDataServiceMockAnonymizeUserDataParams contains parameters of the UserDataService.AnonymizeUserData
+type UserDataServiceMockAnonymizeUserDataParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// UserDataServiceMockAnonymizeUserDataParamPtrs contains pointers to parameters of the UserDataService.AnonymizeUserData
+type UserDataServiceMockAnonymizeUserDataParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// UserDataServiceMockAnonymizeUserDataResults contains results of the UserDataService.AnonymizeUserData
+type UserDataServiceMockAnonymizeUserDataResults struct {
+	a1  models.AnonymizeResult
+	err error
+}
+
+// UserDataServiceMockAnonymizeUserDataOrigins contains origins of expectations of the UserDataService.AnonymizeUserData
+type UserDataServiceMockAnonymizeUserDataExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) Optional() *mUserDataServiceMockAnonymizeUserData {
+	mmAnonymizeUserData.optional = true
+	return mmAnonymizeUserData
+}
+
+// Expect sets up expected params for UserDataService.AnonymizeUserData
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) Expect(ctx context.Context, userID uint64) *mUserDataServiceMockAnonymizeUserData {
+	if mmAnonymizeUserData.mock.funcAnonymizeUserData != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("UserDataServiceMock.AnonymizeUserData mock is already set by Set")
+	}
+
+	if mmAnonymizeUserData.defaultExpectation == nil {
+		mmAnonymizeUserData.defaultExpectation = &UserDataServiceMockAnonymizeUserDataExpectation{}
+	}
+
+	if mmAnonymizeUserData.defaultExpectation.paramPtrs != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("UserDataServiceMock.AnonymizeUserData mock is already set by ExpectParams functions")
+	}
+
+	mmAnonymizeUserData.defaultExpectation.params = &UserDataServiceMockAnonymizeUserDataParams{ctx, userID}
+	mmAnonymizeUserData.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAnonymizeUserData.expectations {
+		if minimock.Equal(e.params, mmAnonymizeUserData.defaultExpectation.params) {
+			mmAnonymizeUserData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAnonymizeUserData.defaultExpectation.params)
+		}
+	}
+
+	return mmAnonymizeUserData
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserDataService.AnonymizeUserData
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) ExpectCtxParam1(ctx context.Context) *mUserDataServiceMockAnonymizeUserData {
+	if mmAnonymizeUserData.mock.funcAnonymizeUserData != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("UserDataServiceMock.AnonymizeUserData mock is already set by Set")
+	}
+
+	if mmAnonymizeUserData.defaultExpectation == nil {
+		mmAnonymizeUserData.defaultExpectation = &UserDataServiceMockAnonymizeUserDataExpectation{}
+	}
+
+	if mmAnonymizeUserData.defaultExpectation.params != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("UserDataServiceMock.AnonymizeUserData mock is already set by Expect")
+	}
+
+	if mmAnonymizeUserData.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUserData.defaultExpectation.paramPtrs = &UserDataServiceMockAnonymizeUserDataParamPtrs{}
+	}
+	mmAnonymizeUserData.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAnonymizeUserData.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAnonymizeUserData
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserDataService.AnonymizeUserData
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) ExpectUserIDParam2(userID uint64) *mUserDataServiceMockAnonymizeUserData {
+	if mmAnonymizeUserData.mock.funcAnonymizeUserData != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("UserDataServiceMock.AnonymizeUserData mock is already set by Set")
+	}
+
+	if mmAnonymizeUserData.defaultExpectation == nil {
+		mmAnonymizeUserData.defaultExpectation = &UserDataServiceMockAnonymizeUserDataExpectation{}
+	}
+
+	if mmAnonymizeUserData.defaultExpectation.params != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("UserDataServiceMock.AnonymizeUserData mock is already set by Expect")
+	}
+
+	if mmAnonymizeUserData.defaultExpectation.paramPtrs == nil {
+		mmAnonymizeUserData.defaultExpectation.paramPtrs = &UserDataServiceMockAnonymizeUserDataParamPtrs{}
+	}
+	mmAnonymizeUserData.defaultExpectation.paramPtrs.userID = &userID
+	mmAnonymizeUserData.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAnonymizeUserData
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserDataService.AnonymizeUserData
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) Inspect(f func(ctx context.Context, userID uint64)) *mUserDataServiceMockAnonymizeUserData {
+	if mmAnonymizeUserData.mock.inspectFuncAnonymizeUserData != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("Inspect function is already set for UserDataServiceMock.AnonymizeUserData")
+	}
+
+	mmAnonymizeUserData.mock.inspectFuncAnonymizeUserData = f
+
+	return mmAnonymizeUserData
+}
+
+// Return sets up results that will be returned by UserDataService.AnonymizeUserData
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) Return(a1 models.AnonymizeResult, err error) *UserDataServiceMock {
+	if mmAnonymizeUserData.mock.funcAnonymizeUserData != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("UserDataServiceMock.AnonymizeUserData mock is already set by Set")
+	}
+
+	if mmAnonymizeUserData.defaultExpectation == nil {
+		mmAnonymizeUserData.defaultExpectation = &UserDataServiceMockAnonymizeUserDataExpectation{mock: mmAnonymizeUserData.mock}
+	}
+	mmAnonymizeUserData.defaultExpectation.results = &UserDataServiceMockAnonymizeUserDataResults{a1, err}
+	mmAnonymizeUserData.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUserData.mock
+}
+
+// Set uses given function f to mock the UserDataService.AnonymizeUserData method
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) Set(f func(ctx context.Context, userID uint64) (a1 models.AnonymizeResult, err error)) *UserDataServiceMock {
+	if mmAnonymizeUserData.defaultExpectation != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("Default expectation is already set for the UserDataService.AnonymizeUserData method")
+	}
+
+	if len(mmAnonymizeUserData.expectations) > 0 {
+		mmAnonymizeUserData.mock.t.Fatalf("Some expectations are already set for the UserDataService.AnonymizeUserData method")
+	}
+
+	mmAnonymizeUserData.mock.funcAnonymizeUserData = f
+	mmAnonymizeUserData.mock.funcAnonymizeUserDataOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUserData.mock
+}
+
+// When sets expectation for the UserDataService.AnonymizeUserData which will trigger the result defined by the following
+// Then helper
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) When(ctx context.Context, userID uint64) *UserDataServiceMockAnonymizeUserDataExpectation {
+	if mmAnonymizeUserData.mock.funcAnonymizeUserData != nil {
+		mmAnonymizeUserData.mock.t.Fatalf("UserDataServiceMock.AnonymizeUserData mock is already set by Set")
+	}
+
+	expectation := &UserDataServiceMockAnonymizeUserDataExpectation{
+		mock:               mmAnonymizeUserData.mock,
+		params:             &UserDataServiceMockAnonymizeUserDataParams{ctx, userID},
+		expectationOrigins: UserDataServiceMockAnonymizeUserDataExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAnonymizeUserData.expectations = append(mmAnonymizeUserData.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserDataService.AnonymizeUserData return parameters for the expectation previously defined by the When method
+func (e *UserDataServiceMockAnonymizeUserDataExpectation) Then(a1 models.AnonymizeResult, err error) *UserDataServiceMock {
+	e.results = &UserDataServiceMockAnonymizeUserDataResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times UserDataService.AnonymizeUserData should be invoked
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) Times(n uint64) *mUserDataServiceMockAnonymizeUserData {
+	if n == 0 {
+		mmAnonymizeUserData.mock.t.Fatalf("Times of UserDataServiceMock.AnonymizeUserData mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAnonymizeUserData.expectedInvocations, n)
+	mmAnonymizeUserData.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAnonymizeUserData
+}
+
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) invocationsDone() bool {
+	if len(mmAnonymizeUserData.expectations) == 0 && mmAnonymizeUserData.defaultExpectation == nil && mmAnonymizeUserData.mock.funcAnonymizeUserData == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAnonymizeUserData.mock.afterAnonymizeUserDataCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAnonymizeUserData.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AnonymizeUserData implements mm_services.UserDataService
+func (mmAnonymizeUserData *UserDataServiceMock) AnonymizeUserData(ctx context.Context, userID uint64) (a1 models.AnonymizeResult, err error) {
+	mm_atomic.AddUint64(&mmAnonymizeUserData.beforeAnonymizeUserDataCounter, 1)
+	defer mm_atomic.AddUint64(&mmAnonymizeUserData.afterAnonymizeUserDataCounter, 1)
+
+	mmAnonymizeUserData.t.Helper()
+
+	if mmAnonymizeUserData.inspectFuncAnonymizeUserData != nil {
+		mmAnonymizeUserData.inspectFuncAnonymizeUserData(ctx, userID)
+	}
+
+	mm_params := UserDataServiceMockAnonymizeUserDataParams{ctx, userID}
+
+	// Record call args
+	mmAnonymizeUserData.AnonymizeUserDataMock.mutex.Lock()
+	mmAnonymizeUserData.AnonymizeUserDataMock.callArgs = append(mmAnonymizeUserData.AnonymizeUserDataMock.callArgs, &mm_params)
+	mmAnonymizeUserData.AnonymizeUserDataMock.mutex.Unlock()
+
+	for _, e := range mmAnonymizeUserData.AnonymizeUserDataMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmAnonymizeUserData.AnonymizeUserDataMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAnonymizeUserData.AnonymizeUserDataMock.defaultExpectation.Counter, 1)
+		mm_want := mmAnonymizeUserData.AnonymizeUserDataMock.defaultExpectation.params
+		mm_want_ptrs := mmAnonymizeUserData.AnonymizeUserDataMock.defaultExpectation.paramPtrs
+
+		mm_got := UserDataServiceMockAnonymizeUserDataParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAnonymizeUserData.t.Errorf("UserDataServiceMock.AnonymizeUserData got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUserData.AnonymizeUserDataMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAnonymizeUserData.t.Errorf("UserDataServiceMock.AnonymizeUserData got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAnonymizeUserData.AnonymizeUserDataMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAnonymizeUserData.t.Errorf("UserDataServiceMock.AnonymizeUserData got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAnonymizeUserData.AnonymizeUserDataMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAnonymizeUserData.AnonymizeUserDataMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAnonymizeUserData.t.Fatal("No results are set for the UserDataServiceMock.AnonymizeUserData")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmAnonymizeUserData.funcAnonymizeUserData != nil {
+		return mmAnonymizeUserData.funcAnonymizeUserData(ctx, userID)
+	}
+	mmAnonymizeUserData.t.Fatalf("Unexpected call to UserDataServiceMock.AnonymizeUserData. %v %v", ctx, userID)
+	return
+}
+
+// AnonymizeUserDataAfterCounter returns a count of finished UserDataServiceMock.AnonymizeUserData invocations
+func (mmAnonymizeUserData *UserDataServiceMock) AnonymizeUserDataAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymizeUserData.afterAnonymizeUserDataCounter)
+}
+
+// AnonymizeUserDataBeforeCounter returns a count of UserDataServiceMock.AnonymizeUserData invocations
+func (mmAnonymizeUserData *UserDataServiceMock) AnonymizeUserDataBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAnonymizeUserData.beforeAnonymizeUserDataCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserDataServiceMock.AnonymizeUserData.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAnonymizeUserData *mUserDataServiceMockAnonymizeUserData) Calls() []*UserDataServiceMockAnonymizeUserDataParams {
+	mmAnonymizeUserData.mutex.RLock()
+
+	argCopy := make([]*UserDataServiceMockAnonymizeUserDataParams, len(mmAnonymizeUserData.callArgs))
+	copy(argCopy, mmAnonymizeUserData.callArgs)
+
+	mmAnonymizeUserData.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAnonymizeUserDataDone returns true if the count of the AnonymizeUserData invocations corresponds
+// the number of defined expectations
+func (m *UserDataServiceMock) MinimockAnonymizeUserDataDone() bool {
+	if m.AnonymizeUserDataMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AnonymizeUserDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AnonymizeUserDataMock.invocationsDone()
+}
+
+// MinimockAnonymizeUserDataInspect logs each unmet expectation
+func (m *UserDataServiceMock) MinimockAnonymizeUserDataInspect() {
+	for _, e := range m.AnonymizeUserDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserDataServiceMock.AnonymizeUserData at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAnonymizeUserDataCounter := mm_atomic.LoadUint64(&m.afterAnonymizeUserDataCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AnonymizeUserDataMock.defaultExpectation != nil && afterAnonymizeUserDataCounter < 1 {
+		if m.AnonymizeUserDataMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserDataServiceMock.AnonymizeUserData at\n%s", m.AnonymizeUserDataMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserDataServiceMock.AnonymizeUserData at\n%s with params: %#v", m.AnonymizeUserDataMock.defaultExpectation.expectationOrigins.origin, *m.AnonymizeUserDataMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAnonymizeUserData != nil && afterAnonymizeUserDataCounter < 1 {
+		m.t.Errorf("Expected call to UserDataServiceMock.AnonymizeUserData at\n%s", m.funcAnonymizeUserDataOrigin)
+	}
+
+	if !m.AnonymizeUserDataMock.invocationsDone() && afterAnonymizeUserDataCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserDataServiceMock.AnonymizeUserData at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AnonymizeUserDataMock.expectedInvocations), m.AnonymizeUserDataMock.expectedInvocationsOrigin, afterAnonymizeUserDataCounter)
+	}
+}
+
+type mUserDataServiceMockExportUserData struct {
+	optional           bool
+	mock               *UserDataServiceMock
+	defaultExpectation *UserDataServiceMockExportUserDataExpectation
+	expectations       []*UserDataServiceMockExportUserDataExpectation
+
+	callArgs []*UserDataServiceMockExportUserDataParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UserDataServiceMockExportUserDataExpectation specifies expectation struct of the UserDataService.ExportUserData
+type UserDataServiceMockExportUserDataExpectation struct {
+	mock               *UserDataServiceMock
+	params             *UserDataServiceMockExportUserDataParams
+	paramPtrs          *UserDataServiceMockExportUserDataParamPtrs
+	expectationOrigins UserDataServiceMockExportUserDataExpectationOrigins
+	results            *UserDataServiceMockExportUserDataResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UserDataServiceMockExportUserDataParams contains parameters of the UserDataService.ExportUserData
+type UserDataServiceMockExportUserDataParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// UserDataServiceMockExportUserDataParamPtrs contains pointers to parameters of the UserDataService.ExportUserData
+type UserDataServiceMockExportUserDataParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// UserDataServiceMockExportUserDataResults contains results of the UserDataService.ExportUserData
+type UserDataServiceMockExportUserDataResults struct {
+	u1  models.UserDataExport
+	err error
+}
+
+// UserDataServiceMockExportUserDataOrigins contains origins of expectations of the UserDataService.ExportUserData
+type UserDataServiceMockExportUserDataExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExportUserData *mUserDataServiceMockExportUserData) Optional() *mUserDataServiceMockExportUserData {
+	mmExportUserData.optional = true
+	return mmExportUserData
+}
+
+// Expect sets up expected params for UserDataService.ExportUserData
+func (mmExportUserData *mUserDataServiceMockExportUserData) Expect(ctx context.Context, userID uint64) *mUserDataServiceMockExportUserData {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UserDataServiceMock.ExportUserData mock is already set by Set")
+	}
+
+	if mmExportUserData.defaultExpectation == nil {
+		mmExportUserData.defaultExpectation = &UserDataServiceMockExportUserDataExpectation{}
+	}
+
+	if mmExportUserData.defaultExpectation.paramPtrs != nil {
+		mmExportUserData.mock.t.Fatalf("UserDataServiceMock.ExportUserData mock is already set by ExpectParams functions")
+	}
+
+	mmExportUserData.defaultExpectation.params = &UserDataServiceMockExportUserDataParams{ctx, userID}
+	mmExportUserData.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportUserData.expectations {
+		if minimock.Equal(e.params, mmExportUserData.defaultExpectation.params) {
+			mmExportUserData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportUserData.defaultExpectation.params)
+		}
+	}
+
+	return mmExportUserData
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserDataService.ExportUserData
+func (mmExportUserData *mUserDataServiceMockExportUserData) ExpectCtxParam1(ctx context.Context) *mUserDataServiceMockExportUserData {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UserDataServiceMock.ExportUserData mock is already set by Set")
+	}
+
+	if mmExportUserData.defaultExpectation == nil {
+		mmExportUserData.defaultExpectation = &UserDataServiceMockExportUserDataExpectation{}
+	}
+
+	if mmExportUserData.defaultExpectation.params != nil {
+		mmExportUserData.mock.t.Fatalf("UserDataServiceMock.ExportUserData mock is already set by Expect")
+	}
+
+	if mmExportUserData.defaultExpectation.paramPtrs == nil {
+		mmExportUserData.defaultExpectation.paramPtrs = &UserDataServiceMockExportUserDataParamPtrs{}
+	}
+	mmExportUserData.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportUserData.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExportUserData
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserDataService.ExportUserData
+func (mmExportUserData *mUserDataServiceMockExportUserData) ExpectUserIDParam2(userID uint64) *mUserDataServiceMockExportUserData {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UserDataServiceMock.ExportUserData mock is already set by Set")
+	}
+
+	if mmExportUserData.defaultExpectation == nil {
+		mmExportUserData.defaultExpectation = &UserDataServiceMockExportUserDataExpectation{}
+	}
+
+	if mmExportUserData.defaultExpectation.params != nil {
+		mmExportUserData.mock.t.Fatalf("UserDataServiceMock.ExportUserData mock is already set by Expect")
+	}
+
+	if mmExportUserData.defaultExpectation.paramPtrs == nil {
+		mmExportUserData.defaultExpectation.paramPtrs = &UserDataServiceMockExportUserDataParamPtrs{}
+	}
+	mmExportUserData.defaultExpectation.paramPtrs.userID = &userID
+	mmExportUserData.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmExportUserData
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserDataService.ExportUserData
+func (mmExportUserData *mUserDataServiceMockExportUserData) Inspect(f func(ctx context.Context, userID uint64)) *mUserDataServiceMockExportUserData {
+	if mmExportUserData.mock.inspectFuncExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("Inspect function is already set for UserDataServiceMock.ExportUserData")
+	}
+
+	mmExportUserData.mock.inspectFuncExportUserData = f
+
+	return mmExportUserData
+}
+
+// Return sets up results that will be returned by UserDataService.ExportUserData
+func (mmExportUserData *mUserDataServiceMockExportUserData) Return(u1 models.UserDataExport, err error) *UserDataServiceMock {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UserDataServiceMock.ExportUserData mock is already set by Set")
+	}
+
+	if mmExportUserData.defaultExpectation == nil {
+		mmExportUserData.defaultExpectation = &UserDataServiceMockExportUserDataExpectation{mock: mmExportUserData.mock}
+	}
+	mmExportUserData.defaultExpectation.results = &UserDataServiceMockExportUserDataResults{u1, err}
+	mmExportUserData.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportUserData.mock
+}
+
+// Set uses given function f to mock the UserDataService.ExportUserData method
+func (mmExportUserData *mUserDataServiceMockExportUserData) Set(f func(ctx context.Context, userID uint64) (u1 models.UserDataExport, err error)) *UserDataServiceMock {
+	if mmExportUserData.defaultExpectation != nil {
+		mmExportUserData.mock.t.Fatalf("Default expectation is already set for the UserDataService.ExportUserData method")
+	}
+
+	if len(mmExportUserData.expectations) > 0 {
+		mmExportUserData.mock.t.Fatalf("Some expectations are already set for the UserDataService.ExportUserData method")
+	}
+
+	mmExportUserData.mock.funcExportUserData = f
+	mmExportUserData.mock.funcExportUserDataOrigin = minimock.CallerInfo(1)
+	return mmExportUserData.mock
+}
+
+// When sets expectation for the UserDataService.ExportUserData which will trigger the result defined by the following
+// Then helper
+func (mmExportUserData *mUserDataServiceMockExportUserData) When(ctx context.Context, userID uint64) *UserDataServiceMockExportUserDataExpectation {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UserDataServiceMock.ExportUserData mock is already set by Set")
+	}
+
+	expectation := &UserDataServiceMockExportUserDataExpectation{
+		mock:               mmExportUserData.mock,
+		params:             &UserDataServiceMockExportUserDataParams{ctx, userID},
+		expectationOrigins: UserDataServiceMockExportUserDataExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportUserData.expectations = append(mmExportUserData.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserDataService.ExportUserData return parameters for the expectation previously defined by the When method
+func (e *UserDataServiceMockExportUserDataExpectation) Then(u1 models.UserDataExport, err error) *UserDataServiceMock {
+	e.results = &UserDataServiceMockExportUserDataResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times UserDataService.ExportUserData should be invoked
+func (mmExportUserData *mUserDataServiceMockExportUserData) Times(n uint64) *mUserDataServiceMockExportUserData {
+	if n == 0 {
+		mmExportUserData.mock.t.Fatalf("Times of UserDataServiceMock.ExportUserData mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportUserData.expectedInvocations, n)
+	mmExportUserData.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportUserData
+}
+
+func (mmExportUserData *mUserDataServiceMockExportUserData) invocationsDone() bool {
+	if len(mmExportUserData.expectations) == 0 && mmExportUserData.defaultExpectation == nil && mmExportUserData.mock.funcExportUserData == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportUserData.mock.afterExportUserDataCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportUserData.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportUserData implements mm_services.UserDataService
+func (mmExportUserData *UserDataServiceMock) ExportUserData(ctx context.Context, userID uint64) (u1 models.UserDataExport, err error) {
+	mm_atomic.AddUint64(&mmExportUserData.beforeExportUserDataCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportUserData.afterExportUserDataCounter, 1)
+
+	mmExportUserData.t.Helper()
+
+	if mmExportUserData.inspectFuncExportUserData != nil {
+		mmExportUserData.inspectFuncExportUserData(ctx, userID)
+	}
+
+	mm_params := UserDataServiceMockExportUserDataParams{ctx, userID}
+
+	// Record call args
+	mmExportUserData.ExportUserDataMock.mutex.Lock()
+	mmExportUserData.ExportUserDataMock.callArgs = append(mmExportUserData.ExportUserDataMock.callArgs, &mm_params)
+	mmExportUserData.ExportUserDataMock.mutex.Unlock()
+
+	for _, e := range mmExportUserData.ExportUserDataMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmExportUserData.ExportUserDataMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportUserData.ExportUserDataMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportUserData.ExportUserDataMock.defaultExpectation.params
+		mm_want_ptrs := mmExportUserData.ExportUserDataMock.defaultExpectation.paramPtrs
+
+		mm_got := UserDataServiceMockExportUserDataParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportUserData.t.Errorf("UserDataServiceMock.ExportUserData got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportUserData.ExportUserDataMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmExportUserData.t.Errorf("UserDataServiceMock.ExportUserData got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportUserData.ExportUserDataMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportUserData.t.Errorf("UserDataServiceMock.ExportUserData got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportUserData.ExportUserDataMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportUserData.ExportUserDataMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportUserData.t.Fatal("No results are set for the UserDataServiceMock.ExportUserData")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmExportUserData.funcExportUserData != nil {
+		return mmExportUserData.funcExportUserData(ctx, userID)
+	}
+	mmExportUserData.t.Fatalf("Unexpected call to UserDataServiceMock.ExportUserData. %v %v", ctx, userID)
+	return
+}
+
+// ExportUserDataAfterCounter returns a count of finished UserDataServiceMock.ExportUserData invocations
+func (mmExportUserData *UserDataServiceMock) ExportUserDataAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportUserData.afterExportUserDataCounter)
+}
+
+// ExportUserDataBeforeCounter returns a count of UserDataServiceMock.ExportUserData invocations
+func (mmExportUserData *UserDataServiceMock) ExportUserDataBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportUserData.beforeExportUserDataCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserDataServiceMock.ExportUserData.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportUserData *mUserDataServiceMockExportUserData) Calls() []*UserDataServiceMockExportUserDataParams {
+	mmExportUserData.mutex.RLock()
+
+	argCopy := make([]*UserDataServiceMockExportUserDataParams, len(mmExportUserData.callArgs))
+	copy(argCopy, mmExportUserData.callArgs)
+
+	mmExportUserData.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportUserDataDone returns true if the count of the ExportUserData invocations corresponds
+// the number of defined expectations
+func (m *UserDataServiceMock) MinimockExportUserDataDone() bool {
+	if m.ExportUserDataMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportUserDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportUserDataMock.invocationsDone()
+}
+
+// MinimockExportUserDataInspect logs each unmet expectation
+func (m *UserDataServiceMock) MinimockExportUserDataInspect() {
+	for _, e := range m.ExportUserDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserDataServiceMock.ExportUserData at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportUserDataCounter := mm_atomic.LoadUint64(&m.afterExportUserDataCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportUserDataMock.defaultExpectation != nil && afterExportUserDataCounter < 1 {
+		if m.ExportUserDataMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UserDataServiceMock.ExportUserData at\n%s", m.ExportUserDataMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UserDataServiceMock.ExportUserData at\n%s with params: %#v", m.ExportUserDataMock.defaultExpectation.expectationOrigins.origin, *m.ExportUserDataMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportUserData != nil && afterExportUserDataCounter < 1 {
+		m.t.Errorf("Expected call to UserDataServiceMock.ExportUserData at\n%s", m.funcExportUserDataOrigin)
+	}
+
+	if !m.ExportUserDataMock.invocationsDone() && afterExportUserDataCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserDataServiceMock.ExportUserData at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportUserDataMock.expectedInvocations), m.ExportUserDataMock.expectedInvocationsOrigin, afterExportUserDataCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserDataServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAnonymizeUserDataInspect()
+
+			m.MinimockExportUserDataInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserDataServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserDataServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAnonymizeUserDataDone() &&
+		m.MinimockExportUserDataDone()
+}
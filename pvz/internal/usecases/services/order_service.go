@@ -10,9 +10,12 @@ import (
 type OrderService interface {
 	AcceptOrder(ctx context.Context, req requests.AcceptOrderRequest) (models.Order, error)
 	IssueOrders(ctx context.Context, req requests.IssueOrdersRequest) ([]models.BatchEntryProcessedResult, error)
+	IssueAllReady(ctx context.Context, req requests.IssueAllReadyRequest) (models.IssueReceipt, error)
 	ListOrders(ctx context.Context, filter requests.OrdersFilterRequest) ([]models.Order, uint64, int, error)
 	CreateClientReturns(ctx context.Context, req requests.ClientReturnsRequest) ([]models.BatchEntryProcessedResult, error)
 	ReturnToCourier(ctx context.Context, req requests.ReturnOrderRequest) error
 	ListReturns(ctx context.Context, filter requests.OrdersFilterRequest) ([]models.Order, error)
+	GetOrder(ctx context.Context, orderID uint64) (models.OrderDetails, error)
 	ImportOrders(ctx context.Context, req requests.ImportOrdersRequest) ([]models.BatchEntryProcessedResult, error)
+	UndoLastOperation(ctx context.Context, req requests.UndoRequest) (models.UndoResult, error)
 }
@@ -3,7 +3,7 @@ package services
 
 import "pvz-cli/internal/models"
 
-// PackagePricingService calculates package pricing and validates weight constraints
+// PackagePricingService calculates package pricing and validates weight and handling constraints
 type PackagePricingService interface {
-	Evaluate(pkg models.PackageType, weight, price float32) (surcharge float32, err error)
+	Evaluate(pkg models.PackageType, weight, price float32, flags models.HandlingFlags) (surcharge float32, err error)
 }
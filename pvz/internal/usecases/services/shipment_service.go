@@ -0,0 +1,15 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package services
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+)
+
+// ShipmentService handles outbound parcel drop-offs: registration, hand-over to a courier and tracking
+type ShipmentService interface {
+	RegisterShipment(ctx context.Context, req requests.RegisterShipmentRequest) (models.Shipment, error)
+	HandOverShipment(ctx context.Context, req requests.HandOverShipmentRequest) (models.Shipment, error)
+	GetShipment(ctx context.Context, id uint64) (models.Shipment, []models.ShipmentHistoryEntry, error)
+}
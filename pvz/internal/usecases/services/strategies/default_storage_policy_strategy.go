@@ -0,0 +1,40 @@
+package strategies
+
+import (
+	"fmt"
+	"pvz-cli/internal/models"
+)
+
+const defaultStoragePolicyName = "default"
+
+var _ StoragePolicyStrategy = (*DefaultStoragePolicyStrategy)(nil)
+
+// DefaultStoragePolicyStrategy is a default implementation of the StoragePolicyStrategy interface.
+// A category policy takes precedence over a package policy, which takes precedence over the default one.
+type DefaultStoragePolicyStrategy struct {
+	defaultDays  int
+	packageDays  map[string]int
+	categoryDays map[string]int
+}
+
+// NewDefaultStoragePolicyStrategy creates a new instance of DefaultStoragePolicyStrategy.
+// Package days are keyed by package name (e.g. "box+film"), category days by category name (e.g. "hazardous").
+func NewDefaultStoragePolicyStrategy(defaultDays int, packageDays, categoryDays map[string]int) *DefaultStoragePolicyStrategy {
+	return &DefaultStoragePolicyStrategy{
+		defaultDays:  defaultDays,
+		packageDays:  packageDays,
+		categoryDays: categoryDays,
+	}
+}
+
+// Resolve returns the storage policy applicable to the given package type and handling flags.
+func (d *DefaultStoragePolicyStrategy) Resolve(pkg models.PackageType, flags models.HandlingFlags) models.StoragePolicy {
+	category := flags.Category()
+	if days, ok := d.categoryDays[string(category)]; ok {
+		return models.StoragePolicy{Name: fmt.Sprintf("category:%s", category), Days: days}
+	}
+	if days, ok := d.packageDays[pkg.String()]; ok {
+		return models.StoragePolicy{Name: fmt.Sprintf("package:%s", pkg), Days: days}
+	}
+	return models.StoragePolicy{Name: defaultStoragePolicyName, Days: d.defaultDays}
+}
@@ -0,0 +1,414 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// StoragePolicyStrategyMock implements mm_strategies.StoragePolicyStrategy
+type StoragePolicyStrategyMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcResolve          func(pkg models.PackageType, flags models.HandlingFlags) (s1 models.StoragePolicy)
+	funcResolveOrigin    string
+	inspectFuncResolve   func(pkg models.PackageType, flags models.HandlingFlags)
+	afterResolveCounter  uint64
+	beforeResolveCounter uint64
+	ResolveMock          mStoragePolicyStrategyMockResolve
+}
+
+// NewStoragePolicyStrategyMock returns a mock for mm_strategies.StoragePolicyStrategy
+func NewStoragePolicyStrategyMock(t minimock.Tester) *StoragePolicyStrategyMock {
+	m := &StoragePolicyStrategyMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ResolveMock = mStoragePolicyStrategyMockResolve{mock: m}
+	m.ResolveMock.callArgs = []*StoragePolicyStrategyMockResolveParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mStoragePolicyStrategyMockResolve struct {
+	optional           bool
+	mock               *StoragePolicyStrategyMock
+	defaultExpectation *StoragePolicyStrategyMockResolveExpectation
+	expectations       []*StoragePolicyStrategyMockResolveExpectation
+
+	callArgs []*StoragePolicyStrategyMockResolveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StoragePolicyStrategyMockResolveExpectation specifies expectation struct of the StoragePolicyStrategy.Resolve
+type StoragePolicyStrategyMockResolveExpectation struct {
+	mock               *StoragePolicyStrategyMock
+	params             *StoragePolicyStrategyMockResolveParams
+	paramPtrs          *StoragePolicyStrategyMockResolveParamPtrs
+	expectationOrigins StoragePolicyStrategyMockResolveExpectationOrigins
+	results            *StoragePolicyStrategyMockResolveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StoragePolicyStrategyMockResolveParams contains parameters of the StoragePolicyStrategy.Resolve
+type StoragePolicyStrategyMockResolveParams struct {
+	pkg   models.PackageType
+	flags models.HandlingFlags
+}
+
+// StoragePolicyStrategyMockResolveParamPtrs contains pointers to parameters of the StoragePolicyStrategy.Resolve
+type StoragePolicyStrategyMockResolveParamPtrs struct {
+	pkg   *models.PackageType
+	flags *models.HandlingFlags
+}
+
+// StoragePolicyStrategyMockResolveResults contains results of the StoragePolicyStrategy.Resolve
+type StoragePolicyStrategyMockResolveResults struct {
+	s1 models.StoragePolicy
+}
+
+// StoragePolicyStrategyMockResolveOrigins contains origins of expectations of the StoragePolicyStrategy.Resolve
+type StoragePolicyStrategyMockResolveExpectationOrigins struct {
+	origin      string
+	originPkg   string
+	originFlags string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResolve *mStoragePolicyStrategyMockResolve) Optional() *mStoragePolicyStrategyMockResolve {
+	mmResolve.optional = true
+	return mmResolve
+}
+
+// Expect sets up expected params for StoragePolicyStrategy.Resolve
+func (mmResolve *mStoragePolicyStrategyMockResolve) Expect(pkg models.PackageType, flags models.HandlingFlags) *mStoragePolicyStrategyMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("StoragePolicyStrategyMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &StoragePolicyStrategyMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs != nil {
+		mmResolve.mock.t.Fatalf("StoragePolicyStrategyMock.Resolve mock is already set by ExpectParams functions")
+	}
+
+	mmResolve.defaultExpectation.params = &StoragePolicyStrategyMockResolveParams{pkg, flags}
+	mmResolve.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResolve.expectations {
+		if minimock.Equal(e.params, mmResolve.defaultExpectation.params) {
+			mmResolve.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResolve.defaultExpectation.params)
+		}
+	}
+
+	return mmResolve
+}
+
+// ExpectPkgParam1 sets up expected param pkg for StoragePolicyStrategy.Resolve
+func (mmResolve *mStoragePolicyStrategyMockResolve) ExpectPkgParam1(pkg models.PackageType) *mStoragePolicyStrategyMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("StoragePolicyStrategyMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &StoragePolicyStrategyMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("StoragePolicyStrategyMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &StoragePolicyStrategyMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.pkg = &pkg
+	mmResolve.defaultExpectation.expectationOrigins.originPkg = minimock.CallerInfo(1)
+
+	return mmResolve
+}
+
+// ExpectFlagsParam2 sets up expected param flags for StoragePolicyStrategy.Resolve
+func (mmResolve *mStoragePolicyStrategyMockResolve) ExpectFlagsParam2(flags models.HandlingFlags) *mStoragePolicyStrategyMockResolve {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("StoragePolicyStrategyMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &StoragePolicyStrategyMockResolveExpectation{}
+	}
+
+	if mmResolve.defaultExpectation.params != nil {
+		mmResolve.mock.t.Fatalf("StoragePolicyStrategyMock.Resolve mock is already set by Expect")
+	}
+
+	if mmResolve.defaultExpectation.paramPtrs == nil {
+		mmResolve.defaultExpectation.paramPtrs = &StoragePolicyStrategyMockResolveParamPtrs{}
+	}
+	mmResolve.defaultExpectation.paramPtrs.flags = &flags
+	mmResolve.defaultExpectation.expectationOrigins.originFlags = minimock.CallerInfo(1)
+
+	return mmResolve
+}
+
+// Inspect accepts an inspector function that has same arguments as the StoragePolicyStrategy.Resolve
+func (mmResolve *mStoragePolicyStrategyMockResolve) Inspect(f func(pkg models.PackageType, flags models.HandlingFlags)) *mStoragePolicyStrategyMockResolve {
+	if mmResolve.mock.inspectFuncResolve != nil {
+		mmResolve.mock.t.Fatalf("Inspect function is already set for StoragePolicyStrategyMock.Resolve")
+	}
+
+	mmResolve.mock.inspectFuncResolve = f
+
+	return mmResolve
+}
+
+// Return sets up results that will be returned by StoragePolicyStrategy.Resolve
+func (mmResolve *mStoragePolicyStrategyMockResolve) Return(s1 models.StoragePolicy) *StoragePolicyStrategyMock {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("StoragePolicyStrategyMock.Resolve mock is already set by Set")
+	}
+
+	if mmResolve.defaultExpectation == nil {
+		mmResolve.defaultExpectation = &StoragePolicyStrategyMockResolveExpectation{mock: mmResolve.mock}
+	}
+	mmResolve.defaultExpectation.results = &StoragePolicyStrategyMockResolveResults{s1}
+	mmResolve.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResolve.mock
+}
+
+// Set uses given function f to mock the StoragePolicyStrategy.Resolve method
+func (mmResolve *mStoragePolicyStrategyMockResolve) Set(f func(pkg models.PackageType, flags models.HandlingFlags) (s1 models.StoragePolicy)) *StoragePolicyStrategyMock {
+	if mmResolve.defaultExpectation != nil {
+		mmResolve.mock.t.Fatalf("Default expectation is already set for the StoragePolicyStrategy.Resolve method")
+	}
+
+	if len(mmResolve.expectations) > 0 {
+		mmResolve.mock.t.Fatalf("Some expectations are already set for the StoragePolicyStrategy.Resolve method")
+	}
+
+	mmResolve.mock.funcResolve = f
+	mmResolve.mock.funcResolveOrigin = minimock.CallerInfo(1)
+	return mmResolve.mock
+}
+
+// When sets expectation for the StoragePolicyStrategy.Resolve which will trigger the result defined by the following
+// Then helper
+func (mmResolve *mStoragePolicyStrategyMockResolve) When(pkg models.PackageType, flags models.HandlingFlags) *StoragePolicyStrategyMockResolveExpectation {
+	if mmResolve.mock.funcResolve != nil {
+		mmResolve.mock.t.Fatalf("StoragePolicyStrategyMock.Resolve mock is already set by Set")
+	}
+
+	expectation := &StoragePolicyStrategyMockResolveExpectation{
+		mock:               mmResolve.mock,
+		params:             &StoragePolicyStrategyMockResolveParams{pkg, flags},
+		expectationOrigins: StoragePolicyStrategyMockResolveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmResolve.expectations = append(mmResolve.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StoragePolicyStrategy.Resolve return parameters for the expectation previously defined by the When method
+func (e *StoragePolicyStrategyMockResolveExpectation) Then(s1 models.StoragePolicy) *StoragePolicyStrategyMock {
+	e.results = &StoragePolicyStrategyMockResolveResults{s1}
+	return e.mock
+}
+
+// Times sets number of times StoragePolicyStrategy.Resolve should be invoked
+func (mmResolve *mStoragePolicyStrategyMockResolve) Times(n uint64) *mStoragePolicyStrategyMockResolve {
+	if n == 0 {
+		mmResolve.mock.t.Fatalf("Times of StoragePolicyStrategyMock.Resolve mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResolve.expectedInvocations, n)
+	mmResolve.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResolve
+}
+
+func (mmResolve *mStoragePolicyStrategyMockResolve) invocationsDone() bool {
+	if len(mmResolve.expectations) == 0 && mmResolve.defaultExpectation == nil && mmResolve.mock.funcResolve == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResolve.mock.afterResolveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResolve.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Resolve implements mm_strategies.StoragePolicyStrategy
+func (mmResolve *StoragePolicyStrategyMock) Resolve(pkg models.PackageType, flags models.HandlingFlags) (s1 models.StoragePolicy) {
+	mm_atomic.AddUint64(&mmResolve.beforeResolveCounter, 1)
+	defer mm_atomic.AddUint64(&mmResolve.afterResolveCounter, 1)
+
+	mmResolve.t.Helper()
+
+	if mmResolve.inspectFuncResolve != nil {
+		mmResolve.inspectFuncResolve(pkg, flags)
+	}
+
+	mm_params := StoragePolicyStrategyMockResolveParams{pkg, flags}
+
+	// Record call args
+	mmResolve.ResolveMock.mutex.Lock()
+	mmResolve.ResolveMock.callArgs = append(mmResolve.ResolveMock.callArgs, &mm_params)
+	mmResolve.ResolveMock.mutex.Unlock()
+
+	for _, e := range mmResolve.ResolveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1
+		}
+	}
+
+	if mmResolve.ResolveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResolve.ResolveMock.defaultExpectation.Counter, 1)
+		mm_want := mmResolve.ResolveMock.defaultExpectation.params
+		mm_want_ptrs := mmResolve.ResolveMock.defaultExpectation.paramPtrs
+
+		mm_got := StoragePolicyStrategyMockResolveParams{pkg, flags}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.pkg != nil && !minimock.Equal(*mm_want_ptrs.pkg, mm_got.pkg) {
+				mmResolve.t.Errorf("StoragePolicyStrategyMock.Resolve got unexpected parameter pkg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResolve.ResolveMock.defaultExpectation.expectationOrigins.originPkg, *mm_want_ptrs.pkg, mm_got.pkg, minimock.Diff(*mm_want_ptrs.pkg, mm_got.pkg))
+			}
+
+			if mm_want_ptrs.flags != nil && !minimock.Equal(*mm_want_ptrs.flags, mm_got.flags) {
+				mmResolve.t.Errorf("StoragePolicyStrategyMock.Resolve got unexpected parameter flags, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResolve.ResolveMock.defaultExpectation.expectationOrigins.originFlags, *mm_want_ptrs.flags, mm_got.flags, minimock.Diff(*mm_want_ptrs.flags, mm_got.flags))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResolve.t.Errorf("StoragePolicyStrategyMock.Resolve got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResolve.ResolveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResolve.ResolveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResolve.t.Fatal("No results are set for the StoragePolicyStrategyMock.Resolve")
+		}
+		return (*mm_results).s1
+	}
+	if mmResolve.funcResolve != nil {
+		return mmResolve.funcResolve(pkg, flags)
+	}
+	mmResolve.t.Fatalf("Unexpected call to StoragePolicyStrategyMock.Resolve. %v %v", pkg, flags)
+	return
+}
+
+// ResolveAfterCounter returns a count of finished StoragePolicyStrategyMock.Resolve invocations
+func (mmResolve *StoragePolicyStrategyMock) ResolveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolve.afterResolveCounter)
+}
+
+// ResolveBeforeCounter returns a count of StoragePolicyStrategyMock.Resolve invocations
+func (mmResolve *StoragePolicyStrategyMock) ResolveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResolve.beforeResolveCounter)
+}
+
+// Calls returns a list of arguments used in each call to StoragePolicyStrategyMock.Resolve.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResolve *mStoragePolicyStrategyMockResolve) Calls() []*StoragePolicyStrategyMockResolveParams {
+	mmResolve.mutex.RLock()
+
+	argCopy := make([]*StoragePolicyStrategyMockResolveParams, len(mmResolve.callArgs))
+	copy(argCopy, mmResolve.callArgs)
+
+	mmResolve.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResolveDone returns true if the count of the Resolve invocations corresponds
+// the number of defined expectations
+func (m *StoragePolicyStrategyMock) MinimockResolveDone() bool {
+	if m.ResolveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResolveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResolveMock.invocationsDone()
+}
+
+// MinimockResolveInspect logs each unmet expectation
+func (m *StoragePolicyStrategyMock) MinimockResolveInspect() {
+	for _, e := range m.ResolveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StoragePolicyStrategyMock.Resolve at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterResolveCounter := mm_atomic.LoadUint64(&m.afterResolveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResolveMock.defaultExpectation != nil && afterResolveCounter < 1 {
+		if m.ResolveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StoragePolicyStrategyMock.Resolve at\n%s", m.ResolveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StoragePolicyStrategyMock.Resolve at\n%s with params: %#v", m.ResolveMock.defaultExpectation.expectationOrigins.origin, *m.ResolveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResolve != nil && afterResolveCounter < 1 {
+		m.t.Errorf("Expected call to StoragePolicyStrategyMock.Resolve at\n%s", m.funcResolveOrigin)
+	}
+
+	if !m.ResolveMock.invocationsDone() && afterResolveCounter > 0 {
+		m.t.Errorf("Expected %d calls to StoragePolicyStrategyMock.Resolve at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResolveMock.expectedInvocations), m.ResolveMock.expectedInvocationsOrigin, afterResolveCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *StoragePolicyStrategyMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockResolveInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *StoragePolicyStrategyMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *StoragePolicyStrategyMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockResolveDone()
+}
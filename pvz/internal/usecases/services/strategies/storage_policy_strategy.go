@@ -0,0 +1,9 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package strategies
+
+import "pvz-cli/internal/models"
+
+// StoragePolicyStrategy defines the interface for choosing the storage policy of an order without an explicit expiry date.
+type StoragePolicyStrategy interface {
+	Resolve(pkg models.PackageType, flags models.HandlingFlags) models.StoragePolicy
+}
@@ -0,0 +1,13 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package services
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+)
+
+// UserDataService handles requests of clients to export or erase their personal data
+type UserDataService interface {
+	ExportUserData(ctx context.Context, userID uint64) (models.UserDataExport, error)
+	AnonymizeUserData(ctx context.Context, userID uint64) (models.AnonymizeResult, error)
+}
@@ -0,0 +1,9 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package validators
+
+import "pvz-cli/internal/models"
+
+// CalendarProvider supplies the pickup-point calendar currently in effect
+type CalendarProvider interface {
+	Current() models.PickupCalendar
+}
@@ -6,30 +6,44 @@ import (
 	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
 	"pvz-cli/pkg/clock"
+	"time"
 )
 
 var _ OrderValidator = (*DefaultOrderValidator)(nil)
 
 // DefaultOrderValidator is a default implementation of the OrderValidator interface.
 type DefaultOrderValidator struct {
-	clk clock.Clock
+	clk      clock.Clock
+	calendar CalendarProvider
 }
 
 // NewDefaultOrderValidator creates a new instance of DefaultOrderValidator.
-func NewDefaultOrderValidator(clk clock.Clock) *DefaultOrderValidator {
+func NewDefaultOrderValidator(clk clock.Clock, calendar CalendarProvider) *DefaultOrderValidator {
 	return &DefaultOrderValidator{
-		clk: clk,
+		clk:      clk,
+		calendar: calendar,
 	}
 }
 
 // ValidateAccept validates order acceptance requirements including expiry date and duplicates
+// The opening hours are checked at the moment the order was submitted, when the request carries one.
 func (v *DefaultOrderValidator) ValidateAccept(o models.Order, req requests.AcceptOrderRequest) error {
+	submittedAt := req.SubmittedAt
+	if submittedAt.IsZero() {
+		submittedAt = v.clk.Now()
+	}
+	if err := v.ensureOpenAt(submittedAt); err != nil {
+		return err
+	}
 	if req.ExpiresAt.Before(v.clk.Now()) {
 		return apperrors.Newf(apperrors.ValidationFailed, "expires date is in the past")
 	}
 	if o.OrderID != 0 {
 		return apperrors.Newf(apperrors.OrderAlreadyExists, "order already exists")
 	}
+	if req.Handling.Hazardous && req.ExpiresAt.Sub(v.clk.Now()) > constants.HazardousMaxStorage {
+		return apperrors.Newf(apperrors.ValidationFailed, "hazardous order cannot be stored longer than %s", constants.HazardousMaxStorage)
+	}
 	return nil
 }
 
@@ -38,6 +52,9 @@ func (v *DefaultOrderValidator) ValidateIssue(o models.Order, req requests.Issue
 	if len(req.OrderIDs) == 0 {
 		return apperrors.Newf(apperrors.ValidationFailed, "no order IDs provided")
 	}
+	if err := v.ensureOpen(); err != nil {
+		return err
+	}
 	now := v.clk.Now()
 	if o.UserID != req.UserID {
 		return apperrors.Newf(apperrors.ValidationFailed, "order %d belongs to different user", o.OrderID)
@@ -48,6 +65,9 @@ func (v *DefaultOrderValidator) ValidateIssue(o models.Order, req requests.Issue
 	if o.ExpiresAt.Before(now) {
 		return apperrors.Newf(apperrors.StorageExpired, "order %d storage period expired", o.OrderID)
 	}
+	if o.AgeRestricted && !req.IdentityVerified {
+		return apperrors.Newf(apperrors.IdentityRequired, "order %d is age-restricted, identity verification required", o.OrderID)
+	}
 	return nil
 }
 
@@ -56,6 +76,9 @@ func (v *DefaultOrderValidator) ValidateClientReturn(o models.Order, req request
 	if len(req.OrderIDs) == 0 {
 		return apperrors.Newf(apperrors.ValidationFailed, "no order IDs provided")
 	}
+	if err := v.ensureOpen(); err != nil {
+		return err
+	}
 	now := v.clk.Now()
 	if o.UserID != req.UserID {
 		return apperrors.Newf(apperrors.ValidationFailed, "order %d belongs to another user", o.OrderID)
@@ -64,7 +87,7 @@ func (v *DefaultOrderValidator) ValidateClientReturn(o models.Order, req request
 		return apperrors.Newf(apperrors.ValidationFailed, "order %d status is %s, not ISSUED", o.OrderID, o.Status)
 	}
 
-	if now.Sub(o.UpdatedStatusAt) > constants.ReturnWindow {
+	if now.After(v.calendar.Current().Deadline(o.UpdatedStatusAt, constants.ReturnWindow)) {
 		return apperrors.Newf(apperrors.ValidationFailed, "return window expired for order %d", o.OrderID)
 	}
 	return nil
@@ -72,6 +95,9 @@ func (v *DefaultOrderValidator) ValidateClientReturn(o models.Order, req request
 
 // ValidateReturnToCourier validates order return to courier including status and expiration
 func (v *DefaultOrderValidator) ValidateReturnToCourier(o models.Order) error {
+	if err := v.ensureOpen(); err != nil {
+		return err
+	}
 	if o.Status == models.Returned {
 		return nil
 	}
@@ -84,3 +110,14 @@ func (v *DefaultOrderValidator) ValidateReturnToCourier(o models.Order) error {
 	}
 	return nil
 }
+
+func (v *DefaultOrderValidator) ensureOpen() error {
+	return v.ensureOpenAt(v.clk.Now())
+}
+
+func (v *DefaultOrderValidator) ensureOpenAt(t time.Time) error {
+	if !v.calendar.Current().IsOpenAt(t) {
+		return apperrors.Newf(apperrors.PickupPointClosed, "pickup point is closed at the moment")
+	}
+	return nil
+}
@@ -11,12 +11,13 @@ import (
 	"pvz-cli/internal/common/constants"
 	"pvz-cli/internal/models"
 	"pvz-cli/internal/usecases/requests"
+	valmocks "pvz-cli/internal/usecases/services/validators/mocks"
 )
 
 // TestDefaultOrderValidator_ValidateAccept tests the ValidateAccept function of DefaultOrderValidator for various scenarios.
 func TestDefaultOrderValidator_ValidateAccept(t *testing.T) {
 	clk := &clock.FakeClock{}
-	v := NewDefaultOrderValidator(clk)
+	v := NewDefaultOrderValidator(clk, newCalendarProvider(t, models.PickupCalendar{}))
 	now := clk.Now()
 	tests := []struct {
 		name      string
@@ -45,6 +46,25 @@ func TestDefaultOrderValidator_ValidateAccept(t *testing.T) {
 			expectErr: true,
 			wantCode:  string(apperrors.OrderAlreadyExists),
 		},
+		{
+			name:  "hazardous within storage limit",
+			order: builders.NewOrderBuilder(clk).WithID(0).Build(),
+			req: requests.AcceptOrderRequest{
+				ExpiresAt: now.Add(constants.HazardousMaxStorage),
+				Handling:  models.HandlingFlags{Hazardous: true},
+			},
+			expectErr: false,
+		},
+		{
+			name:  "hazardous storage limit exceeded",
+			order: builders.NewOrderBuilder(clk).WithID(0).Build(),
+			req: requests.AcceptOrderRequest{
+				ExpiresAt: now.Add(constants.HazardousMaxStorage + time.Hour),
+				Handling:  models.HandlingFlags{Hazardous: true},
+			},
+			expectErr: true,
+			wantCode:  string(apperrors.ValidationFailed),
+		},
 	}
 
 	for _, tt := range tests {
@@ -65,7 +85,7 @@ func TestDefaultOrderValidator_ValidateAccept(t *testing.T) {
 // TestDefaultOrderValidator_ValidateIssue tests the ValidateIssue method of DefaultOrderValidator for various input scenarios.
 func TestDefaultOrderValidator_ValidateIssue(t *testing.T) {
 	clk := &clock.FakeClock{}
-	v := NewDefaultOrderValidator(clk)
+	v := NewDefaultOrderValidator(clk, newCalendarProvider(t, models.PickupCalendar{}))
 	now := clk.Now()
 	baseOrder := models.Order{
 		OrderID:   1,
@@ -73,6 +93,8 @@ func TestDefaultOrderValidator_ValidateIssue(t *testing.T) {
 		Status:    models.Accepted,
 		ExpiresAt: now.Add(time.Hour),
 	}
+	ageRestrictedOrder := baseOrder
+	ageRestrictedOrder.AgeRestricted = true
 	tests := []struct {
 		name      string
 		order     models.Order
@@ -117,6 +139,19 @@ func TestDefaultOrderValidator_ValidateIssue(t *testing.T) {
 			req:       requests.IssueOrdersRequest{UserID: 100, OrderIDs: []uint64{1}},
 			expectErr: true,
 			wantCode:  string(apperrors.StorageExpired)},
+		{
+			name:      "age restricted without identity",
+			order:     ageRestrictedOrder,
+			req:       requests.IssueOrdersRequest{UserID: 100, OrderIDs: []uint64{1}},
+			expectErr: true,
+			wantCode:  string(apperrors.IdentityRequired),
+		},
+		{
+			name:      "age restricted with identity",
+			order:     ageRestrictedOrder,
+			req:       requests.IssueOrdersRequest{UserID: 100, OrderIDs: []uint64{1}, IdentityVerified: true},
+			expectErr: false,
+		},
 		{
 			name:      "ok",
 			order:     baseOrder,
@@ -143,7 +178,7 @@ func TestDefaultOrderValidator_ValidateIssue(t *testing.T) {
 // TestDefaultOrderValidator_ValidateClientReturn tests the validation logic for client return requests.
 func TestDefaultOrderValidator_ValidateClientReturn(t *testing.T) {
 	clk := &clock.FakeClock{}
-	v := NewDefaultOrderValidator(clk)
+	v := NewDefaultOrderValidator(clk, newCalendarProvider(t, models.PickupCalendar{}))
 	now := clk.Now()
 	baseOrder := builders.NewOrderBuilder(clk).
 		WithID(2).
@@ -222,7 +257,7 @@ func TestDefaultOrderValidator_ValidateClientReturn(t *testing.T) {
 
 func TestDefaultOrderValidator_ValidateReturnToCourier(t *testing.T) {
 	clk := &clock.FakeClock{}
-	v := NewDefaultOrderValidator(clk)
+	v := NewDefaultOrderValidator(clk, newCalendarProvider(t, models.PickupCalendar{}))
 	now := clk.Now()
 	tests := []struct {
 		name      string
@@ -283,3 +318,109 @@ func TestDefaultOrderValidator_ValidateReturnToCourier(t *testing.T) {
 		})
 	}
 }
+
+// TestDefaultOrderValidator_Calendar tests that validation respects pickup-point opening hours, days off and business-day deadlines.
+func TestDefaultOrderValidator_Calendar(t *testing.T) {
+	clk := &clock.FakeClock{}
+	now := clk.Now()
+	issued := builders.NewOrderBuilder(clk).
+		WithID(2).
+		WithUserID(200).
+		WithStatus(models.Issued).
+		WithUpdatedStatusAt(now.Add(-3 * 24 * time.Hour)).
+		Build()
+	returnReq := requests.ClientReturnsRequest{UserID: 200, OrderIDs: []uint64{2}}
+	tests := []struct {
+		name      string
+		calendar  models.PickupCalendar
+		validate  func(v *DefaultOrderValidator) error
+		expectErr bool
+		wantCode  string
+	}{
+		{
+			name:     "closed outside opening hours",
+			calendar: models.PickupCalendar{OpensAt: "08:00", ClosesAt: "11:00"},
+			validate: func(v *DefaultOrderValidator) error {
+				return v.ValidateAccept(models.Order{}, requests.AcceptOrderRequest{ExpiresAt: now.Add(time.Hour)})
+			},
+			expectErr: true,
+			wantCode:  string(apperrors.PickupPointClosed),
+		},
+		{
+			name:     "accept checked at the submission time",
+			calendar: models.PickupCalendar{OpensAt: "08:00", ClosesAt: "11:00"},
+			validate: func(v *DefaultOrderValidator) error {
+				return v.ValidateAccept(models.Order{}, requests.AcceptOrderRequest{
+					ExpiresAt:   now.Add(time.Hour),
+					SubmittedAt: now.Add(-2 * time.Hour),
+				})
+			},
+			expectErr: false,
+		},
+		{
+			name:     "open within opening hours",
+			calendar: models.PickupCalendar{OpensAt: "08:00", ClosesAt: "20:00"},
+			validate: func(v *DefaultOrderValidator) error {
+				return v.ValidateAccept(models.Order{}, requests.AcceptOrderRequest{ExpiresAt: now.Add(time.Hour)})
+			},
+			expectErr: false,
+		},
+		{
+			name:     "closed on weekly day off",
+			calendar: models.PickupCalendar{WeeklyDaysOff: []time.Weekday{now.Weekday()}},
+			validate: func(v *DefaultOrderValidator) error {
+				return v.ValidateReturnToCourier(models.Order{Status: models.Returned})
+			},
+			expectErr: true,
+			wantCode:  string(apperrors.PickupPointClosed),
+		},
+		{
+			name:     "closed on holiday",
+			calendar: models.PickupCalendar{Holidays: []time.Time{now.Truncate(24 * time.Hour)}},
+			validate: func(v *DefaultOrderValidator) error {
+				return v.ValidateClientReturn(issued, returnReq)
+			},
+			expectErr: true,
+			wantCode:  string(apperrors.PickupPointClosed),
+		},
+		{
+			name:     "return window in calendar days expired",
+			calendar: models.PickupCalendar{Holidays: []time.Time{now.Add(-2 * 24 * time.Hour)}},
+			validate: func(v *DefaultOrderValidator) error {
+				return v.ValidateClientReturn(issued, returnReq)
+			},
+			expectErr: true,
+			wantCode:  string(apperrors.ValidationFailed),
+		},
+		{
+			name: "return window in business days skips holiday",
+			calendar: models.PickupCalendar{
+				Holidays:     []time.Time{now.Add(-2 * 24 * time.Hour)},
+				BusinessDays: true,
+			},
+			validate: func(v *DefaultOrderValidator) error {
+				return v.ValidateClientReturn(issued, returnReq)
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v := NewDefaultOrderValidator(clk, newCalendarProvider(t, tt.calendar))
+			err := tt.validate(v)
+			if tt.expectErr {
+				require.Error(t, err)
+				require.Equal(t, tt.wantCode, apperrors.CodeFromError(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newCalendarProvider(t *testing.T, cal models.PickupCalendar) *valmocks.CalendarProviderMock {
+	return valmocks.NewCalendarProviderMock(t).CurrentMock.Optional().Return(cal)
+}
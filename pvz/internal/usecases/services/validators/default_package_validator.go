@@ -2,6 +2,7 @@ package validators
 
 import (
 	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/common/constants"
 	"pvz-cli/internal/models"
 )
 
@@ -15,8 +16,8 @@ func NewDefaultPackageValidator() *DefaultPackageValidator {
 	return &DefaultPackageValidator{}
 }
 
-// Validate checks if package type is valid and supports given weight
-func (v *DefaultPackageValidator) Validate(pkg models.PackageType, weight float32) error {
+// Validate checks if package type is valid, supports given weight and is compatible with handling flags
+func (v *DefaultPackageValidator) Validate(pkg models.PackageType, weight float32, flags models.HandlingFlags) error {
 	if !v.isValidPackageType(pkg) {
 		return apperrors.Newf(apperrors.InvalidPackage, "package type is not valid")
 	}
@@ -30,6 +31,21 @@ func (v *DefaultPackageValidator) Validate(pkg models.PackageType, weight float3
 			return apperrors.Newf(apperrors.WeightTooHeavy, "box not suitable for weight >= 30kg")
 		}
 	}
+	return v.validateHandling(pkg, weight, flags)
+}
+
+func (v *DefaultPackageValidator) validateHandling(pkg models.PackageType, weight float32, flags models.HandlingFlags) error {
+	if flags.Fragile && (pkg == models.PackageBag || pkg == models.PackageBagFilm) {
+		return apperrors.Newf(apperrors.InvalidPackage, "fragile order cannot be packed in a bag")
+	}
+	if flags.Hazardous {
+		if pkg != models.PackageBox && pkg != models.PackageBoxFilm {
+			return apperrors.Newf(apperrors.InvalidPackage, "hazardous order must be packed in a box")
+		}
+		if weight >= constants.HazardousMaxWeight {
+			return apperrors.Newf(apperrors.WeightTooHeavy, "hazardous order not storable for weight >= %dkg", constants.HazardousMaxWeight)
+		}
+	}
 	return nil
 }
 
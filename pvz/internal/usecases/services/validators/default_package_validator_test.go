@@ -15,6 +15,7 @@ func TestDefaultPackageValidator_Validate(t *testing.T) {
 		name      string
 		pkg       models.PackageType
 		weight    float32
+		flags     models.HandlingFlags
 		expectErr bool
 		wantCode  string
 	}{
@@ -56,6 +57,52 @@ func TestDefaultPackageValidator_Validate(t *testing.T) {
 			weight:    239,
 			expectErr: false,
 		},
+		{
+			name:      "valid: fragile in box",
+			pkg:       models.PackageBox,
+			weight:    5,
+			flags:     models.HandlingFlags{Fragile: true},
+			expectErr: false,
+		},
+		{
+			name:      "invalid: fragile in bag",
+			pkg:       models.PackageBag,
+			weight:    5,
+			flags:     models.HandlingFlags{Fragile: true},
+			expectErr: true,
+			wantCode:  string(apperrors.InvalidPackage),
+		},
+		{
+			name:      "invalid: fragile in bag+film",
+			pkg:       models.PackageBagFilm,
+			weight:    5,
+			flags:     models.HandlingFlags{Fragile: true},
+			expectErr: true,
+			wantCode:  string(apperrors.InvalidPackage),
+		},
+		{
+			name:      "valid: hazardous in box under limit",
+			pkg:       models.PackageBoxFilm,
+			weight:    19.999,
+			flags:     models.HandlingFlags{Hazardous: true},
+			expectErr: false,
+		},
+		{
+			name:      "invalid: hazardous in film",
+			pkg:       models.PackageFilm,
+			weight:    5,
+			flags:     models.HandlingFlags{Hazardous: true},
+			expectErr: true,
+			wantCode:  string(apperrors.InvalidPackage),
+		},
+		{
+			name:      "invalid: hazardous overweight",
+			pkg:       models.PackageBox,
+			weight:    20,
+			flags:     models.HandlingFlags{Hazardous: true},
+			expectErr: true,
+			wantCode:  string(apperrors.WeightTooHeavy),
+		},
 		{
 			name:      "invalid: Unknown package type",
 			pkg:       models.PackageType(999),
@@ -68,7 +115,7 @@ func TestDefaultPackageValidator_Validate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			err := v.Validate(tt.pkg, tt.weight)
+			err := v.Validate(tt.pkg, tt.weight, tt.flags)
 			if tt.expectErr {
 				require.Error(t, err)
 				require.Equal(t, tt.wantCode, apperrors.CodeFromError(err))
@@ -0,0 +1,56 @@
+package validators
+
+import (
+	"pvz-cli/internal/common/apperrors"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/pkg/clock"
+)
+
+var _ ShipmentValidator = (*DefaultShipmentValidator)(nil)
+
+// DefaultShipmentValidator is a default implementation of the ShipmentValidator interface
+type DefaultShipmentValidator struct {
+	clk      clock.Clock
+	calendar CalendarProvider
+}
+
+// NewDefaultShipmentValidator creates a new instance of DefaultShipmentValidator
+func NewDefaultShipmentValidator(clk clock.Clock, calendar CalendarProvider) *DefaultShipmentValidator {
+	return &DefaultShipmentValidator{clk: clk, calendar: calendar}
+}
+
+// ValidateRegister validates a drop-off: the point must be open and sender and destination must be set
+func (v *DefaultShipmentValidator) ValidateRegister(req requests.RegisterShipmentRequest) error {
+	if err := v.ensureOpen(); err != nil {
+		return err
+	}
+	if req.SenderID == 0 {
+		return apperrors.Newf(apperrors.ValidationFailed, "sender_id must be > 0")
+	}
+	if req.DestinationPointID == 0 {
+		return apperrors.Newf(apperrors.ValidationFailed, "destination_point_id must be > 0")
+	}
+	return nil
+}
+
+// ValidateHandOver validates that a registered shipment can be handed over to the given courier
+func (v *DefaultShipmentValidator) ValidateHandOver(s models.Shipment, req requests.HandOverShipmentRequest) error {
+	if err := v.ensureOpen(); err != nil {
+		return err
+	}
+	if req.CourierID == 0 {
+		return apperrors.Newf(apperrors.ValidationFailed, "courier_id must be > 0")
+	}
+	if s.Status != models.ShipmentRegistered {
+		return apperrors.Newf(apperrors.ValidationFailed, "shipment %d status is %s, not %s", s.ShipmentID, s.Status, models.ShipmentRegistered)
+	}
+	return nil
+}
+
+func (v *DefaultShipmentValidator) ensureOpen() error {
+	if !v.calendar.Current().IsOpenAt(v.clk.Now()) {
+		return apperrors.Newf(apperrors.PickupPointClosed, "pickup point is closed at the moment")
+	}
+	return nil
+}
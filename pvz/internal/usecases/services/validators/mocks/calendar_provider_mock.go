@@ -0,0 +1,257 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"pvz-cli/internal/models"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// CalendarProviderMock implements mm_validators.CalendarProvider
+type CalendarProviderMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCurrent          func() (p1 models.PickupCalendar)
+	funcCurrentOrigin    string
+	inspectFuncCurrent   func()
+	afterCurrentCounter  uint64
+	beforeCurrentCounter uint64
+	CurrentMock          mCalendarProviderMockCurrent
+}
+
+// NewCalendarProviderMock returns a mock for mm_validators.CalendarProvider
+func NewCalendarProviderMock(t minimock.Tester) *CalendarProviderMock {
+	m := &CalendarProviderMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CurrentMock = mCalendarProviderMockCurrent{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCalendarProviderMockCurrent struct {
+	optional           bool
+	mock               *CalendarProviderMock
+	defaultExpectation *CalendarProviderMockCurrentExpectation
+	expectations       []*CalendarProviderMockCurrentExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CalendarProviderMockCurrentExpectation specifies expectation struct of the CalendarProvider.Current
+type CalendarProviderMockCurrentExpectation struct {
+	mock *CalendarProviderMock
+
+	results      *CalendarProviderMockCurrentResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// CalendarProviderMockCurrentResults contains results of the CalendarProvider.Current
+type CalendarProviderMockCurrentResults struct {
+	p1 models.PickupCalendar
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCurrent *mCalendarProviderMockCurrent) Optional() *mCalendarProviderMockCurrent {
+	mmCurrent.optional = true
+	return mmCurrent
+}
+
+// Expect sets up expected params for CalendarProvider.Current
+func (mmCurrent *mCalendarProviderMockCurrent) Expect() *mCalendarProviderMockCurrent {
+	if mmCurrent.mock.funcCurrent != nil {
+		mmCurrent.mock.t.Fatalf("CalendarProviderMock.Current mock is already set by Set")
+	}
+
+	if mmCurrent.defaultExpectation == nil {
+		mmCurrent.defaultExpectation = &CalendarProviderMockCurrentExpectation{}
+	}
+
+	return mmCurrent
+}
+
+// Inspect accepts an inspector function that has same arguments as the CalendarProvider.Current
+func (mmCurrent *mCalendarProviderMockCurrent) Inspect(f func()) *mCalendarProviderMockCurrent {
+	if mmCurrent.mock.inspectFuncCurrent != nil {
+		mmCurrent.mock.t.Fatalf("Inspect function is already set for CalendarProviderMock.Current")
+	}
+
+	mmCurrent.mock.inspectFuncCurrent = f
+
+	return mmCurrent
+}
+
+// Return sets up results that will be returned by CalendarProvider.Current
+func (mmCurrent *mCalendarProviderMockCurrent) Return(p1 models.PickupCalendar) *CalendarProviderMock {
+	if mmCurrent.mock.funcCurrent != nil {
+		mmCurrent.mock.t.Fatalf("CalendarProviderMock.Current mock is already set by Set")
+	}
+
+	if mmCurrent.defaultExpectation == nil {
+		mmCurrent.defaultExpectation = &CalendarProviderMockCurrentExpectation{mock: mmCurrent.mock}
+	}
+	mmCurrent.defaultExpectation.results = &CalendarProviderMockCurrentResults{p1}
+	mmCurrent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCurrent.mock
+}
+
+// Set uses given function f to mock the CalendarProvider.Current method
+func (mmCurrent *mCalendarProviderMockCurrent) Set(f func() (p1 models.PickupCalendar)) *CalendarProviderMock {
+	if mmCurrent.defaultExpectation != nil {
+		mmCurrent.mock.t.Fatalf("Default expectation is already set for the CalendarProvider.Current method")
+	}
+
+	if len(mmCurrent.expectations) > 0 {
+		mmCurrent.mock.t.Fatalf("Some expectations are already set for the CalendarProvider.Current method")
+	}
+
+	mmCurrent.mock.funcCurrent = f
+	mmCurrent.mock.funcCurrentOrigin = minimock.CallerInfo(1)
+	return mmCurrent.mock
+}
+
+// Times sets number of times CalendarProvider.Current should be invoked
+func (mmCurrent *mCalendarProviderMockCurrent) Times(n uint64) *mCalendarProviderMockCurrent {
+	if n == 0 {
+		mmCurrent.mock.t.Fatalf("Times of CalendarProviderMock.Current mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCurrent.expectedInvocations, n)
+	mmCurrent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCurrent
+}
+
+func (mmCurrent *mCalendarProviderMockCurrent) invocationsDone() bool {
+	if len(mmCurrent.expectations) == 0 && mmCurrent.defaultExpectation == nil && mmCurrent.mock.funcCurrent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCurrent.mock.afterCurrentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCurrent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Current implements mm_validators.CalendarProvider
+func (mmCurrent *CalendarProviderMock) Current() (p1 models.PickupCalendar) {
+	mm_atomic.AddUint64(&mmCurrent.beforeCurrentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCurrent.afterCurrentCounter, 1)
+
+	mmCurrent.t.Helper()
+
+	if mmCurrent.inspectFuncCurrent != nil {
+		mmCurrent.inspectFuncCurrent()
+	}
+
+	if mmCurrent.CurrentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCurrent.CurrentMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmCurrent.CurrentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCurrent.t.Fatal("No results are set for the CalendarProviderMock.Current")
+		}
+		return (*mm_results).p1
+	}
+	if mmCurrent.funcCurrent != nil {
+		return mmCurrent.funcCurrent()
+	}
+	mmCurrent.t.Fatalf("Unexpected call to CalendarProviderMock.Current.")
+	return
+}
+
+// CurrentAfterCounter returns a count of finished CalendarProviderMock.Current invocations
+func (mmCurrent *CalendarProviderMock) CurrentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCurrent.afterCurrentCounter)
+}
+
+// CurrentBeforeCounter returns a count of CalendarProviderMock.Current invocations
+func (mmCurrent *CalendarProviderMock) CurrentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCurrent.beforeCurrentCounter)
+}
+
+// MinimockCurrentDone returns true if the count of the Current invocations corresponds
+// the number of defined expectations
+func (m *CalendarProviderMock) MinimockCurrentDone() bool {
+	if m.CurrentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CurrentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CurrentMock.invocationsDone()
+}
+
+// MinimockCurrentInspect logs each unmet expectation
+func (m *CalendarProviderMock) MinimockCurrentInspect() {
+	for _, e := range m.CurrentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to CalendarProviderMock.Current")
+		}
+	}
+
+	afterCurrentCounter := mm_atomic.LoadUint64(&m.afterCurrentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CurrentMock.defaultExpectation != nil && afterCurrentCounter < 1 {
+		m.t.Errorf("Expected call to CalendarProviderMock.Current at\n%s", m.CurrentMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCurrent != nil && afterCurrentCounter < 1 {
+		m.t.Errorf("Expected call to CalendarProviderMock.Current at\n%s", m.funcCurrentOrigin)
+	}
+
+	if !m.CurrentMock.invocationsDone() && afterCurrentCounter > 0 {
+		m.t.Errorf("Expected %d calls to CalendarProviderMock.Current at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CurrentMock.expectedInvocations), m.CurrentMock.expectedInvocationsOrigin, afterCurrentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CalendarProviderMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCurrentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CalendarProviderMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CalendarProviderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCurrentDone()
+}
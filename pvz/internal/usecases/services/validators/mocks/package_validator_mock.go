@@ -16,9 +16,9 @@ type PackageValidatorMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcValidate          func(pkg models.PackageType, weight float32) (err error)
+	funcValidate          func(pkg models.PackageType, weight float32, flags models.HandlingFlags) (err error)
 	funcValidateOrigin    string
-	inspectFuncValidate   func(pkg models.PackageType, weight float32)
+	inspectFuncValidate   func(pkg models.PackageType, weight float32, flags models.HandlingFlags)
 	afterValidateCounter  uint64
 	beforeValidateCounter uint64
 	ValidateMock          mPackageValidatorMockValidate
@@ -68,12 +68,14 @@ type PackageValidatorMockValidateExpectation struct {
 type PackageValidatorMockValidateParams struct {
 	pkg    models.PackageType
 	weight float32
+	flags  models.HandlingFlags
 }
 
 // PackageValidatorMockValidateParamPtrs contains pointers to parameters of the PackageValidator.Validate
 type PackageValidatorMockValidateParamPtrs struct {
 	pkg    *models.PackageType
 	weight *float32
+	flags  *models.HandlingFlags
 }
 
 // PackageValidatorMockValidateResults contains results of the PackageValidator.Validate
@@ -86,6 +88,7 @@ type PackageValidatorMockValidateExpectationOrigins struct {
 	origin       string
 	originPkg    string
 	originWeight string
+	originFlags  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -99,7 +102,7 @@ func (mmValidate *mPackageValidatorMockValidate) Optional() *mPackageValidatorMo
 }
 
 // Expect sets up expected params for PackageValidator.Validate
-func (mmValidate *mPackageValidatorMockValidate) Expect(pkg models.PackageType, weight float32) *mPackageValidatorMockValidate {
+func (mmValidate *mPackageValidatorMockValidate) Expect(pkg models.PackageType, weight float32, flags models.HandlingFlags) *mPackageValidatorMockValidate {
 	if mmValidate.mock.funcValidate != nil {
 		mmValidate.mock.t.Fatalf("PackageValidatorMock.Validate mock is already set by Set")
 	}
@@ -112,7 +115,7 @@ func (mmValidate *mPackageValidatorMockValidate) Expect(pkg models.PackageType,
 		mmValidate.mock.t.Fatalf("PackageValidatorMock.Validate mock is already set by ExpectParams functions")
 	}
 
-	mmValidate.defaultExpectation.params = &PackageValidatorMockValidateParams{pkg, weight}
+	mmValidate.defaultExpectation.params = &PackageValidatorMockValidateParams{pkg, weight, flags}
 	mmValidate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmValidate.expectations {
 		if minimock.Equal(e.params, mmValidate.defaultExpectation.params) {
@@ -169,8 +172,31 @@ func (mmValidate *mPackageValidatorMockValidate) ExpectWeightParam2(weight float
 	return mmValidate
 }
 
+// ExpectFlagsParam3 sets up expected param flags for PackageValidator.Validate
+func (mmValidate *mPackageValidatorMockValidate) ExpectFlagsParam3(flags models.HandlingFlags) *mPackageValidatorMockValidate {
+	if mmValidate.mock.funcValidate != nil {
+		mmValidate.mock.t.Fatalf("PackageValidatorMock.Validate mock is already set by Set")
+	}
+
+	if mmValidate.defaultExpectation == nil {
+		mmValidate.defaultExpectation = &PackageValidatorMockValidateExpectation{}
+	}
+
+	if mmValidate.defaultExpectation.params != nil {
+		mmValidate.mock.t.Fatalf("PackageValidatorMock.Validate mock is already set by Expect")
+	}
+
+	if mmValidate.defaultExpectation.paramPtrs == nil {
+		mmValidate.defaultExpectation.paramPtrs = &PackageValidatorMockValidateParamPtrs{}
+	}
+	mmValidate.defaultExpectation.paramPtrs.flags = &flags
+	mmValidate.defaultExpectation.expectationOrigins.originFlags = minimock.CallerInfo(1)
+
+	return mmValidate
+}
+
 // Inspect accepts an inspector function that has same arguments as the PackageValidator.Validate
-func (mmValidate *mPackageValidatorMockValidate) Inspect(f func(pkg models.PackageType, weight float32)) *mPackageValidatorMockValidate {
+func (mmValidate *mPackageValidatorMockValidate) Inspect(f func(pkg models.PackageType, weight float32, flags models.HandlingFlags)) *mPackageValidatorMockValidate {
 	if mmValidate.mock.inspectFuncValidate != nil {
 		mmValidate.mock.t.Fatalf("Inspect function is already set for PackageValidatorMock.Validate")
 	}
@@ -195,7 +221,7 @@ func (mmValidate *mPackageValidatorMockValidate) Return(err error) *PackageValid
 }
 
 // Set uses given function f to mock the PackageValidator.Validate method
-func (mmValidate *mPackageValidatorMockValidate) Set(f func(pkg models.PackageType, weight float32) (err error)) *PackageValidatorMock {
+func (mmValidate *mPackageValidatorMockValidate) Set(f func(pkg models.PackageType, weight float32, flags models.HandlingFlags) (err error)) *PackageValidatorMock {
 	if mmValidate.defaultExpectation != nil {
 		mmValidate.mock.t.Fatalf("Default expectation is already set for the PackageValidator.Validate method")
 	}
@@ -211,14 +237,14 @@ func (mmValidate *mPackageValidatorMockValidate) Set(f func(pkg models.PackageTy
 
 // When sets expectation for the PackageValidator.Validate which will trigger the result defined by the following
 // Then helper
-func (mmValidate *mPackageValidatorMockValidate) When(pkg models.PackageType, weight float32) *PackageValidatorMockValidateExpectation {
+func (mmValidate *mPackageValidatorMockValidate) When(pkg models.PackageType, weight float32, flags models.HandlingFlags) *PackageValidatorMockValidateExpectation {
 	if mmValidate.mock.funcValidate != nil {
 		mmValidate.mock.t.Fatalf("PackageValidatorMock.Validate mock is already set by Set")
 	}
 
 	expectation := &PackageValidatorMockValidateExpectation{
 		mock:               mmValidate.mock,
-		params:             &PackageValidatorMockValidateParams{pkg, weight},
+		params:             &PackageValidatorMockValidateParams{pkg, weight, flags},
 		expectationOrigins: PackageValidatorMockValidateExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmValidate.expectations = append(mmValidate.expectations, expectation)
@@ -253,17 +279,17 @@ func (mmValidate *mPackageValidatorMockValidate) invocationsDone() bool {
 }
 
 // Validate implements mm_validators.PackageValidator
-func (mmValidate *PackageValidatorMock) Validate(pkg models.PackageType, weight float32) (err error) {
+func (mmValidate *PackageValidatorMock) Validate(pkg models.PackageType, weight float32, flags models.HandlingFlags) (err error) {
 	mm_atomic.AddUint64(&mmValidate.beforeValidateCounter, 1)
 	defer mm_atomic.AddUint64(&mmValidate.afterValidateCounter, 1)
 
 	mmValidate.t.Helper()
 
 	if mmValidate.inspectFuncValidate != nil {
-		mmValidate.inspectFuncValidate(pkg, weight)
+		mmValidate.inspectFuncValidate(pkg, weight, flags)
 	}
 
-	mm_params := PackageValidatorMockValidateParams{pkg, weight}
+	mm_params := PackageValidatorMockValidateParams{pkg, weight, flags}
 
 	// Record call args
 	mmValidate.ValidateMock.mutex.Lock()
@@ -282,7 +308,7 @@ func (mmValidate *PackageValidatorMock) Validate(pkg models.PackageType, weight
 		mm_want := mmValidate.ValidateMock.defaultExpectation.params
 		mm_want_ptrs := mmValidate.ValidateMock.defaultExpectation.paramPtrs
 
-		mm_got := PackageValidatorMockValidateParams{pkg, weight}
+		mm_got := PackageValidatorMockValidateParams{pkg, weight, flags}
 
 		if mm_want_ptrs != nil {
 
@@ -296,6 +322,11 @@ func (mmValidate *PackageValidatorMock) Validate(pkg models.PackageType, weight
 					mmValidate.ValidateMock.defaultExpectation.expectationOrigins.originWeight, *mm_want_ptrs.weight, mm_got.weight, minimock.Diff(*mm_want_ptrs.weight, mm_got.weight))
 			}
 
+			if mm_want_ptrs.flags != nil && !minimock.Equal(*mm_want_ptrs.flags, mm_got.flags) {
+				mmValidate.t.Errorf("PackageValidatorMock.Validate got unexpected parameter flags, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidate.ValidateMock.defaultExpectation.expectationOrigins.originFlags, *mm_want_ptrs.flags, mm_got.flags, minimock.Diff(*mm_want_ptrs.flags, mm_got.flags))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmValidate.t.Errorf("PackageValidatorMock.Validate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmValidate.ValidateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -308,9 +339,9 @@ func (mmValidate *PackageValidatorMock) Validate(pkg models.PackageType, weight
 		return (*mm_results).err
 	}
 	if mmValidate.funcValidate != nil {
-		return mmValidate.funcValidate(pkg, weight)
+		return mmValidate.funcValidate(pkg, weight, flags)
 	}
-	mmValidate.t.Fatalf("Unexpected call to PackageValidatorMock.Validate. %v %v", pkg, weight)
+	mmValidate.t.Fatalf("Unexpected call to PackageValidatorMock.Validate. %v %v %v", pkg, weight, flags)
 	return
 }
 
@@ -0,0 +1,739 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+import (
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ShipmentValidatorMock implements mm_validators.ShipmentValidator
+type ShipmentValidatorMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcValidateHandOver          func(s models.Shipment, req requests.HandOverShipmentRequest) (err error)
+	funcValidateHandOverOrigin    string
+	inspectFuncValidateHandOver   func(s models.Shipment, req requests.HandOverShipmentRequest)
+	afterValidateHandOverCounter  uint64
+	beforeValidateHandOverCounter uint64
+	ValidateHandOverMock          mShipmentValidatorMockValidateHandOver
+
+	funcValidateRegister          func(req requests.RegisterShipmentRequest) (err error)
+	funcValidateRegisterOrigin    string
+	inspectFuncValidateRegister   func(req requests.RegisterShipmentRequest)
+	afterValidateRegisterCounter  uint64
+	beforeValidateRegisterCounter uint64
+	ValidateRegisterMock          mShipmentValidatorMockValidateRegister
+}
+
+// NewShipmentValidatorMock returns a mock for mm_validators.ShipmentValidator
+func NewShipmentValidatorMock(t minimock.Tester) *ShipmentValidatorMock {
+	m := &ShipmentValidatorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ValidateHandOverMock = mShipmentValidatorMockValidateHandOver{mock: m}
+	m.ValidateHandOverMock.callArgs = []*ShipmentValidatorMockValidateHandOverParams{}
+
+	m.ValidateRegisterMock = mShipmentValidatorMockValidateRegister{mock: m}
+	m.ValidateRegisterMock.callArgs = []*ShipmentValidatorMockValidateRegisterParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mShipmentValidatorMockValidateHandOver struct {
+	optional           bool
+	mock               *ShipmentValidatorMock
+	defaultExpectation *ShipmentValidatorMockValidateHandOverExpectation
+	expectations       []*ShipmentValidatorMockValidateHandOverExpectation
+
+	callArgs []*ShipmentValidatorMockValidateHandOverParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ShipmentValidatorMockValidateHandOverExpectation specifies expectation struct of the ShipmentValidator.ValidateHandOver
+type ShipmentValidatorMockValidateHandOverExpectation struct {
+	mock               *ShipmentValidatorMock
+	params             *ShipmentValidatorMockValidateHandOverParams
+	paramPtrs          *ShipmentValidatorMockValidateHandOverParamPtrs
+	expectationOrigins ShipmentValidatorMockValidateHandOverExpectationOrigins
+	results            *ShipmentValidatorMockValidateHandOverResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ShipmentValidatorMockValidateHandOverParams contains parameters of the ShipmentValidator.ValidateHandOver
+type ShipmentValidatorMockValidateHandOverParams struct {
+	s   models.Shipment
+	req requests.HandOverShipmentRequest
+}
+
+// ShipmentValidatorMockValidateHandOverParamPtrs contains pointers to parameters of the ShipmentValidator.ValidateHandOver
+type ShipmentValidatorMockValidateHandOverParamPtrs struct {
+	s   *models.Shipment
+	req *requests.HandOverShipmentRequest
+}
+
+// ShipmentValidatorMockValidateHandOverResults contains results of the ShipmentValidator.ValidateHandOver
+type ShipmentValidatorMockValidateHandOverResults struct {
+	err error
+}
+
+// ShipmentValidatorMockValidateHandOverOrigins contains origins of expectations of the ShipmentValidator.ValidateHandOver
+type ShipmentValidatorMockValidateHandOverExpectationOrigins struct {
+	origin    string
+	originS   string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) Optional() *mShipmentValidatorMockValidateHandOver {
+	mmValidateHandOver.optional = true
+	return mmValidateHandOver
+}
+
+// Expect sets up expected params for ShipmentValidator.ValidateHandOver
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) Expect(s models.Shipment, req requests.HandOverShipmentRequest) *mShipmentValidatorMockValidateHandOver {
+	if mmValidateHandOver.mock.funcValidateHandOver != nil {
+		mmValidateHandOver.mock.t.Fatalf("ShipmentValidatorMock.ValidateHandOver mock is already set by Set")
+	}
+
+	if mmValidateHandOver.defaultExpectation == nil {
+		mmValidateHandOver.defaultExpectation = &ShipmentValidatorMockValidateHandOverExpectation{}
+	}
+
+	if mmValidateHandOver.defaultExpectation.paramPtrs != nil {
+		mmValidateHandOver.mock.t.Fatalf("ShipmentValidatorMock.ValidateHandOver mock is already set by ExpectParams functions")
+	}
+
+	mmValidateHandOver.defaultExpectation.params = &ShipmentValidatorMockValidateHandOverParams{s, req}
+	mmValidateHandOver.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateHandOver.expectations {
+		if minimock.Equal(e.params, mmValidateHandOver.defaultExpectation.params) {
+			mmValidateHandOver.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateHandOver.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateHandOver
+}
+
+// ExpectSParam1 sets up expected param s for ShipmentValidator.ValidateHandOver
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) ExpectSParam1(s models.Shipment) *mShipmentValidatorMockValidateHandOver {
+	if mmValidateHandOver.mock.funcValidateHandOver != nil {
+		mmValidateHandOver.mock.t.Fatalf("ShipmentValidatorMock.ValidateHandOver mock is already set by Set")
+	}
+
+	if mmValidateHandOver.defaultExpectation == nil {
+		mmValidateHandOver.defaultExpectation = &ShipmentValidatorMockValidateHandOverExpectation{}
+	}
+
+	if mmValidateHandOver.defaultExpectation.params != nil {
+		mmValidateHandOver.mock.t.Fatalf("ShipmentValidatorMock.ValidateHandOver mock is already set by Expect")
+	}
+
+	if mmValidateHandOver.defaultExpectation.paramPtrs == nil {
+		mmValidateHandOver.defaultExpectation.paramPtrs = &ShipmentValidatorMockValidateHandOverParamPtrs{}
+	}
+	mmValidateHandOver.defaultExpectation.paramPtrs.s = &s
+	mmValidateHandOver.defaultExpectation.expectationOrigins.originS = minimock.CallerInfo(1)
+
+	return mmValidateHandOver
+}
+
+// ExpectReqParam2 sets up expected param req for ShipmentValidator.ValidateHandOver
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) ExpectReqParam2(req requests.HandOverShipmentRequest) *mShipmentValidatorMockValidateHandOver {
+	if mmValidateHandOver.mock.funcValidateHandOver != nil {
+		mmValidateHandOver.mock.t.Fatalf("ShipmentValidatorMock.ValidateHandOver mock is already set by Set")
+	}
+
+	if mmValidateHandOver.defaultExpectation == nil {
+		mmValidateHandOver.defaultExpectation = &ShipmentValidatorMockValidateHandOverExpectation{}
+	}
+
+	if mmValidateHandOver.defaultExpectation.params != nil {
+		mmValidateHandOver.mock.t.Fatalf("ShipmentValidatorMock.ValidateHandOver mock is already set by Expect")
+	}
+
+	if mmValidateHandOver.defaultExpectation.paramPtrs == nil {
+		mmValidateHandOver.defaultExpectation.paramPtrs = &ShipmentValidatorMockValidateHandOverParamPtrs{}
+	}
+	mmValidateHandOver.defaultExpectation.paramPtrs.req = &req
+	mmValidateHandOver.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmValidateHandOver
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentValidator.ValidateHandOver
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) Inspect(f func(s models.Shipment, req requests.HandOverShipmentRequest)) *mShipmentValidatorMockValidateHandOver {
+	if mmValidateHandOver.mock.inspectFuncValidateHandOver != nil {
+		mmValidateHandOver.mock.t.Fatalf("Inspect function is already set for ShipmentValidatorMock.ValidateHandOver")
+	}
+
+	mmValidateHandOver.mock.inspectFuncValidateHandOver = f
+
+	return mmValidateHandOver
+}
+
+// Return sets up results that will be returned by ShipmentValidator.ValidateHandOver
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) Return(err error) *ShipmentValidatorMock {
+	if mmValidateHandOver.mock.funcValidateHandOver != nil {
+		mmValidateHandOver.mock.t.Fatalf("ShipmentValidatorMock.ValidateHandOver mock is already set by Set")
+	}
+
+	if mmValidateHandOver.defaultExpectation == nil {
+		mmValidateHandOver.defaultExpectation = &ShipmentValidatorMockValidateHandOverExpectation{mock: mmValidateHandOver.mock}
+	}
+	mmValidateHandOver.defaultExpectation.results = &ShipmentValidatorMockValidateHandOverResults{err}
+	mmValidateHandOver.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateHandOver.mock
+}
+
+// Set uses given function f to mock the ShipmentValidator.ValidateHandOver method
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) Set(f func(s models.Shipment, req requests.HandOverShipmentRequest) (err error)) *ShipmentValidatorMock {
+	if mmValidateHandOver.defaultExpectation != nil {
+		mmValidateHandOver.mock.t.Fatalf("Default expectation is already set for the ShipmentValidator.ValidateHandOver method")
+	}
+
+	if len(mmValidateHandOver.expectations) > 0 {
+		mmValidateHandOver.mock.t.Fatalf("Some expectations are already set for the ShipmentValidator.ValidateHandOver method")
+	}
+
+	mmValidateHandOver.mock.funcValidateHandOver = f
+	mmValidateHandOver.mock.funcValidateHandOverOrigin = minimock.CallerInfo(1)
+	return mmValidateHandOver.mock
+}
+
+// When sets expectation for the ShipmentValidator.ValidateHandOver which will trigger the result defined by the following
+// Then helper
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) When(s models.Shipment, req requests.HandOverShipmentRequest) *ShipmentValidatorMockValidateHandOverExpectation {
+	if mmValidateHandOver.mock.funcValidateHandOver != nil {
+		mmValidateHandOver.mock.t.Fatalf("ShipmentValidatorMock.ValidateHandOver mock is already set by Set")
+	}
+
+	expectation := &ShipmentValidatorMockValidateHandOverExpectation{
+		mock:               mmValidateHandOver.mock,
+		params:             &ShipmentValidatorMockValidateHandOverParams{s, req},
+		expectationOrigins: ShipmentValidatorMockValidateHandOverExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateHandOver.expectations = append(mmValidateHandOver.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentValidator.ValidateHandOver return parameters for the expectation previously defined by the When method
+func (e *ShipmentValidatorMockValidateHandOverExpectation) Then(err error) *ShipmentValidatorMock {
+	e.results = &ShipmentValidatorMockValidateHandOverResults{err}
+	return e.mock
+}
+
+// Times sets number of times ShipmentValidator.ValidateHandOver should be invoked
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) Times(n uint64) *mShipmentValidatorMockValidateHandOver {
+	if n == 0 {
+		mmValidateHandOver.mock.t.Fatalf("Times of ShipmentValidatorMock.ValidateHandOver mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateHandOver.expectedInvocations, n)
+	mmValidateHandOver.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateHandOver
+}
+
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) invocationsDone() bool {
+	if len(mmValidateHandOver.expectations) == 0 && mmValidateHandOver.defaultExpectation == nil && mmValidateHandOver.mock.funcValidateHandOver == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateHandOver.mock.afterValidateHandOverCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateHandOver.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateHandOver implements mm_validators.ShipmentValidator
+func (mmValidateHandOver *ShipmentValidatorMock) ValidateHandOver(s models.Shipment, req requests.HandOverShipmentRequest) (err error) {
+	mm_atomic.AddUint64(&mmValidateHandOver.beforeValidateHandOverCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateHandOver.afterValidateHandOverCounter, 1)
+
+	mmValidateHandOver.t.Helper()
+
+	if mmValidateHandOver.inspectFuncValidateHandOver != nil {
+		mmValidateHandOver.inspectFuncValidateHandOver(s, req)
+	}
+
+	mm_params := ShipmentValidatorMockValidateHandOverParams{s, req}
+
+	// Record call args
+	mmValidateHandOver.ValidateHandOverMock.mutex.Lock()
+	mmValidateHandOver.ValidateHandOverMock.callArgs = append(mmValidateHandOver.ValidateHandOverMock.callArgs, &mm_params)
+	mmValidateHandOver.ValidateHandOverMock.mutex.Unlock()
+
+	for _, e := range mmValidateHandOver.ValidateHandOverMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateHandOver.ValidateHandOverMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateHandOver.ValidateHandOverMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateHandOver.ValidateHandOverMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateHandOver.ValidateHandOverMock.defaultExpectation.paramPtrs
+
+		mm_got := ShipmentValidatorMockValidateHandOverParams{s, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.s != nil && !minimock.Equal(*mm_want_ptrs.s, mm_got.s) {
+				mmValidateHandOver.t.Errorf("ShipmentValidatorMock.ValidateHandOver got unexpected parameter s, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateHandOver.ValidateHandOverMock.defaultExpectation.expectationOrigins.originS, *mm_want_ptrs.s, mm_got.s, minimock.Diff(*mm_want_ptrs.s, mm_got.s))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmValidateHandOver.t.Errorf("ShipmentValidatorMock.ValidateHandOver got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateHandOver.ValidateHandOverMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateHandOver.t.Errorf("ShipmentValidatorMock.ValidateHandOver got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateHandOver.ValidateHandOverMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateHandOver.ValidateHandOverMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateHandOver.t.Fatal("No results are set for the ShipmentValidatorMock.ValidateHandOver")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateHandOver.funcValidateHandOver != nil {
+		return mmValidateHandOver.funcValidateHandOver(s, req)
+	}
+	mmValidateHandOver.t.Fatalf("Unexpected call to ShipmentValidatorMock.ValidateHandOver. %v %v", s, req)
+	return
+}
+
+// ValidateHandOverAfterCounter returns a count of finished ShipmentValidatorMock.ValidateHandOver invocations
+func (mmValidateHandOver *ShipmentValidatorMock) ValidateHandOverAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateHandOver.afterValidateHandOverCounter)
+}
+
+// ValidateHandOverBeforeCounter returns a count of ShipmentValidatorMock.ValidateHandOver invocations
+func (mmValidateHandOver *ShipmentValidatorMock) ValidateHandOverBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateHandOver.beforeValidateHandOverCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentValidatorMock.ValidateHandOver.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateHandOver *mShipmentValidatorMockValidateHandOver) Calls() []*ShipmentValidatorMockValidateHandOverParams {
+	mmValidateHandOver.mutex.RLock()
+
+	argCopy := make([]*ShipmentValidatorMockValidateHandOverParams, len(mmValidateHandOver.callArgs))
+	copy(argCopy, mmValidateHandOver.callArgs)
+
+	mmValidateHandOver.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateHandOverDone returns true if the count of the ValidateHandOver invocations corresponds
+// the number of defined expectations
+func (m *ShipmentValidatorMock) MinimockValidateHandOverDone() bool {
+	if m.ValidateHandOverMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateHandOverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateHandOverMock.invocationsDone()
+}
+
+// MinimockValidateHandOverInspect logs each unmet expectation
+func (m *ShipmentValidatorMock) MinimockValidateHandOverInspect() {
+	for _, e := range m.ValidateHandOverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentValidatorMock.ValidateHandOver at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateHandOverCounter := mm_atomic.LoadUint64(&m.afterValidateHandOverCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateHandOverMock.defaultExpectation != nil && afterValidateHandOverCounter < 1 {
+		if m.ValidateHandOverMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ShipmentValidatorMock.ValidateHandOver at\n%s", m.ValidateHandOverMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ShipmentValidatorMock.ValidateHandOver at\n%s with params: %#v", m.ValidateHandOverMock.defaultExpectation.expectationOrigins.origin, *m.ValidateHandOverMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateHandOver != nil && afterValidateHandOverCounter < 1 {
+		m.t.Errorf("Expected call to ShipmentValidatorMock.ValidateHandOver at\n%s", m.funcValidateHandOverOrigin)
+	}
+
+	if !m.ValidateHandOverMock.invocationsDone() && afterValidateHandOverCounter > 0 {
+		m.t.Errorf("Expected %d calls to ShipmentValidatorMock.ValidateHandOver at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateHandOverMock.expectedInvocations), m.ValidateHandOverMock.expectedInvocationsOrigin, afterValidateHandOverCounter)
+	}
+}
+
+type mShipmentValidatorMockValidateRegister struct {
+	optional           bool
+	mock               *ShipmentValidatorMock
+	defaultExpectation *ShipmentValidatorMockValidateRegisterExpectation
+	expectations       []*ShipmentValidatorMockValidateRegisterExpectation
+
+	callArgs []*ShipmentValidatorMockValidateRegisterParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ShipmentValidatorMockValidateRegisterExpectation specifies expectation struct of the ShipmentValidator.ValidateRegister
+type ShipmentValidatorMockValidateRegisterExpectation struct {
+	mock               *ShipmentValidatorMock
+	params             *ShipmentValidatorMockValidateRegisterParams
+	paramPtrs          *ShipmentValidatorMockValidateRegisterParamPtrs
+	expectationOrigins ShipmentValidatorMockValidateRegisterExpectationOrigins
+	results            *ShipmentValidatorMockValidateRegisterResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ShipmentValidatorMockValidateRegisterParams contains parameters of the ShipmentValidator.ValidateRegister
+type ShipmentValidatorMockValidateRegisterParams struct {
+	req requests.RegisterShipmentRequest
+}
+
+// ShipmentValidatorMockValidateRegisterParamPtrs contains pointers to parameters of the ShipmentValidator.ValidateRegister
+type ShipmentValidatorMockValidateRegisterParamPtrs struct {
+	req *requests.RegisterShipmentRequest
+}
+
+// ShipmentValidatorMockValidateRegisterResults contains results of the ShipmentValidator.ValidateRegister
+type ShipmentValidatorMockValidateRegisterResults struct {
+	err error
+}
+
+// ShipmentValidatorMockValidateRegisterOrigins contains origins of expectations of the ShipmentValidator.ValidateRegister
+type ShipmentValidatorMockValidateRegisterExpectationOrigins struct {
+	origin    string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) Optional() *mShipmentValidatorMockValidateRegister {
+	mmValidateRegister.optional = true
+	return mmValidateRegister
+}
+
+// Expect sets up expected params for ShipmentValidator.ValidateRegister
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) Expect(req requests.RegisterShipmentRequest) *mShipmentValidatorMockValidateRegister {
+	if mmValidateRegister.mock.funcValidateRegister != nil {
+		mmValidateRegister.mock.t.Fatalf("ShipmentValidatorMock.ValidateRegister mock is already set by Set")
+	}
+
+	if mmValidateRegister.defaultExpectation == nil {
+		mmValidateRegister.defaultExpectation = &ShipmentValidatorMockValidateRegisterExpectation{}
+	}
+
+	if mmValidateRegister.defaultExpectation.paramPtrs != nil {
+		mmValidateRegister.mock.t.Fatalf("ShipmentValidatorMock.ValidateRegister mock is already set by ExpectParams functions")
+	}
+
+	mmValidateRegister.defaultExpectation.params = &ShipmentValidatorMockValidateRegisterParams{req}
+	mmValidateRegister.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateRegister.expectations {
+		if minimock.Equal(e.params, mmValidateRegister.defaultExpectation.params) {
+			mmValidateRegister.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateRegister.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateRegister
+}
+
+// ExpectReqParam1 sets up expected param req for ShipmentValidator.ValidateRegister
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) ExpectReqParam1(req requests.RegisterShipmentRequest) *mShipmentValidatorMockValidateRegister {
+	if mmValidateRegister.mock.funcValidateRegister != nil {
+		mmValidateRegister.mock.t.Fatalf("ShipmentValidatorMock.ValidateRegister mock is already set by Set")
+	}
+
+	if mmValidateRegister.defaultExpectation == nil {
+		mmValidateRegister.defaultExpectation = &ShipmentValidatorMockValidateRegisterExpectation{}
+	}
+
+	if mmValidateRegister.defaultExpectation.params != nil {
+		mmValidateRegister.mock.t.Fatalf("ShipmentValidatorMock.ValidateRegister mock is already set by Expect")
+	}
+
+	if mmValidateRegister.defaultExpectation.paramPtrs == nil {
+		mmValidateRegister.defaultExpectation.paramPtrs = &ShipmentValidatorMockValidateRegisterParamPtrs{}
+	}
+	mmValidateRegister.defaultExpectation.paramPtrs.req = &req
+	mmValidateRegister.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmValidateRegister
+}
+
+// Inspect accepts an inspector function that has same arguments as the ShipmentValidator.ValidateRegister
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) Inspect(f func(req requests.RegisterShipmentRequest)) *mShipmentValidatorMockValidateRegister {
+	if mmValidateRegister.mock.inspectFuncValidateRegister != nil {
+		mmValidateRegister.mock.t.Fatalf("Inspect function is already set for ShipmentValidatorMock.ValidateRegister")
+	}
+
+	mmValidateRegister.mock.inspectFuncValidateRegister = f
+
+	return mmValidateRegister
+}
+
+// Return sets up results that will be returned by ShipmentValidator.ValidateRegister
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) Return(err error) *ShipmentValidatorMock {
+	if mmValidateRegister.mock.funcValidateRegister != nil {
+		mmValidateRegister.mock.t.Fatalf("ShipmentValidatorMock.ValidateRegister mock is already set by Set")
+	}
+
+	if mmValidateRegister.defaultExpectation == nil {
+		mmValidateRegister.defaultExpectation = &ShipmentValidatorMockValidateRegisterExpectation{mock: mmValidateRegister.mock}
+	}
+	mmValidateRegister.defaultExpectation.results = &ShipmentValidatorMockValidateRegisterResults{err}
+	mmValidateRegister.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateRegister.mock
+}
+
+// Set uses given function f to mock the ShipmentValidator.ValidateRegister method
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) Set(f func(req requests.RegisterShipmentRequest) (err error)) *ShipmentValidatorMock {
+	if mmValidateRegister.defaultExpectation != nil {
+		mmValidateRegister.mock.t.Fatalf("Default expectation is already set for the ShipmentValidator.ValidateRegister method")
+	}
+
+	if len(mmValidateRegister.expectations) > 0 {
+		mmValidateRegister.mock.t.Fatalf("Some expectations are already set for the ShipmentValidator.ValidateRegister method")
+	}
+
+	mmValidateRegister.mock.funcValidateRegister = f
+	mmValidateRegister.mock.funcValidateRegisterOrigin = minimock.CallerInfo(1)
+	return mmValidateRegister.mock
+}
+
+// When sets expectation for the ShipmentValidator.ValidateRegister which will trigger the result defined by the following
+// Then helper
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) When(req requests.RegisterShipmentRequest) *ShipmentValidatorMockValidateRegisterExpectation {
+	if mmValidateRegister.mock.funcValidateRegister != nil {
+		mmValidateRegister.mock.t.Fatalf("ShipmentValidatorMock.ValidateRegister mock is already set by Set")
+	}
+
+	expectation := &ShipmentValidatorMockValidateRegisterExpectation{
+		mock:               mmValidateRegister.mock,
+		params:             &ShipmentValidatorMockValidateRegisterParams{req},
+		expectationOrigins: ShipmentValidatorMockValidateRegisterExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateRegister.expectations = append(mmValidateRegister.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ShipmentValidator.ValidateRegister return parameters for the expectation previously defined by the When method
+func (e *ShipmentValidatorMockValidateRegisterExpectation) Then(err error) *ShipmentValidatorMock {
+	e.results = &ShipmentValidatorMockValidateRegisterResults{err}
+	return e.mock
+}
+
+// Times sets number of times ShipmentValidator.ValidateRegister should be invoked
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) Times(n uint64) *mShipmentValidatorMockValidateRegister {
+	if n == 0 {
+		mmValidateRegister.mock.t.Fatalf("Times of ShipmentValidatorMock.ValidateRegister mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateRegister.expectedInvocations, n)
+	mmValidateRegister.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateRegister
+}
+
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) invocationsDone() bool {
+	if len(mmValidateRegister.expectations) == 0 && mmValidateRegister.defaultExpectation == nil && mmValidateRegister.mock.funcValidateRegister == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateRegister.mock.afterValidateRegisterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateRegister.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateRegister implements mm_validators.ShipmentValidator
+func (mmValidateRegister *ShipmentValidatorMock) ValidateRegister(req requests.RegisterShipmentRequest) (err error) {
+	mm_atomic.AddUint64(&mmValidateRegister.beforeValidateRegisterCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateRegister.afterValidateRegisterCounter, 1)
+
+	mmValidateRegister.t.Helper()
+
+	if mmValidateRegister.inspectFuncValidateRegister != nil {
+		mmValidateRegister.inspectFuncValidateRegister(req)
+	}
+
+	mm_params := ShipmentValidatorMockValidateRegisterParams{req}
+
+	// Record call args
+	mmValidateRegister.ValidateRegisterMock.mutex.Lock()
+	mmValidateRegister.ValidateRegisterMock.callArgs = append(mmValidateRegister.ValidateRegisterMock.callArgs, &mm_params)
+	mmValidateRegister.ValidateRegisterMock.mutex.Unlock()
+
+	for _, e := range mmValidateRegister.ValidateRegisterMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateRegister.ValidateRegisterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateRegister.ValidateRegisterMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateRegister.ValidateRegisterMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateRegister.ValidateRegisterMock.defaultExpectation.paramPtrs
+
+		mm_got := ShipmentValidatorMockValidateRegisterParams{req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmValidateRegister.t.Errorf("ShipmentValidatorMock.ValidateRegister got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateRegister.ValidateRegisterMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateRegister.t.Errorf("ShipmentValidatorMock.ValidateRegister got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateRegister.ValidateRegisterMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateRegister.ValidateRegisterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateRegister.t.Fatal("No results are set for the ShipmentValidatorMock.ValidateRegister")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateRegister.funcValidateRegister != nil {
+		return mmValidateRegister.funcValidateRegister(req)
+	}
+	mmValidateRegister.t.Fatalf("Unexpected call to ShipmentValidatorMock.ValidateRegister. %v", req)
+	return
+}
+
+// ValidateRegisterAfterCounter returns a count of finished ShipmentValidatorMock.ValidateRegister invocations
+func (mmValidateRegister *ShipmentValidatorMock) ValidateRegisterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateRegister.afterValidateRegisterCounter)
+}
+
+// ValidateRegisterBeforeCounter returns a count of ShipmentValidatorMock.ValidateRegister invocations
+func (mmValidateRegister *ShipmentValidatorMock) ValidateRegisterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateRegister.beforeValidateRegisterCounter)
+}
+
+// Calls returns a list of arguments used in each call to ShipmentValidatorMock.ValidateRegister.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateRegister *mShipmentValidatorMockValidateRegister) Calls() []*ShipmentValidatorMockValidateRegisterParams {
+	mmValidateRegister.mutex.RLock()
+
+	argCopy := make([]*ShipmentValidatorMockValidateRegisterParams, len(mmValidateRegister.callArgs))
+	copy(argCopy, mmValidateRegister.callArgs)
+
+	mmValidateRegister.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateRegisterDone returns true if the count of the ValidateRegister invocations corresponds
+// the number of defined expectations
+func (m *ShipmentValidatorMock) MinimockValidateRegisterDone() bool {
+	if m.ValidateRegisterMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateRegisterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateRegisterMock.invocationsDone()
+}
+
+// MinimockValidateRegisterInspect logs each unmet expectation
+func (m *ShipmentValidatorMock) MinimockValidateRegisterInspect() {
+	for _, e := range m.ValidateRegisterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ShipmentValidatorMock.ValidateRegister at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateRegisterCounter := mm_atomic.LoadUint64(&m.afterValidateRegisterCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateRegisterMock.defaultExpectation != nil && afterValidateRegisterCounter < 1 {
+		if m.ValidateRegisterMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ShipmentValidatorMock.ValidateRegister at\n%s", m.ValidateRegisterMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ShipmentValidatorMock.ValidateRegister at\n%s with params: %#v", m.ValidateRegisterMock.defaultExpectation.expectationOrigins.origin, *m.ValidateRegisterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateRegister != nil && afterValidateRegisterCounter < 1 {
+		m.t.Errorf("Expected call to ShipmentValidatorMock.ValidateRegister at\n%s", m.funcValidateRegisterOrigin)
+	}
+
+	if !m.ValidateRegisterMock.invocationsDone() && afterValidateRegisterCounter > 0 {
+		m.t.Errorf("Expected %d calls to ShipmentValidatorMock.ValidateRegister at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateRegisterMock.expectedInvocations), m.ValidateRegisterMock.expectedInvocationsOrigin, afterValidateRegisterCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ShipmentValidatorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockValidateHandOverInspect()
+
+			m.MinimockValidateRegisterInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ShipmentValidatorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ShipmentValidatorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockValidateHandOverDone() &&
+		m.MinimockValidateRegisterDone()
+}
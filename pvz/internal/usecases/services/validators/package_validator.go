@@ -3,7 +3,7 @@ package validators
 
 import "pvz-cli/internal/models"
 
-// PackageValidator validates package types and weight constraints
+// PackageValidator validates package types, weight constraints and handling compatibility
 type PackageValidator interface {
-	Validate(pkg models.PackageType, weight float32) error
+	Validate(pkg models.PackageType, weight float32, flags models.HandlingFlags) error
 }
@@ -0,0 +1,13 @@
+//go:generate minimock -g -i * -o mocks -s "_mock.go"
+package validators
+
+import (
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+)
+
+// ShipmentValidator validates outbound shipment operations and business rules
+type ShipmentValidator interface {
+	ValidateRegister(req requests.RegisterShipmentRequest) error
+	ValidateHandOver(s models.Shipment, req requests.HandOverShipmentRequest) error
+}
@@ -0,0 +1,12 @@
+package workers
+
+import (
+	"context"
+	"pvz-cli/internal/models"
+)
+
+// ArchiveWorker defines the periodic job that archives finished orders and purges expired archives.
+type ArchiveWorker interface {
+	Run(ctx context.Context) error
+	RunOnce(ctx context.Context) (models.ArchiveStats, error)
+}
@@ -0,0 +1,109 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"pvz-cli/internal/common/constants"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/services"
+	"pvz-cli/pkg/clock"
+	"time"
+)
+
+var _ ArchiveWorker = (*DefaultArchiveWorker)(nil)
+
+// DefaultArchiveWorker moves terminal orders older than archiveAfter into the archive and
+// purges archives older than retentionMonths on every tick.
+// Orders are never archived while an issued one could still be returned under the current pickup calendar.
+type DefaultArchiveWorker struct {
+	repo            repositories.ArchiveRepository
+	clk             clock.Clock
+	calendar        services.CalendarService
+	archiveAfter    time.Duration
+	retentionMonths int
+	batchSize       int
+	interval        time.Duration
+}
+
+// NewDefaultArchiveWorker creates and returns a new DefaultArchiveWorker with the specified configuration and dependencies.
+func NewDefaultArchiveWorker(
+	repo repositories.ArchiveRepository,
+	clk clock.Clock,
+	calendar services.CalendarService,
+	archiveAfter time.Duration,
+	retentionMonths int,
+	batchSize int,
+	interval time.Duration,
+) *DefaultArchiveWorker {
+	return &DefaultArchiveWorker{
+		repo:            repo,
+		clk:             clk,
+		calendar:        calendar,
+		archiveAfter:    archiveAfter,
+		retentionMonths: retentionMonths,
+		batchSize:       batchSize,
+		interval:        interval,
+	}
+}
+
+// Run archives immediately and then once per interval until the context is canceled.
+func (w *DefaultArchiveWorker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if stats, err := w.RunOnce(ctx); err != nil {
+			slog.Error("archival run failed", "error", err, "archived", stats.Archived, "purged", stats.Purged)
+		} else if stats.Archived > 0 || stats.Purged > 0 {
+			slog.Info("archival run finished", "archived", stats.Archived, "purged", stats.Purged)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce archives terminal orders batch by batch until none are left, then applies the retention policy.
+func (w *DefaultArchiveWorker) RunOnce(ctx context.Context) (models.ArchiveStats, error) {
+	var stats models.ArchiveStats
+	now := w.clk.Now()
+	olderThan := now.Add(-w.archiveAfter)
+	if cutoff := returnCutoff(w.calendar.Current(), now); cutoff.Before(olderThan) {
+		olderThan = cutoff
+	}
+	for {
+		if ctx.Err() != nil {
+			return stats, ctx.Err()
+		}
+		n, err := w.repo.ArchiveOrders(ctx, olderThan, now, w.batchSize)
+		if err != nil {
+			return stats, err
+		}
+		stats.Archived += n
+		if n < w.batchSize {
+			break
+		}
+	}
+
+	if w.retentionMonths > 0 {
+		purged, err := w.repo.PurgeArchive(ctx, now.AddDate(0, -w.retentionMonths, 0))
+		if err != nil {
+			return stats, err
+		}
+		stats.Purged = purged
+	}
+	return stats, nil
+}
+
+// returnCutoff returns a moment such that every order issued before it is past its return deadline at now.
+// The deadline never moves back for a later issue, so the search steps back a day at a time from the plain
+// return window until the deadline counted in business days has passed.
+func returnCutoff(cal models.PickupCalendar, now time.Time) time.Time {
+	cutoff := now.Add(-constants.ReturnWindow)
+	for cal.Deadline(cutoff, constants.ReturnWindow).After(now) {
+		cutoff = cutoff.AddDate(0, 0, -1)
+	}
+	return cutoff
+}
@@ -0,0 +1,92 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	repmocks "pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/internal/models"
+	svcmocks "pvz-cli/internal/usecases/services/mocks"
+	"pvz-cli/pkg/clock"
+)
+
+// calendarMock returns a calendar service serving cal as the current calendar
+func calendarMock(t *testing.T, cal models.PickupCalendar) *svcmocks.CalendarServiceMock {
+	calendar := svcmocks.NewCalendarServiceMock(t)
+	calendar.CurrentMock.Return(cal)
+	return calendar
+}
+
+func TestDefaultArchiveWorker_RunOnce_ArchivesInBatchesAndPurges(t *testing.T) {
+	t.Parallel()
+	clk := &clock.FakeClock{}
+	now := clk.Now()
+	mockRepo := repmocks.NewArchiveRepositoryMock(t)
+	batches := []int{2, 2, 1}
+	mockRepo.ArchiveOrdersMock.Set(func(ctx context.Context, olderThan, archivedAt time.Time, limit int) (int, error) {
+		assert.Equal(t, now.Add(-30*24*time.Hour), olderThan)
+		assert.Equal(t, now, archivedAt)
+		assert.Equal(t, 2, limit)
+		n := batches[0]
+		batches = batches[1:]
+		return n, nil
+	})
+	mockRepo.PurgeArchiveMock.Set(func(ctx context.Context, archivedBefore time.Time) (int, error) {
+		assert.Equal(t, now.AddDate(0, -12, 0), archivedBefore)
+		return 3, nil
+	})
+	worker := NewDefaultArchiveWorker(mockRepo, clk, calendarMock(t, models.PickupCalendar{}), 30*24*time.Hour, 12, 2, time.Hour)
+
+	stats, err := worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, models.ArchiveStats{Archived: 5, Purged: 3}, stats)
+	assert.Empty(t, batches)
+}
+
+func TestDefaultArchiveWorker_RunOnce_NoRetention(t *testing.T) {
+	t.Parallel()
+	mockRepo := repmocks.NewArchiveRepositoryMock(t)
+	mockRepo.ArchiveOrdersMock.Return(0, nil)
+	worker := NewDefaultArchiveWorker(mockRepo, &clock.FakeClock{}, calendarMock(t, models.PickupCalendar{}), time.Hour, 0, 10, time.Hour)
+
+	stats, err := worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, models.ArchiveStats{}, stats)
+	assert.Equal(t, uint64(0), mockRepo.PurgeArchiveAfterCounter())
+}
+
+func TestDefaultArchiveWorker_RunOnce_ArchiveFails(t *testing.T) {
+	t.Parallel()
+	mockRepo := repmocks.NewArchiveRepositoryMock(t)
+	mockRepo.ArchiveOrdersMock.Return(0, errors.New("db down"))
+	worker := NewDefaultArchiveWorker(mockRepo, &clock.FakeClock{}, calendarMock(t, models.PickupCalendar{}), time.Hour, 12, 10, time.Hour)
+
+	_, err := worker.RunOnce(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, uint64(0), mockRepo.PurgeArchiveAfterCounter())
+}
+
+func TestDefaultArchiveWorker_RunOnce_KeepsReturnableOrders(t *testing.T) {
+	t.Parallel()
+	clk := &clock.FakeClock{}
+	now := clk.Now()
+	// issued on Tuesday the return window runs over the Wednesday holiday and the weekend into Monday
+	cal := models.PickupCalendar{
+		WeeklyDaysOff: []time.Weekday{time.Saturday, time.Sunday},
+		Holidays:      []time.Time{time.Date(2025, 6, 25, 0, 0, 0, 0, time.UTC)},
+		BusinessDays:  true,
+	}
+	mockRepo := repmocks.NewArchiveRepositoryMock(t)
+	mockRepo.ArchiveOrdersMock.Set(func(ctx context.Context, olderThan, archivedAt time.Time, limit int) (int, error) {
+		assert.Equal(t, time.Date(2025, 6, 23, 12, 0, 0, 0, time.UTC), olderThan)
+		assert.False(t, cal.Deadline(olderThan, 48*time.Hour).After(now))
+		return 0, nil
+	})
+	worker := NewDefaultArchiveWorker(mockRepo, clk, calendarMock(t, cal), 48*time.Hour, 0, 10, time.Hour)
+
+	_, err := worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,60 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/pkg/clock"
+	"time"
+)
+
+var _ IdempotencyPurgeWorker = (*DefaultIdempotencyPurgeWorker)(nil)
+
+// DefaultIdempotencyPurgeWorker deletes Idempotency-Key records older than ttl on every tick.
+type DefaultIdempotencyPurgeWorker struct {
+	repo     repositories.IdempotencyRepository
+	clk      clock.Clock
+	ttl      time.Duration
+	interval time.Duration
+}
+
+// NewDefaultIdempotencyPurgeWorker creates and returns a new DefaultIdempotencyPurgeWorker.
+func NewDefaultIdempotencyPurgeWorker(
+	repo repositories.IdempotencyRepository,
+	clk clock.Clock,
+	ttl time.Duration,
+	interval time.Duration,
+) *DefaultIdempotencyPurgeWorker {
+	return &DefaultIdempotencyPurgeWorker{
+		repo:     repo,
+		clk:      clk,
+		ttl:      ttl,
+		interval: interval,
+	}
+}
+
+// Run purges immediately and then once per interval until the context is canceled.
+func (w *DefaultIdempotencyPurgeWorker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if purged, err := w.RunOnce(ctx); err != nil {
+			slog.Error("idempotency key purge failed", "error", err)
+		} else if purged > 0 {
+			slog.Info("idempotency key purge finished", "purged", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce deletes the records created more than ttl ago and returns how many were deleted.
+func (w *DefaultIdempotencyPurgeWorker) RunOnce(ctx context.Context) (int, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	return w.repo.Purge(ctx, w.clk.Now().Add(-w.ttl))
+}
@@ -0,0 +1,37 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	repmocks "pvz-cli/internal/data/repositories/mocks"
+	"pvz-cli/pkg/clock"
+)
+
+func TestDefaultIdempotencyPurgeWorker_RunOnce(t *testing.T) {
+	t.Parallel()
+	clk := &clock.FakeClock{}
+	mockRepo := repmocks.NewIdempotencyRepositoryMock(t)
+	mockRepo.PurgeMock.Set(func(ctx context.Context, createdBefore time.Time) (int, error) {
+		assert.Equal(t, clk.Now().Add(-24*time.Hour), createdBefore)
+		return 4, nil
+	})
+	worker := NewDefaultIdempotencyPurgeWorker(mockRepo, clk, 24*time.Hour, time.Hour)
+
+	purged, err := worker.RunOnce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 4, purged)
+}
+
+func TestDefaultIdempotencyPurgeWorker_RunOnce_PurgeFails(t *testing.T) {
+	t.Parallel()
+	mockRepo := repmocks.NewIdempotencyRepositoryMock(t)
+	mockRepo.PurgeMock.Return(0, errors.New("db down"))
+	worker := NewDefaultIdempotencyPurgeWorker(mockRepo, &clock.FakeClock{}, time.Hour, time.Hour)
+
+	_, err := worker.RunOnce(context.Background())
+	assert.Error(t, err)
+}
@@ -99,7 +99,7 @@ func (w *DefaultOutboxDispatcher) processLoop(ctx context.Context) {
 }
 
 func (w *DefaultOutboxDispatcher) dispatchEvent(ctx context.Context, ev models.OutboxEvent) (retry bool) {
-	key := []byte(strconv.FormatUint(ev.OrderID, 10))
+	key := []byte(strconv.FormatUint(ev.AggregateID, 10))
 	if err := w.producer.SendWithKey(ctx, w.topic, key, []byte(ev.Payload)); err != nil {
 		if ev.Attempts >= constants.EventSendingMaxAttempts {
 			_ = w.repo.SetFailed(ctx, ev.EventID, ErrNoAttemptsLeft)
@@ -0,0 +1,9 @@
+package workers
+
+import "context"
+
+// IdempotencyPurgeWorker defines the periodic job that deletes expired Idempotency-Key records.
+type IdempotencyPurgeWorker interface {
+	Run(ctx context.Context) error
+	RunOnce(ctx context.Context) (int, error)
+}
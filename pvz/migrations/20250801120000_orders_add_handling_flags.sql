@@ -0,0 +1,11 @@
+-- +goose Up
+alter table orders
+    add column if not exists fragile boolean not null default false,
+    add column if not exists hazardous boolean not null default false,
+    add column if not exists age_restricted boolean not null default false;
+
+-- +goose Down
+alter table orders
+    drop column if exists fragile,
+    drop column if exists hazardous,
+    drop column if exists age_restricted;
@@ -0,0 +1,13 @@
+-- +goose Up
+create table if not exists pickup_calendar(
+    id smallint primary key check (id = 1),
+    opens_at varchar(5) not null default '',
+    closes_at varchar(5) not null default '',
+    weekly_days_off integer[] not null default '{}',
+    holidays date[] not null default '{}',
+    business_days boolean not null default false,
+    updated_at timestamptz not null default now()
+);
+
+-- +goose Down
+drop table if exists pickup_calendar;
@@ -0,0 +1,7 @@
+-- +goose Up
+alter table orders
+    add column if not exists storage_policy text not null default 'manual';
+
+-- +goose Down
+alter table orders
+    drop column if exists storage_policy;
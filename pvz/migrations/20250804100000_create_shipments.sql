@@ -0,0 +1,33 @@
+-- +goose Up
+create table if not exists shipments (
+    id bigint primary key,
+    sender_id bigint not null,
+    destination_point_id bigint not null,
+    status integer not null,
+    courier_id bigint not null default 0,
+    created_at timestamptz not null default now(),
+    updated_status_at timestamptz not null default now(),
+    package integer not null,
+    weight real not null,
+    price real not null,
+    fragile boolean not null default false,
+    hazardous boolean not null default false,
+    age_restricted boolean not null default false
+);
+
+create index if not exists idx_shipments_sender_id on shipments(sender_id);
+
+create index if not exists idx_shipments_status on shipments(status);
+
+create table if not exists shipment_history (
+    id bigserial primary key,
+    shipment_id bigint not null references shipments(id),
+    status integer not null,
+    timestamp timestamptz not null default now()
+);
+
+create index if not exists idx_shipment_history_shipment_id_ts on shipment_history(shipment_id, timestamp);
+
+-- +goose Down
+drop table if exists shipment_history;
+drop table if exists shipments;
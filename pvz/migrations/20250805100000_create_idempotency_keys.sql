@@ -0,0 +1,11 @@
+-- +goose Up
+create table if not exists idempotency_keys (
+    key text primary key,
+    method text not null,
+    request_hash text not null,
+    response bytea not null,
+    created_at timestamptz not null default now()
+);
+
+-- +goose Down
+drop table if exists idempotency_keys;
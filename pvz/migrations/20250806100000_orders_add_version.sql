@@ -0,0 +1,7 @@
+-- +goose Up
+alter table orders
+    add column if not exists version bigint not null default 1;
+
+-- +goose Down
+alter table orders
+    drop column if exists version;
@@ -0,0 +1,7 @@
+-- +goose Up
+alter table order_history
+    add column if not exists operator_id text not null default '';
+
+-- +goose Down
+alter table order_history
+    drop column if exists operator_id;
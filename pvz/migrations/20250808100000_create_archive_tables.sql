@@ -0,0 +1,39 @@
+-- +goose Up
+create table if not exists orders_archive (
+    id bigint not null,
+    user_id bigint not null,
+    status integer not null,
+    created_at timestamptz not null,
+    expires_at timestamptz not null,
+    updated_status_at timestamptz not null,
+    package integer not null,
+    weight real not null,
+    price real not null,
+    fragile boolean not null default false,
+    hazardous boolean not null default false,
+    age_restricted boolean not null default false,
+    storage_policy text not null default '',
+    version bigint not null default 1,
+    is_deleted boolean not null default false,
+    archived_at timestamptz not null default now(),
+    primary key (id, archived_at)
+);
+
+create index if not exists idx_orders_archive_archived_at on orders_archive(archived_at);
+
+create table if not exists order_history_archive (
+    id bigint primary key,
+    order_id bigint not null,
+    event integer not null,
+    timestamp timestamptz not null,
+    operator_id text not null default '',
+    archived_at timestamptz not null default now()
+);
+
+create index if not exists idx_order_history_archive_order_id_ts on order_history_archive(order_id, timestamp desc);
+
+create index if not exists idx_order_history_archive_archived_at on order_history_archive(archived_at);
+
+-- +goose Down
+drop table if exists order_history_archive;
+drop table if exists orders_archive;
@@ -0,0 +1,30 @@
+-- +goose Up
+create table if not exists import_jobs (
+    id bigint primary key,
+    status integer not null,
+    atomic boolean not null default false,
+    total integer not null,
+    processed integer not null default 0,
+    imported integer not null default 0,
+    failed integer not null default 0,
+    operator_id text not null default '',
+    error text not null default '',
+    created_at timestamptz not null default now(),
+    updated_at timestamptz not null default now(),
+    finished_at timestamptz
+);
+
+create index if not exists idx_import_jobs_status on import_jobs(status);
+
+create table if not exists import_job_failures (
+    job_id bigint not null references import_jobs(id) on delete cascade,
+    item_number integer not null,
+    order_id bigint not null default 0,
+    code text not null,
+    reason text not null,
+    primary key (job_id, item_number)
+);
+
+-- +goose Down
+drop table if exists import_job_failures;
+drop table if exists import_jobs;
@@ -0,0 +1,33 @@
+-- +goose Up
+alter table order_history
+    add column if not exists actor_type text not null default '',
+    add column if not exists actor_id bigint not null default 0,
+    add column if not exists previous_status integer not null default 0,
+    add column if not exists new_status integer not null default 0,
+    add column if not exists correlation_id text not null default '',
+    add column if not exists metadata jsonb;
+
+alter table order_history_archive
+    add column if not exists actor_type text not null default '',
+    add column if not exists actor_id bigint not null default 0,
+    add column if not exists previous_status integer not null default 0,
+    add column if not exists new_status integer not null default 0,
+    add column if not exists correlation_id text not null default '',
+    add column if not exists metadata jsonb;
+
+-- +goose Down
+alter table order_history_archive
+    drop column if exists metadata,
+    drop column if exists correlation_id,
+    drop column if exists new_status,
+    drop column if exists previous_status,
+    drop column if exists actor_id,
+    drop column if exists actor_type;
+
+alter table order_history
+    drop column if exists metadata,
+    drop column if exists correlation_id,
+    drop column if exists new_status,
+    drop column if exists previous_status,
+    drop column if exists actor_id,
+    drop column if exists actor_type;
@@ -0,0 +1,7 @@
+-- +goose Up
+create index if not exists idx_order_history_ts_id on order_history(timestamp, id);
+create index if not exists idx_order_history_actor_ts on order_history(actor_type, actor_id, timestamp);
+
+-- +goose Down
+drop index if exists idx_order_history_actor_ts;
+drop index if exists idx_order_history_ts_id;
@@ -0,0 +1,18 @@
+-- +goose Up
+create table if not exists audit_log (
+    id bigserial primary key,
+    timestamp timestamptz not null,
+    operator_id text not null default '',
+    role text not null default '',
+    source text not null,
+    method text not null,
+    request jsonb,
+    result_code text not null,
+    correlation_id text not null default ''
+);
+
+create index if not exists idx_audit_log_ts_id on audit_log(timestamp, id);
+create index if not exists idx_audit_log_operator_ts on audit_log(operator_id, timestamp);
+
+-- +goose Down
+drop table if exists audit_log;
@@ -0,0 +1,8 @@
+-- +goose Up
+alter table idempotency_keys
+    alter column response drop not null;
+
+-- +goose Down
+delete from idempotency_keys where response is null;
+alter table idempotency_keys
+    alter column response set not null;
@@ -0,0 +1,19 @@
+-- +goose Up
+alter table idempotency_keys
+    add column if not exists scope text not null default '';
+alter table idempotency_keys
+    drop constraint if exists idempotency_keys_pkey;
+alter table idempotency_keys
+    add primary key (scope, key);
+
+create index if not exists idx_idempotency_keys_created_at on idempotency_keys(created_at);
+
+-- +goose Down
+drop index if exists idx_idempotency_keys_created_at;
+alter table idempotency_keys
+    drop constraint if exists idempotency_keys_pkey;
+delete from idempotency_keys where scope <> '';
+alter table idempotency_keys
+    add primary key (key);
+alter table idempotency_keys
+    drop column if exists scope;
@@ -0,0 +1,15 @@
+-- +goose Up
+alter table outbox
+    rename column order_id to aggregate_id;
+alter table outbox
+    add column if not exists aggregate_type text not null default 'order';
+update outbox set aggregate_type = 'shipment' where payload ? 'shipment';
+
+create index if not exists idx_outbox_aggregate on outbox(aggregate_type, aggregate_id);
+
+-- +goose Down
+drop index if exists idx_outbox_aggregate;
+alter table outbox
+    drop column if exists aggregate_type;
+alter table outbox
+    rename column aggregate_id to order_id;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- user_ids are the clients mentioned in the stored response, so their data can be exported and erased
+alter table idempotency_keys
+    add column if not exists user_ids bigint[] not null default '{}';
+
+create index if not exists idx_idempotency_keys_user_ids on idempotency_keys using gin(user_ids);
+
+-- failures recorded earlier are not backfilled: a rejected order may carry an ID that belongs to another client
+alter table import_job_failures
+    add column if not exists user_id bigint not null default 0;
+
+create index if not exists idx_import_job_failures_user_id on import_job_failures(user_id);
+
+-- +goose Down
+drop index if exists idx_import_job_failures_user_id;
+alter table import_job_failures
+    drop column if exists user_id;
+drop index if exists idx_idempotency_keys_user_ids;
+alter table idempotency_keys
+    drop column if exists user_ids;
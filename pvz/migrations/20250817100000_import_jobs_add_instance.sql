@@ -0,0 +1,12 @@
+-- +goose Up
+-- instance_id is the server running the job, so a restarted server fails only the jobs it was running itself;
+-- jobs submitted before the column existed keep an empty instance and are left as they are
+alter table import_jobs
+    add column if not exists instance_id text not null default '';
+
+create index if not exists idx_import_jobs_instance_status on import_jobs(instance_id, status);
+
+-- +goose Down
+drop index if exists idx_import_jobs_instance_status;
+alter table import_jobs
+    drop column if exists instance_id;
@@ -4,14 +4,17 @@ package standalone
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"io"
 	"log/slog"
 	"net"
 	"os"
 	"pvz-cli/internal/app"
+	"strings"
 	"testing"
 	"time"
 
@@ -138,6 +141,131 @@ func TestE2E_AcceptIssueAndReturn(t *testing.T) {
 	r.RunTests()
 }
 
+func TestE2E_ScrollOrders(t *testing.T) {
+	r := runner.NewRunner(t, "E2E: Scroll orders")
+	r.NewTest("Scroll orders by cursor", func(t provider.T) {
+		t.Parallel()
+		const userID uint64 = 555
+		orderIDs := []uint64{3001, 3002, 3003}
+		deps := newE2E(t)
+		t.WithNewStep("Setup: accept orders", func(ctx provider.StepCtx) {
+			for _, id := range orderIDs {
+				_, err := deps.client.AcceptOrder(context.Background(), &pb.AcceptOrderRequest{
+					OrderId:   id,
+					UserId:    userID,
+					ExpiresAt: timestamppb.New(time.Now().Add(2 * time.Hour)),
+					Package:   pb.PackageType_PACKAGE_TYPE_BOX.Enum(),
+					Weight:    1.0,
+					Price:     40,
+				})
+				require.NoError(t, err)
+			}
+		})
+		t.WithNewStep("Scroll two batches", func(ctx provider.StepCtx) {
+			limit := uint32(2)
+			first, err := deps.client.ScrollOrders(context.Background(), &pb.ScrollOrdersRequest{UserId: userID, Limit: &limit})
+			require.NoError(t, err)
+			require.Len(t, first.Orders, 2)
+			require.NotEmpty(t, first.NextCursor)
+
+			second, err := deps.client.ScrollOrders(context.Background(), &pb.ScrollOrdersRequest{
+				UserId: userID,
+				Limit:  &limit,
+				Cursor: first.NextCursor,
+			})
+			require.NoError(t, err)
+			require.Len(t, second.Orders, 1)
+			require.Empty(t, second.NextCursor)
+
+			var seen []uint64
+			for _, o := range append(first.Orders, second.Orders...) {
+				seen = append(seen, o.OrderId)
+			}
+			require.ElementsMatch(t, orderIDs, seen)
+		})
+	})
+	r.RunTests()
+}
+
+func TestE2E_ExportOrders(t *testing.T) {
+	r := runner.NewRunner(t, "E2E: Export orders")
+	r.NewTest("Export orders of a client as CSV", func(t provider.T) {
+		t.Parallel()
+		const userID uint64 = 556
+		orderIDs := []uint64{3101, 3102}
+		deps := newE2E(t)
+		t.WithNewStep("Setup: accept orders", func(ctx provider.StepCtx) {
+			for _, id := range orderIDs {
+				_, err := deps.client.AcceptOrder(context.Background(), &pb.AcceptOrderRequest{
+					OrderId:   id,
+					UserId:    userID,
+					ExpiresAt: timestamppb.New(time.Now().Add(2 * time.Hour)),
+					Package:   pb.PackageType_PACKAGE_TYPE_BOX.Enum(),
+					Weight:    1.0,
+					Price:     40,
+				})
+				require.NoError(t, err)
+			}
+		})
+		t.WithNewStep("Read the exported file", func(ctx provider.StepCtx) {
+			uid := userID
+			stream, err := deps.client.ExportOrders(context.Background(), &pb.ExportRequest{
+				Dataset: pb.ExportDataset_EXPORT_DATASET_ORDERS,
+				Format:  pb.ExportFormat_EXPORT_FORMAT_CSV,
+				Filter:  &pb.SearchOrdersRequest{UserId: &uid},
+			})
+			require.NoError(t, err)
+
+			var file strings.Builder
+			for {
+				chunk, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				require.NoError(t, err)
+				require.Equal(t, "text/csv; charset=utf-8", chunk.ContentType)
+				file.Write(chunk.Data)
+			}
+			lines := strings.Split(strings.TrimSpace(file.String()), "\n")
+			require.Len(t, lines, 1+len(orderIDs))
+			require.True(t, strings.HasPrefix(lines[0], "order_id,user_id,status"))
+		})
+	})
+	r.RunTests()
+}
+
+func TestE2E_ImportOrdersStream(t *testing.T) {
+	r := runner.NewRunner(t, "E2E: Streamed import")
+	r.NewTest("Import orders sent in chunks", func(t provider.T) {
+		t.Parallel()
+		const userID uint64 = 557
+		deps := newE2E(t)
+		order := func(id uint64) *pb.AcceptOrderRequest {
+			return &pb.AcceptOrderRequest{
+				OrderId:   id,
+				UserId:    userID,
+				ExpiresAt: timestamppb.New(time.Now().Add(2 * time.Hour)),
+				Package:   pb.PackageType_PACKAGE_TYPE_BOX.Enum(),
+				Weight:    1.0,
+				Price:     40,
+			}
+		}
+		t.WithNewStep("Stream two chunks with a duplicate order", func(ctx provider.StepCtx) {
+			stream, err := deps.client.ImportOrdersStream(context.Background())
+			require.NoError(t, err)
+			require.NoError(t, stream.Send(&pb.ImportOrdersChunk{Orders: []*pb.AcceptOrderRequest{order(3201), order(3202)}}))
+			require.NoError(t, stream.Send(&pb.ImportOrdersChunk{Orders: []*pb.AcceptOrderRequest{order(3201), order(3203)}}))
+
+			result, err := stream.CloseAndRecv()
+			require.NoError(t, err)
+			require.Equal(t, int32(3), result.Imported)
+			require.Len(t, result.Errors, 1)
+			require.Equal(t, uint64(3201), result.Errors[0].OrderId)
+		})
+	})
+	r.RunTests()
+}
+
 func findFreePort(t provider.T) int {
 	t.Helper()
 	addr, err := net.ResolveTCPAddr("tcp", "localhost:0")
@@ -0,0 +1,159 @@
+//go:build integration
+
+package standalone
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/require"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/data/storage"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/tests"
+)
+
+// historyStore is one storage mode under comparison
+type historyStore struct {
+	orders  repositories.OrderRepository
+	history repositories.HistoryRepository
+	archive repositories.ArchiveRepository
+}
+
+// historyEvent is a listed entry without its storage-specific ID
+type historyEvent struct {
+	OrderID uint64
+	Event   models.EventType
+}
+
+// TestHistoryRepository_SnapshotParity validates that Postgres and snapshot storage list the same history for
+// deleted, archived and re-accepted orders.
+func TestHistoryRepository_SnapshotParity(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "HistoryRepository: Snapshot parity")
+	const (
+		deletedID    uint64 = 40001
+		liveID       uint64 = 40002
+		reacceptedID uint64 = 40003
+		userID       uint64 = 4001
+		nextUserID   uint64 = 4002
+	)
+
+	r.NewTest("Both storage modes list the same history", func(t provider.T) {
+		commonDeps := tests.NewCommonDeps(t)
+		ctx := commonDeps.Ctx
+		s := storage.NewJSONStorage(filepath.Join(t.TempDir(), "storage.json"))
+		stores := map[string]historyStore{
+			"postgres": {
+				orders:  repositories.NewPGOrderRepository(commonDeps.Client),
+				history: repositories.NewPGHistoryRepository(commonDeps.Client),
+				archive: repositories.NewPGArchiveRepository(commonDeps.Client),
+			},
+			"snapshot": {
+				orders:  repositories.NewSnapshotOrderRepository(s),
+				history: repositories.NewSnapshotHistoryRepository(s),
+				archive: repositories.NewSnapshotArchiveRepository(s),
+			},
+		}
+		base := time.Now().UTC().Truncate(time.Microsecond).AddDate(0, 0, -60)
+		order := func(id, user uint64, status models.OrderStatus, at time.Time) models.Order {
+			return models.Order{
+				OrderID:         id,
+				UserID:          user,
+				Status:          status,
+				CreatedAt:       at.Add(-time.Hour),
+				ExpiresAt:       at.Add(48 * time.Hour),
+				UpdatedStatusAt: at,
+				Package:         models.PackageBox,
+				Weight:          2.5,
+				Price:           100.0,
+			}
+		}
+		// list runs the filter in both modes, requires them to agree and returns the Postgres result
+		list := func(filter requests.OrderHistoryFilter) []historyEvent {
+			filter.Page, filter.Limit = 1, 10
+			results := make(map[string][]historyEvent, len(stores))
+			for mode, st := range stores {
+				entries, total, err := st.history.List(ctx, filter)
+				require.NoError(t, err, mode)
+				require.Len(t, entries, total, mode)
+				events := make([]historyEvent, 0, len(entries))
+				for _, e := range entries {
+					events = append(events, historyEvent{OrderID: e.OrderID, Event: e.Event})
+				}
+				results[mode] = events
+			}
+			require.Equal(t, results["postgres"], results["snapshot"])
+			return results["postgres"]
+		}
+		seed := func(fn func(ctx context.Context, st historyStore)) {
+			for _, st := range stores {
+				fn(ctx, st)
+			}
+		}
+
+		t.WithNewStep("Setup: a returned and deleted order, a live one and an issued one", func(sCtx provider.StepCtx) {
+			seed(func(ctx context.Context, st historyStore) {
+				version, err := st.orders.Save(ctx, order(deletedID, userID, models.ReturnedToWarehouse, base))
+				require.NoError(t, err)
+				require.NoError(t, st.history.Save(ctx, models.HistoryEntry{OrderID: deletedID, Event: models.EventReturnedToWarehouse, Timestamp: base}))
+				require.NoError(t, st.orders.Delete(ctx, deletedID, version))
+
+				_, err = st.orders.Save(ctx, order(liveID, userID, models.Accepted, base.Add(time.Minute)))
+				require.NoError(t, err)
+				require.NoError(t, st.history.Save(ctx, models.HistoryEntry{OrderID: liveID, Event: models.EventAccepted, Timestamp: base.Add(time.Minute)}))
+
+				_, err = st.orders.Save(ctx, order(reacceptedID, userID, models.Issued, base))
+				require.NoError(t, err)
+				require.NoError(t, st.history.Save(ctx, models.HistoryEntry{OrderID: reacceptedID, Event: models.EventIssued, Timestamp: base}))
+			})
+		})
+
+		t.WithNewStep("The user filter keeps the history of the deleted order", func(sCtx provider.StepCtx) {
+			require.Equal(t, []historyEvent{
+				{OrderID: liveID, Event: models.EventAccepted},
+				{OrderID: reacceptedID, Event: models.EventIssued},
+				{OrderID: deletedID, Event: models.EventReturnedToWarehouse},
+			}, list(requests.OrderHistoryFilter{UserID: utils.Ptr(userID)}))
+		})
+
+		t.WithNewStep("Setup: archive finished orders and accept one of them again for another user", func(sCtx provider.StepCtx) {
+			seed(func(ctx context.Context, st historyStore) {
+				n, err := st.archive.ArchiveOrders(ctx, base.Add(30*time.Second), base.Add(time.Hour), 100)
+				require.NoError(t, err)
+				require.Equal(t, 2, n)
+
+				_, err = st.orders.Save(ctx, order(reacceptedID, nextUserID, models.Accepted, base.Add(2*time.Minute)))
+				require.NoError(t, err)
+				require.NoError(t, st.history.Save(ctx, models.HistoryEntry{OrderID: reacceptedID, Event: models.EventAccepted, Timestamp: base.Add(2 * time.Minute)}))
+			})
+		})
+
+		t.WithNewStep("Live history lists only live orders of the user", func(sCtx provider.StepCtx) {
+			require.Equal(t, []historyEvent{{OrderID: liveID, Event: models.EventAccepted}},
+				list(requests.OrderHistoryFilter{UserID: utils.Ptr(userID)}))
+		})
+
+		t.WithNewStep("An archived deleted order is found by its owner", func(sCtx provider.StepCtx) {
+			require.Equal(t, []historyEvent{{OrderID: deletedID, Event: models.EventReturnedToWarehouse}},
+				list(requests.OrderHistoryFilter{OrderID: utils.Ptr(deletedID), UserID: utils.Ptr(userID)}))
+		})
+
+		t.WithNewStep("A narrowed filter of a live order does not fall through to the archive", func(sCtx provider.StepCtx) {
+			require.Empty(t, list(requests.OrderHistoryFilter{
+				OrderID: utils.Ptr(reacceptedID),
+				Events:  []models.EventType{models.EventIssued},
+			}))
+			require.Equal(t, []historyEvent{{OrderID: reacceptedID, Event: models.EventAccepted}},
+				list(requests.OrderHistoryFilter{OrderID: utils.Ptr(reacceptedID)}))
+		})
+	})
+
+	r.RunTests()
+}
@@ -0,0 +1,85 @@
+//go:build integration
+
+package standalone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/require"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/tests"
+)
+
+// TestPGArchiveRepository_ArchiveAndPurge validates that finished orders move to the archive with their history and are purged later.
+func TestPGArchiveRepository_ArchiveAndPurge(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGArchiveRepository: Archive and purge")
+	const (
+		oldIssuedID   uint64 = 3001
+		freshIssuedID uint64 = 3002
+	)
+
+	r.NewTest("Archive terminal orders and purge them after retention", func(t provider.T) {
+		commonDeps := tests.NewCommonDeps(t)
+		ctx := commonDeps.Ctx
+		orderRepo := repositories.NewPGOrderRepository(commonDeps.Client)
+		historyRepo := repositories.NewPGHistoryRepository(commonDeps.Client)
+		archiveRepo := repositories.NewPGArchiveRepository(commonDeps.Client)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+
+		t.WithNewStep("Setup: issued orders with history", func(sCtx provider.StepCtx) {
+			for id, issuedAt := range map[uint64]time.Time{oldIssuedID: now.AddDate(0, 0, -60), freshIssuedID: now} {
+				_, err := orderRepo.Save(ctx, models.Order{
+					OrderID:         id,
+					UserID:          1,
+					Status:          models.Issued,
+					CreatedAt:       issuedAt.Add(-time.Hour),
+					ExpiresAt:       issuedAt.Add(48 * time.Hour),
+					UpdatedStatusAt: issuedAt,
+					Package:         models.PackageBox,
+					Weight:          2.5,
+					Price:           100.0,
+				})
+				require.NoError(t, err)
+				require.NoError(t, historyRepo.Save(ctx, models.HistoryEntry{OrderID: id, Event: models.EventAccepted, Timestamp: issuedAt.Add(-time.Hour)}))
+				require.NoError(t, historyRepo.Save(ctx, models.HistoryEntry{OrderID: id, Event: models.EventIssued, Timestamp: issuedAt}))
+			}
+		})
+
+		t.WithNewStep("Only the old order is archived", func(sCtx provider.StepCtx) {
+			n, err := archiveRepo.ArchiveOrders(ctx, now.AddDate(0, 0, -30), now, 100)
+			require.NoError(t, err)
+			require.Equal(t, 1, n)
+
+			_, err = orderRepo.Load(ctx, oldIssuedID)
+			require.Error(t, err)
+			_, err = orderRepo.Load(ctx, freshIssuedID)
+			require.NoError(t, err)
+		})
+
+		t.WithNewStep("History of the archived order is still readable", func(sCtx provider.StepCtx) {
+			entries, count, err := historyRepo.List(ctx, requests.OrderHistoryFilter{OrderID: utils.Ptr(oldIssuedID), Page: 1, Limit: 10})
+			require.NoError(t, err)
+			require.Equal(t, 2, count)
+			require.Equal(t, models.EventIssued, entries[0].Event)
+		})
+
+		t.WithNewStep("Retention purges the archive", func(sCtx provider.StepCtx) {
+			n, err := archiveRepo.PurgeArchive(ctx, now.Add(time.Second))
+			require.NoError(t, err)
+			require.Equal(t, 1, n)
+
+			_, count, err := historyRepo.List(ctx, requests.OrderHistoryFilter{OrderID: utils.Ptr(oldIssuedID), Page: 1, Limit: 10})
+			require.NoError(t, err)
+			require.Zero(t, count)
+		})
+	})
+
+	r.RunTests()
+}
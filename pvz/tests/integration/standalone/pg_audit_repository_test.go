@@ -0,0 +1,102 @@
+//go:build integration
+
+package standalone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/require"
+	"pvz-cli/internal/common/utils"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/internal/usecases/requests"
+	"pvz-cli/tests"
+)
+
+// TestPGAuditRepository_List validates the filters, newest-first ordering and paging of the audit log.
+func TestPGAuditRepository_List(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGAuditRepository: List")
+
+	r.NewTest("Filter and page the audit log", func(t provider.T) {
+		commonDeps := tests.NewCommonDeps(t)
+		ctx := commonDeps.Ctx
+		repo := repositories.NewPGAuditRepository(commonDeps.Client)
+		at := time.Now().UTC().Truncate(time.Microsecond).Add(-time.Hour)
+		// methods lists the methods of a page in order
+		methods := func(filter requests.AuditLogFilter) ([]string, int) {
+			if filter.Page == 0 {
+				filter.Page, filter.Limit = 1, 10
+			}
+			records, total, err := repo.List(ctx, filter)
+			require.NoError(t, err)
+			out := make([]string, 0, len(records))
+			for _, rec := range records {
+				out = append(out, rec.Method)
+			}
+			return out, total
+		}
+
+		t.WithNewStep("Setup: record calls of two operators from both sources", func(sCtx provider.StepCtx) {
+			for _, rec := range []models.AuditRecord{
+				{Timestamp: at, OperatorID: "op-1", Source: models.AuditSourceGRPC, Method: "/orders.OrdersService/AcceptOrder", Request: []byte(`{"order_id":1}`), ResultCode: "OK", CorrelationID: "corr-1"},
+				{Timestamp: at.Add(time.Minute), OperatorID: "op-2", Source: models.AuditSourceCLI, Method: "AcceptOrder", ResultCode: "ORDER_ALREADY_EXISTS"},
+				{Timestamp: at.Add(2 * time.Minute), OperatorID: "op-1", Source: models.AuditSourceGRPC, Method: "/orders.OrdersService/ReturnOrder", ResultCode: "OK"},
+				{Timestamp: at.Add(2 * time.Minute), Source: models.AuditSourceGRPC, Method: "/admin.AdminService/SetWorkerCount", ResultCode: "Unauthenticated"},
+			} {
+				require.NoError(t, repo.Save(ctx, rec))
+			}
+		})
+
+		t.WithNewStep("Records are listed newest first, the later one first on equal time", func(sCtx provider.StepCtx) {
+			got, total := methods(requests.AuditLogFilter{})
+			require.Equal(t, 4, total)
+			require.Equal(t, []string{
+				"/admin.AdminService/SetWorkerCount",
+				"/orders.OrdersService/ReturnOrder",
+				"AcceptOrder",
+				"/orders.OrdersService/AcceptOrder",
+			}, got)
+
+			records, _, err := repo.List(ctx, requests.AuditLogFilter{OperatorID: "op-1", Method: "AcceptOrder", Page: 1, Limit: 1})
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+			require.Equal(t, at, records[0].Timestamp.UTC())
+			require.JSONEq(t, `{"order_id":1}`, string(records[0].Request))
+			require.Equal(t, "corr-1", records[0].CorrelationID)
+		})
+
+		t.WithNewStep("Method, operator, source, result code and time filters", func(sCtx provider.StepCtx) {
+			got, total := methods(requests.AuditLogFilter{Method: "AcceptOrder"})
+			require.Equal(t, 2, total)
+			require.Equal(t, []string{"AcceptOrder", "/orders.OrdersService/AcceptOrder"}, got)
+
+			got, _ = methods(requests.AuditLogFilter{Method: "/orders.OrdersService/AcceptOrder"})
+			require.Equal(t, []string{"/orders.OrdersService/AcceptOrder"}, got)
+
+			got, _ = methods(requests.AuditLogFilter{OperatorID: "op-2"})
+			require.Equal(t, []string{"AcceptOrder"}, got)
+
+			got, _ = methods(requests.AuditLogFilter{Source: models.AuditSourceGRPC, ResultCode: "OK"})
+			require.Equal(t, []string{"/orders.OrdersService/ReturnOrder", "/orders.OrdersService/AcceptOrder"}, got)
+
+			got, _ = methods(requests.AuditLogFilter{From: utils.Ptr(at.Add(time.Minute)), To: utils.Ptr(at.Add(2 * time.Minute))})
+			require.Equal(t, []string{"AcceptOrder"}, got)
+		})
+
+		t.WithNewStep("Pages keep the total of all matching records", func(sCtx provider.StepCtx) {
+			got, total := methods(requests.AuditLogFilter{Page: 2, Limit: 3})
+			require.Equal(t, 4, total)
+			require.Equal(t, []string{"/orders.OrdersService/AcceptOrder"}, got)
+
+			got, total = methods(requests.AuditLogFilter{Page: 3, Limit: 3})
+			require.Equal(t, 4, total)
+			require.Empty(t, got)
+		})
+	})
+
+	r.RunTests()
+}
@@ -4,6 +4,7 @@ package standalone
 
 import (
 	"context"
+	"encoding/json"
 	"pvz-cli/internal/infrastructure/db"
 	"testing"
 	"time"
@@ -36,9 +37,16 @@ func TestPGHistoryRepository_Save(t *testing.T) {
 				Timestamp: time.Now().UTC().Truncate(time.Microsecond),
 			},
 			{
-				OrderID:   orderID,
-				Event:     models.EventIssued,
-				Timestamp: time.Now().UTC().Add(1 * time.Hour).Truncate(time.Microsecond),
+				OrderID:        orderID,
+				Event:          models.EventIssued,
+				Timestamp:      time.Now().UTC().Add(1 * time.Hour).Truncate(time.Microsecond),
+				OperatorID:     "op-1",
+				ActorType:      models.ActorClient,
+				ActorID:        1,
+				PreviousStatus: models.Accepted,
+				NewStatus:      models.Issued,
+				CorrelationID:  "corr-1",
+				Metadata:       json.RawMessage(`{"identity_verified":true}`),
 			},
 		}
 		t.WithNewStep("Save accepted event", func(ctx provider.StepCtx) {
@@ -60,6 +68,15 @@ func TestPGHistoryRepository_Save(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, 2, count)
 			require.Len(t, result, 2)
+
+			issued := result[0]
+			require.Equal(t, models.EventIssued, issued.Event)
+			require.Equal(t, entries[1].Actor(), issued.Actor())
+			require.Equal(t, models.Accepted, issued.PreviousStatus)
+			require.Equal(t, models.Issued, issued.NewStatus)
+			require.Equal(t, "corr-1", issued.CorrelationID)
+			require.JSONEq(t, string(entries[1].Metadata), string(issued.Metadata))
+			require.Nil(t, result[1].Metadata)
 		})
 	})
 
@@ -146,6 +163,82 @@ func TestPGHistoryRepository_List(t *testing.T) {
 	r.RunTests()
 }
 
+// TestPGHistoryRepository_ListFilters tests narrowing the history by user, events, time range and actor with cursor paging in both directions.
+func TestPGHistoryRepository_ListFilters(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGHistoryRepository: List filters")
+	r.NewTest("List with narrowing filters and cursors", func(t provider.T) {
+		deps := newHistoryDeps(t)
+		const (
+			orderID1 uint64 = 30001
+			orderID2 uint64 = 30002
+			userID   uint64 = 3001
+		)
+		base := time.Now().UTC().Truncate(time.Microsecond)
+		entries := []models.HistoryEntry{
+			{OrderID: orderID1, Event: models.EventAccepted, Timestamp: base.Add(-3 * time.Hour), ActorType: models.ActorCourier, ActorID: 7},
+			{OrderID: orderID1, Event: models.EventIssued, Timestamp: base.Add(-2 * time.Hour), ActorType: models.ActorClient, ActorID: userID},
+			{OrderID: orderID2, Event: models.EventAccepted, Timestamp: base.Add(-1 * time.Hour), ActorType: models.ActorCourier, ActorID: 7},
+		}
+
+		t.WithNewStep("Setup: create orders of two users and their history", func(ctx provider.StepCtx) {
+			deps.createUserOrder(t, orderID1, userID)
+			deps.createUserOrder(t, orderID2, userID+1)
+			for _, e := range entries {
+				require.NoError(t, deps.repo.Save(deps.ctx, e))
+			}
+		})
+
+		t.WithNewStep("Filter by user joins the orders", func(ctx provider.StepCtx) {
+			result, count, err := deps.repo.List(deps.ctx, requests.OrderHistoryFilter{UserID: utils.Ptr(userID), Page: 1, Limit: 10})
+			require.NoError(t, err)
+			require.Equal(t, 2, count)
+			require.Equal(t, models.EventIssued, result[0].Event)
+			require.Equal(t, models.EventAccepted, result[1].Event)
+		})
+
+		t.WithNewStep("Filter by events, time range and actor", func(ctx provider.StepCtx) {
+			filter := requests.OrderHistoryFilter{
+				Events:    []models.EventType{models.EventAccepted},
+				From:      utils.Ptr(base.Add(-4 * time.Hour)),
+				To:        utils.Ptr(base.Add(-90 * time.Minute)),
+				ActorType: utils.Ptr(models.ActorCourier),
+				ActorID:   utils.Ptr(uint64(7)),
+				Page:      1,
+				Limit:     10,
+			}
+			result, count, err := deps.repo.List(deps.ctx, filter)
+			require.NoError(t, err)
+			require.Equal(t, 1, count)
+			require.Equal(t, orderID1, result[0].OrderID)
+		})
+
+		t.WithNewStep("Ascending cursor continues past the previous page", func(ctx provider.StepCtx) {
+			filter := requests.OrderHistoryFilter{ActorType: utils.Ptr(models.ActorCourier), Asc: true, Page: 1, Limit: 1}
+			first, _, err := deps.repo.List(deps.ctx, filter)
+			require.NoError(t, err)
+			require.Len(t, first, 1)
+			require.Equal(t, orderID1, first[0].OrderID)
+
+			filter.After = &requests.HistoryCursor{At: first[0].Timestamp, ID: first[0].ID, Asc: true}
+			second, _, err := deps.repo.List(deps.ctx, filter)
+			require.NoError(t, err)
+			require.Len(t, second, 1)
+			require.Equal(t, orderID2, second[0].OrderID)
+		})
+
+		t.WithNewStep("Narrowed filter of an existing order may match nothing", func(ctx provider.StepCtx) {
+			filter := requests.OrderHistoryFilter{OrderID: utils.Ptr(orderID2), Events: []models.EventType{models.EventIssued}, Page: 1, Limit: 10}
+			result, count, err := deps.repo.List(deps.ctx, filter)
+			require.NoError(t, err)
+			require.Zero(t, count)
+			require.Empty(t, result)
+		})
+	})
+
+	r.RunTests()
+}
+
 func newHistoryDeps(t provider.T) historyDeps {
 	commonDeps := tests.NewCommonDeps(t)
 	ctx := commonDeps.Ctx
@@ -161,11 +254,16 @@ func newHistoryDeps(t provider.T) historyDeps {
 }
 
 func (deps historyDeps) createOrder(t provider.T, orderID uint64) {
+	t.Helper()
+	deps.createUserOrder(t, orderID, 1)
+}
+
+func (deps historyDeps) createUserOrder(t provider.T, orderID, userID uint64) {
 	t.Helper()
 	_, err := deps.client.ExecCtx(deps.ctx, db.WriteMode,
 		`INSERT INTO orders(id, user_id, status, created_at, expires_at, updated_status_at, package, weight, price, is_deleted) 
         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, false)`,
-		orderID, 1, models.Accepted, time.Now(), time.Now().Add(48*time.Hour), time.Now(), models.PackageBox, 2.5, 100.0)
+		orderID, userID, models.Accepted, time.Now(), time.Now().Add(48*time.Hour), time.Now(), models.PackageBox, 2.5, 100.0)
 	require.NoError(t, err)
 }
 
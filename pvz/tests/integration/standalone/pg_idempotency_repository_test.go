@@ -0,0 +1,96 @@
+//go:build integration
+
+package standalone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/require"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/tests"
+)
+
+// TestPGIdempotencyRepository_Lifecycle validates reserving, completing, releasing, expiring and purging idempotency keys.
+func TestPGIdempotencyRepository_Lifecycle(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGIdempotencyRepository: Lifecycle")
+
+	r.NewTest("Reserve keys per operator, replay completed ones and purge expired ones", func(t provider.T) {
+		commonDeps := tests.NewCommonDeps(t)
+		ctx := commonDeps.Ctx
+		repo := repositories.NewPGIdempotencyRepository(commonDeps.Client)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		rec := models.IdempotencyRecord{Scope: "op-1", Key: "k1", Method: "AcceptOrder", RequestHash: "h1", CreatedAt: now}
+
+		t.WithNewStep("Reserve a key and find it taken on retry", func(sCtx provider.StepCtx) {
+			stored, reserved, err := repo.Reserve(ctx, rec, now.Add(-time.Hour))
+			require.NoError(t, err)
+			require.True(t, reserved)
+			require.True(t, stored.Pending())
+
+			retry := rec
+			retry.RequestHash = "h2"
+			stored, reserved, err = repo.Reserve(ctx, retry, now.Add(-time.Hour))
+			require.NoError(t, err)
+			require.False(t, reserved)
+			require.Equal(t, "h1", stored.RequestHash)
+			require.True(t, stored.Pending())
+		})
+
+		t.WithNewStep("The same key of another operator is free and can be released", func(sCtx provider.StepCtx) {
+			other := rec
+			other.Scope = "op-2"
+			_, reserved, err := repo.Reserve(ctx, other, now.Add(-time.Hour))
+			require.NoError(t, err)
+			require.True(t, reserved)
+
+			require.NoError(t, repo.Release(ctx, "op-2", "k1"))
+			_, err = repo.Load(ctx, "op-2", "k1")
+			require.ErrorIs(t, err, repositories.ErrIdempotencyKeyNotFound)
+		})
+
+		t.WithNewStep("A completed record is kept on release", func(sCtx provider.StepCtx) {
+			require.NoError(t, repo.Complete(ctx, "op-1", "k1", []byte("response"), []uint64{7}))
+			require.NoError(t, repo.Release(ctx, "op-1", "k1"))
+
+			loaded, err := repo.Load(ctx, "op-1", "k1")
+			require.NoError(t, err)
+			require.False(t, loaded.Pending())
+			require.Equal(t, []byte("response"), loaded.Response)
+		})
+
+		t.WithNewStep("An expired record is replaced", func(sCtx provider.StepCtx) {
+			later := rec
+			later.RequestHash = "h2"
+			later.CreatedAt = now.Add(2 * time.Hour)
+			stored, reserved, err := repo.Reserve(ctx, later, now.Add(time.Hour))
+			require.NoError(t, err)
+			require.True(t, reserved)
+			require.Equal(t, "h2", stored.RequestHash)
+
+			loaded, err := repo.Load(ctx, "op-1", "k1")
+			require.NoError(t, err)
+			require.True(t, loaded.Pending())
+		})
+
+		t.WithNewStep("Purge deletes only records created before the cutoff", func(sCtx provider.StepCtx) {
+			old := models.IdempotencyRecord{Scope: "op-1", Key: "k2", Method: "AcceptOrder", RequestHash: "h3", CreatedAt: now}
+			_, _, err := repo.Reserve(ctx, old, now.Add(-time.Hour))
+			require.NoError(t, err)
+
+			purged, err := repo.Purge(ctx, now.Add(time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, 1, purged)
+			_, err = repo.Load(ctx, "op-1", "k2")
+			require.ErrorIs(t, err, repositories.ErrIdempotencyKeyNotFound)
+			_, err = repo.Load(ctx, "op-1", "k1")
+			require.NoError(t, err)
+		})
+	})
+
+	r.RunTests()
+}
@@ -0,0 +1,102 @@
+//go:build integration
+
+package standalone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/require"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/tests"
+)
+
+// TestPGImportJobRepository_Lifecycle validates that import jobs and their failures are persisted and interrupted jobs are failed.
+func TestPGImportJobRepository_Lifecycle(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGImportJobRepository: Lifecycle")
+	const (
+		runningJobID uint64 = 4001
+		freshJobID   uint64 = 4002
+		foreignJobID uint64 = 4003
+	)
+
+	r.NewTest("Save progress, record failures and fail interrupted jobs", func(t provider.T) {
+		commonDeps := tests.NewCommonDeps(t)
+		ctx := commonDeps.Ctx
+		repo := repositories.NewPGImportJobRepository(commonDeps.Client)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+
+		t.WithNewStep("Save a running job, a fresh queued one and a job of another instance", func(sCtx provider.StepCtx) {
+			require.NoError(t, repo.Save(ctx, models.ImportJob{
+				JobID:      runningJobID,
+				Status:     models.ImportJobRunning,
+				Total:      3,
+				Processed:  2,
+				Imported:   1,
+				Failed:     1,
+				CreatedAt:  now.Add(-time.Hour),
+				UpdatedAt:  now.Add(-time.Minute),
+				InstanceID: "pvz-1",
+			}))
+			require.NoError(t, repo.Save(ctx, models.ImportJob{
+				JobID:      freshJobID,
+				Status:     models.ImportJobQueued,
+				Total:      1,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+				InstanceID: "pvz-1",
+			}))
+			require.NoError(t, repo.Save(ctx, models.ImportJob{
+				JobID:      foreignJobID,
+				Status:     models.ImportJobRunning,
+				Total:      1,
+				CreatedAt:  now.Add(-time.Hour),
+				UpdatedAt:  now.Add(-time.Minute),
+				InstanceID: "pvz-2",
+			}))
+		})
+
+		t.WithNewStep("Record failures, keeping already recorded items", func(sCtx provider.StepCtx) {
+			failure := models.ImportJobFailure{JobID: runningJobID, ItemNumber: 2, OrderID: 42, Code: "ORDER_ALREADY_EXISTS", Reason: "exists"}
+			require.NoError(t, repo.SaveFailures(ctx, []models.ImportJobFailure{failure}))
+			require.NoError(t, repo.SaveFailures(ctx, []models.ImportJobFailure{failure}))
+
+			failures, err := repo.ListFailures(ctx, runningJobID)
+			require.NoError(t, err)
+			require.Equal(t, []models.ImportJobFailure{failure}, failures)
+		})
+
+		t.WithNewStep("Fail jobs of the restarted instance interrupted before the restart", func(sCtx provider.StepCtx) {
+			n, err := repo.FailUnfinished(ctx, "pvz-1", now.Add(-time.Second), "interrupted", now)
+			require.NoError(t, err)
+			require.Equal(t, 1, n)
+
+			job, err := repo.Load(ctx, runningJobID)
+			require.NoError(t, err)
+			require.Equal(t, models.ImportJobFailed, job.Status)
+			require.Equal(t, "interrupted", job.Error)
+			require.Equal(t, 2, job.Processed)
+			require.NotNil(t, job.FinishedAt)
+
+			fresh, err := repo.Load(ctx, freshJobID)
+			require.NoError(t, err)
+			require.Equal(t, models.ImportJobQueued, fresh.Status)
+			require.Equal(t, "pvz-1", fresh.InstanceID)
+
+			foreign, err := repo.Load(ctx, foreignJobID)
+			require.NoError(t, err)
+			require.Equal(t, models.ImportJobRunning, foreign.Status)
+		})
+
+		t.WithNewStep("Unknown job is not found", func(sCtx provider.StepCtx) {
+			_, err := repo.Load(ctx, 4999)
+			require.ErrorIs(t, err, repositories.ErrImportJobNotFound)
+		})
+	})
+
+	r.RunTests()
+}
@@ -41,7 +41,7 @@ func TestPGOrderRepository_SaveAndLoad(t *testing.T) {
 				Weight:          2.5,
 				Price:           100.0,
 			}
-			err := deps.repo.Save(deps.ctx, order)
+			_, err := deps.repo.Save(deps.ctx, order)
 			require.NoError(t, err)
 		})
 
@@ -57,6 +57,109 @@ func TestPGOrderRepository_SaveAndLoad(t *testing.T) {
 	r.RunTests()
 }
 
+// TestPGOrderRepository_SaveStaleVersion validates that saving an order loaded before a concurrent update is rejected.
+func TestPGOrderRepository_SaveStaleVersion(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGOrderRepository: Save with stale version")
+	const orderID uint64 = 1501
+
+	r.NewTest("Stale save is rejected", func(t provider.T) {
+		deps := newOrderDeps(t)
+		var loaded models.Order
+
+		t.WithNewStep("Setup: create and load order", func(ctx provider.StepCtx) {
+			order := models.Order{
+				OrderID:         orderID,
+				UserID:          1,
+				Status:          models.Accepted,
+				CreatedAt:       time.Now().UTC().Truncate(time.Microsecond),
+				ExpiresAt:       time.Now().UTC().Add(48 * time.Hour).Truncate(time.Microsecond),
+				UpdatedStatusAt: time.Now().UTC().Truncate(time.Microsecond),
+				Package:         models.PackageBox,
+				Weight:          2.5,
+				Price:           100.0,
+			}
+			version, err := deps.repo.Save(deps.ctx, order)
+			require.NoError(t, err)
+			require.Equal(t, int64(1), version)
+			loaded, err = deps.repo.Load(deps.ctx, orderID)
+			require.NoError(t, err)
+			require.Equal(t, int64(1), loaded.Version)
+		})
+
+		t.WithNewStep("First writer wins, second gets conflict", func(ctx provider.StepCtx) {
+			first := loaded
+			first.Status = models.Issued
+			version, err := deps.repo.Save(deps.ctx, first)
+			require.NoError(t, err)
+			require.Equal(t, int64(2), version)
+
+			second := loaded
+			second.Status = models.Returned
+			_, err = deps.repo.Save(deps.ctx, second)
+			require.ErrorIs(t, err, repositories.ErrConcurrentModification)
+
+			current, err := deps.repo.Load(deps.ctx, orderID)
+			require.NoError(t, err)
+			require.Equal(t, models.Issued, current.Status)
+			require.Equal(t, int64(2), current.Version)
+		})
+	})
+
+	r.RunTests()
+}
+
+// TestPGOrderRepository_SaveDeleted validates that only a new acceptance revives a removed order, continuing its version.
+func TestPGOrderRepository_SaveDeleted(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGOrderRepository: Save over a removed order")
+	const orderID uint64 = 1502
+
+	r.NewTest("Stale save is rejected and acceptance revives", func(t provider.T) {
+		deps := newOrderDeps(t)
+		order := models.Order{
+			OrderID:         orderID,
+			UserID:          1,
+			Status:          models.Accepted,
+			CreatedAt:       time.Now().UTC().Truncate(time.Microsecond),
+			ExpiresAt:       time.Now().UTC().Add(48 * time.Hour).Truncate(time.Microsecond),
+			UpdatedStatusAt: time.Now().UTC().Truncate(time.Microsecond),
+			Package:         models.PackageBox,
+			Weight:          2.5,
+			Price:           100.0,
+		}
+		var loaded models.Order
+
+		t.WithNewStep("Setup: create, load and remove order", func(ctx provider.StepCtx) {
+			_, err := deps.repo.Save(deps.ctx, order)
+			require.NoError(t, err)
+			loaded, err = deps.repo.Load(deps.ctx, orderID)
+			require.NoError(t, err)
+			require.NoError(t, deps.repo.Delete(deps.ctx, orderID, loaded.Version))
+		})
+
+		t.WithNewStep("Save loaded before the removal is rejected", func(ctx provider.StepCtx) {
+			stale := loaded
+			stale.Status = models.Issued
+			_, err := deps.repo.Save(deps.ctx, stale)
+			require.ErrorIs(t, err, repositories.ErrConcurrentModification)
+			_, err = deps.repo.Load(deps.ctx, orderID)
+			require.ErrorIs(t, err, repositories.ErrOrderNotFound)
+		})
+
+		t.WithNewStep("New acceptance revives with a higher version", func(ctx provider.StepCtx) {
+			version, err := deps.repo.Save(deps.ctx, order)
+			require.NoError(t, err)
+			require.Greater(t, version, loaded.Version)
+			revived, err := deps.repo.Load(deps.ctx, orderID)
+			require.NoError(t, err)
+			require.Equal(t, version, revived.Version)
+		})
+	})
+
+	r.RunTests()
+}
+
 // TestPGOrderRepository_Delete validates the delete functionality of the PGOrderRepository by checking proper deletion of an order.
 func TestPGOrderRepository_Delete(t *testing.T) {
 	t.Parallel()
@@ -78,15 +181,25 @@ func TestPGOrderRepository_Delete(t *testing.T) {
 				Weight:          2.5,
 				Price:           100.0,
 			}
-			err := deps.repo.Save(deps.ctx, order)
+			_, err := deps.repo.Save(deps.ctx, order)
 			require.NoError(t, err)
 		})
 
+		t.WithNewStep("Delete with a stale version is rejected", func(ctx provider.StepCtx) {
+			err := deps.repo.Delete(deps.ctx, orderID, 0)
+			require.ErrorIs(t, err, repositories.ErrConcurrentModification)
+		})
+
 		t.WithNewStep("Delete order", func(ctx provider.StepCtx) {
-			err := deps.repo.Delete(deps.ctx, orderID)
+			err := deps.repo.Delete(deps.ctx, orderID, 1)
 			require.NoError(t, err)
 		})
 
+		t.WithNewStep("Deleting again is rejected", func(ctx provider.StepCtx) {
+			err := deps.repo.Delete(deps.ctx, orderID, 1)
+			require.ErrorIs(t, err, repositories.ErrConcurrentModification)
+		})
+
 		t.WithNewStep("Verify order is deleted", func(ctx provider.StepCtx) {
 			_, err := deps.repo.Load(deps.ctx, orderID)
 			require.Equal(t, repositories.ErrOrderNotFound, err)
@@ -125,7 +238,7 @@ func TestPGOrderRepository_List(t *testing.T) {
 					Weight:          2.5,
 					Price:           100.0,
 				}
-				err := deps.repo.Save(deps.ctx, order)
+				_, err := deps.repo.Save(deps.ctx, order)
 				require.NoError(t, err)
 			}
 		})
@@ -161,6 +274,121 @@ func TestPGOrderRepository_List(t *testing.T) {
 	r.RunTests()
 }
 
+// TestPGOrderRepository_Search validates range filters, multi-value filters and sorting of the List method.
+func TestPGOrderRepository_Search(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGOrderRepository: Search")
+	r.NewTest("Search with ranges and sorting", func(t provider.T) {
+		deps := newOrderDeps(t)
+		base := time.Now().UTC().Truncate(time.Microsecond)
+		cases := []struct {
+			orderID uint64
+			status  models.OrderStatus
+			pkg     models.PackageType
+			price   float32
+			expires time.Time
+		}{
+			{orderID: 4001, status: models.Accepted, pkg: models.PackageBox, price: 300, expires: base.Add(12 * time.Hour)},
+			{orderID: 4002, status: models.Returned, pkg: models.PackageBag, price: 100, expires: base.Add(36 * time.Hour)},
+			{orderID: 4003, status: models.Issued, pkg: models.PackageBox, price: 200, expires: base.Add(60 * time.Hour)},
+		}
+
+		t.WithNewStep("Setup: create orders", func(ctx provider.StepCtx) {
+			for i, tc := range cases {
+				order := models.Order{
+					OrderID:         tc.orderID,
+					UserID:          77,
+					Status:          tc.status,
+					CreatedAt:       base.Add(time.Duration(i) * time.Minute),
+					ExpiresAt:       tc.expires,
+					UpdatedStatusAt: base,
+					Package:         tc.pkg,
+					Weight:          2.5,
+					Price:           tc.price,
+				}
+				_, err := deps.repo.Save(deps.ctx, order)
+				require.NoError(t, err)
+			}
+		})
+
+		t.WithNewStep("Filter by statuses and price range sorted by price", func(ctx provider.StepCtx) {
+			filter := requests.OrdersFilterRequest{
+				UserID:   utils.Ptr(uint64(77)),
+				Statuses: []models.OrderStatus{models.Accepted, models.Issued},
+				PriceMin: utils.Ptr(float32(200)),
+				PriceMax: utils.Ptr(float32(300)),
+				SortBy:   requests.SortByPrice,
+				SortDesc: true,
+			}
+			result, count, err := deps.repo.List(deps.ctx, filter)
+			require.NoError(t, err)
+			require.Equal(t, 2, count)
+			require.Equal(t, uint64(4001), result[0].OrderID)
+			require.Equal(t, uint64(4003), result[1].OrderID)
+		})
+
+		t.WithNewStep("Filter by package and expiry range", func(ctx provider.StepCtx) {
+			filter := requests.OrdersFilterRequest{
+				UserID:      utils.Ptr(uint64(77)),
+				Packages:    []models.PackageType{models.PackageBox},
+				ExpiresFrom: utils.Ptr(base),
+				ExpiresTo:   utils.Ptr(base.Add(24 * time.Hour)),
+			}
+			result, count, err := deps.repo.List(deps.ctx, filter)
+			require.NoError(t, err)
+			require.Equal(t, 1, count)
+			require.Equal(t, uint64(4001), result[0].OrderID)
+		})
+	})
+
+	r.RunTests()
+}
+
+// TestPGOrderRepository_ListAfterCursor validates keyset paging over orders sharing the same creation time.
+func TestPGOrderRepository_ListAfterCursor(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGOrderRepository: List after cursor")
+	r.NewTest("Equal timestamps are not skipped", func(t provider.T) {
+		deps := newOrderDeps(t)
+		createdAt := time.Now().UTC().Truncate(time.Microsecond)
+
+		t.WithNewStep("Setup: create orders with equal creation time", func(ctx provider.StepCtx) {
+			for _, id := range []uint64{5001, 5002, 5003} {
+				order := models.Order{
+					OrderID:         id,
+					UserID:          88,
+					Status:          models.Accepted,
+					CreatedAt:       createdAt,
+					ExpiresAt:       createdAt.Add(48 * time.Hour),
+					UpdatedStatusAt: createdAt,
+					Package:         models.PackageBox,
+					Weight:          2.5,
+					Price:           100.0,
+				}
+				_, err := deps.repo.Save(deps.ctx, order)
+				require.NoError(t, err)
+			}
+		})
+
+		t.WithNewStep("Page through with cursors", func(ctx provider.StepCtx) {
+			filter := requests.NewOrdersFilter(requests.WithUserID(88), requests.WithLimit(2))
+			first, total, err := deps.repo.List(deps.ctx, filter)
+			require.NoError(t, err)
+			require.Equal(t, 3, total)
+			require.Len(t, first, 2)
+
+			filter.After = utils.Ptr(filter.CursorAt(first[1]))
+			second, total, err := deps.repo.List(deps.ctx, filter)
+			require.NoError(t, err)
+			require.Equal(t, 3, total)
+			require.Len(t, second, 1)
+			require.Equal(t, uint64(5003), second[0].OrderID)
+		})
+	})
+
+	r.RunTests()
+}
+
 func newOrderDeps(t provider.T) orderDeps {
 	commonDeps := tests.NewCommonDeps(t)
 	ctx := commonDeps.Ctx
@@ -0,0 +1,160 @@
+//go:build integration
+
+package standalone
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/stretchr/testify/require"
+	"pvz-cli/internal/data/repositories"
+	"pvz-cli/internal/models"
+	"pvz-cli/tests"
+)
+
+// TestPGUserDataRepository_ShipmentsKeysAndImports validates that shipments, their outbox events, stored idempotent
+// responses and rejected imports of a client are exported and anonymized.
+func TestPGUserDataRepository_ShipmentsKeysAndImports(t *testing.T) {
+	t.Parallel()
+	r := runner.NewRunner(t, "PGUserDataRepository: ShipmentsKeysAndImports")
+	const (
+		userID     uint64 = 7
+		otherID    uint64 = 8
+		shipmentID uint64 = 5001
+		jobID      uint64 = 5002
+		eventID    uint64 = 5003
+		orderID    uint64 = 5004
+	)
+
+	r.NewTest("Export and anonymize the client's shipments, keys and import failures", func(t provider.T) {
+		commonDeps := tests.NewCommonDeps(t)
+		ctx := commonDeps.Ctx
+		repo := repositories.NewPGUserDataRepository(commonDeps.Client)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+
+		t.WithNewStep("Store a shipment with its event, a response and an import failure of the client", func(sCtx provider.StepCtx) {
+			shipments := repositories.NewPGShipmentRepository(commonDeps.Client)
+			shipment := models.Shipment{
+				ShipmentID:      shipmentID,
+				SenderID:        userID,
+				Status:          models.ShipmentRegistered,
+				CreatedAt:       now,
+				UpdatedStatusAt: now,
+				Package:         models.PackageBox,
+				Weight:          1,
+				Price:           10,
+			}
+			require.NoError(t, shipments.Save(ctx, shipment))
+			require.NoError(t, shipments.SaveHistory(ctx, models.ShipmentHistoryEntry{
+				ShipmentID: shipmentID,
+				Status:     models.ShipmentRegistered,
+				Timestamp:  now,
+			}))
+			payload, err := json.Marshal(models.ShipmentKafkaEvent{
+				EventID:  eventID,
+				Actor:    models.Actor{Type: models.ActorClient, ID: userID},
+				Shipment: shipment,
+			})
+			require.NoError(t, err)
+			outbox := repositories.NewPGOutboxRepository(commonDeps.Client)
+			require.NoError(t, outbox.Create(ctx, eventID, models.OutboxAggregateShipment, shipmentID, payload))
+
+			keys := repositories.NewPGIdempotencyRepository(commonDeps.Client)
+			for key, mentioned := range map[string]uint64{"k1": userID, "k2": otherID} {
+				rec := models.IdempotencyRecord{Scope: "op-1", Key: key, Method: "AcceptOrder", RequestHash: "h", CreatedAt: now}
+				_, reserved, err := keys.Reserve(ctx, rec, now.Add(-time.Hour))
+				require.NoError(t, err)
+				require.True(t, reserved)
+				require.NoError(t, keys.Complete(ctx, "op-1", key, []byte("response"), []uint64{mentioned}))
+			}
+
+			jobs := repositories.NewPGImportJobRepository(commonDeps.Client)
+			require.NoError(t, jobs.Save(ctx, models.ImportJob{
+				JobID:     jobID,
+				Status:    models.ImportJobCompleted,
+				Total:     1,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}))
+			require.NoError(t, jobs.SaveFailures(ctx, []models.ImportJobFailure{
+				{JobID: jobID, ItemNumber: 1, OrderID: 42, UserID: userID, Code: "VALIDATION_FAILED", Reason: "invalid"},
+			}))
+		})
+
+		t.WithNewStep("Export returns every record of the client", func(sCtx provider.StepCtx) {
+			export, err := repo.Export(ctx, userID)
+			require.NoError(t, err)
+			require.Len(t, export.Shipments, 1)
+			require.Len(t, export.ShipmentHistory, 1)
+			require.Len(t, export.OutboxEvents, 1)
+			require.Equal(t, models.OutboxAggregateShipment, export.OutboxEvents[0].AggregateType)
+			require.Len(t, export.IdempotencyRecords, 1)
+			require.Equal(t, "k1", export.IdempotencyRecords[0].Key)
+			require.Len(t, export.ImportJobFailures, 1)
+			require.Equal(t, uint64(42), export.ImportJobFailures[0].OrderID)
+		})
+
+		t.WithNewStep("Anonymize is refused while an order of the client is at the pickup point", func(sCtx provider.StepCtx) {
+			orders := repositories.NewPGOrderRepository(commonDeps.Client)
+			version, err := orders.Save(ctx, models.Order{
+				OrderID:         orderID,
+				UserID:          userID,
+				Status:          models.Accepted,
+				CreatedAt:       now,
+				ExpiresAt:       now.Add(48 * time.Hour),
+				UpdatedStatusAt: now,
+				Package:         models.PackageBox,
+				Weight:          1,
+				Price:           10,
+			})
+			require.NoError(t, err)
+
+			_, err = repo.Anonymize(ctx, userID)
+			require.ErrorIs(t, err, repositories.ErrUserHasActiveOrders)
+			export, err := repo.Export(ctx, userID)
+			require.NoError(t, err)
+			require.Len(t, export.Orders, 1)
+			require.Len(t, export.Shipments, 1)
+			require.Len(t, export.IdempotencyRecords, 1)
+
+			require.NoError(t, orders.Delete(ctx, orderID, version))
+		})
+
+		t.WithNewStep("Anonymize detaches the client and drops the stored response", func(sCtx provider.StepCtx) {
+			res, err := repo.Anonymize(ctx, userID)
+			require.NoError(t, err)
+			require.Equal(t, models.AnonymizeResult{
+				UserID:            userID,
+				Orders:            1,
+				OutboxEvents:      1,
+				Shipments:         1,
+				ImportJobFailures: 1,
+				IdempotencyKeys:   1,
+			}, res)
+
+			export, err := repo.Export(ctx, userID)
+			require.NoError(t, err)
+			require.Empty(t, export.Shipments)
+			require.Empty(t, export.OutboxEvents)
+			require.Empty(t, export.IdempotencyRecords)
+			require.Empty(t, export.ImportJobFailures)
+
+			anonymous, err := repo.Export(ctx, models.AnonymousUserID)
+			require.NoError(t, err)
+			require.Len(t, anonymous.OutboxEvents, 1)
+			var event models.ShipmentKafkaEvent
+			require.NoError(t, json.Unmarshal(anonymous.OutboxEvents[0].Payload, &event))
+			require.Equal(t, models.AnonymousUserID, event.Shipment.SenderID)
+			require.Equal(t, models.AnonymousUserID, event.Actor.ID)
+
+			other, err := repo.Export(ctx, otherID)
+			require.NoError(t, err)
+			require.Len(t, other.IdempotencyRecords, 1)
+		})
+	})
+
+	r.RunTests()
+}
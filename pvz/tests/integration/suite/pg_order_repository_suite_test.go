@@ -48,7 +48,7 @@ func (s *PGOrderRepositorySuite) TestSaveAndLoad(t provider.T) {
 			Weight:          2.5,
 			Price:           100.0,
 		}
-		err := deps.repo.Save(deps.ctx, order)
+		_, err := deps.repo.Save(deps.ctx, order)
 		require.NoError(t, err)
 	})
 
@@ -77,11 +77,11 @@ func (s *PGOrderRepositorySuite) TestDelete(t provider.T) {
 			Weight:          2.5,
 			Price:           100.0,
 		}
-		err := deps.repo.Save(deps.ctx, order)
+		_, err := deps.repo.Save(deps.ctx, order)
 		require.NoError(t, err)
 	})
 	t.WithNewStep("Delete order", func(ctx provider.StepCtx) {
-		err := deps.repo.Delete(deps.ctx, orderID)
+		err := deps.repo.Delete(deps.ctx, orderID, 1)
 		require.NoError(t, err)
 	})
 	t.WithNewStep("Verify order is deleted", func(ctx provider.StepCtx) {
@@ -116,7 +116,7 @@ func (s *PGOrderRepositorySuite) TestList(t provider.T) {
 				Weight:          2.5,
 				Price:           100.0,
 			}
-			err := deps.repo.Save(deps.ctx, order)
+			_, err := deps.repo.Save(deps.ctx, order)
 			require.NoError(t, err)
 		}
 	})
@@ -152,7 +152,7 @@ func (s *PGOrderRepositorySuite) TestUpdate(t provider.T) {
 			Weight:          float32(2.5),
 			Price:           float32(100.0),
 		}
-		err := deps.repo.Save(deps.ctx, order)
+		_, err := deps.repo.Save(deps.ctx, order)
 		require.NoError(t, err)
 	})
 
@@ -167,8 +167,9 @@ func (s *PGOrderRepositorySuite) TestUpdate(t provider.T) {
 			Package:         models.PackageBox,
 			Weight:          float32(3.0),
 			Price:           float32(150.0),
+			Version:         1,
 		}
-		err := deps.repo.Save(deps.ctx, updatedOrder)
+		_, err := deps.repo.Save(deps.ctx, updatedOrder)
 		require.NoError(t, err)
 	})
 
@@ -199,7 +200,7 @@ func (s *PGOrderRepositorySuite) TestListPagination(t provider.T) {
 				Weight:          2.5,
 				Price:           100.0,
 			}
-			err := deps.repo.Save(deps.ctx, order)
+			_, err := deps.repo.Save(deps.ctx, order)
 			require.NoError(t, err)
 		}
 	})
@@ -246,7 +247,7 @@ func (s *PGOrderRepositorySuite) TestListByStatus(t provider.T) {
 				Weight:          2.5,
 				Price:           100.0,
 			}
-			err := deps.repo.Save(deps.ctx, order)
+			_, err := deps.repo.Save(deps.ctx, order)
 			require.NoError(t, err)
 		}
 	})